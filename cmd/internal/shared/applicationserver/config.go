@@ -80,8 +80,10 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	},
 	PubSub: applicationserver.PubSubConfig{
 		Providers: map[string]string{
-			"mqtt": "enabled",
-			"nats": "enabled",
+			"mqtt":  "enabled",
+			"nats":  "enabled",
+			"kafka": "enabled",
+			"cloud": "enabled",
 		},
 	},
 	Packages: applicationserver.ApplicationPackagesConfig{
@@ -21,6 +21,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
@@ -53,7 +54,12 @@ var DefaultTLSConfig = tlsconfig.Config{
 }
 
 // DefaultClusterConfig is the default cluster configuration.
-var DefaultClusterConfig = cluster.Config{}
+var DefaultClusterConfig = cluster.Config{
+	Discovery: cluster.DiscoveryConfig{
+		Provider: "static",
+		Interval: 10 * time.Second,
+	},
+}
 
 // DefaultHTTPConfig is the default HTTP config.
 var DefaultHTTPConfig = config.HTTP{
@@ -145,6 +151,14 @@ var DefaultRightsConfig = config.Rights{
 // DefaultKeyVaultConfig is the default config for key vaults.
 var DefaultKeyVaultConfig = config.KeyVault{
 	Provider: "static",
+	Vault: config.KeyVaultVault{
+		AuthMethod: "token",
+		AppRole: config.KeyVaultVaultAppRole{
+			Mount: "approle",
+		},
+		KVMount:   "secret",
+		KVVersion: 2,
+	},
 }
 
 // DefaultRateLimitingConfig is the default config for rate limiting.
@@ -176,6 +190,11 @@ var DefaultTelemetryConfig = telemetry.Config{
 	},
 }
 
+// DefaultMeteringConfig is the default config for usage metering.
+var DefaultMeteringConfig = metering.Config{
+	FlushInterval: 10 * time.Second,
+}
+
 // DefaultTTGCConfig is the default config for The Things Gateway Controller.
 var DefaultTTGCConfig = ttgc.Config{
 	GatewayEUIs: []types.EUI64Prefix{
@@ -46,6 +46,15 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		UpdateGatewayJitter:   packetbroker.DefaultUpdateGatewayJitter,
 		OnlineTTLMargin:       packetbroker.DefaultOnlineTTLMargin,
 	},
+	Alerts: gatewayserver.GatewayAlertsConfig{
+		Interval: time.Minute,
+	},
+	Drain: gatewayserver.DrainConfig{
+		Timeout:       30 * time.Second,
+		HandoffTTL:    2 * time.Minute,
+		BatchSize:     100,
+		BatchInterval: 100 * time.Millisecond,
+	},
 	UDP: gatewayserver.UDPConfig{
 		Config: udp.DefaultConfig,
 		Listeners: map[string]string{
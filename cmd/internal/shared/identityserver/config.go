@@ -80,4 +80,8 @@ func init() {
 	DefaultIdentityServerConfig.Delete.Restore = 24 * time.Hour
 	DefaultIdentityServerConfig.Gateways.TokenValidity = 5 * time.Second
 	DefaultIdentityServerConfig.Pagination.DefaultLimit = 100
+	DefaultIdentityServerConfig.Metering.FlushInterval = 5 * time.Minute
+	DefaultIdentityServerConfig.NotificationChannels.Attempts = 5
+	DefaultIdentityServerConfig.NotificationChannels.Backoff = 10 * time.Second
+	DefaultIdentityServerConfig.NotificationChannels.Timeout = 10 * time.Second
 }
@@ -0,0 +1,907 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/archive"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	archiveApplication         = "application.json"
+	archiveCollaborators       = "collaborators.json"
+	archiveLink                = "link.json"
+	archiveActivationSettings  = "activation_settings.json"
+	archiveWebhooks            = "webhooks.json"
+	archivePubSubs             = "pubsubs.json"
+	archiveDefaultAssociations = "default_associations.json"
+	archiveDevicePrefix        = "devices/"
+	archiveAssociationsPrefix  = "associations/"
+
+	archivePageLimit = 100
+)
+
+var (
+	errNoArchiveFile          = errors.DefineInvalidArgument("no_archive_file", "no archive file set")
+	errInvalidDeviceIDMapping = errors.DefineInvalidArgument(
+		"invalid_device_id_mapping", "invalid device ID mapping `{mapping}`",
+	)
+	errImportProgressMismatch = errors.DefineFailedPrecondition(
+		"import_progress_mismatch",
+		"import progress is for application `{progress_application_id}` instead of `{application_id}`",
+	)
+	errUnknownArchiveEntry = errors.DefineInvalidArgument("unknown_archive_entry", "unknown archive entry `{name}`")
+	errWrappedKey          = errors.DefineFailedPrecondition(
+		"wrapped_key", "key `{path}` is wrapped with KEK `{kek_label}` and cannot be exported",
+	)
+	errPlaintextSecrets = errors.DefineInvalidArgument(
+		"plaintext_secrets",
+		"secrets are only exported with a passphrase, or with `--insecure-plaintext-secrets`",
+	)
+
+	// endDeviceSecretPaths are the end device paths that are only exported with secrets.
+	endDeviceSecretPaths = []string{
+		"claim_authentication_code",
+		"mac_state",
+		"pending_mac_state",
+		"pending_session",
+		"root_keys",
+		"session",
+	}
+)
+
+func applicationsExportFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("output", "", "file to write the archive to (default is <application-id>.tar.gz)")
+	flagSet.Bool("include-secrets", false, "export root keys, session keys and MAC state")
+	flagSet.String("passphrase", "", "passphrase to encrypt the archive with")
+	flagSet.Bool("insecure-plaintext-secrets", false, "export secrets without passphrase, in plaintext")
+	return flagSet
+}
+
+func applicationsImportFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("input", "", "file to read the archive from")
+	flagSet.String("passphrase", "", "passphrase to decrypt the archive with")
+	flagSet.String("target-application-id", "", "application ID to import to (default is the exported application ID)")
+	flagSet.StringSlice("device-id-map", nil, "map exported device IDs to new device IDs (old=new)")
+	flagSet.String("progress-file", "", "file to keep the import progress in (default is <input>.progress.json)")
+	flagSet.Bool("dry-run", false, "validate the archive without importing")
+	return flagSet
+}
+
+func parseDeviceIDMap(mappings []string) (map[string]string, error) {
+	res := make(map[string]string, len(mappings))
+	for _, mapping := range mappings {
+		from, to, ok := strings.Cut(mapping, "=")
+		if !ok || from == "" || to == "" {
+			return nil, errInvalidDeviceIDMapping.WithAttributes("mapping", mapping)
+		}
+		res[from] = to
+	}
+	return res, nil
+}
+
+// unwrapExportedKey ensures that the exported key is not wrapped, as the KEKs of the exporting cluster are not
+// available where the archive is imported. The importing cluster wraps the keys with its own KEKs.
+func unwrapExportedKey(path string, env *ttnpb.KeyEnvelope) error {
+	switch {
+	case env == nil:
+		return nil
+	case len(env.Key) > 0:
+		env.KekLabel, env.EncryptedKey = "", nil
+		return nil
+	case len(env.EncryptedKey) > 0:
+		return errWrappedKey.WithAttributes("path", path, "kek_label", env.KekLabel)
+	default:
+		return nil
+	}
+}
+
+func unwrapExportedEndDeviceKeys(device *ttnpb.EndDevice) error {
+	// The queued Join-Accept is only kept until it is transmitted, and its AppSKey is wrapped for the Application
+	// Server. It is therefore not exported.
+	if device.PendingMacState != nil {
+		device.PendingMacState.QueuedJoinAccept = nil
+	}
+	if device.MacState != nil {
+		device.MacState.QueuedJoinAccept = nil
+	}
+	envelopes := map[string]*ttnpb.KeyEnvelope{
+		"root_keys.app_key": device.GetRootKeys().GetAppKey(),
+		"root_keys.nwk_key": device.GetRootKeys().GetNwkKey(),
+	}
+	for prefix, keys := range map[string]*ttnpb.SessionKeys{
+		"session.keys":         device.GetSession().GetKeys(),
+		"pending_session.keys": device.GetPendingSession().GetKeys(),
+	} {
+		envelopes[prefix+".app_s_key"] = keys.GetAppSKey()
+		envelopes[prefix+".f_nwk_s_int_key"] = keys.GetFNwkSIntKey()
+		envelopes[prefix+".s_nwk_s_int_key"] = keys.GetSNwkSIntKey()
+		envelopes[prefix+".nwk_s_enc_key"] = keys.GetNwkSEncKey()
+	}
+	for path, env := range envelopes {
+		if err := unwrapExportedKey(path, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportEndDevice(ids *ttnpb.EndDeviceIdentifiers, includeSecrets bool) (*ttnpb.EndDevice, error) {
+	paths := ttnpb.EndDeviceFieldPathsTopLevel
+	if !includeSecrets {
+		paths = ttnpb.ExcludeFields(paths, endDeviceSecretPaths...)
+	}
+	isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceGetPaths(paths...)
+	isPaths = ttnpb.AddFields(isPaths, "network_server_address", "application_server_address", "join_server_address")
+
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	device, err := ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask(isPaths...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if device.JoinServerAddress == "" {
+		jsPaths = nil
+	}
+	nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(device, config)
+	if nsMismatch {
+		nsPaths = nil
+	}
+	if asMismatch {
+		asPaths = nil
+	}
+	if jsMismatch {
+		jsPaths = nil
+	}
+	if device.ClaimAuthenticationCode.GetValue() != "" {
+		jsPaths = ttnpb.ExcludeFields(jsPaths, claimAuthenticationCodePaths...)
+	}
+
+	res, err := getEndDevice(device.Ids, nsPaths, asPaths, jsPaths, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := device.SetFields(res, "ids.dev_addr"); err != nil {
+		return nil, err
+	}
+	if err := device.SetFields(res, append(append(nsPaths, asPaths...), jsPaths...)...); err != nil {
+		return nil, err
+	}
+	if err := unwrapExportedEndDeviceKeys(device); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+func exportApplication(w *archive.Writer, appID *ttnpb.ApplicationIdentifiers, includeSecrets bool) error {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	application, err := ttnpb.NewApplicationRegistryClient(is).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIds: appID,
+		FieldMask: ttnpb.FieldMask(ttnpb.AllowedFields(
+			ttnpb.ApplicationFieldPathsTopLevel,
+			ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.ApplicationRegistry/Get"].Allowed,
+		)...),
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.WriteMessage(archiveApplication, application); err != nil {
+		return err
+	}
+
+	collaborators := &ttnpb.Collaborators{}
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewApplicationAccessClient(is).ListCollaborators(ctx, &ttnpb.ListApplicationCollaboratorsRequest{
+			ApplicationIds: appID,
+			Limit:          archivePageLimit,
+			Page:           page,
+		})
+		if err != nil {
+			return err
+		}
+		collaborators.Collaborators = append(collaborators.Collaborators, res.Collaborators...)
+		if len(res.Collaborators) < archivePageLimit {
+			break
+		}
+	}
+	if err := w.WriteMessage(archiveCollaborators, collaborators); err != nil {
+		return err
+	}
+
+	if config.JoinServerEnabled {
+		paths := ttnpb.ApplicationActivationSettingsFieldPathsTopLevel
+		if !includeSecrets {
+			paths = ttnpb.ExcludeFields(paths, "kek")
+		}
+		js, err := api.Dial(ctx, config.JoinServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		settings, err := ttnpb.NewApplicationActivationSettingRegistryClient(js).Get(ctx, &ttnpb.GetApplicationActivationSettingsRequest{ //nolint:lll
+			ApplicationIds: appID,
+			FieldMask:      ttnpb.FieldMask(paths...),
+		})
+		switch {
+		case errors.IsNotFound(err):
+			logger.Debug("No application activation settings found")
+		case errors.IsPermissionDenied(err):
+			logger.WithError(err).Warn("Could not export application activation settings")
+		case err != nil:
+			return err
+		default:
+			if err := unwrapExportedKey("kek", settings.Kek); err != nil {
+				return err
+			}
+			if err := w.WriteMessage(archiveActivationSettings, settings); err != nil {
+				return err
+			}
+		}
+	}
+
+	var devices []*ttnpb.EndDevice
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIds: appID,
+			FieldMask:      ttnpb.FieldMask("ids"),
+			Limit:          archivePageLimit,
+			Page:           page,
+		})
+		if err != nil {
+			return err
+		}
+		devices = append(devices, res.EndDevices...)
+		if len(res.EndDevices) < archivePageLimit {
+			break
+		}
+	}
+	for _, dev := range devices {
+		logger.WithField("device_id", dev.Ids.DeviceId).Info("Export end device")
+		device, err := exportEndDevice(dev.Ids, includeSecrets)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteMessage(archiveDevicePrefix+dev.Ids.DeviceId+".json", device); err != nil {
+			return err
+		}
+	}
+
+	if !config.ApplicationServerEnabled {
+		logger.Warn("Application Server disabled, skipping link, integrations and package associations")
+		return nil
+	}
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	link, err := ttnpb.NewAsClient(as).GetLink(ctx, &ttnpb.GetApplicationLinkRequest{
+		ApplicationIds: appID,
+		FieldMask: ttnpb.FieldMask(ttnpb.AllowedFields(
+			ttnpb.ApplicationLinkFieldPathsTopLevel,
+			ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.As/GetLink"].Allowed,
+		)...),
+	})
+	switch {
+	case errors.IsNotFound(err):
+		logger.Debug("No application link found")
+	case err != nil:
+		return err
+	default:
+		if err := w.WriteMessage(archiveLink, link); err != nil {
+			return err
+		}
+	}
+
+	webhooks, err := ttnpb.NewApplicationWebhookRegistryClient(as).List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+		ApplicationIds: appID,
+		FieldMask: ttnpb.FieldMask(ttnpb.AllowedFields(
+			ttnpb.ApplicationWebhookFieldPathsTopLevel,
+			ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.ApplicationWebhookRegistry/List"].Allowed,
+		)...),
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.WriteMessage(archiveWebhooks, webhooks); err != nil {
+		return err
+	}
+
+	pubsubs, err := ttnpb.NewApplicationPubSubRegistryClient(as).List(ctx, &ttnpb.ListApplicationPubSubsRequest{
+		ApplicationIds: appID,
+		FieldMask: ttnpb.FieldMask(ttnpb.AllowedFields(
+			ttnpb.ApplicationPubSubFieldPathsTopLevel,
+			ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.ApplicationPubSubRegistry/List"].Allowed,
+		)...),
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.WriteMessage(archivePubSubs, pubsubs); err != nil {
+		return err
+	}
+
+	defaults := &ttnpb.ApplicationPackageDefaultAssociations{}
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewApplicationPackageRegistryClient(as).ListDefaultAssociations(ctx, &ttnpb.ListApplicationPackageDefaultAssociationRequest{ //nolint:lll
+			Ids:       appID,
+			Limit:     archivePageLimit,
+			Page:      page,
+			FieldMask: ttnpb.FieldMask(ttnpb.ApplicationPackageDefaultAssociationFieldPathsTopLevel...),
+		})
+		if err != nil {
+			return err
+		}
+		defaults.Defaults = append(defaults.Defaults, res.Defaults...)
+		if len(res.Defaults) < archivePageLimit {
+			break
+		}
+	}
+	if err := w.WriteMessage(archiveDefaultAssociations, defaults); err != nil {
+		return err
+	}
+
+	for _, dev := range devices {
+		associations := &ttnpb.ApplicationPackageAssociations{}
+		for page := uint32(1); ; page++ {
+			res, err := ttnpb.NewApplicationPackageRegistryClient(as).ListAssociations(ctx, &ttnpb.ListApplicationPackageAssociationRequest{ //nolint:lll
+				Ids:       dev.Ids,
+				Limit:     archivePageLimit,
+				Page:      page,
+				FieldMask: ttnpb.FieldMask(ttnpb.ApplicationPackageAssociationFieldPathsTopLevel...),
+			})
+			if err != nil {
+				return err
+			}
+			associations.Associations = append(associations.Associations, res.Associations...)
+			if len(res.Associations) < archivePageLimit {
+				break
+			}
+		}
+		if len(associations.Associations) == 0 {
+			continue
+		}
+		if err := w.WriteMessage(archiveAssociationsPrefix+dev.Ids.DeviceId+".json", associations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applicationImporter imports the entries of an application archive.
+type applicationImporter struct {
+	reader      *archive.Reader
+	appID       *ttnpb.ApplicationIdentifiers
+	owner       *ttnpb.OrganizationOrUserIdentifiers
+	deviceIDMap map[string]string
+	dryRun      bool
+}
+
+func (imp *applicationImporter) endDeviceIDs(ids *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDeviceIdentifiers {
+	res := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: imp.appID,
+		DeviceId:       ids.GetDeviceId(),
+		DevEui:         ids.GetDevEui(),
+		JoinEui:        ids.GetJoinEui(),
+		DevAddr:        ids.GetDevAddr(),
+	}
+	if deviceID, ok := imp.deviceIDMap[res.DeviceId]; ok {
+		res.DeviceId = deviceID
+	}
+	return res
+}
+
+func (imp *applicationImporter) importApplication() error {
+	application := &ttnpb.Application{}
+	if err := imp.reader.ReadMessage(archiveApplication, application); err != nil {
+		return err
+	}
+	application.Ids = imp.appID
+	application.CreatedAt, application.UpdatedAt, application.DeletedAt = nil, nil, nil
+	application.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+	application.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+	application.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+	if imp.dryRun {
+		return application.ValidateFields()
+	}
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	_, err = ttnpb.NewApplicationRegistryClient(is).Create(ctx, &ttnpb.CreateApplicationRequest{
+		Application:  application,
+		Collaborator: imp.owner,
+	})
+	return err
+}
+
+func (imp *applicationImporter) importCollaborators() error {
+	collaborators := &ttnpb.Collaborators{}
+	if err := imp.reader.ReadMessage(archiveCollaborators, collaborators); err != nil {
+		return err
+	}
+	if imp.dryRun {
+		return nil
+	}
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	for _, collaborator := range collaborators.Collaborators {
+		if collaborator.GetIds().IDString() == imp.owner.IDString() {
+			continue
+		}
+		_, err := ttnpb.NewApplicationAccessClient(is).SetCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIds: imp.appID,
+			Collaborator:   collaborator,
+		})
+		if err != nil {
+			// Collaborators of the exported application may not exist where the archive is imported.
+			logger.WithError(err).
+				WithField("collaborator", collaborator.GetIds().IDString()).
+				Warn("Could not import collaborator")
+		}
+	}
+	return nil
+}
+
+func (imp *applicationImporter) importActivationSettings() error {
+	settings := &ttnpb.ApplicationActivationSettings{}
+	if err := imp.reader.ReadMessage(archiveActivationSettings, settings); err != nil {
+		return err
+	}
+	if imp.dryRun {
+		return settings.ValidateFields()
+	}
+	paths := ttnpb.ApplicationActivationSettingsFieldPathsTopLevel
+	if settings.Kek == nil {
+		// The KEK is only exported with secrets.
+		paths = ttnpb.ExcludeFields(paths, "kek")
+	}
+	js, err := api.Dial(ctx, config.JoinServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	_, err = ttnpb.NewApplicationActivationSettingRegistryClient(js).Set(ctx, &ttnpb.SetApplicationActivationSettingsRequest{ //nolint:lll
+		ApplicationIds: imp.appID,
+		Settings:       settings,
+		FieldMask:      ttnpb.FieldMask(paths...),
+	})
+	return err
+}
+
+func (imp *applicationImporter) importEndDevice(name string) error {
+	device := &ttnpb.EndDevice{}
+	if err := imp.reader.ReadMessage(name, device); err != nil {
+		return err
+	}
+	device.Ids = imp.endDeviceIDs(device.Ids)
+	device.CreatedAt, device.UpdatedAt = nil, nil
+	if device.NetworkServerAddress != "" {
+		device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+	}
+	if device.ApplicationServerAddress != "" {
+		device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+	}
+	if device.JoinServerAddress != "" {
+		device.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+	}
+	paths := ttnpb.BottomLevelFields(ttnpb.NonZeroFields(device, ttnpb.EndDeviceFieldPathsNestedWithoutWrappers...))
+	if imp.dryRun {
+		return device.ValidateFields(append(paths, "ids")...)
+	}
+
+	isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, paths...)
+	if device.JoinServerAddress == "" {
+		jsPaths = nil
+	}
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	isDevice := &ttnpb.EndDevice{}
+	if err := isDevice.SetFields(device, append(isPaths, "ids")...); err != nil {
+		return err
+	}
+	if _, err := ttnpb.NewEndDeviceRegistryClient(is).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: isDevice,
+	}); err != nil {
+		return err
+	}
+	if _, err := setEndDevice(device, nil, nsPaths, asPaths, jsPaths, nil, true, false); err != nil {
+		logger.WithError(err).Error("Could not import end device, rolling back...")
+		if err := deleteEndDevice(context.Background(), device.Ids, false); err != nil {
+			logger.WithError(err).Error("Could not roll back end device import")
+		}
+		return err
+	}
+	return nil
+}
+
+func (imp *applicationImporter) importLink() error {
+	link := &ttnpb.ApplicationLink{}
+	if err := imp.reader.ReadMessage(archiveLink, link); err != nil {
+		return err
+	}
+	if imp.dryRun {
+		return link.ValidateFields()
+	}
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	_, err = ttnpb.NewAsClient(as).SetLink(ctx, &ttnpb.SetApplicationLinkRequest{
+		ApplicationIds: imp.appID,
+		Link:           link,
+		FieldMask: ttnpb.FieldMask(ttnpb.AllowedFields(
+			ttnpb.ApplicationLinkFieldPathsTopLevel,
+			ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.As/SetLink"].Allowed,
+		)...),
+	})
+	return err
+}
+
+func (imp *applicationImporter) importWebhooks() error {
+	webhooks := &ttnpb.ApplicationWebhooks{}
+	if err := imp.reader.ReadMessage(archiveWebhooks, webhooks); err != nil {
+		return err
+	}
+	paths := ttnpb.AllowedFields(
+		ttnpb.ExcludeFields(ttnpb.ApplicationWebhookFieldPathsTopLevel, "ids", "created_at", "updated_at"),
+		ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.ApplicationWebhookRegistry/Set"].Allowed,
+	)
+	for _, webhook := range webhooks.Webhooks {
+		webhook.Ids.ApplicationIds = imp.appID
+		if imp.dryRun {
+			if err := webhook.ValidateFields(); err != nil {
+				return err
+			}
+			continue
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewApplicationWebhookRegistryClient(as).Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			Webhook:   webhook,
+			FieldMask: ttnpb.FieldMask(paths...),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (imp *applicationImporter) importPubSubs() error {
+	pubsubs := &ttnpb.ApplicationPubSubs{}
+	if err := imp.reader.ReadMessage(archivePubSubs, pubsubs); err != nil {
+		return err
+	}
+	paths := ttnpb.AllowedFields(
+		ttnpb.ExcludeFields(ttnpb.ApplicationPubSubFieldPathsTopLevel, "ids", "created_at", "updated_at"),
+		ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.ApplicationPubSubRegistry/Set"].Allowed,
+	)
+	for _, pubsub := range pubsubs.Pubsubs {
+		pubsub.Ids.ApplicationIds = imp.appID
+		if imp.dryRun {
+			if err := pubsub.ValidateFields(); err != nil {
+				return err
+			}
+			continue
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewApplicationPubSubRegistryClient(as).Set(ctx, &ttnpb.SetApplicationPubSubRequest{
+			Pubsub:    pubsub,
+			FieldMask: ttnpb.FieldMask(paths...),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (imp *applicationImporter) importDefaultAssociations() error {
+	defaults := &ttnpb.ApplicationPackageDefaultAssociations{}
+	if err := imp.reader.ReadMessage(archiveDefaultAssociations, defaults); err != nil {
+		return err
+	}
+	paths := ttnpb.ExcludeFields(
+		ttnpb.ApplicationPackageDefaultAssociationFieldPathsTopLevel, "ids", "created_at", "updated_at",
+	)
+	for _, association := range defaults.Defaults {
+		association.Ids.ApplicationIds = imp.appID
+		if imp.dryRun {
+			if err := association.ValidateFields(); err != nil {
+				return err
+			}
+			continue
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewApplicationPackageRegistryClient(as).SetDefaultAssociation(ctx, &ttnpb.SetApplicationPackageDefaultAssociationRequest{ //nolint:lll
+			Default:   association,
+			FieldMask: ttnpb.FieldMask(paths...),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (imp *applicationImporter) importAssociations(name string) error {
+	associations := &ttnpb.ApplicationPackageAssociations{}
+	if err := imp.reader.ReadMessage(name, associations); err != nil {
+		return err
+	}
+	paths := ttnpb.ExcludeFields(ttnpb.ApplicationPackageAssociationFieldPathsTopLevel, "ids", "created_at", "updated_at")
+	for _, association := range associations.Associations {
+		association.Ids.EndDeviceIds = imp.endDeviceIDs(association.Ids.EndDeviceIds)
+		if imp.dryRun {
+			if err := association.ValidateFields(); err != nil {
+				return err
+			}
+			continue
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewApplicationPackageRegistryClient(as).SetAssociation(ctx, &ttnpb.SetApplicationPackageAssociationRequest{ //nolint:lll
+			Association: association,
+			FieldMask:   ttnpb.FieldMask(paths...),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (imp *applicationImporter) importEntry(name string) error {
+	switch {
+	case name == archiveApplication:
+		return imp.importApplication()
+	case name == archiveCollaborators:
+		return imp.importCollaborators()
+	case name == archiveActivationSettings:
+		return imp.importActivationSettings()
+	case name == archiveLink:
+		return imp.importLink()
+	case name == archiveWebhooks:
+		return imp.importWebhooks()
+	case name == archivePubSubs:
+		return imp.importPubSubs()
+	case name == archiveDefaultAssociations:
+		return imp.importDefaultAssociations()
+	case strings.HasPrefix(name, archiveDevicePrefix):
+		return imp.importEndDevice(name)
+	case strings.HasPrefix(name, archiveAssociationsPrefix):
+		return imp.importAssociations(name)
+	default:
+		return errUnknownArchiveEntry.WithAttributes("name", name)
+	}
+}
+
+var (
+	applicationsExportCommand = &cobra.Command{
+		Use:   "export [application-id]",
+		Short: "Export an application to an archive",
+		Long: `Export an application to an archive
+
+The archive contains the application, its collaborators, activation settings,
+link, webhooks, pub/subs, package associations and end devices from the
+Identity Server, Network Server, Application Server and Join Server.
+
+Root keys, session keys and MAC state are only exported with --include-secrets
+and when the used credentials have the rights to read them. Archives that
+contain secrets are encrypted with --passphrase. Use
+--insecure-plaintext-secrets to export secrets without encryption.`,
+		Example: `  ttn-lw-cli applications export my-app --include-secrets --passphrase secret`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			includeSecrets, _ := cmd.Flags().GetBool("include-secrets")
+			passphrase, _ := cmd.Flags().GetString("passphrase")
+			if includeSecrets && passphrase == "" {
+				if insecure, _ := cmd.Flags().GetBool("insecure-plaintext-secrets"); !insecure {
+					return errPlaintextSecrets.New()
+				}
+				logger.Warn("Exporting secrets without passphrase, the archive will not be encrypted")
+			}
+			output, _ := cmd.Flags().GetString("output")
+			if output == "" {
+				output = appID.ApplicationId + ".tar.gz"
+			}
+
+			f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w, err := archive.NewWriter(f, appID.ApplicationId, includeSecrets, passphrase)
+			if err != nil {
+				return err
+			}
+			if err := exportApplication(w, appID, includeSecrets); err != nil {
+				return err
+			}
+			if err := w.Close(); err != nil {
+				return err
+			}
+			logger.WithField("output", output).Info("Exported application")
+			return f.Close()
+		},
+	}
+	applicationsImportCommand = &cobra.Command{
+		Use:   "import [archive]",
+		Short: "Import an application from an archive",
+		Long: `Import an application from an archive
+
+The application is created with the given user or organization as owner, and
+the other collaborators are added when they exist. The application and device
+IDs can be remapped with --target-application-id and --device-id-map.
+
+The imported entries are kept in a progress file, so that an interrupted import
+can be resumed by running the same command again. The progress file is removed
+when the import completes.`,
+		Example: `  ttn-lw-cli applications import my-app.tar.gz --user-id admin --passphrase secret --dry-run
+  ttn-lw-cli applications import my-app.tar.gz --user-id admin --passphrase secret \
+    --target-application-id my-new-app --device-id-map old-device=new-device`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input, _ := cmd.Flags().GetString("input")
+			if len(args) > 0 {
+				input = args[0]
+			}
+			if input == "" {
+				return errNoArchiveFile.New()
+			}
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			owner := &ttnpb.OrganizationOrUserIdentifiers{}
+			if _, err := owner.SetFromFlags(cmd.Flags(), "collaborator"); err != nil {
+				return err
+			}
+			if owner.GetIds() == nil && !dryRun {
+				return errNoCollaborator.New()
+			}
+			deviceIDMappings, _ := cmd.Flags().GetStringSlice("device-id-map")
+			deviceIDMap, err := parseDeviceIDMap(deviceIDMappings)
+			if err != nil {
+				return err
+			}
+			passphrase, _ := cmd.Flags().GetString("passphrase")
+
+			f, err := os.Open(input)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			reader, err := archive.NewReader(f, passphrase)
+			if err != nil {
+				return err
+			}
+			manifest := reader.Manifest()
+
+			appID := &ttnpb.ApplicationIdentifiers{ApplicationId: manifest.ApplicationID}
+			if targetAppID, _ := cmd.Flags().GetString("target-application-id"); targetAppID != "" {
+				appID.ApplicationId = targetAppID
+			}
+			if err := appID.ValidateFields(); err != nil {
+				return err
+			}
+
+			progressFile, _ := cmd.Flags().GetString("progress-file")
+			if progressFile == "" {
+				progressFile = input + ".progress.json"
+			}
+			progress := &archive.Progress{ApplicationID: appID.ApplicationId}
+			if pf, err := os.Open(progressFile); err == nil {
+				progress, err = archive.LoadProgress(pf)
+				pf.Close()
+				if err != nil {
+					return err
+				}
+				if progress.ApplicationID != appID.ApplicationId {
+					return errImportProgressMismatch.WithAttributes(
+						"progress_application_id", progress.ApplicationID,
+						"application_id", appID.ApplicationId,
+					)
+				}
+				logger.WithField("completed", len(progress.Completed)).Info("Resuming import")
+			} else if !os.IsNotExist(err) {
+				return err
+			}
+			saveProgress := func() error {
+				pf, err := os.OpenFile(progressFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+				if err != nil {
+					return err
+				}
+				defer pf.Close()
+				if err := progress.Save(pf); err != nil {
+					return err
+				}
+				return pf.Close()
+			}
+
+			imp := &applicationImporter{
+				reader:      reader,
+				appID:       appID,
+				owner:       owner,
+				deviceIDMap: deviceIDMap,
+				dryRun:      dryRun,
+			}
+			for _, entry := range manifest.Entries {
+				logger := logger.WithField("entry", entry.Name)
+				if progress.IsCompleted(entry.Name) {
+					logger.Debug("Skip imported entry")
+					continue
+				}
+				if dryRun {
+					logger.Info("Validate entry")
+				} else {
+					logger.Info("Import entry")
+				}
+				if err := imp.importEntry(entry.Name); err != nil {
+					return err
+				}
+				if dryRun {
+					continue
+				}
+				progress.Complete(entry.Name)
+				if err := saveProgress(); err != nil {
+					return err
+				}
+			}
+			if dryRun {
+				logger.Info("Archive is valid")
+				return nil
+			}
+			logger.WithField("application_id", appID.ApplicationId).Info("Imported application")
+			return os.Remove(progressFile)
+		},
+	}
+)
+
+func init() {
+	applicationsExportCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsExportCommand.Flags().AddFlagSet(applicationsExportFlags())
+	applicationsCommand.AddCommand(applicationsExportCommand)
+	applicationsImportCommand.Flags().AddFlagSet(applicationsImportFlags())
+	ttnpb.AddSetFlagsForOrganizationOrUserIdentifiers(applicationsImportCommand.Flags(), "collaborator", true)
+	AddCollaboratorFlagAlias(applicationsImportCommand.Flags(), "collaborator")
+	applicationsCommand.AddCommand(applicationsImportCommand)
+}
@@ -35,6 +35,8 @@ var (
 
 	natsProviderApplicationPubSubFlags   = util.NormalizedFlagSet()
 	mqttProviderApplicationPubSubFlags   = util.NormalizedFlagSet()
+	kafkaProviderApplicationPubSubFlags  = util.NormalizedFlagSet()
+	cloudProviderApplicationPubSubFlags  = util.NormalizedFlagSet()
 	awsiotProviderApplicationPubSubFlags = util.NormalizedFlagSet()
 	awsiotDefaultIntegrationPubSubFlags  = util.NormalizedFlagSet()
 
@@ -59,6 +61,15 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("mqtt.tls-ca", "")))
 	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("mqtt.tls-client-cert", "")))
 	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("mqtt.tls-client-key", "")))
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	util.HideFlag(flagSet, "kafka")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("kafka.tls-ca", "")))
+	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("kafka.tls-client-cert", "")))
+	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("kafka.tls-client-key", "")))
+	flagSet.Bool("cloud", false, "use the Go Cloud provider")
+	util.HideFlag(flagSet, "cloud")
+	flagSet.AddFlagSet(cloudProviderApplicationPubSubFlags)
 	flagSet.Bool("aws-iot", false, "use the AWS IoT provider")
 	util.HideFlag(flagSet, "aws-iot")
 	flagSet.AddFlagSet(awsiotProviderApplicationPubSubFlags)
@@ -277,6 +288,56 @@ var (
 				}
 			}
 
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if useTLS, _ := cmd.Flags().GetBool("kafka.use-tls"); useTLS {
+					for _, name := range []string{
+						"kafka.tls-ca",
+						"kafka.tls-client-cert",
+						"kafka.tls-client-key",
+					} {
+						data, err := getDataBytes(name, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						err = cmd.Flags().Set(name, hex.EncodeToString(data))
+						if err != nil {
+							return err
+						}
+					}
+				}
+				_, err := pubsub.GetKafka().SetFromFlags(cmd.Flags(), "kafka")
+				if err != nil {
+					return err
+				}
+			}
+
+			if cloud, _ := cmd.Flags().GetBool("cloud"); cloud {
+				if pubsub.GetCloud() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Cloud{
+						Cloud: &ttnpb.ApplicationPubSub_CloudProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), cloudProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				_, err := pubsub.GetCloud().SetFromFlags(cmd.Flags(), "cloud")
+				if err != nil {
+					return err
+				}
+			}
+
 			if awsiot, _ := cmd.Flags().GetBool("aws-iot"); awsiot {
 				if pubsub.GetAwsIot() == nil {
 					paths = append(paths, "provider")
@@ -345,6 +406,8 @@ var (
 func init() {
 	ttnpb.AddSetFlagsForApplicationPubSub_NATSProvider(natsProviderApplicationPubSubFlags, "nats", true)
 	ttnpb.AddSetFlagsForApplicationPubSub_MQTTProvider(mqttProviderApplicationPubSubFlags, "mqtt", true)
+	ttnpb.AddSetFlagsForApplicationPubSub_KafkaProvider(kafkaProviderApplicationPubSubFlags, "kafka", true)
+	ttnpb.AddSetFlagsForApplicationPubSub_CloudProvider(cloudProviderApplicationPubSubFlags, "cloud", true)
 	ttnpb.AddSetFlagsForApplicationPubSub_AWSIoTProvider(awsiotProviderApplicationPubSubFlags, "aws-iot", true)
 	ttnpb.AddSetFlagsForApplicationPubSub_AWSIoTProvider_DefaultIntegration(awsiotDefaultIntegrationPubSubFlags, "aws-iot.deployment.default", true)
 	ttnpb.AddSetFlagsForApplicationPubSub(setApplicationPubSubFlags, "", false)
@@ -0,0 +1,271 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	errNoRekeyCampaignID = errors.DefineInvalidArgument("no_rekey_campaign_id", "no rekey campaign ID set")
+	errInvalidRejoinType = errors.DefineInvalidArgument(
+		"invalid_rejoin_type", "invalid rejoin type `{type}`, must be `context`, `session` or `keys`",
+	)
+	errInvalidAppCommandPayload = errors.DefineInvalidArgument(
+		"invalid_app_command_payload", "invalid application command payload",
+	)
+)
+
+func rekeyCampaignIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("application-id", "", "")
+	flagSet.String("campaign-id", "", "")
+	return flagSet
+}
+
+func getRekeyCampaignID(flagSet *pflag.FlagSet, args []string) (*ttnpb.GetRekeyCampaignRequest, error) {
+	applicationID, _ := flagSet.GetString("application-id")
+	campaignID, _ := flagSet.GetString("campaign-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		logger.Warn("Only single ID found in arguments, not considering arguments")
+	case 2:
+		applicationID = args[0]
+		campaignID = args[1]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		applicationID = args[0]
+		campaignID = args[1]
+	}
+	if applicationID == "" {
+		return nil, errNoApplicationID.New()
+	}
+	if campaignID == "" {
+		return nil, errNoRekeyCampaignID.New()
+	}
+	return &ttnpb.GetRekeyCampaignRequest{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: applicationID},
+		CampaignId:     campaignID,
+	}, nil
+}
+
+// newRekeyCampaign returns the rekey campaign based on the flags.
+func newRekeyCampaign(flagSet *pflag.FlagSet, ids *ttnpb.GetRekeyCampaignRequest) (*ttnpb.RekeyCampaign, error) {
+	deviceIDs, _ := flagSet.GetStringSlice("device-ids")
+	attributes, _ := flagSet.GetStringToString("attributes")
+	prefixes, _ := flagSet.GetStringSlice("dev-addr-prefixes")
+	devicesPerMinute, _ := flagSet.GetUint32("devices-per-minute")
+	rejoinTimeout, _ := flagSet.GetDuration("rejoin-timeout")
+	c := &ttnpb.RekeyCampaign{
+		ApplicationIds: ids.ApplicationIds,
+		CampaignId:     ids.CampaignId,
+		Selector: &ttnpb.RekeyCampaign_Selector{
+			DeviceIds:  deviceIDs,
+			Attributes: attributes,
+		},
+		DevicesPerMinute: devicesPerMinute,
+		RejoinTimeout:    durationpb.New(rejoinTimeout),
+	}
+	for _, s := range prefixes {
+		var prefix types.DevAddrPrefix
+		if err := prefix.UnmarshalText([]byte(s)); err != nil {
+			return nil, err
+		}
+		c.Selector.DevAddrPrefixes = append(c.Selector.DevAddrPrefixes, &ttnpb.DevAddrPrefix{
+			DevAddr: prefix.DevAddr.Bytes(),
+			Length:  uint32(prefix.Length),
+		})
+	}
+	if flagSet.Changed("rejoin-type") || flagSet.Changed("rejoin-max-retries") {
+		rejoinType, _ := flagSet.GetString("rejoin-type")
+		v, ok := ttnpb.RejoinRequestType_value[strings.ToUpper(rejoinType)]
+		if !ok {
+			return nil, errInvalidRejoinType.WithAttributes("type", rejoinType)
+		}
+		maxRetries, _ := flagSet.GetUint32("rejoin-max-retries")
+		c.ForceRejoinReq = &ttnpb.MACCommand_ForceRejoinReq{
+			RejoinType: ttnpb.RejoinRequestType(v),
+			MaxRetries: maxRetries,
+		}
+	}
+	if flagSet.Changed("app-command-frm-payload") {
+		fPort, _ := flagSet.GetUint32("app-command-f-port")
+		s, _ := flagSet.GetString("app-command-frm-payload")
+		frmPayload, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, errInvalidAppCommandPayload.WithCause(err)
+		}
+		c.AppCommand = &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FrmPayload: frmPayload,
+		}
+	}
+	return c, nil
+}
+
+var (
+	applicationsRekeyCampaignsCommand = &cobra.Command{
+		Use:     "rekey-campaigns",
+		Aliases: []string{"rekey-campaign", "rekey"},
+		Short:   "Application session rekey campaign commands (NS only)",
+	}
+	applicationsRekeyCampaignsCreateCommand = &cobra.Command{
+		Use:     "create [application-id] [campaign-id]",
+		Aliases: []string{"add", "register"},
+		Short:   "Create a session rekey campaign",
+		Long: `Create a session rekey campaign
+
+The campaign triggers the selected end devices to rejoin, at the
+specified rate. LoRaWAN 1.1 end devices are sent a ForceRejoinReq.
+LoRaWAN 1.0.x end devices are sent the specified application command,
+which the application on the end device should handle by joining again.
+LoRaWAN 1.0.x end devices are skipped if no command is specified.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getRekeyCampaignID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			c, err := newRekeyCampaign(cmd.Flags(), ids)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsRekeyCampaignsClient(ns).CreateRekeyCampaign(ctx, &ttnpb.CreateRekeyCampaignRequest{
+				Campaign: c,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsRekeyCampaignsGetCommand = &cobra.Command{
+		Use:     "get [application-id] [campaign-id]",
+		Aliases: []string{"info"},
+		Short:   "Get a session rekey campaign",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getRekeyCampaignID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsRekeyCampaignsClient(ns).GetRekeyCampaign(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsRekeyCampaignsListCommand = &cobra.Command{
+		Use:     "list [application-id]",
+		Aliases: []string{"ls"},
+		Short:   "List session rekey campaigns",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsRekeyCampaignsClient(ns).ListRekeyCampaigns(ctx, &ttnpb.ListRekeyCampaignsRequest{
+				ApplicationIds: appID,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsRekeyCampaignsCancelCommand = &cobra.Command{
+		Use:   "cancel [application-id] [campaign-id]",
+		Short: "Cancel a session rekey campaign",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getRekeyCampaignID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsRekeyCampaignsClient(ns).CancelRekeyCampaign(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsRekeyCampaignsDeleteCommand = &cobra.Command{
+		Use:     "delete [application-id] [campaign-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete a session rekey campaign",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getRekeyCampaignID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNsRekeyCampaignsClient(ns).DeleteRekeyCampaign(ctx, req)
+			return err
+		},
+	}
+)
+
+func init() {
+	applicationsRekeyCampaignsCreateCommand.Flags().AddFlagSet(rekeyCampaignIDFlags())
+	applicationsRekeyCampaignsCreateCommand.Flags().StringSlice("device-ids", nil, "device IDs of the end devices (all end devices if empty)")
+	applicationsRekeyCampaignsCreateCommand.Flags().StringToString("attributes", nil, "attributes that the end devices must have")
+	applicationsRekeyCampaignsCreateCommand.Flags().StringSlice("dev-addr-prefixes", nil, "DevAddr prefixes of the sessions of the end devices (e.g. 26000000/8)")
+	applicationsRekeyCampaignsCreateCommand.Flags().Uint32("devices-per-minute", 10, "maximum number of end devices triggered per minute")
+	applicationsRekeyCampaignsCreateCommand.Flags().Duration("rejoin-timeout", 24*time.Hour, "time after which a triggered end device is considered timed out")
+	applicationsRekeyCampaignsCreateCommand.Flags().String("rejoin-type", "keys", "rejoin type of the ForceRejoinReq (context, session, keys)")
+	applicationsRekeyCampaignsCreateCommand.Flags().Uint32("rejoin-max-retries", 3, "maximum number of retries of the ForceRejoinReq")
+	applicationsRekeyCampaignsCreateCommand.Flags().Uint32("app-command-f-port", 1, "FPort of the application command for LoRaWAN 1.0.x end devices")
+	applicationsRekeyCampaignsCreateCommand.Flags().String("app-command-frm-payload", "", "hex encoded payload of the application command for LoRaWAN 1.0.x end devices")
+	applicationsRekeyCampaignsCommand.AddCommand(applicationsRekeyCampaignsCreateCommand)
+	applicationsRekeyCampaignsGetCommand.Flags().AddFlagSet(rekeyCampaignIDFlags())
+	applicationsRekeyCampaignsCommand.AddCommand(applicationsRekeyCampaignsGetCommand)
+	applicationsRekeyCampaignsListCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsRekeyCampaignsCommand.AddCommand(applicationsRekeyCampaignsListCommand)
+	applicationsRekeyCampaignsCancelCommand.Flags().AddFlagSet(rekeyCampaignIDFlags())
+	applicationsRekeyCampaignsCommand.AddCommand(applicationsRekeyCampaignsCancelCommand)
+	applicationsRekeyCampaignsDeleteCommand.Flags().AddFlagSet(rekeyCampaignIDFlags())
+	applicationsRekeyCampaignsCommand.AddCommand(applicationsRekeyCampaignsDeleteCommand)
+	applicationsCommand.AddCommand(applicationsRekeyCampaignsCommand)
+}
@@ -0,0 +1,187 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var errInvalidSecurityAnomalyType = errors.DefineInvalidArgument(
+	"invalid_security_anomaly_type", "invalid security anomaly type `{type}`",
+)
+
+// securityMonitorSettingsFlags maps the flags of the security monitor settings to their field paths.
+var securityMonitorSettingsFlags = map[string]string{
+	"dev-nonce-replay-threshold": "dev_nonce_replay_threshold",
+	"dev-nonce-replay-window":    "dev_nonce_replay_window",
+	"join-flood-threshold":       "join_flood_threshold",
+	"join-flood-window":          "join_flood_window",
+	"mic-failure-threshold":      "mic_failure_threshold",
+	"mic-failure-window":         "mic_failure_window",
+	"max-travel-speed":           "max_travel_speed",
+	"disabled-types":             "disabled_types",
+	"notify":                     "notify",
+}
+
+// newSecurityMonitorSettings returns the security monitor settings and the field paths based on the changed flags.
+func newSecurityMonitorSettings(
+	flagSet *pflag.FlagSet, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.SecurityMonitorSettings, []string, error) {
+	settings := &ttnpb.SecurityMonitorSettings{ApplicationIds: ids}
+	settings.DevNonceReplayThreshold, _ = flagSet.GetUint32("dev-nonce-replay-threshold")
+	settings.JoinFloodThreshold, _ = flagSet.GetUint32("join-flood-threshold")
+	settings.MicFailureThreshold, _ = flagSet.GetUint32("mic-failure-threshold")
+	settings.MaxTravelSpeed, _ = flagSet.GetFloat32("max-travel-speed")
+	settings.Notify, _ = flagSet.GetBool("notify")
+	if flagSet.Changed("dev-nonce-replay-window") {
+		d, _ := flagSet.GetDuration("dev-nonce-replay-window")
+		settings.DevNonceReplayWindow = durationpb.New(d)
+	}
+	if flagSet.Changed("join-flood-window") {
+		d, _ := flagSet.GetDuration("join-flood-window")
+		settings.JoinFloodWindow = durationpb.New(d)
+	}
+	if flagSet.Changed("mic-failure-window") {
+		d, _ := flagSet.GetDuration("mic-failure-window")
+		settings.MicFailureWindow = durationpb.New(d)
+	}
+	disabledTypes, _ := flagSet.GetStringSlice("disabled-types")
+	for _, s := range disabledTypes {
+		v, ok := ttnpb.SecurityAnomalyType_value[strings.ToUpper(s)]
+		if !ok {
+			v, ok = ttnpb.SecurityAnomalyType_value["SECURITY_ANOMALY_"+strings.ToUpper(s)]
+		}
+		if !ok {
+			return nil, nil, errInvalidSecurityAnomalyType.WithAttributes("type", s)
+		}
+		settings.DisabledTypes = append(settings.DisabledTypes, ttnpb.SecurityAnomalyType(v))
+	}
+	var paths []string
+	for name, path := range securityMonitorSettingsFlags {
+		if flagSet.Changed(name) {
+			paths = append(paths, path)
+		}
+	}
+	return settings, paths, nil
+}
+
+var (
+	applicationsSecurityMonitorCommand = &cobra.Command{
+		Use:     "security-monitor",
+		Aliases: []string{"security"},
+		Short:   "Application security monitor commands (NS only)",
+	}
+	applicationsSecurityMonitorGetCommand = &cobra.Command{
+		Use:     "get [application-id]",
+		Aliases: []string{"info"},
+		Short:   "Get the security monitor settings of an application",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsSecurityMonitorClient(ns).GetSecurityMonitorSettings(ctx, appID)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsSecurityMonitorSetCommand = &cobra.Command{
+		Use:     "set [application-id] [flags]",
+		Aliases: []string{"update"},
+		Short:   "Set the security monitor settings of an application",
+		Long: `Set the security monitor settings of an application
+
+Only the specified settings are updated. Zero thresholds and windows
+use the defaults of the Network Server.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			settings, paths, err := newSecurityMonitorSettings(cmd.Flags(), appID)
+			if err != nil {
+				return err
+			}
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, won't update anything")
+				return nil
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsSecurityMonitorClient(ns).SetSecurityMonitorSettings(ctx, &ttnpb.SetSecurityMonitorSettingsRequest{
+				Settings:  settings,
+				FieldMask: &fieldmaskpb.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsSecurityMonitorDeleteCommand = &cobra.Command{
+		Use:     "delete [application-id]",
+		Aliases: []string{"del", "remove", "rm", "reset"},
+		Short:   "Delete the security monitor settings of an application",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNsSecurityMonitorClient(ns).DeleteSecurityMonitorSettings(ctx, appID)
+			return err
+		},
+	}
+)
+
+func init() {
+	applicationsSecurityMonitorGetCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsSecurityMonitorCommand.AddCommand(applicationsSecurityMonitorGetCommand)
+	applicationsSecurityMonitorSetCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsSecurityMonitorSetCommand.Flags().Uint32("dev-nonce-replay-threshold", 0, "number of DevNonce replays of an end device within the window that is reported")
+	applicationsSecurityMonitorSetCommand.Flags().Duration("dev-nonce-replay-window", 0, "window in which DevNonce replays are counted")
+	applicationsSecurityMonitorSetCommand.Flags().Uint32("join-flood-threshold", 0, "number of join-requests of an end device within the window that is reported")
+	applicationsSecurityMonitorSetCommand.Flags().Duration("join-flood-window", 0, "window in which join-requests are counted")
+	applicationsSecurityMonitorSetCommand.Flags().Uint32("mic-failure-threshold", 0, "number of MIC failures of an end device within the window that is reported")
+	applicationsSecurityMonitorSetCommand.Flags().Duration("mic-failure-window", 0, "window in which MIC failures are counted")
+	applicationsSecurityMonitorSetCommand.Flags().Float32("max-travel-speed", 0, "maximum travel speed of an end device in km/h")
+	applicationsSecurityMonitorSetCommand.Flags().StringSlice("disabled-types", nil, "anomaly types that are not reported (dev_nonce_replay, join_flood, mic_failures, impossible_travel, f_cnt_reset)")
+	applicationsSecurityMonitorSetCommand.Flags().Bool("notify", false, "notify the collaborators and technical contact of anomalies")
+	applicationsSecurityMonitorCommand.AddCommand(applicationsSecurityMonitorSetCommand)
+	applicationsSecurityMonitorDeleteCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsSecurityMonitorCommand.AddCommand(applicationsSecurityMonitorDeleteCommand)
+	applicationsCommand.AddCommand(applicationsSecurityMonitorCommand)
+}
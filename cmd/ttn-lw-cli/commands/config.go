@@ -42,31 +42,32 @@ var (
 
 // Config for the ttn-lw-cli binary.
 type Config struct {
-	conf.Base                          `name:",squash"`
-	CredentialsID                      string        `name:"credentials-id" yaml:"credentials-id" description:"Credentials ID (if using multiple configurations)"`                                 //nolint:lll
-	InputFormat                        string        `name:"input-format" yaml:"input-format" description:"Input format"`                                                                          //nolint:lll
-	OutputFormat                       string        `name:"output-format" yaml:"output-format" description:"Output format"`                                                                       //nolint:lll
-	AllowUnknownHosts                  bool          `name:"allow-unknown-hosts" yaml:"allow-unknown-hosts" description:"Allow sending credentials to unknown hosts"`                              //nolint:lll
-	OAuthServerAddress                 string        `name:"oauth-server-address" yaml:"oauth-server-address" description:"OAuth Server address"`                                                  //nolint:lll
-	IdentityServerGRPCAddress          string        `name:"identity-server-grpc-address" yaml:"identity-server-grpc-address" description:"Identity Server address"`                               //nolint:lll
-	GatewayServerEnabled               bool          `name:"gateway-server-enabled" yaml:"gateway-server-enabled" description:"Gateway Server enabled"`                                            //nolint:lll
-	GatewayServerGRPCAddress           string        `name:"gateway-server-grpc-address" yaml:"gateway-server-grpc-address" description:"Gateway Server address"`                                  //nolint:lll
-	NetworkServerEnabled               bool          `name:"network-server-enabled" yaml:"network-server-enabled" description:"Network Server enabled"`                                            //nolint:lll
-	NetworkServerGRPCAddress           string        `name:"network-server-grpc-address" yaml:"network-server-grpc-address" description:"Network Server address"`                                  //nolint:lll
-	ApplicationServerEnabled           bool          `name:"application-server-enabled" yaml:"application-server-enabled" description:"Application Server enabled"`                                //nolint:lll
-	ApplicationServerGRPCAddress       string        `name:"application-server-grpc-address" yaml:"application-server-grpc-address" description:"Application Server address"`                      //nolint:lll
-	JoinServerEnabled                  bool          `name:"join-server-enabled" yaml:"join-server-enabled" description:"Join Server enabled"`                                                     //nolint:lll
-	JoinServerGRPCAddress              string        `name:"join-server-grpc-address" yaml:"join-server-grpc-address" description:"Join Server address"`                                           //nolint:lll
-	DeviceTemplateConverterGRPCAddress string        `name:"device-template-converter-grpc-address" yaml:"device-template-converter-grpc-address" description:"Device Template Converter address"` //nolint:lll
-	DeviceClaimingServerGRPCAddress    string        `name:"device-claiming-server-grpc-address" yaml:"device-claiming-server-grpc-address" description:"Device Claiming Server address"`          //nolint:lll
-	QRCodeGeneratorGRPCAddress         string        `name:"qr-code-generator-grpc-address" yaml:"qr-code-generator-grpc-address" description:"QR Code Generator address"`                         //nolint:lll
-	PacketBrokerAgentGRPCAddress       string        `name:"packet-broker-agent-grpc-address" yaml:"packet-broker-agent-grpc-address" description:"Packet Broker Agent address"`                   //nolint:lll
-	Insecure                           bool          `name:"insecure" yaml:"insecure" description:"Connect without TLS"`                                                                           //nolint:lll
-	CA                                 string        `name:"ca" yaml:"ca" description:"CA certificate file"`
-	DumpRequests                       bool          `name:"dump-requests" yaml:"dump-requests" description:"When log level is set to debug, also dump request payload as JSON"` //nolint:lll
-	SkipVersionCheck                   bool          `name:"skip-version-check" yaml:"skip-version-check" description:"Do not perform version checks"`                           //nolint:lll
-	Retry                              RetryConfig   `name:"retry" yaml:"retry"`
-	Telemetry                          telemetry.CLI `name:"telemetry" yaml:"telemetry" description:"Telemetry configuration"` //nolint:lll
+	conf.Base                             `name:",squash"`
+	CredentialsID                         string        `name:"credentials-id" yaml:"credentials-id" description:"Credentials ID (if using multiple configurations)"`                                          //nolint:lll
+	InputFormat                           string        `name:"input-format" yaml:"input-format" description:"Input format"`                                                                                   //nolint:lll
+	OutputFormat                          string        `name:"output-format" yaml:"output-format" description:"Output format"`                                                                                //nolint:lll
+	AllowUnknownHosts                     bool          `name:"allow-unknown-hosts" yaml:"allow-unknown-hosts" description:"Allow sending credentials to unknown hosts"`                                       //nolint:lll
+	OAuthServerAddress                    string        `name:"oauth-server-address" yaml:"oauth-server-address" description:"OAuth Server address"`                                                           //nolint:lll
+	IdentityServerGRPCAddress             string        `name:"identity-server-grpc-address" yaml:"identity-server-grpc-address" description:"Identity Server address"`                                        //nolint:lll
+	GatewayServerEnabled                  bool          `name:"gateway-server-enabled" yaml:"gateway-server-enabled" description:"Gateway Server enabled"`                                                     //nolint:lll
+	GatewayServerGRPCAddress              string        `name:"gateway-server-grpc-address" yaml:"gateway-server-grpc-address" description:"Gateway Server address"`                                           //nolint:lll
+	NetworkServerEnabled                  bool          `name:"network-server-enabled" yaml:"network-server-enabled" description:"Network Server enabled"`                                                     //nolint:lll
+	NetworkServerGRPCAddress              string        `name:"network-server-grpc-address" yaml:"network-server-grpc-address" description:"Network Server address"`                                           //nolint:lll
+	ApplicationServerEnabled              bool          `name:"application-server-enabled" yaml:"application-server-enabled" description:"Application Server enabled"`                                         //nolint:lll
+	ApplicationServerGRPCAddress          string        `name:"application-server-grpc-address" yaml:"application-server-grpc-address" description:"Application Server address"`                               //nolint:lll
+	JoinServerEnabled                     bool          `name:"join-server-enabled" yaml:"join-server-enabled" description:"Join Server enabled"`                                                              //nolint:lll
+	JoinServerGRPCAddress                 string        `name:"join-server-grpc-address" yaml:"join-server-grpc-address" description:"Join Server address"`                                                    //nolint:lll
+	DeviceTemplateConverterGRPCAddress    string        `name:"device-template-converter-grpc-address" yaml:"device-template-converter-grpc-address" description:"Device Template Converter address"`          //nolint:lll
+	DeviceClaimingServerGRPCAddress       string        `name:"device-claiming-server-grpc-address" yaml:"device-claiming-server-grpc-address" description:"Device Claiming Server address"`                   //nolint:lll
+	QRCodeGeneratorGRPCAddress            string        `name:"qr-code-generator-grpc-address" yaml:"qr-code-generator-grpc-address" description:"QR Code Generator address"`                                  //nolint:lll
+	PacketBrokerAgentGRPCAddress          string        `name:"packet-broker-agent-grpc-address" yaml:"packet-broker-agent-grpc-address" description:"Packet Broker Agent address"`                            //nolint:lll
+	GatewayConfigurationServerGRPCAddress string        `name:"gateway-configuration-server-grpc-address" yaml:"gateway-configuration-server-grpc-address" description:"Gateway Configuration Server address"` //nolint:lll
+	Insecure                              bool          `name:"insecure" yaml:"insecure" description:"Connect without TLS"`                                                                                    //nolint:lll
+	CA                                    string        `name:"ca" yaml:"ca" description:"CA certificate file"`
+	DumpRequests                          bool          `name:"dump-requests" yaml:"dump-requests" description:"When log level is set to debug, also dump request payload as JSON"` //nolint:lll
+	SkipVersionCheck                      bool          `name:"skip-version-check" yaml:"skip-version-check" description:"Do not perform version checks"`                           //nolint:lll
+	Retry                                 RetryConfig   `name:"retry" yaml:"retry"`
+	Telemetry                             telemetry.CLI `name:"telemetry" yaml:"telemetry" description:"Telemetry configuration"` //nolint:lll
 }
 
 // RetryConfig defines the values for the retry behavior in the CLI.
@@ -97,6 +98,7 @@ func (c Config) getHosts() []string {
 	hosts = append(hosts, c.DeviceClaimingServerGRPCAddress)
 	hosts = append(hosts, c.QRCodeGeneratorGRPCAddress)
 	hosts = append(hosts, c.PacketBrokerAgentGRPCAddress)
+	hosts = append(hosts, c.GatewayConfigurationServerGRPCAddress)
 	return getHosts(hosts...)
 }
 
@@ -109,25 +111,26 @@ func MakeDefaultConfig(clusterGRPCAddress string, oauthServerAddress string, ins
 				Level:  log.InfoLevel,
 			},
 		},
-		InputFormat:                        "json",
-		OutputFormat:                       "json",
-		OAuthServerAddress:                 oauthServerAddress,
-		IdentityServerGRPCAddress:          clusterGRPCAddress,
-		GatewayServerEnabled:               true,
-		GatewayServerGRPCAddress:           clusterGRPCAddress,
-		NetworkServerEnabled:               true,
-		NetworkServerGRPCAddress:           clusterGRPCAddress,
-		ApplicationServerEnabled:           true,
-		ApplicationServerGRPCAddress:       clusterGRPCAddress,
-		JoinServerEnabled:                  true,
-		JoinServerGRPCAddress:              clusterGRPCAddress,
-		DeviceTemplateConverterGRPCAddress: clusterGRPCAddress,
-		DeviceClaimingServerGRPCAddress:    clusterGRPCAddress,
-		QRCodeGeneratorGRPCAddress:         clusterGRPCAddress,
-		PacketBrokerAgentGRPCAddress:       clusterGRPCAddress,
-		Insecure:                           insecure,
-		Retry:                              defaultRetryConfig,
-		Telemetry:                          defaultTelemetryConfig,
+		InputFormat:                           "json",
+		OutputFormat:                          "json",
+		OAuthServerAddress:                    oauthServerAddress,
+		IdentityServerGRPCAddress:             clusterGRPCAddress,
+		GatewayServerEnabled:                  true,
+		GatewayServerGRPCAddress:              clusterGRPCAddress,
+		NetworkServerEnabled:                  true,
+		NetworkServerGRPCAddress:              clusterGRPCAddress,
+		ApplicationServerEnabled:              true,
+		ApplicationServerGRPCAddress:          clusterGRPCAddress,
+		JoinServerEnabled:                     true,
+		JoinServerGRPCAddress:                 clusterGRPCAddress,
+		DeviceTemplateConverterGRPCAddress:    clusterGRPCAddress,
+		DeviceClaimingServerGRPCAddress:       clusterGRPCAddress,
+		QRCodeGeneratorGRPCAddress:            clusterGRPCAddress,
+		PacketBrokerAgentGRPCAddress:          clusterGRPCAddress,
+		GatewayConfigurationServerGRPCAddress: clusterGRPCAddress,
+		Insecure:                              insecure,
+		Retry:                                 defaultRetryConfig,
+		Telemetry:                             defaultTelemetryConfig,
 	}
 }
 
@@ -0,0 +1,94 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidLinkStatsGranularity = errors.DefineInvalidArgument(
+		"invalid_link_stats_granularity", "invalid granularity `{granularity}`, must be `hour` or `day`",
+	)
+	errLinkStatsSinceAndLast = errors.DefineInvalidArgument(
+		"link_stats_since_and_last", "`--since` cannot be used with `--last`",
+	)
+)
+
+var endDevicesLinkStatsCommand = &cobra.Command{
+	Use:   "link-stats [application-id] [device-id]",
+	Short: "Get the link statistics of an end device (NS only)",
+	Long: `Get the link statistics of an end device (NS only)
+
+The Network Server aggregates the packet error rate, frame counter gaps,
+SNR, RSSI and gateway diversity of the uplink messages of the end device
+per hour and per day.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		devID, err := getEndDeviceID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		req := &ttnpb.GetEndDeviceLinkStatsRequest{
+			EndDeviceIds: devID,
+		}
+		granularity, _ := cmd.Flags().GetString("granularity")
+		v, ok := ttnpb.LinkStatsGranularity_value["LINK_STATS_GRANULARITY_"+strings.ToUpper(granularity)]
+		if !ok {
+			return errInvalidLinkStatsGranularity.WithAttributes("granularity", granularity)
+		}
+		req.Granularity = ttnpb.LinkStatsGranularity(v)
+		if cmd.Flags().Changed("last") && hasTimestampFlags(cmd.Flags(), "since") {
+			return errLinkStatsSinceAndLast.New()
+		}
+		since, err := getTimestampFlags(cmd.Flags(), "since")
+		if err != nil {
+			return err
+		}
+		if since != nil {
+			req.Since = timestamppb.New(*since)
+		}
+		if cmd.Flags().Changed("last") {
+			last, _ := cmd.Flags().GetDuration("last")
+			req.Since = timestamppb.New(time.Now().Add(-last))
+		}
+
+		ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		res, err := ttnpb.NewNsEndDeviceLinkStatsClient(ns).GetLinkStats(ctx, req)
+		if err != nil {
+			return err
+		}
+		return io.Write(os.Stdout, config.OutputFormat, res)
+	},
+}
+
+func init() {
+	endDevicesLinkStatsCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesLinkStatsCommand.Flags().String("granularity", "hour", "time span of the buckets (hour, day)")
+	endDevicesLinkStatsCommand.Flags().AddFlagSet(timestampFlags("since", "only return buckets since the specified timestamp"))
+	endDevicesLinkStatsCommand.Flags().Duration("last", 0, "only return buckets of the specified duration")
+	endDevicesCommand.AddCommand(endDevicesLinkStatsCommand)
+}
@@ -0,0 +1,187 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errNoMACCommands = errors.DefineInvalidArgument(
+		"no_mac_commands", "no MAC commands specified",
+	)
+	errInvalidChannelMaskIndex = errors.DefineInvalidArgument(
+		"invalid_channel_mask_index", "invalid channel mask index `{index}`",
+	)
+)
+
+func macCommandsFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("dev-status", false, "enqueue DevStatusReq")
+	flagSet.Bool("link-check", false, "enqueue LinkCheckAns")
+	flagSet.Uint32("link-check.margin", 0, "link margin (dB) of the LinkCheckAns")
+	flagSet.Uint32("link-check.gateway-count", 1, "gateway count of the LinkCheckAns")
+	flagSet.Bool("device-time", false, "enqueue DeviceTimeAns with the current time")
+	flagSet.Bool("link-adr", false, "enqueue LinkADRReq")
+	flagSet.Uint32("link-adr.data-rate-index", 0, "data rate index of the LinkADRReq")
+	flagSet.Uint32("link-adr.tx-power-index", 0, "TX power index of the LinkADRReq")
+	flagSet.IntSlice("link-adr.channel-mask", nil, "indices of the channels enabled in the channel mask of the LinkADRReq")
+	flagSet.Uint32("link-adr.channel-mask-control", 0, "channel mask control of the LinkADRReq")
+	flagSet.Uint32("link-adr.nb-trans", 1, "number of transmissions of the LinkADRReq")
+	flagSet.Bool("new-channel", false, "enqueue NewChannelReq")
+	flagSet.Uint32("new-channel.channel-index", 0, "channel index of the NewChannelReq")
+	flagSet.Uint64("new-channel.frequency", 0, "frequency (Hz) of the NewChannelReq (0 to disable the channel)")
+	flagSet.Uint32("new-channel.min-data-rate-index", 0, "minimum data rate index of the NewChannelReq")
+	flagSet.Uint32("new-channel.max-data-rate-index", 0, "maximum data rate index of the NewChannelReq")
+	return flagSet
+}
+
+func getMACCommands(flagSet *pflag.FlagSet) ([]*ttnpb.MACCommand, error) {
+	var cmds []*ttnpb.MACCommand
+	if devStatus, _ := flagSet.GetBool("dev-status"); devStatus {
+		cmds = append(cmds, ttnpb.MACCommandIdentifier_CID_DEV_STATUS.MACCommand())
+	}
+	if linkCheck, _ := flagSet.GetBool("link-check"); linkCheck {
+		margin, _ := flagSet.GetUint32("link-check.margin")
+		gatewayCount, _ := flagSet.GetUint32("link-check.gateway-count")
+		cmds = append(cmds, (&ttnpb.MACCommand_LinkCheckAns{
+			Margin:       margin,
+			GatewayCount: gatewayCount,
+		}).MACCommand())
+	}
+	if deviceTime, _ := flagSet.GetBool("device-time"); deviceTime {
+		cmds = append(cmds, (&ttnpb.MACCommand_DeviceTimeAns{
+			Time: timestamppb.Now(),
+		}).MACCommand())
+	}
+	if linkADR, _ := flagSet.GetBool("link-adr"); linkADR {
+		dataRateIndex, _ := flagSet.GetUint32("link-adr.data-rate-index")
+		txPowerIndex, _ := flagSet.GetUint32("link-adr.tx-power-index")
+		channels, _ := flagSet.GetIntSlice("link-adr.channel-mask")
+		channelMaskControl, _ := flagSet.GetUint32("link-adr.channel-mask-control")
+		nbTrans, _ := flagSet.GetUint32("link-adr.nb-trans")
+		channelMask := make([]bool, 16)
+		for _, i := range channels {
+			if i < 0 || i >= len(channelMask) {
+				return nil, errInvalidChannelMaskIndex.WithAttributes("index", i)
+			}
+			channelMask[i] = true
+		}
+		cmds = append(cmds, (&ttnpb.MACCommand_LinkADRReq{
+			DataRateIndex:      ttnpb.DataRateIndex(dataRateIndex),
+			TxPowerIndex:       txPowerIndex,
+			ChannelMask:        channelMask,
+			ChannelMaskControl: channelMaskControl,
+			NbTrans:            nbTrans,
+		}).MACCommand())
+	}
+	if newChannel, _ := flagSet.GetBool("new-channel"); newChannel {
+		channelIndex, _ := flagSet.GetUint32("new-channel.channel-index")
+		frequency, _ := flagSet.GetUint64("new-channel.frequency")
+		minDataRateIndex, _ := flagSet.GetUint32("new-channel.min-data-rate-index")
+		maxDataRateIndex, _ := flagSet.GetUint32("new-channel.max-data-rate-index")
+		cmds = append(cmds, (&ttnpb.MACCommand_NewChannelReq{
+			ChannelIndex:     channelIndex,
+			Frequency:        frequency,
+			MinDataRateIndex: ttnpb.DataRateIndex(minDataRateIndex),
+			MaxDataRateIndex: ttnpb.DataRateIndex(maxDataRateIndex),
+		}).MACCommand())
+	}
+	return cmds, nil
+}
+
+var (
+	endDevicesMACCommandsCommand = &cobra.Command{
+		Use:     "mac-commands",
+		Aliases: []string{"mac"},
+		Short:   "Operator MAC commands of end devices (NS only)",
+	}
+	endDevicesMACCommandsEnqueueCommand = &cobra.Command{
+		Use:   "enqueue [application-id] [device-id]",
+		Short: "Enqueue MAC commands for an end device",
+		Long: `Enqueue MAC commands for an end device
+
+The MAC commands are validated against the band and LoRaWAN version of the
+end device, and are sent in the next class A downlink messages. MAC requests
+that are not answered are sent again.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			cmds, err := getMACCommands(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			replace, _ := cmd.Flags().GetBool("replace")
+			if len(cmds) == 0 && !replace {
+				return errNoMACCommands.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns).EnqueueMACCommands(ctx, &ttnpb.EnqueueMACCommandsRequest{
+				EndDeviceIds: devID,
+				Commands:     cmds,
+				Replace:      replace,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMACCommandsClearCommand = &cobra.Command{
+		Use:   "clear [application-id] [device-id]",
+		Short: "Clear the queued MAC commands of an end device",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns).EnqueueMACCommands(ctx, &ttnpb.EnqueueMACCommandsRequest{
+				EndDeviceIds: devID,
+				Replace:      true,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	endDevicesMACCommandsEnqueueCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMACCommandsEnqueueCommand.Flags().AddFlagSet(macCommandsFlags())
+	endDevicesMACCommandsEnqueueCommand.Flags().Bool("replace", false, "replace the queued MAC commands")
+	endDevicesMACCommandsCommand.AddCommand(endDevicesMACCommandsEnqueueCommand)
+	endDevicesMACCommandsClearCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMACCommandsCommand.AddCommand(endDevicesMACCommandsClearCommand)
+	endDevicesCommand.AddCommand(endDevicesMACCommandsCommand)
+}
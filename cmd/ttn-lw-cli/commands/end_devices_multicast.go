@@ -0,0 +1,449 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errInvalidMulticastClass = errors.DefineInvalidArgument(
+		"invalid_multicast_class", "invalid multicast class `{class}`, must be `B` or `C`",
+	)
+	errInvalidPingSlotPeriodicity = errors.DefineInvalidArgument(
+		"invalid_ping_slot_periodicity", "invalid ping slot periodicity `{value}`",
+	)
+	errNoMulticastMembers = errors.DefineInvalidArgument(
+		"no_multicast_members", "no members specified",
+	)
+)
+
+func multicastMembersFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("members", nil, "device IDs of the member end devices")
+	return flagSet
+}
+
+func multicastGatewaysFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("gateways", nil, "IDs of the gateways used for downlink to the group")
+	return flagSet
+}
+
+func getMulticastGateways(flagSet *pflag.FlagSet) []*ttnpb.ClassBCGatewayIdentifiers {
+	gatewayIDs, _ := flagSet.GetStringSlice("gateways")
+	gtws := make([]*ttnpb.ClassBCGatewayIdentifiers, 0, len(gatewayIDs))
+	for _, gatewayID := range gatewayIDs {
+		gtws = append(gtws, &ttnpb.ClassBCGatewayIdentifiers{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: gatewayID},
+		})
+	}
+	return gtws
+}
+
+func getMulticastAES128Key(flagSet *pflag.FlagSet, name string) (*ttnpb.KeyEnvelope, error) {
+	s, _ := flagSet.GetString(name)
+	if s == "" {
+		return nil, nil
+	}
+	var key types.AES128Key
+	if err := key.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return &ttnpb.KeyEnvelope{Key: key.Bytes()}, nil
+}
+
+// newMulticastEndDevice returns the multicast end device and the paths to set in the Identity Server, based on the
+// flags. The Network Server assigns the multicast address and session keys that are not specified.
+func newMulticastEndDevice(
+	flagSet *pflag.FlagSet, devID *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDevice, []string, error) {
+	device := &ttnpb.EndDevice{
+		Ids:       devID,
+		Multicast: true,
+		Session: &ttnpb.Session{
+			Keys: &ttnpb.SessionKeys{},
+		},
+	}
+	paths := []string{"multicast", "supports_join"}
+
+	if config.NetworkServerEnabled {
+		device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+		paths = append(paths, "network_server_address")
+	}
+	if config.ApplicationServerEnabled {
+		device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+		paths = append(paths, "application_server_address")
+	}
+
+	device.FrequencyPlanId, _ = flagSet.GetString("frequency-plan-id")
+	paths = append(paths, "frequency_plan_id")
+	if s, _ := flagSet.GetString("lorawan-version"); s != "" {
+		if err := device.LorawanVersion.UnmarshalText([]byte(s)); err != nil {
+			return nil, nil, errInvalidMACVersion.WithCause(err)
+		}
+		if err := device.LorawanVersion.Validate(); err != nil {
+			return nil, nil, errInvalidMACVersion.WithCause(err)
+		}
+	}
+	paths = append(paths, "lorawan_version")
+	if s, _ := flagSet.GetString("lorawan-phy-version"); s != "" {
+		if err := device.LorawanPhyVersion.UnmarshalText([]byte(s)); err != nil {
+			return nil, nil, err
+		}
+	}
+	paths = append(paths, "lorawan_phy_version")
+
+	class, _ := flagSet.GetString("class")
+	switch strings.ToUpper(class) {
+	case "B":
+		device.SupportsClassB = true
+		paths = append(paths, "supports_class_b")
+		if s, _ := flagSet.GetString("ping-slot-periodicity"); s != "" {
+			v, ok := ttnpb.PingSlotPeriod_value[strings.ToUpper(s)]
+			if !ok {
+				return nil, nil, errInvalidPingSlotPeriodicity.WithAttributes("value", s)
+			}
+			device.MacSettings = &ttnpb.MACSettings{
+				PingSlotPeriodicity: &ttnpb.PingSlotPeriodValue{Value: ttnpb.PingSlotPeriod(v)},
+			}
+		}
+	case "C":
+		device.SupportsClassC = true
+		paths = append(paths, "supports_class_c")
+	default:
+		return nil, nil, errInvalidMulticastClass.WithAttributes("class", class)
+	}
+
+	if s, _ := flagSet.GetString("mc-addr"); s != "" {
+		var devAddr types.DevAddr
+		if err := devAddr.UnmarshalText([]byte(s)); err != nil {
+			return nil, nil, err
+		}
+		device.Session.DevAddr = devAddr.Bytes()
+	}
+	var err error
+	if device.Session.Keys.AppSKey, err = getMulticastAES128Key(flagSet, "mc-app-s-key"); err != nil {
+		return nil, nil, err
+	}
+	if device.Session.Keys.FNwkSIntKey, err = getMulticastAES128Key(flagSet, "mc-nwk-s-key"); err != nil {
+		return nil, nil, err
+	}
+	isPaths, _, _, _ := splitEndDeviceSetPaths(false, paths...)
+	return device, isPaths, nil
+}
+
+var (
+	endDevicesMulticastCommand = &cobra.Command{
+		Use:     "multicast",
+		Aliases: []string{"mc"},
+		Short:   "Multicast group commands",
+	}
+	endDevicesMulticastCreateCommand = &cobra.Command{
+		Use:     "create [application-id] [device-id]",
+		Aliases: []string{"add", "register"},
+		Short:   "Create a multicast group",
+		Long: `Create a multicast group
+
+The multicast end device that represents the group is registered in the
+Identity Server, Network Server and Application Server. The Network Server
+generates the multicast address and session keys, unless they are specified.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			device, isPaths, err := newMulticastEndDevice(cmd.Flags(), devID)
+			if err != nil {
+				return err
+			}
+			members, _ := cmd.Flags().GetStringSlice("members")
+			gtws := getMulticastGateways(cmd.Flags())
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			isDevice := &ttnpb.EndDevice{}
+			logger.WithField("paths", isPaths).Debug("Create end device on Identity Server")
+			if err := isDevice.SetFields(device, append(isPaths, "ids")...); err != nil {
+				return err
+			}
+			if _, err := ttnpb.NewEndDeviceRegistryClient(is).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+				EndDevice: isDevice,
+			}); err != nil {
+				return err
+			}
+
+			rollback := func() {
+				logger.Error("Could not create multicast group, rolling back...")
+				if err := deleteEndDevice(context.Background(), device.Ids, true); err != nil {
+					logger.WithError(err).Error("Could not roll back multicast group creation")
+				}
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				rollback()
+				return err
+			}
+			// The Network Server registers the multicast end device in the Network Server and Application Server.
+			client := ttnpb.NewNsMulticastGroupRegistryClient(ns)
+			group, err := client.CreateMulticastGroup(ctx, &ttnpb.CreateMulticastGroupRequest{
+				Group: &ttnpb.MulticastGroup{
+					Ids:             device.Ids,
+					MemberDeviceIds: members,
+					Gateways:        gtws,
+				},
+				EndDevice: device,
+			})
+			if err != nil {
+				rollback()
+				return err
+			}
+
+			if computeGateways, _ := cmd.Flags().GetBool("compute-gateways"); computeGateways {
+				res, err := client.ComputeMulticastGroupGateways(ctx, &ttnpb.ComputeMulticastGroupGatewaysRequest{
+					EndDeviceIds: device.Ids,
+					Apply:        true,
+				})
+				if err != nil {
+					return err
+				}
+				if len(res.UncoveredDeviceIds) > 0 {
+					logger.WithField("device_ids", res.UncoveredDeviceIds).Warn("Members not covered by any gateway")
+				}
+				group.Gateways = res.Gateways
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, group)
+		},
+	}
+	endDevicesMulticastGetCommand = &cobra.Command{
+		Use:     "get [application-id] [device-id]",
+		Aliases: []string{"info"},
+		Short:   "Get a multicast group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).GetMulticastGroup(ctx, &ttnpb.GetMulticastGroupRequest{
+				EndDeviceIds: devID,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastListCommand = &cobra.Command{
+		Use:     "list [application-id]",
+		Aliases: []string{"ls"},
+		Short:   "List multicast groups",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).ListMulticastGroups(ctx, &ttnpb.ListMulticastGroupsRequest{
+				ApplicationIds: appID,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastAddMembersCommand = &cobra.Command{
+		Use:   "add-members [application-id] [device-id]",
+		Short: "Add members to a multicast group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			members, _ := cmd.Flags().GetStringSlice("members")
+			if len(members) == 0 {
+				return errNoMulticastMembers.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).UpdateMulticastGroupMembers(
+				ctx, &ttnpb.UpdateMulticastGroupMembersRequest{
+					EndDeviceIds: devID,
+					AddDeviceIds: members,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastRemoveMembersCommand = &cobra.Command{
+		Use:   "remove-members [application-id] [device-id]",
+		Short: "Remove members from a multicast group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			members, _ := cmd.Flags().GetStringSlice("members")
+			if len(members) == 0 {
+				return errNoMulticastMembers.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).UpdateMulticastGroupMembers(
+				ctx, &ttnpb.UpdateMulticastGroupMembersRequest{
+					EndDeviceIds:    devID,
+					RemoveDeviceIds: members,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastSetGatewaysCommand = &cobra.Command{
+		Use:   "set-gateways [application-id] [device-id]",
+		Short: "Set the downlink gateways of a multicast group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).SetMulticastGroupGateways(
+				ctx, &ttnpb.SetMulticastGroupGatewaysRequest{
+					EndDeviceIds: devID,
+					Gateways:     getMulticastGateways(cmd.Flags()),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastComputeGatewaysCommand = &cobra.Command{
+		Use:   "compute-gateways [application-id] [device-id]",
+		Short: "Compute downlink gateways that cover the members of a multicast group",
+		Long: `Compute downlink gateways that cover the members of a multicast group
+
+The gateways are selected from the gateways that received recent uplink
+messages of the members. Use --apply to store the gateways in the group.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			apply, _ := cmd.Flags().GetBool("apply")
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).ComputeMulticastGroupGateways(
+				ctx, &ttnpb.ComputeMulticastGroupGatewaysRequest{
+					EndDeviceIds: devID,
+					Apply:        apply,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastDeleteCommand = &cobra.Command{
+		Use:     "delete [application-id] [device-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete a multicast group",
+		Long: `Delete a multicast group
+
+The multicast end device that represents the group is deleted from the
+Identity Server, Network Server and Application Server.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			// Deleting the end device from the Network Server also deletes the multicast group.
+			return deleteEndDevice(ctx, devID, false)
+		},
+	}
+)
+
+func init() {
+	endDevicesMulticastCreateCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastCreateCommand.Flags().String("frequency-plan-id", "", "frequency plan ID of the group")
+	endDevicesMulticastCreateCommand.Flags().String("lorawan-version", "1.0.3", "LoRaWAN version of the group")
+	endDevicesMulticastCreateCommand.Flags().String("lorawan-phy-version", "1.0.3-a", "LoRaWAN PHY version of the group")
+	endDevicesMulticastCreateCommand.Flags().String("class", "C", "device class of the group (B or C)")
+	endDevicesMulticastCreateCommand.Flags().String("ping-slot-periodicity", "", "ping slot periodicity for class B (e.g. PING_EVERY_32S)")
+	endDevicesMulticastCreateCommand.Flags().String("mc-addr", "", "multicast address (generated if empty)")
+	endDevicesMulticastCreateCommand.Flags().String("mc-app-s-key", "", "multicast application session key (generated if empty)")
+	endDevicesMulticastCreateCommand.Flags().String("mc-nwk-s-key", "", "multicast network session key (generated if empty)")
+	endDevicesMulticastCreateCommand.Flags().AddFlagSet(multicastMembersFlags())
+	endDevicesMulticastCreateCommand.Flags().AddFlagSet(multicastGatewaysFlags())
+	endDevicesMulticastCreateCommand.Flags().Bool("compute-gateways", false, "compute the gateways from the recent uplinks of the members")
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastCreateCommand)
+	endDevicesMulticastGetCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastGetCommand)
+	endDevicesMulticastListCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastListCommand)
+	endDevicesMulticastAddMembersCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastAddMembersCommand.Flags().AddFlagSet(multicastMembersFlags())
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastAddMembersCommand)
+	endDevicesMulticastRemoveMembersCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastRemoveMembersCommand.Flags().AddFlagSet(multicastMembersFlags())
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastRemoveMembersCommand)
+	endDevicesMulticastSetGatewaysCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastSetGatewaysCommand.Flags().AddFlagSet(multicastGatewaysFlags())
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastSetGatewaysCommand)
+	endDevicesMulticastComputeGatewaysCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastComputeGatewaysCommand.Flags().Bool("apply", false, "store the computed gateways in the group")
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastComputeGatewaysCommand)
+	endDevicesMulticastDeleteCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastCommand.AddCommand(endDevicesMulticastDeleteCommand)
+	endDevicesCommand.AddCommand(endDevicesMulticastCommand)
+}
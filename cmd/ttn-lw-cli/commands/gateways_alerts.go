@@ -0,0 +1,315 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	errNoAlertRuleEntity           = errors.DefineInvalidArgument("no_alert_rule_entity", "no gateway or organization ID set")
+	errNoAlertRuleID               = errors.DefineInvalidArgument("no_alert_rule_id", "no alert rule ID set")
+	errInvalidAlertCondition       = errors.DefineInvalidArgument("invalid_alert_condition", "invalid alert condition `{condition}`")
+	errInvalidNotificationReceiver = errors.DefineInvalidArgument(
+		"invalid_notification_receiver", "invalid notification receiver `{receiver}`",
+	)
+	errInvalidAlertRuleTarget = errors.DefineInvalidArgument(
+		"invalid_alert_rule_target", "set either a gateway ID or an organization ID",
+	)
+)
+
+// gatewayAlertRuleFlags maps the flags of gateway alert rules to their field paths.
+var gatewayAlertRuleFlags = map[string]string{
+	"condition":              "condition",
+	"threshold":              "threshold",
+	"tx-failure-ratio":       "tx_failure_ratio",
+	"min-tx-acknowledgments": "min_tx_acknowledgments",
+	"receivers":              "receivers",
+	"email":                  "email",
+	"disabled":               "disabled",
+}
+
+func gatewayAlertRuleIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("gateway-id", "", "")
+	flagSet.String("organization-id", "", "")
+	flagSet.String("rule-id", "", "")
+	return flagSet
+}
+
+// getGatewayAlertRuleEntityIDs returns the identifiers of the gateway or organization of the alert rules.
+func getGatewayAlertRuleEntityIDs(flagSet *pflag.FlagSet) (*ttnpb.EntityIdentifiers, error) {
+	gatewayID, _ := flagSet.GetString("gateway-id")
+	organizationID, _ := flagSet.GetString("organization-id")
+	switch {
+	case gatewayID != "" && organizationID != "":
+		return nil, errInvalidAlertRuleTarget.New()
+	case gatewayID != "":
+		return (&ttnpb.GatewayIdentifiers{GatewayId: gatewayID}).GetEntityIdentifiers(), nil
+	case organizationID != "":
+		return (&ttnpb.OrganizationIdentifiers{OrganizationId: organizationID}).GetEntityIdentifiers(), nil
+	default:
+		return nil, errNoAlertRuleEntity.New()
+	}
+}
+
+// getGatewayAlertRuleID returns the identifiers of the alert rule from the flags and arguments.
+func getGatewayAlertRuleID(flagSet *pflag.FlagSet, args []string) (*ttnpb.GatewayAlertRuleIdentifiers, error) {
+	entityIDs, err := getGatewayAlertRuleEntityIDs(flagSet)
+	if err != nil {
+		return nil, err
+	}
+	ruleID, _ := flagSet.GetString("rule-id")
+	if len(args) > 0 {
+		if len(args) > 1 {
+			logger.Warn("Multiple IDs found in arguments, considering only the first")
+		}
+		ruleID = args[0]
+	}
+	if ruleID == "" {
+		return nil, errNoAlertRuleID.New()
+	}
+	return &ttnpb.GatewayAlertRuleIdentifiers{EntityIds: entityIDs, RuleId: ruleID}, nil
+}
+
+// newGatewayAlertRule returns the alert rule and the field paths based on the changed flags.
+func newGatewayAlertRule(
+	flagSet *pflag.FlagSet, ids *ttnpb.GatewayAlertRuleIdentifiers,
+) (*ttnpb.GatewayAlertRule, []string, error) {
+	rule := &ttnpb.GatewayAlertRule{
+		EntityIds: ids.EntityIds,
+		RuleId:    ids.RuleId,
+	}
+	if s, _ := flagSet.GetString("condition"); s != "" {
+		v, ok := ttnpb.GatewayAlertCondition_value[strings.ToUpper(s)]
+		if !ok {
+			v, ok = ttnpb.GatewayAlertCondition_value["GATEWAY_ALERT_"+strings.ToUpper(s)]
+		}
+		if !ok {
+			return nil, nil, errInvalidAlertCondition.WithAttributes("condition", s)
+		}
+		rule.Condition = ttnpb.GatewayAlertCondition(v)
+	}
+	if flagSet.Changed("threshold") {
+		d, _ := flagSet.GetDuration("threshold")
+		rule.Threshold = durationpb.New(d)
+	}
+	rule.TxFailureRatio, _ = flagSet.GetFloat32("tx-failure-ratio")
+	rule.MinTxAcknowledgments, _ = flagSet.GetUint64("min-tx-acknowledgments")
+	rule.Email, _ = flagSet.GetBool("email")
+	rule.Disabled, _ = flagSet.GetBool("disabled")
+	receivers, _ := flagSet.GetStringSlice("receivers")
+	for _, s := range receivers {
+		v, ok := ttnpb.NotificationReceiver_value[strings.ToUpper(s)]
+		if !ok {
+			v, ok = ttnpb.NotificationReceiver_value["NOTIFICATION_RECEIVER_"+strings.ToUpper(s)]
+		}
+		if !ok {
+			return nil, nil, errInvalidNotificationReceiver.WithAttributes("receiver", s)
+		}
+		rule.Receivers = append(rule.Receivers, ttnpb.NotificationReceiver(v))
+	}
+	var paths []string
+	for name, path := range gatewayAlertRuleFlags {
+		if flagSet.Changed(name) {
+			paths = append(paths, path)
+		}
+	}
+	return rule, paths, nil
+}
+
+func gatewayAlertRuleSettingFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("condition", "", "condition of the alert (offline, no_uplinks, tx_failure_ratio, clock_drift)")
+	flagSet.Duration("threshold", 0, "duration that the condition must exceed before raising an alert")
+	flagSet.Float32("tx-failure-ratio", 0, "downlink transmission failure ratio above which an alert is raised")
+	flagSet.Uint64("min-tx-acknowledgments", 0, "minimum number of transmission acknowledgments before evaluating the failure ratio")
+	flagSet.StringSlice("receivers", nil, "receivers of the notifications (collaborator, administrative_contact, technical_contact)")
+	flagSet.Bool("email", false, "send the notifications by email")
+	flagSet.Bool("disabled", false, "disable the evaluation of the rule")
+	return flagSet
+}
+
+var (
+	gatewaysAlertRulesCommand = &cobra.Command{
+		Use:     "alert-rules",
+		Aliases: []string{"alert-rule"},
+		Short:   "Gateway alert rule commands (GS only)",
+	}
+	gatewaysAlertRulesListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the alert rules of a gateway or organization",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			entityIDs, err := getGatewayAlertRuleEntityIDs(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsGatewayAlertsClient(gs).ListGatewayAlertRules(ctx, &ttnpb.ListGatewayAlertRulesRequest{
+				EntityIds: entityIDs,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res.Rules)
+		},
+	}
+	gatewaysAlertRulesGetCommand = &cobra.Command{
+		Use:     "get [rule-id]",
+		Aliases: []string{"info"},
+		Short:   "Get an alert rule of a gateway or organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleIDs, err := getGatewayAlertRuleID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsGatewayAlertsClient(gs).GetGatewayAlertRule(ctx, ruleIDs)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysAlertRulesCreateCommand = &cobra.Command{
+		Use:     "create [rule-id] [flags]",
+		Aliases: []string{"add", "register"},
+		Short:   "Create an alert rule for a gateway or organization",
+		Long: `Create an alert rule for a gateway or organization
+
+Alert rules of an organization apply to the gateways of the organization at
+the time the rule is created or updated.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleIDs, err := getGatewayAlertRuleID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			rule, _, err := newGatewayAlertRule(cmd.Flags(), ruleIDs)
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsGatewayAlertsClient(gs).CreateGatewayAlertRule(ctx, &ttnpb.CreateGatewayAlertRuleRequest{
+				Rule: rule,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysAlertRulesUpdateCommand = &cobra.Command{
+		Use:     "update [rule-id] [flags]",
+		Aliases: []string{"set"},
+		Short:   "Update an alert rule of a gateway or organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleIDs, err := getGatewayAlertRuleID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			rule, paths, err := newGatewayAlertRule(cmd.Flags(), ruleIDs)
+			if err != nil {
+				return err
+			}
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, won't update anything")
+				return nil
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsGatewayAlertsClient(gs).UpdateGatewayAlertRule(ctx, &ttnpb.UpdateGatewayAlertRuleRequest{
+				Rule:      rule,
+				FieldMask: &fieldmaskpb.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysAlertRulesDeleteCommand = &cobra.Command{
+		Use:     "delete [rule-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete an alert rule of a gateway or organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleIDs, err := getGatewayAlertRuleID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGsGatewayAlertsClient(gs).DeleteGatewayAlertRule(ctx, ruleIDs)
+			return err
+		},
+	}
+	gatewaysAlertsCommand = &cobra.Command{
+		Use:     "alerts [gateway-id]",
+		Aliases: []string{"alert"},
+		Short:   "List the active alerts of a gateway (GS only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsGatewayAlertsClient(gs).ListGatewayAlerts(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res.Alerts)
+		},
+	}
+)
+
+func init() {
+	gatewaysAlertRulesCommand.PersistentFlags().AddFlagSet(gatewayAlertRuleIDFlags())
+	gatewaysAlertRulesCommand.AddCommand(gatewaysAlertRulesListCommand)
+	gatewaysAlertRulesCommand.AddCommand(gatewaysAlertRulesGetCommand)
+	gatewaysAlertRulesCreateCommand.Flags().AddFlagSet(gatewayAlertRuleSettingFlags())
+	gatewaysAlertRulesCommand.AddCommand(gatewaysAlertRulesCreateCommand)
+	gatewaysAlertRulesUpdateCommand.Flags().AddFlagSet(gatewayAlertRuleSettingFlags())
+	gatewaysAlertRulesCommand.AddCommand(gatewaysAlertRulesUpdateCommand)
+	gatewaysAlertRulesCommand.AddCommand(gatewaysAlertRulesDeleteCommand)
+	gatewaysCommand.AddCommand(gatewaysAlertRulesCommand)
+	gatewaysAlertsCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysAlertsCommand)
+}
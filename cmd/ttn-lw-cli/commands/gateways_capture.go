@@ -0,0 +1,115 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	stdio "io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/pcap"
+)
+
+func gatewayCaptureFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("output", "-", "file to write the PCAP capture to (- for stdout)")
+	return flagSet
+}
+
+var gatewaysCaptureCommand = &cobra.Command{
+	Use:   "capture [gateway-id]...",
+	Short: "Capture gateway traffic in PCAP format (GS only)",
+	Long: `Capture gateway traffic in PCAP format (GS only)
+
+Uplink messages, downlink messages and transmission acknowledgments of the
+gateways are written as LoRaTap encapsulated frames, which can be opened in
+Wireshark. The capture runs until interrupted.`,
+	Example: `  ttn-lw-cli gateways capture my-gateway --output my-gateway.pcap
+  ttn-lw-cli gateways capture my-gateway other-gateway | wireshark -k -i -`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var gtwIDs []*ttnpb.GatewayIdentifiers
+		if len(args) > 0 {
+			for _, arg := range args {
+				gtwIDs = append(gtwIDs, &ttnpb.GatewayIdentifiers{GatewayId: arg})
+			}
+		} else {
+			gtwID, err := getGatewayID(cmd.Flags(), nil, true)
+			if err != nil {
+				return err
+			}
+			gtwIDs = append(gtwIDs, gtwID)
+		}
+
+		var out stdio.Writer = os.Stdout
+		if output, _ := cmd.Flags().GetString("output"); output != "-" {
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		w, err := pcap.NewWriter(out, pcap.LinkTypeLoRaTap)
+		if err != nil {
+			return err
+		}
+
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := ttnpb.NewGsGatewayCaptureClient(gs).CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+			GatewayIds: gtwIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		var streamErr error
+		go func() {
+			defer cancel()
+			for {
+				pkt, err := stream.Recv()
+				if err != nil {
+					streamErr = err
+					return
+				}
+				if err := w.WritePacket(pkt.Time.AsTime(), pkt.Data); err != nil {
+					streamErr = err
+					return
+				}
+			}
+		}()
+
+		<-ctx.Done()
+
+		if streamErr != nil {
+			return streamErr
+		}
+		return ctx.Err()
+	},
+}
+
+func init() {
+	gatewaysCaptureCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureCommand.Flags().AddFlagSet(gatewayCaptureFlags())
+	gatewaysCommand.AddCommand(gatewaysCaptureCommand)
+}
@@ -0,0 +1,214 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func gatewayFirmwareIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("firmware-id", "", "")
+	return flagSet
+}
+
+var errNoFirmwareID = errors.DefineInvalidArgument("no_firmware_id", "no firmware ID set")
+
+func getGatewayFirmwareID(flagSet *pflag.FlagSet, args []string) (string, error) {
+	firmwareID, _ := flagSet.GetString("firmware-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		firmwareID = args[0]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		firmwareID = args[0]
+	}
+	if firmwareID == "" {
+		return "", errNoFirmwareID.New()
+	}
+	return firmwareID, nil
+}
+
+var (
+	gatewaysFirmwareCommand = &cobra.Command{
+		Use:   "firmware",
+		Short: "Gateway firmware update commands (admin only)",
+		Long: `Gateway firmware update commands (admin only)
+
+The firmware updates are delivered through CUPS to LoRa Basics Station gateways
+that have automatic updates enabled.`,
+	}
+	gatewaysFirmwareCreateCommand = &cobra.Command{
+		Use:     "create [firmware-id]",
+		Aliases: []string{"add", "register"},
+		Short:   "Create a gateway firmware update",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firmware := &ttnpb.GatewayFirmware{}
+			if _, err := firmware.SetFromFlags(cmd.Flags(), ""); err != nil {
+				return err
+			}
+			firmwareID, err := getGatewayFirmwareID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			firmware.FirmwareId = firmwareID
+			data, err := getDataBytes("data", cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).Create(ctx, &ttnpb.CreateGatewayFirmwareRequest{
+				Firmware: firmware,
+				Data:     data,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysFirmwareGetCommand = &cobra.Command{
+		Use:     "get [firmware-id]",
+		Aliases: []string{"info"},
+		Short:   "Get a gateway firmware update",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firmwareID, err := getGatewayFirmwareID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).Get(ctx, &ttnpb.GetGatewayFirmwareRequest{
+				FirmwareId: firmwareID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysFirmwareListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List gateway firmware updates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, _ := cmd.Flags().GetString("model")
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).List(ctx, &ttnpb.ListGatewayFirmwaresRequest{
+				Model: model,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Firmwares)
+		},
+	}
+	gatewaysFirmwareUpdateCommand = &cobra.Command{
+		Use:     "update [firmware-id]",
+		Aliases: []string{"set"},
+		Short:   "Update a gateway firmware update",
+		Long: `Update a gateway firmware update
+
+Increase the rollout percentage to deliver the firmware to more gateways.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firmware := &ttnpb.GatewayFirmware{}
+			paths, err := firmware.SetFromFlags(cmd.Flags(), "")
+			if err != nil {
+				return err
+			}
+			paths = ttnpb.ExcludeFields(paths, "firmware_id")
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, won't update anything")
+				return nil
+			}
+			firmwareID, err := getGatewayFirmwareID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			firmware.FirmwareId = firmwareID
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).Update(ctx, &ttnpb.UpdateGatewayFirmwareRequest{
+				Firmware:  firmware,
+				FieldMask: ttnpb.FieldMask(paths...),
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysFirmwareDeleteCommand = &cobra.Command{
+		Use:     "delete [firmware-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete a gateway firmware update",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firmwareID, err := getGatewayFirmwareID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayFirmwareRegistryClient(gcs).Delete(ctx, &ttnpb.DeleteGatewayFirmwareRequest{
+				FirmwareId: firmwareID,
+			})
+			return err
+		},
+	}
+)
+
+func init() {
+	ttnpb.AddSetFlagsForGatewayFirmware(gatewaysFirmwareCreateCommand.Flags(), "", false)
+	gatewaysFirmwareCreateCommand.Flags().AddFlagSet(dataFlags("data", "firmware update data"))
+	gatewaysFirmwareCommand.AddCommand(gatewaysFirmwareCreateCommand)
+	gatewaysFirmwareGetCommand.Flags().AddFlagSet(gatewayFirmwareIDFlags())
+	gatewaysFirmwareCommand.AddCommand(gatewaysFirmwareGetCommand)
+	gatewaysFirmwareListCommand.Flags().String("model", "", "only list the firmware updates of the model")
+	gatewaysFirmwareCommand.AddCommand(gatewaysFirmwareListCommand)
+	ttnpb.AddSetFlagsForGatewayFirmware(gatewaysFirmwareUpdateCommand.Flags(), "", false)
+	gatewaysFirmwareCommand.AddCommand(gatewaysFirmwareUpdateCommand)
+	gatewaysFirmwareDeleteCommand.Flags().AddFlagSet(gatewayFirmwareIDFlags())
+	gatewaysFirmwareCommand.AddCommand(gatewaysFirmwareDeleteCommand)
+	gatewaysCommand.AddCommand(gatewaysFirmwareCommand)
+}
@@ -0,0 +1,275 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoNotificationChannelID = errors.DefineInvalidArgument(
+		"no_notification_channel_id", "no notification channel ID set",
+	)
+	errMultipleNotificationChannels = errors.DefineInvalidArgument(
+		"multiple_notification_channels", "set only one of webhook, Slack or Matrix",
+	)
+)
+
+func notificationChannelFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("notification-types", nil, "notification types to send to the channel (default all)")
+	flagSet.Bool("disabled", false, "disable the channel")
+	flagSet.String("webhook.url", "", "URL of the webhook")
+	flagSet.String("webhook.secret", "", "secret to sign the webhook requests with")
+	flagSet.StringToString("webhook.headers", nil, "headers of the webhook requests")
+	flagSet.String("slack.url", "", "URL of the Slack incoming webhook")
+	flagSet.String("matrix.homeserver-url", "", "URL of the Matrix homeserver")
+	flagSet.String("matrix.room-id", "", "ID of the Matrix room")
+	flagSet.String("matrix.access-token", "", "access token of the Matrix user that sends the messages")
+	return flagSet
+}
+
+func getNotificationChannelIDs(flagSet *pflag.FlagSet, args []string) (*ttnpb.NotificationChannelIdentifiers, error) {
+	ownerIDs := getCollaborator(flagSet)
+	if ownerIDs == nil {
+		return nil, errNoCollaborator.New()
+	}
+	channelID, _ := flagSet.GetString("channel-id")
+	if channelID == "" && len(args) > 0 {
+		if len(args) > 1 {
+			logger.Warn("Multiple IDs found in arguments, considering only the first")
+		}
+		channelID = args[0]
+	}
+	if channelID == "" {
+		return nil, errNoNotificationChannelID.New()
+	}
+	return &ttnpb.NotificationChannelIdentifiers{
+		OwnerIds:  ownerIDs,
+		ChannelId: channelID,
+	}, nil
+}
+
+// setNotificationChannelFromFlags sets the fields of the channel from the changed flags,
+// and returns the paths of the fields that were set.
+func setNotificationChannelFromFlags(flagSet *pflag.FlagSet, channel *ttnpb.NotificationChannel) ([]string, error) {
+	var paths []string
+	if flagSet.Changed("notification-types") {
+		channel.NotificationTypes, _ = flagSet.GetStringSlice("notification-types")
+		paths = append(paths, "notification_types")
+	}
+	if flagSet.Changed("disabled") {
+		channel.Disabled, _ = flagSet.GetBool("disabled")
+		paths = append(paths, "disabled")
+	}
+	var channels int
+	if flagSet.Changed("webhook.url") || flagSet.Changed("webhook.secret") || flagSet.Changed("webhook.headers") {
+		webhook := &ttnpb.NotificationChannel_Webhook{}
+		webhook.Url, _ = flagSet.GetString("webhook.url")
+		if secret, _ := flagSet.GetString("webhook.secret"); secret != "" {
+			webhook.Secret = &ttnpb.Secret{Value: []byte(secret)}
+		}
+		webhook.Headers, _ = flagSet.GetStringToString("webhook.headers")
+		channel.Channel = &ttnpb.NotificationChannel_Webhook_{Webhook: webhook}
+		channels++
+	}
+	if flagSet.Changed("slack.url") {
+		slack := &ttnpb.NotificationChannel_Slack{}
+		slack.Url, _ = flagSet.GetString("slack.url")
+		channel.Channel = &ttnpb.NotificationChannel_Slack_{Slack: slack}
+		channels++
+	}
+	if flagSet.Changed("matrix.homeserver-url") || flagSet.Changed("matrix.room-id") ||
+		flagSet.Changed("matrix.access-token") {
+		matrix := &ttnpb.NotificationChannel_Matrix{}
+		matrix.HomeserverUrl, _ = flagSet.GetString("matrix.homeserver-url")
+		matrix.RoomId, _ = flagSet.GetString("matrix.room-id")
+		accessToken, _ := flagSet.GetString("matrix.access-token")
+		matrix.AccessToken = &ttnpb.Secret{Value: []byte(accessToken)}
+		channel.Channel = &ttnpb.NotificationChannel_Matrix_{Matrix: matrix}
+		channels++
+	}
+	switch channels {
+	case 0:
+	case 1:
+		paths = append(paths, "channel")
+	default:
+		return nil, errMultipleNotificationChannels.New()
+	}
+	return paths, nil
+}
+
+var (
+	notificationsChannelsCommand = &cobra.Command{
+		Use:     "channels",
+		Aliases: []string{"channel"},
+		Short:   "Manage notification channels",
+	}
+	notificationsChannelsListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List notification channels of a user or organization",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ownerIDs := getCollaborator(cmd.Flags())
+			if ownerIDs == nil {
+				return errNoCollaborator.New()
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNotificationServiceClient(is).ListChannels(ctx, &ttnpb.ListNotificationChannelsRequest{
+				OwnerIds: ownerIDs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Channels)
+		},
+	}
+	notificationsChannelsGetCommand = &cobra.Command{
+		Use:   "get [channel-id]",
+		Short: "Get a notification channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getNotificationChannelIDs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNotificationServiceClient(is).GetChannel(ctx, ids)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	notificationsChannelsCreateCommand = &cobra.Command{
+		Use:   "create [channel-id]",
+		Short: "Create a notification channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getNotificationChannelIDs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			channel := &ttnpb.NotificationChannel{
+				OwnerIds:  ids.OwnerIds,
+				ChannelId: ids.ChannelId,
+			}
+			if _, err := setNotificationChannelFromFlags(cmd.Flags(), channel); err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNotificationServiceClient(is).CreateChannel(ctx, &ttnpb.CreateNotificationChannelRequest{
+				Channel: channel,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	notificationsChannelsSetCommand = &cobra.Command{
+		Use:     "set [channel-id]",
+		Aliases: []string{"update"},
+		Short:   "Set properties of a notification channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getNotificationChannelIDs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			channel := &ttnpb.NotificationChannel{
+				OwnerIds:  ids.OwnerIds,
+				ChannelId: ids.ChannelId,
+			}
+			paths, err := setNotificationChannelFromFlags(cmd.Flags(), channel)
+			if err != nil {
+				return err
+			}
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, won't update anything")
+				return nil
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNotificationServiceClient(is).UpdateChannel(ctx, &ttnpb.UpdateNotificationChannelRequest{
+				Channel:   channel,
+				FieldMask: ttnpb.FieldMask(paths...),
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	notificationsChannelsDeleteCommand = &cobra.Command{
+		Use:     "delete [channel-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete a notification channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getNotificationChannelIDs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNotificationServiceClient(is).DeleteChannel(ctx, ids)
+			return err
+		},
+	}
+)
+
+func init() {
+	notificationsChannelsListCommand.Flags().AddFlagSet(collaboratorFlags())
+	notificationsChannelsCommand.AddCommand(notificationsChannelsListCommand)
+	for _, cmd := range []*cobra.Command{
+		notificationsChannelsGetCommand,
+		notificationsChannelsCreateCommand,
+		notificationsChannelsSetCommand,
+		notificationsChannelsDeleteCommand,
+	} {
+		cmd.Flags().AddFlagSet(collaboratorFlags())
+		cmd.Flags().String("channel-id", "", "")
+		notificationsChannelsCommand.AddCommand(cmd)
+	}
+	notificationsChannelsCreateCommand.Flags().AddFlagSet(notificationChannelFlags())
+	notificationsChannelsSetCommand.Flags().AddFlagSet(notificationChannelFlags())
+	notificationsCommand.AddCommand(notificationsChannelsCommand)
+}
@@ -47,6 +47,7 @@ var (
 	applicationUplinkFlags = util.NormalizedFlagSet()
 
 	errApplicationServerDisabled = errors.DefineFailedPrecondition("application_server_disabled", "Application Server is disabled")
+	errNoScenarioFile            = errors.DefineInvalidArgument("no_scenario_file", "no scenario file set")
 )
 
 func simulateFlags() *pflag.FlagSet {
@@ -680,6 +681,68 @@ var (
 			return err
 		},
 	}
+
+	simulateFleetCommand = &cobra.Command{
+		Use:   "fleet",
+		Short: "Simulate a fleet of gateways and end devices for load and regression testing (EXPERIMENTAL)",
+		Long: `Simulate a fleet of gateways and end devices for load and regression testing (EXPERIMENTAL)
+
+The simulated gateways connect to the Gateway Server using the UDP packet
+forwarder protocol or the LoRa Basics Station LNS protocol. The simulated end
+devices join using OTAA, send (confirmed) uplinks with realistic RF metadata and
+answer MAC commands of the Network Server. The gateways and end devices must be
+registered with the keys and EUIs of the scenario.
+
+The scenario is a YAML file, for example:
+
+  duration: 10m
+  band-id: EU_863_870
+  gateways:
+    count: 10
+    protocol: udp
+    address: localhost:1700
+    start-eui: 0000000000000001
+  end-devices:
+    count: 1000
+    lorawan-version: 1.0.3
+    lorawan-phy-version: 1.0.3-a
+    join-eui: 0000000000000000
+    start-dev-eui: 0000000000000001
+    app-key: 000102030405060708090A0B0C0D0E0F
+    uplink-interval: 1m
+    confirmed: 0.1
+    gateways: 3
+
+When the simulation ends, a report with the join and downlink latencies and the
+message loss is printed.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			scenarioFile, _ := cmd.Flags().GetString("scenario")
+			if scenarioFile == "" {
+				return errNoScenarioFile.New()
+			}
+			data, err := os.ReadFile(scenarioFile)
+			if err != nil {
+				return err
+			}
+			scenario, err := simulate.ParseScenario(data)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("duration") {
+				scenario.Duration, _ = cmd.Flags().GetDuration("duration")
+			}
+			logger.WithFields(log.Fields(
+				"gateways", scenario.Gateways.Count,
+				"end_devices", scenario.EndDevices.Count,
+				"duration", scenario.Duration,
+			)).Info("Start fleet simulation")
+			report, err := simulate.Run(ctx, scenario)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, report)
+		},
+	}
 )
 
 func init() {
@@ -710,5 +773,10 @@ func init() {
 
 	simulateCommand.AddCommand(simulateApplicationUplinkCommand)
 
+	simulateFleetCommand.Flags().String("scenario", "", "scenario YAML file")
+	simulateFleetCommand.Flags().Duration("duration", 0, "duration of the simulation (overrides the scenario)")
+
+	simulateCommand.AddCommand(simulateFleetCommand)
+
 	Root.AddCommand(simulateCommand)
 }
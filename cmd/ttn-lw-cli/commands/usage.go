@@ -0,0 +1,183 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errMultipleUsageEntities = errors.DefineInvalidArgument(
+	"multiple_usage_entities", "set at most one of application ID, gateway ID or organization ID",
+)
+
+func usageEntityFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("application-id", "", "")
+	flagSet.String("gateway-id", "", "")
+	flagSet.String("organization-id", "", "")
+	flagSet.AddFlagSet(timestampFlags("from", "only return usage from the specified day"))
+	flagSet.AddFlagSet(timestampFlags("to", "only return usage before the specified day"))
+	return flagSet
+}
+
+// getListUsageRequest returns the request based on the entity and time flags.
+// If no entity is set, the usage of all entities is requested.
+func getListUsageRequest(flagSet *pflag.FlagSet) (*ttnpb.ListUsageRequest, error) {
+	req := &ttnpb.ListUsageRequest{}
+	var entities int
+	if id, _ := flagSet.GetString("application-id"); id != "" {
+		req.EntityIds = (&ttnpb.ApplicationIdentifiers{ApplicationId: id}).GetEntityIdentifiers()
+		entities++
+	}
+	if id, _ := flagSet.GetString("gateway-id"); id != "" {
+		req.EntityIds = (&ttnpb.GatewayIdentifiers{GatewayId: id}).GetEntityIdentifiers()
+		entities++
+	}
+	if id, _ := flagSet.GetString("organization-id"); id != "" {
+		req.EntityIds = (&ttnpb.OrganizationIdentifiers{OrganizationId: id}).GetEntityIdentifiers()
+		entities++
+	}
+	if entities > 1 {
+		return nil, errMultipleUsageEntities.New()
+	}
+	from, err := getTimestampFlags(flagSet, "from")
+	if err != nil {
+		return nil, err
+	}
+	if from != nil {
+		req.From = timestamppb.New(*from)
+	}
+	to, err := getTimestampFlags(flagSet, "to")
+	if err != nil {
+		return nil, err
+	}
+	if to != nil {
+		req.To = timestamppb.New(*to)
+	}
+	return req, nil
+}
+
+var usageCSVHeader = []string{
+	"day", "entity_type", "entity_id", "uplinks", "downlinks", "joins", "airtime_seconds", "webhook_deliveries",
+}
+
+func usageCSVRecord(record *ttnpb.UsageRecord) []string {
+	return []string{
+		record.GetDay().AsTime().Format(time.DateOnly),
+		record.GetEntityIds().EntityType(),
+		record.GetEntityIds().IDString(),
+		strconv.FormatUint(record.GetUplinks(), 10),
+		strconv.FormatUint(record.GetDownlinks(), 10),
+		strconv.FormatUint(record.GetJoins(), 10),
+		strconv.FormatFloat(record.GetAirtime().AsDuration().Seconds(), 'f', -1, 64),
+		strconv.FormatUint(record.GetWebhookDeliveries(), 10),
+	}
+}
+
+var (
+	usageCommand = &cobra.Command{
+		Use:   "usage",
+		Short: "Usage metering commands (IS only)",
+	}
+	usageListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the daily usage of an application, gateway or organization",
+		Long: `List the daily usage of an application, gateway or organization
+
+The usage of an organization is the sum of the usage of the applications and
+gateways that it owns. If no entity is specified, the usage of all
+applications and gateways is listed, which requires administrative rights.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req, err := getListUsageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			req.Limit, req.Page = limit, page
+			res, err := ttnpb.NewUsageMeteringClient(is).ListUsage(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+			return io.Write(os.Stdout, config.OutputFormat, res.Records)
+		},
+	}
+	usageExportCommand = &cobra.Command{
+		Use:   "export",
+		Short: "Export the daily usage of an application, gateway or organization as CSV",
+		Long: `Export the daily usage of an application, gateway or organization as CSV
+
+All pages of results are retrieved and written to standard output, with one
+row per entity and day.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req, err := getListUsageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewUsageMeteringClient(is)
+
+			w := csv.NewWriter(os.Stdout)
+			if err := w.Write(usageCSVHeader); err != nil {
+				return err
+			}
+			req.Limit = 1000
+			for req.Page = 1; ; req.Page++ {
+				res, err := client.ListUsage(ctx, req)
+				if err != nil {
+					return err
+				}
+				for _, record := range res.Records {
+					if err := w.Write(usageCSVRecord(record)); err != nil {
+						return err
+					}
+				}
+				if uint32(len(res.Records)) < req.Limit {
+					break
+				}
+			}
+			w.Flush()
+			return w.Error()
+		},
+	}
+)
+
+func init() {
+	usageListCommand.Flags().AddFlagSet(usageEntityFlags())
+	usageListCommand.Flags().AddFlagSet(paginationFlags())
+	usageCommand.AddCommand(usageListCommand)
+	usageExportCommand.Flags().AddFlagSet(usageEntityFlags())
+	usageCommand.AddCommand(usageExportCommand)
+	Root.AddCommand(usageCommand)
+}
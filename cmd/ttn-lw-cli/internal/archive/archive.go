@@ -0,0 +1,408 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive implements the application export archive.
+//
+// An archive is a gzipped tarball with one JSON entry per exported object and a manifest that is written last.
+// When a passphrase is given, the entries are encrypted with AES-256-GCM using a key derived from the passphrase.
+// The manifest itself is never encrypted, so that the archive version and encryption parameters can be read, but it is
+// authenticated with an HMAC-SHA256 using a second key derived from the passphrase. The manifest contains the SHA256
+// checksums of the stored entries, which are the ciphertexts for encrypted archives.
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Version is the current archive version.
+const Version = 1
+
+// ManifestName is the name of the manifest entry.
+const ManifestName = "manifest.json"
+
+// ManifestMACName is the name of the entry with the HMAC of the manifest of encrypted archives.
+const ManifestMACName = "manifest.mac"
+
+const (
+	encryptionAlgorithm = "aes-256-gcm"
+	keyDerivation       = "pbkdf2-sha256"
+	keyIterations       = 100000
+	keyLength           = 32
+	saltLength          = 16
+)
+
+var (
+	errUnsupportedVersion = errors.DefineFailedPrecondition(
+		"unsupported_version", "unsupported archive version `{version}`",
+	)
+	errNoManifest             = errors.DefineCorruption("no_manifest", "no manifest in archive")
+	errEntryNotFound          = errors.DefineNotFound("entry_not_found", "entry `{name}` not found in archive")
+	errDuplicateEntry         = errors.DefineAlreadyExists("duplicate_entry", "duplicate entry `{name}`")
+	errPassphraseRequired     = errors.DefineFailedPrecondition("passphrase_required", "archive is encrypted")
+	errInvalidPassphrase      = errors.DefinePermissionDenied("invalid_passphrase", "invalid passphrase")
+	errNotEncrypted           = errors.DefineFailedPrecondition("not_encrypted", "archive is not encrypted")
+	errManifestAuthentication = errors.DefinePermissionDenied(
+		"manifest_authentication", "manifest authentication failed; invalid passphrase or modified archive",
+	)
+	errUnsupportedEncryption = errors.DefineFailedPrecondition(
+		"unsupported_encryption", "unsupported encryption `{algorithm}` with key derivation `{kdf}`",
+	)
+	errChecksum = errors.DefineCorruption("checksum", "checksum mismatch for entry `{name}`")
+)
+
+// Encryption contains the parameters of the archive encryption.
+type Encryption struct {
+	Algorithm     string `json:"algorithm"`
+	KeyDerivation string `json:"key_derivation"`
+	Iterations    int    `json:"iterations"`
+	Salt          []byte `json:"salt"`
+}
+
+// Entry describes an entry in the archive.
+type Entry struct {
+	Name   string `json:"name"`
+	SHA256 []byte `json:"sha256"`
+}
+
+// Manifest describes the archive contents.
+type Manifest struct {
+	Version       int         `json:"version"`
+	CreatedAt     time.Time   `json:"created_at"`
+	ApplicationID string      `json:"application_id"`
+	Secrets       bool        `json:"secrets"`
+	Encryption    *Encryption `json:"encryption,omitempty"`
+	// Entries are the entries in the order in which they were written.
+	Entries []Entry `json:"entries"`
+}
+
+// deriveKeys derives the entry encryption AEAD and the manifest MAC key from the passphrase.
+func deriveKeys(passphrase string, encryption *Encryption) (cipher.AEAD, []byte, error) {
+	if encryption.Algorithm != encryptionAlgorithm || encryption.KeyDerivation != keyDerivation {
+		return nil, nil, errUnsupportedEncryption.WithAttributes(
+			"algorithm", encryption.Algorithm,
+			"kdf", encryption.KeyDerivation,
+		)
+	}
+	key := pbkdf2.Key([]byte(passphrase), encryption.Salt, encryption.Iterations, 2*keyLength, sha256.New)
+	block, err := aes.NewCipher(key[:keyLength])
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, key[keyLength:], nil
+}
+
+func manifestMAC(key, manifest []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(manifest)
+	return h.Sum(nil)
+}
+
+// Writer writes an archive.
+type Writer struct {
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	aead     cipher.AEAD
+	macKey   []byte
+	manifest Manifest
+	names    map[string]struct{}
+}
+
+// NewWriter returns a new archive writer for the given application.
+// If passphrase is non-empty, the entries are encrypted.
+func NewWriter(w io.Writer, applicationID string, secrets bool, passphrase string) (*Writer, error) {
+	gz := gzip.NewWriter(w)
+	aw := &Writer{
+		gz: gz,
+		tw: tar.NewWriter(gz),
+		manifest: Manifest{
+			Version:       Version,
+			CreatedAt:     time.Now().UTC(),
+			ApplicationID: applicationID,
+			Secrets:       secrets,
+		},
+		names: make(map[string]struct{}),
+	}
+	if passphrase != "" {
+		salt := make([]byte, saltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		aw.manifest.Encryption = &Encryption{
+			Algorithm:     encryptionAlgorithm,
+			KeyDerivation: keyDerivation,
+			Iterations:    keyIterations,
+			Salt:          salt,
+		}
+		aead, macKey, err := deriveKeys(passphrase, aw.manifest.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		aw.aead, aw.macKey = aead, macKey
+	}
+	return aw, nil
+}
+
+func (w *Writer) writeFile(name string, data []byte) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o600,
+		Size:     int64(len(data)),
+		ModTime:  w.manifest.CreatedAt,
+	}); err != nil {
+		return err
+	}
+	_, err := w.tw.Write(data)
+	return err
+}
+
+// Write writes the entry with the given name.
+func (w *Writer) Write(name string, data []byte) error {
+	if _, ok := w.names[name]; ok || name == ManifestName || name == ManifestMACName {
+		return errDuplicateEntry.WithAttributes("name", name)
+	}
+	w.names[name] = struct{}{}
+	if w.aead != nil {
+		nonce := make([]byte, w.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return err
+		}
+		// The entry name is authenticated so that encrypted entries cannot be swapped.
+		data = w.aead.Seal(nonce, nonce, data, []byte(name))
+	}
+	if err := w.writeFile(name, data); err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	w.manifest.Entries = append(w.manifest.Entries, Entry{Name: name, SHA256: sum[:]})
+	return nil
+}
+
+// WriteMessage writes the given message as JSON entry with the given name.
+func (w *Writer) WriteMessage(name string, msg any) error {
+	data, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return w.Write(name, data)
+}
+
+// Close writes the manifest and closes the archive. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	data, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := w.writeFile(ManifestName, data); err != nil {
+		return err
+	}
+	if w.macKey != nil {
+		if err := w.writeFile(ManifestMACName, manifestMAC(w.macKey, data)); err != nil {
+			return err
+		}
+	}
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+// Reader reads an archive.
+type Reader struct {
+	manifest Manifest
+	aead     cipher.AEAD
+	files    map[string][]byte
+}
+
+// NewReader reads the archive from r. The archive is read in memory.
+// The passphrase is required if the archive is encrypted, and the archive must be encrypted if the passphrase is set.
+func NewReader(r io.Reader, passphrase string) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	files := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if _, ok := files[hdr.Name]; ok {
+			return nil, errDuplicateEntry.WithAttributes("name", hdr.Name)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[hdr.Name] = data
+	}
+	manifestData, ok := files[ManifestName]
+	if !ok {
+		return nil, errNoManifest.New()
+	}
+	mac := files[ManifestMACName]
+	delete(files, ManifestName)
+	delete(files, ManifestMACName)
+	ar := &Reader{files: files}
+	if err := json.Unmarshal(manifestData, &ar.manifest); err != nil {
+		return nil, err
+	}
+	if ar.manifest.Version < 1 || ar.manifest.Version > Version {
+		return nil, errUnsupportedVersion.WithAttributes("version", ar.manifest.Version)
+	}
+	switch {
+	case ar.manifest.Encryption != nil:
+		if passphrase == "" {
+			return nil, errPassphraseRequired.New()
+		}
+		aead, macKey, err := deriveKeys(passphrase, ar.manifest.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		if !hmac.Equal(mac, manifestMAC(macKey, manifestData)) {
+			return nil, errManifestAuthentication.New()
+		}
+		ar.aead = aead
+	case passphrase != "":
+		// NOTE: An attacker could otherwise replace an encrypted archive by an unencrypted one.
+		return nil, errNotEncrypted.New()
+	}
+	for _, entry := range ar.manifest.Entries {
+		if _, err := ar.Read(entry.Name); err != nil {
+			return nil, err
+		}
+	}
+	return ar, nil
+}
+
+// Manifest returns the archive manifest.
+func (r *Reader) Manifest() Manifest {
+	return r.manifest
+}
+
+// Read reads the entry with the given name.
+func (r *Reader) Read(name string) ([]byte, error) {
+	sum, ok := r.checksum(name)
+	if !ok {
+		return nil, errEntryNotFound.WithAttributes("name", name)
+	}
+	data, ok := r.files[name]
+	if !ok {
+		return nil, errEntryNotFound.WithAttributes("name", name)
+	}
+	// The checksum covers the stored data, so that it does not reveal the plaintext of encrypted entries.
+	if actual := sha256.Sum256(data); !hmac.Equal(actual[:], sum) {
+		return nil, errChecksum.WithAttributes("name", name)
+	}
+	if r.aead != nil {
+		nonceSize := r.aead.NonceSize()
+		if len(data) < nonceSize {
+			return nil, errInvalidPassphrase.New()
+		}
+		plaintext, err := r.aead.Open(nil, data[:nonceSize], data[nonceSize:], []byte(name))
+		if err != nil {
+			return nil, errInvalidPassphrase.WithCause(err)
+		}
+		data = plaintext
+	}
+	return data, nil
+}
+
+// checksum returns the checksum of the entry with the given name in the manifest.
+func (r *Reader) checksum(name string) ([]byte, bool) {
+	for _, entry := range r.manifest.Entries {
+		if entry.Name == name {
+			return entry.SHA256, true
+		}
+	}
+	return nil, false
+}
+
+// ReadMessage reads the JSON entry with the given name into msg.
+func (r *Reader) ReadMessage(name string, msg any) error {
+	data, err := r.Read(name)
+	if err != nil {
+		return err
+	}
+	return jsonpb.TTN().Unmarshal(data, msg)
+}
+
+// Progress keeps track of the imported entries, so that an interrupted import can be resumed.
+type Progress struct {
+	ApplicationID string   `json:"application_id"`
+	Completed     []string `json:"completed"`
+
+	completed map[string]struct{}
+}
+
+// LoadProgress loads the progress from r.
+func LoadProgress(r io.Reader) (*Progress, error) {
+	p := &Progress{}
+	if err := json.NewDecoder(r).Decode(p); err != nil {
+		return nil, err
+	}
+	p.completed = make(map[string]struct{}, len(p.Completed))
+	for _, name := range p.Completed {
+		p.completed[name] = struct{}{}
+	}
+	return p, nil
+}
+
+// IsCompleted returns whether the entry with the given name has been imported.
+func (p *Progress) IsCompleted(name string) bool {
+	_, ok := p.completed[name]
+	return ok
+}
+
+// Complete marks the entry with the given name as imported.
+func (p *Progress) Complete(name string) {
+	if p.IsCompleted(name) {
+		return
+	}
+	if p.completed == nil {
+		p.completed = make(map[string]struct{})
+	}
+	p.completed[name] = struct{}{}
+	p.Completed = append(p.Completed, name)
+}
+
+// Save writes the progress to w.
+func (p *Progress) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,276 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"testing"
+
+	. "go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/archive"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	testEntries = []struct {
+		name string
+		data []byte
+	}{
+		{name: "application.json", data: []byte(`{"ids":{"application_id":"test-app"}}`)},
+		{name: "devices/test-dev.json", data: []byte(`{"root_keys":{"app_key":{"key":"AAAAAAAAAAAAAAAAAAAAAA=="}}}`)},
+	}
+	testPassphrase = "secret"
+)
+
+func writeArchive(t *testing.T, passphrase string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "test-app", true, passphrase)
+	if err != nil {
+		t.Fatalf("Failed to create archive writer: %v", err)
+	}
+	for _, entry := range testEntries {
+		if err := w.Write(entry.name, entry.data); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close archive writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// rewriteArchive rewrites the archive, calling f with the name and contents of each file.
+func rewriteArchive(t *testing.T, archive []byte, f func(name string, data []byte) []byte) []byte {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatalf("Failed to read archive: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read archive: %v", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("Failed to read archive: %v", err)
+		}
+		data = f(hdr.Name, data)
+		hdr.Size = int64(len(data))
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("Failed to write archive: %v", err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatalf("Failed to write archive: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func rewriteManifest(t *testing.T, archive []byte, f func(*Manifest)) []byte {
+	t.Helper()
+	return rewriteArchive(t, archive, func(name string, data []byte) []byte {
+		if name != ManifestName {
+			return data
+		}
+		var manifest Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			t.Fatalf("Failed to unmarshal manifest: %v", err)
+		}
+		f(&manifest)
+		data, err := json.Marshal(manifest)
+		if err != nil {
+			t.Fatalf("Failed to marshal manifest: %v", err)
+		}
+		return data
+	})
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name       string
+		Passphrase string
+	}{
+		{Name: "Plaintext"},
+		{Name: "Encrypted", Passphrase: testPassphrase},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			r, err := NewReader(bytes.NewReader(writeArchive(t, tc.Passphrase)), tc.Passphrase)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			manifest := r.Manifest()
+			a.So(manifest.Version, should.Equal, Version)
+			a.So(manifest.ApplicationID, should.Equal, "test-app")
+			a.So(manifest.Secrets, should.BeTrue)
+			a.So(manifest.Encryption != nil, should.Equal, tc.Passphrase != "")
+			if !a.So(manifest.Entries, should.HaveLength, len(testEntries)) {
+				t.FailNow()
+			}
+			for i, entry := range testEntries {
+				a.So(manifest.Entries[i].Name, should.Equal, entry.name)
+				data, err := r.Read(entry.name)
+				a.So(err, should.BeNil)
+				a.So(data, should.Resemble, entry.data)
+
+				// The checksum must not be a fingerprint of the plaintext of encrypted entries.
+				sum := sha256.Sum256(entry.data)
+				a.So(bytes.Equal(manifest.Entries[i].SHA256, sum[:]), should.Equal, tc.Passphrase == "")
+			}
+			_, err = r.Read("unknown.json")
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		})
+	}
+}
+
+func TestArchivePassphrase(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	encrypted := writeArchive(t, testPassphrase)
+
+	_, err := NewReader(bytes.NewReader(encrypted), "")
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	_, err = NewReader(bytes.NewReader(encrypted), "wrong")
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// A passphrase is given, but the archive is not encrypted.
+	_, err = NewReader(bytes.NewReader(writeArchive(t, "")), testPassphrase)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+}
+
+func TestArchiveTamper(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name       string
+		Passphrase string
+		Tamper     func(*testing.T, []byte) []byte
+		Assertion  func(error) bool
+	}{
+		{
+			Name:       "Encrypted/Manifest",
+			Passphrase: testPassphrase,
+			Tamper: func(t *testing.T, archive []byte) []byte {
+				t.Helper()
+				return rewriteManifest(t, archive, func(manifest *Manifest) {
+					manifest.ApplicationID = "other-app"
+				})
+			},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:       "Encrypted/ManifestEncryption",
+			Passphrase: testPassphrase,
+			Tamper: func(t *testing.T, archive []byte) []byte {
+				t.Helper()
+				return rewriteManifest(t, archive, func(manifest *Manifest) {
+					manifest.Encryption.Iterations = 1
+				})
+			},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:       "Encrypted/ManifestMAC",
+			Passphrase: testPassphrase,
+			Tamper: func(t *testing.T, archive []byte) []byte {
+				t.Helper()
+				return rewriteArchive(t, archive, func(name string, data []byte) []byte {
+					if name == ManifestMACName {
+						return nil
+					}
+					return data
+				})
+			},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:       "Encrypted/StripEncryption",
+			Passphrase: testPassphrase,
+			Tamper: func(t *testing.T, archive []byte) []byte {
+				t.Helper()
+				return rewriteManifest(t, archive, func(manifest *Manifest) {
+					manifest.Encryption = nil
+				})
+			},
+			Assertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name:       "Encrypted/Entry",
+			Passphrase: testPassphrase,
+			Tamper: func(t *testing.T, archive []byte) []byte {
+				t.Helper()
+				return rewriteArchive(t, archive, func(name string, data []byte) []byte {
+					if name == testEntries[1].name {
+						data[len(data)-1] ^= 0xff
+					}
+					return data
+				})
+			},
+			Assertion: errors.IsDataLoss,
+		},
+		{
+			Name: "Plaintext/Entry",
+			Tamper: func(t *testing.T, archive []byte) []byte {
+				t.Helper()
+				return rewriteArchive(t, archive, func(name string, data []byte) []byte {
+					if name == testEntries[0].name {
+						data = bytes.ReplaceAll(data, []byte("test-app"), []byte("evil-app"))
+					}
+					return data
+				})
+			},
+			Assertion: errors.IsDataLoss,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			archive := tc.Tamper(t, writeArchive(t, tc.Passphrase))
+			_, err := NewReader(bytes.NewReader(archive), tc.Passphrase)
+			if !a.So(tc.Assertion(err), should.BeTrue) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,541 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"bytes"
+	"math/rand"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errMICMismatch   = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
+	errFCntReplay    = errors.DefineInvalidArgument("f_cnt_replay", "frame counter `{f_cnt}` replayed")
+	errNotJoined     = errors.DefineFailedPrecondition("not_joined", "end device not joined")
+	errNoChannel     = errors.DefineFailedPrecondition("no_channel", "no channel for data rate `{data_rate_index}`")
+	errMACCommandLen = errors.DefineInvalidArgument("mac_command_length", "MAC commands exceed FOpts length")
+)
+
+// maxFOptsLen is the maximum length of the FOpts field.
+const maxFOptsLen = 15
+
+// link is the radio link between an end device and a gateway.
+type link struct {
+	gateway int
+	rssi    float32
+	snr     float32
+}
+
+// uplink is a LoRaWAN frame transmitted by a simulated end device.
+type uplink struct {
+	rawPayload   []byte
+	dataRate     *ttnpb.DataRate
+	frequency    uint64
+	channelIndex int
+	join         bool
+	confirmed    bool
+}
+
+// downlinkResult is the result of handling a data downlink.
+type downlinkResult struct {
+	// ack indicates whether the downlink acknowledged a confirmed uplink.
+	ack bool
+	// confirmed indicates whether the downlink is a confirmed downlink.
+	confirmed bool
+	// macCommands is the number of MAC commands in the downlink.
+	macCommands int
+}
+
+// device is a simulated OTAA end device.
+// The methods of device are safe for concurrent use.
+type device struct {
+	mu sync.Mutex
+
+	scenario *EndDeviceScenario
+	phy      *band.Band
+	channels []band.Channel
+	devEUI   types.EUI64
+	links    []link
+
+	devNonce uint16
+	joined   bool
+	// macVersion is the negotiated LoRaWAN version.
+	macVersion ttnpb.MACVersion
+	devAddr    types.DevAddr
+	keys       struct {
+		fNwkSIntKey, sNwkSIntKey, nwkSEncKey, appSKey types.AES128Key
+	}
+
+	dataRateIndex ttnpb.DataRateIndex
+	fCntUp        uint32
+	// confFCntUp is the frame counter of the last confirmed uplink.
+	confFCntUp uint32
+	// confFCntDown is the frame counter of the last confirmed downlink, which is acknowledged in the next uplink.
+	confFCntDown  uint32
+	ack           bool
+	lastNFCntDown *uint32
+	lastAFCntDown *uint32
+
+	rekeyConfirmed      bool
+	deviceModeConfirmed bool
+	answers             []*ttnpb.MACCommand
+	// stickyAnswers are repeated in every uplink until a downlink is received.
+	stickyAnswers []*ttnpb.MACCommand
+}
+
+func newDevice(scenario *EndDeviceScenario, phy *band.Band, channels []band.Channel, devEUI types.EUI64, links []link, rnd *rand.Rand) *device {
+	devNonce := scenario.DevNonce
+	if devNonce == 0 {
+		devNonce = uint16(rnd.Intn(0x8000))
+	}
+	return &device{
+		scenario:      scenario,
+		phy:           phy,
+		channels:      channels,
+		devEUI:        devEUI,
+		links:         links,
+		devNonce:      devNonce,
+		macVersion:    scenario.LoRaWANVersion,
+		dataRateIndex: scenario.DataRateIndex,
+	}
+}
+
+func (d *device) rootKey() types.AES128Key {
+	if macspec.UseNwkKey(d.scenario.LoRaWANVersion) {
+		return d.scenario.NwkKey
+	}
+	return d.scenario.AppKey
+}
+
+func (d *device) channel(rnd *rand.Rand) (int, band.Channel, error) {
+	var candidates []int
+	for i, ch := range d.channels {
+		if d.dataRateIndex >= ch.MinDataRate && d.dataRateIndex <= ch.MaxDataRate {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, band.Channel{}, errNoChannel.WithAttributes("data_rate_index", d.dataRateIndex)
+	}
+	i := candidates[rnd.Intn(len(candidates))]
+	return i, d.channels[i], nil
+}
+
+// JoinRequest returns a new join-request. The end device leaves the current session, if any.
+func (d *device) JoinRequest(rnd *rand.Rand) (*uplink, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	chIdx, ch, err := d.channel(rnd)
+	if err != nil {
+		return nil, err
+	}
+	d.joined = false
+	d.devNonce++
+	msg := &ttnpb.Message{
+		MHdr: &ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEui:  d.scenario.JoinEUI.Bytes(),
+				DevEui:   d.devEUI.Bytes(),
+				DevNonce: types.DevNonce{byte(d.devNonce >> 8), byte(d.devNonce)}.Bytes(),
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(d.rootKey(), buf)
+	if err != nil {
+		return nil, err
+	}
+	return &uplink{
+		rawPayload:   append(buf, mic[:]...),
+		dataRate:     d.phy.DataRates[d.dataRateIndex].Rate,
+		frequency:    ch.Frequency,
+		channelIndex: chIdx,
+		join:         true,
+	}, nil
+}
+
+// HandleJoinAccept handles the join-accept message. The raw payload is the encrypted join-accept.
+func (d *device) HandleJoinAccept(rawPayload []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rawPayload, msg); err != nil {
+		return err
+	}
+	joinAcceptPayload := msg.GetJoinAcceptPayload()
+	key := d.rootKey()
+	payload, err := crypto.DecryptJoinAccept(key, joinAcceptPayload.GetEncrypted())
+	if err != nil {
+		return err
+	}
+	joinAcceptBytes, mic := payload[:len(payload)-4], payload[len(payload)-4:]
+	if err := lorawan.UnmarshalJoinAcceptPayload(joinAcceptBytes, joinAcceptPayload); err != nil {
+		return err
+	}
+
+	var (
+		devNonce  = types.DevNonce{byte(d.devNonce >> 8), byte(d.devNonce)}
+		optNeg    = macspec.UseNwkKey(d.scenario.LoRaWANVersion) && joinAcceptPayload.DlSettings.OptNeg
+		micBytes  = append([]byte{rawPayload[0]}, joinAcceptBytes...)
+		expectMIC [4]byte
+	)
+	if optNeg {
+		expectMIC, err = crypto.ComputeJoinAcceptMIC(
+			crypto.DeriveJSIntKey(key, d.devEUI), 0xFF, d.scenario.JoinEUI, devNonce, micBytes,
+		)
+	} else {
+		expectMIC, err = crypto.ComputeLegacyJoinAcceptMIC(key, micBytes)
+	}
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(mic, expectMIC[:]) {
+		return errMICMismatch.New()
+	}
+
+	joinNonce := types.MustJoinNonce(joinAcceptPayload.JoinNonce).OrZero()
+	if optNeg {
+		nwkKey, joinEUI := d.scenario.NwkKey, d.scenario.JoinEUI
+		d.keys.appSKey = crypto.DeriveAppSKey(d.scenario.AppKey, joinNonce, joinEUI, devNonce)
+		d.keys.fNwkSIntKey = crypto.DeriveFNwkSIntKey(nwkKey, joinNonce, joinEUI, devNonce)
+		d.keys.sNwkSIntKey = crypto.DeriveSNwkSIntKey(nwkKey, joinNonce, joinEUI, devNonce)
+		d.keys.nwkSEncKey = crypto.DeriveNwkSEncKey(nwkKey, joinNonce, joinEUI, devNonce)
+		d.macVersion = d.scenario.LoRaWANVersion
+	} else {
+		netID := types.MustNetID(joinAcceptPayload.NetId).OrZero()
+		d.keys.appSKey = crypto.DeriveLegacyAppSKey(key, joinNonce, netID, devNonce)
+		nwkSKey := crypto.DeriveLegacyNwkSKey(key, joinNonce, netID, devNonce)
+		d.keys.fNwkSIntKey, d.keys.sNwkSIntKey, d.keys.nwkSEncKey = nwkSKey, nwkSKey, nwkSKey
+		d.macVersion = d.scenario.LoRaWANVersion
+		if macspec.UseNwkKey(d.macVersion) {
+			// A LoRaWAN 1.1 end device falls back to LoRaWAN 1.0 if the Join Server does not negotiate 1.1.
+			d.macVersion = ttnpb.MACVersion_MAC_V1_0_3
+		}
+	}
+	d.devAddr = types.MustDevAddr(joinAcceptPayload.DevAddr).OrZero()
+	d.joined = true
+	d.fCntUp, d.confFCntUp, d.confFCntDown, d.ack = 0, 0, 0, false
+	d.lastNFCntDown, d.lastAFCntDown = nil, nil
+	d.rekeyConfirmed, d.deviceModeConfirmed = false, false
+	d.answers, d.stickyAnswers = nil, nil
+	d.dataRateIndex = d.scenario.DataRateIndex
+	return nil
+}
+
+// Joined returns whether the end device is joined and its DevAddr.
+func (d *device) Joined() (types.DevAddr, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.devAddr, d.joined
+}
+
+// DataUplink returns a new data uplink with the given application payload.
+// Pending MAC command answers are sent in FOpts, or on FPort 0 if they do not fit.
+func (d *device) DataUplink(confirmed bool, frmPayload []byte, rnd *rand.Rand) (*uplink, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.joined {
+		return nil, errNotJoined.New()
+	}
+	chIdx, ch, err := d.channel(rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	cmds := append(append([]*ttnpb.MACCommand(nil), d.stickyAnswers...), d.answers...)
+	if macspec.UseRekeyInd(d.macVersion) && !d.rekeyConfirmed {
+		cmds = append(cmds, (&ttnpb.MACCommand_RekeyInd{MinorVersion: ttnpb.Minor_MINOR_1}).MACCommand())
+	}
+	if d.scenario.ClassC && macspec.UseDeviceModeInd(d.macVersion) && !d.deviceModeConfirmed {
+		cmds = append(cmds, (&ttnpb.MACCommand_DeviceModeInd{Class: ttnpb.Class_CLASS_C}).MACCommand())
+	}
+	d.answers = nil
+	var cmdBuf []byte
+	for _, cmd := range cmds {
+		if cmdBuf, err = lorawan.DefaultMACCommands.AppendUplink(*d.phy, cmdBuf, cmd); err != nil {
+			return nil, err
+		}
+	}
+
+	fCnt, fPort := d.fCntUp, d.scenario.FPort
+	var fOpts []byte
+	switch {
+	case len(cmdBuf) > maxFOptsLen:
+		if frmPayload, err = crypto.EncryptUplink(d.keys.nwkSEncKey, d.devAddr, fCnt, cmdBuf); err != nil {
+			return nil, err
+		}
+		fPort = 0
+	case len(cmdBuf) > 0 && macspec.EncryptFOpts(d.macVersion):
+		encOpts := macspec.EncryptionOptions(d.macVersion, macspec.UplinkFrame, fPort, true)
+		if fOpts, err = crypto.EncryptUplink(d.keys.nwkSEncKey, d.devAddr, fCnt, cmdBuf, encOpts...); err != nil {
+			return nil, err
+		}
+		fallthrough
+	default:
+		if len(cmdBuf) > 0 && fOpts == nil {
+			fOpts = cmdBuf
+		}
+		if frmPayload, err = crypto.EncryptUplink(d.keys.appSKey, d.devAddr, fCnt, frmPayload); err != nil {
+			return nil, err
+		}
+	}
+	if len(fOpts) > maxFOptsLen {
+		return nil, errMACCommandLen.New()
+	}
+
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	msg := &ttnpb.Message{
+		MHdr: &ttnpb.MHDR{
+			MType: mType,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MacPayload{
+			MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{
+					DevAddr: d.devAddr.Bytes(),
+					FCtrl: &ttnpb.FCtrl{
+						Adr: true,
+						Ack: d.ack,
+					},
+					FCnt:  fCnt & 0xffff,
+					FOpts: fOpts,
+				},
+				FPort:      fPort,
+				FrmPayload: frmPayload,
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	var mic [4]byte
+	if macspec.UseLegacyMIC(d.macVersion) {
+		mic, err = crypto.ComputeLegacyUplinkMIC(d.keys.fNwkSIntKey, d.devAddr, fCnt, buf)
+	} else {
+		var confFCnt uint32
+		if d.ack {
+			confFCnt = d.confFCntDown
+		}
+		mic, err = crypto.ComputeUplinkMIC(
+			d.keys.sNwkSIntKey, d.keys.fNwkSIntKey, confFCnt,
+			uint8(d.dataRateIndex), uint8(chIdx), d.devAddr, fCnt, buf,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.ack = false
+	d.fCntUp++
+	if confirmed {
+		d.confFCntUp = fCnt
+	}
+	return &uplink{
+		rawPayload:   append(buf, mic[:]...),
+		dataRate:     d.phy.DataRates[d.dataRateIndex].Rate,
+		frequency:    ch.Frequency,
+		channelIndex: chIdx,
+		confirmed:    confirmed,
+	}, nil
+}
+
+// fullFCnt returns the full 32-bit frame counter of the 16-bit frame counter fCnt,
+// given the last received frame counter.
+func fullFCnt(last *uint32, fCnt uint32) (uint32, error) {
+	if last == nil {
+		return fCnt, nil
+	}
+	full := *last&^0xffff | fCnt&0xffff
+	if full <= *last {
+		full += 0x10000
+	}
+	if full-*last > 0x8000 {
+		return 0, errFCntReplay.WithAttributes("f_cnt", fCnt)
+	}
+	return full, nil
+}
+
+// HandleDataDownlink handles the data downlink message. The message is verified and decrypted, and the MAC
+// commands in the downlink are answered in the next uplink.
+func (d *device) HandleDataDownlink(rawPayload []byte, msg *ttnpb.Message) (*downlinkResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.joined {
+		return nil, errNotJoined.New()
+	}
+	macPayload := msg.GetMacPayload()
+	lastFCnt := &d.lastNFCntDown
+	if !macspec.UseSharedFCntDown(d.macVersion) && macPayload.FPort > 0 {
+		lastFCnt = &d.lastAFCntDown
+	}
+	fCnt, err := fullFCnt(*lastFCnt, macPayload.FHdr.FCnt)
+	if err != nil {
+		return nil, err
+	}
+
+	var mic [4]byte
+	if macspec.UseLegacyMIC(d.macVersion) {
+		mic, err = crypto.ComputeLegacyDownlinkMIC(d.keys.sNwkSIntKey, d.devAddr, fCnt, rawPayload[:len(rawPayload)-4])
+	} else {
+		var confFCnt uint32
+		if macPayload.FHdr.FCtrl.GetAck() {
+			confFCnt = d.confFCntUp
+		}
+		mic, err = crypto.ComputeDownlinkMIC(d.keys.sNwkSIntKey, d.devAddr, confFCnt, fCnt, rawPayload[:len(rawPayload)-4])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(msg.Mic, mic[:]) {
+		return nil, errMICMismatch.New()
+	}
+	*lastFCnt = &fCnt
+
+	cmdBuf := macPayload.FHdr.FOpts
+	if len(cmdBuf) > 0 && macspec.EncryptFOpts(d.macVersion) {
+		encOpts := macspec.EncryptionOptions(d.macVersion, macspec.DownlinkFrame, macPayload.FPort, true)
+		if cmdBuf, err = crypto.DecryptDownlink(d.keys.nwkSEncKey, d.devAddr, fCnt, cmdBuf, encOpts...); err != nil {
+			return nil, err
+		}
+	}
+	if macPayload.FPort == 0 && len(macPayload.FrmPayload) > 0 {
+		if cmdBuf, err = crypto.DecryptDownlink(d.keys.nwkSEncKey, d.devAddr, fCnt, macPayload.FrmPayload); err != nil {
+			return nil, err
+		}
+	}
+	var cmds []*ttnpb.MACCommand
+	for r := bytes.NewReader(cmdBuf); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		if err := lorawan.DefaultMACCommands.ReadDownlink(*d.phy, r, cmd); err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	res := &downlinkResult{
+		ack:         macPayload.FHdr.FCtrl.GetAck(),
+		confirmed:   msg.MHdr.MType == ttnpb.MType_CONFIRMED_DOWN,
+		macCommands: len(cmds),
+	}
+	if res.confirmed {
+		d.ack, d.confFCntDown = true, fCnt
+	}
+	d.stickyAnswers = nil
+	d.answerMACCommands(cmds)
+	return res, nil
+}
+
+func (d *device) margin() int32 {
+	var snr float32
+	for _, l := range d.links {
+		snr += l.snr
+	}
+	margin := int32(snr / float32(len(d.links)))
+	switch {
+	case margin < -32:
+		return -32
+	case margin > 31:
+		return 31
+	}
+	return margin
+}
+
+// answerMACCommands queues the answers to the given MAC commands.
+// All requests are accepted. Channel masks and new channels are acknowledged but the uplink channels do not change.
+func (d *device) answerMACCommands(cmds []*ttnpb.MACCommand) {
+	var linkADRReqs int
+	for _, cmd := range cmds {
+		switch cmd.Cid {
+		case ttnpb.MACCommandIdentifier_CID_LINK_ADR:
+			linkADRReqs++
+			req := cmd.GetLinkAdrReq()
+			noChange := macspec.HasNoChangeADRIndices(d.macVersion) && req.DataRateIndex == ttnpb.DataRateIndex_DATA_RATE_15
+			if _, ok := d.phy.DataRates[req.DataRateIndex]; ok && !noChange {
+				d.dataRateIndex = req.DataRateIndex
+			}
+		case ttnpb.MACCommandIdentifier_CID_DEV_STATUS:
+			d.answers = append(d.answers, (&ttnpb.MACCommand_DevStatusAns{
+				Battery: d.scenario.Battery,
+				Margin:  d.margin(),
+			}).MACCommand())
+		case ttnpb.MACCommandIdentifier_CID_RX_PARAM_SETUP:
+			d.stickyAnswers = append(d.stickyAnswers, (&ttnpb.MACCommand_RxParamSetupAns{
+				Rx2DataRateIndexAck:  true,
+				Rx1DataRateOffsetAck: true,
+				Rx2FrequencyAck:      true,
+			}).MACCommand())
+		case ttnpb.MACCommandIdentifier_CID_RX_TIMING_SETUP:
+			d.stickyAnswers = append(d.stickyAnswers, &ttnpb.MACCommand{Cid: cmd.Cid})
+		case ttnpb.MACCommandIdentifier_CID_DL_CHANNEL:
+			d.stickyAnswers = append(d.stickyAnswers, (&ttnpb.MACCommand_DLChannelAns{
+				ChannelIndexAck: true,
+				FrequencyAck:    true,
+			}).MACCommand())
+		case ttnpb.MACCommandIdentifier_CID_NEW_CHANNEL:
+			d.answers = append(d.answers, (&ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+				DataRateAck:  true,
+			}).MACCommand())
+		case ttnpb.MACCommandIdentifier_CID_REJOIN_PARAM_SETUP:
+			d.answers = append(d.answers, (&ttnpb.MACCommand_RejoinParamSetupAns{
+				MaxTimeExponentAck: true,
+			}).MACCommand())
+		case ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE,
+			ttnpb.MACCommandIdentifier_CID_TX_PARAM_SETUP,
+			ttnpb.MACCommandIdentifier_CID_ADR_PARAM_SETUP:
+			d.answers = append(d.answers, &ttnpb.MACCommand{Cid: cmd.Cid})
+		case ttnpb.MACCommandIdentifier_CID_REKEY:
+			d.rekeyConfirmed = true
+		case ttnpb.MACCommandIdentifier_CID_DEVICE_MODE:
+			d.deviceModeConfirmed = true
+		}
+	}
+	if linkADRReqs == 0 {
+		return
+	}
+	if !macspec.AllowDuplicateLinkADRAns(d.macVersion) {
+		linkADRReqs = 1
+	}
+	for i := 0; i < linkADRReqs; i++ {
+		d.answers = append(d.answers, (&ttnpb.MACCommand_LinkADRAns{
+			ChannelMaskAck:   true,
+			DataRateIndexAck: true,
+			TxPowerIndexAck:  true,
+		}).MACCommand())
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	testAppKey  = types.AES128Key{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	testJoinEUI = types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	testDevEUI  = types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	testDevAddr = types.DevAddr{0x01, 0x02, 0x03, 0x04}
+
+	// testAppSKey and testNwkSKey are the LoRaWAN 1.0 session keys derived from testAppKey, JoinNonce 0x010203,
+	// NetID 0x010203 and DevNonce 0x0102.
+	testAppSKey = types.AES128Key{0xc6, 0xc6, 0x49, 0x54, 0x3f, 0x7b, 0xbb, 0x9a, 0x7c, 0x61, 0x58, 0xf6, 0x26, 0x3c, 0xc7, 0xcf}
+	testNwkSKey = types.AES128Key{0x38, 0xe0, 0xc1, 0xd1, 0x50, 0x3b, 0xd4, 0xc1, 0x6b, 0x29, 0xbb, 0x09, 0xd6, 0xef, 0xf4, 0x44}
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("Failed to decode hex: %v", err)
+	}
+	return b
+}
+
+func newTestDevice(t *testing.T, macVersion ttnpb.MACVersion) *device {
+	t.Helper()
+	phy, err := band.Get(band.EU_863_870, ttnpb.PHYVersion_RP001_V1_0_3_REV_A)
+	if err != nil {
+		t.Fatalf("Failed to get band: %v", err)
+	}
+	scenario := &EndDeviceScenario{
+		LoRaWANVersion: macVersion,
+		JoinEUI:        testJoinEUI,
+		AppKey:         testAppKey,
+		DevNonce:       0x0101,
+		FPort:          1,
+		DataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_5,
+		Battery:        254,
+	}
+	return newDevice(scenario, &phy, phy.UplinkChannels, testDevEUI, []link{{snr: 7.5}}, rand.New(rand.NewSource(1)))
+}
+
+// newTestDownlink returns a LoRaWAN 1.0 data downlink, of which the MIC is computed with testNwkSKey.
+func newTestDownlink(t *testing.T, fCnt uint32, fOpts []byte) ([]byte, *ttnpb.Message) {
+	t.Helper()
+	buf, err := lorawan.MarshalMessage(&ttnpb.Message{
+		MHdr: &ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_DOWN, Major: ttnpb.Major_LORAWAN_R1},
+		Payload: &ttnpb.Message_MacPayload{
+			MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{
+					DevAddr: testDevAddr.Bytes(),
+					FCtrl:   &ttnpb.FCtrl{},
+					FCnt:    fCnt & 0xffff,
+					FOpts:   fOpts,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal downlink: %v", err)
+	}
+	mic, err := crypto.ComputeLegacyDownlinkMIC(testNwkSKey, testDevAddr, fCnt, buf)
+	if err != nil {
+		t.Fatalf("Failed to compute downlink MIC: %v", err)
+	}
+	rawPayload := append(buf, mic[:]...)
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rawPayload, msg); err != nil {
+		t.Fatalf("Failed to unmarshal downlink: %v", err)
+	}
+	return rawPayload, msg
+}
+
+func TestDeviceJoin(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	d := newTestDevice(t, ttnpb.MACVersion_MAC_V1_0_3)
+	rnd := rand.New(rand.NewSource(1))
+
+	up, err := d.JoinRequest(rnd)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.join, should.BeTrue)
+	a.So(up.rawPayload, should.Resemble, mustDecodeHex(t,
+		"00"+ // MHDR
+			"0807060504030201"+ // JoinEUI
+			"0807060504030201"+ // DevEUI
+			"0201"+ // DevNonce
+			"e6e10c55", // MIC
+	))
+
+	// The join-accept is not accepted if the MIC does not match.
+	joinAccept := mustDecodeHex(t, "20"+"c9fbb259e11649096a568a9e3b7117c3")
+	tampered := append([]byte(nil), joinAccept...)
+	tampered[len(tampered)-1] ^= 0xff
+	err = d.HandleJoinAccept(tampered)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, joined := d.Joined()
+	a.So(joined, should.BeFalse)
+
+	// JoinNonce 0x010203, NetID 0x010203, DevAddr 01020304, RX1DROffset 0, RX2 data rate 0, RxDelay 1.
+	a.So(d.HandleJoinAccept(joinAccept), should.BeNil)
+	devAddr, joined := d.Joined()
+	a.So(joined, should.BeTrue)
+	a.So(devAddr, should.Equal, testDevAddr)
+	a.So(d.keys.appSKey, should.Equal, testAppSKey)
+	a.So(d.keys.fNwkSIntKey, should.Equal, testNwkSKey)
+	a.So(d.keys.sNwkSIntKey, should.Equal, testNwkSKey)
+	a.So(d.keys.nwkSEncKey, should.Equal, testNwkSKey)
+
+	up, err = d.DataUplink(false, []byte{0x01, 0x02, 0x03, 0x04}, rnd)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.rawPayload, should.Resemble, mustDecodeHex(t,
+		"40"+ // MHDR
+			"04030201"+ // DevAddr
+			"80"+ // FCtrl (ADR)
+			"0000"+ // FCnt
+			"01"+ // FPort
+			"039ad307"+ // FRMPayload
+			"eb68e56d", // MIC
+	))
+}
+
+func TestDeviceMACCommands(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	d := newTestDevice(t, ttnpb.MACVersion_MAC_V1_0_3)
+	rnd := rand.New(rand.NewSource(1))
+	if _, err := d.JoinRequest(rnd); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if err := d.HandleJoinAccept(mustDecodeHex(t, "20"+"c9fbb259e11649096a568a9e3b7117c3")); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if _, err := d.DataUplink(false, []byte{0x01, 0x02, 0x03, 0x04}, rnd); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	rawPayload := mustDecodeHex(t,
+		"60"+ // MHDR
+			"04030201"+ // DevAddr
+			"06"+ // FCtrl (FOptsLen 6)
+			"0000"+ // FCnt
+			"0331070001"+ // LinkADRReq: DR3, TXPower 1, ChMask 0x0007, NbTrans 1
+			"06"+ // DevStatusReq
+			"a6c2ffac", // MIC
+	)
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rawPayload, msg); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	res, err := d.HandleDataDownlink(rawPayload, msg)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.macCommands, should.Equal, 2)
+	a.So(res.confirmed, should.BeFalse)
+	a.So(d.dataRateIndex, should.Equal, ttnpb.DataRateIndex_DATA_RATE_3)
+
+	up, err := d.DataUplink(false, []byte{0x01, 0x02, 0x03, 0x04}, rnd)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.dataRate, should.Resemble, d.phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_3].Rate)
+	a.So(up.rawPayload, should.Resemble, mustDecodeHex(t,
+		"40"+ // MHDR
+			"04030201"+ // DevAddr
+			"85"+ // FCtrl (ADR, FOptsLen 5)
+			"0100"+ // FCnt
+			"06fe07"+ // DevStatusAns: battery 254, margin 7
+			"0307"+ // LinkADRAns: all acknowledged
+			"01"+ // FPort
+			"88c0ee4e"+ // FRMPayload
+			"9f6a697e", // MIC
+	))
+
+	// The answers are only sent once.
+	up, err = d.DataUplink(false, nil, rnd)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	upMsg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(up.rawPayload, upMsg); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(upMsg.GetMacPayload().FHdr.FOpts, should.BeEmpty)
+
+	// A replayed downlink is rejected.
+	_, err = d.HandleDataDownlink(rawPayload, msg)
+	a.So(err, should.NotBeNil)
+}
+
+func TestDeviceLinkADRAns(t *testing.T) {
+	t.Parallel()
+
+	linkADRReq := func(drIdx ttnpb.DataRateIndex) *ttnpb.MACCommand {
+		return (&ttnpb.MACCommand_LinkADRReq{
+			DataRateIndex: drIdx,
+			ChannelMask:   []bool{true, true, true},
+			NbTrans:       1,
+		}).MACCommand()
+	}
+	for _, tc := range []struct {
+		Name          string
+		MACVersion    ttnpb.MACVersion
+		Commands      []*ttnpb.MACCommand
+		LinkADRAns    int
+		DataRateIndex ttnpb.DataRateIndex
+	}{
+		{
+			Name:          "1.0.3/Single",
+			MACVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+			Commands:      []*ttnpb.MACCommand{linkADRReq(ttnpb.DataRateIndex_DATA_RATE_2)},
+			LinkADRAns:    1,
+			DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_2,
+		},
+		{
+			Name:       "1.0.3/Block",
+			MACVersion: ttnpb.MACVersion_MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				linkADRReq(ttnpb.DataRateIndex_DATA_RATE_2),
+				linkADRReq(ttnpb.DataRateIndex_DATA_RATE_4),
+			},
+			LinkADRAns:    2,
+			DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_4,
+		},
+		{
+			Name:       "1.1/Block",
+			MACVersion: ttnpb.MACVersion_MAC_V1_1,
+			Commands: []*ttnpb.MACCommand{
+				linkADRReq(ttnpb.DataRateIndex_DATA_RATE_2),
+				linkADRReq(ttnpb.DataRateIndex_DATA_RATE_4),
+			},
+			LinkADRAns:    1,
+			DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_4,
+		},
+		{
+			Name:          "1.0.4/NoChange",
+			MACVersion:    ttnpb.MACVersion_MAC_V1_0_4,
+			Commands:      []*ttnpb.MACCommand{linkADRReq(ttnpb.DataRateIndex_DATA_RATE_15)},
+			LinkADRAns:    1,
+			DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			d := newTestDevice(t, tc.MACVersion)
+			d.macVersion = tc.MACVersion
+			d.answerMACCommands(tc.Commands)
+			a.So(d.dataRateIndex, should.Equal, tc.DataRateIndex)
+			if !a.So(d.answers, should.HaveLength, tc.LinkADRAns) {
+				t.FailNow()
+			}
+			for _, ans := range d.answers {
+				a.So(ans, should.Resemble, (&ttnpb.MACCommand_LinkADRAns{
+					ChannelMaskAck:   true,
+					DataRateIndexAck: true,
+					TxPowerIndexAck:  true,
+				}).MACCommand())
+			}
+		})
+	}
+}
+
+func TestDeviceDevStatusAns(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name   string
+		Links  []link
+		Margin int32
+	}{
+		{Name: "Average", Links: []link{{snr: 4}, {snr: 9}}, Margin: 6},
+		{Name: "Negative", Links: []link{{snr: -12.5}}, Margin: -12},
+		{Name: "Maximum", Links: []link{{snr: 40}}, Margin: 31},
+		{Name: "Minimum", Links: []link{{snr: -40}}, Margin: -32},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			d := newTestDevice(t, ttnpb.MACVersion_MAC_V1_0_3)
+			d.links = tc.Links
+			d.answerMACCommands([]*ttnpb.MACCommand{ttnpb.MACCommandIdentifier_CID_DEV_STATUS.MACCommand()})
+			a.So(d.answers, should.Resemble, []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DevStatusAns{Battery: 254, Margin: tc.Margin}).MACCommand(),
+			})
+		})
+	}
+}
+
+func TestFullFCnt(t *testing.T) {
+	t.Parallel()
+
+	last := func(fCnt uint32) *uint32 { return &fCnt }
+	for _, tc := range []struct {
+		Name     string
+		Last     *uint32
+		FCnt     uint32
+		FullFCnt uint32
+		Replay   bool
+	}{
+		{Name: "First", FCnt: 0x0005, FullFCnt: 0x0005},
+		{Name: "Next", Last: last(0x0005), FCnt: 0x0006, FullFCnt: 0x0006},
+		{Name: "Gap", Last: last(0x0005), FCnt: 0x0105, FullFCnt: 0x0105},
+		{Name: "Rollover", Last: last(0xfffe), FCnt: 0x0001, FullFCnt: 0x10001},
+		{Name: "SecondRollover", Last: last(0x1ffff), FCnt: 0x0000, FullFCnt: 0x20000},
+		{Name: "AfterRollover", Last: last(0x10001), FCnt: 0x0002, FullFCnt: 0x10002},
+		{Name: "Replay", Last: last(0x0005), FCnt: 0x0005, Replay: true},
+		{Name: "Old", Last: last(0x10005), FCnt: 0x0004, Replay: true},
+		{Name: "TooLargeGap", Last: last(0x0005), FCnt: 0x9000, Replay: true},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			fCnt, err := fullFCnt(tc.Last, tc.FCnt)
+			if tc.Replay {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(fCnt, should.Equal, tc.FullFCnt)
+		})
+	}
+}
+
+func TestDeviceFCntRollover(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	d := newTestDevice(t, ttnpb.MACVersion_MAC_V1_0_3)
+	rnd := rand.New(rand.NewSource(1))
+	d.joined, d.devAddr = true, testDevAddr
+	d.keys.appSKey = testAppSKey
+	d.keys.fNwkSIntKey, d.keys.sNwkSIntKey, d.keys.nwkSEncKey = testNwkSKey, testNwkSKey, testNwkSKey
+
+	// The uplink contains the 16 least significant bits of the frame counter, and the MIC is computed with the full
+	// frame counter.
+	d.fCntUp = 0x1ffff
+	for _, fCnt := range []uint32{0x1ffff, 0x20000} {
+		up, err := d.DataUplink(false, nil, rnd)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msg := &ttnpb.Message{}
+		if err := lorawan.UnmarshalMessage(up.rawPayload, msg); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.GetMacPayload().FHdr.FCnt, should.Equal, fCnt&0xffff)
+		mic, err := crypto.ComputeLegacyUplinkMIC(testNwkSKey, testDevAddr, fCnt, up.rawPayload[:len(up.rawPayload)-4])
+		a.So(err, should.BeNil)
+		a.So(msg.Mic, should.Resemble, mic[:])
+	}
+	a.So(d.fCntUp, should.Equal, 0x20001)
+
+	// The downlink frame counter rolls over to the next 16 bits.
+	d.lastNFCntDown = func(fCnt uint32) *uint32 { return &fCnt }(0xffff)
+	rawPayload, msg := newTestDownlink(t, 0x10000, nil)
+	_, err := d.HandleDataDownlink(rawPayload, msg)
+	a.So(err, should.BeNil)
+	if a.So(d.lastNFCntDown, should.NotBeNil) {
+		a.So(*d.lastNFCntDown, should.Equal, 0x10000)
+	}
+
+	// A downlink of which the MIC is computed with the 16-bit frame counter does not match after the rollover.
+	rawPayload, msg = newTestDownlink(t, 0x0001, nil)
+	_, err = d.HandleDataDownlink(rawPayload, msg)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	a.So(*d.lastNFCntDown, should.Equal, 0x10000)
+}
@@ -0,0 +1,448 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// rfJitter is the maximum deviation in dB of the RSSI and SNR of an uplink from the link base values.
+const rfJitter = 2
+
+// gateway is a simulated gateway.
+type gateway struct {
+	eui types.EUI64
+	// start is the time at which the concentrator counter started.
+	start time.Time
+	conn  gatewayConn
+}
+
+// timestamp returns the concentrator timestamp in microseconds at t.
+func (g *gateway) timestamp(t time.Time) int64 {
+	return t.Sub(g.start).Microseconds()
+}
+
+// member is a simulated end device in the fleet.
+type member struct {
+	*device
+	// rnd is only used by the goroutine that runs the end device.
+	rnd    *rand.Rand
+	joined chan struct{}
+
+	mu               sync.Mutex
+	joinSentAt       time.Time
+	uplinkSentAt     time.Time
+	awaitingDownlink bool
+	awaitingAck      bool
+}
+
+// pendingJoin is a join-request received by a gateway.
+type pendingJoin struct {
+	gateway   int
+	timestamp uint32
+}
+
+type fleet struct {
+	scenario *Scenario
+	phy      *band.Band
+	gateways []*gateway
+	members  []*member
+	stats    stats
+
+	mu           sync.Mutex
+	devAddrs     map[types.DevAddr]*member
+	pendingJoins []map[uint32]*member
+}
+
+func addEUI(eui types.EUI64, n int) (res types.EUI64) {
+	binary.BigEndian.PutUint64(res[:], binary.BigEndian.Uint64(eui[:])+uint64(n))
+	return res
+}
+
+func randRange(rnd *rand.Rand, r Range) float32 {
+	return r.Min + rnd.Float32()*(r.Max-r.Min)
+}
+
+func randDuration(rnd *rand.Rand, d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rnd.Int63n(int64(d)))
+}
+
+// uplinkChannels returns the uplink channels of the scenario.
+func uplinkChannels(scenario *Scenario, phy *band.Band) []band.Channel {
+	if len(scenario.Frequencies) == 0 {
+		return phy.UplinkChannels
+	}
+	channels := make([]band.Channel, 0, len(scenario.Frequencies))
+	for _, freq := range scenario.Frequencies {
+		ch := band.Channel{
+			Frequency:   freq,
+			MinDataRate: phy.UplinkChannels[0].MinDataRate,
+			MaxDataRate: phy.UplinkChannels[0].MaxDataRate,
+		}
+		for _, bandCh := range phy.UplinkChannels {
+			if bandCh.Frequency == freq {
+				ch = bandCh
+				break
+			}
+		}
+		channels = append(channels, ch)
+	}
+	return channels
+}
+
+// Run runs the fleet simulation of the scenario until the scenario duration elapses or the context is done.
+// The returned report contains the statistics of the simulation.
+func Run(ctx context.Context, scenario *Scenario) (*Report, error) {
+	phy, err := band.Get(scenario.BandID, scenario.EndDevices.LoRaWANPHYVersion)
+	if err != nil {
+		return nil, err
+	}
+	f := &fleet{
+		scenario:     scenario,
+		phy:          &phy,
+		devAddrs:     make(map[types.DevAddr]*member),
+		pendingJoins: make([]map[uint32]*member, scenario.Gateways.Count),
+	}
+	logger := log.FromContext(ctx)
+
+	for i := 0; i < scenario.Gateways.Count; i++ {
+		i := i
+		gtw := &gateway{
+			eui:   addEUI(scenario.Gateways.StartEUI, i),
+			start: time.Now(),
+		}
+		gtw.conn, err = dialGateway(ctx, &scenario.Gateways, scenario.BandID, gtw.eui, func(down *downlink) {
+			f.handleDownlink(ctx, i, down)
+		})
+		if err != nil {
+			f.closeGateways()
+			return nil, err
+		}
+		f.gateways = append(f.gateways, gtw)
+		f.pendingJoins[i] = make(map[uint32]*member)
+	}
+	defer f.closeGateways()
+	logger.WithField("count", len(f.gateways)).Info("Gateways connected")
+
+	channels := uplinkChannels(scenario, f.phy)
+	seed := rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+	for i := 0; i < scenario.EndDevices.Count; i++ {
+		rnd := rand.New(rand.NewSource(seed.Int63())) //nolint:gosec
+		links := make([]link, 0, scenario.EndDevices.Gateways)
+		for _, gtwIdx := range rnd.Perm(scenario.Gateways.Count)[:scenario.EndDevices.Gateways] {
+			links = append(links, link{
+				gateway: gtwIdx,
+				rssi:    randRange(rnd, scenario.EndDevices.RSSI),
+				snr:     randRange(rnd, scenario.EndDevices.SNR),
+			})
+		}
+		devEUI := addEUI(scenario.EndDevices.StartDevEUI, i)
+		f.members = append(f.members, &member{
+			device: newDevice(&scenario.EndDevices, f.phy, channels, devEUI, links, rnd),
+			rnd:    rnd,
+			joined: make(chan struct{}, 1),
+		})
+	}
+
+	start := time.Now()
+	runCtx, cancel := context.WithTimeout(ctx, scenario.Duration)
+	defer cancel()
+	wg := sync.WaitGroup{}
+	for _, m := range f.members {
+		m := m
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f.runDevice(runCtx, m)
+		}()
+	}
+	wg.Wait()
+
+	// Wait for the downlinks of the last uplinks.
+	select {
+	case <-ctx.Done():
+	case <-time.After(f.phy.JoinAcceptDelay2 + time.Second):
+	}
+
+	report := f.stats.report()
+	report.Duration = time.Since(start).Round(time.Second).String()
+	report.Gateways = len(f.gateways)
+	report.EndDevices = len(f.members)
+	for _, m := range f.members {
+		if _, ok := m.Joined(); ok {
+			report.JoinedEndDevices++
+		}
+	}
+	return report, nil
+}
+
+func (f *fleet) closeGateways() {
+	for _, gtw := range f.gateways {
+		gtw.conn.Close()
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (f *fleet) runDevice(ctx context.Context, m *member) {
+	if !sleep(ctx, randDuration(m.rnd, f.scenario.EndDevices.StartSpread)) {
+		return
+	}
+	if !f.join(ctx, m) {
+		return
+	}
+	interval := f.scenario.EndDevices.UplinkInterval
+	for {
+		// The uplink interval varies between 50% and 150% of the configured interval.
+		if !sleep(ctx, interval/2+randDuration(m.rnd, interval)) {
+			return
+		}
+		f.sendDataUplink(ctx, m)
+	}
+}
+
+// join joins the end device. It returns false if the context is done before the end device joined.
+func (f *fleet) join(ctx context.Context, m *member) bool {
+	logger := log.FromContext(ctx).WithField("dev_eui", m.devEUI)
+	for {
+		select {
+		case <-m.joined:
+		default:
+		}
+		up, err := m.JoinRequest(m.rnd)
+		if err != nil {
+			f.stats.errors.Add(1)
+			logger.WithError(err).Debug("Failed to create join-request")
+			return false
+		}
+		m.mu.Lock()
+		m.joinSentAt = time.Now()
+		m.mu.Unlock()
+		f.stats.joinRequests.Add(1)
+		pending := f.transmit(ctx, m, up)
+
+		timer := time.NewTimer(f.scenario.EndDevices.JoinTimeout)
+		var joined, done bool
+		select {
+		case <-m.joined:
+			joined = true
+		case <-timer.C:
+		case <-ctx.Done():
+			done = true
+		}
+		timer.Stop()
+		f.mu.Lock()
+		for _, p := range pending {
+			delete(f.pendingJoins[p.gateway], p.timestamp)
+		}
+		f.mu.Unlock()
+		if joined {
+			return true
+		}
+		if done {
+			return false
+		}
+	}
+}
+
+func (f *fleet) sendDataUplink(ctx context.Context, m *member) {
+	confirmed := m.rnd.Float32() < f.scenario.EndDevices.Confirmed
+	payload := make([]byte, f.scenario.EndDevices.PayloadSize)
+	m.rnd.Read(payload)
+	up, err := m.DataUplink(confirmed, payload, m.rnd)
+	if err != nil {
+		f.stats.errors.Add(1)
+		log.FromContext(ctx).WithField("dev_eui", m.devEUI).WithError(err).Debug("Failed to create uplink")
+		return
+	}
+	m.mu.Lock()
+	m.uplinkSentAt = time.Now()
+	m.awaitingDownlink = true
+	m.awaitingAck = confirmed
+	m.mu.Unlock()
+	f.stats.uplinks.Add(1)
+	if confirmed {
+		f.stats.confirmedUplinks.Add(1)
+	}
+	f.transmit(ctx, m, up)
+}
+
+// transmit sends the uplink through the gateways in range of the end device with simulated RF metadata.
+// For join-requests, transmit returns the concentrator timestamps by which the join-accept is matched.
+func (f *fleet) transmit(ctx context.Context, m *member, up *uplink) []pendingJoin {
+	var (
+		now      = time.Now()
+		pending  []pendingJoin
+		received bool
+	)
+	for _, l := range m.links {
+		if m.rnd.Float32() < f.scenario.EndDevices.Loss {
+			continue
+		}
+		received = true
+		gtw := f.gateways[l.gateway]
+		rssi := l.rssi + (m.rnd.Float32()*2-1)*rfJitter
+		msg := &ttnpb.UplinkMessage{
+			RawPayload: up.rawPayload,
+			Settings: &ttnpb.TxSettings{
+				DataRate:  up.dataRate,
+				Frequency: up.frequency,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIds:   &ttnpb.GatewayIdentifiers{Eui: gtw.eui.Bytes()},
+				Rssi:         rssi,
+				ChannelRssi:  rssi,
+				Snr:          l.snr + (m.rnd.Float32()*2-1)*rfJitter,
+				ChannelIndex: uint32(up.channelIndex),
+				Time:         timestamppb.New(now),
+			}},
+		}
+		timestamp := gtw.timestamp(now)
+		if up.join {
+			p := pendingJoin{gateway: l.gateway, timestamp: uint32(timestamp)}
+			f.mu.Lock()
+			f.pendingJoins[p.gateway][p.timestamp] = m
+			f.mu.Unlock()
+			pending = append(pending, p)
+		}
+		if err := gtw.conn.SendUplink(msg, timestamp); err != nil {
+			f.stats.errors.Add(1)
+			log.FromContext(ctx).WithField("gateway_eui", gtw.eui).WithError(err).Debug("Failed to send uplink")
+		}
+	}
+	if !received {
+		f.stats.lostUplinks.Add(1)
+	}
+	return pending
+}
+
+func (f *fleet) handleDownlink(ctx context.Context, gtwIdx int, down *downlink) {
+	f.stats.downlinks.Add(1)
+	logger := log.FromContext(ctx).WithField("gateway_eui", f.gateways[gtwIdx].eui)
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(down.rawPayload, msg); err != nil {
+		f.stats.invalidDownlinks.Add(1)
+		logger.WithError(err).Debug("Failed to unmarshal downlink")
+		return
+	}
+	switch msg.MHdr.MType {
+	case ttnpb.MType_JOIN_ACCEPT:
+		f.handleJoinAccept(ctx, gtwIdx, down)
+	case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+		f.handleDataDownlink(ctx, down, msg)
+	default:
+		f.stats.unmatchedDownlinks.Add(1)
+	}
+}
+
+func (f *fleet) handleJoinAccept(ctx context.Context, gtwIdx int, down *downlink) {
+	// Join-accepts are encrypted, so they are matched to the join-request by the receive window timestamps.
+	var m *member
+	f.mu.Lock()
+	for _, delay := range []time.Duration{f.phy.JoinAcceptDelay1, f.phy.JoinAcceptDelay2} {
+		if m = f.pendingJoins[gtwIdx][down.timestamp-uint32(delay/time.Microsecond)]; m != nil {
+			break
+		}
+	}
+	f.mu.Unlock()
+	if m == nil {
+		f.stats.unmatchedDownlinks.Add(1)
+		return
+	}
+	oldDevAddr, joined := m.Joined()
+	if joined {
+		// The end device already accepted a join-accept for the join-request.
+		f.stats.unmatchedDownlinks.Add(1)
+		return
+	}
+	if err := m.HandleJoinAccept(down.rawPayload); err != nil {
+		f.stats.invalidDownlinks.Add(1)
+		log.FromContext(ctx).WithField("dev_eui", m.devEUI).WithError(err).Debug("Failed to handle join-accept")
+		return
+	}
+	devAddr, _ := m.Joined()
+	f.mu.Lock()
+	if f.devAddrs[oldDevAddr] == m {
+		delete(f.devAddrs, oldDevAddr)
+	}
+	f.devAddrs[devAddr] = m
+	f.mu.Unlock()
+
+	m.mu.Lock()
+	f.stats.addJoinLatency(time.Since(m.joinSentAt))
+	m.mu.Unlock()
+	f.stats.joinAccepts.Add(1)
+	select {
+	case m.joined <- struct{}{}:
+	default:
+	}
+}
+
+func (f *fleet) handleDataDownlink(ctx context.Context, down *downlink, msg *ttnpb.Message) {
+	devAddr := types.MustDevAddr(msg.GetMacPayload().GetFHdr().GetDevAddr()).OrZero()
+	f.mu.Lock()
+	m := f.devAddrs[devAddr]
+	f.mu.Unlock()
+	if m == nil {
+		f.stats.unmatchedDownlinks.Add(1)
+		return
+	}
+	res, err := m.HandleDataDownlink(down.rawPayload, msg)
+	if err != nil {
+		if errors.IsInvalidArgument(err) {
+			f.stats.invalidDownlinks.Add(1)
+		} else {
+			f.stats.errors.Add(1)
+		}
+		log.FromContext(ctx).WithField("dev_eui", m.devEUI).WithError(err).Debug("Failed to handle downlink")
+		return
+	}
+	f.stats.macCommands.Add(uint64(res.macCommands))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.awaitingDownlink {
+		f.stats.addDownlinkLatency(time.Since(m.uplinkSentAt))
+		m.awaitingDownlink = false
+	}
+	if res.ack && m.awaitingAck {
+		f.stats.acknowledgements.Add(1)
+		m.awaitingAck = false
+	}
+}
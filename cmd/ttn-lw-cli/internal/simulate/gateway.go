@@ -0,0 +1,312 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/semtechws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/semtechws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var errDiscover = errors.DefineUnavailable("discover", "discover LNS endpoint: {error}")
+
+// downlink is a downlink message received by a simulated gateway.
+type downlink struct {
+	rawPayload []byte
+	// timestamp is the concentrator timestamp in microseconds at which the downlink is transmitted.
+	// The timestamp is zero for downlinks that are not transmitted on a concentrator timestamp.
+	timestamp uint32
+}
+
+// gatewayConn is the connection of a simulated gateway with the Gateway Server.
+type gatewayConn interface {
+	// SendUplink sends the uplink message. The timestamp is the concentrator timestamp in microseconds.
+	SendUplink(up *ttnpb.UplinkMessage, timestamp int64) error
+	// Close closes the connection.
+	Close() error
+}
+
+// downlinkHandler handles downlink messages received by a gateway.
+type downlinkHandler func(*downlink)
+
+func dialGateway(
+	ctx context.Context, scenario *GatewayScenario, bandID string, eui types.EUI64, handler downlinkHandler,
+) (gatewayConn, error) {
+	switch scenario.Protocol {
+	case ProtocolUDP:
+		return dialUDP(ctx, scenario, eui, handler)
+	case ProtocolBasicStation:
+		return dialBasicStation(ctx, scenario, bandID, eui, handler)
+	default:
+		return nil, errProtocol.WithAttributes("protocol", scenario.Protocol)
+	}
+}
+
+// udpGateway is a Semtech UDP packet forwarder.
+type udpGateway struct {
+	conn    *net.UDPConn
+	eui     types.EUI64
+	token   atomic.Uint32
+	handler downlinkHandler
+	cancel  context.CancelFunc
+}
+
+func dialUDP(ctx context.Context, scenario *GatewayScenario, eui types.EUI64, handler downlinkHandler) (*udpGateway, error) {
+	addr, err := net.ResolveUDPAddr("udp", scenario.Address)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	g := &udpGateway{
+		conn:    conn,
+		eui:     eui,
+		handler: handler,
+		cancel:  cancel,
+	}
+	if err := g.write(udp.PullData, nil); err != nil {
+		cancel()
+		conn.Close()
+		return nil, err
+	}
+	go g.readLoop()
+	go g.keepAlive(ctx, scenario.KeepAliveInterval)
+	return g, nil
+}
+
+func (g *udpGateway) write(packetType udp.PacketType, data *udp.Data) error {
+	token := uint16(g.token.Add(1))
+	return g.writeToken(packetType, [2]byte{byte(token >> 8), byte(token)}, data)
+}
+
+func (g *udpGateway) writeToken(packetType udp.PacketType, token [2]byte, data *udp.Data) error {
+	buf, err := udp.Packet{
+		ProtocolVersion: udp.Version2,
+		Token:           token,
+		PacketType:      packetType,
+		GatewayEUI:      &g.eui,
+		Data:            data,
+	}.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = g.conn.Write(buf)
+	return err
+}
+
+func (g *udpGateway) keepAlive(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Errors are transient; the next PULL_DATA is sent on the next tick.
+			_ = g.write(udp.PullData, nil)
+		}
+	}
+}
+
+func (g *udpGateway) readLoop() {
+	buf := make([]byte, 65507)
+	for {
+		n, err := g.conn.Read(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		var packet udp.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			continue
+		}
+		if packet.PacketType != udp.PullResp || packet.Data == nil || packet.Data.TxPacket == nil {
+			continue
+		}
+		tx := packet.Data.TxPacket
+		rawPayload, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(tx.Data, "="))
+		if err != nil {
+			continue
+		}
+		_ = g.writeToken(udp.TxAck, packet.Token, &udp.Data{
+			TxPacketAck: &udp.TxPacketAck{Error: udp.TxErrNone},
+		})
+		g.handler(&downlink{
+			rawPayload: rawPayload,
+			timestamp:  tx.Tmst,
+		})
+	}
+}
+
+// SendUplink implements gatewayConn.
+func (g *udpGateway) SendUplink(up *ttnpb.UplinkMessage, timestamp int64) error {
+	up.RxMetadata[0].Timestamp = uint32(timestamp)
+	rxs, _, _ := udp.FromGatewayUp(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
+	return g.write(udp.PushData, &udp.Data{RxPacket: rxs})
+}
+
+// Close implements gatewayConn.
+func (g *udpGateway) Close() error {
+	g.cancel()
+	return g.conn.Close()
+}
+
+// basicStationGateway is a LoRa Basics Station gateway using the LNS protocol.
+type basicStationGateway struct {
+	bandID  string
+	eui     types.EUI64
+	handler downlinkHandler
+
+	writeMu sync.Mutex
+	conn    *websocket.Conn
+}
+
+func dialBasicStation(
+	ctx context.Context, scenario *GatewayScenario, bandID string, eui types.EUI64, handler downlinkHandler,
+) (*basicStationGateway, error) {
+	header := http.Header{}
+	if scenario.APIKey != "" {
+		header.Set("Authorization", "Bearer "+scenario.APIKey)
+	}
+	conn, _, err := websocket.DefaultDialer.DialContext(
+		ctx, strings.TrimSuffix(scenario.Address, "/")+"/router-info", header,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.WriteJSON(lbslns.DiscoverQuery{EUI: id6.EUI{EUI64: eui}}); err != nil {
+		return nil, err
+	}
+	var res lbslns.DiscoverResponse
+	if err := conn.ReadJSON(&res); err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, errDiscover.WithAttributes("error", res.Error)
+	}
+
+	trafficConn, _, err := websocket.DefaultDialer.DialContext(ctx, res.URI, header)
+	if err != nil {
+		return nil, err
+	}
+	g := &basicStationGateway{
+		bandID:  bandID,
+		eui:     eui,
+		handler: handler,
+		conn:    trafficConn,
+	}
+	if err := g.write(lbslns.Version{
+		Station:  "ttn-lw-cli-simulate",
+		Firmware: "1.0.0",
+		Package:  "1.0.0",
+		Model:    "simulated",
+		Protocol: 2,
+	}); err != nil {
+		trafficConn.Close()
+		return nil, err
+	}
+	go g.readLoop()
+	return g, nil
+}
+
+func (g *basicStationGateway) write(msg any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+	return g.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (g *basicStationGateway) readLoop() {
+	for {
+		_, data, err := g.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		typ, err := lbslns.Type(data)
+		if err != nil || typ != lbslns.TypeDownstreamDownlinkMessage {
+			continue
+		}
+		var dnmsg lbslns.DownlinkMessage
+		if err := json.Unmarshal(data, &dnmsg); err != nil {
+			continue
+		}
+		rawPayload, err := hex.DecodeString(dnmsg.Pdu)
+		if err != nil {
+			continue
+		}
+		down := &downlink{rawPayload: rawPayload}
+		var xTime int64
+		if ts := dnmsg.TimestampDownlinkMessage; ts != nil {
+			// The lower 48 bits of the XTime are the concentrator timestamp of the receive window.
+			xTime = ts.XTime
+			down.timestamp = uint32(ts.XTime&(1<<48-1) + int64(ts.RxDelay)*int64(time.Second/time.Microsecond))
+		}
+		_ = g.write(lbslns.TxConfirmation{
+			Diid:   dnmsg.Diid,
+			RCtx:   dnmsg.RCtx,
+			XTime:  xTime,
+			TxTime: float64(time.Now().UnixNano()) / float64(time.Second),
+		})
+		g.handler(down)
+	}
+}
+
+// SendUplink implements gatewayConn.
+func (g *basicStationGateway) SendUplink(up *ttnpb.UplinkMessage, timestamp int64) error {
+	up.RxMetadata[0].Timestamp = uint32(timestamp)
+	if up.RawPayload[0]>>5 == byte(ttnpb.MType_JOIN_REQUEST) {
+		var jreq lbslns.JoinRequest
+		if err := jreq.FromUplinkMessage(up, g.bandID); err != nil {
+			return err
+		}
+		jreq.UpInfo.XTime = timestamp
+		return g.write(jreq)
+	}
+	var updf lbslns.UplinkDataFrame
+	if err := updf.FromUplinkMessage(up, g.bandID); err != nil {
+		return err
+	}
+	updf.UpInfo.XTime = timestamp
+	return g.write(updf)
+}
+
+// Close implements gatewayConn.
+func (g *basicStationGateway) Close() error {
+	return g.conn.Close()
+}
@@ -0,0 +1,141 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Latency contains latency statistics.
+type Latency struct {
+	Count int    `json:"count"`
+	Min   string `json:"min"`
+	Mean  string `json:"mean"`
+	P50   string `json:"p50"`
+	P90   string `json:"p90"`
+	P99   string `json:"p99"`
+	Max   string `json:"max"`
+}
+
+func newLatency(ds []time.Duration) *Latency {
+	if len(ds) == 0 {
+		return nil
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	percentile := func(p int) string {
+		return sorted[(len(sorted)-1)*p/100].Round(time.Millisecond).String()
+	}
+	return &Latency{
+		Count: len(sorted),
+		Min:   sorted[0].Round(time.Millisecond).String(),
+		Mean:  (sum / time.Duration(len(sorted))).Round(time.Millisecond).String(),
+		P50:   percentile(50),
+		P90:   percentile(90),
+		P99:   percentile(99),
+		Max:   sorted[len(sorted)-1].Round(time.Millisecond).String(),
+	}
+}
+
+// Report is the result of a fleet simulation.
+type Report struct {
+	Duration         string `json:"duration"`
+	Gateways         int    `json:"gateways"`
+	EndDevices       int    `json:"end_devices"`
+	JoinedEndDevices int    `json:"joined_end_devices"`
+
+	JoinRequests uint64 `json:"join_requests"`
+	JoinAccepts  uint64 `json:"join_accepts"`
+	Uplinks      uint64 `json:"uplinks"`
+	// LostUplinks are the uplinks that were not received by any gateway due to the simulated loss.
+	LostUplinks      uint64 `json:"lost_uplinks"`
+	ConfirmedUplinks uint64 `json:"confirmed_uplinks"`
+	Acknowledgements uint64 `json:"acknowledgements"`
+	// ConfirmedLoss is the fraction of confirmed uplinks that were not acknowledged.
+	ConfirmedLoss float64 `json:"confirmed_loss"`
+
+	Downlinks uint64 `json:"downlinks"`
+	// InvalidDownlinks are the downlinks that failed MIC or frame counter verification.
+	InvalidDownlinks uint64 `json:"invalid_downlinks"`
+	// UnmatchedDownlinks are the downlinks that could not be matched to an end device.
+	UnmatchedDownlinks uint64 `json:"unmatched_downlinks"`
+	MACCommands        uint64 `json:"mac_commands"`
+	Errors             uint64 `json:"errors"`
+
+	JoinLatency     *Latency `json:"join_latency,omitempty"`
+	DownlinkLatency *Latency `json:"downlink_latency,omitempty"`
+}
+
+// stats collects the statistics of a fleet simulation.
+type stats struct {
+	joinRequests       atomic.Uint64
+	joinAccepts        atomic.Uint64
+	uplinks            atomic.Uint64
+	lostUplinks        atomic.Uint64
+	confirmedUplinks   atomic.Uint64
+	acknowledgements   atomic.Uint64
+	downlinks          atomic.Uint64
+	invalidDownlinks   atomic.Uint64
+	unmatchedDownlinks atomic.Uint64
+	macCommands        atomic.Uint64
+	errors             atomic.Uint64
+
+	mu                sync.Mutex
+	joinLatencies     []time.Duration
+	downlinkLatencies []time.Duration
+}
+
+func (s *stats) addJoinLatency(d time.Duration) {
+	s.mu.Lock()
+	s.joinLatencies = append(s.joinLatencies, d)
+	s.mu.Unlock()
+}
+
+func (s *stats) addDownlinkLatency(d time.Duration) {
+	s.mu.Lock()
+	s.downlinkLatencies = append(s.downlinkLatencies, d)
+	s.mu.Unlock()
+}
+
+func (s *stats) report() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := &Report{
+		JoinRequests:       s.joinRequests.Load(),
+		JoinAccepts:        s.joinAccepts.Load(),
+		Uplinks:            s.uplinks.Load(),
+		LostUplinks:        s.lostUplinks.Load(),
+		ConfirmedUplinks:   s.confirmedUplinks.Load(),
+		Acknowledgements:   s.acknowledgements.Load(),
+		Downlinks:          s.downlinks.Load(),
+		InvalidDownlinks:   s.invalidDownlinks.Load(),
+		UnmatchedDownlinks: s.unmatchedDownlinks.Load(),
+		MACCommands:        s.macCommands.Load(),
+		Errors:             s.errors.Load(),
+		JoinLatency:        newLatency(s.joinLatencies),
+		DownlinkLatency:    newLatency(s.downlinkLatencies),
+	}
+	if r.ConfirmedUplinks > 0 {
+		r.ConfirmedLoss = 1 - float64(r.Acknowledgements)/float64(r.ConfirmedUplinks)
+	}
+	return r
+}
@@ -0,0 +1,201 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Gateway protocols supported by the fleet simulator.
+const (
+	ProtocolUDP          = "udp"
+	ProtocolBasicStation = "basicstation"
+)
+
+var (
+	errInvalidScenario = errors.DefineInvalidArgument("invalid_scenario", "invalid scenario")
+	errScenarioField   = errors.DefineInvalidArgument("scenario_field", "invalid scenario field `{field}`")
+	errProtocol        = errors.DefineInvalidArgument("protocol", "unsupported gateway protocol `{protocol}`")
+)
+
+// Range is a range of values.
+type Range struct {
+	Min float32 `yaml:"min"`
+	Max float32 `yaml:"max"`
+}
+
+// GatewayScenario describes the simulated gateways.
+type GatewayScenario struct {
+	// Count is the number of gateways.
+	Count int `yaml:"count"`
+	// Protocol is the gateway protocol, udp or basicstation.
+	Protocol string `yaml:"protocol"`
+	// Address is the host and port of the UDP packet forwarder endpoint,
+	// or the URL of the LoRa Basics Station LNS endpoint.
+	Address string `yaml:"address"`
+	// APIKey is the gateway API key used to authenticate LoRa Basics Station gateways.
+	APIKey string `yaml:"api-key"`
+	// StartEUI is the EUI of the first gateway. The EUIs of the other gateways are incremented.
+	StartEUI types.EUI64 `yaml:"start-eui"`
+	// KeepAliveInterval is the interval of UDP PULL_DATA messages.
+	KeepAliveInterval time.Duration `yaml:"keep-alive-interval"`
+}
+
+// EndDeviceScenario describes the simulated end devices.
+type EndDeviceScenario struct {
+	// Count is the number of end devices.
+	Count int `yaml:"count"`
+	// LoRaWANVersion is the LoRaWAN MAC version.
+	LoRaWANVersion ttnpb.MACVersion `yaml:"lorawan-version"`
+	// LoRaWANPHYVersion is the LoRaWAN Regional Parameters version.
+	LoRaWANPHYVersion ttnpb.PHYVersion `yaml:"lorawan-phy-version"`
+	// JoinEUI is the JoinEUI of the end devices.
+	JoinEUI types.EUI64 `yaml:"join-eui"`
+	// StartDevEUI is the DevEUI of the first end device. The DevEUIs of the other end devices are incremented.
+	StartDevEUI types.EUI64 `yaml:"start-dev-eui"`
+	// AppKey is the AppKey of the end devices.
+	AppKey types.AES128Key `yaml:"app-key"`
+	// NwkKey is the NwkKey of the LoRaWAN 1.1 end devices.
+	NwkKey types.AES128Key `yaml:"nwk-key"`
+	// DevNonce is the first DevNonce used in join requests. If zero, a random DevNonce is used.
+	DevNonce uint16 `yaml:"dev-nonce"`
+	// ClassC indicates whether the end devices are class C devices.
+	ClassC bool `yaml:"class-c"`
+	// StartSpread is the period in which the end devices start joining.
+	StartSpread time.Duration `yaml:"start-spread"`
+	// JoinTimeout is the time after which a join request is retried.
+	JoinTimeout time.Duration `yaml:"join-timeout"`
+	// UplinkInterval is the average interval between uplinks.
+	UplinkInterval time.Duration `yaml:"uplink-interval"`
+	// Confirmed is the fraction of confirmed uplinks.
+	Confirmed float32 `yaml:"confirmed"`
+	// FPort is the FPort of the uplinks.
+	FPort uint32 `yaml:"f-port"`
+	// PayloadSize is the size of the uplink application payload.
+	PayloadSize int `yaml:"payload-size"`
+	// DataRateIndex is the data rate of the uplinks until it is changed by the Network Server.
+	DataRateIndex ttnpb.DataRateIndex `yaml:"data-rate-index"`
+	// Gateways is the number of gateways that receive the uplinks of an end device.
+	Gateways int `yaml:"gateways"`
+	// RSSI is the range of the RSSI of the links between end devices and gateways.
+	RSSI Range `yaml:"rssi"`
+	// SNR is the range of the SNR of the links between end devices and gateways.
+	SNR Range `yaml:"snr"`
+	// Loss is the fraction of uplinks that are not received by a gateway.
+	Loss float32 `yaml:"loss"`
+	// Battery is the battery level reported in DevStatusAns.
+	Battery uint32 `yaml:"battery"`
+}
+
+// Scenario describes a fleet simulation.
+type Scenario struct {
+	// Duration is the duration of the simulation.
+	Duration time.Duration `yaml:"duration"`
+	// BandID is the band of the gateways and end devices.
+	BandID string `yaml:"band-id"`
+	// Frequencies are the uplink frequencies. If empty, the default uplink channels of the band are used.
+	Frequencies []uint64 `yaml:"frequencies"`
+
+	Gateways   GatewayScenario   `yaml:"gateways"`
+	EndDevices EndDeviceScenario `yaml:"end-devices"`
+}
+
+// ParseScenario parses the YAML scenario and sets the defaults.
+func ParseScenario(data []byte) (*Scenario, error) {
+	scenario := &Scenario{
+		Duration: 10 * time.Minute,
+		BandID:   band.EU_863_870,
+		Gateways: GatewayScenario{
+			Count:             1,
+			Protocol:          ProtocolUDP,
+			Address:           "localhost:1700",
+			KeepAliveInterval: 10 * time.Second,
+		},
+		EndDevices: EndDeviceScenario{
+			Count:             1,
+			LoRaWANVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+			LoRaWANPHYVersion: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+			StartSpread:       time.Minute,
+			JoinTimeout:       10 * time.Second,
+			UplinkInterval:    time.Minute,
+			FPort:             1,
+			PayloadSize:       12,
+			DataRateIndex:     ttnpb.DataRateIndex_DATA_RATE_5,
+			Gateways:          1,
+			RSSI:              Range{Min: -110, Max: -60},
+			SNR:               Range{Min: -5, Max: 10},
+			Battery:           254,
+		},
+	}
+	if err := yaml.UnmarshalStrict(data, scenario); err != nil {
+		return nil, errInvalidScenario.WithCause(err)
+	}
+	if err := scenario.validate(); err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+func (s *Scenario) validate() error {
+	phy, err := band.Get(s.BandID, s.EndDevices.LoRaWANPHYVersion)
+	if err != nil {
+		return err
+	}
+	if err := s.EndDevices.LoRaWANVersion.Validate(); err != nil {
+		return errScenarioField.WithAttributes("field", "end-devices.lorawan-version").WithCause(err)
+	}
+	if _, ok := phy.DataRates[s.EndDevices.DataRateIndex]; !ok {
+		return errScenarioField.WithAttributes("field", "end-devices.data-rate-index")
+	}
+	switch s.Gateways.Protocol {
+	case ProtocolUDP, ProtocolBasicStation:
+	default:
+		return errProtocol.WithAttributes("protocol", s.Gateways.Protocol)
+	}
+	for _, check := range []struct {
+		field   string
+		invalid bool
+	}{
+		{"duration", s.Duration <= 0},
+		{"gateways.count", s.Gateways.Count <= 0},
+		{"gateways.address", s.Gateways.Address == ""},
+		{"gateways.keep-alive-interval", s.Gateways.KeepAliveInterval <= 0},
+		{"end-devices.count", s.EndDevices.Count <= 0},
+		{"end-devices.app-key", s.EndDevices.AppKey.IsZero()},
+		{"end-devices.nwk-key", macspec.UseNwkKey(s.EndDevices.LoRaWANVersion) && s.EndDevices.NwkKey.IsZero()},
+		{"end-devices.join-timeout", s.EndDevices.JoinTimeout <= 0},
+		{"end-devices.uplink-interval", s.EndDevices.UplinkInterval <= 0},
+		{"end-devices.confirmed", s.EndDevices.Confirmed < 0 || s.EndDevices.Confirmed > 1},
+		{"end-devices.f-port", s.EndDevices.FPort == 0 || s.EndDevices.FPort > 223},
+		{"end-devices.payload-size", s.EndDevices.PayloadSize < 0},
+		{"end-devices.gateways", s.EndDevices.Gateways <= 0 || s.EndDevices.Gateways > s.Gateways.Count},
+		{"end-devices.rssi", s.EndDevices.RSSI.Min > s.EndDevices.RSSI.Max},
+		{"end-devices.snr", s.EndDevices.SNR.Min > s.EndDevices.SNR.Max},
+		{"end-devices.loss", s.EndDevices.Loss < 0 || s.EndDevices.Loss >= 1},
+		{"end-devices.battery", s.EndDevices.Battery > 255},
+	} {
+		if check.invalid {
+			return errScenarioField.WithAttributes("field", check.field)
+		}
+	}
+	return nil
+}
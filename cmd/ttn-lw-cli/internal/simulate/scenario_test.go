@@ -0,0 +1,197 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate_test
+
+import (
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/simulate"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestParseScenario(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+		a, _ := test.New(t)
+
+		scenario, err := ParseScenario([]byte(`
+end-devices:
+  app-key: 01010101010101010101010101010101
+`))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(scenario.Duration, should.Equal, 10*time.Minute)
+		a.So(scenario.BandID, should.Equal, band.EU_863_870)
+		a.So(scenario.Gateways, should.Resemble, GatewayScenario{
+			Count:             1,
+			Protocol:          ProtocolUDP,
+			Address:           "localhost:1700",
+			KeepAliveInterval: 10 * time.Second,
+		})
+		a.So(scenario.EndDevices.Count, should.Equal, 1)
+		a.So(scenario.EndDevices.LoRaWANVersion, should.Equal, ttnpb.MACVersion_MAC_V1_0_3)
+		a.So(scenario.EndDevices.LoRaWANPHYVersion, should.Equal, ttnpb.PHYVersion_RP001_V1_0_3_REV_A)
+		a.So(scenario.EndDevices.AppKey, should.Equal, types.AES128Key{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1})
+		a.So(scenario.EndDevices.DataRateIndex, should.Equal, ttnpb.DataRateIndex_DATA_RATE_5)
+		a.So(scenario.EndDevices.FPort, should.Equal, 1)
+		a.So(scenario.EndDevices.RSSI, should.Resemble, Range{Min: -110, Max: -60})
+		a.So(scenario.EndDevices.Battery, should.Equal, 254)
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		t.Parallel()
+		a, _ := test.New(t)
+
+		scenario, err := ParseScenario([]byte(`
+duration: 1h
+band-id: US_902_928
+frequencies: [902300000, 902500000]
+gateways:
+  count: 3
+  protocol: basicstation
+  address: wss://localhost:8887
+  api-key: NNSXS.KEY
+  start-eui: 0102030405060708
+end-devices:
+  count: 100
+  lorawan-version: MAC_V1_1
+  lorawan-phy-version: RP001_V1_1_REV_B
+  join-eui: 0000000000000001
+  start-dev-eui: 70B3D57ED0000000
+  app-key: 01010101010101010101010101010101
+  nwk-key: 02020202020202020202020202020202
+  dev-nonce: 42
+  class-c: true
+  uplink-interval: 5m
+  confirmed: 0.5
+  f-port: 10
+  payload-size: 20
+  data-rate-index: 3
+  gateways: 2
+  snr: {min: 0, max: 5}
+  loss: 0.1
+`))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(scenario.Duration, should.Equal, time.Hour)
+		a.So(scenario.BandID, should.Equal, band.US_902_928)
+		a.So(scenario.Frequencies, should.Resemble, []uint64{902300000, 902500000})
+		a.So(scenario.Gateways.Protocol, should.Equal, ProtocolBasicStation)
+		a.So(scenario.Gateways.StartEUI, should.Equal, types.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+		a.So(scenario.Gateways.KeepAliveInterval, should.Equal, 10*time.Second)
+		a.So(scenario.EndDevices.Count, should.Equal, 100)
+		a.So(scenario.EndDevices.LoRaWANVersion, should.Equal, ttnpb.MACVersion_MAC_V1_1)
+		a.So(scenario.EndDevices.LoRaWANPHYVersion, should.Equal, ttnpb.PHYVersion_RP001_V1_1_REV_B)
+		a.So(scenario.EndDevices.NwkKey, should.Equal, types.AES128Key{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2})
+		a.So(scenario.EndDevices.DevNonce, should.Equal, 42)
+		a.So(scenario.EndDevices.ClassC, should.BeTrue)
+		a.So(scenario.EndDevices.UplinkInterval, should.Equal, 5*time.Minute)
+		a.So(scenario.EndDevices.DataRateIndex, should.Equal, ttnpb.DataRateIndex_DATA_RATE_3)
+		a.So(scenario.EndDevices.SNR, should.Resemble, Range{Min: 0, Max: 5})
+		a.So(scenario.EndDevices.RSSI, should.Resemble, Range{Min: -110, Max: -60})
+		a.So(scenario.EndDevices.Loss, should.Equal, float32(0.1))
+	})
+
+	for _, tc := range []struct {
+		Name      string
+		Scenario  string
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "UnknownField",
+			Scenario:  "end-devices:\n  app-key: 01010101010101010101010101010101\n  app-sky: true\n",
+			Assertion: errInvalidScenario,
+		},
+		{
+			Name:      "MissingAppKey",
+			Scenario:  "end-devices:\n  count: 10\n",
+			Assertion: errScenarioField("end-devices.app-key"),
+		},
+		{
+			Name: "MissingNwkKey",
+			Scenario: "end-devices:\n  lorawan-version: MAC_V1_1\n  lorawan-phy-version: RP001_V1_1_REV_B\n" +
+				"  app-key: 01010101010101010101010101010101\n",
+			Assertion: errScenarioField("end-devices.nwk-key"),
+		},
+		{
+			Name:      "UnknownBand",
+			Scenario:  "band-id: XX_123\nend-devices:\n  app-key: 01010101010101010101010101010101\n",
+			Assertion: errors.IsNotFound,
+		},
+		{
+			Name:      "DataRateIndex",
+			Scenario:  "end-devices:\n  app-key: 01010101010101010101010101010101\n  data-rate-index: 15\n",
+			Assertion: errScenarioField("end-devices.data-rate-index"),
+		},
+		{
+			Name:      "Protocol",
+			Scenario:  "gateways:\n  protocol: mqtt\nend-devices:\n  app-key: 01010101010101010101010101010101\n",
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "FPort",
+			Scenario:  "end-devices:\n  app-key: 01010101010101010101010101010101\n  f-port: 224\n",
+			Assertion: errScenarioField("end-devices.f-port"),
+		},
+		{
+			Name:      "Gateways",
+			Scenario:  "gateways:\n  count: 2\nend-devices:\n  app-key: 01010101010101010101010101010101\n  gateways: 3\n",
+			Assertion: errScenarioField("end-devices.gateways"),
+		},
+		{
+			Name:      "Confirmed",
+			Scenario:  "end-devices:\n  app-key: 01010101010101010101010101010101\n  confirmed: 1.5\n",
+			Assertion: errScenarioField("end-devices.confirmed"),
+		},
+		{
+			Name:      "Loss",
+			Scenario:  "end-devices:\n  app-key: 01010101010101010101010101010101\n  loss: 1\n",
+			Assertion: errScenarioField("end-devices.loss"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			_, err := ParseScenario([]byte(tc.Scenario))
+			if !a.So(tc.Assertion(err), should.BeTrue) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func errInvalidScenario(err error) bool {
+	ttnErr, ok := errors.From(err)
+	return ok && ttnErr.Name() == "invalid_scenario"
+}
+
+func errScenarioField(field string) func(error) bool {
+	return func(err error) bool {
+		ttnErr, ok := errors.From(err)
+		return ok && ttnErr.Name() == "scenario_field" && ttnErr.Attributes()["field"] == field
+	}
+}
@@ -39,6 +39,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
@@ -59,6 +60,7 @@ type Config struct {
 	PBA              packetbrokeragent.Config          `name:"pba"`
 	DR               devicerepository.Config           `name:"dr"`
 	DCS              deviceclaimingserver.Config       `name:"dcs"`
+	Metering         metering.Config                   `name:"metering"`
 	OutputFormat     string                            `name:"output-format" yaml:"output-format" description:"Output format"`
 }
 
@@ -77,6 +79,7 @@ var DefaultConfig = Config{
 	PBA:          shared_packetbrokeragent.DefaultPacketBrokerAgentConfig,
 	DR:           shared_devicerepository.DefaultDeviceRepositoryConfig,
 	DCS:          shared_deviceclaimingserver.DefaultDeviceClaimingServerConfig,
+	Metering:     shared.DefaultMeteringConfig,
 	OutputFormat: "json",
 }
 
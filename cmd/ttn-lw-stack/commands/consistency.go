@@ -0,0 +1,229 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/spf13/cobra"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/consistency"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	bunstore "go.thethings.network/lorawan-stack/v3/pkg/identityserver/bunstore"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+var errUnknownConsistencyComponent = errors.DefineInvalidArgument(
+	"unknown_consistency_component", "unknown component `{component}`",
+)
+
+// newConsistencyChecker returns a consistency checker for the Identity Server and the given components.
+// The returned database must be closed by the caller.
+func newConsistencyChecker(cmd *cobra.Command) (*consistency.Checker, *sql.DB, error) {
+	components, err := cmd.Flags().GetStringSlice("components")
+	if err != nil {
+		return nil, nil, err
+	}
+	checker := &consistency.Checker{
+		Registries: make(map[consistency.Component]consistency.Registry),
+		Addresses:  make(map[consistency.Component]string),
+	}
+
+	logger.Info("Connecting to Identity Server database...")
+	db, err := storeutil.OpenDB(ctx, config.IS.DatabaseURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	st, err := bunstore.NewStore(ctx, bun.NewDB(db, pgdialect.New()))
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	checker.Registries[consistency.IdentityServer] = &isConsistencyRegistry{store: st}
+
+	for _, component := range components {
+		var (
+			registry    consistency.Registry
+			addressFlag string
+			address     string
+		)
+		switch consistency.Component(component) {
+		case consistency.NetworkServer:
+			registry, err = newNSConsistencyRegistry(ctx, &config.Redis)
+			addressFlag, address = "network-server-address", config.Cluster.NetworkServer
+		case consistency.ApplicationServer:
+			registry, err = newASConsistencyRegistry(ctx, &config.Redis)
+			addressFlag, address = "application-server-address", config.Cluster.ApplicationServer
+		case consistency.JoinServer:
+			registry, err = newJSConsistencyRegistry(ctx, &config.Redis)
+			addressFlag, address = "join-server-address", config.Cluster.JoinServer
+		default:
+			err = errUnknownConsistencyComponent.WithAttributes("component", component)
+		}
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		if s, _ := cmd.Flags().GetString(addressFlag); s != "" {
+			address = s
+		}
+		if address == "" {
+			logger.WithField("component", component).Warnf(
+				"No address of component, set --%s to check that end devices have a record in its registry",
+				addressFlag,
+			)
+		}
+		checker.Registries[consistency.Component(component)] = registry
+		checker.Addresses[consistency.Component(component)] = address
+	}
+
+	tlsConfigProvider := tlsconfig.ConfigurationProvider(func(context.Context) tlsconfig.Config {
+		return config.TLS
+	})
+	fpFetcher, err := config.FrequencyPlansFetcher(ctx, httpclient.NewProvider(tlsConfigProvider))
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	if fpFetcher != nil {
+		checker.FrequencyPlans = frequencyplans.NewStore(fpFetcher)
+	} else {
+		logger.Warn("No frequency plans configured, skip frequency plan checks")
+	}
+
+	return checker, db, nil
+}
+
+func logConsistencyIssue(issue *consistency.Issue) log.Interface {
+	return logger.WithFields(log.Fields(
+		"device_uid", unique.ID(ctx, issue.Ids),
+		"component", issue.Component,
+		"path", issue.Path,
+		"expected", issue.Expected,
+		"actual", issue.Actual,
+		"repairable", issue.Repair != nil,
+	))
+}
+
+var (
+	consistencyCommand = &cobra.Command{
+		Use:   "consistency",
+		Short: "Check consistency of end device registries",
+		Long: `Check consistency of end device registries
+
+The end device registries of the Network Server, Application Server and Join Server
+are compared with the Identity Server, which is the source of truth.`,
+	}
+	consistencyCheckCommand = &cobra.Command{
+		Use:   "check",
+		Short: "Report inconsistencies between end device registries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checker, db, err := newConsistencyChecker(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger.Info("Checking end device registries...")
+			issues, err := checker.Run(ctx)
+			if err != nil {
+				return err
+			}
+			var repairable int
+			for _, issue := range issues {
+				logConsistencyIssue(issue).Warn(issue.Message)
+				if issue.Repair != nil {
+					repairable++
+				}
+			}
+			logger.WithFields(log.Fields(
+				"issues", len(issues),
+				"repairable", repairable,
+			)).Info("Checked end device registries")
+			return nil
+		},
+	}
+	consistencyRepairCommand = &cobra.Command{
+		Use:   "repair",
+		Short: "Repair inconsistencies between end device registries",
+		Long: `Repair inconsistencies between end device registries
+
+Only inconsistencies that can be repaired automatically are repaired, the other inconsistencies
+are reported. Records of end devices that do not exist in the Identity Server can be deleted
+with the cleanup commands of the Network Server, Application Server and Join Server databases.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checker, db, err := newConsistencyChecker(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger.Info("Checking end device registries...")
+			issues, err := checker.Run(ctx)
+			if err != nil {
+				return err
+			}
+			var repaired, failed int
+			for _, issue := range issues {
+				logger := logConsistencyIssue(issue)
+				if issue.Repair == nil {
+					logger.Warn(issue.Message)
+					continue
+				}
+				if err := checker.Repair(ctx, issue); err != nil {
+					logger.WithError(err).Error("Failed to repair inconsistency")
+					failed++
+					continue
+				}
+				logger.Info("Repaired inconsistency")
+				repaired++
+			}
+			logger.WithFields(log.Fields(
+				"issues", len(issues),
+				"repaired", repaired,
+				"failed", failed,
+			)).Info("Repaired end device registries")
+			return nil
+		},
+	}
+)
+
+func init() {
+	consistencyCommand.PersistentFlags().StringSlice(
+		"components",
+		[]string{string(consistency.NetworkServer), string(consistency.ApplicationServer), string(consistency.JoinServer)},
+		"Components of which the end device registry is compared with the Identity Server (ns, as, js)",
+	)
+	consistencyCommand.PersistentFlags().String(
+		"network-server-address", "", "Address of the Network Server in end devices (default cluster.network-server)",
+	)
+	consistencyCommand.PersistentFlags().String(
+		"application-server-address", "",
+		"Address of the Application Server in end devices (default cluster.application-server)",
+	)
+	consistencyCommand.PersistentFlags().String(
+		"join-server-address", "", "Address of the Join Server in end devices (default cluster.join-server)",
+	)
+	consistencyCommand.AddCommand(consistencyCheckCommand)
+	consistencyCommand.AddCommand(consistencyRepairCommand)
+	Root.AddCommand(consistencyCommand)
+}
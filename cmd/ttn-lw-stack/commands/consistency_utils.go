@@ -0,0 +1,216 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
+	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
+	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errConsistencyDeviceNotFound = errors.DefineNotFound(
+	"consistency_device_not_found", "end device `{device_uid}` not found",
+)
+
+// isConsistencyRegistry is the Identity Server end device registry that is checked for consistency.
+type isConsistencyRegistry struct {
+	store store.EndDeviceStore
+}
+
+func (r *isConsistencyRegistry) Range(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDevice) error,
+) error {
+	for page := uint32(1); ; page++ {
+		var total uint64
+		devs, err := r.store.ListEndDevices(
+			store.WithPagination(ctx, defaultPaginationLimit, page, &total), nil, paths,
+		)
+		if err != nil {
+			return err
+		}
+		for _, dev := range devs {
+			if err := f(ctx, dev); err != nil {
+				return err
+			}
+		}
+		if len(devs) == 0 || uint64(page)*defaultPaginationLimit >= total {
+			return nil
+		}
+	}
+}
+
+func (r *isConsistencyRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	return r.store.GetEndDevice(ctx, ids, paths)
+}
+
+func (r *isConsistencyRegistry) Update(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	_, err := r.store.UpdateEndDevice(ctx, dev, paths)
+	return err
+}
+
+// rangeWithError adapts a registry range function to a range function that returns an error.
+func rangeWithError(
+	rangeFunc func(context.Context, []string, func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error, // nolint:lll
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDevice) error,
+) error {
+	var fErr error
+	err := rangeFunc(ctx, paths, func(ctx context.Context, _ *ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+		fErr = f(ctx, dev)
+		return fErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	return fErr
+}
+
+// setConsistencyFields returns an update function that sets the fields of dev in the stored end device.
+func setConsistencyFields(
+	ctx context.Context, dev *ttnpb.EndDevice, paths []string,
+) func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+	return func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if stored == nil {
+			return nil, nil, errConsistencyDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, dev.GetIds()))
+		}
+		if err := stored.SetFields(dev, paths...); err != nil {
+			return nil, nil, err
+		}
+		return stored, paths, nil
+	}
+}
+
+// nsConsistencyRegistry is the Network Server end device registry that is checked for consistency.
+type nsConsistencyRegistry struct {
+	registry *nsredis.DeviceRegistry
+}
+
+func (r *nsConsistencyRegistry) Range(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDevice) error,
+) error {
+	return rangeWithError(r.registry.Range, ctx, paths, f)
+}
+
+func (r *nsConsistencyRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	dev, _, err := r.registry.GetByID(ctx, ids.GetApplicationIds(), ids.GetDeviceId(), paths)
+	return dev, err
+}
+
+func (r *nsConsistencyRegistry) Update(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	set := setConsistencyFields(ctx, dev, paths)
+	_, _, err := r.registry.SetByID(
+		ctx, dev.GetIds().GetApplicationIds(), dev.GetIds().GetDeviceId(), ttnpb.EndDeviceFieldPathsTopLevel,
+		func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return set(stored)
+		},
+	)
+	return err
+}
+
+// asConsistencyRegistry is the Application Server end device registry that is checked for consistency.
+type asConsistencyRegistry struct {
+	registry *asredis.DeviceRegistry
+}
+
+func (r *asConsistencyRegistry) Range(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDevice) error,
+) error {
+	return rangeWithError(r.registry.Range, ctx, paths, f)
+}
+
+func (r *asConsistencyRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	return r.registry.Get(ctx, ids, paths)
+}
+
+func (r *asConsistencyRegistry) Update(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	_, err := r.registry.Set(ctx, dev.GetIds(), ttnpb.EndDeviceFieldPathsTopLevel, setConsistencyFields(ctx, dev, paths))
+	return err
+}
+
+// jsConsistencyRegistry is the Join Server end device registry that is checked for consistency.
+type jsConsistencyRegistry struct {
+	registry *jsredis.DeviceRegistry
+}
+
+func (r *jsConsistencyRegistry) Range(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDevice) error,
+) error {
+	return rangeWithError(r.registry.RangeByID, ctx, paths, f)
+}
+
+func (r *jsConsistencyRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	return r.registry.GetByID(ctx, ids.GetApplicationIds(), ids.GetDeviceId(), paths)
+}
+
+func (r *jsConsistencyRegistry) Update(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	_, err := r.registry.SetByID(
+		ctx, dev.GetIds().GetApplicationIds(), dev.GetIds().GetDeviceId(), ttnpb.EndDeviceFieldPathsTopLevel,
+		setConsistencyFields(ctx, dev, paths),
+	)
+	return err
+}
+
+// newNSConsistencyRegistry returns the Network Server end device registry.
+func newNSConsistencyRegistry(ctx context.Context, config *redis.Config) (*nsConsistencyRegistry, error) {
+	registry := &nsredis.DeviceRegistry{
+		Redis:   redis.New(config.WithNamespace("ns", "devices")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := registry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeNetworkServer.WithCause(err)
+	}
+	return &nsConsistencyRegistry{registry: registry}, nil
+}
+
+// newASConsistencyRegistry returns the Application Server end device registry.
+func newASConsistencyRegistry(ctx context.Context, config *redis.Config) (*asConsistencyRegistry, error) {
+	registry := &asredis.DeviceRegistry{
+		Redis:   redis.New(config.WithNamespace("as", "devices")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := registry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeApplicationServer.WithCause(err)
+	}
+	return &asConsistencyRegistry{registry: registry}, nil
+}
+
+// newJSConsistencyRegistry returns the Join Server end device registry.
+func newJSConsistencyRegistry(ctx context.Context, config *redis.Config) (*jsConsistencyRegistry, error) {
+	registry := &jsredis.DeviceRegistry{
+		Redis:   redis.New(config.WithNamespace("js", "devices")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := registry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeJoinServer.WithCause(err)
+	}
+	return &jsConsistencyRegistry{registry: registry}, nil
+}
@@ -16,8 +16,12 @@ package commands
 
 import (
 	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 )
 
+var errInvalidOverlays = errors.DefineInvalidArgument("invalid_overlays", "{count} invalid overlay(s)")
+
 var (
 	drDBCommand = &cobra.Command{
 		Use:   "dr-db",
@@ -32,6 +36,42 @@ var (
 			return config.DR.Initialize(ctx, config.Blob, overwrite)
 		},
 	}
+	drValidateCommand = &cobra.Command{
+		Use:   "validate [overlay-directory...]",
+		Short: "Validate Device Repository overlays",
+		Long: `Validate Device Repository overlays.
+
+The overlays are validated in increasing order of precedence on top of the
+Device Repository. If no overlay directories are given, the configured
+overlays are validated.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			directories := args
+			if len(directories) == 0 {
+				directories = config.DR.Overlays
+			}
+			overlays, issues, err := config.DR.ValidateOverlays(directories...)
+			if err != nil {
+				return err
+			}
+			for _, o := range overlays {
+				logger := logger.WithFields(log.Fields(
+					"overlay", o.Name,
+					"directory", o.Directory,
+				))
+				if len(issues[o.Name]) == 0 {
+					logger.Info("Overlay is valid")
+					continue
+				}
+				for _, issue := range issues[o.Name] {
+					logger.WithError(issue).Error("Invalid overlay")
+				}
+			}
+			if len(issues) > 0 {
+				return errInvalidOverlays.WithAttributes("count", len(issues))
+			}
+			return nil
+		},
+	}
 )
 
 func init() {
@@ -39,4 +79,5 @@ func init() {
 
 	drInitCommand.Flags().Bool("overwrite", true, "Overwrite existing index files")
 	drDBCommand.AddCommand(drInitCommand)
+	drDBCommand.AddCommand(drValidateCommand)
 }
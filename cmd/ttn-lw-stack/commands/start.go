@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"context"
 	"math"
 	"net/http"
 	"strings"
@@ -43,12 +44,15 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	meteringredis "go.thethings.network/lorawan-stack/v3/pkg/metering/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing"
 	"go.thethings.network/lorawan-stack/v3/pkg/web"
@@ -113,6 +117,23 @@ func NewRateLimitingRedis(conf *Config) *redis.Client {
 
 var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
 
+// loadConfig reads in the config files again and returns the parsed configuration.
+// This is used for live configuration reloads.
+func loadConfig() (any, error) {
+	if err := mgr.Reload(); err != nil {
+		return nil, err
+	}
+	conf := new(Config)
+	if err := mgr.Unmarshal(conf); err != nil {
+		return nil, err
+	}
+	if err := shared.InitializeFallbacks(&conf.ServiceBase); err != nil {
+		return nil, err
+	}
+	telemetryConfigFallback(ctx, conf)
+	return conf, nil
+}
+
 var startCommand = &cobra.Command{
 	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
 	Short: "Start The Things Stack",
@@ -208,8 +229,16 @@ var startCommand = &cobra.Command{
 
 		var rootRedirect web.Registerer
 
+		// The configuration is modified while setting up the components, so the configuration that live reloads
+		// are compared with is loaded separately.
+		initialConfig, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
 		componentOptions := []component.Option{
 			component.WithTracerProvider(tp),
+			component.WithConfigLoader(initialConfig, loadConfig),
 		}
 
 		cookieHashKey, cookieBlockKey := config.ServiceBase.HTTP.Cookie.HashKey, config.ServiceBase.HTTP.Cookie.BlockKey
@@ -239,6 +268,26 @@ var startCommand = &cobra.Command{
 		c.RegisterGRPC(events_grpc.NewEventsServer(c.Context(), events.DefaultPubSub()))
 		c.RegisterGRPC(component.NewConfigurationServer(c))
 
+		if config.Metering.Enable {
+			usageRegistry := &meteringredis.UsageRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("metering")),
+			}
+			usageRecorder := metering.NewRecorder(usageRegistry)
+			c.RegisterTask(&task.Config{
+				Context: ctx,
+				ID:      "flush_usage_recorder",
+				Func: func(ctx context.Context) error {
+					return usageRecorder.Run(ctx, config.Metering.FlushInterval)
+				},
+				Restart: task.RestartOnFailure,
+				Backoff: task.DefaultBackoffConfig,
+			})
+			config.IS.Metering.Registry = usageRegistry
+			config.GS.Metering = usageRecorder
+			config.NS.Metering = usageRecorder
+			config.AS.Webhooks.Metering = usageRecorder
+		}
+
 		if start.IdentityServer {
 			logger.Info("Setting up Identity Server")
 			if config.IS.OAuth.UI.TemplateData.SentryDSN == "" {
@@ -290,6 +339,17 @@ var startCommand = &cobra.Command{
 				}
 				config.GS.Stats = gatewayConnectionStatsRegistry
 			}
+			gatewayAlertRegistry := &gsredis.GatewayAlertRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("gs", "alerts")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := gatewayAlertRegistry.Init(ctx); err != nil {
+				return shared.ErrInitializeGatewayServer.WithCause(err)
+			}
+			config.GS.Alerts.Registry = gatewayAlertRegistry
+			config.GS.Drain.HandoffRegistry = &gsredis.GatewayHandoffRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("gs", "handoff")),
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -325,6 +385,39 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeNetworkServer.WithCause(err)
 			}
 			config.NS.Devices = devices
+			multicastGroups := &nsredis.MulticastGroupRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("ns", "multicast-groups")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := multicastGroups.Init(ctx); err != nil {
+				return shared.ErrInitializeNetworkServer.WithCause(err)
+			}
+			config.NS.MulticastGroups = multicastGroups
+			config.NS.LinkStats.Registry = &nsredis.LinkStatsRegistry{
+				Redis:         redis.New(config.Redis.WithNamespace("ns", "link-stats")),
+				HourRetention: config.NS.LinkStats.HourRetention,
+				DayRetention:  config.NS.LinkStats.DayRetention,
+			}
+			rekeyCampaigns := &nsredis.RekeyCampaignRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("ns", "rekey-campaigns")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := rekeyCampaigns.Init(ctx); err != nil {
+				return shared.ErrInitializeNetworkServer.WithCause(err)
+			}
+			config.NS.RekeyCampaigns.Registry = rekeyCampaigns
+			securityMonitorSettings := &nsredis.SecurityMonitorSettingsRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("ns", "security-monitor")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := securityMonitorSettings.Init(ctx); err != nil {
+				return shared.ErrInitializeNetworkServer.WithCause(err)
+			}
+			config.NS.SecurityMonitor.Settings = securityMonitorSettings
+			config.NS.SecurityMonitor.State = &nsredis.SecurityMonitorStateRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "security-monitor-state")),
+				TTL:   config.NS.SecurityMonitor.StateTTL,
+			}
 			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
 			}
@@ -416,7 +509,7 @@ var startCommand = &cobra.Command{
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
-			_ = as
+			c.RegisterConfigReloader("as.webhooks.templates", as.ReloadWebhookTemplates)
 		}
 
 		if start.JoinServer {
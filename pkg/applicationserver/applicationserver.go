@@ -30,8 +30,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/cloud" // The Go Cloud integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka" // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"  // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats"  // The NATS integration provider
 	ioweb "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/lastseen"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
@@ -84,7 +86,7 @@ type ApplicationServer struct {
 	locationRegistry       metadata.EndDeviceLocationRegistry
 	formatters             messageprocessors.MapPayloadProcessor
 	webhooks               ioweb.Webhooks
-	webhookTemplates       ioweb.TemplateStore
+	webhookTemplates       *ioweb.ReloadableTemplateStore
 	pubsub                 *pubsub.PubSub
 	appPackages            packages.Server
 	appPkgRegistry         packages.Registry
@@ -289,9 +291,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		return nil, err
 	}
 
-	if as.webhookTemplates, err = conf.Webhooks.Templates.NewTemplateStore(ctx, as); err != nil {
+	webhookTemplates, err := conf.Webhooks.Templates.NewTemplateStore(ctx, as)
+	if err != nil {
 		return nil, err
 	}
+	as.webhookTemplates = ioweb.NewReloadableTemplateStore(webhookTemplates)
 
 	if as.pubsub, err = conf.PubSub.NewPubSub(c, as); err != nil {
 		return nil, err
@@ -334,6 +338,18 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 	return as, nil
 }
 
+// ReloadWebhookTemplates implements component.ConfigReloadFunc for the webhook templates configuration.
+// The value must be a ioweb.TemplatesConfig.
+func (as *ApplicationServer) ReloadWebhookTemplates(ctx context.Context, value any) (func(), error) {
+	conf := value.(ioweb.TemplatesConfig)
+	conf.Static = as.config.Webhooks.Templates.Static
+	store, err := conf.NewTemplateStore(ctx, as)
+	if err != nil {
+		return nil, err
+	}
+	return func() { as.webhookTemplates.Set(store) }, nil
+}
+
 // RegisterServices registers services provided by as at s.
 func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsServer(s, as)
@@ -22,6 +22,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	localgeolocationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/localgls/v1"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	loracloudgeolocationv3 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loragls/v3"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
@@ -32,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -146,6 +148,7 @@ type UplinkStorageConfig struct {
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
 	Registry                   web.WebhookRegistry `name:"-"`
+	Metering                   *metering.Recorder  `name:"-"`
 	Target                     string              `name:"target" description:"Target of the integration (direct)"`
 	Timeout                    time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
 	QueueSize                  int                 `name:"queue-size" description:"Number of requests to queue"`
@@ -206,7 +209,8 @@ type GlobalDistributorConfig struct {
 type PubSubConfig struct {
 	Registry pubsub.Registry `name:"-"`
 
-	Providers map[string]string `name:"providers" description:"Controls the status of each provider (enabled, disabled, warning)"`
+	Providers    map[string]string `name:"providers" description:"Controls the status of each provider (enabled, disabled, warning)"`
+	CloudDrivers map[string]string `name:"cloud-drivers" description:"Controls the status of each driver of the Go Cloud provider by URL scheme (enabled, disabled, warning)"`
 }
 
 func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
@@ -229,6 +233,12 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 	if status, ok := c.Providers["nats"]; ok {
 		providers.Nats = toStatus(status)
 	}
+	if status, ok := c.Providers["kafka"]; ok {
+		providers.Kafka = toStatus(status)
+	}
+	if status, ok := c.Providers["cloud"]; ok {
+		providers.Cloud = toStatus(status)
+	}
 	return &ttnpb.AsConfiguration_PubSub{
 		Providers: providers,
 	}
@@ -260,6 +270,9 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry.New()
 	}
+	if c.Metering != nil {
+		target = sink.NewMeteringSink(target, c.Metering)
+	}
 	if c.UnhealthyAttemptsThreshold > 0 || c.UnhealthyRetryInterval > 0 {
 		registry := web.NewHealthStatusRegistry(c.Registry)
 		registry = web.NewCachedHealthStatusRegistry(registry)
@@ -281,7 +294,11 @@ func (c PubSubConfig) NewPubSub(comp *component.Component, server io.Server) (*p
 	if err != nil {
 		return nil, err
 	}
-	return pubsub.New(comp, server, c.Registry, statuses)
+	driverStatuses, err := pubsub.DriverStatusesFromMap(c.CloudDrivers)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.New(comp, server, c.Registry, statuses, pubsub.WithDriverStatuses(driverStatuses))
 }
 
 // NewApplicationPackages returns a new applications packages frontend based on the configuration.
@@ -301,6 +318,9 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	// Initialize LoRa Application Layer Clock Synchronization v1 package handler.
 	handlers[alcsyncv1.PackageName] = alcsyncv1.New(server, c.Registry)
 
+	// Initialize local geolocation v1 package handler.
+	handlers[localgeolocationv1.PackageName] = localgeolocationv1.New(server, c.Registry)
+
 	return packages.New(ctx, server, c.Registry, handlers, c.Workers, c.Timeout)
 }
 
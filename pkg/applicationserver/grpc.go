@@ -150,3 +150,14 @@ func (as *ApplicationServer) HandleUplink(ctx context.Context, req *ttnpb.NsAsHa
 	}
 	return ttnpb.Empty, nil
 }
+
+// PushDownlinks implements ttnpb.NsAsServer.
+func (as *ApplicationServer) PushDownlinks(ctx context.Context, req *ttnpb.NsAsPushDownlinksRequest) (*emptypb.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if err := as.DownlinkQueuePush(ctx, req.EndDeviceIds, req.Downlinks); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
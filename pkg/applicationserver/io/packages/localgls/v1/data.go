@@ -0,0 +1,162 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// QueryType is the method used to solve the location.
+type QueryType string
+
+const (
+	// QueryTDOARSSI solves the location with TDOA if enough gateways report fine timestamps, and with RSSI otherwise.
+	QueryTDOARSSI QueryType = "TDOARSSI"
+	// QueryTDOA solves the location with TDOA only.
+	QueryTDOA QueryType = "TDOA"
+	// QueryRSSI solves the location with RSSI only.
+	QueryRSSI QueryType = "RSSI"
+)
+
+const (
+	queryField              = "query"
+	referenceRSSIField      = "reference_rssi"
+	pathLossExponentField   = "path_loss_exponent"
+	minIntervalField        = "min_interval"
+	lastSolvedAtField       = "last_solved_at"
+	defaultReferenceRSSI    = -40.0
+	defaultPathLossExponent = 2.7
+)
+
+// packageData is the configuration of the package.
+type packageData struct {
+	// Query is the method used to solve the location.
+	Query QueryType
+	// ReferenceRSSI is the RSSI in dBm at 1 meter from the end device, used to estimate distances from RSSI.
+	ReferenceRSSI float64
+	// PathLossExponent is the path loss exponent, used to estimate distances from RSSI.
+	PathLossExponent float64
+	// MinInterval is the minimum interval between solved locations of an end device.
+	MinInterval time.Duration
+}
+
+func numberField(fields map[string]*structpb.Value, name string) (float64, bool, error) {
+	value, ok := fields[name]
+	if !ok {
+		return 0, false, nil
+	}
+	numberValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, false, errInvalidFieldType.WithAttributes(
+			"field", name,
+			"type", "number",
+		)
+	}
+	return numberValue.NumberValue, true, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) error {
+	fields := st.GetFields()
+	if value, ok := fields[queryField]; ok {
+		stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes(
+				"field", queryField,
+				"type", "string",
+			)
+		}
+		switch q := QueryType(stringValue.StringValue); q {
+		case QueryTDOARSSI, QueryTDOA, QueryRSSI:
+			d.Query = q
+		default:
+			return errInvalidFieldValue.WithAttributes(
+				"field", queryField,
+				"value", stringValue.StringValue,
+			)
+		}
+	}
+	if v, ok, err := numberField(fields, referenceRSSIField); err != nil {
+		return err
+	} else if ok {
+		d.ReferenceRSSI = v
+	}
+	if v, ok, err := numberField(fields, pathLossExponentField); err != nil {
+		return err
+	} else if ok {
+		if v <= 0 {
+			return errInvalidFieldValue.WithAttributes(
+				"field", pathLossExponentField,
+				"value", v,
+			)
+		}
+		d.PathLossExponent = v
+	}
+	if v, ok, err := numberField(fields, minIntervalField); err != nil {
+		return err
+	} else if ok {
+		d.MinInterval = time.Duration(v * float64(time.Second))
+	}
+	return nil
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err)
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err)
+	}
+
+	merged := &packageData{
+		Query:            QueryTDOARSSI,
+		ReferenceRSSI:    defaultReferenceRSSI,
+		PathLossExponent: defaultPathLossExponent,
+	}
+	for _, data := range []packageData{defaultData, associationData} {
+		if data.Query != "" {
+			merged.Query = data.Query
+		}
+		if data.ReferenceRSSI != 0 {
+			merged.ReferenceRSSI = data.ReferenceRSSI
+		}
+		if data.PathLossExponent != 0 {
+			merged.PathLossExponent = data.PathLossExponent
+		}
+		if data.MinInterval != 0 {
+			merged.MinInterval = data.MinInterval
+		}
+	}
+	return merged, nil
+}
+
+// lastSolvedAt returns the time at which the location of the end device was last solved.
+func lastSolvedAt(st *structpb.Struct) time.Time {
+	value, ok := st.GetFields()[lastSolvedAtField]
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, value.GetStringValue())
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
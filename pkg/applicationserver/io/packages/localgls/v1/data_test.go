@@ -0,0 +1,129 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMergePackageData(t *testing.T) {
+	t.Parallel()
+
+	mustStruct := func(m map[string]any) *structpb.Struct {
+		st, err := structpb.NewStruct(m)
+		if err != nil {
+			panic(err)
+		}
+		return st
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Default        *ttnpb.ApplicationPackageDefaultAssociation
+		Association    *ttnpb.ApplicationPackageAssociation
+		Expected       *packageData
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Defaults",
+			Expected: &packageData{
+				Query:            QueryTDOARSSI,
+				ReferenceRSSI:    defaultReferenceRSSI,
+				PathLossExponent: defaultPathLossExponent,
+			},
+		},
+		{
+			Name: "AssociationOverridesDefault",
+			Default: &ttnpb.ApplicationPackageDefaultAssociation{
+				Data: mustStruct(map[string]any{
+					queryField:            "RSSI",
+					referenceRSSIField:    -45,
+					pathLossExponentField: 3,
+					minIntervalField:      60,
+				}),
+			},
+			Association: &ttnpb.ApplicationPackageAssociation{
+				Data: mustStruct(map[string]any{
+					queryField:        "TDOA",
+					lastSolvedAtField: "2024-01-01T00:00:00Z",
+				}),
+			},
+			Expected: &packageData{
+				Query:            QueryTDOA,
+				ReferenceRSSI:    -45,
+				PathLossExponent: 3,
+				MinInterval:      time.Minute,
+			},
+		},
+		{
+			Name: "InvalidQuery",
+			Association: &ttnpb.ApplicationPackageAssociation{
+				Data: mustStruct(map[string]any{
+					queryField: "GNSS",
+				}),
+			},
+			ErrorAssertion: errPkgDataMerge.Is,
+		},
+		{
+			Name: "InvalidPathLossExponent",
+			Default: &ttnpb.ApplicationPackageDefaultAssociation{
+				Data: mustStruct(map[string]any{
+					pathLossExponentField: -1,
+				}),
+			},
+			ErrorAssertion: errPkgDataMerge.Is,
+		},
+		{
+			Name: "InvalidFieldType",
+			Default: &ttnpb.ApplicationPackageDefaultAssociation{
+				Data: mustStruct(map[string]any{
+					referenceRSSIField: "loud",
+				}),
+			},
+			ErrorAssertion: errPkgDataMerge.Is,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			data, err := mergePackageData(tc.Default, tc.Association)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if a.So(err, should.BeNil) {
+				a.So(data, should.Resemble, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestLastSolvedAt(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+	a.So(lastSolvedAt(nil).IsZero(), should.BeTrue)
+	st, err := structpb.NewStruct(map[string]any{lastSolvedAtField: "2024-01-01T12:00:00.5Z"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(lastSolvedAt(st), should.Equal, time.Date(2024, 1, 1, 12, 0, 0, 5e8, time.UTC))
+}
@@ -0,0 +1,200 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localgeolocationv1 solves the location of end devices from the uplink metadata, without external services.
+package localgeolocationv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PackageName defines the package name.
+const PackageName = "local-geolocation-v1"
+
+// lastSolvedCacheSize is the number of end devices without association of which the time at which the location was
+// last solved is kept in memory.
+const lastSolvedCacheSize = 1 << 14
+
+// GeolocationPackage is the local geolocation application package.
+type GeolocationPackage struct {
+	server   io.Server
+	registry packages.Registry
+
+	// lastSolved holds the time at which the location of end devices without association was last solved, by end
+	// device UID. End devices with an association store the time in the association data instead, so that no
+	// association is created for end devices that only use the default association.
+	lastSolved   gcache.Cache
+	lastSolvedMu sync.Mutex
+}
+
+// New instantiates the local geolocation package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &GeolocationPackage{
+		server:     server,
+		registry:   registry,
+		lastSolved: gcache.New(lastSolvedCacheSize).LRU().Build(),
+	}
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*GeolocationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 198,
+	}
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/localgls/v1")
+	ctx = events.ContextWithCorrelationID(
+		ctx, append(
+			up.CorrelationIds,
+			fmt.Sprintf("as:packages:localglsv1:%s", events.NewCorrelationID()),
+		)...,
+	)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIds, err)
+		}
+	}()
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+	data, err := mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+	loc, ok := solve(msg.RxMetadata, data)
+	if !ok {
+		log.FromContext(ctx).Debug("Not enough gateway metadata to solve location")
+		return nil
+	}
+	if data.MinInterval > 0 {
+		due, err := p.markSolved(ctx, assoc, up.EndDeviceIds, data.MinInterval)
+		if err != nil || !due {
+			return err
+		}
+	}
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   up.EndDeviceIds,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     timestamppb.Now(),
+		Up: &ttnpb.ApplicationUp_LocationSolved{
+			LocationSolved: &ttnpb.ApplicationLocation{
+				Service:  PackageName,
+				Location: loc,
+			},
+		},
+	})
+}
+
+// solve solves the location of the end device from the uplink metadata.
+func solve(mds []*ttnpb.RxMetadata, data *packageData) (*ttnpb.Location, bool) {
+	rs, o := receiversFromMetadata(mds)
+	initial, ok := solveRSSI(rs, pathLossModel{
+		ReferenceRSSI: data.ReferenceRSSI,
+		Exponent:      data.PathLossExponent,
+	})
+	if !ok {
+		return nil, false
+	}
+	res, source := initial, ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION
+	if data.Query != QueryRSSI {
+		tdoa, ok := solveTDOA(rs, initial)
+		switch {
+		case ok:
+			res, source = tdoa, ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION
+		case data.Query == QueryTDOA:
+			return nil, false
+		}
+	}
+	latitude, longitude := o.unproject(res.X, res.Y)
+	return &ttnpb.Location{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Accuracy:  int32(math.Ceil(res.Accuracy)),
+		Source:    source,
+	}, true
+}
+
+// markSolved stores the time at which the location of the end device is solved, and returns whether the minimum
+// interval since the previously solved location elapsed. The time is stored in the association data if the end
+// device has an association, and in memory otherwise.
+func (p *GeolocationPackage) markSolved(
+	ctx context.Context,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	ids *ttnpb.EndDeviceIdentifiers,
+	minInterval time.Duration,
+) (due bool, err error) {
+	now := time.Now()
+	if assoc == nil {
+		uid := unique.ID(ctx, ids)
+		p.lastSolvedMu.Lock()
+		defer p.lastSolvedMu.Unlock()
+		if v, err := p.lastSolved.Get(uid); err == nil && now.Sub(v.(time.Time)) < minInterval {
+			return false, nil
+		}
+		return true, p.lastSolved.Set(uid, now)
+	}
+	_, err = p.registry.SetAssociation(ctx, assoc.Ids, []string{"data"},
+		func(stored *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if stored == nil {
+				// The association is deleted, so the default association applies and the time is not stored.
+				due = true
+				return nil, nil, nil
+			}
+			if now.Sub(lastSolvedAt(stored.Data)) < minInterval {
+				due = false
+				return stored, nil, nil
+			}
+			if stored.Data == nil {
+				stored.Data = &structpb.Struct{}
+			}
+			if stored.Data.Fields == nil {
+				stored.Data.Fields = make(map[string]*structpb.Value)
+			}
+			stored.Data.Fields[lastSolvedAtField] = structpb.NewStringValue(now.UTC().Format(time.RFC3339Nano))
+			due = true
+			return stored, []string{"data"}, nil
+		},
+	)
+	return due, err
+}
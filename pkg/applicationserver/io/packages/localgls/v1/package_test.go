@@ -0,0 +1,56 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarkSolvedWithoutAssociation(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	// The registry is not used for end devices without association.
+	p := New(nil, nil).(*GeolocationPackage)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	otherIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: ids.ApplicationIds,
+		DeviceId:       "other-dev",
+	}
+
+	due, err := p.markSolved(ctx, nil, ids, time.Hour)
+	a.So(err, should.BeNil)
+	a.So(due, should.BeTrue)
+
+	due, err = p.markSolved(ctx, nil, ids, time.Hour)
+	a.So(err, should.BeNil)
+	a.So(due, should.BeFalse)
+
+	due, err = p.markSolved(ctx, nil, otherIDs, time.Hour)
+	a.So(err, should.BeNil)
+	a.So(due, should.BeTrue)
+
+	due, err = p.markSolved(ctx, nil, ids, time.Nanosecond)
+	a.So(err, should.BeNil)
+	a.So(due, should.BeTrue)
+}
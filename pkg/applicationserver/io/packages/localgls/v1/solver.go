@@ -0,0 +1,263 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// earthRadius is the mean radius of the earth in meters.
+	earthRadius = 6371e3
+	// speedOfLight is the speed of light in meters per nanosecond.
+	speedOfLight = 0.299792458
+	// nanosecondsPerSecond is the period of fine timestamps in nanoseconds.
+	nanosecondsPerSecond = 1e9
+
+	// tdoaRangeError is the minimum expected error of the range differences in meters. This corresponds to the
+	// precision of fine timestamps of typical gateways.
+	tdoaRangeError = 30.0
+	// tdoaMaxIterations is the maximum number of Gauss-Newton iterations.
+	tdoaMaxIterations = 50
+	// tdoaConvergence is the step size in meters below which the solver converged.
+	tdoaConvergence = 0.01
+	// tdoaMinGateways is the minimum number of gateways to solve the location with TDOA.
+	tdoaMinGateways = 3
+)
+
+// receiver is a gateway antenna that received an uplink.
+type receiver struct {
+	// X and Y are the coordinates of the antenna in meters relative to the origin.
+	X, Y float64
+	// Altitude is the altitude of the antenna in meters.
+	Altitude float64
+	// RSSI is the received signal strength in dBm.
+	RSSI float64
+	// SNR is the signal-to-noise ratio in dB.
+	SNR float64
+	// FineTimestamp is the fine timestamp in nanoseconds within the second, if HasFineTimestamp is set.
+	FineTimestamp    uint64
+	HasFineTimestamp bool
+}
+
+// origin is the point around which the gateway locations are projected to a plane.
+type origin struct {
+	latitude, longitude float64
+}
+
+// project returns the coordinates in meters of the location relative to the origin.
+func (o origin) project(latitude, longitude float64) (x, y float64) {
+	x = earthRadius * (longitude - o.longitude) * math.Pi / 180 * math.Cos(o.latitude*math.Pi/180)
+	y = earthRadius * (latitude - o.latitude) * math.Pi / 180
+	return x, y
+}
+
+// unproject returns the location of the coordinates in meters relative to the origin.
+func (o origin) unproject(x, y float64) (latitude, longitude float64) {
+	latitude = o.latitude + y/earthRadius*180/math.Pi
+	longitude = o.longitude + x/(earthRadius*math.Cos(o.latitude*math.Pi/180))*180/math.Pi
+	return latitude, longitude
+}
+
+// receiversFromMetadata returns the receivers of the uplink with a known location, and the origin of their
+// coordinates. Only the antenna with the best SNR of each gateway is used.
+func receiversFromMetadata(mds []*ttnpb.RxMetadata) ([]receiver, origin) {
+	best := make(map[string]*ttnpb.RxMetadata, len(mds))
+	var keys []string
+	for _, md := range mds {
+		loc := md.GetLocation()
+		if loc == nil || (loc.Latitude == 0 && loc.Longitude == 0) {
+			continue
+		}
+		key := md.GetGatewayIds().GetGatewayId()
+		if key == "" {
+			key = md.GetPacketBroker().GetForwarderGatewayId().GetValue()
+		}
+		if stored, ok := best[key]; ok {
+			if md.Snr > stored.Snr {
+				best[key] = md
+			}
+			continue
+		}
+		best[key] = md
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var o origin
+	for _, key := range keys {
+		o.latitude += best[key].Location.Latitude / float64(len(keys))
+		o.longitude += best[key].Location.Longitude / float64(len(keys))
+	}
+	rs := make([]receiver, 0, len(keys))
+	for _, key := range keys {
+		md := best[key]
+		x, y := o.project(md.Location.Latitude, md.Location.Longitude)
+		rssi := md.Rssi
+		if md.ChannelRssi != 0 {
+			rssi = md.ChannelRssi
+		}
+		rs = append(rs, receiver{
+			X:                x,
+			Y:                y,
+			Altitude:         float64(md.Location.Altitude),
+			RSSI:             float64(rssi),
+			SNR:              float64(md.Snr),
+			FineTimestamp:    md.FineTimestamp,
+			HasFineTimestamp: md.FineTimestamp != 0 && md.FineTimestamp < nanosecondsPerSecond,
+		})
+	}
+	return rs, o
+}
+
+// pathLossModel is a log-distance path loss model.
+type pathLossModel struct {
+	// ReferenceRSSI is the RSSI in dBm at 1 meter from the end device.
+	ReferenceRSSI float64
+	// Exponent is the path loss exponent.
+	Exponent float64
+}
+
+// distance returns the estimated distance in meters at which the RSSI is received.
+func (m pathLossModel) distance(rssi float64) float64 {
+	d := math.Pow(10, (m.ReferenceRSSI-rssi)/(10*m.Exponent))
+	if d < 1 {
+		return 1
+	}
+	return d
+}
+
+// solution is a solved location in the plane.
+type solution struct {
+	X, Y float64
+	// Accuracy is the estimated accuracy in meters.
+	Accuracy float64
+}
+
+// solveRSSI returns the weighted centroid of the receivers, where the weights are the inverse squared distances
+// that are estimated with the path loss model. The accuracy is the weighted mean of the estimated distances.
+func solveRSSI(rs []receiver, model pathLossModel) (solution, bool) {
+	if len(rs) == 0 {
+		return solution{}, false
+	}
+	var sumW, x, y, accuracy float64
+	for _, r := range rs {
+		d := model.distance(r.RSSI)
+		w := 1 / (d * d)
+		sumW += w
+		x += w * r.X
+		y += w * r.Y
+		accuracy += w * d
+	}
+	return solution{
+		X:        x / sumW,
+		Y:        y / sumW,
+		Accuracy: accuracy / sumW,
+	}, true
+}
+
+// rangeDifference returns the range difference in meters between the fine timestamps.
+// The fine timestamps wrap every second, so the difference is taken modulo one second.
+func rangeDifference(t, ref uint64) float64 {
+	d := int64(t) - int64(ref)
+	switch {
+	case d > nanosecondsPerSecond/2:
+		d -= nanosecondsPerSecond
+	case d < -nanosecondsPerSecond/2:
+		d += nanosecondsPerSecond
+	}
+	return float64(d) * speedOfLight
+}
+
+// solveTDOA solves the location from the time difference of arrival at the receivers with fine timestamps, using
+// Gauss-Newton iterations starting at the initial solution. The accuracy is the range error multiplied by the
+// horizontal dilution of precision.
+func solveTDOA(rs []receiver, initial solution) (solution, bool) {
+	var timed []receiver
+	for _, r := range rs {
+		if r.HasFineTimestamp {
+			timed = append(timed, r)
+		}
+	}
+	if len(timed) < tdoaMinGateways {
+		return solution{}, false
+	}
+	// NOTE: The receiver with the earliest arrival is the reference, as it is most likely in line of sight.
+	sort.SliceStable(timed, func(i, j int) bool {
+		return rangeDifference(timed[i].FineTimestamp, timed[j].FineTimestamp) < 0
+	})
+	ref := timed[0]
+	others := timed[1:]
+	measured := make([]float64, len(others))
+	for i, r := range others {
+		measured[i] = rangeDifference(r.FineTimestamp, ref.FineTimestamp)
+	}
+
+	x, y := initial.X, initial.Y
+	var jtj [2][2]float64
+	var residuals float64
+	for iter := 0; iter < tdoaMaxIterations; iter++ {
+		d0 := math.Max(math.Hypot(x-ref.X, y-ref.Y), 1)
+		jtj = [2][2]float64{}
+		var jtr [2]float64
+		residuals = 0
+		for i, r := range others {
+			di := math.Max(math.Hypot(x-r.X, y-r.Y), 1)
+			residual := measured[i] - (di - d0)
+			jx := (x-r.X)/di - (x-ref.X)/d0
+			jy := (y-r.Y)/di - (y-ref.Y)/d0
+			jtj[0][0] += jx * jx
+			jtj[0][1] += jx * jy
+			jtj[1][1] += jy * jy
+			jtr[0] += jx * residual
+			jtr[1] += jy * residual
+			residuals += residual * residual
+		}
+		jtj[1][0] = jtj[0][1]
+		det := jtj[0][0]*jtj[1][1] - jtj[0][1]*jtj[1][0]
+		if math.Abs(det) < 1e-12 {
+			return solution{}, false
+		}
+		dx := (jtj[1][1]*jtr[0] - jtj[0][1]*jtr[1]) / det
+		dy := (jtj[0][0]*jtr[1] - jtj[1][0]*jtr[0]) / det
+		x += dx
+		y += dy
+		if math.Hypot(dx, dy) < tdoaConvergence {
+			break
+		}
+	}
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return solution{}, false
+	}
+
+	det := jtj[0][0]*jtj[1][1] - jtj[0][1]*jtj[1][0]
+	if det <= 0 {
+		return solution{}, false
+	}
+	hdop := math.Sqrt((jtj[0][0] + jtj[1][1]) / det)
+	rangeError := tdoaRangeError
+	if n := len(others); n > 2 {
+		// NOTE: With more range differences than unknowns, the residuals estimate the range error.
+		rangeError = math.Max(rangeError, math.Sqrt(residuals/float64(n-2)))
+	}
+	return solution{
+		X:        x,
+		Y:        y,
+		Accuracy: rangeError * hdop,
+	}, true
+}
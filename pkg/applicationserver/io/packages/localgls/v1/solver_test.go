@@ -0,0 +1,193 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"math"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// testGateways are gateway locations around Amsterdam, roughly 2 km apart.
+var testGateways = []struct {
+	ID                  string
+	Latitude, Longitude float64
+}{
+	{"gtw-a", 52.3600, 4.8800},
+	{"gtw-b", 52.3600, 4.9100},
+	{"gtw-c", 52.3780, 4.8950},
+	{"gtw-d", 52.3450, 4.8950},
+}
+
+// testMetadata returns the metadata of an uplink of an end device at the given location, as received by the test
+// gateways with ideal fine timestamps and RSSI following the path loss model.
+func testMetadata(latitude, longitude float64, baseNanos uint64, model pathLossModel) []*ttnpb.RxMetadata {
+	o := origin{latitude: latitude, longitude: longitude}
+	mds := make([]*ttnpb.RxMetadata, 0, len(testGateways))
+	for _, gtw := range testGateways {
+		x, y := o.project(gtw.Latitude, gtw.Longitude)
+		d := math.Hypot(x, y)
+		rssi := model.ReferenceRSSI - 10*model.Exponent*math.Log10(d)
+		mds = append(mds, &ttnpb.RxMetadata{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: gtw.ID},
+			Location: &ttnpb.Location{
+				Latitude:  gtw.Latitude,
+				Longitude: gtw.Longitude,
+			},
+			Rssi:          float32(rssi),
+			ChannelRssi:   float32(rssi),
+			Snr:           5,
+			FineTimestamp: (baseNanos + uint64(math.Round(d/speedOfLight))) % nanosecondsPerSecond,
+		})
+	}
+	return mds
+}
+
+// distance returns the distance in meters between the locations.
+func distance(lat1, lon1, lat2, lon2 float64) float64 {
+	x, y := origin{latitude: lat1, longitude: lon1}.project(lat2, lon2)
+	return math.Hypot(x, y)
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	model := pathLossModel{ReferenceRSSI: defaultReferenceRSSI, Exponent: defaultPathLossExponent}
+	const latitude, longitude = 52.3650, 4.8900
+
+	for _, tc := range []struct {
+		Name        string
+		BaseNanos   uint64
+		Query       QueryType
+		Strip       func([]*ttnpb.RxMetadata)
+		Source      ttnpb.LocationSource
+		MaxDistance float64
+		NoSolution  bool
+	}{
+		{
+			Name:        "TDOA",
+			BaseNanos:   123456789,
+			Query:       QueryTDOARSSI,
+			Source:      ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxDistance: 5,
+		},
+		{
+			Name:        "TDOAWrapped",
+			BaseNanos:   nanosecondsPerSecond - 2000,
+			Query:       QueryTDOA,
+			Source:      ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxDistance: 5,
+		},
+		{
+			Name:  "RSSIFallback",
+			Query: QueryTDOARSSI,
+			Strip: func(mds []*ttnpb.RxMetadata) {
+				for _, md := range mds[1:] {
+					md.FineTimestamp = 0
+				}
+			},
+			Source:      ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+			MaxDistance: 1000,
+		},
+		{
+			Name: "TDOAOnly",
+			Strip: func(mds []*ttnpb.RxMetadata) {
+				for _, md := range mds[1:] {
+					md.FineTimestamp = 0
+				}
+			},
+			Query:      QueryTDOA,
+			NoSolution: true,
+		},
+		{
+			Name:        "RSSI",
+			BaseNanos:   123456789,
+			Query:       QueryRSSI,
+			Source:      ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+			MaxDistance: 1000,
+		},
+		{
+			Name: "NoLocations",
+			Strip: func(mds []*ttnpb.RxMetadata) {
+				for _, md := range mds {
+					md.Location = nil
+				}
+			},
+			Query:      QueryTDOARSSI,
+			NoSolution: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			mds := testMetadata(latitude, longitude, tc.BaseNanos, model)
+			if tc.Strip != nil {
+				tc.Strip(mds)
+			}
+			loc, ok := solve(mds, &packageData{
+				Query:            tc.Query,
+				ReferenceRSSI:    model.ReferenceRSSI,
+				PathLossExponent: model.Exponent,
+			})
+			if tc.NoSolution {
+				a.So(ok, should.BeFalse)
+				return
+			}
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(loc.Source, should.Equal, tc.Source)
+			a.So(distance(latitude, longitude, loc.Latitude, loc.Longitude), should.BeLessThan, tc.MaxDistance)
+			a.So(loc.Accuracy, should.BeGreaterThan, 0)
+		})
+	}
+}
+
+func TestSolveRSSI(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+	model := pathLossModel{ReferenceRSSI: -40, Exponent: 2}
+
+	// A single receiver is the solution, with the estimated distance as accuracy.
+	res, ok := solveRSSI([]receiver{{X: 100, Y: 200, RSSI: -80}}, model)
+	a.So(ok, should.BeTrue)
+	a.So(res.X, should.Equal, 100)
+	a.So(res.Y, should.Equal, 200)
+	a.So(res.Accuracy, should.AlmostEqual, 100, 1e-6)
+
+	// Receivers with equal RSSI weigh equally.
+	res, ok = solveRSSI([]receiver{
+		{X: -100, Y: 0, RSSI: -80},
+		{X: 100, Y: 0, RSSI: -80},
+	}, model)
+	a.So(ok, should.BeTrue)
+	a.So(res.X, should.AlmostEqual, 0, 1e-6)
+	a.So(res.Y, should.AlmostEqual, 0, 1e-6)
+
+	_, ok = solveRSSI(nil, model)
+	a.So(ok, should.BeFalse)
+}
+
+func TestRangeDifference(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+	a.So(rangeDifference(1000, 0), should.AlmostEqual, 1000*speedOfLight, 1e-6)
+	a.So(rangeDifference(0, 1000), should.AlmostEqual, -1000*speedOfLight, 1e-6)
+	a.So(rangeDifference(500, nanosecondsPerSecond-500), should.AlmostEqual, 1000*speedOfLight, 1e-6)
+	a.So(rangeDifference(nanosecondsPerSecond-500, 500), should.AlmostEqual, -1000*speedOfLight, 1e-6)
+}
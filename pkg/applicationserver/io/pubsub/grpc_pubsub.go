@@ -55,7 +55,7 @@ func (ps *PubSub) Get(ctx context.Context, req *ttnpb.GetApplicationPubSubReques
 	if err != nil {
 		return nil, err
 	}
-	if err := ps.providerStatuses.Enabled(ctx, pubsub.Provider); err != nil {
+	if err := ps.statuses.Enabled(ctx, pubsub.Provider); err != nil {
 		return nil, err
 	}
 	return pubsub, nil
@@ -71,7 +71,7 @@ func (ps *PubSub) List(ctx context.Context, req *ttnpb.ListApplicationPubSubsReq
 		return nil, err
 	}
 	for _, pubsub := range pubsubs {
-		_ = ps.providerStatuses.Enabled(ctx, pubsub.Provider)
+		_ = ps.statuses.Enabled(ctx, pubsub.Provider)
 	}
 	return &ttnpb.ApplicationPubSubs{
 		Pubsubs: pubsubs,
@@ -87,7 +87,7 @@ func (ps *PubSub) Set(ctx context.Context, req *ttnpb.SetApplicationPubSubReques
 	); err != nil {
 		return nil, err
 	}
-	if err := ps.providerStatuses.Enabled(ctx, req.Pubsub.Provider); err != nil {
+	if err := ps.statuses.Enabled(ctx, req.Pubsub.Provider); err != nil {
 		return nil, err
 	}
 	// Get all the fields here for starting the integration task.
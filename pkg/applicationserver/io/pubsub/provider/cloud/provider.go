@@ -0,0 +1,200 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloud implements the Go Cloud provider using the Go Cloud URL openers.
+// The drivers are selected by the scheme of the URLs. Drivers need to be registered with the default URL mux
+// of the gocloud.dev/pubsub package in order to be used. This package registers the GCP Pub/Sub (gcppubsub) and
+// AWS SNS and SQS (awssns, awssqs) drivers.
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+	_ "gocloud.dev/pubsub/awssnssqs"    // AWS backend for PubSub.
+	_ "gocloud.dev/pubsub/azuresb"      // Azure Service Bus backend for PubSub.
+	_ "gocloud.dev/pubsub/gcppubsub"    // GCP backend for PubSub.
+	_ "gocloud.dev/pubsub/kafkapubsub"  // Kafka backend for PubSub.
+	_ "gocloud.dev/pubsub/rabbitpubsub" // RabbitMQ backend for PubSub.
+)
+
+// TopicPlaceholder is the placeholder in the URLs that is replaced with the topic of the message.
+const TopicPlaceholder = "{topic}"
+
+// defaultTopicSeparator is the separator between the base topic and the message topic of drivers that are not in
+// topicSeparators.
+const defaultTopicSeparator = "/"
+
+// topicSeparators are the separators between the base topic and the message topic by URL scheme.
+// The separators are valid characters in the topic, queue or exchange names of the drivers.
+var topicSeparators = map[string]string{
+	"awssns":    "-",
+	"awssqs":    "-",
+	"gcppubsub": ".",
+	"kafka":     ".",
+	"rabbit":    ".",
+}
+
+var (
+	errOpenTopic        = errors.Define("open_topic", "open topic `{topic}`")
+	errOpenSubscription = errors.Define("open_subscription", "open subscription `{topic}`")
+)
+
+type impl struct{}
+
+// OpenConnection implements provider.Provider using the Go Cloud URL openers.
+func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Cloud)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+	return OpenConnection(ctx, settings.Cloud.GetPublishUrl(), settings.Cloud.GetSubscribeUrl(), target)
+}
+
+// OpenConnection opens the topics and subscriptions using the given URLs.
+// The topic placeholder in the URLs is replaced with the base topic and the message topic, joined by the topic
+// separator of the driver.
+// If the publish URL is empty, no topics are opened. If the subscribe URL is empty, no subscriptions are opened.
+func OpenConnection(ctx context.Context, publishURL, subscribeURL string, topics provider.Topics) (_ *provider.Connection, err error) {
+	pc := &provider.Connection{}
+	defer func() {
+		if err != nil {
+			pc.Shutdown(ctx) //nolint:errcheck
+		}
+	}()
+	if publishURL != "" {
+		for _, t := range []struct {
+			topic   **pubsub.Topic
+			message *ttnpb.ApplicationPubSub_Message
+		}{
+			{
+				topic:   &pc.Topics.UplinkMessage,
+				message: topics.GetUplinkMessage(),
+			},
+			{
+				topic:   &pc.Topics.UplinkNormalized,
+				message: topics.GetUplinkNormalized(),
+			},
+			{
+				topic:   &pc.Topics.JoinAccept,
+				message: topics.GetJoinAccept(),
+			},
+			{
+				topic:   &pc.Topics.DownlinkAck,
+				message: topics.GetDownlinkAck(),
+			},
+			{
+				topic:   &pc.Topics.DownlinkNack,
+				message: topics.GetDownlinkNack(),
+			},
+			{
+				topic:   &pc.Topics.DownlinkSent,
+				message: topics.GetDownlinkSent(),
+			},
+			{
+				topic:   &pc.Topics.DownlinkFailed,
+				message: topics.GetDownlinkFailed(),
+			},
+			{
+				topic:   &pc.Topics.DownlinkQueued,
+				message: topics.GetDownlinkQueued(),
+			},
+			{
+				topic:   &pc.Topics.DownlinkQueueInvalidated,
+				message: topics.GetDownlinkQueueInvalidated(),
+			},
+			{
+				topic:   &pc.Topics.LocationSolved,
+				message: topics.GetLocationSolved(),
+			},
+			{
+				topic:   &pc.Topics.ServiceData,
+				message: topics.GetServiceData(),
+			},
+		} {
+			if t.message == nil {
+				continue
+			}
+			topic := combineTopics(topicSeparator(publishURL), topics.GetBaseTopic(), t.message.GetTopic())
+			if *t.topic, err = pubsub.OpenTopic(ctx, expandURL(publishURL, topic)); err != nil {
+				return nil, errOpenTopic.WithAttributes("topic", topic).WithCause(err)
+			}
+		}
+	}
+	if subscribeURL != "" {
+		for _, s := range []struct {
+			subscription **pubsub.Subscription
+			message      *ttnpb.ApplicationPubSub_Message
+		}{
+			{
+				subscription: &pc.Subscriptions.Push,
+				message:      topics.GetDownlinkPush(),
+			},
+			{
+				subscription: &pc.Subscriptions.Replace,
+				message:      topics.GetDownlinkReplace(),
+			},
+		} {
+			if s.message == nil {
+				continue
+			}
+			topic := combineTopics(topicSeparator(subscribeURL), topics.GetBaseTopic(), s.message.GetTopic())
+			if *s.subscription, err = pubsub.OpenSubscription(ctx, expandURL(subscribeURL, topic)); err != nil {
+				return nil, errOpenSubscription.WithAttributes("topic", topic).WithCause(err)
+			}
+		}
+	}
+	return pc, nil
+}
+
+// topicSeparator returns the separator between the base topic and the message topic of the driver of url.
+func topicSeparator(url string) string {
+	scheme, _, ok := strings.Cut(url, "://")
+	if !ok {
+		return defaultTopicSeparator
+	}
+	if sep, ok := topicSeparators[scheme]; ok {
+		return sep
+	}
+	return defaultTopicSeparator
+}
+
+// combineTopics combines the base topic and the message topic using the separator sep.
+func combineTopics(sep, t1, t2 string) string {
+	t1 = strings.Trim(t1, sep)
+	t2 = strings.Trim(t2, sep)
+	if t1 == "" {
+		return t2
+	}
+	if t2 == "" {
+		return t1
+	}
+	return fmt.Sprintf("%s%s%s", t1, sep, t2)
+}
+
+func expandURL(url, topic string) string {
+	return strings.ReplaceAll(url, TopicPlaceholder, topic)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Cloud{}, impl{})
+}
@@ -0,0 +1,45 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCombineTopics(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	for _, tc := range []struct {
+		url, t1, t2, expected string
+	}{
+		{url: "mem://{topic}", t1: "", t2: "up", expected: "up"},
+		{url: "mem://{topic}", t1: "app1", t2: "", expected: "app1"},
+		{url: "mem://{topic}", t1: "app1", t2: "up", expected: "app1/up"},
+		{url: "mem://{topic}", t1: "/app1/", t2: "/up/", expected: "app1/up"},
+		{url: "azuresb://{topic}", t1: "app1", t2: "up", expected: "app1/up"},
+		{url: "gcppubsub://projects/p/topics/{topic}", t1: "app1", t2: "up", expected: "app1.up"},
+		{url: "kafka://{topic}", t1: "app1", t2: "up", expected: "app1.up"},
+		{url: "rabbit://{topic}", t1: "app1.", t2: "up", expected: "app1.up"},
+		{url: "awssns:///arn:aws:sns:eu-west-1:123456789012:{topic}", t1: "app1", t2: "up", expected: "app1-up"},
+		{url: "awssqs://sqs.eu-west-1.amazonaws.com/123456789012/{topic}", t1: "app1", t2: "up", expected: "app1-up"},
+		{url: "{topic}", t1: "app1", t2: "up", expected: "app1/up"},
+	} {
+		a.So(combineTopics(topicSeparator(tc.url), tc.t1, tc.t2), should.Equal, tc.expected)
+	}
+}
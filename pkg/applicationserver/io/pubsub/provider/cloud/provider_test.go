@@ -0,0 +1,159 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/cloud"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+	_ "gocloud.dev/pubsub/mempubsub"
+)
+
+type allEnabled struct{}
+
+// Enabled implements provider.Enabler.
+func (*allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+func TestOpenConnection(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	pb := &ttnpb.ApplicationPubSub{
+		Ids: &ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+			PubSubId:       "app1-ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_Cloud{
+			Cloud: &ttnpb.ApplicationPubSub_CloudProvider{
+				PublishUrl:   "mem://{topic}",
+				SubscribeUrl: "mem://{topic}?ackdeadline=1m",
+			},
+		},
+		BaseTopic: "app1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "down-push",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "up",
+		},
+	}
+
+	// The base topic and the message topic are joined by a slash for the in-memory driver.
+	// The in-memory driver requires the topic to exist before subscribing to it.
+	downlinkPush, err := pubsub.OpenTopic(ctx, "mem://app1/down-push")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	impl, err := provider.GetProvider(pb)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	conn, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Shutdown(ctx) //nolint:errcheck
+
+	a.So(conn.Topics.UplinkMessage, should.NotBeNil)
+	a.So(conn.Topics.JoinAccept, should.BeNil)
+	a.So(conn.Subscriptions.Push, should.NotBeNil)
+	a.So(conn.Subscriptions.Replace, should.BeNil)
+
+	t.Run("Uplink", func(t *testing.T) {
+		a, ctx := test.New(t)
+		sub, err := pubsub.OpenSubscription(ctx, "mem://app1/up")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		defer sub.Shutdown(ctx) //nolint:errcheck
+
+		err = conn.Topics.UplinkMessage.Send(ctx, &pubsub.Message{
+			Body: []byte("uplink"),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msg, err := sub.Receive(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msg.Ack()
+		a.So(msg.Body, should.Resemble, []byte("uplink"))
+	})
+
+	t.Run("DownlinkPush", func(t *testing.T) {
+		a, ctx := test.New(t)
+		err := downlinkPush.Send(ctx, &pubsub.Message{
+			Body: []byte("push"),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msg, err := conn.Subscriptions.Push.Receive(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msg.Ack()
+		a.So(msg.Body, should.Resemble, []byte("push"))
+	})
+}
+
+func TestOpenConnectionUnknownDriver(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	pb := &ttnpb.ApplicationPubSub{
+		Ids: &ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+			PubSubId:       "app1-ps2",
+		},
+		Provider: &ttnpb.ApplicationPubSub_Cloud{
+			Cloud: &ttnpb.ApplicationPubSub_CloudProvider{
+				PublishUrl: "unknown://{topic}",
+			},
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "up",
+		},
+	}
+	impl, err := provider.GetProvider(pb)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = impl.OpenConnection(ctx, pb, &allEnabled{})
+	a.So(err, should.NotBeNil)
+}
+
+func TestDriverSchemes(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	mux := pubsub.DefaultURLMux()
+	for _, scheme := range []string{"gcppubsub", "awssns", "azuresb", "rabbit", "kafka"} {
+		a.So(mux.ValidTopicScheme(scheme), should.BeTrue)
+	}
+	for _, scheme := range []string{"gcppubsub", "awssqs", "azuresb", "rabbit", "kafka"} {
+		a.So(mux.ValidSubscriptionScheme(scheme), should.BeTrue)
+	}
+}
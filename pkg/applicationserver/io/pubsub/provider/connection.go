@@ -71,6 +71,25 @@ func (ut *UplinkTopics) Shutdown(ctx context.Context) error {
 	)
 }
 
+// MessageKey is the key of a message that is sent on a topic.
+// Drivers that support message keys expose the key of the message through the As function of
+// pubsub.Message.BeforeSend, as a **MessageKey.
+type MessageKey struct {
+	Key string
+}
+
+// WithMessageKey returns a pubsub.Message.BeforeSend function that sets the key of the message.
+// The key is ignored by drivers that do not support message keys.
+func WithMessageKey(key string) func(func(any) bool) error {
+	return func(as func(any) bool) error {
+		var mk *MessageKey
+		if as(&mk) {
+			mk.Key = key
+		}
+		return nil
+	}
+}
+
 // Shutdowner is an interface that contains a contextual shutdown method.
 type Shutdowner interface {
 	Shutdown(ctx context.Context) error
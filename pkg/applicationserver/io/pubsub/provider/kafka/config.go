@@ -0,0 +1,181 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// defaultClientID is the client ID that is used if the provider settings do not specify a client ID.
+const defaultClientID = "ttn-lw-stack"
+
+var (
+	errInvalidCAPEMData     = errors.DefineInvalidArgument("ca_pem_data", "CA PEM data is invalid")
+	errUnknownSASLMechanism = errors.DefineInvalidArgument("unknown_sasl_mechanism", "unknown SASL mechanism `{mechanism}`")
+	errInvalidConfig        = errors.DefineInvalidArgument("invalid_config", "invalid Kafka configuration")
+)
+
+func createTLSConfig(caPEM []byte, certPEM []byte, keyPEM []byte) (*tls.Config, error) {
+	// Change the CA certificate pool only if a CA has been provided.
+	// This allows the system-wide CA pool to be used.
+	var certPool *x509.CertPool
+	if len(caPEM) != 0 {
+		certPool = x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caPEM) {
+			return nil, errInvalidCAPEMData.New()
+		}
+	}
+	config := &tls.Config{
+		RootCAs: certPool,
+	}
+	// Client certificates are optional, since brokers commonly authenticate clients using SASL.
+	if len(certPEM) != 0 || len(keyPEM) != 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// scramClient implements sarama.SCRAMClient.
+type scramClient struct {
+	scram.HashGeneratorFcn
+	*scram.ClientConversation
+}
+
+// Begin implements sarama.SCRAMClient.
+func (c *scramClient) Begin(username, password, authzID string) error {
+	client, err := c.HashGeneratorFcn.NewClient(username, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.ClientConversation = client.NewConversation()
+	return nil
+}
+
+// Step implements sarama.SCRAMClient.
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.ClientConversation.Step(challenge)
+}
+
+// Done implements sarama.SCRAMClient.
+func (c *scramClient) Done() bool {
+	return c.ClientConversation.Done()
+}
+
+func configureSASL(config *sarama.Config, settings *ttnpb.ApplicationPubSub_KafkaProvider_SASL) error {
+	config.Net.SASL.Enable = true
+	config.Net.SASL.Handshake = true
+	config.Net.SASL.User = settings.Username
+	config.Net.SASL.Password = settings.Password
+	switch settings.Mechanism {
+	case ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: scram.SHA256}
+		}
+	case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: scram.SHA512}
+		}
+	default:
+		return errUnknownSASLMechanism.WithAttributes("mechanism", settings.Mechanism.String())
+	}
+	return nil
+}
+
+// newConfig returns the Kafka client configuration for the given provider settings.
+func newConfig(settings *ttnpb.ApplicationPubSub_KafkaProvider) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	config.ClientID = settings.ClientId
+	if config.ClientID == "" {
+		config.ClientID = defaultClientID
+	}
+
+	if settings.UseTls {
+		tlsConfig, err := createTLSConfig(settings.TlsCa, settings.TlsClientCert, settings.TlsClientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if settings.Sasl != nil {
+		if err := configureSASL(config, settings.Sasl); err != nil {
+			return nil, err
+		}
+	}
+
+	// The synchronous producer requires the successes to be returned.
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	if settings.IdempotentProducer {
+		config.Producer.Idempotent = true
+		config.Producer.RequiredAcks = sarama.WaitForAll
+		config.Net.MaxOpenRequests = 1
+	}
+
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+
+	if err := config.Validate(); err != nil {
+		return nil, errInvalidConfig.WithCause(err)
+	}
+	return config, nil
+}
+
+// consumerGroupTarget is a target that has pub/sub identifiers.
+type consumerGroupTarget interface {
+	GetIds() *ttnpb.ApplicationPubSubIdentifiers
+}
+
+// consumerGroup returns the consumer group of the downlink topics.
+// If the provider settings do not specify a consumer group, the consumer group is derived from the pub/sub
+// identifiers of the target.
+func consumerGroup(settings *ttnpb.ApplicationPubSub_KafkaProvider, target any) string {
+	if group := settings.ConsumerGroup; group != "" {
+		return group
+	}
+	if t, ok := target.(consumerGroupTarget); ok && t.GetIds() != nil {
+		ids := t.GetIds()
+		return fmt.Sprintf("%s.%s", ids.GetApplicationIds().GetApplicationId(), ids.GetPubSubId())
+	}
+	return defaultClientID
+}
+
+// combineTopics combines the base topic and the message topic into a Kafka topic name.
+func combineTopics(t1, t2 string) string {
+	t1 = strings.Trim(t1, ".")
+	t2 = strings.Trim(t2, ".")
+	if t1 == "" {
+		return t2
+	}
+	if t2 == "" {
+		return t1
+	}
+	return fmt.Sprintf("%s.%s", t1, t2)
+}
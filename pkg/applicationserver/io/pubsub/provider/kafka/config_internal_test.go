@@ -0,0 +1,101 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNewConfig(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	config, err := newConfig(&ttnpb.ApplicationPubSub_KafkaProvider{
+		Brokers: []string{"localhost:9092"},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(config.ClientID, should.Equal, defaultClientID)
+	a.So(config.Net.TLS.Enable, should.BeFalse)
+	a.So(config.Net.SASL.Enable, should.BeFalse)
+	a.So(config.Producer.Idempotent, should.BeFalse)
+	a.So(config.Producer.Return.Successes, should.BeTrue)
+
+	config, err = newConfig(&ttnpb.ApplicationPubSub_KafkaProvider{
+		Brokers:  []string{"localhost:9092"},
+		ClientId: "test",
+		Sasl: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+			Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512,
+			Username:  "user",
+			Password:  "secret",
+		},
+		UseTls:             true,
+		IdempotentProducer: true,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(config.ClientID, should.Equal, "test")
+	a.So(config.Net.TLS.Enable, should.BeTrue)
+	a.So(config.Net.SASL.Enable, should.BeTrue)
+	a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512))
+	a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.NotBeNil)
+	a.So(config.Producer.Idempotent, should.BeTrue)
+	a.So(config.Producer.RequiredAcks, should.Equal, sarama.WaitForAll)
+	a.So(config.Net.MaxOpenRequests, should.Equal, 1)
+
+	_, err = newConfig(&ttnpb.ApplicationPubSub_KafkaProvider{
+		Brokers: []string{"localhost:9092"},
+		UseTls:  true,
+		TlsCa:   []byte("invalid"),
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidCAPEMData)
+}
+
+func TestConsumerGroup(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	target := &ttnpb.ApplicationPubSub{
+		Ids: &ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+			PubSubId:       "ps1",
+		},
+	}
+	a.So(consumerGroup(&ttnpb.ApplicationPubSub_KafkaProvider{}, target), should.Equal, "app1.ps1")
+	a.So(consumerGroup(&ttnpb.ApplicationPubSub_KafkaProvider{ConsumerGroup: "group"}, target), should.Equal, "group")
+}
+
+func TestCombineTopics(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	for _, tc := range []struct {
+		t1, t2, expected string
+	}{
+		{t1: "", t2: "uplink", expected: "uplink"},
+		{t1: "app1", t2: "", expected: "app1"},
+		{t1: "app1", t2: "uplink", expected: "app1.uplink"},
+		{t1: ".app1.", t2: ".uplink.", expected: "app1.uplink"},
+	} {
+		a.So(combineTopics(tc.t1, tc.t2), should.Equal, tc.expected)
+	}
+}
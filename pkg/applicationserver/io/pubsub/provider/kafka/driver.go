@@ -0,0 +1,348 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+var (
+	errNilProducer      = errors.DefineInvalidArgument("nil_producer", "producer is nil")
+	errNilConsumerGroup = errors.DefineInvalidArgument("nil_consumer_group", "consumer group is nil")
+	errProduceFailed    = errors.Define("produce_failed", "produce to Kafka topic `{topic}` failed")
+	errConsumeFailed    = errors.Define("consume_failed", "consume from Kafka topic `{topic}` failed")
+)
+
+type topic struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// OpenTopic returns a *pubsub.Topic that produces to the given Kafka topic with the given producer.
+// Messages are produced with the key that is set using provider.WithMessageKey.
+func OpenTopic(producer sarama.SyncProducer, topicName string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(producer, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(producer sarama.SyncProducer, topicName string) (driver.Topic, error) {
+	if producer == nil {
+		return nil, errNilProducer.New()
+	}
+	return &topic{
+		producer: producer,
+		topic:    topicName,
+	}, nil
+}
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.producer == nil {
+		return errNilProducer.New()
+	}
+	pms := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pm := &sarama.ProducerMessage{
+			Topic: t.topic,
+			Value: sarama.ByteEncoder(msg.Body),
+		}
+		for k, v := range msg.Metadata {
+			pm.Headers = append(pm.Headers, sarama.RecordHeader{
+				Key:   []byte(k),
+				Value: []byte(v),
+			})
+		}
+		if msg.BeforeSend != nil {
+			key := &provider.MessageKey{}
+			asFunc := func(i any) bool {
+				switch p := i.(type) {
+				case **provider.MessageKey:
+					*p = key
+				case **sarama.ProducerMessage:
+					*p = pm
+				default:
+					return false
+				}
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+			if key.Key != "" {
+				pm.Key = sarama.StringEncoder(key.Key)
+			}
+		}
+		pms = append(pms, pm)
+	}
+	if err := t.producer.SendMessages(pms); err != nil {
+		return errProduceFailed.WithAttributes("topic", t.topic).WithCause(err)
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i any) bool {
+	p, ok := i.(*sarama.SyncProducer)
+	if !ok {
+		return false
+	}
+	*p = t.producer
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(err error, i any) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+// The producer is shared by the topics of the connection, and is closed when the connection shuts down.
+func (*topic) Close() error { return nil }
+
+// consumerMessage is a message that is consumed in a consumer group session.
+type consumerMessage struct {
+	session sarama.ConsumerGroupSession
+	message *sarama.ConsumerMessage
+}
+
+// consumerGroupHandler implements sarama.ConsumerGroupHandler.
+type consumerGroupHandler struct {
+	messageCh chan<- *consumerMessage
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (consumerGroupHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (consumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+func (h consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			select {
+			case <-session.Context().Done():
+				return nil
+			case h.messageCh <- &consumerMessage{session: session, message: msg}:
+			}
+		}
+	}
+}
+
+type subscription struct {
+	group     sarama.ConsumerGroup
+	topic     string
+	messageCh chan *consumerMessage
+	errCh     chan error
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// subscriptionQueueSize is the size of the subscription channel buffer.
+const subscriptionQueueSize = 16
+
+// OpenSubscription returns a *pubsub.Subscription that consumes the given Kafka topic as a member of the given
+// consumer group. The consumer group is closed when the subscription is shut down.
+func OpenSubscription(ctx context.Context, group sarama.ConsumerGroup, topicName string) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscription(ctx, group, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+func openDriverSubscription(ctx context.Context, group sarama.ConsumerGroup, topicName string) (driver.Subscription, error) {
+	if group == nil {
+		return nil, errNilConsumerGroup.New()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &subscription{
+		group:     group,
+		topic:     topicName,
+		messageCh: make(chan *consumerMessage, subscriptionQueueSize),
+		errCh:     make(chan error, 1),
+		cancel:    cancel,
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		handler := consumerGroupHandler{messageCh: s.messageCh}
+		// Consume returns when the consumer group rebalances, so it is called again to rejoin the consumer group.
+		for ctx.Err() == nil {
+			if err := group.Consume(ctx, []string{topicName}, handler); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
+				}
+				s.errCh <- errConsumeFailed.WithAttributes("topic", topicName).WithCause(err)
+				return
+			}
+		}
+	}()
+	return s, nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+// We always return one message at a time, since the consumer group handler passes the messages one at a time.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil || s.group == nil {
+		return nil, errNilConsumerGroup.New()
+	}
+	if maxMessages <= 0 {
+		return nil, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case err := <-s.errCh:
+		return nil, err
+	case msg := <-s.messageCh:
+		return []*driver.Message{decodeMessage(msg)}, nil
+	}
+}
+
+func decodeMessage(msg *consumerMessage) *driver.Message {
+	dm := &driver.Message{
+		LoggableID: string(msg.message.Key),
+		Body:       msg.message.Value,
+		AckID:      msg,
+		AsFunc: func(i any) bool {
+			p, ok := i.(**sarama.ConsumerMessage)
+			if !ok {
+				return false
+			}
+			*p = msg.message
+			return true
+		},
+	}
+	if len(msg.message.Headers) > 0 {
+		dm.Metadata = make(map[string]string, len(msg.message.Headers))
+		for _, h := range msg.message.Headers {
+			dm.Metadata[string(h.Key)] = string(h.Value)
+		}
+	}
+	return dm
+}
+
+// SendAcks implements driver.Subscription.
+// Acknowledged messages are marked as consumed, and their offsets are committed by the consumer group.
+func (*subscription) SendAcks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		msg := id.(*consumerMessage)
+		msg.session.MarkMessage(msg.message, "")
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i any) bool {
+	p, ok := i.(*sarama.ConsumerGroup)
+	if !ok {
+		return false
+	}
+	*p = s.group
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(err error, i any) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() error {
+	if s == nil || s.group == nil {
+		return nil
+	}
+	s.cancel()
+	err := s.group.Close()
+	s.wg.Wait()
+	return err
+}
+
+func errorAs(err error, i any) bool {
+	p, ok := i.(*sarama.KError)
+	if !ok {
+		return false
+	}
+	return errors.As(err, p)
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if errors.Resemble(err, errNilProducer) || errors.Resemble(err, errNilConsumerGroup) {
+		return gcerrors.NotFound
+	}
+	var kErr sarama.KError
+	switch {
+	case err == nil:
+		return gcerrors.OK
+	case errors.Is(err, context.Canceled):
+		return gcerrors.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return gcerrors.DeadlineExceeded
+	case errors.As(err, &kErr):
+		switch kErr {
+		case sarama.ErrUnknownTopicOrPartition:
+			return gcerrors.NotFound
+		case sarama.ErrTopicAuthorizationFailed, sarama.ErrGroupAuthorizationFailed,
+			sarama.ErrClusterAuthorizationFailed, sarama.ErrSASLAuthenticationFailed:
+			return gcerrors.PermissionDenied
+		case sarama.ErrMessageSizeTooLarge, sarama.ErrInvalidTopic:
+			return gcerrors.InvalidArgument
+		}
+	}
+	return gcerrors.Unknown
+}
@@ -0,0 +1,165 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+func TestTopic(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(pm *sarama.ProducerMessage) error {
+		if pm.Topic != "app1.uplink" {
+			return fmt.Errorf("unexpected topic %q", pm.Topic)
+		}
+		if key, _ := pm.Key.Encode(); string(key) != "dev1" {
+			return fmt.Errorf("unexpected key %q", key)
+		}
+		if value, _ := pm.Value.Encode(); string(value) != "test" {
+			return fmt.Errorf("unexpected value %q", value)
+		}
+		return nil
+	})
+	topic, err := OpenTopic(producer, combineTopics("app1", "uplink"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(topic.Send(ctx, &pubsub.Message{
+		Body:       []byte("test"),
+		BeforeSend: provider.WithMessageKey("dev1"),
+	}), should.BeNil)
+
+	var sp sarama.SyncProducer
+	a.So(topic.As(&sp), should.BeTrue)
+	a.So(topic.Shutdown(ctx), should.BeNil)
+	a.So(producer.Close(), should.BeNil)
+}
+
+type mockConsumerGroupSession struct {
+	sarama.ConsumerGroupSession
+	ctx      context.Context
+	markedCh chan *sarama.ConsumerMessage
+}
+
+func (s *mockConsumerGroupSession) Context() context.Context { return s.ctx }
+
+func (s *mockConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.markedCh <- msg
+}
+
+type mockConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *mockConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+type mockConsumerGroup struct {
+	sarama.ConsumerGroup
+	session *mockConsumerGroupSession
+	claim   *mockConsumerGroupClaim
+	topicCh chan []string
+	closed  chan struct{}
+}
+
+func (g *mockConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	select {
+	case <-g.closed:
+		return sarama.ErrClosedConsumerGroup
+	default:
+	}
+	g.topicCh <- topics
+	g.session.ctx = ctx
+	if err := handler.Setup(g.session); err != nil {
+		return err
+	}
+	if err := handler.ConsumeClaim(g.session, g.claim); err != nil {
+		return err
+	}
+	return handler.Cleanup(g.session)
+}
+
+func (g *mockConsumerGroup) Close() error {
+	close(g.closed)
+	return nil
+}
+
+func TestSubscription(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	group := &mockConsumerGroup{
+		session: &mockConsumerGroupSession{
+			markedCh: make(chan *sarama.ConsumerMessage, 1),
+		},
+		claim: &mockConsumerGroupClaim{
+			messages: make(chan *sarama.ConsumerMessage, 1),
+		},
+		topicCh: make(chan []string, 1),
+		closed:  make(chan struct{}),
+	}
+	sub, err := OpenSubscription(ctx, group, combineTopics("app1.", ".down.push"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case topics := <-group.topicCh:
+		a.So(topics, should.Resemble, []string{"app1.down.push"})
+	case <-ctx.Done():
+		t.Fatal("Expected consumer group to consume")
+	}
+
+	expected := &sarama.ConsumerMessage{
+		Topic: "app1.down.push",
+		Key:   []byte("dev1"),
+		Value: []byte("test"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("foo"), Value: []byte("bar")},
+		},
+	}
+	group.claim.messages <- expected
+	msg, err := sub.Receive(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(msg.Body, should.Resemble, []byte("test"))
+	a.So(msg.Metadata, should.Resemble, map[string]string{"foo": "bar"})
+	a.So(msg.LoggableID, should.Equal, "dev1")
+	var cm *sarama.ConsumerMessage
+	a.So(msg.As(&cm), should.BeTrue)
+	a.So(cm, should.Equal, expected)
+
+	msg.Ack()
+	select {
+	case marked := <-group.session.markedCh:
+		a.So(marked, should.Equal, expected)
+	case <-ctx.Done():
+		t.Fatal("Expected message to be marked")
+	}
+
+	a.So(sub.Shutdown(ctx), should.BeNil)
+}
@@ -0,0 +1,195 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Apache Kafka provider using the Kafka driver.
+package kafka
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+type impl struct{}
+
+type connection struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+}
+
+// Shutdown implements provider.Shutdowner.
+// The producer does not close the client it is created from, so the client is closed separately.
+func (c *connection) Shutdown(_ context.Context) error {
+	if err := c.producer.Close(); err != nil {
+		c.client.Close()
+		return err
+	}
+	return c.client.Close()
+}
+
+var (
+	errConnectFailed  = errors.Define("connect_failed", "connection to Kafka brokers failed")
+	errConsumerGroup  = errors.Define("consumer_group", "join Kafka consumer group `{group}`")
+	errCreateProducer = errors.Define("create_producer", "create Kafka producer")
+)
+
+// OpenConnection implements provider.Provider using the Kafka driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (pc *provider.Connection, err error) {
+	provider, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+	config, err := newConfig(provider.Kafka)
+	if err != nil {
+		return nil, err
+	}
+	return OpenConnection(ctx, provider.Kafka.Brokers, config, consumerGroup(provider.Kafka, target), target)
+}
+
+// OpenConnection opens a Kafka connection to the given brokers using the given configuration.
+// The messages are produced with the device ID as key, and the downlink topics are consumed as member of the given
+// consumer group.
+func OpenConnection(
+	ctx context.Context, brokers []string, config *sarama.Config, group string, topics provider.Topics,
+) (_ *provider.Connection, err error) {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"brokers", brokers,
+		"client_id", config.ClientID,
+		"consumer_group", group,
+	))
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, errCreateProducer.WithCause(err)
+	}
+	logger.Info("Connected to Kafka brokers")
+	pc := &provider.Connection{
+		ProviderConnection: &connection{
+			client:   client,
+			producer: producer,
+		},
+	}
+	defer func() {
+		if err != nil {
+			pc.Shutdown(ctx) //nolint:errcheck
+		}
+	}()
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.UplinkNormalized,
+			message: topics.GetUplinkNormalized(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueueInvalidated,
+			message: topics.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &pc.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = OpenTopic(
+			producer,
+			combineTopics(topics.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		// Consumer groups cannot share clients, so each subscription uses its own consumer group client.
+		cg, err := sarama.NewConsumerGroup(brokers, group, config)
+		if err != nil {
+			return nil, errConsumerGroup.WithAttributes("group", group).WithCause(err)
+		}
+		if *s.subscription, err = OpenSubscription(
+			ctx,
+			cg,
+			combineTopics(topics.GetBaseTopic(), s.message.GetTopic()),
+		); err != nil {
+			cg.Close()
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
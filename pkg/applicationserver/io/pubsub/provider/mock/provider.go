@@ -189,6 +189,8 @@ func init() {
 	for _, p := range []ttnpb.ApplicationPubSub_Provider{
 		&ttnpb.ApplicationPubSub_Nats{},
 		&ttnpb.ApplicationPubSub_Mqtt{},
+		&ttnpb.ApplicationPubSub_Kafka{},
+		&ttnpb.ApplicationPubSub_Cloud{},
 	} {
 		provider.RegisterProvider(p, impl)
 	}
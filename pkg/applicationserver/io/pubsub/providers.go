@@ -59,6 +59,10 @@ func providerTypeFromString(ctx context.Context, s string) (reflect.Type, error)
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Mqtt{}), nil
 	case "nats":
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Nats{}), nil
+	case "kafka":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Kafka{}), nil
+	case "cloud":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Cloud{}), nil
 	default:
 		log.FromContext(ctx).WithField("provider", s).Warn("Unknown PubSub provider specified")
 		return nil, nil
@@ -110,3 +114,76 @@ func (ps ProviderStatuses) Enabled(ctx context.Context, provider ttnpb.Applicati
 		panic("unreachable pubsub provider status")
 	}
 }
+
+// DriverStatuses maps the URL scheme of a Go Cloud driver to a provider status.
+type DriverStatuses map[string]ProviderStatus
+
+// DriverStatusesFromMap constructs the driver statuses from the provided map.
+func DriverStatusesFromMap(m map[string]string) (DriverStatuses, error) {
+	drivers := make(DriverStatuses, len(m))
+	for k, v := range m {
+		status, err := providerStatusFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		drivers[k] = status
+	}
+	return drivers, nil
+}
+
+var errDriverDisabled = errors.DefineFailedPrecondition(
+	"driver_disabled", "driver `{driver}` of the Go Cloud provider is disabled",
+)
+
+// Enabled checks if the Go Cloud driver of the provided URL is enabled.
+// Unlike providers, drivers which are not specified in the map are considered to be disabled, as the drivers
+// authenticate using the credentials of the Application Server environment.
+func (ds DriverStatuses) Enabled(ctx context.Context, url string) error {
+	driver, _, _ := strings.Cut(url, "://")
+	status, ok := ds[driver]
+	if !ok {
+		status = providerStatusDisabled
+	}
+	switch status {
+	case providerStatusEnabled:
+		return nil
+	case providerStatusWarning:
+		warning.Add(ctx, fmt.Sprintf(
+			"The %v driver of the Go Cloud Pub/Sub provider will be disabled in a future version of the stack", driver,
+		))
+		return nil
+	case providerStatusDisabled:
+		return errDriverDisabled.WithAttributes("driver", driver)
+	default:
+		panic("unreachable pubsub driver status")
+	}
+}
+
+// statuses combines the provider and driver statuses.
+type statuses struct {
+	providers ProviderStatuses
+	drivers   DriverStatuses
+}
+
+// Enabled implements provider.Enabler.
+func (s statuses) Enabled(ctx context.Context, provider ttnpb.ApplicationPubSub_Provider) error {
+	if err := s.providers.Enabled(ctx, provider); err != nil {
+		return err
+	}
+	cloud, ok := provider.(*ttnpb.ApplicationPubSub_Cloud)
+	if !ok {
+		return nil
+	}
+	for _, url := range []string{
+		cloud.Cloud.GetPublishUrl(),
+		cloud.Cloud.GetSubscribeUrl(),
+	} {
+		if url == "" {
+			continue
+		}
+		if err := s.drivers.Enabled(ctx, url); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestStatusesEnabled(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	providers, err := ProviderStatusesFromMap(ctx, map[string]string{
+		"cloud": "enabled",
+		"mqtt":  "disabled",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	drivers, err := DriverStatusesFromMap(map[string]string{
+		"gcppubsub": "enabled",
+		"awssqs":    "disabled",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	s := statuses{
+		providers: providers,
+		drivers:   drivers,
+	}
+
+	cloud := func(publishURL, subscribeURL string) ttnpb.ApplicationPubSub_Provider {
+		return &ttnpb.ApplicationPubSub_Cloud{
+			Cloud: &ttnpb.ApplicationPubSub_CloudProvider{
+				PublishUrl:   publishURL,
+				SubscribeUrl: subscribeURL,
+			},
+		}
+	}
+	a.So(s.Enabled(ctx, &ttnpb.ApplicationPubSub_Nats{}), should.BeNil)
+	a.So(s.Enabled(ctx, &ttnpb.ApplicationPubSub_Mqtt{}), should.HaveSameErrorDefinitionAs, errProviderDisabled)
+	a.So(s.Enabled(ctx, cloud("", "")), should.BeNil)
+	a.So(s.Enabled(ctx, cloud("gcppubsub://projects/p/topics/{topic}", "")), should.BeNil)
+	a.So(
+		s.Enabled(ctx, cloud("gcppubsub://projects/p/topics/{topic}", "awssqs://queue")),
+		should.HaveSameErrorDefinitionAs, errDriverDisabled,
+	)
+	a.So(s.Enabled(ctx, cloud("", "mem://{topic}")), should.HaveSameErrorDefinitionAs, errDriverDisabled)
+
+	_, err = DriverStatusesFromMap(map[string]string{"gcppubsub": "unknown"})
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidProviderStatus)
+}
@@ -43,7 +43,23 @@ type PubSub struct {
 
 	integrations sync.Map
 
-	providerStatuses ProviderStatuses
+	statuses statuses
+}
+
+// Option represents an option for the pub/sub frontend.
+type Option interface {
+	apply(*PubSub)
+}
+
+type optionFunc func(*PubSub)
+
+func (f optionFunc) apply(ps *PubSub) { f(ps) }
+
+// WithDriverStatuses sets the statuses of the Go Cloud drivers of the Go Cloud provider.
+func WithDriverStatuses(driverStatuses DriverStatuses) Option {
+	return optionFunc(func(ps *PubSub) {
+		ps.statuses.drivers = driverStatuses
+	})
 }
 
 // New creates a new pusub frontend.
@@ -52,6 +68,7 @@ func New(
 	server io.Server,
 	registry Registry,
 	providerStatuses ProviderStatuses,
+	opts ...Option,
 ) (*PubSub, error) {
 	ctx := log.NewContextWithField(c.Context(), "namespace", "applicationserver/io/pubsub")
 	ps := &PubSub{
@@ -60,7 +77,12 @@ func New(
 		server:    server,
 		registry:  registry,
 
-		providerStatuses: providerStatuses,
+		statuses: statuses{
+			providers: providerStatuses,
+		},
+	}
+	for _, opt := range opts {
+		opt.apply(ps)
 	}
 	ps.RegisterTask(&task.Config{
 		Context: ctx,
@@ -98,7 +120,7 @@ func (ps *PubSub) startTask(ctx context.Context, ids *ttnpb.ApplicationPubSubIde
 				return nil
 			}
 
-			if err := ps.providerStatuses.Enabled(ctx, target.Provider); err != nil {
+			if err := ps.statuses.Enabled(ctx, target.Provider); err != nil {
 				log.FromContext(ctx).WithError(err).Debug("Pub/Sub not enabled")
 				return nil
 			}
@@ -165,7 +187,8 @@ func (i *integration) handleUp(ctx context.Context) {
 				continue
 			}
 			err = topic.Send(ctx, &pubsub.Message{
-				Body: buf,
+				Body:       buf,
+				BeforeSend: provider.WithMessageKey(up.ApplicationUp.GetEndDeviceIds().GetDeviceId()),
 			})
 			if err != nil {
 				logger.WithError(err).Warn("Failed to publish upstream message")
@@ -283,10 +306,10 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 	if err != nil {
 		return err
 	}
-	if err := ps.providerStatuses.Enabled(ctx, pb.GetProvider()); err != nil {
+	if err := ps.statuses.Enabled(ctx, pb.GetProvider()); err != nil {
 		return err
 	}
-	i.conn, err = p.OpenConnection(ctx, pb, ps.providerStatuses)
+	i.conn, err = p.OpenConnection(ctx, pb, ps.statuses)
 	if err != nil {
 		return err
 	}
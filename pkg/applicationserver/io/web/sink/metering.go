@@ -0,0 +1,45 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+)
+
+type meteringSink struct {
+	sink     Sink
+	recorder *metering.Recorder
+}
+
+// Process sends the request to the underlying sink and records the delivery if it succeeds.
+func (s *meteringSink) Process(req *http.Request) error {
+	if err := s.sink.Process(req); err != nil {
+		return err
+	}
+	ids := internal.WebhookIDFromContext(req.Context())
+	s.recorder.Record(ids.GetApplicationIds().GetEntityIdentifiers(), metering.Usage{WebhookDeliveries: 1})
+	return nil
+}
+
+// NewMeteringSink creates a Sink that records the successful webhook deliveries of the applications.
+func NewMeteringSink(sink Sink, recorder *metering.Recorder) Sink {
+	return &meteringSink{
+		sink:     sink,
+		recorder: recorder,
+	}
+}
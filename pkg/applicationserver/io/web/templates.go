@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -44,6 +45,37 @@ func (ts *noopTemplateStore) ListTemplates(ctx context.Context, req *ttnpb.ListA
 	return &ttnpb.ApplicationWebhookTemplates{}, nil
 }
 
+type templateStoreHolder struct {
+	TemplateStore
+}
+
+// ReloadableTemplateStore is a TemplateStore of which the underlying store can be replaced at runtime.
+type ReloadableTemplateStore struct {
+	store atomic.Value
+}
+
+// NewReloadableTemplateStore returns a new ReloadableTemplateStore that delegates to the given store.
+func NewReloadableTemplateStore(store TemplateStore) *ReloadableTemplateStore {
+	s := &ReloadableTemplateStore{}
+	s.Set(store)
+	return s
+}
+
+// Set replaces the underlying store.
+func (s *ReloadableTemplateStore) Set(store TemplateStore) {
+	s.store.Store(templateStoreHolder{store})
+}
+
+// GetTemplate implements TemplateStore.
+func (s *ReloadableTemplateStore) GetTemplate(ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error) {
+	return s.store.Load().(templateStoreHolder).GetTemplate(ctx, req)
+}
+
+// ListTemplates implements TemplateStore.
+func (s *ReloadableTemplateStore) ListTemplates(ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest) (*ttnpb.ApplicationWebhookTemplates, error) {
+	return s.store.Load().(templateStoreHolder).ListTemplates(ctx, req)
+}
+
 // templateStore implements TemplateStore using an underlying fetcher.
 type templateStore struct {
 	fetcher fetch.Interface
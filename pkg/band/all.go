@@ -15,11 +15,18 @@
 package band
 
 import (
+	"sync"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
 var (
+	// mu guards All and LatestVersion, which are updated by Register.
+	mu sync.RWMutex
+
 	// All contains all the bands available.
+	// Custom bands are added by Register, so All must not be accessed directly while bands may be registered.
+	// Use Get and GetLatest instead.
 	All = map[string]map[ttnpb.PHYVersion]Band{
 		AS_923: {
 			ttnpb.PHYVersion_RP001_V1_0_2:       AS_923_RP1_v1_0_2,
@@ -242,6 +249,8 @@ var (
 
 // Get returns the band if it was found, and returns an error otherwise.
 func Get(id string, version ttnpb.PHYVersion) (Band, error) {
+	mu.RLock()
+	defer mu.RUnlock()
 	versions, ok := All[id]
 	if !ok {
 		return Band{}, errBandNotFound.WithAttributes("id", id, "version", version)
@@ -256,6 +265,8 @@ func Get(id string, version ttnpb.PHYVersion) (Band, error) {
 // GetLatest returns the latest version of the band if it was found,
 // and returns an error otherwise.
 func GetLatest(id string) (Band, error) {
+	mu.RLock()
+	defer mu.RUnlock()
 	versions, ok := All[id]
 	if !ok {
 		return Band{}, errBandNotFound.WithAttributes("id", id, "version", "latest")
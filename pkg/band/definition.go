@@ -0,0 +1,435 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package band
+
+import (
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gopkg.in/yaml.v2"
+)
+
+// ChannelDefinition is the definition of a default channel.
+type ChannelDefinition struct {
+	Frequency   uint64              `yaml:"frequency"`
+	MinDataRate ttnpb.DataRateIndex `yaml:"min-data-rate"`
+	MaxDataRate ttnpb.DataRateIndex `yaml:"max-data-rate"`
+}
+
+// SubBandDefinition is the definition of a sub-band.
+type SubBandDefinition struct {
+	MinFrequency uint64  `yaml:"min-frequency"`
+	MaxFrequency uint64  `yaml:"max-frequency"`
+	DutyCycle    float32 `yaml:"duty-cycle"`
+	MaxEIRP      float32 `yaml:"max-eirp"`
+}
+
+// LoRaDataRateDefinition is the definition of a LoRa data rate.
+type LoRaDataRateDefinition struct {
+	SpreadingFactor uint32 `yaml:"spreading-factor"`
+	Bandwidth       uint32 `yaml:"bandwidth"`
+	CodingRate      string `yaml:"coding-rate"`
+}
+
+// FSKDataRateDefinition is the definition of a FSK data rate.
+type FSKDataRateDefinition struct {
+	BitRate uint32 `yaml:"bit-rate"`
+}
+
+// LRFHSSDataRateDefinition is the definition of a LR-FHSS data rate.
+type LRFHSSDataRateDefinition struct {
+	ModulationType        uint32 `yaml:"modulation-type"`
+	OperatingChannelWidth uint32 `yaml:"operating-channel-width"`
+	CodingRate            string `yaml:"coding-rate"`
+}
+
+// DataRateDefinition is the definition of a data rate. Exactly one modulation must be set.
+type DataRateDefinition struct {
+	LoRa   *LoRaDataRateDefinition   `yaml:"lora"`
+	FSK    *FSKDataRateDefinition    `yaml:"fsk"`
+	LRFHSS *LRFHSSDataRateDefinition `yaml:"lrfhss"`
+
+	// MaxMACPayloadSize is the maximum MAC payload size.
+	MaxMACPayloadSize uint16 `yaml:"max-mac-payload-size"`
+	// MaxMACPayloadSizeDwellTime is the maximum MAC payload size when dwell time restrictions apply.
+	// If zero, MaxMACPayloadSize applies.
+	MaxMACPayloadSizeDwellTime uint16 `yaml:"max-mac-payload-size-dwell-time"`
+}
+
+// Rx2Definition is the definition of the default Rx2 parameters.
+type Rx2Definition struct {
+	DataRateIndex ttnpb.DataRateIndex `yaml:"data-rate-index"`
+	Frequency     uint64              `yaml:"frequency"`
+}
+
+// DwellTimeDefinition is the definition of the dwell time on boot.
+type DwellTimeDefinition struct {
+	Uplinks   *bool `yaml:"uplinks"`
+	Downlinks *bool `yaml:"downlinks"`
+}
+
+// RelayWORChannelDefinition is the definition of a relay wake on radio channel.
+type RelayWORChannelDefinition struct {
+	Frequency     uint64              `yaml:"frequency"`
+	ACKFrequency  uint64              `yaml:"ack-frequency"`
+	DataRateIndex ttnpb.DataRateIndex `yaml:"data-rate-index"`
+}
+
+// BeaconDefinition is the definition of the Class B beacon.
+type BeaconDefinition struct {
+	DataRateIndex ttnpb.DataRateIndex `yaml:"data-rate-index"`
+	CodingRate    string              `yaml:"coding-rate"`
+	Frequencies   []uint64            `yaml:"frequencies"`
+}
+
+// TimingDefinition is the definition of the receive windows, retransmissions and ADR back-off.
+type TimingDefinition struct {
+	ReceiveDelay1        time.Duration             `yaml:"receive-delay-1"`
+	ReceiveDelay2        time.Duration             `yaml:"receive-delay-2"`
+	JoinAcceptDelay1     time.Duration             `yaml:"join-accept-delay-1"`
+	JoinAcceptDelay2     time.Duration             `yaml:"join-accept-delay-2"`
+	MaxFCntGap           uint                      `yaml:"max-f-cnt-gap"`
+	ADRAckLimit          ttnpb.ADRAckLimitExponent `yaml:"adr-ack-limit"`
+	ADRAckDelay          ttnpb.ADRAckDelayExponent `yaml:"adr-ack-delay"`
+	MinRetransmitTimeout time.Duration             `yaml:"min-retransmit-timeout"`
+	MaxRetransmitTimeout time.Duration             `yaml:"max-retransmit-timeout"`
+	RelayForwardDelay    time.Duration             `yaml:"relay-forward-delay"`
+	RelayReceiveDelay    time.Duration             `yaml:"relay-receive-delay"`
+	ServedRelayBackoff   uint32                    `yaml:"served-relay-backoff"`
+}
+
+// Definition is the definition of a custom band, typically loaded from YAML.
+type Definition struct {
+	// ID is the band ID. It must not be the ID of a built-in band.
+	ID string `yaml:"id"`
+	// PHYVersions are the Regional Parameters versions for which the band is registered.
+	// If empty, the band is registered for all versions.
+	PHYVersions []ttnpb.PHYVersion `yaml:"phy-versions"`
+
+	// ChannelMask is the number of channels addressed by the channel mask: 16, 48, 64, 72 or 96.
+	// This is also the maximum number of uplink channels.
+	ChannelMask uint8 `yaml:"channel-mask"`
+	// MaxDownlinkChannels is the maximum number of downlink channels. If zero, it is equal to ChannelMask.
+	MaxDownlinkChannels uint8               `yaml:"max-downlink-channels"`
+	UplinkChannels      []ChannelDefinition `yaml:"uplink-channels"`
+	// DownlinkChannels are the default downlink channels. If empty, the uplink channels are used.
+	DownlinkChannels []ChannelDefinition `yaml:"downlink-channels"`
+	// Rx1ChannelModulo is the modulo of the uplink channel index to compute the Rx1 channel index.
+	// If zero, the Rx1 channel index is the uplink channel index.
+	Rx1ChannelModulo uint8 `yaml:"rx1-channel-modulo"`
+
+	SubBands []SubBandDefinition `yaml:"sub-bands"`
+
+	DataRates        map[ttnpb.DataRateIndex]DataRateDefinition `yaml:"data-rates"`
+	StrictCodingRate bool                                       `yaml:"strict-coding-rate"`
+	// Rx1DataRates is the Rx1 data rate table, indexed by uplink data rate index and Rx1 data rate offset.
+	Rx1DataRates        [][]ttnpb.DataRateIndex `yaml:"rx1-data-rates"`
+	MaxADRDataRateIndex ttnpb.DataRateIndex     `yaml:"max-adr-data-rate-index"`
+	SupportsDynamicADR  bool                    `yaml:"supports-dynamic-adr"`
+
+	// DefaultMaxEIRP is the default maximum EIRP in dBm.
+	DefaultMaxEIRP float32 `yaml:"default-max-eirp"`
+	// TxOffset is the TX power table: the offsets in dB from the maximum EIRP, indexed by TxPower.
+	TxOffset               []float32 `yaml:"tx-offset"`
+	TxParamSetupReqSupport bool      `yaml:"tx-param-setup-req-support"`
+
+	FreqMultiplier uint64 `yaml:"freq-multiplier"`
+	// CFListType is the CFList type of the join-accept. If not set, the band does not implement CFList.
+	CFListType *ttnpb.CFListType `yaml:"cf-list-type"`
+
+	DefaultRx2          Rx2Definition               `yaml:"default-rx2"`
+	BootDwellTime       DwellTimeDefinition         `yaml:"boot-dwell-time"`
+	RelayWORChannels    []RelayWORChannelDefinition `yaml:"relay-wor-channels"`
+	Beacon              BeaconDefinition            `yaml:"beacon"`
+	PingSlotFrequencies []uint64                    `yaml:"ping-slot-frequencies"`
+
+	Timing TimingDefinition `yaml:"timing"`
+}
+
+// ParseDefinition parses the YAML band definition and sets the defaults.
+func ParseDefinition(data []byte) (*Definition, error) {
+	def := &Definition{
+		ChannelMask:    16,
+		FreqMultiplier: 100,
+		Timing: TimingDefinition{
+			ReceiveDelay1:        relayAwareSharedParameters.ReceiveDelay1,
+			ReceiveDelay2:        relayAwareSharedParameters.ReceiveDelay2,
+			JoinAcceptDelay1:     relayAwareSharedParameters.JoinAcceptDelay1,
+			JoinAcceptDelay2:     relayAwareSharedParameters.JoinAcceptDelay2,
+			MaxFCntGap:           relayAwareSharedParameters.MaxFCntGap,
+			ADRAckLimit:          relayAwareSharedParameters.ADRAckLimit,
+			ADRAckDelay:          relayAwareSharedParameters.ADRAckDelay,
+			MinRetransmitTimeout: relayAwareSharedParameters.MinRetransmitTimeout,
+			MaxRetransmitTimeout: relayAwareSharedParameters.MaxRetransmitTimeout,
+			RelayForwardDelay:    relayAwareSharedParameters.RelayForwardDelay,
+			RelayReceiveDelay:    relayAwareSharedParameters.RelayReceiveDelay,
+			ServedRelayBackoff:   relayAwareSharedParameters.ServedRelayBackoff,
+		},
+	}
+	if err := yaml.UnmarshalStrict(data, def); err != nil {
+		return nil, errInvalidDefinition.WithCause(err)
+	}
+	return def, nil
+}
+
+func (d ChannelDefinition) channel() Channel {
+	return Channel{
+		Frequency:   d.Frequency,
+		MinDataRate: d.MinDataRate,
+		MaxDataRate: d.MaxDataRate,
+	}
+}
+
+func (d DataRateDefinition) dataRate() (DataRate, bool) {
+	maxMACPayloadSize := makeConstMaxMACPayloadSizeFunc(d.MaxMACPayloadSize)
+	if d.MaxMACPayloadSizeDwellTime != 0 {
+		maxMACPayloadSize = makeDwellTimeMaxMACPayloadSizeFunc(d.MaxMACPayloadSize, d.MaxMACPayloadSizeDwellTime)
+	}
+	switch {
+	case d.LoRa != nil && d.FSK == nil && d.LRFHSS == nil:
+		return DataRate{
+			Rate: (&ttnpb.LoRaDataRate{
+				SpreadingFactor: d.LoRa.SpreadingFactor,
+				Bandwidth:       d.LoRa.Bandwidth,
+				CodingRate:      d.LoRa.CodingRate,
+			}).DataRate(),
+			MaxMACPayloadSize: maxMACPayloadSize,
+		}, true
+	case d.FSK != nil && d.LoRa == nil && d.LRFHSS == nil:
+		return makeFSKDataRate(d.FSK.BitRate, maxMACPayloadSize), true
+	case d.LRFHSS != nil && d.LoRa == nil && d.FSK == nil:
+		return makeLRFHSSDataRate(
+			d.LRFHSS.ModulationType, d.LRFHSS.OperatingChannelWidth, d.LRFHSS.CodingRate, maxMACPayloadSize,
+		), true
+	default:
+		return DataRate{}, false
+	}
+}
+
+func makeRx1DataRateTable(
+	table [][]ttnpb.DataRateIndex,
+) func(ttnpb.DataRateIndex, ttnpb.DataRateOffset, bool) (ttnpb.DataRateIndex, error) {
+	return func(idx ttnpb.DataRateIndex, offset ttnpb.DataRateOffset, _ bool) (ttnpb.DataRateIndex, error) {
+		if int(idx) >= len(table) {
+			return 0, errDataRateIndexTooHigh.WithAttributes("max", len(table)-1)
+		}
+		if int(offset) >= len(table[idx]) {
+			return 0, errDataRateOffsetTooHigh.WithAttributes("max", len(table[idx])-1)
+		}
+		return table[idx][offset], nil
+	}
+}
+
+var channelMasks = map[uint8]struct {
+	generate func([]bool, []bool) ([]ChMaskCntlPair, error)
+	parse    func([16]bool, uint8) (map[uint8]bool, error)
+}{
+	16: {generateChMask16, parseChMask16},
+	48: {generateChMask48, parseChMask48},
+	64: {generateChMask64, parseChMask64},
+	72: {makeGenerateChMask72(true, true), parseChMask72},
+	96: {generateChMask96, parseChMask96},
+}
+
+// Band returns the band of the definition.
+// The band is validated against the invariants of all bands, see Band.Validate.
+func (d Definition) Band() (Band, error) {
+	invalid := func(field string) error {
+		return errInvalidBand.WithAttributes("id", d.ID, "field", field)
+	}
+
+	chMask, ok := channelMasks[d.ChannelMask]
+	if !ok {
+		return Band{}, invalid("channel_mask")
+	}
+	b := Band{
+		ID: d.ID,
+
+		MaxUplinkChannels:   d.ChannelMask,
+		MaxDownlinkChannels: d.MaxDownlinkChannels,
+
+		StrictCodingRate:       d.StrictCodingRate,
+		MaxADRDataRateIndex:    d.MaxADRDataRateIndex,
+		SupportsDynamicADR:     d.SupportsDynamicADR,
+		DefaultMaxEIRP:         d.DefaultMaxEIRP,
+		TxOffset:               d.TxOffset,
+		TxParamSetupReqSupport: d.TxParamSetupReqSupport,
+		FreqMultiplier:         d.FreqMultiplier,
+
+		Rx1Channel:      channelIndexIdentity,
+		Rx1DataRate:     makeRx1DataRateTable(d.Rx1DataRates),
+		GenerateChMasks: chMask.generate,
+		ParseChMask:     chMask.parse,
+
+		DefaultRx2Parameters: Rx2Parameters{
+			DataRateIndex: d.DefaultRx2.DataRateIndex,
+			Frequency:     d.DefaultRx2.Frequency,
+		},
+		BootDwellTime: DwellTime{
+			Uplinks:   d.BootDwellTime.Uplinks,
+			Downlinks: d.BootDwellTime.Downlinks,
+		},
+		Beacon: Beacon{
+			DataRateIndex: d.Beacon.DataRateIndex,
+			CodingRate:    d.Beacon.CodingRate,
+			Frequencies:   d.Beacon.Frequencies,
+		},
+		PingSlotFrequencies: d.PingSlotFrequencies,
+
+		SharedParameters: SharedParameters{
+			ReceiveDelay1:        d.Timing.ReceiveDelay1,
+			ReceiveDelay2:        d.Timing.ReceiveDelay2,
+			JoinAcceptDelay1:     d.Timing.JoinAcceptDelay1,
+			JoinAcceptDelay2:     d.Timing.JoinAcceptDelay2,
+			MaxFCntGap:           d.Timing.MaxFCntGap,
+			ADRAckLimit:          d.Timing.ADRAckLimit,
+			ADRAckDelay:          d.Timing.ADRAckDelay,
+			MinRetransmitTimeout: d.Timing.MinRetransmitTimeout,
+			MaxRetransmitTimeout: d.Timing.MaxRetransmitTimeout,
+			RelayForwardDelay:    d.Timing.RelayForwardDelay,
+			RelayReceiveDelay:    d.Timing.RelayReceiveDelay,
+			ServedRelayBackoff:   d.Timing.ServedRelayBackoff,
+		},
+	}
+	if b.MaxDownlinkChannels == 0 {
+		b.MaxDownlinkChannels = d.ChannelMask
+	}
+	if d.Rx1ChannelModulo != 0 {
+		b.Rx1Channel = channelIndexModulo(d.Rx1ChannelModulo)
+	}
+	if d.CFListType != nil {
+		b.ImplementsCFList = true
+		b.CFListType = *d.CFListType
+	}
+
+	for _, ch := range d.UplinkChannels {
+		b.UplinkChannels = append(b.UplinkChannels, ch.channel())
+	}
+	downlinkChannels := d.DownlinkChannels
+	if len(downlinkChannels) == 0 {
+		downlinkChannels = d.UplinkChannels
+	}
+	for _, ch := range downlinkChannels {
+		b.DownlinkChannels = append(b.DownlinkChannels, ch.channel())
+	}
+	for _, sb := range d.SubBands {
+		b.SubBands = append(b.SubBands, SubBandParameters{
+			MinFrequency: sb.MinFrequency,
+			MaxFrequency: sb.MaxFrequency,
+			DutyCycle:    sb.DutyCycle,
+			MaxEIRP:      sb.MaxEIRP,
+		})
+	}
+	for _, ch := range d.RelayWORChannels {
+		b.Relay.WORChannels = append(b.Relay.WORChannels, RelayWORChannel{
+			Frequency:     ch.Frequency,
+			ACKFrequency:  ch.ACKFrequency,
+			DataRateIndex: ch.DataRateIndex,
+		})
+	}
+
+	b.DataRates = make(map[ttnpb.DataRateIndex]DataRate, len(d.DataRates))
+	for idx, def := range d.DataRates {
+		dr, ok := def.dataRate()
+		if !ok {
+			return Band{}, invalid("data_rates")
+		}
+		b.DataRates[idx] = dr
+	}
+
+	// Unlike some built-in bands, custom bands must define the Rx1 data rate of every data rate
+	// and the default Rx2 data rate.
+	for idx := range b.DataRates {
+		if int(idx) >= len(d.Rx1DataRates) || len(d.Rx1DataRates[idx]) == 0 {
+			return Band{}, invalid("rx1_data_rates")
+		}
+		for _, rx1 := range d.Rx1DataRates[idx] {
+			if _, ok := b.DataRates[rx1]; !ok {
+				return Band{}, invalid("rx1_data_rates")
+			}
+		}
+	}
+	if _, ok := b.DataRates[b.DefaultRx2Parameters.DataRateIndex]; !ok {
+		return Band{}, invalid("default_rx2")
+	}
+	if len(b.PingSlotFrequencies) > 0 && len(b.Beacon.Frequencies) == 0 {
+		return Band{}, invalid("beacon")
+	}
+
+	if err := b.Validate(); err != nil {
+		return Band{}, err
+	}
+	return b, nil
+}
+
+// builtIn contains the IDs of the built-in bands.
+var builtIn = func() map[string]struct{} {
+	ids := make(map[string]struct{}, len(All))
+	for id := range All {
+		ids[id] = struct{}{}
+	}
+	return ids
+}()
+
+// Register registers the band for the given Regional Parameters versions in All, and updates LatestVersion.
+// If no versions are given, the band is registered for all versions. Built-in bands cannot be replaced.
+// Register is safe for concurrent use with Get and GetLatest, but custom bands are meant to be registered on
+// startup: lookups that resolved a band before it is replaced keep using the previous definition.
+func Register(b Band, versions ...ttnpb.PHYVersion) error {
+	if _, ok := builtIn[b.ID]; ok {
+		return errBuiltInBand.WithAttributes("id", b.ID)
+	}
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		for v := range ttnpb.PHYVersion_name {
+			if v == int32(ttnpb.PHYVersion_PHY_UNKNOWN) {
+				continue
+			}
+			versions = append(versions, ttnpb.PHYVersion(v))
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	bands := make(map[ttnpb.PHYVersion]Band, len(versions))
+	for _, v := range versions {
+		if v == ttnpb.PHYVersion_PHY_UNKNOWN {
+			return errInvalidBand.WithAttributes("id", b.ID, "field", "phy_versions")
+		}
+		bands[v] = b
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	All[b.ID] = bands
+	LatestVersion[b.ID] = versions[len(versions)-1]
+	return nil
+}
+
+// RegisterDefinition parses the YAML band definition and registers the band.
+func RegisterDefinition(data []byte) (Band, error) {
+	def, err := ParseDefinition(data)
+	if err != nil {
+		return Band{}, err
+	}
+	b, err := def.Band()
+	if err != nil {
+		return Band{}, err
+	}
+	if err := Register(b, def.PHYVersions...); err != nil {
+		return Band{}, err
+	}
+	return b, nil
+}
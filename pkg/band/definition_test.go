@@ -0,0 +1,218 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package band_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidateBuiltIn(t *testing.T) {
+	t.Parallel()
+
+	for name, versions := range All {
+		for version, b := range versions {
+			b := b
+			t.Run(fmt.Sprintf("%v/%v", name, version), func(t *testing.T) {
+				t.Parallel()
+				assertions.New(t).So(b.Validate(), should.BeNil)
+			})
+		}
+	}
+}
+
+func TestDefinition(t *testing.T) {
+	// NOTE: This test is not parallel since it registers a band in All.
+	a := assertions.New(t)
+
+	data, err := os.ReadFile("testdata/custom_band.yml")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	b, err := RegisterDefinition(data)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		delete(All, "PRIVATE_868")
+		delete(LatestVersion, "PRIVATE_868")
+	})
+
+	a.So(b.ID, should.Equal, "PRIVATE_868")
+	a.So(b.MaxUplinkChannels, should.Equal, 16)
+	a.So(b.MaxDownlinkChannels, should.Equal, 16)
+	a.So(b.UplinkChannels, should.HaveLength, 5)
+	a.So(b.DownlinkChannels, should.Resemble, b.UplinkChannels)
+	a.So(b.DataRates, should.HaveLength, 8)
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_6].Rate.GetLora().GetBandwidth(), should.Equal, 250000)
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_7].Rate.GetFsk().GetBitRate(), should.Equal, 50000)
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_3].MaxMACPayloadSize(true), should.Equal, 123)
+	a.So(b.ImplementsCFList, should.BeTrue)
+	a.So(b.CFListType, should.Equal, ttnpb.CFListType_FREQUENCIES)
+	a.So(b.MaxTxPowerIndex(), should.Equal, 7)
+	a.So(*b.BootDwellTime.Uplinks, should.BeFalse)
+	a.So(b.Relay.WORChannels, should.HaveLength, 1)
+	a.So(b.ReceiveDelay1, should.Equal, 2*time.Second)
+	a.So(b.JoinAcceptDelay1, should.Equal, 5*time.Second)
+
+	rx1, err := b.Rx1DataRate(ttnpb.DataRateIndex_DATA_RATE_5, 2, false)
+	a.So(err, should.BeNil)
+	a.So(rx1, should.Equal, ttnpb.DataRateIndex_DATA_RATE_3)
+	_, err = b.Rx1DataRate(ttnpb.DataRateIndex_DATA_RATE_8, 0, false)
+	a.So(err, should.NotBeNil)
+	ch, err := b.Rx1Channel(4)
+	a.So(err, should.BeNil)
+	a.So(ch, should.Equal, 4)
+
+	_, err = Get("PRIVATE_868", ttnpb.PHYVersion_RP002_V1_0_3)
+	a.So(err, should.BeNil)
+	_, err = Get("PRIVATE_868", ttnpb.PHYVersion_RP001_V1_0_3_REV_A)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(LatestVersion["PRIVATE_868"], should.Equal, ttnpb.PHYVersion_RP002_V1_0_4)
+
+	// Registering the band again replaces it, which is safe for concurrent use with the band lookups.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				Get("PRIVATE_868", ttnpb.PHYVersion_RP002_V1_0_3) // nolint:errcheck
+				GetLatest("PRIVATE_868")                          // nolint:errcheck
+			}
+		}()
+	}
+	_, err = RegisterDefinition(data)
+	a.So(err, should.BeNil)
+	wg.Wait()
+
+	// Built-in bands cannot be replaced.
+	_, err = RegisterDefinition([]byte(strings.Replace(string(data), "PRIVATE_868", EU_863_870, 1)))
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+}
+
+func TestDefinitionInvalid(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/custom_band.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		Name    string
+		Old     string
+		New     string
+		Invalid string
+	}{
+		{
+			Name: "UnknownField",
+			Old:  "channel-mask: 16",
+			New:  "channel-masks: 16",
+		},
+		{
+			Name:    "ChannelMask",
+			Old:     "channel-mask: 16",
+			New:     "channel-mask: 8",
+			Invalid: "channel_mask",
+		},
+		{
+			Name:    "ChannelDataRate",
+			Old:     "max-data-rate: 6",
+			New:     "max-data-rate: 9",
+			Invalid: "uplink_channels",
+		},
+		{
+			Name:    "ChannelOutsideSubBands",
+			Old:     "frequency: 867300000",
+			New:     "frequency: 864300000",
+			Invalid: "uplink_channels",
+		},
+		{
+			Name:    "OverlappingSubBands",
+			Old:     "max-frequency: 868600000",
+			New:     "max-frequency: 869500000",
+			Invalid: "sub_bands",
+		},
+		{
+			Name:    "MultipleModulations",
+			Old:     "7: {fsk: {bit-rate: 50000}",
+			New:     "7: {fsk: {bit-rate: 50000}, lora: {spreading-factor: 7, bandwidth: 500000, coding-rate: 4/5}",
+			Invalid: "data_rates",
+		},
+		{
+			Name:    "MissingRx1DataRates",
+			Old:     "  - [7, 6, 5, 4, 3, 2]\n",
+			New:     "",
+			Invalid: "rx1_data_rates",
+		},
+		{
+			Name:    "TxOffset",
+			Old:     "tx-offset: [0, -2,",
+			New:     "tx-offset: [0, 2,",
+			Invalid: "tx_offset",
+		},
+		{
+			Name:    "Rx2DataRate",
+			Old:     "  data-rate-index: 0\n",
+			New:     "  data-rate-index: 8\n",
+			Invalid: "default_rx2",
+		},
+		{
+			Name:    "BeaconDataRate",
+			Old:     "  data-rate-index: 3\n  coding-rate",
+			New:     "  data-rate-index: 7\n  coding-rate",
+			Invalid: "beacon.data_rate_index",
+		},
+		{
+			Name:    "ReceiveDelays",
+			Old:     "receive-delay-2: 3s",
+			New:     "receive-delay-2: 1s",
+			Invalid: "receive_delays",
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			modified := strings.Replace(string(data), tc.Old, tc.New, 1)
+			if !a.So(modified, should.NotEqual, string(data)) {
+				t.FailNow()
+			}
+			def, err := ParseDefinition([]byte(modified))
+			if tc.Invalid == "" {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			_, err = def.Band()
+			if a.So(errors.IsInvalidArgument(err), should.BeTrue) {
+				a.So(errors.Attributes(err)["field"], should.Equal, tc.Invalid)
+			}
+		})
+	}
+}
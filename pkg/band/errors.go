@@ -17,9 +17,12 @@ package band
 import "go.thethings.network/lorawan-stack/v3/pkg/errors"
 
 var (
+	errBuiltInBand           = errors.DefineAlreadyExists("built_in_band", "built-in band `{id}` cannot be replaced")
 	errBandNotFound          = errors.DefineNotFound("band_not_found", "band `{id}@{version}` not found")
 	errDataRateIndexTooHigh  = errors.DefineInvalidArgument("data_rate_index_too_high", "data rate index must be lower or equal to {max}")
 	errDataRateOffsetTooHigh = errors.DefineInvalidArgument("data_rate_offset_too_high", "data rate offset must be lower or equal to {max}")
+	errInvalidBand           = errors.DefineInvalidArgument("invalid_band", "invalid field `{field}` of band `{id}`")
+	errInvalidDefinition     = errors.DefineInvalidArgument("invalid_definition", "invalid band definition")
 	errInvalidChannelCount   = errors.DefineInvalidArgument("invalid_channel_count", "invalid number of channels defined")
 	errUnsupportedChMaskCntl = errors.DefineInvalidArgument("chmaskcntl_unsupported", "ChMaskCntl `{chmaskcntl}` unsupported")
 )
@@ -25,6 +25,8 @@ import (
 
 // GetPhyVersions returns the list of supported phy versions for the given band.
 func GetPhyVersions(_ context.Context, req *ttnpb.GetPhyVersionsRequest) (*ttnpb.GetPhyVersionsResponse, error) {
+	mu.RLock()
+	defer mu.RUnlock()
 	var res *ttnpb.GetPhyVersionsResponse
 	if req.BandId != "" {
 		versions, ok := All[req.BandId]
@@ -66,9 +68,11 @@ func GetPhyVersions(_ context.Context, req *ttnpb.GetPhyVersionsRequest) (*ttnpb
 func ListBands(_ context.Context, req *ttnpb.ListBandsRequest) (*ttnpb.ListBandsResponse, error) {
 	filteredVersions := make(map[string]map[ttnpb.PHYVersion]Band)
 
+	mu.RLock()
 	if req.BandId != "" {
 		versions, ok := All[req.BandId]
 		if !ok {
+			mu.RUnlock()
 			return nil, errBandNotFound.WithAttributes("id", req.BandId)
 		}
 
@@ -78,6 +82,7 @@ func ListBands(_ context.Context, req *ttnpb.ListBandsRequest) (*ttnpb.ListBands
 	if len(filteredVersions) == 0 {
 		filteredVersions = maps.Clone(All)
 	}
+	mu.RUnlock()
 
 	if req.PhyVersion != ttnpb.PHYVersion_PHY_UNKNOWN {
 		for bandID, versions := range filteredVersions {
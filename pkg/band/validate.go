@@ -0,0 +1,140 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package band
+
+import (
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Validate checks the invariants that all bands must satisfy.
+func (b Band) Validate() error {
+	invalid := func(field string) *errors.Error {
+		return errInvalidBand.WithAttributes("id", b.ID, "field", field)
+	}
+	hasDataRate := func(idx ttnpb.DataRateIndex) bool {
+		_, ok := b.DataRates[idx]
+		return ok
+	}
+	hasSubBand := func(frequency uint64) bool {
+		_, ok := b.FindSubBand(frequency)
+		return ok
+	}
+	validChannel := func(ch Channel) bool {
+		return ch.MinDataRate <= ch.MaxDataRate &&
+			hasDataRate(ch.MinDataRate) && hasDataRate(ch.MaxDataRate) &&
+			hasSubBand(ch.Frequency)
+	}
+
+	if b.ID == "" {
+		return invalid("id")
+	}
+	if len(b.DataRates) == 0 {
+		return invalid("data_rates")
+	}
+	for idx, dr := range b.DataRates {
+		if err := dr.Rate.ValidateFields(); err != nil {
+			return invalid("data_rates").WithCause(err)
+		}
+		if dr.Rate.GetModulation() == nil {
+			return invalid("data_rates")
+		}
+		if dr.MaxMACPayloadSize == nil || dr.MaxMACPayloadSize(false) == 0 {
+			return invalid("data_rates.max_mac_payload_size")
+		}
+		if b.Rx1DataRate == nil {
+			return invalid("rx1_data_rate")
+		}
+		// Some data rates are uplink only, but the Rx1 data rates that are defined must exist.
+		if rx1, err := b.Rx1DataRate(idx, 0, false); err == nil && !hasDataRate(rx1) {
+			return invalid("rx1_data_rate")
+		}
+	}
+	if !hasDataRate(b.MaxADRDataRateIndex) {
+		return invalid("max_adr_data_rate_index")
+	}
+
+	subBands := make([]SubBandParameters, len(b.SubBands))
+	copy(subBands, b.SubBands)
+	sort.Slice(subBands, func(i, j int) bool { return subBands[i].MinFrequency < subBands[j].MinFrequency })
+	for i, sb := range subBands {
+		if sb.MinFrequency > sb.MaxFrequency || sb.DutyCycle <= 0 || sb.DutyCycle > 1 {
+			return invalid("sub_bands")
+		}
+		if i > 0 && subBands[i-1].MaxFrequency > sb.MinFrequency {
+			return invalid("sub_bands")
+		}
+	}
+
+	if len(b.UplinkChannels) > int(b.MaxUplinkChannels) {
+		return invalid("uplink_channels")
+	}
+	for _, ch := range b.UplinkChannels {
+		if !validChannel(ch) {
+			return invalid("uplink_channels")
+		}
+	}
+	if len(b.DownlinkChannels) > int(b.MaxDownlinkChannels) {
+		return invalid("downlink_channels")
+	}
+	for _, ch := range b.DownlinkChannels {
+		if !validChannel(ch) {
+			return invalid("downlink_channels")
+		}
+	}
+	if b.Rx1Channel == nil {
+		return invalid("rx1_channel")
+	}
+	if b.GenerateChMasks == nil || b.ParseChMask == nil {
+		return invalid("channel_mask")
+	}
+
+	// TxPower is encoded in 4 bits, and index 0 is the maximum EIRP.
+	if n := len(b.TxOffset); n == 0 || n > 16 || b.TxOffset[0] != 0 {
+		return invalid("tx_offset")
+	}
+	for i := 1; i < len(b.TxOffset); i++ {
+		if b.TxOffset[i] > b.TxOffset[i-1] {
+			return invalid("tx_offset")
+		}
+	}
+
+	if b.FreqMultiplier == 0 {
+		return invalid("freq_multiplier")
+	}
+	if !hasSubBand(b.DefaultRx2Parameters.Frequency) {
+		return invalid("default_rx2_parameters")
+	}
+
+	if len(b.Beacon.Frequencies) > 0 {
+		if dr, ok := b.DataRates[b.Beacon.DataRateIndex]; !ok || dr.Rate.GetLora() == nil {
+			return invalid("beacon.data_rate_index")
+		}
+	}
+
+	for _, ch := range b.Relay.WORChannels {
+		if !hasDataRate(ch.DataRateIndex) {
+			return invalid("relay.wor_channels")
+		}
+	}
+
+	if b.ReceiveDelay1 <= 0 || b.ReceiveDelay2 <= b.ReceiveDelay1 ||
+		b.JoinAcceptDelay1 <= 0 || b.JoinAcceptDelay2 <= b.JoinAcceptDelay1 {
+		return invalid("receive_delays")
+	}
+	return nil
+}
@@ -17,13 +17,26 @@ package cups
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"hash/crc32"
+	"os"
+	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/blob"
 	"google.golang.org/grpc"
 )
 
+// firmwarePrefix is the prefix of the firmware updates in the firmware bucket.
+const firmwarePrefix = "cups/firmware"
+
 // ServerConfig is the configuration of the CUPS server.
 type ServerConfig struct {
 	ExplicitEnable  bool `name:"require-explicit-enable" description:"Require gateways to explicitly enable CUPS. This option is ineffective"` //nolint:lll
@@ -36,10 +49,51 @@ type ServerConfig struct {
 		LNSURI string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
 	} `name:"default" description:"Default gateway settings"`
 	AllowCUPSURIUpdate bool `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	Firmware           struct {
+		Bucket      string        `name:"bucket" description:"Bucket of the blob store in which the firmware updates are stored"`
+		SigningKeys []string      `name:"signing-keys" description:"Paths to PEM encoded ECDSA private keys to sign firmware updates"` //nolint:lll
+		CacheTTL    time.Duration `name:"cache-ttl" description:"Time after which the firmware catalog is reloaded from the bucket"`   //nolint:lll
+	} `name:"firmware" description:"Firmware update configuration"`
+}
+
+var errSigningKey = errors.DefineInvalidArgument("signing_key", "invalid signing key `{path}`")
+
+// loadSigningKey loads the PEM encoded ECDSA private key from the given path.
+// It returns the CRC of the public key, as computed by the gateways, and the signer.
+func loadSigningKey(path string) (uint32, crypto.Signer, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, errSigningKey.WithAttributes("path", path).WithCause(err)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return 0, nil, errSigningKey.WithAttributes("path", path)
+	}
+	var key *ecdsa.PrivateKey
+	if block.Type == "EC PRIVATE KEY" {
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	} else {
+		var pkcs8Key any
+		if pkcs8Key, err = x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			var ok bool
+			if key, ok = pkcs8Key.(*ecdsa.PrivateKey); !ok {
+				return 0, nil, errSigningKey.WithAttributes("path", path)
+			}
+		}
+	}
+	if err != nil {
+		return 0, nil, errSigningKey.WithAttributes("path", path).WithCause(err)
+	}
+	// The gateways store the public key as the concatenation of the X and Y coordinates.
+	size := (key.Curve.Params().BitSize + 7) / 8
+	pub := make([]byte, 2*size)
+	key.X.FillBytes(pub[:size])
+	key.Y.FillBytes(pub[size:])
+	return crc32.ChecksumIEEE(pub), key, nil
 }
 
 // NewServer returns a new CUPS server from this config on top of the component.
-func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) *Server {
+func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) (*Server, error) {
 	opts := []Option{
 		WithAllowCUPSURIUpdate(conf.AllowCUPSURIUpdate),
 		WithDefaultLNSURI(conf.Default.LNSURI),
@@ -71,7 +125,23 @@ func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option)
 	if tlsConfig, err := c.GetTLSClientConfig(c.Context()); err == nil {
 		opts = append(opts, WithTLSConfig(tlsConfig))
 	}
+	if conf.Firmware.Bucket != "" {
+		store := firmware.NewBucketStore(func(ctx context.Context) (*blob.Bucket, error) {
+			return c.GetBaseConfig(ctx).Blob.Bucket(ctx, conf.Firmware.Bucket, c)
+		}, firmwarePrefix)
+		if conf.Firmware.CacheTTL > 0 {
+			store = firmware.NewCacheStore(store, conf.Firmware.CacheTTL)
+		}
+		opts = append(opts, WithFirmwareStore(store))
+	}
+	for _, path := range conf.Firmware.SigningKeys {
+		keyCRC, signer, err := loadSigningKey(path)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithSigner(keyCRC, signer))
+	}
 	s := NewServer(c, append(opts, customOpts...)...)
 	c.RegisterWeb(s)
-	return s
+	return s, nil
 }
@@ -0,0 +1,65 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestLoadSigningKey(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sig-0.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The gateway computes the CRC over the last 64 bytes of the DER encoded public key.
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	keyCRC, signer, err := loadSigningKey(path)
+	if a.So(err, should.BeNil) {
+		a.So(keyCRC, should.Equal, crc32.ChecksumIEEE(pub[len(pub)-64:]))
+		a.So(signer.Public(), should.Resemble, key.Public())
+	}
+
+	_, _, err = loadSigningKey(filepath.Join(dir, "unknown.pem"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
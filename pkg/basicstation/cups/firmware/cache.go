@@ -0,0 +1,134 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firmware
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/sync/singleflight"
+)
+
+// dataCacheSize is the number of firmware update data that is cached.
+// The update data is typically several megabytes, so only the most recently sent firmware is cached.
+const dataCacheSize = 4
+
+// NewCacheStore returns a Store that caches the firmware catalog of the given store for the given time-to-live.
+// The update data is cached by the hash in the catalog, so that it is not read from the store on every update.
+// Changes through the returned Store invalidate the cached catalog. Changes through other stores, such as the stores
+// of other replicas, are visible when the cached catalog expires.
+func NewCacheStore(inner Store, ttl time.Duration) Store {
+	return &cacheStore{
+		Store: inner,
+		ttl:   ttl,
+		data:  gcache.New(dataCacheSize).LRU().Build(),
+	}
+}
+
+type cacheStore struct {
+	Store
+	ttl time.Duration
+
+	listGroup  singleflight.Group
+	listMu     sync.RWMutex
+	list       []*ttnpb.GatewayFirmware
+	listExpiry time.Time
+
+	data gcache.Cache
+}
+
+func (s *cacheStore) invalidate() {
+	s.listMu.Lock()
+	s.list, s.listExpiry = nil, time.Time{}
+	s.listMu.Unlock()
+}
+
+// List implements Store.
+// The returned firmware must not be modified.
+func (s *cacheStore) List(ctx context.Context) ([]*ttnpb.GatewayFirmware, error) {
+	s.listMu.RLock()
+	fws, expiry := s.list, s.listExpiry
+	s.listMu.RUnlock()
+	if time.Now().Before(expiry) {
+		return fws, nil
+	}
+	v, err, _ := s.listGroup.Do("", func() (any, error) {
+		fws, err := s.Store.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.listMu.Lock()
+		s.list, s.listExpiry = fws, time.Now().Add(s.ttl)
+		s.listMu.Unlock()
+		return fws, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*ttnpb.GatewayFirmware), nil
+}
+
+// Create implements Store.
+func (s *cacheStore) Create(
+	ctx context.Context, fw *ttnpb.GatewayFirmware, data []byte,
+) (*ttnpb.GatewayFirmware, error) {
+	defer s.invalidate()
+	return s.Store.Create(ctx, fw, data)
+}
+
+// Update implements Store.
+func (s *cacheStore) Update(
+	ctx context.Context, fw *ttnpb.GatewayFirmware, paths ...string,
+) (*ttnpb.GatewayFirmware, error) {
+	defer s.invalidate()
+	return s.Store.Update(ctx, fw, paths...)
+}
+
+// Delete implements Store.
+func (s *cacheStore) Delete(ctx context.Context, id string) error {
+	defer s.invalidate()
+	return s.Store.Delete(ctx, id)
+}
+
+// Data implements Store.
+func (s *cacheStore) Data(ctx context.Context, id string) ([]byte, error) {
+	fws, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var key string
+	for _, fw := range fws {
+		if fw.FirmwareId == id {
+			key = id + ":" + hex.EncodeToString(fw.Sha512)
+			break
+		}
+	}
+	if key == "" {
+		return s.Store.Data(ctx, id)
+	}
+	if v, err := s.data.Get(key); err == nil {
+		return v.([]byte), nil
+	}
+	data, err := s.Store.Data(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.data.Set(key, data) //nolint:errcheck
+	return data, nil
+}
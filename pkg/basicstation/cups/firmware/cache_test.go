@@ -0,0 +1,112 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firmware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
+	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/blob"
+)
+
+type countingStore struct {
+	firmware.Store
+	lists, datas int
+}
+
+func (s *countingStore) List(ctx context.Context) ([]*ttnpb.GatewayFirmware, error) {
+	s.lists++
+	return s.Store.List(ctx)
+}
+
+func (s *countingStore) Data(ctx context.Context, id string) ([]byte, error) {
+	s.datas++
+	return s.Store.Data(ctx, id)
+}
+
+func TestCacheStore(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	dir := t.TempDir()
+	inner := &countingStore{
+		Store: firmware.NewBucketStore(func(ctx context.Context) (*blob.Bucket, error) {
+			return ttnblob.Local(ctx, "firmware", dir)
+		}, "cups/firmware"),
+	}
+	const ttl = 200 * time.Millisecond
+	store := firmware.NewCacheStore(inner, ttl)
+
+	data := []byte("update data")
+	_, err := store.Create(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId: "minihub-2-1-0",
+		Model:      "minihub",
+		Package:    "2.1.0",
+	}, data)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The catalog is listed once.
+	for i := 0; i < 3; i++ {
+		fws, err := store.List(ctx)
+		a.So(err, should.BeNil)
+		a.So(fws, should.HaveLength, 1)
+	}
+	a.So(inner.lists, should.Equal, 1)
+
+	// The update data is read once.
+	for i := 0; i < 3; i++ {
+		b, err := store.Data(ctx, "minihub-2-1-0")
+		a.So(err, should.BeNil)
+		a.So(b, should.Resemble, data)
+	}
+	a.So(inner.lists, should.Equal, 1)
+	a.So(inner.datas, should.Equal, 1)
+
+	// Changes through the store invalidate the catalog.
+	_, err = store.Update(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId:        "minihub-2-1-0",
+		RolloutPercentage: 50,
+	}, "rollout_percentage")
+	a.So(err, should.BeNil)
+	fws, err := store.List(ctx)
+	if a.So(err, should.BeNil) && a.So(fws, should.HaveLength, 1) {
+		a.So(fws[0].RolloutPercentage, should.Equal, 50)
+	}
+	a.So(inner.lists, should.Equal, 2)
+
+	// Changes through other stores are visible when the catalog expires.
+	_, err = inner.Create(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId: "corecell-1-0-0",
+		Model:      "corecell",
+		Package:    "1.0.0",
+	}, []byte("other data"))
+	a.So(err, should.BeNil)
+	fws, err = store.List(ctx)
+	a.So(err, should.BeNil)
+	a.So(fws, should.HaveLength, 1)
+	time.Sleep(ttl)
+	fws, err = store.List(ctx)
+	a.So(err, should.BeNil)
+	a.So(fws, should.HaveLength, 2)
+	a.So(inner.lists, should.Equal, 3)
+}
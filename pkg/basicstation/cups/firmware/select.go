@@ -0,0 +1,86 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firmware
+
+import (
+	"hash/fnv"
+	"strings"
+
+	"github.com/blang/semver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Target is the gateway for which a firmware update is selected.
+type Target struct {
+	EUI types.EUI64
+	// Station, Model and Package are reported by the gateway in the CUPS request.
+	Station string
+	Model   string
+	Package string
+	// UpdateChannel is the update channel of the gateway in the registry.
+	UpdateChannel string
+}
+
+// InRollout returns whether the gateway with the given EUI is part of the rollout of the firmware.
+// The gateways are selected deterministically per firmware, so that increasing the rollout percentage
+// extends the rollout to more gateways while keeping the gateways that were already selected.
+func InRollout(eui types.EUI64, fw *ttnpb.GatewayFirmware) bool {
+	h := fnv.New32a()
+	h.Write(eui[:])                //nolint:errcheck
+	h.Write([]byte(fw.FirmwareId)) //nolint:errcheck
+	return h.Sum32()%100 < fw.RolloutPercentage
+}
+
+// Select returns the firmware with the highest package version that applies to the target and that is newer
+// than the package version reported by the target. If no firmware applies, Select returns nil.
+// If the target does not report a package version, any applicable firmware is considered newer.
+func Select(target Target, fws ...*ttnpb.GatewayFirmware) *ttnpb.GatewayFirmware {
+	var current *semver.Version
+	if target.Package != "" {
+		v, err := semver.ParseTolerant(target.Package)
+		if err != nil {
+			// The versions cannot be compared, so it is not safe to deliver an update.
+			return nil
+		}
+		current = &v
+	}
+	var (
+		selected        *ttnpb.GatewayFirmware
+		selectedVersion semver.Version
+	)
+	for _, fw := range fws {
+		if fw.Model != target.Model ||
+			fw.UpdateChannel != target.UpdateChannel ||
+			!strings.HasPrefix(target.Station, fw.Station) {
+			continue
+		}
+		v, err := semver.ParseTolerant(fw.Package)
+		if err != nil {
+			continue
+		}
+		if current != nil && !v.GT(*current) {
+			continue
+		}
+		if selected != nil && !v.GT(selectedVersion) {
+			continue
+		}
+		if !InRollout(target.EUI, fw) {
+			continue
+		}
+		selected, selectedVersion = fw, v
+	}
+	return selected
+}
@@ -0,0 +1,164 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firmware_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	eui := types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x19}
+	fw := func(id, model, station, pkg, channel string) *ttnpb.GatewayFirmware {
+		return &ttnpb.GatewayFirmware{
+			FirmwareId:        id,
+			Model:             model,
+			Station:           station,
+			Package:           pkg,
+			UpdateChannel:     channel,
+			RolloutPercentage: 100,
+		}
+	}
+	fws := []*ttnpb.GatewayFirmware{
+		fw("minihub-2-0-5", "minihub", "", "2.0.5", ""),
+		fw("minihub-2-1-0", "minihub", "", "2.1.0", ""),
+		fw("minihub-2-2-0-beta", "minihub", "", "2.2.0", "beta"),
+		fw("minihub-2-0-6-debug", "minihub", "2.0.5(minihub/debug)", "2.0.6", ""),
+		fw("corecell-3-0-0", "corecell", "", "3.0.0", ""),
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Target   Target
+		Expected string
+	}{
+		{
+			Name: "Newer",
+			Target: Target{
+				EUI:     eui,
+				Model:   "minihub",
+				Package: "2.0.0",
+			},
+			Expected: "minihub-2-1-0",
+		},
+		{
+			Name: "UpToDate",
+			Target: Target{
+				EUI:     eui,
+				Model:   "minihub",
+				Package: "2.1.0",
+			},
+		},
+		{
+			Name: "NoPackage",
+			Target: Target{
+				EUI:   eui,
+				Model: "minihub",
+			},
+			Expected: "minihub-2-1-0",
+		},
+		{
+			Name: "InvalidPackage",
+			Target: Target{
+				EUI:     eui,
+				Model:   "minihub",
+				Package: "invalid",
+			},
+		},
+		{
+			Name: "UpdateChannel",
+			Target: Target{
+				EUI:           eui,
+				Model:         "minihub",
+				Package:       "2.1.0",
+				UpdateChannel: "beta",
+			},
+			Expected: "minihub-2-2-0-beta",
+		},
+		{
+			Name: "Station",
+			Target: Target{
+				EUI:     eui,
+				Model:   "minihub",
+				Station: "2.0.5(minihub/debug) 2021-01-01 00:00:00",
+				Package: "2.0.5",
+			},
+			Expected: "minihub-2-1-0",
+		},
+		{
+			Name: "UnknownModel",
+			Target: Target{
+				EUI:     eui,
+				Model:   "unknown",
+				Package: "1.0.0",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			selected := Select(tc.Target, fws...)
+			if tc.Expected == "" {
+				a.So(selected, should.BeNil)
+			} else if a.So(selected, should.NotBeNil) {
+				a.So(selected.FirmwareId, should.Equal, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestInRollout(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	fw := &ttnpb.GatewayFirmware{FirmwareId: "minihub-2-1-0"}
+	selected := func() map[string]bool {
+		m := make(map[string]bool)
+		for i := 0; i < 1000; i++ {
+			eui := types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, byte(i >> 8), byte(i)}
+			if InRollout(eui, fw) {
+				m[fmt.Sprint(eui)] = true
+			}
+		}
+		return m
+	}
+
+	fw.RolloutPercentage = 0
+	a.So(selected(), should.BeEmpty)
+
+	fw.RolloutPercentage = 10
+	partial := selected()
+	a.So(len(partial), should.BeBetween, 50, 150)
+
+	// Increasing the percentage keeps the gateways that were selected before.
+	fw.RolloutPercentage = 50
+	extended := selected()
+	a.So(len(extended), should.BeBetween, 400, 600)
+	for eui := range partial {
+		a.So(extended[eui], should.BeTrue)
+	}
+
+	fw.RolloutPercentage = 100
+	a.So(selected(), should.HaveLength, 1000)
+}
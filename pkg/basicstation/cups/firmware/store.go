@@ -0,0 +1,278 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package firmware implements the firmware catalog of the CUPS server.
+package firmware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	metadataKey = "metadata"
+	dataKey     = "data"
+)
+
+var (
+	errFirmwareNotFound      = errors.DefineNotFound("firmware_not_found", "firmware `{firmware_id}` not found")
+	errFirmwareAlreadyExists = errors.DefineAlreadyExists(
+		"firmware_already_exists", "firmware `{firmware_id}` already exists",
+	)
+	errInvalidPackage = errors.DefineInvalidArgument(
+		"invalid_package", "invalid package version `{package}`",
+	)
+	errDataIntegrity = errors.DefineDataLoss(
+		"data_integrity", "data of firmware `{firmware_id}` does not match its hash",
+	)
+	errReadFirmware  = errors.Define("read_firmware", "read firmware `{firmware_id}`")
+	errWriteFirmware = errors.Define("write_firmware", "write firmware `{firmware_id}`")
+)
+
+// Store is a firmware catalog.
+type Store interface {
+	// Get returns the firmware metadata.
+	Get(ctx context.Context, id string) (*ttnpb.GatewayFirmware, error)
+	// List returns the metadata of all firmware, ordered by identifier.
+	List(ctx context.Context) ([]*ttnpb.GatewayFirmware, error)
+	// Create stores the firmware metadata and update data.
+	// The size, hash and timestamps of the metadata are set by the store.
+	Create(ctx context.Context, fw *ttnpb.GatewayFirmware, data []byte) (*ttnpb.GatewayFirmware, error)
+	// Update updates the given paths of the firmware metadata.
+	Update(ctx context.Context, fw *ttnpb.GatewayFirmware, paths ...string) (*ttnpb.GatewayFirmware, error)
+	// Delete deletes the firmware metadata and update data.
+	Delete(ctx context.Context, id string) error
+	// Data returns the update data of the firmware.
+	Data(ctx context.Context, id string) ([]byte, error)
+}
+
+// BucketOpener opens the blob bucket in which the firmware is stored.
+type BucketOpener func(ctx context.Context) (*blob.Bucket, error)
+
+// NewBucketStore returns a Store that stores the firmware in the blob bucket that is opened by open.
+// The firmware is stored under the given prefix, with the metadata and the update data as separate objects.
+func NewBucketStore(open BucketOpener, prefix string) Store {
+	return &bucketStore{
+		open:   open,
+		prefix: prefix,
+	}
+}
+
+type bucketStore struct {
+	open   BucketOpener
+	prefix string
+}
+
+func (s *bucketStore) key(id, name string) string {
+	return path.Join(s.prefix, id, name)
+}
+
+func (s *bucketStore) withBucket(ctx context.Context, f func(*blob.Bucket) error) error {
+	bucket, err := s.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+	return f(bucket)
+}
+
+func (s *bucketStore) get(ctx context.Context, bucket *blob.Bucket, id string) (*ttnpb.GatewayFirmware, error) {
+	b, err := bucket.ReadAll(ctx, s.key(id, metadataKey))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errFirmwareNotFound.WithAttributes("firmware_id", id)
+		}
+		return nil, errReadFirmware.WithAttributes("firmware_id", id).WithCause(err)
+	}
+	fw := &ttnpb.GatewayFirmware{}
+	if err := proto.Unmarshal(b, fw); err != nil {
+		return nil, errReadFirmware.WithAttributes("firmware_id", id).WithCause(err)
+	}
+	return fw, nil
+}
+
+func (s *bucketStore) put(ctx context.Context, bucket *blob.Bucket, fw *ttnpb.GatewayFirmware) error {
+	b, err := proto.Marshal(fw)
+	if err != nil {
+		return err
+	}
+	err = bucket.WriteAll(ctx, s.key(fw.FirmwareId, metadataKey), b, ttnblob.WriterOptions("application/x-protobuf"))
+	if err != nil {
+		return errWriteFirmware.WithAttributes("firmware_id", fw.FirmwareId).WithCause(err)
+	}
+	return nil
+}
+
+// Get implements Store.
+func (s *bucketStore) Get(ctx context.Context, id string) (fw *ttnpb.GatewayFirmware, err error) {
+	err = s.withBucket(ctx, func(bucket *blob.Bucket) error {
+		fw, err = s.get(ctx, bucket, id)
+		return err
+	})
+	return fw, err
+}
+
+// List implements Store.
+func (s *bucketStore) List(ctx context.Context) (fws []*ttnpb.GatewayFirmware, err error) {
+	err = s.withBucket(ctx, func(bucket *blob.Bucket) error {
+		prefix := s.prefix
+		if prefix != "" {
+			prefix += "/"
+		}
+		iter := bucket.List(&blob.ListOptions{Prefix: prefix})
+		for {
+			obj, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			id, name := path.Split(strings.TrimPrefix(obj.Key, prefix))
+			if name != metadataKey {
+				continue
+			}
+			fw, err := s.get(ctx, bucket, strings.TrimSuffix(id, "/"))
+			if err != nil {
+				if errors.IsNotFound(err) {
+					// The firmware has been deleted while listing.
+					continue
+				}
+				return err
+			}
+			fws = append(fws, fw)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(fws, func(i, j int) bool { return fws[i].FirmwareId < fws[j].FirmwareId })
+	return fws, nil
+}
+
+// Create implements Store.
+func (s *bucketStore) Create(
+	ctx context.Context, fw *ttnpb.GatewayFirmware, data []byte,
+) (*ttnpb.GatewayFirmware, error) {
+	if _, err := semver.ParseTolerant(fw.Package); err != nil {
+		return nil, errInvalidPackage.WithAttributes("package", fw.Package).WithCause(err)
+	}
+	hash := sha512.Sum512(data)
+	now := timestamppb.Now()
+	fw = proto.Clone(fw).(*ttnpb.GatewayFirmware)
+	fw.CreatedAt, fw.UpdatedAt = now, now
+	fw.Size, fw.Sha512 = uint64(len(data)), hash[:]
+	err := s.withBucket(ctx, func(bucket *blob.Bucket) error {
+		exists, err := bucket.Exists(ctx, s.key(fw.FirmwareId, metadataKey))
+		if err != nil {
+			return errReadFirmware.WithAttributes("firmware_id", fw.FirmwareId).WithCause(err)
+		}
+		if exists {
+			return errFirmwareAlreadyExists.WithAttributes("firmware_id", fw.FirmwareId)
+		}
+		// The data is written before the metadata, so that firmware is never listed without its data.
+		err = bucket.WriteAll(ctx, s.key(fw.FirmwareId, dataKey), data, ttnblob.WriterOptions("application/octet-stream"))
+		if err != nil {
+			return errWriteFirmware.WithAttributes("firmware_id", fw.FirmwareId).WithCause(err)
+		}
+		return s.put(ctx, bucket, fw)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fw, nil
+}
+
+// Update implements Store.
+func (s *bucketStore) Update(
+	ctx context.Context, fw *ttnpb.GatewayFirmware, paths ...string,
+) (updated *ttnpb.GatewayFirmware, err error) {
+	if ttnpb.HasAnyField(paths, "package") {
+		if _, err := semver.ParseTolerant(fw.Package); err != nil {
+			return nil, errInvalidPackage.WithAttributes("package", fw.Package).WithCause(err)
+		}
+	}
+	err = s.withBucket(ctx, func(bucket *blob.Bucket) error {
+		updated, err = s.get(ctx, bucket, fw.FirmwareId)
+		if err != nil {
+			return err
+		}
+		if err := updated.SetFields(fw, paths...); err != nil {
+			return err
+		}
+		updated.UpdatedAt = timestamppb.Now()
+		return s.put(ctx, bucket, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete implements Store.
+func (s *bucketStore) Delete(ctx context.Context, id string) error {
+	return s.withBucket(ctx, func(bucket *blob.Bucket) error {
+		// The metadata is deleted before the data, so that firmware is never listed without its data.
+		if err := bucket.Delete(ctx, s.key(id, metadataKey)); err != nil {
+			if gcerrors.Code(err) == gcerrors.NotFound {
+				return errFirmwareNotFound.WithAttributes("firmware_id", id)
+			}
+			return errWriteFirmware.WithAttributes("firmware_id", id).WithCause(err)
+		}
+		if err := bucket.Delete(ctx, s.key(id, dataKey)); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return errWriteFirmware.WithAttributes("firmware_id", id).WithCause(err)
+		}
+		return nil
+	})
+}
+
+// Data implements Store.
+func (s *bucketStore) Data(ctx context.Context, id string) (data []byte, err error) {
+	err = s.withBucket(ctx, func(bucket *blob.Bucket) error {
+		fw, err := s.get(ctx, bucket, id)
+		if err != nil {
+			return err
+		}
+		data, err = bucket.ReadAll(ctx, s.key(id, dataKey))
+		if err != nil {
+			if gcerrors.Code(err) == gcerrors.NotFound {
+				return errFirmwareNotFound.WithAttributes("firmware_id", id)
+			}
+			return errReadFirmware.WithAttributes("firmware_id", id).WithCause(err)
+		}
+		if hash := sha512.Sum512(data); !bytes.Equal(hash[:], fw.Sha512) {
+			return errDataIntegrity.WithAttributes("firmware_id", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
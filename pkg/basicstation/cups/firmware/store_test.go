@@ -0,0 +1,115 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firmware_test
+
+import (
+	"context"
+	"crypto/sha512"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
+	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/blob"
+)
+
+func TestBucketStore(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	dir := t.TempDir()
+	store := firmware.NewBucketStore(func(ctx context.Context) (*blob.Bucket, error) {
+		return ttnblob.Local(ctx, "firmware", dir)
+	}, "cups/firmware")
+
+	fws, err := store.List(ctx)
+	a.So(err, should.BeNil)
+	a.So(fws, should.BeEmpty)
+
+	data := []byte("update data")
+	hash := sha512.Sum512(data)
+	created, err := store.Create(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId: "minihub-2-1-0",
+		Model:      "minihub",
+		Package:    "2.1.0",
+	}, data)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(created.Size, should.Equal, len(data))
+	a.So(created.Sha512, should.Resemble, hash[:])
+	a.So(created.CreatedAt, should.NotBeNil)
+
+	_, err = store.Create(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId: "minihub-2-1-0",
+		Model:      "minihub",
+		Package:    "2.1.0",
+	}, data)
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	_, err = store.Create(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId: "minihub-invalid",
+		Model:      "minihub",
+		Package:    "invalid",
+	}, data)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = store.Create(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId: "corecell-1-0-0",
+		Model:      "corecell",
+		Package:    "1.0.0",
+	}, []byte("other data"))
+	a.So(err, should.BeNil)
+
+	fw, err := store.Get(ctx, "minihub-2-1-0")
+	a.So(err, should.BeNil)
+	a.So(fw, should.Resemble, created)
+
+	updated, err := store.Update(ctx, &ttnpb.GatewayFirmware{
+		FirmwareId:        "minihub-2-1-0",
+		RolloutPercentage: 50,
+		Model:             "other",
+	}, "rollout_percentage")
+	if a.So(err, should.BeNil) {
+		a.So(updated.RolloutPercentage, should.Equal, 50)
+		a.So(updated.Model, should.Equal, "minihub")
+		a.So(updated.Sha512, should.Resemble, hash[:])
+	}
+
+	fws, err = store.List(ctx)
+	if a.So(err, should.BeNil) && a.So(fws, should.HaveLength, 2) {
+		a.So(fws[0].FirmwareId, should.Equal, "corecell-1-0-0")
+		a.So(fws[1], should.Resemble, updated)
+	}
+
+	b, err := store.Data(ctx, "minihub-2-1-0")
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, data)
+
+	a.So(store.Delete(ctx, "minihub-2-1-0"), should.BeNil)
+	_, err = store.Get(ctx, "minihub-2-1-0")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = store.Data(ctx, "minihub-2-1-0")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(errors.IsNotFound(store.Delete(ctx, "minihub-2-1-0")), should.BeTrue)
+
+	fws, err = store.List(ctx)
+	if a.So(err, should.BeNil) && a.So(fws, should.HaveLength, 1) {
+		a.So(fws[0].FirmwareId, should.Equal, "corecell-1-0-0")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type firmwareRegistryServer struct {
+	ttnpb.UnsafeGatewayFirmwareRegistryServer
+	store firmware.Store
+}
+
+var _ ttnpb.GatewayFirmwareRegistryServer = (*firmwareRegistryServer)(nil)
+
+// Create implements ttnpb.GatewayFirmwareRegistryServer.
+func (r *firmwareRegistryServer) Create(
+	ctx context.Context, req *ttnpb.CreateGatewayFirmwareRequest,
+) (*ttnpb.GatewayFirmware, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return r.store.Create(ctx, req.Firmware, req.Data)
+}
+
+// Get implements ttnpb.GatewayFirmwareRegistryServer.
+func (r *firmwareRegistryServer) Get(
+	ctx context.Context, req *ttnpb.GetGatewayFirmwareRequest,
+) (*ttnpb.GatewayFirmware, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return r.store.Get(ctx, req.FirmwareId)
+}
+
+// List implements ttnpb.GatewayFirmwareRegistryServer.
+func (r *firmwareRegistryServer) List(
+	ctx context.Context, req *ttnpb.ListGatewayFirmwaresRequest,
+) (*ttnpb.GatewayFirmwares, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	fws, err := r.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.GatewayFirmwares{}
+	for _, fw := range fws {
+		if req.Model != "" && fw.Model != req.Model {
+			continue
+		}
+		res.Firmwares = append(res.Firmwares, fw)
+	}
+	return res, nil
+}
+
+// Update implements ttnpb.GatewayFirmwareRegistryServer.
+func (r *firmwareRegistryServer) Update(
+	ctx context.Context, req *ttnpb.UpdateGatewayFirmwareRequest,
+) (*ttnpb.GatewayFirmware, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return r.store.Update(ctx, req.Firmware, req.FieldMask.GetPaths()...)
+}
+
+// Delete implements ttnpb.GatewayFirmwareRegistryServer.
+func (r *firmwareRegistryServer) Delete(
+	ctx context.Context, req *ttnpb.DeleteGatewayFirmwareRequest,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.store.Delete(ctx, req.FirmwareId); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// RegisterServices registers the firmware registry if the CUPS server is configured with a firmware catalog.
+func (s *Server) RegisterServices(grpcServer *grpc.Server) {
+	if s.firmware == nil {
+		return
+	}
+	ttnpb.RegisterGatewayFirmwareRegistryServer(grpcServer, &firmwareRegistryServer{store: s.firmware})
+}
+
+// RegisterHandlers registers the firmware registry handlers if the CUPS server is configured with a
+// firmware catalog.
+//
+//nolint:errcheck
+func (s *Server) RegisterHandlers(mux *runtime.ServeMux, conn *grpc.ClientConn) {
+	if s.firmware == nil {
+		return
+	}
+	ttnpb.RegisterGatewayFirmwareRegistryHandler(s.component.Context(), mux, conn)
+}
@@ -19,7 +19,22 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtSendFirmware = events.Define(
+		"gcs.cups.firmware.send", "send firmware update",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithDataType(&ttnpb.GatewayFirmware{}),
+	)
+	evtUpdateFirmware = events.Define(
+		"gcs.cups.firmware.update", "update firmware",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithDataType(&ttnpb.GatewayFirmwareUpdate{}),
+	)
 )
 
 type messageMetrics struct {
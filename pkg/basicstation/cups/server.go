@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
@@ -60,7 +61,8 @@ type Server struct {
 	trustCacheMu sync.RWMutex
 	trustCache   map[string]*x509.Certificate
 
-	signers map[uint32]crypto.Signer
+	signers  map[uint32]crypto.Signer
+	firmware firmware.Store
 }
 
 func (s *Server) getServerAuth(ctx context.Context) grpc.CallOption {
@@ -148,6 +150,14 @@ func WithSigner(keyCRC uint32, signer crypto.Signer) Option {
 	}
 }
 
+// WithFirmwareStore configures the CUPS server with a firmware catalog.
+// The firmware is delivered to gateways that have automatic updates enabled.
+func WithFirmwareStore(store firmware.Store) Option {
+	return func(s *Server) {
+		s.firmware = store
+	}
+}
+
 // WithRegistries overrides the CUPS server's gateway registries.
 func WithRegistries(registry ttnpb.GatewayRegistryClient, access ttnpb.GatewayAccessClient) Option {
 	return func(s *Server) {
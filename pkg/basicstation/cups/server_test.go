@@ -16,6 +16,10 @@ package cups
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
 	"crypto/tls"
 	"io"
 	"net/http"
@@ -26,6 +30,8 @@ import (
 
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
+	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -36,6 +42,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/blob"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
@@ -174,6 +181,14 @@ var (
 	}
 )
 
+type noDataFirmwareStore struct {
+	firmware.Store
+}
+
+func (*noDataFirmwareStore) Data(context.Context, string) ([]byte, error) {
+	panic("Data must not be called")
+}
+
 func TestServer(t *testing.T) { //nolint:gocyclo
 	t.Parallel()
 	tlsServer := httptest.NewTLSServer(http.HandlerFunc(http.NotFound))
@@ -217,6 +232,25 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 		return &gtw
 	}
 
+	firmwareDir := t.TempDir()
+	firmwareStore := firmware.NewBucketStore(func(ctx context.Context) (*blob.Bucket, error) {
+		return ttnblob.Local(ctx, "firmware", firmwareDir)
+	}, firmwarePrefix)
+	firmwareData := []byte("update data")
+	_, err := firmwareStore.Create(test.Context(), &ttnpb.GatewayFirmware{
+		FirmwareId:        "minihub-2-1-0",
+		Model:             "minihub",
+		Package:           "2.1.0",
+		RolloutPercentage: 100,
+	}, firmwareData)
+	if err != nil {
+		t.Fatalf("Failed to create firmware: %v", err)
+	}
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate signing key: %v", err)
+	}
+
 	for _, tt := range []struct {
 		Name           string
 		StoreSetup     func(*mockGatewayClient)
@@ -558,6 +592,90 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				}
 			},
 		},
+		{
+			Name: "Firmware Update",
+			StoreSetup: func(c *mockGatewayClient) {
+				gtw := mockGateway(true, false, false)
+				gtw.GatewayServerAddress = "ws://192.168.2.3:1885"
+				gtw.AutoUpdate = true
+				c.res.Get = gtw
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithFirmwareStore(firmwareStore),
+				WithSigner(392840017, signingKey),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+				var res UpdateInfoResponse
+				err := res.UnmarshalBinary(rec.Body.Bytes())
+				a.So(err, should.BeNil)
+				a.So(res.SignatureKeyCRC, should.Equal, 392840017)
+				a.So(res.UpdateData, should.Resemble, firmwareData)
+				hash := sha512.Sum512(firmwareData)
+				a.So(ecdsa.VerifyASN1(&signingKey.PublicKey, hash[:], res.Signature), should.BeTrue)
+			},
+		},
+		{
+			Name: "Firmware Update Without Trusted Signing Key",
+			StoreSetup: func(c *mockGatewayClient) {
+				gtw := mockGateway(true, false, false)
+				gtw.GatewayServerAddress = "ws://192.168.2.3:1885"
+				gtw.AutoUpdate = true
+				c.res.Get = gtw
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				// The update data is only read when the update is sent.
+				WithFirmwareStore(&noDataFirmwareStore{firmwareStore}),
+				WithSigner(1, signingKey),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+				var res UpdateInfoResponse
+				err := res.UnmarshalBinary(rec.Body.Bytes())
+				a.So(err, should.BeNil)
+				a.So(res.Signature, should.BeEmpty)
+				a.So(res.UpdateData, should.BeEmpty)
+			},
+		},
+		{
+			Name: "Firmware Update Without Auto Update",
+			StoreSetup: func(c *mockGatewayClient) {
+				gtw := mockGateway(true, false, false)
+				gtw.GatewayServerAddress = "ws://192.168.2.3:1885"
+				c.res.Get = gtw
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithFirmwareStore(firmwareStore),
+				WithSigner(392840017, signingKey),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+				var res UpdateInfoResponse
+				err := res.UnmarshalBinary(rec.Body.Bytes())
+				a.So(err, should.BeNil)
+				a.So(res.SignatureKeyCRC, should.BeZeroValue)
+				a.So(res.Signature, should.BeEmpty)
+				a.So(res.UpdateData, should.BeEmpty)
+			},
+		},
 	} {
 		tt := tt
 		t.Run(tt.Name, func(t *testing.T) {
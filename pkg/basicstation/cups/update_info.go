@@ -28,7 +28,9 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/firmware"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -144,6 +146,24 @@ var getGatewayMask = ttnpb.FieldMask(
 	"target_cups_key",
 )
 
+// selectFirmware returns the firmware update for the gateway.
+// If no update applies to the gateway, selectFirmware returns nil.
+func (s *Server) selectFirmware(
+	ctx context.Context, req UpdateInfoRequest, gtw *ttnpb.Gateway,
+) (*ttnpb.GatewayFirmware, error) {
+	fws, err := s.firmware.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return firmware.Select(firmware.Target{
+		EUI:           req.Router.EUI64,
+		Station:       req.Station,
+		Model:         req.Model,
+		Package:       req.Package,
+		UpdateChannel: gtw.UpdateChannel,
+	}, fws...), nil
+}
+
 // UpdateInfo implements the CUPS update-info handler.
 func (s *Server) UpdateInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -328,11 +348,15 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 		}
 	}
 
-	if gtw.AutoUpdate {
-		// TODO: Compare the Station, Model, Package, version_ids and update_channel in order to check if any updates are required
-		// (https://github.com/TheThingsNetwork/lorawan-stack/issues/365)
-		var updateData []byte
-		if updateData != nil {
+	if gtw.AutoUpdate && s.firmware != nil {
+		fw, err := s.selectFirmware(ctx, req, gtw)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to select firmware update")
+		} else if fw != nil {
+			logger := logger.WithFields(log.Fields(
+				"firmware_id", fw.FirmwareId,
+				"package", fw.Package,
+			))
 			var (
 				keyCRC uint32
 				signer crypto.Signer
@@ -343,7 +367,11 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 					break
 				}
 			}
-			if signer != nil {
+			if signer == nil {
+				logger.Warn("No signing key trusted by gateway for firmware update")
+			} else if updateData, err := s.firmware.Data(ctx, fw.FirmwareId); err != nil {
+				logger.WithError(err).Warn("Failed to read firmware update")
+			} else {
 				hash := sha512.Sum512(updateData)
 				sig, err := signer.Sign(rand.Reader, hash[:], nil)
 				if err != nil {
@@ -352,10 +380,13 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 				res.SignatureKeyCRC = keyCRC
 				res.Signature = sig
 				res.UpdateData = updateData
+				logger.Info("Send firmware update")
+				events.Publish(evtSendFirmware.NewWithIdentifiersAndData(ctx, gtw.GetIds(), fw))
 			}
 		}
 	}
 
+	previousPackage := gtw.Attributes[cupsPackageAttribute]
 	gtw.Attributes[cupsLastSeenAttribute] = time.Now().UTC().Format(time.RFC3339)
 	if req.Station != "" {
 		gtw.Attributes[cupsStationAttribute] = req.Station
@@ -378,6 +409,18 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 	if err != nil {
 		return err
 	}
+	if previousPackage != "" && req.Package != "" && previousPackage != req.Package {
+		logger.WithFields(log.Fields(
+			"previous_package", previousPackage,
+			"package", req.Package,
+		)).Info("Gateway firmware updated")
+		events.Publish(evtUpdateFirmware.NewWithIdentifiersAndData(ctx, gtw.GetIds(), &ttnpb.GatewayFirmwareUpdate{
+			PreviousPackage: previousPackage,
+			Package:         req.Package,
+			Station:         req.Station,
+			Model:           req.Model,
+		}))
+	}
 
 	b, err := res.MarshalBinary()
 	if err != nil {
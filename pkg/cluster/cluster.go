@@ -20,13 +20,18 @@ import (
 	"crypto/tls"
 	"encoding/hex"
 	"os"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -115,12 +120,20 @@ func WithTLSConfig(tlsConfig *tls.Config) Option {
 	})
 }
 
+// WithDiscoverer sets the Discoverer to use for discovering the replicas of cluster peers.
+// This overrides the discovery provider from the configuration.
+func WithDiscoverer(discoverer Discoverer) Option {
+	return optionFunc(func(c *cluster) {
+		c.discoverer = discoverer
+	})
+}
+
 // CustomNew allows you to replace the clustering implementation. New will call CustomNew if not nil.
 var CustomNew func(ctx context.Context, config *Config, options ...Option) (Cluster, error)
 
 // New instantiates a new clustering implementation.
 // The basic clustering implementation allows for a cluster setup with a single-instance deployment of each component
-// (GS/NS/AS/JS).
+// (GS/NS/AS/JS), or with replicas of components that are discovered using DNS SRV records or Kubernetes Endpoints.
 // Network operators can use their own clustering logic, which can be activated by setting the CustomNew variable.
 func New(ctx context.Context, config *Config, options ...Option) (Cluster, error) {
 	if CustomNew != nil {
@@ -137,12 +150,28 @@ func defaultNew(ctx context.Context, config *Config, options ...Option) (Cluster
 		dialOptions: func(ctx context.Context) []grpc.DialOption {
 			return nil
 		},
-		peers: make(map[string]*peer),
+		peers:             make(map[string]*peer),
+		discoveryInterval: config.Discovery.Interval,
+		discoveryPeers:    make(map[string]bool, len(config.Discovery.Peers)),
+	}
+	for _, name := range config.Discovery.Peers {
+		if statefulPeers[name] {
+			return nil, errStatefulDiscoveryPeer.WithAttributes("name", name)
+		}
+		c.discoveryPeers[name] = true
 	}
 
 	if err := c.loadKeys(ctx, config.Keys...); err != nil {
 		return nil, err
 	}
+	discoverer, err := newDiscoverer(config.Discovery)
+	if err != nil {
+		return nil, err
+	}
+	c.discoverer = discoverer
+	if c.discoveryInterval <= 0 {
+		c.discoveryInterval = defaultDiscoveryInterval
+	}
 
 	c.self = &peer{
 		name:   config.Name,
@@ -184,15 +213,40 @@ func defaultNew(ctx context.Context, config *Config, options ...Option) (Cluster
 	return c, nil
 }
 
+// defaultDiscoveryInterval is the interval between peer discoveries if none is configured.
+const defaultDiscoveryInterval = 10 * time.Second
+
+// statefulPeers are the cluster peers of which the replicas hold per-entity state, such as gateway connections.
+// Replicas of these peers cannot be discovered, as calls must be sent to the replica that holds the state.
+var statefulPeers = map[string]bool{
+	"gs":  true,
+	"pba": true,
+}
+
+// discoveryTarget is a cluster peer of which the replicas are discovered.
+type discoveryTarget struct {
+	name   string
+	target string
+	roles  []ttnpb.ClusterRole
+}
+
 type cluster struct {
 	ctx           context.Context
 	tls           bool
 	tlsConfig     *tls.Config
 	tlsServerName string
 	dialOptions   func(ctx context.Context) []grpc.DialOption
+	peersMu       sync.RWMutex
 	peers         map[string]*peer
 	self          *peer
 
+	discoverer        Discoverer
+	discoveryInterval time.Duration
+	discoveryTargets  []discoveryTarget
+	discoveryPeers    map[string]bool
+	stopDiscovery     context.CancelFunc
+	nextPeer          atomic.Uint64
+
 	keys [][]byte
 }
 
@@ -201,6 +255,11 @@ var (
 	errPeerEmptyTarget   = errors.DefineInvalidArgument("peer_empty_target", "peer target address is empty")
 	errInvalidClusterKey = errors.DefineInvalidArgument("cluster_key", "invalid cluster key")
 	errInvalidKeyLength  = errors.DefineInvalidArgument("key_length", "invalid key length %d, must be 16, 24 or 32 bytes")
+
+	errStatefulDiscoveryPeer = errors.DefineInvalidArgument(
+		"stateful_discovery_peer",
+		"replicas of cluster peer `{name}` cannot be discovered",
+	)
 )
 
 func (c *cluster) loadKeys(ctx context.Context, keys ...string) error {
@@ -248,6 +307,14 @@ func (c *cluster) addPeer(name string, target string, roles ...ttnpb.ClusterRole
 	if len(filteredRoles) == 0 {
 		return
 	}
+	if c.discoverer != nil && c.discoveryPeers[name] {
+		c.discoveryTargets = append(c.discoveryTargets, discoveryTarget{
+			name:   name,
+			target: target,
+			roles:  filteredRoles,
+		})
+		return
+	}
 	c.peers[name] = &peer{
 		name:          name,
 		target:        target,
@@ -256,68 +323,190 @@ func (c *cluster) addPeer(name string, target string, roles ...ttnpb.ClusterRole
 	}
 }
 
+func (c *cluster) connect(peer *peer) error {
+	peer.ctx, peer.cancel = context.WithCancel(c.ctx)
+	logger := log.FromContext(c.ctx).WithFields(log.Fields(
+		"target", peer.target,
+		"name", peer.Name(),
+		"roles", peer.Roles(),
+	))
+	if peer.target == "" {
+		logger.Warn("Not connecting to peer, empty address.")
+		peer.connErr = errPeerEmptyTarget
+		return nil
+	}
+	options := c.dialOptions(c.ctx)
+	if c.tls {
+		tlsConfig := &tls.Config{}
+		if c.tlsConfig != nil {
+			tlsConfig = c.tlsConfig.Clone()
+		}
+		tlsConfig.ServerName = peer.tlsServerName
+		logger = logger.WithField("tls_server_name", peer.tlsServerName)
+		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		options = append(options, grpc.WithInsecure())
+	}
+	logger.Debug("Connecting to peer...")
+	peer.conn, peer.connErr = grpc.DialContext(peer.ctx, peer.target, options...)
+	if peer.connErr != nil {
+		return errPeerConnection.WithCause(peer.connErr).WithAttributes("name", peer.name, "address", peer.target)
+	}
+	logger.Debug("Connected to peer")
+	return nil
+}
+
+func (c *cluster) disconnect(peer *peer) error {
+	if peer.conn != nil {
+		if err := peer.conn.Close(); err != nil {
+			return err
+		}
+	}
+	if peer.cancel != nil {
+		peer.cancel()
+	}
+	return nil
+}
+
 func (c *cluster) Join() (err error) {
+	c.peersMu.Lock()
 	for _, peer := range c.peers {
 		if peer.conn != nil {
 			continue
 		}
-		peer.ctx, peer.cancel = context.WithCancel(c.ctx)
-		logger := log.FromContext(c.ctx).WithFields(log.Fields(
-			"target", peer.target,
-			"name", peer.Name(),
-			"roles", peer.Roles(),
+		if err := c.connect(peer); err != nil {
+			c.peersMu.Unlock()
+			return err
+		}
+	}
+	c.peersMu.Unlock()
+	if c.discoverer == nil || len(c.discoveryTargets) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.stopDiscovery = cancel
+	c.discover(ctx)
+	go func() {
+		ticker := time.NewTicker(c.discoveryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.discover(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// discover updates the replicas of the discovery targets.
+// Replicas that are no longer discovered are removed and their connections are closed.
+// If discovery of a target fails, its replicas are kept.
+func (c *cluster) discover(ctx context.Context) {
+	for _, target := range c.discoveryTargets {
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"name", target.name,
+			"target", target.target,
 		))
-		if peer.target == "" {
-			logger.Warn("Not connecting to peer, empty address.")
-			peer.connErr = errPeerEmptyTarget
+		addresses, err := c.discoverer.Discover(ctx, target.target)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to discover cluster peers")
 			continue
 		}
-		options := c.dialOptions(c.ctx)
-		if c.tls {
-			tlsConfig := &tls.Config{}
-			if c.tlsConfig != nil {
-				tlsConfig = c.tlsConfig.Clone()
+		prefix := target.name + "/"
+		discovered := make(map[string]string, len(addresses))
+		for _, address := range addresses {
+			discovered[prefix+address] = address
+		}
+		var removed, added []*peer
+		c.peersMu.RLock()
+		for name, peer := range c.peers {
+			if !peer.discovered || !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if _, ok := discovered[name]; !ok {
+				removed = append(removed, peer)
 			}
-			tlsConfig.ServerName = peer.tlsServerName
-			logger = logger.WithField("tls_server_name", peer.tlsServerName)
-			options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
-		} else {
-			options = append(options, grpc.WithInsecure())
 		}
-		logger.Debug("Connecting to peer...")
-		peer.conn, peer.connErr = grpc.DialContext(peer.ctx, peer.target, options...)
-		if peer.connErr != nil {
-			return errPeerConnection.WithCause(peer.connErr).WithAttributes("name", peer.name, "address", peer.target)
+		for name, address := range discovered {
+			if _, ok := c.peers[name]; !ok {
+				added = append(added, &peer{
+					name:          name,
+					target:        address,
+					roles:         target.roles,
+					tlsServerName: c.getTLSServerName(address),
+					discovered:    true,
+				})
+			}
+		}
+		c.peersMu.RUnlock()
+
+		// NOTE: Peers are connected and disconnected without holding the lock, so that dialing does not block
+		// the calls to other peers.
+		connected := added[:0]
+		for _, peer := range added {
+			if err := c.connect(peer); err != nil {
+				logger.WithError(err).Warn("Failed to connect to cluster peer")
+				continue
+			}
+			peer.conn.Connect()
+			connected = append(connected, peer)
+		}
+		c.peersMu.Lock()
+		for _, peer := range removed {
+			if c.peers[peer.name] == peer {
+				logger.WithField("address", peer.target).Info("Remove cluster peer")
+				delete(c.peers, peer.name)
+			}
+		}
+		for _, peer := range connected {
+			logger.WithField("address", peer.target).Info("Add cluster peer")
+			c.peers[peer.name] = peer
+		}
+		c.peersMu.Unlock()
+		for _, peer := range removed {
+			if err := c.disconnect(peer); err != nil {
+				logger.WithError(err).Warn("Failed to disconnect from cluster peer")
+			}
 		}
-		logger.Debug("Connected to peer")
 	}
-	return nil
 }
 
 func (c *cluster) Leave() error {
+	if c.stopDiscovery != nil {
+		c.stopDiscovery()
+	}
+	c.peersMu.Lock()
+	defer c.peersMu.Unlock()
 	for _, peer := range c.peers {
-		if peer.conn != nil {
-			if err := peer.conn.Close(); err != nil {
-				return err
-			}
-		}
-		if peer.cancel != nil {
-			peer.cancel()
+		if err := c.disconnect(peer); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
 func (c *cluster) GetPeers(ctx context.Context, role ttnpb.ClusterRole) ([]Peer, error) {
+	c.peersMu.RLock()
+	defer c.peersMu.RUnlock()
 	matches := make([]Peer, 0, len(c.peers))
 	for _, peer := range c.peers {
 		if !peer.HasRole(role) {
 			continue
 		}
-		_, err := peer.Conn()
+		conn, err := peer.Conn()
 		if err != nil {
 			continue
 		}
+		// Discovered replicas are only used while they are healthy.
+		if peer.discovered {
+			switch conn.GetState() {
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				continue
+			}
+		}
 		matches = append(matches, peer)
 	}
 	return matches, nil
@@ -325,15 +514,20 @@ func (c *cluster) GetPeers(ctx context.Context, role ttnpb.ClusterRole) ([]Peer,
 
 var errPeerUnavailable = errors.DefineUnavailable("peer_unavailable", "{cluster_role} cluster peer unavailable")
 
-func (c *cluster) GetPeer(ctx context.Context, role ttnpb.ClusterRole, _ EntityIdentifiers) (Peer, error) {
+func (c *cluster) GetPeer(ctx context.Context, role ttnpb.ClusterRole, ids EntityIdentifiers) (Peer, error) {
 	matches, err := c.GetPeers(ctx, role)
 	if err != nil {
 		return nil, err
 	}
-	if len(matches) == 1 {
+	switch len(matches) {
+	case 0:
+	case 1:
 		return matches[0], nil
+	default:
+		// Calls are balanced over the replicas of the component, as the replicas share their state.
+		sort.Slice(matches, func(i, j int) bool { return matches[i].Name() < matches[j].Name() })
+		return matches[c.nextPeer.Add(1)%uint64(len(matches))], nil
 	}
-	// The reference cluster only has a single instance of each component, so we don't need to filter on IDs.
 	return nil, errPeerUnavailable.WithAttributes("cluster_role", strings.Title(strings.Replace(role.String(), "_", " ", -1)))
 }
 
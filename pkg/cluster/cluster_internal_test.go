@@ -14,4 +14,15 @@
 
 package cluster
 
+import (
+	"context"
+	"net"
+)
+
 type ClusterImpl cluster
+
+func NewTestDNSSRVDiscoverer(
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error),
+) Discoverer {
+	return &dnsSRVDiscoverer{lookupSRV: lookupSRV}
+}
@@ -14,22 +14,45 @@
 
 package cluster
 
+import "time"
+
+// KubernetesDiscoveryConfig represents the configuration for discovering cluster peers using Kubernetes Endpoints.
+type KubernetesDiscoveryConfig struct {
+	APIServer string `name:"api-server" description:"Address of the Kubernetes API server (default: in-cluster API server)"` //nolint:lll
+	Namespace string `name:"namespace" description:"Namespace of the Endpoints (default: namespace of the pod)"`
+	TokenFile string `name:"token-file" description:"Location of the service account token"`
+	CAFile    string `name:"ca-file" description:"Location of the CA certificate of the API server"`
+}
+
+// DiscoveryConfig represents the configuration for discovering cluster peers.
+// The addresses of the peers in Peers are discovered, the addresses of other peers are used as is.
+// With the dns provider, the discovered addresses are DNS SRV record names.
+// With the kubernetes provider, the discovered addresses are Endpoints names, optionally followed by the port name or
+// number.
+type DiscoveryConfig struct {
+	Provider   string                    `name:"provider" description:"Provider of cluster peer addresses (static, dns, kubernetes)"` //nolint:lll
+	Interval   time.Duration             `name:"interval" description:"Interval between cluster peer discoveries"`
+	Peers      []string                  `name:"peers" description:"Cluster peers of which the replicas are discovered (is, ns, as, js, cs, dr, gcs, dcs)"` //nolint:lll
+	Kubernetes KubernetesDiscoveryConfig `name:"kubernetes"`
+}
+
 // Config represents clustering configuration.
 type Config struct {
-	Join                       []string `name:"join" description:"Addresses of cluster peers to join"`
-	Name                       string   `name:"name" description:"Name of the current cluster peer (default: $HOSTNAME)"`
-	Address                    string   `name:"address" description:"Address to use for cluster communication"`
-	IdentityServer             string   `name:"identity-server" description:"Address for the Identity Server"`
-	GatewayServer              string   `name:"gateway-server" description:"Address for the Gateway Server"`
-	NetworkServer              string   `name:"network-server" description:"Address for the Network Server"`
-	ApplicationServer          string   `name:"application-server" description:"Address for the Application Server"`
-	JoinServer                 string   `name:"join-server" description:"Address for the Join Server"`
-	CryptoServer               string   `name:"crypto-server" description:"Address for the Crypto Server"`
-	PacketBrokerAgent          string   `name:"packet-broker-agent" description:"Address of the Packet Broker Agent"`
-	DeviceRepository           string   `name:"device-repository" description:"Address for the Device Repository"`
-	GatewayConfigurationServer string   `name:"gateway-configuration-server" description:"Address for the Gateway Configuration Server"` //nolint:lll
-	DeviceClaimingServer       string   `name:"device-claiming-server" description:"Address for the Device Claiming Server"`             //nolint:lll
-	TLS                        bool     `name:"tls" description:"Do cluster gRPC over TLS"`
-	TLSServerName              string   `name:"tls-server-name" description:"Server name to use in TLS handshake to cluster peers"`                                                          //nolint:lll
-	Keys                       []string `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"` //nolint:lll
+	Join                       []string        `name:"join" description:"Addresses of cluster peers to join"`
+	Name                       string          `name:"name" description:"Name of the current cluster peer (default: $HOSTNAME)"`
+	Address                    string          `name:"address" description:"Address to use for cluster communication"`
+	IdentityServer             string          `name:"identity-server" description:"Address for the Identity Server"`
+	GatewayServer              string          `name:"gateway-server" description:"Address for the Gateway Server"`
+	NetworkServer              string          `name:"network-server" description:"Address for the Network Server"`
+	ApplicationServer          string          `name:"application-server" description:"Address for the Application Server"`
+	JoinServer                 string          `name:"join-server" description:"Address for the Join Server"`
+	CryptoServer               string          `name:"crypto-server" description:"Address for the Crypto Server"`
+	PacketBrokerAgent          string          `name:"packet-broker-agent" description:"Address of the Packet Broker Agent"`
+	DeviceRepository           string          `name:"device-repository" description:"Address for the Device Repository"`
+	GatewayConfigurationServer string          `name:"gateway-configuration-server" description:"Address for the Gateway Configuration Server"` //nolint:lll
+	DeviceClaimingServer       string          `name:"device-claiming-server" description:"Address for the Device Claiming Server"`             //nolint:lll
+	TLS                        bool            `name:"tls" description:"Do cluster gRPC over TLS"`
+	TLSServerName              string          `name:"tls-server-name" description:"Server name to use in TLS handshake to cluster peers"`                                                          //nolint:lll
+	Keys                       []string        `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"` //nolint:lll
+	Discovery                  DiscoveryConfig `name:"discovery"`
 }
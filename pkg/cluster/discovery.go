@@ -0,0 +1,94 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// Discoverer discovers the addresses of the replicas of a cluster peer.
+type Discoverer interface {
+	// Discover returns the addresses of the ready replicas behind the given target.
+	Discover(ctx context.Context, target string) ([]string, error)
+}
+
+// DiscovererFunc is a function that implements Discoverer.
+type DiscovererFunc func(ctx context.Context, target string) ([]string, error)
+
+// Discover implements Discoverer.
+func (f DiscovererFunc) Discover(ctx context.Context, target string) ([]string, error) {
+	return f(ctx, target)
+}
+
+var (
+	errDiscoveryProvider = errors.DefineInvalidArgument(
+		"discovery_provider", "invalid cluster peer discovery provider `{provider}`",
+	)
+	errDiscovery = errors.DefineUnavailable("discovery", "discover cluster peers for `{target}`")
+)
+
+type dnsSRVDiscoverer struct {
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// Discover implements Discoverer.
+// The target is the full name of the SRV record, for example `_grpc._tcp.ns.lorawan.svc.cluster.local`.
+func (d *dnsSRVDiscoverer) Discover(ctx context.Context, target string) ([]string, error) {
+	_, records, err := d.lookupSRV(ctx, "", "", target)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if stderrors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil
+		}
+		return nil, errDiscovery.WithAttributes("target", target).WithCause(err)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Priority < records[j].Priority
+	})
+	addresses := make([]string, 0, len(records))
+	for _, record := range records {
+		addresses = append(addresses, net.JoinHostPort(
+			strings.TrimSuffix(record.Target, "."), strconv.FormatUint(uint64(record.Port), 10),
+		))
+	}
+	return addresses, nil
+}
+
+// NewDNSSRVDiscoverer returns a Discoverer that looks up DNS SRV records with the given resolver.
+func NewDNSSRVDiscoverer(resolver *net.Resolver) Discoverer {
+	return &dnsSRVDiscoverer{
+		lookupSRV: resolver.LookupSRV,
+	}
+}
+
+func newDiscoverer(config DiscoveryConfig) (Discoverer, error) {
+	switch config.Provider {
+	case "", "static":
+		return nil, nil
+	case "dns":
+		return NewDNSSRVDiscoverer(net.DefaultResolver), nil
+	case "kubernetes":
+		return NewKubernetesDiscoverer(config.Kubernetes)
+	default:
+		return nil, errDiscoveryProvider.WithAttributes("provider", config.Provider)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	kubernetesRequestTimeout    = 10 * time.Second
+)
+
+var (
+	errKubernetesAPIServer = errors.DefineFailedPrecondition(
+		"kubernetes_api_server", "Kubernetes API server address not configured and not running in a Kubernetes pod",
+	)
+	errKubernetesCA        = errors.DefineInvalidArgument("kubernetes_ca", "invalid Kubernetes CA certificate")
+	errKubernetesEndpoints = errors.DefineUnavailable(
+		"kubernetes_endpoints", "get Kubernetes Endpoints `{namespace}/{name}` with status code `{code}`",
+	)
+	errKubernetesTarget = errors.DefineInvalidArgument("kubernetes_target", "invalid Kubernetes target `{target}`")
+)
+
+type kubernetesEndpoints struct {
+	Subsets []struct {
+		Addresses []struct {
+			IP string `json:"ip"`
+		} `json:"addresses"`
+		Ports []struct {
+			Name string `json:"name"`
+			Port int    `json:"port"`
+		} `json:"ports"`
+	} `json:"subsets"`
+}
+
+type kubernetesDiscoverer struct {
+	httpClient *http.Client
+	apiServer  string
+	namespace  string
+	tokenFile  string
+}
+
+// NewKubernetesDiscoverer returns a Discoverer that reads the ready addresses of Kubernetes Endpoints.
+// The target is the name of the Endpoints, optionally followed by the namespace and the port name or number,
+// for example `ns`, `ns:grpc` or `ns.lorawan:1884`. If the port is omitted, the first port is used.
+// Addresses that are not ready are not returned.
+func NewKubernetesDiscoverer(config KubernetesDiscoveryConfig) (Discoverer, error) {
+	d := &kubernetesDiscoverer{
+		apiServer: strings.TrimSuffix(config.APIServer, "/"),
+		namespace: config.Namespace,
+		tokenFile: config.TokenFile,
+	}
+	if d.apiServer == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return nil, errKubernetesAPIServer.New()
+		}
+		d.apiServer = "https://" + net.JoinHostPort(host, port)
+	}
+	if d.namespace == "" {
+		if namespace, err := os.ReadFile(kubernetesServiceAccountDir + "/namespace"); err == nil {
+			d.namespace = strings.TrimSpace(string(namespace))
+		}
+	}
+	if d.tokenFile == "" {
+		d.tokenFile = kubernetesServiceAccountDir + "/token"
+	}
+	caFile := config.CAFile
+	if caFile == "" {
+		caFile = kubernetesServiceAccountDir + "/ca.crt"
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caPEM, err := os.ReadFile(caFile); err == nil {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errKubernetesCA.New()
+		}
+	} else if config.CAFile != "" {
+		return nil, errKubernetesCA.WithCause(err)
+	}
+	d.httpClient = &http.Client{
+		Timeout: kubernetesRequestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	return d, nil
+}
+
+func (d *kubernetesDiscoverer) parseTarget(target string) (name, namespace, port string, err error) {
+	name, port = target, ""
+	if i := strings.LastIndexByte(target, ':'); i >= 0 {
+		name, port = target[:i], target[i+1:]
+	}
+	name, namespace, _ = strings.Cut(name, ".")
+	if namespace == "" {
+		namespace = d.namespace
+	}
+	if name == "" || namespace == "" {
+		return "", "", "", errKubernetesTarget.WithAttributes("target", target)
+	}
+	return name, namespace, port, nil
+}
+
+// Discover implements Discoverer.
+func (d *kubernetesDiscoverer) Discover(ctx context.Context, target string) ([]string, error) {
+	name, namespace, port, err := d.parseTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(
+		"%s/api/v1/namespaces/%s/endpoints/%s", d.apiServer, url.PathEscape(namespace), url.PathEscape(name),
+	), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// The service account token is read on every request, as projected tokens are rotated.
+	if token, err := os.ReadFile(d.tokenFile); err == nil {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	res, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, errDiscovery.WithAttributes("target", target).WithCause(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errKubernetesEndpoints.WithAttributes(
+			"namespace", namespace,
+			"name", name,
+			"code", res.StatusCode,
+		)
+	}
+	var endpoints kubernetesEndpoints
+	if err := json.NewDecoder(res.Body).Decode(&endpoints); err != nil {
+		return nil, errDiscovery.WithAttributes("target", target).WithCause(err)
+	}
+	var addresses []string
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Ports) == 0 {
+			continue
+		}
+		subsetPort := subset.Ports[0].Port
+		if port != "" {
+			subsetPort = 0
+			for _, p := range subset.Ports {
+				if p.Name == port || strconv.Itoa(p.Port) == port {
+					subsetPort = p.Port
+					break
+				}
+			}
+			if subsetPort == 0 {
+				continue
+			}
+		}
+		for _, address := range subset.Addresses {
+			addresses = append(addresses, net.JoinHostPort(address.IP, strconv.Itoa(subsetPort)))
+		}
+	}
+	return addresses, nil
+}
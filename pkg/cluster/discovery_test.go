@@ -0,0 +1,214 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestDNSSRVDiscoverer(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	d := NewTestDNSSRVDiscoverer(func(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+		switch name {
+		case "_grpc._tcp.ns.lorawan.svc.cluster.local":
+			return "", []*net.SRV{
+				{Target: "ns-1.ns.lorawan.svc.cluster.local.", Port: 1884, Priority: 10},
+				{Target: "ns-0.ns.lorawan.svc.cluster.local.", Port: 1884, Priority: 0},
+			}, nil
+		default:
+			return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+		}
+	})
+
+	addresses, err := d.Discover(ctx, "_grpc._tcp.ns.lorawan.svc.cluster.local")
+	a.So(err, should.BeNil)
+	a.So(addresses, should.Resemble, []string{
+		"ns-0.ns.lorawan.svc.cluster.local:1884",
+		"ns-1.ns.lorawan.svc.cluster.local:1884",
+	})
+
+	addresses, err = d.Discover(ctx, "_grpc._tcp.as.lorawan.svc.cluster.local")
+	a.So(err, should.BeNil)
+	a.So(addresses, should.BeEmpty)
+}
+
+func TestKubernetesDiscoverer(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/lorawan/endpoints/ns":
+			w.Write([]byte(`{
+				"subsets": [{
+					"addresses": [{"ip": "10.0.0.1"}, {"ip": "10.0.0.2"}],
+					"notReadyAddresses": [{"ip": "10.0.0.3"}],
+					"ports": [{"name": "http", "port": 1885}, {"name": "grpc", "port": 1884}]
+				}]
+			}`)) //nolint:errcheck
+		case "/api/v1/namespaces/other/endpoints/ns":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	d, err := NewKubernetesDiscoverer(KubernetesDiscoveryConfig{
+		APIServer: srv.URL,
+		Namespace: "lorawan",
+		TokenFile: "/nonexistent",
+		CAFile:    "",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	addresses, err := d.Discover(ctx, "ns:grpc")
+	a.So(err, should.BeNil)
+	a.So(addresses, should.Resemble, []string{"10.0.0.1:1884", "10.0.0.2:1884"})
+
+	addresses, err = d.Discover(ctx, "ns.lorawan")
+	a.So(err, should.BeNil)
+	a.So(addresses, should.Resemble, []string{"10.0.0.1:1885", "10.0.0.2:1885"})
+
+	addresses, err = d.Discover(ctx, "ns:unknown")
+	a.So(err, should.BeNil)
+	a.So(addresses, should.BeEmpty)
+
+	addresses, err = d.Discover(ctx, "as")
+	a.So(err, should.BeNil)
+	a.So(addresses, should.BeEmpty)
+
+	_, err = d.Discover(ctx, "ns.other")
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+}
+
+func TestClusterDiscovery(t *testing.T) {
+	a, ctx := test.New(t)
+
+	var listeners []net.Listener
+	for i := 0; i < 3; i++ {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			panic(err)
+		}
+		defer lis.Close()
+		go grpc.NewServer().Serve(lis) //nolint:errcheck
+		listeners = append(listeners, lis)
+	}
+
+	var (
+		addressesMu sync.Mutex
+		addresses   = []string{listeners[0].Addr().String(), listeners[1].Addr().String()}
+	)
+	discoverer := DiscovererFunc(func(_ context.Context, target string) ([]string, error) {
+		switch target {
+		case "ns":
+		default:
+			return nil, nil
+		}
+		addressesMu.Lock()
+		defer addressesMu.Unlock()
+		return append([]string(nil), addresses...), nil
+	})
+
+	c, err := New(ctx, &Config{
+		GatewayServer:     "gs",
+		NetworkServer:     "ns",
+		ApplicationServer: "as",
+		JoinServer:        "js:1234",
+		Discovery: DiscoveryConfig{
+			Provider: "dns",
+			Interval: 10 * time.Millisecond,
+			Peers:    []string{"ns", "as"},
+		},
+	}, WithDiscoverer(discoverer))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(c.Join(), should.BeNil)
+	defer c.Leave()
+
+	// The Gateway Server is not discovered.
+	gs, err := c.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, nil)
+	if a.So(err, should.BeNil) {
+		a.So(gs.Name(), should.Equal, "gs")
+	}
+
+	peers, err := c.GetPeers(ctx, ttnpb.ClusterRole_NETWORK_SERVER)
+	a.So(err, should.BeNil)
+	a.So(peers, should.HaveLength, 2)
+
+	// Calls are balanced over the replicas.
+	names := make(map[string]bool)
+	for i := 0; i < 4; i++ {
+		peer, err := c.GetPeer(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if a.So(err, should.BeNil) {
+			names[peer.Name()] = true
+		}
+	}
+	a.So(names, should.HaveLength, 2)
+
+	_, err = c.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	// Peers that are not discovered are used as is.
+	js, err := c.GetPeer(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+	if a.So(err, should.BeNil) {
+		a.So(js.Name(), should.Equal, "js")
+	}
+
+	// Replicas of peers with per-entity state cannot be discovered.
+	for _, name := range []string{"gs", "pba"} {
+		_, err := New(ctx, &Config{
+			Discovery: DiscoveryConfig{
+				Provider: "dns",
+				Peers:    []string{name},
+			},
+		}, WithDiscoverer(discoverer))
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Replicas are added and removed without restart.
+	addressesMu.Lock()
+	addresses = []string{listeners[2].Addr().String()}
+	addressesMu.Unlock()
+	for i := 0; i < 50; i++ {
+		time.Sleep(20 * time.Millisecond)
+		peers, err = c.GetPeers(ctx, ttnpb.ClusterRole_NETWORK_SERVER)
+		if err == nil && len(peers) == 1 {
+			break
+		}
+	}
+	if a.So(peers, should.HaveLength, 1) {
+		a.So(peers[0].Name(), should.Equal, "ns/"+listeners[2].Addr().String())
+	}
+}
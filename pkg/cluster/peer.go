@@ -42,6 +42,7 @@ type peer struct {
 
 	target        string
 	tlsServerName string
+	discovered    bool
 
 	ctx     context.Context
 	cancel  context.CancelFunc
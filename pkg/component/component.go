@@ -99,7 +99,15 @@ type Component struct {
 
 	caStore *mtls.CAStore
 
-	limiter ratelimit.Interface
+	limiter *ratelimit.ReloadableRateLimiter
+
+	configReloadMu  sync.Mutex
+	configCurrent   any
+	configLoader    func() (any, error)
+	configReloaders []configReloader
+
+	drainersMu sync.Mutex
+	drainers   []drainer
 }
 
 // Option allows extending the component when it is instantiated with New.
@@ -188,15 +196,19 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		return nil, err
 	}
 
-	c.limiter, err = ratelimit.New(ctx, config.RateLimiting, config.Blob, c)
+	limiter, err := ratelimit.New(ctx, config.RateLimiting, config.Blob, c)
 	if err != nil {
 		return nil, err
 	}
+	c.limiter = ratelimit.NewReloadable(limiter)
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	if err := config.FrequencyPlans.RegisterBands(); err != nil {
+		return nil, err
+	}
 	fpsFetcher, err := config.FrequencyPlansFetcher(ctx, c)
 	if err != nil {
 		return nil, err
@@ -236,6 +248,8 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 
 	c.initGRPC()
 
+	c.initConfigReloaders()
+
 	if !config.ServiceBase.SkipVersionCheck {
 		c.RegisterTask(versionCheckTask(ctx, c))
 	}
@@ -410,10 +424,26 @@ func (c *Component) Run() error {
 
 	signal.Notify(c.terminationSignals, os.Interrupt, syscall.SIGTERM)
 
-	sig := <-c.terminationSignals
-	fmt.Println()
-	c.logger.WithField("signal", sig).Info("Received signal, exiting...")
-	return nil
+	reloadSignals := make(chan os.Signal, 1)
+	if c.configLoader != nil {
+		signal.Notify(reloadSignals, syscall.SIGHUP)
+		defer signal.Stop(reloadSignals)
+	}
+
+	for {
+		select {
+		case sig := <-c.terminationSignals:
+			fmt.Println()
+			c.logger.WithField("signal", sig).Info("Received signal, exiting...")
+			c.Drain(c.ctx)
+			return nil
+		case sig := <-reloadSignals:
+			c.logger.WithField("signal", sig).Info("Received signal, reloading configuration...")
+			if _, err := c.ReloadConfig(); err != nil {
+				c.logger.WithError(err).Error("Failed to reload configuration")
+			}
+		}
+	}
 }
 
 // Close closes the server.
@@ -18,10 +18,12 @@ import (
 	"context"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // NewConfigurationServer returns a new ConfigurationServer on top of the given component.
@@ -73,3 +75,17 @@ func (*ConfigurationServer) ListBands(
 ) (*ttnpb.ListBandsResponse, error) {
 	return band.ListBands(ctx, req)
 }
+
+// ReloadConfiguration implements the Configuration service's ReloadConfiguration RPC.
+func (c *ConfigurationServer) ReloadConfiguration(
+	ctx context.Context, _ *emptypb.Empty,
+) (*ttnpb.ReloadConfigurationResponse, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	changed, err := c.component.ReloadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ReloadConfigurationResponse{ChangedKeys: changed}, nil
+}
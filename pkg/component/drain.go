@@ -0,0 +1,61 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"sync"
+)
+
+// DrainFunc drains a subsystem of the component before the component stops.
+// The function should return when the subsystem is drained or when the context is done.
+type DrainFunc func(ctx context.Context) error
+
+type drainer struct {
+	name  string
+	drain DrainFunc
+}
+
+// RegisterDrainer registers a function that drains the subsystem with the given name when the component receives a
+// termination signal. The component stops after all drainers returned.
+func (c *Component) RegisterDrainer(name string, drain DrainFunc) {
+	c.drainersMu.Lock()
+	defer c.drainersMu.Unlock()
+	c.drainers = append(c.drainers, drainer{name: name, drain: drain})
+}
+
+// Drain calls the registered drainers concurrently and waits until they returned.
+func (c *Component) Drain(ctx context.Context) {
+	c.drainersMu.Lock()
+	drainers := append([]drainer(nil), c.drainers...)
+	c.drainersMu.Unlock()
+
+	wg := sync.WaitGroup{}
+	for _, d := range drainers {
+		d := d
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger := c.logger.WithField("subsystem", d.name)
+			logger.Info("Draining...")
+			if err := d.drain(ctx); err != nil {
+				logger.WithError(err).Warn("Failed to drain")
+				return
+			}
+			logger.Info("Drained")
+		}()
+	}
+	wg.Wait()
+}
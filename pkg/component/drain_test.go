@@ -0,0 +1,49 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	c, err := component.New(test.GetLogger(t), &component.Config{ServiceBase: config.ServiceBase{}})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var drained atomic.Int32
+	c.RegisterDrainer("first", func(context.Context) error {
+		drained.Add(1)
+		return nil
+	})
+	c.RegisterDrainer("second", func(context.Context) error {
+		drained.Add(1)
+		return errors.New("failed")
+	})
+	c.Drain(ctx)
+	a.So(drained.Load(), should.Equal, 2)
+}
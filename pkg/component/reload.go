@@ -0,0 +1,188 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+)
+
+var (
+	errConfigReloadNotConfigured = errors.DefineFailedPrecondition(
+		"config_reload_not_configured", "configuration reload not configured",
+	)
+	errLoadConfig          = errors.Define("load_config", "load configuration")
+	errConfigNotReloadable = errors.DefineFailedPrecondition(
+		"config_not_reloadable", "changes to configuration keys `{keys}` require a restart ({changes})",
+	)
+	errReloadConfig = errors.Define("reload_config", "reload configuration `{key}`")
+)
+
+// ConfigReloadFunc prepares the reload of a configuration section with its new value.
+// The returned function applies the new value. It is only called when all changed configuration sections
+// have been prepared successfully, so that the configuration is never partially reloaded.
+type ConfigReloadFunc func(ctx context.Context, value any) (apply func(), err error)
+
+type configReloader struct {
+	key    string
+	reload ConfigReloadFunc
+}
+
+// WithConfigLoader returns an option that enables live configuration reloads.
+// The initial configuration is the configuration that the component was started with. The load function reads,
+// parses and validates the configuration again, and must return the same struct type as the initial configuration.
+// The config.ServiceBase must be squashed into that struct, as the keys of the component's configuration are
+// registered relative to the root of the configuration.
+func WithConfigLoader(initial any, load func() (any, error)) Option {
+	return func(c *Component) {
+		c.configCurrent = initial
+		c.configLoader = load
+	}
+}
+
+// RegisterConfigReloader subscribes the reload function to changes of the configuration section with the given key.
+// Changes to the key or any of its sub keys are considered hot-reloadable.
+// The value passed to the reload function has the type of the configuration section.
+func (c *Component) RegisterConfigReloader(key string, reload ConfigReloadFunc) {
+	c.configReloadMu.Lock()
+	defer c.configReloadMu.Unlock()
+	c.configReloaders = append(c.configReloaders, configReloader{key: key, reload: reload})
+}
+
+// configChange formats the change of the value of the configuration key.
+func configChange(key string, oldValue, newValue any) string {
+	return fmt.Sprintf("%s from `%v` to `%v`", key, oldValue, newValue)
+}
+
+func (c *Component) configReloaderIndex(key string) int {
+	for i, r := range c.configReloaders {
+		if key == r.key || strings.HasPrefix(key, r.key+".") {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReloadConfig loads the configuration and applies the changes to the subscribed configuration reloaders.
+// If any of the changed keys is not hot-reloadable, none of the changes are applied and an error is returned
+// that lists the keys that require a restart. It returns the keys that changed.
+func (c *Component) ReloadConfig() ([]string, error) {
+	c.configReloadMu.Lock()
+	defer c.configReloadMu.Unlock()
+
+	if c.configLoader == nil {
+		return nil, errConfigReloadNotConfigured.New()
+	}
+	next, err := c.configLoader()
+	if err != nil {
+		return nil, errLoadConfig.WithCause(err)
+	}
+	changed := config.Diff(c.configCurrent, next)
+	if len(changed) == 0 {
+		c.logger.Info("Configuration not changed")
+		return nil, nil
+	}
+
+	var notReloadable, changes []string
+	reload := make([]bool, len(c.configReloaders))
+	for _, key := range changed {
+		i := c.configReloaderIndex(key)
+		if i < 0 {
+			oldValue, _ := config.Lookup(c.configCurrent, key)
+			newValue, _ := config.Lookup(next, key)
+			notReloadable = append(notReloadable, key)
+			changes = append(changes, configChange(key, oldValue, newValue))
+			continue
+		}
+		reload[i] = true
+	}
+	if len(notReloadable) > 0 {
+		return nil, errConfigNotReloadable.WithAttributes(
+			"keys", strings.Join(notReloadable, ", "),
+			"changes", strings.Join(changes, ", "),
+		)
+	}
+
+	applies := make([]func(), 0, len(c.configReloaders))
+	for i, r := range c.configReloaders {
+		if !reload[i] {
+			continue
+		}
+		value, ok := config.Lookup(next, r.key)
+		if !ok {
+			return nil, errReloadConfig.WithAttributes("key", r.key)
+		}
+		apply, err := r.reload(c.ctx, value)
+		if err != nil {
+			return nil, errReloadConfig.WithAttributes("key", r.key).WithCause(err)
+		}
+		if apply != nil {
+			applies = append(applies, apply)
+		}
+	}
+	for _, apply := range applies {
+		apply()
+	}
+	c.configCurrent = next
+
+	c.logger.WithField("keys", changed).Info("Reloaded configuration")
+	return changed, nil
+}
+
+func (c *Component) initConfigReloaders() {
+	if setter, ok := c.logger.(log.LevelSetter); ok {
+		c.RegisterConfigReloader("log.level", func(_ context.Context, value any) (func(), error) {
+			level := value.(log.Level)
+			return func() { setter.SetLevel(level) }, nil
+		})
+	}
+	c.RegisterConfigReloader("rate-limiting", func(ctx context.Context, value any) (func(), error) {
+		conf := value.(config.RateLimiting)
+		if conf.Provider != c.config.RateLimiting.Provider {
+			return nil, errConfigNotReloadable.WithAttributes(
+				"keys", "rate-limiting.provider",
+				"changes", configChange("rate-limiting.provider", c.config.RateLimiting.Provider, conf.Provider),
+			)
+		}
+		conf.Redis = c.config.RateLimiting.Redis
+		limiter, err := ratelimit.New(ctx, conf, c.config.Blob, c)
+		if err != nil {
+			return nil, err
+		}
+		return func() { c.limiter.Set(limiter) }, nil
+	})
+	c.RegisterConfigReloader("frequency-plans", func(ctx context.Context, value any) (func(), error) {
+		conf := value.(config.FrequencyPlansConfig)
+		if !slices.Equal(conf.Bands, c.config.FrequencyPlans.Bands) {
+			return nil, errConfigNotReloadable.WithAttributes(
+				"keys", "frequency-plans.bands",
+				"changes", configChange("frequency-plans.bands", c.config.FrequencyPlans.Bands, conf.Bands),
+			)
+		}
+		conf.Static = c.config.FrequencyPlans.Static
+		fetcher, err := conf.Fetcher(ctx, c.config.Blob, c)
+		if err != nil {
+			return nil, err
+		}
+		return func() { c.frequencyPlans.SetFetcher(fetcher) }, nil
+	})
+}
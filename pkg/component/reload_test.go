@@ -0,0 +1,140 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/log/handler/memory"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type reloadTestConfig struct {
+	config.ServiceBase `name:",squash"`
+	Custom             struct {
+		Value string `name:"value"`
+	} `name:"custom"`
+}
+
+type reloadTestResource struct{}
+
+func (reloadTestResource) Key() string       { return "key" }
+func (reloadTestResource) Classes() []string { return []string{"test"} }
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	mem := memory.New()
+	logger := log.NewLogger(mem, log.WithLevel(log.InfoLevel))
+
+	current := &reloadTestConfig{}
+	current.Log.Level = log.InfoLevel
+	next := *current
+	c, err := component.New(logger, &component.Config{ServiceBase: current.ServiceBase},
+		component.WithConfigLoader(current, func() (any, error) {
+			loaded := next
+			return &loaded, nil
+		}),
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var customValue string
+	c.RegisterConfigReloader("custom", func(_ context.Context, value any) (func(), error) {
+		custom := value.(struct {
+			Value string `name:"value"`
+		})
+		if custom.Value == "invalid" {
+			return nil, errors.New("invalid value")
+		}
+		return func() { customValue = custom.Value }, nil
+	})
+
+	// No changes.
+	changed, err := c.ReloadConfig()
+	a.So(err, should.BeNil)
+	a.So(changed, should.BeEmpty)
+
+	// Change hot-reloadable keys.
+	next.Log.Level = log.DebugLevel
+	next.Custom.Value = "foo"
+	next.RateLimiting.Profiles = []config.RateLimitingProfile{
+		{
+			Name:         "test",
+			MaxPerMin:    1,
+			Associations: []string{"test"},
+		},
+	}
+	changed, err = c.ReloadConfig()
+	a.So(err, should.BeNil)
+	a.So(changed, should.Resemble, []string{"log.level", "rate-limiting.profiles", "custom.value"})
+	a.So(customValue, should.Equal, "foo")
+	entries := len(mem.Entries)
+	logger.Debug("Debug message")
+	a.So(mem.Entries, should.HaveLength, entries+1)
+	a.So(ratelimit.Require(c.RateLimiter(), reloadTestResource{}), should.BeNil)
+	a.So(errors.IsResourceExhausted(ratelimit.Require(c.RateLimiter(), reloadTestResource{})), should.BeTrue)
+
+	// Changes to keys that are not hot-reloadable are rejected, and no changes are applied.
+	next.Log.Level = log.InfoLevel
+	next.Custom.Value = "bar"
+	next.HTTP.Listen = ":1886"
+	next.GRPC.Listen = ":1887"
+	_, err = c.ReloadConfig()
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	a.So(errors.Attributes(err)["keys"], should.Equal, "grpc.listen, http.listen")
+	a.So(errors.Attributes(err)["changes"], should.Equal, "grpc.listen from `` to `:1887`, http.listen from `` to `:1886`")
+	a.So(customValue, should.Equal, "foo")
+
+	// Failing reloads are not applied.
+	next.HTTP.Listen = ""
+	next.GRPC.Listen = ""
+	next.Custom.Value = "invalid"
+	_, err = c.ReloadConfig()
+	a.So(err, should.NotBeNil)
+	entries = len(mem.Entries)
+	logger.Debug("Debug message")
+	a.So(mem.Entries, should.HaveLength, entries+1)
+
+	next.Custom.Value = "bar"
+	changed, err = c.ReloadConfig()
+	a.So(err, should.BeNil)
+	a.So(changed, should.Resemble, []string{"log.level", "custom.value"})
+	a.So(customValue, should.Equal, "bar")
+	entries = len(mem.Entries)
+	logger.Debug("Debug message")
+	a.So(mem.Entries, should.HaveLength, entries)
+}
+
+func TestReloadConfigNotConfigured(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	c, err := component.New(test.GetLogger(t), &component.Config{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = c.ReloadConfig()
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+}
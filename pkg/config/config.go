@@ -297,6 +297,15 @@ func (m *Manager) ReadInConfig() error {
 	return nil
 }
 
+// Reload discards the values that were read from config files and reads in all config files again.
+// Values from environment variables and flags are retained.
+func (m *Manager) Reload() error {
+	if err := m.viper.ReadConfig(strings.NewReader("")); err != nil {
+		return err
+	}
+	return m.ReadInConfig()
+}
+
 // mergeConfig merges the config from the reader as a yml config file.
 func (m *Manager) mergeConfig(in io.Reader) error {
 	return m.viper.MergeConfig(in)
@@ -0,0 +1,102 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// configFields calls f for each configurable field of the struct value v.
+// Squashed structs are flattened into the parent.
+func configFields(v reflect.Value, f func(name string, field reflect.Value)) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("name"), ",")
+		if name == "-" {
+			continue
+		}
+		if opts == "squash" && field.Type.Kind() == reflect.Struct {
+			configFields(v.Field(i), f)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		f(name, v.Field(i))
+	}
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func diff(prefix string, a, b reflect.Value, keys []string) []string {
+	a, b = indirect(a), indirect(b)
+	if a.Kind() != reflect.Struct || b.Kind() != reflect.Struct || a.Type() != b.Type() {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			keys = append(keys, prefix)
+		}
+		return keys
+	}
+	if prefix != "" {
+		prefix += "."
+	}
+	fields := make(map[string]reflect.Value)
+	configFields(b, func(name string, field reflect.Value) {
+		fields[name] = field
+	})
+	configFields(a, func(name string, field reflect.Value) {
+		keys = diff(prefix+name, field, fields[name], keys)
+	})
+	return keys
+}
+
+// Diff returns the configuration keys of which the values are different in a and b.
+// The keys are derived from the name struct tags, in the same way as the flags of the Manager.
+// Nested structs are compared field by field; all other values are compared as a whole.
+func Diff(a, b any) []string {
+	return diff("", reflect.ValueOf(a), reflect.ValueOf(b), nil)
+}
+
+// Lookup returns the value of the configuration key in the config struct.
+func Lookup(config any, key string) (any, bool) {
+	v := reflect.ValueOf(config)
+	for _, name := range strings.Split(key, ".") {
+		if v = indirect(v); v.Kind() != reflect.Struct {
+			return nil, false
+		}
+		var found reflect.Value
+		configFields(v, func(fieldName string, field reflect.Value) {
+			if fieldName == name {
+				found = field
+			}
+		})
+		if !found.IsValid() {
+			return nil, false
+		}
+		v = found
+	}
+	return v.Interface(), true
+}
@@ -0,0 +1,117 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	str := "foo"
+	base := example{
+		EmbeddedConfig: EmbeddedConfig{EmbeddedString: "embedded"},
+		Int:            42,
+		Strings:        []string{"a", "b"},
+		StringMap:      map[string]string{"a": "b"},
+		Nested:         NestedConfig{String: "nested"},
+		NestedPtr:      &NestedConfig{String: "nested"},
+		StringPtr:      &str,
+	}
+	a.So(Diff(base, base), should.BeEmpty)
+
+	changed := base
+	changed.EmbeddedString = "other"
+	changed.Int = 43
+	changed.Strings = []string{"a"}
+	changed.StringMap = map[string]string{"a": "c"}
+	changed.Nested.String = "other"
+	changed.NestedPtr = &NestedConfig{String: "other"}
+	a.So(Diff(&base, &changed), should.Resemble, []string{
+		"embedded-string",
+		"int",
+		"strings",
+		"stringmap",
+		"nested.string",
+		"nestedptr.string",
+	})
+
+	changed = base
+	changed.NestedPtr = nil
+	changed.StringPtr = nil
+	a.So(Diff(base, changed), should.Resemble, []string{"stringptr", "nestedptr"})
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	conf := &example{
+		EmbeddedConfig: EmbeddedConfig{EmbeddedString: "embedded"},
+		Int:            42,
+		Nested:         NestedConfig{String: "nested"},
+		NestedPtr:      &NestedConfig{String: "nested ptr"},
+	}
+	for key, expected := range map[string]any{
+		"embedded-string":  "embedded",
+		"int":              42,
+		"nested":           NestedConfig{String: "nested"},
+		"nested.string":    "nested",
+		"nestedptr.string": "nested ptr",
+	} {
+		v, ok := Lookup(conf, key)
+		a.So(ok, should.BeTrue)
+		a.So(v, should.Resemble, expected)
+	}
+	for _, key := range []string{"unknown", "int.unknown", "nested.unknown"} {
+		_, ok := Lookup(conf, key)
+		a.So(ok, should.BeFalse)
+	}
+}
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	file := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(file, []byte("foo: bar\nbar:\n  a: b\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mgr := InitializeWithDefaults("empty", "empty", &singleFileConfig{})
+	a.So(mgr.Parse("--config", file), should.BeNil)
+	a.So(mgr.ReadInConfig(), should.BeNil)
+	res := new(singleFileConfig)
+	a.So(mgr.Unmarshal(res), should.BeNil)
+	a.So(res.Foo, should.Equal, "bar")
+	a.So(res.Bar, should.Resemble, map[string]string{"a": "b"})
+
+	if err := os.WriteFile(file, []byte("foo: baz\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a.So(mgr.Reload(), should.BeNil)
+	res = new(singleFileConfig)
+	a.So(mgr.Unmarshal(res), should.BeNil)
+	a.So(res.Foo, should.Equal, "baz")
+	a.So(res.Bar, should.BeEmpty)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
@@ -177,11 +178,55 @@ type KeyVaultCache struct {
 	ErrorTTL time.Duration `name:"error-ttl" description:"Cache elements time to live for errors. If 0, the TTL is used"`
 }
 
+// KeyVaultVaultAppRole represents the configuration for the AppRole authentication method of HashiCorp Vault.
+type KeyVaultVaultAppRole struct {
+	Mount    string `name:"mount" description:"Mount path of the AppRole authentication method"`
+	RoleID   string `name:"role-id" description:"Role ID"`
+	SecretID string `name:"secret-id" description:"Secret ID"`
+}
+
+// KeyVaultVault represents the configuration for the HashiCorp Vault key vault provider.
+type KeyVaultVault struct {
+	Address      string               `name:"address" description:"Address of the Vault server"`
+	Namespace    string               `name:"namespace" description:"Vault Enterprise namespace"`
+	AuthMethod   string               `name:"auth-method" description:"Authentication method (token, approle)"`
+	Token        string               `name:"token" description:"Token for the token authentication method"`
+	AppRole      KeyVaultVaultAppRole `name:"approle"`
+	KVMount      string               `name:"kv-mount" description:"Mount path of the KV secrets engine that stores keys and certificates"` //nolint:lll
+	KVVersion    int                  `name:"kv-version" description:"Version of the KV secrets engine (1, 2)"`
+	KVPathPrefix string               `name:"kv-path-prefix" description:"Prefix of secret paths in the KV secrets engine"`
+	TransitMount string               `name:"transit-mount" description:"Mount path of the transit secrets engine used to wrap and unwrap keys. If empty, keys are wrapped with KEKs from the KV secrets engine"` //nolint:lll
+}
+
+var errVaultAuthMethod = errors.DefineInvalidArgument(
+	"vault_auth_method", "invalid Vault authentication method `{method}`",
+)
+
+func (v KeyVaultVault) client(
+	ctx context.Context, httpClientProvider httpclient.Provider,
+) (*cryptoutil.VaultClient, error) {
+	var auth cryptoutil.VaultAuthMethod
+	switch v.AuthMethod {
+	case "token":
+		auth = cryptoutil.VaultTokenAuth(v.Token)
+	case "approle":
+		auth = cryptoutil.VaultAppRoleAuth(v.AppRole.Mount, v.AppRole.RoleID, v.AppRole.SecretID)
+	default:
+		return nil, errVaultAuthMethod.WithAttributes("method", v.AuthMethod)
+	}
+	httpClient, err := httpClientProvider.HTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cryptoutil.NewVaultClient(httpClient, v.Address, auth, cryptoutil.WithVaultNamespace(v.Namespace)), nil
+}
+
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Provider string            `name:"provider" description:"Provider (static)"`
+	Provider string            `name:"provider" description:"Provider (static, vault)"`
 	Cache    KeyVaultCache     `name:"cache"`
 	Static   map[string][]byte `name:"static"`
+	Vault    KeyVaultVault     `name:"vault"`
 }
 
 // ComponentKEKLabeler returns an initialized crypto.ComponentKEKLabeler based on the configuration.
@@ -197,10 +242,23 @@ func (v KeyVault) ComponentKEKLabeler() (crypto.ComponentKEKLabeler, error) {
 
 // KeyService returns an initialized crypto.KeyService based on the configuration.
 func (v KeyVault) KeyService(ctx context.Context, httpClientProvider httpclient.Provider) (crypto.KeyService, error) {
-	var kv crypto.KeyVault
+	var (
+		kv          crypto.KeyVault
+		vaultClient *cryptoutil.VaultClient
+	)
 	switch v.Provider {
 	case "static":
 		kv = cryptoutil.NewMemKeyVault(v.Static)
+	case "vault":
+		var err error
+		vaultClient, err = v.Vault.client(ctx, httpClientProvider)
+		if err != nil {
+			return nil, err
+		}
+		kv = cryptoutil.NewVaultKeyVault(vaultClient, v.Vault.KVMount,
+			cryptoutil.WithVaultKVVersion(v.Vault.KVVersion),
+			cryptoutil.WithVaultKVPathPrefix(v.Vault.KVPathPrefix),
+		)
 	default:
 		kv = cryptoutil.EmptyKeyVault
 	}
@@ -215,6 +273,9 @@ func (v KeyVault) KeyService(ctx context.Context, httpClientProvider httpclient.
 		)
 	}
 	ks := crypto.NewKeyService(kv)
+	if vaultClient != nil && v.Vault.TransitMount != "" {
+		ks = cryptoutil.NewVaultTransitKeyService(ks, vaultClient, v.Vault.TransitMount)
+	}
 	if v.Cache.Size > 0 {
 		ks = cryptoutil.NewCacheKeyService(ks, v.Cache.TTL, v.Cache.Size)
 	}
@@ -342,6 +403,21 @@ type FrequencyPlansConfig struct {
 	Directory    string            `name:"directory" description:"OS filesystem directory, which contains frequency plans"` //nolint:lll
 	URL          string            `name:"url" description:"URL, which contains frequency plans"`
 	Blob         BlobPathConfig    `name:"blob"`
+	Bands        []string          `name:"bands" description:"Files that contain custom band definitions"`
+}
+
+// RegisterBands registers the custom bands defined in the band files.
+func (c FrequencyPlansConfig) RegisterBands() error {
+	for _, name := range c.Bands {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if _, err := band.RegisterDefinition(data); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Fetcher returns a fetch.Interface based on the configuration.
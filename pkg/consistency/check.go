@@ -0,0 +1,381 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+)
+
+var localComponents = []Component{NetworkServer, ApplicationServer, JoinServer}
+
+// host returns the lower case host of the address, without scheme and port.
+func host(address string) string {
+	if strings.Contains(address, "://") {
+		if u, err := url.Parse(address); err == nil {
+			address = u.Host
+		}
+	}
+	if h, _, err := net.SplitHostPort(address); err == nil {
+		address = h
+	}
+	return strings.ToLower(address)
+}
+
+// sameHost returns whether the addresses refer to the same host.
+func sameHost(a, b string) bool {
+	return host(a) == host(b)
+}
+
+func formatBytes(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+func formatVersionIDs(ids *ttnpb.EndDeviceVersionIdentifiers) string {
+	if ids == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s",
+		ids.GetBrandId(), ids.GetModelId(), ids.GetHardwareVersion(), ids.GetFirmwareVersion(), ids.GetBandId(),
+	)
+}
+
+func isEmptyKeyEnvelope(env *ttnpb.KeyEnvelope) bool {
+	return len(env.GetKey()) == 0 && len(env.GetEncryptedKey()) == 0
+}
+
+type checker struct {
+	*Checker
+	ids     *ttnpb.EndDeviceIdentifiers
+	devices Devices
+	issues  []*Issue
+}
+
+func (c *checker) report(issue *Issue) {
+	issue.Ids = c.ids
+	c.issues = append(c.issues, issue)
+}
+
+// isLocal returns whether the Identity Server points the end device at the checked registry of the component.
+func (c *checker) isLocal(component Component) bool {
+	address := c.Addresses[component]
+	if address == "" {
+		return false
+	}
+	return sameHost(component.address(c.devices[IdentityServer]), address)
+}
+
+// checkRecords checks that the checked registries have a record of the end device if, and only if,
+// the Identity Server points the end device at them.
+func (c *checker) checkRecords() {
+	is := c.devices[IdentityServer]
+	for _, component := range localComponents {
+		if _, ok := c.Registries[component]; !ok {
+			continue
+		}
+		dev := c.devices[component]
+		switch {
+		case is == nil && dev != nil:
+			c.report(&Issue{
+				Component: component,
+				Message:   "record of end device that does not exist in the Identity Server",
+			})
+		case is == nil:
+		case c.isLocal(component) && dev == nil:
+			path := component.addressPath()
+			c.report(&Issue{
+				Component: IdentityServer,
+				Path:      path,
+				Expected:  "",
+				Actual:    component.address(is),
+				Message:   fmt.Sprintf("end device points at the %s, which has no record of it", component),
+				Repair: &Repair{
+					Component: IdentityServer,
+					EndDevice: &ttnpb.EndDevice{Ids: is.GetIds()},
+					Paths:     []string{path},
+				},
+			})
+		case !c.isLocal(component) && dev != nil && c.Addresses[component] != "":
+			c.report(&Issue{
+				Component: component,
+				Expected:  c.Addresses[component],
+				Actual:    component.address(is),
+				Message:   fmt.Sprintf("stale record of end device that the Identity Server points at another %s", component),
+			})
+		}
+	}
+}
+
+// checkEUIs checks that the EUIs of the end device in the registries match the EUIs in the Identity Server.
+func (c *checker) checkEUIs() {
+	is := c.devices[IdentityServer]
+	if is == nil {
+		return
+	}
+	for _, component := range localComponents {
+		dev := c.devices[component]
+		if dev == nil {
+			continue
+		}
+		for _, eui := range []struct {
+			path     string
+			expected []byte
+			actual   []byte
+		}{
+			{"ids.join_eui", is.GetIds().GetJoinEui(), dev.GetIds().GetJoinEui()},
+			{"ids.dev_eui", is.GetIds().GetDevEui(), dev.GetIds().GetDevEui()},
+		} {
+			if len(eui.expected) == 0 || len(eui.actual) == 0 || bytes.Equal(eui.expected, eui.actual) {
+				continue
+			}
+			c.report(&Issue{
+				Component: component,
+				Path:      eui.path,
+				Expected:  formatBytes(eui.expected),
+				Actual:    formatBytes(eui.actual),
+				Message:   "EUI does not match the Identity Server",
+			})
+		}
+	}
+}
+
+// checkFrequencyPlan checks that the frequency plan in the Network Server exists
+// and that it matches the band of the end device version in the Identity Server.
+func (c *checker) checkFrequencyPlan() {
+	ns := c.devices[NetworkServer]
+	if ns == nil || c.FrequencyPlans == nil {
+		return
+	}
+	fpID := ns.GetFrequencyPlanId()
+	if fpID == "" {
+		c.report(&Issue{
+			Component: NetworkServer,
+			Path:      "frequency_plan_id",
+			Message:   "end device has no frequency plan",
+		})
+		return
+	}
+	fp, err := c.FrequencyPlans.GetByID(fpID)
+	if err != nil {
+		c.report(&Issue{
+			Component: NetworkServer,
+			Path:      "frequency_plan_id",
+			Actual:    fpID,
+			Message:   "frequency plan does not exist",
+		})
+		return
+	}
+	bandID := c.devices[IdentityServer].GetVersionIds().GetBandId()
+	if bandID != "" && fp.BandID != bandID {
+		c.report(&Issue{
+			Component: NetworkServer,
+			Path:      "frequency_plan_id",
+			Expected:  bandID,
+			Actual:    fmt.Sprintf("%s (%s)", fpID, fp.BandID),
+			Message:   "band of frequency plan does not match the band of the end device version",
+		})
+	}
+}
+
+// checkVersionIDs checks that the end device version in the Application Server matches the Identity Server.
+func (c *checker) checkVersionIDs() {
+	is, as := c.devices[IdentityServer], c.devices[ApplicationServer]
+	if is == nil || as == nil || is.GetVersionIds() == nil {
+		return
+	}
+	if proto.Equal(is.GetVersionIds(), as.GetVersionIds()) {
+		return
+	}
+	c.report(&Issue{
+		Component: ApplicationServer,
+		Path:      "version_ids",
+		Expected:  formatVersionIDs(is.GetVersionIds()),
+		Actual:    formatVersionIDs(as.GetVersionIds()),
+		Message:   "end device version does not match the Identity Server",
+		Repair: &Repair{
+			Component: ApplicationServer,
+			EndDevice: &ttnpb.EndDevice{Ids: as.GetIds(), VersionIds: is.GetVersionIds()},
+			Paths:     []string{"version_ids"},
+		},
+	})
+}
+
+// checkJoinServerAddresses checks that the server addresses in the Join Server match the Identity Server.
+func (c *checker) checkJoinServerAddresses() {
+	is, js := c.devices[IdentityServer], c.devices[JoinServer]
+	if is == nil || js == nil {
+		return
+	}
+	for _, address := range []struct {
+		path     string
+		expected string
+		actual   string
+		set      func(*ttnpb.EndDevice, string)
+	}{
+		{
+			"network_server_address", is.GetNetworkServerAddress(), js.GetNetworkServerAddress(),
+			func(dev *ttnpb.EndDevice, s string) { dev.NetworkServerAddress = s },
+		},
+		{
+			"application_server_address", is.GetApplicationServerAddress(), js.GetApplicationServerAddress(),
+			func(dev *ttnpb.EndDevice, s string) { dev.ApplicationServerAddress = s },
+		},
+	} {
+		// The Join Server uses the default address when the address is empty.
+		if address.actual == "" || address.expected == "" || sameHost(address.expected, address.actual) {
+			continue
+		}
+		dev := &ttnpb.EndDevice{Ids: js.GetIds()}
+		address.set(dev, address.expected)
+		c.report(&Issue{
+			Component: JoinServer,
+			Path:      address.path,
+			Expected:  address.expected,
+			Actual:    address.actual,
+			Message:   "server address does not match the Identity Server",
+			Repair: &Repair{
+				Component: JoinServer,
+				EndDevice: dev,
+				Paths:     []string{address.path},
+			},
+		})
+	}
+}
+
+// checkRootKeys checks that the Join Server has a NwkKey for end devices that activate over the air
+// with LoRaWAN 1.1 or later.
+func (c *checker) checkRootKeys() {
+	ns, js := c.devices[NetworkServer], c.devices[JoinServer]
+	if ns == nil || js == nil || !ns.GetSupportsJoin() || !macspec.UseNwkKey(ns.GetLorawanVersion()) {
+		return
+	}
+	if !isEmptyKeyEnvelope(js.GetRootKeys().GetNwkKey()) {
+		return
+	}
+	c.report(&Issue{
+		Component: JoinServer,
+		Path:      "root_keys.nwk_key",
+		Expected:  ns.GetLorawanVersion().String(),
+		Message:   "end device has no NwkKey, which is required by the LoRaWAN version in the Network Server",
+	})
+}
+
+// checkSessions checks that the Application Server has the sessions of the Network Server,
+// and that the Application Server has the AppSKey of its sessions.
+func (c *checker) checkSessions() {
+	ns, as := c.devices[NetworkServer], c.devices[ApplicationServer]
+	if as == nil {
+		return
+	}
+	if ns != nil {
+		for _, session := range []struct {
+			path    string
+			session *ttnpb.Session
+		}{
+			{"session", ns.GetSession()},
+			{"pending_session", ns.GetPendingSession()},
+		} {
+			keyID := session.session.GetKeys().GetSessionKeyId()
+			if len(keyID) == 0 {
+				continue
+			}
+			var asSession *ttnpb.Session
+			for _, s := range []*ttnpb.Session{as.GetSession(), as.GetPendingSession()} {
+				if bytes.Equal(s.GetKeys().GetSessionKeyId(), keyID) {
+					asSession = s
+					break
+				}
+			}
+			switch {
+			case asSession == nil:
+				c.report(&Issue{
+					Component: ApplicationServer,
+					Path:      session.path,
+					Expected:  formatBytes(keyID),
+					Message:   "session of the Network Server is missing",
+				})
+			case !bytes.Equal(asSession.GetDevAddr(), session.session.GetDevAddr()):
+				c.report(&Issue{
+					Component: ApplicationServer,
+					Path:      session.path + ".dev_addr",
+					Expected:  formatBytes(session.session.GetDevAddr()),
+					Actual:    formatBytes(asSession.GetDevAddr()),
+					Message:   "DevAddr of session does not match the Network Server",
+				})
+			}
+		}
+	}
+	for _, session := range []struct {
+		path    string
+		session *ttnpb.Session
+	}{
+		{"session", as.GetSession()},
+		{"pending_session", as.GetPendingSession()},
+	} {
+		if session.session == nil {
+			continue
+		}
+		appSKey := session.session.GetKeys().GetAppSKey()
+		switch {
+		case isEmptyKeyEnvelope(appSKey):
+			c.report(&Issue{
+				Component: ApplicationServer,
+				Path:      session.path + ".keys.app_s_key",
+				Message:   "session has no AppSKey",
+			})
+		case len(appSKey.GetEncryptedKey()) > 0 && appSKey.GetKekLabel() == "":
+			c.report(&Issue{
+				Component: ApplicationServer,
+				Path:      session.path + ".keys.app_s_key.kek_label",
+				Message:   "encrypted AppSKey has no KEK label",
+			})
+		}
+	}
+}
+
+// Check returns the issues of the end device.
+func (c *Checker) Check(devices Devices) []*Issue {
+	var ids *ttnpb.EndDeviceIdentifiers
+	for _, component := range append([]Component{IdentityServer}, localComponents...) {
+		if dev := devices[component]; dev != nil {
+			ids = dev.GetIds()
+			break
+		}
+	}
+	chk := &checker{
+		Checker: c,
+		ids:     ids,
+		devices: devices,
+	}
+	chk.checkRecords()
+	chk.checkEUIs()
+	chk.checkFrequencyPlan()
+	chk.checkVersionIDs()
+	chk.checkJoinServerAddresses()
+	chk.checkRootKeys()
+	chk.checkSessions()
+	return chk.issues
+}
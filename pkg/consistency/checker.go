@@ -0,0 +1,163 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errNoIdentityServer = errors.DefineFailedPrecondition("no_identity_server", "no Identity Server registry")
+	errNoRegistry       = errors.DefineFailedPrecondition("no_registry", "no registry of component `{component}`")
+	errNoRepair         = errors.DefineFailedPrecondition("no_repair", "issue can not be repaired automatically")
+	errRepairOutdated   = errors.DefineAborted(
+		"repair_outdated", "issue of end device `{device_uid}` no longer applies to the {component}",
+	)
+)
+
+// Registry is an end device registry of a component.
+type Registry interface {
+	// Range calls f for each end device in the registry, with the fields in paths.
+	Range(ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDevice) error) error
+	// Get returns the fields in paths of the end device in the registry.
+	// Get returns a NotFound error if the registry has no record of the end device.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error)
+	// Update sets the fields in paths of the end device in the registry.
+	Update(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error
+}
+
+// Checker checks the end device registries for consistency.
+type Checker struct {
+	// Registries are the registries to check, by component.
+	// The Identity Server registry is required.
+	Registries map[Component]Registry
+	// Addresses are the addresses of the components of which the registries are checked.
+	// End devices in the Identity Server that have these addresses are expected to have a record in the registry.
+	Addresses map[Component]string
+	// FrequencyPlans are used to check the frequency plans of end devices. Optional.
+	FrequencyPlans FrequencyPlans
+}
+
+// loadOrder is the order in which the registries are loaded.
+// The Identity Server is loaded last: end devices are created in the Identity Server before they are created in
+// the other registries, so records that are created in the other registries during the load are not reported as
+// records of end devices that do not exist in the Identity Server.
+var loadOrder = []Component{NetworkServer, ApplicationServer, JoinServer, IdentityServer}
+
+// Load loads the end devices of all registries, by unique end device ID.
+func (c *Checker) Load(ctx context.Context) (map[string]Devices, error) {
+	if _, ok := c.Registries[IdentityServer]; !ok {
+		return nil, errNoIdentityServer.New()
+	}
+	devices := make(map[string]Devices)
+	for _, component := range loadOrder {
+		registry, ok := c.Registries[component]
+		if !ok {
+			continue
+		}
+		component := component
+		err := registry.Range(ctx, Paths[component], func(ctx context.Context, dev *ttnpb.EndDevice) error {
+			uid := unique.ID(ctx, dev.GetIds())
+			if devices[uid] == nil {
+				devices[uid] = make(Devices)
+			}
+			devices[uid][component] = dev
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return devices, nil
+}
+
+// Run loads the end devices of all registries, and returns the issues sorted by unique end device ID.
+func (c *Checker) Run(ctx context.Context) ([]*Issue, error) {
+	devices, err := c.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]string, 0, len(devices))
+	for uid := range devices {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	var issues []*Issue
+	for _, uid := range uids {
+		issues = append(issues, c.Check(devices[uid])...)
+	}
+	return issues, nil
+}
+
+// verifyMissingRecord verifies that the registry of the component still has no record of the end device,
+// and that the Identity Server still points the end device at the component.
+func (c *Checker) verifyMissingRecord(
+	ctx context.Context, component Component, ids *ttnpb.EndDeviceIdentifiers,
+) error {
+	registry, ok := c.Registries[component]
+	if !ok {
+		return errNoRegistry.WithAttributes("component", component)
+	}
+	outdated := errRepairOutdated.WithAttributes(
+		"device_uid", unique.ID(ctx, ids),
+		"component", component,
+	)
+	_, err := registry.Get(ctx, ids, []string{"ids"})
+	switch {
+	case err == nil:
+		return outdated
+	case !errors.IsNotFound(err):
+		return err
+	}
+	dev, err := c.Registries[IdentityServer].Get(ctx, ids, []string{component.addressPath()})
+	if err != nil {
+		return err
+	}
+	if !sameHost(component.address(dev), c.Addresses[component]) {
+		return outdated
+	}
+	return nil
+}
+
+// Repair applies the repair of the issue.
+// The issues are reported from a snapshot of the registries, so before the address of a component
+// is cleared in the Identity Server, Repair verifies that the registry of the component still has
+// no record of the end device.
+func (c *Checker) Repair(ctx context.Context, issue *Issue) error {
+	if issue.Repair == nil {
+		return errNoRepair.New()
+	}
+	registry, ok := c.Registries[issue.Repair.Component]
+	if !ok {
+		return errNoRegistry.WithAttributes("component", issue.Repair.Component)
+	}
+	if issue.Repair.Component == IdentityServer {
+		for _, component := range localComponents {
+			if !slices.Contains(issue.Repair.Paths, component.addressPath()) {
+				continue
+			}
+			if err := c.verifyMissingRecord(ctx, component, issue.Repair.EndDevice.GetIds()); err != nil {
+				return err
+			}
+		}
+	}
+	return registry.Update(ctx, issue.Repair.EndDevice, issue.Repair.Paths)
+}
@@ -0,0 +1,148 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package consistency compares the end device registries of the Identity Server, Network Server,
+// Application Server and Join Server, and repairs drift between them.
+package consistency
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Component is a component that has an end device registry.
+type Component string
+
+// Components with an end device registry.
+const (
+	IdentityServer    Component = "is"
+	NetworkServer     Component = "ns"
+	ApplicationServer Component = "as"
+	JoinServer        Component = "js"
+)
+
+// String implements fmt.Stringer.
+func (c Component) String() string {
+	switch c {
+	case IdentityServer:
+		return "Identity Server"
+	case NetworkServer:
+		return "Network Server"
+	case ApplicationServer:
+		return "Application Server"
+	case JoinServer:
+		return "Join Server"
+	default:
+		return string(c)
+	}
+}
+
+// addressPath returns the path of the end device field in the Identity Server that contains the address
+// of the component.
+func (c Component) addressPath() string {
+	switch c {
+	case NetworkServer:
+		return "network_server_address"
+	case ApplicationServer:
+		return "application_server_address"
+	case JoinServer:
+		return "join_server_address"
+	default:
+		return ""
+	}
+}
+
+// address returns the address of the component in the end device.
+func (c Component) address(dev *ttnpb.EndDevice) string {
+	switch c {
+	case NetworkServer:
+		return dev.GetNetworkServerAddress()
+	case ApplicationServer:
+		return dev.GetApplicationServerAddress()
+	case JoinServer:
+		return dev.GetJoinServerAddress()
+	default:
+		return ""
+	}
+}
+
+// Paths are the end device fields that are loaded from the registry of each component.
+var Paths = map[Component][]string{
+	IdentityServer: {
+		"ids",
+		"network_server_address",
+		"application_server_address",
+		"join_server_address",
+		"version_ids",
+	},
+	NetworkServer: {
+		"ids",
+		"frequency_plan_id",
+		"lorawan_version",
+		"supports_join",
+		"session.dev_addr",
+		"session.keys.session_key_id",
+		"pending_session.dev_addr",
+		"pending_session.keys.session_key_id",
+	},
+	ApplicationServer: {
+		"ids",
+		"version_ids",
+		"session",
+		"pending_session",
+	},
+	JoinServer: {
+		"ids",
+		"network_server_address",
+		"application_server_address",
+		"root_keys.nwk_key",
+	},
+}
+
+// Devices are the records of an end device in the registries, by component.
+// A component has no entry if it has no record of the end device.
+type Devices map[Component]*ttnpb.EndDevice
+
+// FrequencyPlans provides frequency plans by ID.
+type FrequencyPlans interface {
+	GetByID(id string) (*frequencyplans.FrequencyPlan, error)
+}
+
+// Repair is a change to an end device in a registry that repairs an issue.
+type Repair struct {
+	// Component is the component of which the registry is changed.
+	Component Component
+	// EndDevice contains the identifiers and the fields to set.
+	EndDevice *ttnpb.EndDevice
+	// Paths are the paths of the fields to set.
+	Paths []string
+}
+
+// Issue is an inconsistency of an end device between registries.
+type Issue struct {
+	// Ids are the identifiers of the end device.
+	Ids *ttnpb.EndDeviceIdentifiers
+	// Component is the component of which the registry is inconsistent.
+	Component Component
+	// Path is the path of the inconsistent field. The path is empty if the record is missing or stale.
+	Path string
+	// Expected is the expected value of the field.
+	Expected string
+	// Actual is the actual value of the field.
+	Actual string
+	// Message describes the issue.
+	Message string
+	// Repair is the change that repairs the issue, or nil if the issue cannot be repaired automatically.
+	Repair *Repair
+}
@@ -0,0 +1,461 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency_test
+
+import (
+	"context"
+	"testing"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/consistency"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+)
+
+type memRegistry map[string]*ttnpb.EndDevice
+
+func (r memRegistry) Range(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDevice) error,
+) error {
+	for _, dev := range r {
+		res := &ttnpb.EndDevice{}
+		if err := res.SetFields(dev, paths...); err != nil {
+			return err
+		}
+		if err := f(ctx, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+func (r memRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	dev, ok := r[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	res := &ttnpb.EndDevice{}
+	if err := res.SetFields(dev, paths...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r memRegistry) Update(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	stored, ok := r[unique.ID(ctx, dev.GetIds())]
+	if !ok {
+		return errors.New("not found")
+	}
+	return stored.SetFields(dev, paths...)
+}
+
+type frequencyPlans map[string]*frequencyplans.FrequencyPlan
+
+func (fps frequencyPlans) GetByID(id string) (*frequencyplans.FrequencyPlan, error) {
+	fp, ok := fps[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return fp, nil
+}
+
+var (
+	devIDs = &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		DeviceId:       "foo-dev",
+		JoinEui:        types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}.Bytes(),
+		DevEui:         types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30}.Bytes(),
+	}
+	versionIDs = &ttnpb.EndDeviceVersionIdentifiers{
+		BrandId:         "foo-brand",
+		ModelId:         "foo-model",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0",
+		BandId:          "EU_863_870",
+	}
+	sessionKeyID = []byte{0x01, 0x02, 0x03}
+	devAddr      = types.DevAddr{0x26, 0x01, 0x02, 0x03}.Bytes()
+)
+
+func idsWith(f func(*ttnpb.EndDeviceIdentifiers)) *ttnpb.EndDeviceIdentifiers {
+	ids := proto.Clone(devIDs).(*ttnpb.EndDeviceIdentifiers)
+	f(ids)
+	return ids
+}
+
+func consistentDevices() Devices {
+	return Devices{
+		IdentityServer: {
+			Ids:                      devIDs,
+			NetworkServerAddress:     "ns.example.com",
+			ApplicationServerAddress: "as.example.com",
+			JoinServerAddress:        "js.example.com",
+			VersionIds:               versionIDs,
+		},
+		NetworkServer: {
+			Ids:             devIDs,
+			FrequencyPlanId: "EU_863_870",
+			LorawanVersion:  ttnpb.MACVersion_MAC_V1_1,
+			SupportsJoin:    true,
+			Session: &ttnpb.Session{
+				DevAddr: devAddr,
+				Keys:    &ttnpb.SessionKeys{SessionKeyId: sessionKeyID},
+			},
+		},
+		ApplicationServer: {
+			Ids:        devIDs,
+			VersionIds: versionIDs,
+			Session: &ttnpb.Session{
+				DevAddr: devAddr,
+				Keys: &ttnpb.SessionKeys{
+					SessionKeyId: sessionKeyID,
+					AppSKey: &ttnpb.KeyEnvelope{
+						EncryptedKey: []byte{0x01},
+						KekLabel:     "as",
+					},
+				},
+			},
+		},
+		JoinServer: {
+			Ids:                      devIDs,
+			NetworkServerAddress:     "ns.example.com:8884",
+			ApplicationServerAddress: "as.example.com",
+			RootKeys: &ttnpb.RootKeys{
+				NwkKey: &ttnpb.KeyEnvelope{Key: make([]byte, 16)},
+			},
+		},
+	}
+}
+
+func newChecker() *Checker {
+	return &Checker{
+		Registries: map[Component]Registry{
+			IdentityServer:    memRegistry{},
+			NetworkServer:     memRegistry{},
+			ApplicationServer: memRegistry{},
+			JoinServer:        memRegistry{},
+		},
+		Addresses: map[Component]string{
+			NetworkServer:     "ns.example.com:8884",
+			ApplicationServer: "https://as.example.com",
+			JoinServer:        "js.example.com",
+		},
+		FrequencyPlans: frequencyPlans{
+			"EU_863_870": {BandID: "EU_863_870"},
+			"US_902_928": {BandID: "US_902_928"},
+		},
+	}
+}
+
+type issue struct {
+	Component Component
+	Path      string
+	Repair    bool
+}
+
+func issuesOf(issues []*Issue) []issue {
+	res := make([]issue, len(issues))
+	for i, iss := range issues {
+		res[i] = issue{
+			Component: iss.Component,
+			Path:      iss.Path,
+			Repair:    iss.Repair != nil,
+		}
+	}
+	return res
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name     string
+		Modify   func(Devices)
+		Expected []issue
+	}{
+		{
+			Name:   "Consistent",
+			Modify: func(Devices) {},
+		},
+		{
+			Name: "MissingRecord",
+			Modify: func(devs Devices) {
+				delete(devs, NetworkServer)
+			},
+			Expected: []issue{
+				{IdentityServer, "network_server_address", true},
+			},
+		},
+		{
+			Name: "StaleRecord",
+			Modify: func(devs Devices) {
+				devs[IdentityServer].JoinServerAddress = "js.other.com"
+			},
+			Expected: []issue{
+				{JoinServer, "", false},
+			},
+		},
+		{
+			Name: "OrphanRecords",
+			Modify: func(devs Devices) {
+				delete(devs, IdentityServer)
+			},
+			Expected: []issue{
+				{NetworkServer, "", false},
+				{ApplicationServer, "", false},
+				{JoinServer, "", false},
+			},
+		},
+		{
+			Name: "EUIs",
+			Modify: func(devs Devices) {
+				devs[NetworkServer].Ids = idsWith(func(ids *ttnpb.EndDeviceIdentifiers) {
+					ids.DevEui = types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}.Bytes()
+				})
+				devs[JoinServer].Ids = idsWith(func(ids *ttnpb.EndDeviceIdentifiers) {
+					ids.JoinEui = types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}.Bytes()
+				})
+			},
+			Expected: []issue{
+				{NetworkServer, "ids.dev_eui", false},
+				{JoinServer, "ids.join_eui", false},
+			},
+		},
+		{
+			Name: "FrequencyPlanBand",
+			Modify: func(devs Devices) {
+				devs[NetworkServer].FrequencyPlanId = "US_902_928"
+			},
+			Expected: []issue{
+				{NetworkServer, "frequency_plan_id", false},
+			},
+		},
+		{
+			Name: "UnknownFrequencyPlan",
+			Modify: func(devs Devices) {
+				devs[NetworkServer].FrequencyPlanId = "unknown"
+			},
+			Expected: []issue{
+				{NetworkServer, "frequency_plan_id", false},
+			},
+		},
+		{
+			Name: "VersionIDs",
+			Modify: func(devs Devices) {
+				devs[ApplicationServer].VersionIds = nil
+			},
+			Expected: []issue{
+				{ApplicationServer, "version_ids", true},
+			},
+		},
+		{
+			Name: "JoinServerAddresses",
+			Modify: func(devs Devices) {
+				devs[JoinServer].NetworkServerAddress = "ns.other.com"
+				devs[JoinServer].ApplicationServerAddress = ""
+			},
+			Expected: []issue{
+				{JoinServer, "network_server_address", true},
+			},
+		},
+		{
+			Name: "NwkKey",
+			Modify: func(devs Devices) {
+				devs[JoinServer].RootKeys = &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: make([]byte, 16)},
+				}
+			},
+			Expected: []issue{
+				{JoinServer, "root_keys.nwk_key", false},
+			},
+		},
+		{
+			Name: "NwkKeyLoRaWAN1.0",
+			Modify: func(devs Devices) {
+				devs[NetworkServer].LorawanVersion = ttnpb.MACVersion_MAC_V1_0_3
+				devs[JoinServer].RootKeys = nil
+			},
+		},
+		{
+			Name: "MissingSession",
+			Modify: func(devs Devices) {
+				devs[ApplicationServer].Session = nil
+			},
+			Expected: []issue{
+				{ApplicationServer, "session", false},
+			},
+		},
+		{
+			Name: "PendingSession",
+			Modify: func(devs Devices) {
+				devs[NetworkServer].PendingSession = devs[NetworkServer].Session
+				devs[NetworkServer].Session = nil
+			},
+		},
+		{
+			Name: "SessionDevAddr",
+			Modify: func(devs Devices) {
+				devs[ApplicationServer].Session.DevAddr = types.DevAddr{0x26, 0xff, 0xff, 0xff}.Bytes()
+			},
+			Expected: []issue{
+				{ApplicationServer, "session.dev_addr", false},
+			},
+		},
+		{
+			Name: "AppSKey",
+			Modify: func(devs Devices) {
+				devs[ApplicationServer].PendingSession = &ttnpb.Session{
+					Keys: &ttnpb.SessionKeys{SessionKeyId: []byte{0x04}},
+				}
+				devs[ApplicationServer].Session.Keys.AppSKey.KekLabel = ""
+			},
+			Expected: []issue{
+				{ApplicationServer, "session.keys.app_s_key.kek_label", false},
+				{ApplicationServer, "pending_session.keys.app_s_key", false},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			devs := consistentDevices()
+			tc.Modify(devs)
+			issues := newChecker().Check(devs)
+			if tc.Expected == nil {
+				a.So(issues, should.BeEmpty)
+				return
+			}
+			a.So(issuesOf(issues), should.Resemble, tc.Expected)
+			for _, iss := range issues {
+				a.So(iss.Ids.GetDeviceId(), should.Equal, "foo-dev")
+				a.So(iss.Message, should.NotBeEmpty)
+			}
+		})
+	}
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	checker := newChecker()
+	devs := consistentDevices()
+	devs[IdentityServer].ApplicationServerAddress = "as.other.com"
+	devs[ApplicationServer].VersionIds = nil
+	devs[JoinServer].ApplicationServerAddress = "as.example.com"
+	delete(devs, NetworkServer)
+	uid := unique.ID(ctx, devIDs)
+	for component, dev := range devs {
+		checker.Registries[component].(memRegistry)[uid] = dev
+	}
+	orphanIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: devIDs.ApplicationIds,
+		DeviceId:       "bar-dev",
+	}
+	checker.Registries[NetworkServer].(memRegistry)[unique.ID(ctx, orphanIDs)] = &ttnpb.EndDevice{
+		Ids:             orphanIDs,
+		FrequencyPlanId: "EU_863_870",
+	}
+
+	issues, err := checker.Run(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(issuesOf(issues), should.Resemble, []issue{
+		{NetworkServer, "", false},
+		{IdentityServer, "network_server_address", true},
+		{ApplicationServer, "", false},
+		{ApplicationServer, "version_ids", true},
+		{JoinServer, "application_server_address", true},
+	})
+	a.So(issues[0].Ids.GetDeviceId(), should.Equal, "bar-dev")
+
+	for _, iss := range issues {
+		err := checker.Repair(ctx, iss)
+		if iss.Repair == nil {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+			continue
+		}
+		a.So(err, should.BeNil)
+	}
+
+	is := checker.Registries[IdentityServer].(memRegistry)[uid]
+	a.So(is.GetNetworkServerAddress(), should.BeEmpty)
+	as := checker.Registries[ApplicationServer].(memRegistry)[uid]
+	a.So(as.GetVersionIds(), should.Resemble, versionIDs)
+	js := checker.Registries[JoinServer].(memRegistry)[uid]
+	a.So(js.GetApplicationServerAddress(), should.Equal, "as.other.com")
+
+	issues, err = checker.Run(ctx)
+	if a.So(err, should.BeNil) {
+		a.So(issuesOf(issues), should.Resemble, []issue{
+			{NetworkServer, "", false},
+			{ApplicationServer, "", false},
+		})
+	}
+
+	_, err = (&Checker{}).Run(ctx)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+}
+
+func TestCheckerRepairOutdated(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	checker := newChecker()
+	devs := consistentDevices()
+	delete(devs, NetworkServer)
+	uid := unique.ID(ctx, devIDs)
+	for component, dev := range devs {
+		checker.Registries[component].(memRegistry)[uid] = dev
+	}
+
+	issues, err := checker.Run(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(issuesOf(issues), should.Resemble, []issue{
+		{IdentityServer, "network_server_address", true},
+	}) {
+		t.FailNow()
+	}
+
+	// The Network Server creates the record after the registries are loaded.
+	checker.Registries[NetworkServer].(memRegistry)[uid] = consistentDevices()[NetworkServer]
+	err = checker.Repair(ctx, issues[0])
+	a.So(errors.IsAborted(err), should.BeTrue)
+	a.So(checker.Registries[IdentityServer].(memRegistry)[uid].GetNetworkServerAddress(), should.NotBeEmpty)
+
+	// The end device is pointed at another Network Server after the registries are loaded.
+	delete(checker.Registries[NetworkServer].(memRegistry), uid)
+	checker.Registries[IdentityServer].(memRegistry)[uid].NetworkServerAddress = "ns.other.com"
+	err = checker.Repair(ctx, issues[0])
+	a.So(errors.IsAborted(err), should.BeTrue)
+	a.So(checker.Registries[IdentityServer].(memRegistry)[uid].GetNetworkServerAddress(), should.Equal, "ns.other.com")
+
+	checker.Registries[IdentityServer].(memRegistry)[uid].NetworkServerAddress = "ns.example.com"
+	a.So(checker.Repair(ctx, issues[0]), should.BeNil)
+	a.So(checker.Registries[IdentityServer].(memRegistry)[uid].GetNetworkServerAddress(), should.BeEmpty)
+}
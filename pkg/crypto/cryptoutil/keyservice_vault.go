@@ -0,0 +1,101 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+type vaultTransitKeyService struct {
+	crypto.KeyService
+	client *VaultClient
+	mount  string
+}
+
+// vaultTransitCiphertextPrefix is the prefix of ciphertexts of the transit secrets engine.
+var vaultTransitCiphertextPrefix = []byte("vault:")
+
+// NewVaultTransitKeyService returns a crypto.KeyService that wraps and unwraps keys using the transit secrets engine
+// mounted at the given path. The KEK label is used as the name of the transit key.
+// Keys that are not wrapped by the transit secrets engine, such as keys wrapped with AES key wrap before the transit
+// secrets engine was configured, are unwrapped by the given key service.
+// All other operations are delegated to the given key service.
+func NewVaultTransitKeyService(inner crypto.KeyService, client *VaultClient, mount string) crypto.KeyService {
+	return &vaultTransitKeyService{
+		KeyService: inner,
+		client:     client,
+		mount:      mount,
+	}
+}
+
+func validKeyLength(n int) bool {
+	return n == 16 || n == 24 || n == 32
+}
+
+// Wrap implements crypto.KeyService.
+func (ks *vaultTransitKeyService) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	if !validKeyLength(len(plaintext)) {
+		return nil, errInvalidLength.New()
+	}
+	var res struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	if err := ks.client.Do(ctx, http.MethodPost, ks.mount+"/encrypt/"+url.PathEscape(kekLabel), map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}, &res); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errKeyNotFound.WithAttributes("label", kekLabel)
+		}
+		return nil, err
+	}
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// Unwrap implements crypto.KeyService.
+func (ks *vaultTransitKeyService) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, vaultTransitCiphertextPrefix) {
+		return ks.KeyService.Unwrap(ctx, ciphertext, kekLabel)
+	}
+	var res struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if err := ks.client.Do(ctx, http.MethodPost, ks.mount+"/decrypt/"+url.PathEscape(kekLabel), map[string]string{
+		"ciphertext": string(ciphertext),
+	}, &res); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errKeyNotFound.WithAttributes("label", kekLabel)
+		}
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(res.Data.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	if !validKeyLength(len(plaintext)) {
+		return nil, errInvalidLength.New()
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestVaultTransitKeyService(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	vault := newMockVault()
+	vault.tokens["root"] = 0
+	srv := httptest.NewServer(vault)
+	t.Cleanup(srv.Close)
+
+	client := cryptoutil.NewVaultClient(srv.Client(), srv.URL, cryptoutil.VaultTokenAuth("root"))
+	ks := cryptoutil.NewVaultTransitKeyService(
+		crypto.NewKeyService(cryptoutil.NewVaultKeyVault(client, "secret")), client, "transit",
+	)
+
+	plaintext := []byte{
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}
+	wrapped, err := ks.Wrap(ctx, plaintext, "ns:kek1")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(string(wrapped), should.Equal, "vault:v1:ns:kek1:ABEiM0RVZneImaq7zN3u/w==")
+
+	unwrapped, err := ks.Unwrap(ctx, wrapped, "ns:kek1")
+	a.So(err, should.BeNil)
+	a.So(unwrapped, should.Resemble, plaintext)
+
+	_, err = ks.Wrap(ctx, plaintext[:10], "ns:kek1")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = ks.Unwrap(ctx, wrapped, "ns:kek2")
+	a.So(err, should.NotBeNil)
+
+	// Keys wrapped with AES key wrap are unwrapped with KEKs from the KV secrets engine.
+	kek := []byte{
+		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+	}
+	vault.secrets["kek1"] = map[string]string{"key": "000102030405060708090A0B0C0D0E0F"}
+	legacy, err := crypto.WrapKey(plaintext, kek)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	unwrapped, err = ks.Unwrap(ctx, legacy, "kek1")
+	a.So(err, should.BeNil)
+	a.So(unwrapped, should.Resemble, plaintext)
+
+	// Other operations use keys from the KV secrets engine.
+	_, err = ks.Encrypt(ctx, plaintext, "key1")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
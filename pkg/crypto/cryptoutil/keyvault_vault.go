@@ -0,0 +1,143 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var errVaultSecretField = errors.DefineCorruption(
+	"vault_secret_field", "field `{field}` of Vault secret `{label}` is missing or invalid",
+)
+
+type vaultKeyVault struct {
+	client     *VaultClient
+	mount      string
+	pathPrefix string
+	kvVersion  int
+}
+
+// VaultKeyVaultOption configures the Vault KV key vault.
+type VaultKeyVaultOption func(*vaultKeyVault)
+
+// WithVaultKVVersion configures the version of the KV secrets engine (1 or 2). The default is 2.
+func WithVaultKVVersion(version int) VaultKeyVaultOption {
+	return func(kv *vaultKeyVault) {
+		kv.kvVersion = version
+	}
+}
+
+// WithVaultKVPathPrefix configures the prefix of the secret paths.
+func WithVaultKVPathPrefix(prefix string) VaultKeyVaultOption {
+	return func(kv *vaultKeyVault) {
+		kv.pathPrefix = prefix
+	}
+}
+
+// NewVaultKeyVault returns a crypto.KeyVault that reads keys and certificates from the KV secrets engine
+// mounted at the given path.
+// Keys are stored hex encoded in the `key` field of the secret with the label as name.
+// Certificates are stored PEM encoded in the `certificate` and `private_key` fields.
+func NewVaultKeyVault(client *VaultClient, mount string, opts ...VaultKeyVaultOption) crypto.KeyVault {
+	kv := &vaultKeyVault{
+		client:    client,
+		mount:     mount,
+		kvVersion: 2,
+	}
+	for _, opt := range opts {
+		opt(kv)
+	}
+	return kv
+}
+
+func (kv *vaultKeyVault) secretPath(label string) string {
+	name := kv.pathPrefix + url.PathEscape(label)
+	if kv.kvVersion == 1 {
+		return kv.mount + "/" + name
+	}
+	return kv.mount + "/data/" + name
+}
+
+func (kv *vaultKeyVault) read(ctx context.Context, label string) (map[string]string, error) {
+	var res vaultSecret
+	if err := kv.client.Do(ctx, http.MethodGet, kv.secretPath(label), nil, &res); err != nil {
+		return nil, err
+	}
+	data := res.Data
+	if kv.kvVersion != 1 {
+		var v2 struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(data, &v2); err != nil {
+			return nil, err
+		}
+		data = v2.Data
+	}
+	fields := make(map[string]string)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// Key implements crypto.KeyVault.
+func (kv *vaultKeyVault) Key(ctx context.Context, label string) ([]byte, error) {
+	fields, err := kv.read(ctx, label)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errKeyNotFound.WithAttributes("label", label)
+		}
+		return nil, err
+	}
+	key, err := hex.DecodeString(fields["key"])
+	if err != nil || len(key) == 0 {
+		return nil, errVaultSecretField.WithAttributes("field", "key", "label", label)
+	}
+	return key, nil
+}
+
+func (kv *vaultKeyVault) certificate(ctx context.Context, label string) (tls.Certificate, error) {
+	fields, err := kv.read(ctx, label)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return tls.Certificate{}, errCertificateNotFound.WithAttributes("label", label)
+		}
+		return tls.Certificate{}, err
+	}
+	for _, field := range []string{"certificate", "private_key"} {
+		if fields[field] == "" {
+			return tls.Certificate{}, errVaultSecretField.WithAttributes("field", field, "label", label)
+		}
+	}
+	return tls.X509KeyPair([]byte(fields["certificate"]), []byte(fields["private_key"]))
+}
+
+// ServerCertificate implements crypto.KeyVault.
+func (kv *vaultKeyVault) ServerCertificate(ctx context.Context, label string) (tls.Certificate, error) {
+	return kv.certificate(ctx, label)
+}
+
+// ClientCertificate implements crypto.KeyVault.
+func (kv *vaultKeyVault) ClientCertificate(ctx context.Context, label string) (tls.Certificate, error) {
+	return kv.certificate(ctx, label)
+}
@@ -0,0 +1,264 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errVaultRequest = errors.Define(
+		"vault_request", "Vault request to `{path}` failed with status code `{code}`", "path", "code",
+	)
+	errVaultNotFound = errors.DefineNotFound(
+		"vault_not_found", "Vault path `{path}` not found", "path",
+	)
+	errVaultPermissionDenied = errors.DefinePermissionDenied(
+		"vault_permission_denied", "permission denied for Vault path `{path}`", "path",
+	)
+	errVaultUnavailable = errors.DefineUnavailable(
+		"vault_unavailable", "Vault unavailable", "path",
+	)
+	errVaultAuthentication = errors.DefineUnauthenticated(
+		"vault_authentication", "authenticate with Vault",
+	)
+)
+
+// vaultSecretAuth is the authentication information returned by Vault on login and token renewal.
+type vaultSecretAuth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int64  `json:"lease_duration"`
+	Renewable     bool   `json:"renewable"`
+}
+
+// vaultSecret is the generic response of the Vault HTTP API.
+type vaultSecret struct {
+	Data          json.RawMessage  `json:"data"`
+	Auth          *vaultSecretAuth `json:"auth"`
+	LeaseDuration int64            `json:"lease_duration"`
+	Renewable     bool             `json:"renewable"`
+}
+
+// VaultAuthMethod authenticates with Vault.
+type VaultAuthMethod interface {
+	login(ctx context.Context, c *VaultClient) (*vaultSecretAuth, error)
+}
+
+type vaultTokenAuth struct {
+	token string
+}
+
+// login implements VaultAuthMethod.
+// The token is looked up to determine whether it expires and whether it can be renewed.
+func (a vaultTokenAuth) login(ctx context.Context, c *VaultClient) (*vaultSecretAuth, error) {
+	var res struct {
+		Data struct {
+			TTL       int64 `json:"ttl"`
+			Renewable bool  `json:"renewable"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "auth/token/lookup-self", a.token, nil, &res); err != nil {
+		return nil, errVaultAuthentication.WithCause(err)
+	}
+	return &vaultSecretAuth{
+		ClientToken:   a.token,
+		LeaseDuration: res.Data.TTL,
+		Renewable:     res.Data.Renewable,
+	}, nil
+}
+
+// VaultTokenAuth returns a VaultAuthMethod that uses the given token.
+// If the token is renewable, it is renewed before it expires.
+func VaultTokenAuth(token string) VaultAuthMethod {
+	return vaultTokenAuth{token: token}
+}
+
+type vaultAppRoleAuth struct {
+	mount, roleID, secretID string
+}
+
+// login implements VaultAuthMethod.
+func (a vaultAppRoleAuth) login(ctx context.Context, c *VaultClient) (*vaultSecretAuth, error) {
+	var res vaultSecret
+	if err := c.do(ctx, http.MethodPost, "auth/"+a.mount+"/login", "", map[string]string{
+		"role_id":   a.roleID,
+		"secret_id": a.secretID,
+	}, &res); err != nil {
+		return nil, errVaultAuthentication.WithCause(err)
+	}
+	if res.Auth == nil || res.Auth.ClientToken == "" {
+		return nil, errVaultAuthentication.New()
+	}
+	return res.Auth, nil
+}
+
+// VaultAppRoleAuth returns a VaultAuthMethod that logs in using the AppRole authentication method
+// mounted at the given path. A new login is performed when the token can no longer be renewed.
+func VaultAppRoleAuth(mount, roleID, secretID string) VaultAuthMethod {
+	return vaultAppRoleAuth{
+		mount:    mount,
+		roleID:   roleID,
+		secretID: secretID,
+	}
+}
+
+// VaultClient is a minimal client for the HashiCorp Vault HTTP API.
+// The client token is renewed when half of its lease duration has passed.
+type VaultClient struct {
+	httpClient *http.Client
+	address    string
+	namespace  string
+	auth       VaultAuthMethod
+	clock      CacheKeyVaultClock
+
+	tokenMu   sync.Mutex
+	token     string
+	renewable bool
+	renewAt   time.Time
+	expiresAt time.Time
+}
+
+// VaultClientOption configures VaultClient.
+type VaultClientOption func(*VaultClient)
+
+// WithVaultNamespace configures the Vault Enterprise namespace.
+func WithVaultNamespace(namespace string) VaultClientOption {
+	return func(c *VaultClient) {
+		c.namespace = namespace
+	}
+}
+
+// WithVaultClock configures a time source.
+// This is useful for testing.
+func WithVaultClock(clock CacheKeyVaultClock) VaultClientOption {
+	return func(c *VaultClient) {
+		c.clock = clock
+	}
+}
+
+// NewVaultClient returns a new VaultClient for the Vault server at the given address.
+func NewVaultClient(
+	httpClient *http.Client, address string, auth VaultAuthMethod, opts ...VaultClientOption,
+) *VaultClient {
+	c := &VaultClient{
+		httpClient: httpClient,
+		address:    strings.TrimSuffix(address, "/"),
+		auth:       auth,
+		clock:      CacheKeyVaultClockFunc(time.Now),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *VaultClient) setAuth(auth *vaultSecretAuth) {
+	now := c.clock.Now()
+	c.token = auth.ClientToken
+	c.renewable = auth.Renewable
+	if auth.LeaseDuration <= 0 {
+		c.renewAt, c.expiresAt = time.Time{}, time.Time{}
+		return
+	}
+	ttl := time.Duration(auth.LeaseDuration) * time.Second
+	c.renewAt, c.expiresAt = now.Add(ttl/2), now.Add(ttl)
+}
+
+// clientToken returns a valid client token, logging in or renewing the current token when needed.
+func (c *VaultClient) clientToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	now := c.clock.Now()
+	if c.token != "" && (c.renewAt.IsZero() || now.Before(c.renewAt)) {
+		return c.token, nil
+	}
+	if c.token != "" && c.renewable && now.Before(c.expiresAt) {
+		var res vaultSecret
+		err := c.do(ctx, http.MethodPost, "auth/token/renew-self", c.token, struct{}{}, &res)
+		if err == nil && res.Auth != nil && res.Auth.ClientToken != "" {
+			c.setAuth(res.Auth)
+			return c.token, nil
+		}
+	}
+	auth, err := c.auth.login(ctx, c)
+	if err != nil {
+		c.token = ""
+		return "", err
+	}
+	c.setAuth(auth)
+	return c.token, nil
+}
+
+func (c *VaultClient) do(ctx context.Context, method, path, token string, body, res any) error {
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address+"/v1/"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errVaultUnavailable.WithAttributes("path", path).WithCause(err)
+	}
+	defer resp.Body.Close()
+	switch code := resp.StatusCode; {
+	case code == http.StatusNotFound:
+		return errVaultNotFound.WithAttributes("path", path)
+	case code == http.StatusForbidden:
+		return errVaultPermissionDenied.WithAttributes("path", path)
+	case code >= 500:
+		return errVaultUnavailable.WithAttributes("path", path)
+	case code >= 300:
+		return errVaultRequest.WithAttributes("path", path, "code", code)
+	}
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// Do performs an authenticated request to the Vault HTTP API. The path is relative to `/v1/`.
+// The request body is encoded as JSON, and the response is decoded as JSON into res, if not nil.
+func (c *VaultClient) Do(ctx context.Context, method, path string, body, res any) error {
+	token, err := c.clientToken(ctx)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, method, path, token, body, res)
+}
@@ -0,0 +1,172 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// mockVault implements the subset of the Vault HTTP API used by the Vault key vault and key service.
+type mockVault struct {
+	mu       sync.Mutex
+	tokens   map[string]int // Token to lease duration in seconds.
+	logins   int
+	renewals int
+	secrets  map[string]map[string]string
+	requests []string
+}
+
+func newMockVault() *mockVault {
+	return &mockVault{
+		tokens:  make(map[string]int),
+		secrets: make(map[string]map[string]string),
+	}
+}
+
+func (v *mockVault) reply(w http.ResponseWriter, res any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res) //nolint:errcheck
+}
+
+func (v *mockVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	v.requests = append(v.requests, path)
+	var body map[string]string
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+	}
+
+	if path == "auth/approle/login" {
+		if body["role_id"] != "role" || body["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.logins++
+		token := fmt.Sprintf("token-%d", v.logins)
+		v.tokens[token] = 60
+		v.reply(w, map[string]any{
+			"auth": map[string]any{"client_token": token, "lease_duration": 60, "renewable": true},
+		})
+		return
+	}
+	token := r.Header.Get("X-Vault-Token")
+	ttl, ok := v.tokens[token]
+	if !ok {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch {
+	case path == "auth/token/lookup-self":
+		v.reply(w, map[string]any{"data": map[string]any{"ttl": ttl, "renewable": ttl > 0}})
+	case path == "auth/token/renew-self":
+		v.renewals++
+		v.reply(w, map[string]any{
+			"auth": map[string]any{"client_token": token, "lease_duration": ttl, "renewable": true},
+		})
+	case strings.HasPrefix(path, "secret/data/"):
+		secret, ok := v.secrets[strings.TrimPrefix(path, "secret/data/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		v.reply(w, map[string]any{"data": map[string]any{"data": secret}})
+	case strings.HasPrefix(path, "transit/encrypt/"):
+		name := strings.TrimPrefix(path, "transit/encrypt/")
+		v.reply(w, map[string]any{"data": map[string]any{"ciphertext": "vault:v1:" + name + ":" + body["plaintext"]}})
+	case strings.HasPrefix(path, "transit/decrypt/"):
+		prefix := "vault:v1:" + strings.TrimPrefix(path, "transit/decrypt/") + ":"
+		if !strings.HasPrefix(body["ciphertext"], prefix) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.reply(w, map[string]any{"data": map[string]any{"plaintext": strings.TrimPrefix(body["ciphertext"], prefix)}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVaultClientAppRole(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	vault := newMockVault()
+	srv := httptest.NewServer(vault)
+	t.Cleanup(srv.Close)
+
+	now := time.Unix(0, 0)
+	client := cryptoutil.NewVaultClient(srv.Client(), srv.URL,
+		cryptoutil.VaultAppRoleAuth("approle", "role", "secret"),
+		cryptoutil.WithVaultClock(cryptoutil.CacheKeyVaultClockFunc(func() time.Time { return now })),
+	)
+	var res struct {
+		Data map[string]any `json:"data"`
+	}
+	if !a.So(client.Do(ctx, http.MethodGet, "auth/token/lookup-self", nil, &res), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(vault.logins, should.Equal, 1)
+	a.So(vault.renewals, should.Equal, 0)
+
+	// The token is renewed after half of the lease duration.
+	now = now.Add(40 * time.Second)
+	a.So(client.Do(ctx, http.MethodGet, "auth/token/lookup-self", nil, &res), should.BeNil)
+	a.So(vault.logins, should.Equal, 1)
+	a.So(vault.renewals, should.Equal, 1)
+
+	// When the token expired, the client logs in again.
+	now = now.Add(2 * time.Minute)
+	a.So(client.Do(ctx, http.MethodGet, "auth/token/lookup-self", nil, &res), should.BeNil)
+	a.So(vault.logins, should.Equal, 2)
+}
+
+func TestVaultClientToken(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	vault := newMockVault()
+	vault.tokens["root"] = 0
+	srv := httptest.NewServer(vault)
+	t.Cleanup(srv.Close)
+
+	client := cryptoutil.NewVaultClient(srv.Client(), srv.URL, cryptoutil.VaultTokenAuth("root"))
+	a.So(client.Do(ctx, http.MethodGet, "auth/token/lookup-self", nil, nil), should.BeNil)
+	a.So(errors.IsNotFound(client.Do(ctx, http.MethodGet, "unknown", nil, nil)), should.BeTrue)
+
+	client = cryptoutil.NewVaultClient(srv.Client(), srv.URL, cryptoutil.VaultTokenAuth("invalid"))
+	err := client.Do(ctx, http.MethodGet, "auth/token/lookup-self", nil, nil)
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+}
+
+func assertVaultRequests(a *assertions.Assertion, vault *mockVault, expected ...string) {
+	vault.mu.Lock()
+	defer vault.mu.Unlock()
+	a.So(vault.requests, should.Resemble, expected)
+	vault.requests = nil
+}
@@ -0,0 +1,348 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backendinterfaces provides the claiming client implementation for Join Servers that implement
+// claiming using LoRaWAN Backend Interfaces messages.
+//
+// Requests are sent as JSON encoded LoRaWAN Backend Interfaces messages over HTTP POST, with the Network Server
+// as sender and the JoinEUI as receiver. The Join Server answers with the corresponding answer message type and
+// a Result that indicates success or failure.
+package backendinterfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	claimerrors "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Paths are the paths relative to the URL of the Join Server for each message type.
+// If a path is empty, the message is sent to the URL.
+type Paths struct {
+	Claim       string `yaml:"claim"`
+	ClaimStatus string `yaml:"claim-status"`
+	Unclaim     string `yaml:"unclaim"`
+}
+
+// ConfigFile defines the configuration file for the LoRaWAN Backend Interfaces claiming client.
+type ConfigFile struct {
+	URL      string                  `yaml:"url"`
+	Paths    Paths                   `yaml:"paths"`
+	Protocol interop.ProtocolVersion `yaml:"protocol"`
+	Headers  map[string]string       `yaml:"headers"`
+	TLS      ttjsv2.TLSConfig        `yaml:"tls"`
+}
+
+// Config is the configuration for the LoRaWAN Backend Interfaces claiming client.
+type Config struct {
+	NetID           types.NetID
+	NSID            *types.EUI64
+	ASID            string
+	JoinEUIPrefixes []types.EUI64Prefix
+	ConfigFile
+}
+
+// Component abstracts the component.
+type Component interface {
+	httpclient.Provider
+	KeyService() crypto.KeyService
+}
+
+// Client is a client that claims end devices on a Join Server using LoRaWAN Backend Interfaces messages.
+type Client struct {
+	Component
+
+	fetcher fetch.Interface
+	config  Config
+}
+
+// NewClient applies the config and returns a new Client.
+func NewClient(c Component, fetcher fetch.Interface, conf Config) *Client {
+	if conf.Protocol == "" {
+		conf.Protocol = interop.ProtocolV1_1
+	}
+	return &Client{
+		Component: c,
+		fetcher:   fetcher,
+		config:    conf,
+	}
+}
+
+// SupportsJoinEUI implements EndDeviceClaimer.
+func (c *Client) SupportsJoinEUI(eui types.EUI64) bool {
+	for _, prefix := range c.config.JoinEUIPrefixes {
+		if eui.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	errBadRequest           = errors.DefineInvalidArgument("bad_request", "bad request", "message")
+	errDeviceNotProvisioned = errors.DefineNotFound("device_not_provisioned", "device with EUI `{dev_eui}` not provisioned") //nolint:lll
+	errDeviceNotClaimed     = errors.DefineNotFound("device_not_claimed", "device with EUI `{dev_eui}` not claimed")
+	errDeviceAccessDenied   = errors.DefineInvalidArgument("device_access_denied", "access to device with `{dev_eui}` denied: device is already claimed or the owner token is invalid") //nolint:lll
+	errCredentials          = errors.DefineInternal("credentials", "invalid credentials")
+	errMissingNSID          = errors.DefineFailedPrecondition("missing_ns_id", "missing NSID")
+	errUnexpectedResult     = errors.Define("unexpected_result", "unexpected result code `{result_code}`", "result_description") //nolint:lll
+	errUnexpectedAnswer     = errors.DefineInternal("unexpected_answer", "unexpected answer message type `{message_type}`")      //nolint:lll
+	errUnclaimDevice        = errors.Define("unclaim_device", "unclaim device with EUI `{dev_eui}`")
+)
+
+func (c *Client) httpClient(ctx context.Context) (*http.Client, error) {
+	var opts []httpclient.Option
+	if !c.config.TLS.IsZero() {
+		tlsConf, err := c.config.TLS.TLSConfig(c.fetcher, c.KeyService())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, httpclient.WithTLSConfig(tlsConf))
+	}
+	return c.HTTPClient(ctx, opts...)
+}
+
+func (c *Client) header(messageType interop.MessageType, joinEUI types.EUI64) (interop.NsJsMessageHeader, error) {
+	header := interop.NsJsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: c.config.Protocol,
+			MessageType:     messageType,
+		},
+		SenderID:   interop.NetID(c.config.NetID),
+		ReceiverID: interop.EUI64(joinEUI),
+	}
+	if c.config.Protocol.RequiresNSID() {
+		if c.config.NSID == nil {
+			return interop.NsJsMessageHeader{}, errMissingNSID.New()
+		}
+		header.SenderNSID = (*interop.EUI64)(c.config.NSID)
+	}
+	return header, nil
+}
+
+func (c *Client) url(path string) string {
+	if path == "" {
+		return c.config.URL
+	}
+	return strings.TrimSuffix(c.config.URL, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// exchange sends the request message and decodes the answer message.
+// LoRaWAN Backend Interfaces answers are sent with HTTP status code 200, including errors encoded in the Result.
+func (c *Client) exchange(
+	ctx context.Context, path string, req any, expected interop.MessageType, ans any,
+) error {
+	reqURL := c.url(path)
+	logger := log.FromContext(ctx).WithField("url", reqURL)
+
+	buf, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for k, v := range c.config.Headers {
+		request.Header.Set(k, v)
+	}
+
+	client, err := c.httpClient(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return errCredentials.New()
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		logger.WithField("http_code", resp.StatusCode).Warn("Response status code does not indicate success")
+		return errors.FromHTTPStatusCode(resp.StatusCode)
+	}
+
+	var header interop.MessageHeader
+	if err := json.Unmarshal(respBody, &header); err != nil {
+		logger.WithError(err).Warn("Failed to decode answer")
+		return err
+	}
+	if header.MessageType != expected {
+		return errUnexpectedAnswer.WithAttributes("message_type", header.MessageType)
+	}
+	return json.Unmarshal(respBody, ans)
+}
+
+func resultError(r interop.Result, devEUI types.EUI64) error {
+	switch r.ResultCode {
+	case interop.ResultSuccess:
+		return nil
+	case interop.ResultUnknownDevEUI:
+		return errDeviceNotProvisioned.WithAttributes("dev_eui", devEUI)
+	case ResultDeviceNotClaimed:
+		return errDeviceNotClaimed.WithAttributes("dev_eui", devEUI)
+	case ResultInvalidOwnerToken, ResultClaimDisallowed:
+		return errDeviceAccessDenied.WithAttributes("dev_eui", devEUI)
+	case interop.ResultUnknownSender, interop.ResultUnknownReceiver, interop.ResultUnkownReceiver:
+		return errCredentials.New()
+	case interop.ResultMalformedRequest, interop.ResultMalformedMessage, interop.ResultInvalidProtocolVersion:
+		return errBadRequest.WithAttributes("message", r.Description)
+	default:
+		return errUnexpectedResult.WithAttributes(
+			"result_code", r.ResultCode,
+			"result_description", r.Description,
+		)
+	}
+}
+
+// Claim implements EndDeviceClaimer.
+func (c *Client) Claim(ctx context.Context, joinEUI, devEUI types.EUI64, claimAuthenticationCode string) error {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", devEUI,
+		"join_eui", joinEUI,
+	))
+	header, err := c.header(MessageTypeClaimReq, joinEUI)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Debug("Claim end device")
+	var ans ClaimAns
+	if err := c.exchange(ctx, c.config.Paths.Claim, &ClaimReq{
+		NsJsMessageHeader: header,
+		DevEUI:            interop.EUI64(devEUI),
+		OwnerToken:        claimAuthenticationCode,
+		ASID:              c.config.ASID,
+		Lock:              true,
+	}, MessageTypeClaimAns, &ans); err != nil {
+		return err
+	}
+	return resultError(ans.Result, devEUI)
+}
+
+// GetClaimStatus implements EndDeviceClaimer.
+func (c *Client) GetClaimStatus(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.GetClaimStatusResponse, error) {
+	devEUI := types.MustEUI64(ids.DevEui).OrZero()
+	joinEUI := types.MustEUI64(ids.JoinEui).OrZero()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", devEUI,
+		"join_eui", joinEUI,
+	))
+	header, err := c.header(MessageTypeClaimStatusReq, joinEUI)
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).Debug("Get claim status for end device")
+	var ans ClaimStatusAns
+	if err := c.exchange(ctx, c.config.Paths.ClaimStatus, &ClaimStatusReq{
+		NsJsMessageHeader: header,
+		DevEUI:            interop.EUI64(devEUI),
+	}, MessageTypeClaimStatusAns, &ans); err != nil {
+		return nil, err
+	}
+	if err := resultError(ans.Result, devEUI); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.GetClaimStatusResponse{
+		EndDeviceIds: ids,
+	}
+	if ans.HomeNetID != nil {
+		res.HomeNetId = types.NetID(*ans.HomeNetID).Bytes()
+	}
+	if ans.HomeNSID != nil {
+		res.HomeNsId = types.EUI64(*ans.HomeNSID).Bytes()
+	}
+	return res, nil
+}
+
+// Unclaim implements EndDeviceClaimer.
+func (c *Client) Unclaim(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	devEUI := types.MustEUI64(ids.DevEui).OrZero()
+	joinEUI := types.MustEUI64(ids.JoinEui).OrZero()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", devEUI,
+		"join_eui", joinEUI,
+	))
+	header, err := c.header(MessageTypeUnclaimReq, joinEUI)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Debug("Unclaim end device")
+	var ans UnclaimAns
+	if err := c.exchange(ctx, c.config.Paths.Unclaim, &UnclaimReq{
+		NsJsMessageHeader: header,
+		DevEUI:            interop.EUI64(devEUI),
+	}, MessageTypeUnclaimAns, &ans); err != nil {
+		return err
+	}
+	return resultError(ans.Result, devEUI)
+}
+
+// BatchUnclaim implements EndDeviceClaimer.
+// LoRaWAN Backend Interfaces messages address a single end device, so the end devices are unclaimed one by one.
+// Only errors that are specific to an end device are returned per end device. Other errors, such as invalid
+// credentials, transport errors and unavailable Join Servers, abort the batch.
+func (c *Client) BatchUnclaim(
+	ctx context.Context,
+	ids []*ttnpb.EndDeviceIdentifiers,
+) error {
+	if len(ids) == 0 {
+		return errBadRequest.WithAttributes("message", "no devices in request")
+	}
+	ret := claimerrors.DeviceErrors{
+		Errors: make(map[types.EUI64]errors.ErrorDetails),
+	}
+	for _, ids := range ids {
+		err := c.Unclaim(ctx, ids)
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) && !errors.IsInvalidArgument(err) {
+			return err
+		}
+		devEUI := types.MustEUI64(ids.DevEui).OrZero()
+		details, ok := errors.From(err)
+		if !ok {
+			details = errUnclaimDevice.WithAttributes("dev_eui", devEUI).WithCause(err)
+		}
+		ret.Errors[devEUI] = details
+	}
+	if len(ret.Errors) > 0 {
+		return ret
+	}
+	return nil
+}
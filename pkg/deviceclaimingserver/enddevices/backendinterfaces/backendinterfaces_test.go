@@ -0,0 +1,193 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendinterfaces_test
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/backendinterfaces"
+	claimerrors "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2/testdata"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	client1ASID             = "client1.local"
+	client2ASID             = "client2.local"
+	claimAuthenticationCode = "SECRET"
+	homeNSID                = types.EUI64{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88}
+	supportedJoinEUI        = types.EUI64{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0C}
+	supportedJoinEUIPrefix  = types.EUI64Prefix{
+		EUI64:  supportedJoinEUI,
+		Length: 64,
+	}
+	unsupportedJoinEUI = types.EUI64{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0D}
+	unsupportedDevEUI  = types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0xFF}
+	devEUI1            = types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x31}
+	devEUI2            = types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x32}
+	devEUI3            = types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x33}
+)
+
+func endDeviceIdentifiers(devEUI types.EUI64) *ttnpb.EndDeviceIdentifiers {
+	return &ttnpb.EndDeviceIdentifiers{
+		DevEui:  devEUI.Bytes(),
+		JoinEui: supportedJoinEUI.Bytes(),
+	}
+}
+
+func TestBackendInterfaces(t *testing.T) { //nolint:paralleltest
+	a, ctx := test.New(t)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		lis.Close()
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+
+	js := &mockJS{
+		lis: lis,
+		clients: map[string]*x509.Certificate{
+			client1ASID: testdata.Client1Cert,
+			client2ASID: testdata.Client2Cert,
+		},
+		provisionedDevices: map[types.EUI64]device{
+			devEUI1: {claimAuthenticationCode: claimAuthenticationCode},
+			devEUI2: {claimAuthenticationCode: claimAuthenticationCode},
+			devEUI3: {claimAuthenticationCode: claimAuthenticationCode},
+		},
+	}
+	go js.Start(ctx) //nolint:errcheck
+
+	fetcher := fetch.FromFilesystem("../ttjsv2/testdata")
+	newClient := func(addr net.Addr, asID, certificate, key string, nsID *types.EUI64) *backendinterfaces.Client {
+		return backendinterfaces.NewClient(c, fetcher, backendinterfaces.Config{
+			NetID: test.DefaultNetID,
+			NSID:  nsID,
+			ASID:  asID,
+			JoinEUIPrefixes: []types.EUI64Prefix{
+				supportedJoinEUIPrefix,
+			},
+			ConfigFile: backendinterfaces.ConfigFile{
+				URL: fmt.Sprintf("https://%s/claiming", addr.String()),
+				Paths: backendinterfaces.Paths{
+					Claim:       "claim",
+					ClaimStatus: "claim-status",
+					Unclaim:     "unclaim",
+				},
+				Protocol: interop.ProtocolV1_1,
+				TLS: ttjsv2.TLSConfig{
+					RootCA:      "rootCA.pem",
+					Source:      "file",
+					Certificate: certificate,
+					Key:         key,
+				},
+			},
+		})
+	}
+	client1 := newClient(lis.Addr(), client1ASID, "clientcert-1.pem", "clientkey-1.pem", &homeNSID)
+	client2 := newClient(lis.Addr(), client2ASID, "clientcert-2.pem", "clientkey-2.pem", &homeNSID)
+
+	// Check JoinEUI support.
+	a.So(client1.SupportsJoinEUI(unsupportedJoinEUI), should.BeFalse)
+	a.So(client1.SupportsJoinEUI(supportedJoinEUI), should.BeTrue)
+
+	// Backend Interfaces 1.1 requires the NSID.
+	err = newClient(lis.Addr(), client1ASID, "clientcert-1.pem", "clientkey-1.pem", nil).
+		Claim(ctx, supportedJoinEUI, devEUI1, claimAuthenticationCode)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	// Claim.
+	err = client1.Claim(ctx, supportedJoinEUI, unsupportedDevEUI, claimAuthenticationCode)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	err = client1.Claim(ctx, supportedJoinEUI, devEUI1, "INVALID")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	for _, devEUI := range []types.EUI64{devEUI1, devEUI2, devEUI3} {
+		err = client1.Claim(ctx, supportedJoinEUI, devEUI, claimAuthenticationCode)
+		a.So(err, should.BeNil)
+	}
+	err = client2.Claim(ctx, supportedJoinEUI, devEUI1, claimAuthenticationCode)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Get claim status.
+	_, err = client2.GetClaimStatus(ctx, endDeviceIdentifiers(devEUI1))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	resp, err := client1.GetClaimStatus(ctx, endDeviceIdentifiers(devEUI1))
+	if a.So(err, should.BeNil) {
+		a.So(resp.EndDeviceIds, should.Resemble, endDeviceIdentifiers(devEUI1))
+		a.So(resp.HomeNetId, should.Resemble, test.DefaultNetID.Bytes())
+		a.So(resp.HomeNsId, should.Resemble, homeNSID.Bytes())
+	}
+
+	// Unclaim.
+	err = client2.Unclaim(ctx, endDeviceIdentifiers(devEUI1))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	err = client1.Unclaim(ctx, endDeviceIdentifiers(devEUI1))
+	a.So(err, should.BeNil)
+	err = client1.Unclaim(ctx, endDeviceIdentifiers(devEUI1))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = client1.GetClaimStatus(ctx, endDeviceIdentifiers(devEUI1))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Batch unclaim.
+	err = client1.BatchUnclaim(ctx, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	err = client1.BatchUnclaim(ctx, []*ttnpb.EndDeviceIdentifiers{
+		endDeviceIdentifiers(devEUI1),
+		endDeviceIdentifiers(devEUI2),
+		endDeviceIdentifiers(devEUI3),
+	})
+	var deviceErrs claimerrors.DeviceErrors
+	if a.So(errors.As(err, &deviceErrs), should.BeTrue) {
+		a.So(deviceErrs.Errors, should.HaveLength, 1)
+		a.So(errors.IsNotFound(deviceErrs.Errors[devEUI1]), should.BeTrue)
+	}
+	for _, devEUI := range []types.EUI64{devEUI2, devEUI3} {
+		_, err = client1.GetClaimStatus(ctx, endDeviceIdentifiers(devEUI))
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Transport errors abort the batch.
+	closedLis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	closedLis.Close()
+	err = newClient(closedLis.Addr(), client1ASID, "clientcert-1.pem", "clientkey-1.pem", &homeNSID).
+		BatchUnclaim(ctx, []*ttnpb.EndDeviceIdentifiers{
+			endDeviceIdentifiers(devEUI1),
+			endDeviceIdentifiers(devEUI2),
+		})
+	a.So(err, should.NotBeNil)
+	a.So(errors.As(err, &deviceErrs), should.BeFalse)
+}
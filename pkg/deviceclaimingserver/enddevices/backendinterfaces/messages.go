@@ -0,0 +1,79 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendinterfaces
+
+import "go.thethings.network/lorawan-stack/v3/pkg/interop"
+
+// Claiming message types. These are non-standard extensions; claiming is not part of the LoRaWAN Backend Interfaces
+// specification.
+const (
+	MessageTypeClaimReq       interop.MessageType = "ClaimReq"
+	MessageTypeClaimAns       interop.MessageType = "ClaimAns"
+	MessageTypeClaimStatusReq interop.MessageType = "ClaimStatusReq"
+	MessageTypeClaimStatusAns interop.MessageType = "ClaimStatusAns"
+	MessageTypeUnclaimReq     interop.MessageType = "UnclaimReq"
+	MessageTypeUnclaimAns     interop.MessageType = "UnclaimAns"
+)
+
+// Claiming result codes, in addition to the LoRaWAN Backend Interfaces result codes.
+const (
+	ResultDeviceNotClaimed  interop.ResultCode = "DeviceNotClaimed"
+	ResultInvalidOwnerToken interop.ResultCode = "InvalidOwnerToken"
+	ResultClaimDisallowed   interop.ResultCode = "ClaimDisallowed"
+)
+
+// ClaimReq is a request to claim an end device.
+// This message is non-standard and not part of the LoRaWAN Backend Interfaces specification.
+type ClaimReq struct {
+	interop.NsJsMessageHeader
+	DevEUI     interop.EUI64
+	OwnerToken string
+	ASID       string `json:",omitempty"`
+	Lock       bool
+}
+
+// ClaimAns is the answer to a ClaimReq.
+// This message is non-standard and not part of the LoRaWAN Backend Interfaces specification.
+type ClaimAns struct {
+	interop.JsNsMessageHeader
+	Result interop.Result
+}
+
+// ClaimStatusReq is a request for the claim status of an end device.
+type ClaimStatusReq struct {
+	interop.NsJsMessageHeader
+	DevEUI interop.EUI64
+}
+
+// ClaimStatusAns is the answer to a ClaimStatusReq.
+type ClaimStatusAns struct {
+	interop.JsNsMessageHeader
+	Result    interop.Result
+	HomeNetID *interop.NetID `json:",omitempty"`
+	HomeNSID  *interop.EUI64 `json:",omitempty"`
+	Locked    bool
+}
+
+// UnclaimReq is a request to release the claim on an end device.
+type UnclaimReq struct {
+	interop.NsJsMessageHeader
+	DevEUI interop.EUI64
+}
+
+// UnclaimAns is the answer to an UnclaimReq.
+type UnclaimAns struct {
+	interop.JsNsMessageHeader
+	Result interop.Result
+}
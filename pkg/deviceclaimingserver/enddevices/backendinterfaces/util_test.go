@@ -0,0 +1,202 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendinterfaces_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/backendinterfaces"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type device struct {
+	homeNetID               *types.NetID
+	homeNSID                *types.EUI64
+	asID                    string
+	locked                  bool
+	claimAuthenticationCode string
+}
+
+// mockJS is a Join Server that implements claiming with LoRaWAN Backend Interfaces messages.
+type mockJS struct {
+	mu                 sync.Mutex
+	provisionedDevices map[types.EUI64]device
+	lis                net.Listener
+	clients            map[string]*x509.Certificate // the key is the AS-ID
+}
+
+func (srv *mockJS) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/claiming/claim", srv.handleClaim)
+	mux.HandleFunc("/claiming/claim-status", srv.handleClaimStatus)
+	mux.HandleFunc("/claiming/unclaim", srv.handleUnclaim)
+	s := http.Server{
+		Handler:           mux,
+		ReadTimeout:       60 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ClientAuth: tls.RequireAnyClientCert,
+		},
+	}
+	go func() {
+		<-ctx.Done()
+		s.Close()
+	}()
+	return s.ServeTLS(srv.lis, "../ttjsv2/testdata/servercert.pem", "../ttjsv2/testdata/serverkey.pem")
+}
+
+// authenticate returns the AS-ID of the client. If the client is unknown, authenticate writes the response.
+func (srv *mockJS) authenticate(w http.ResponseWriter, r *http.Request) (string, bool) {
+	for asID, cert := range srv.clients {
+		if len(r.TLS.PeerCertificates) > 0 && r.TLS.PeerCertificates[0].Equal(cert) {
+			return asID, true
+		}
+	}
+	w.WriteHeader(http.StatusUnauthorized)
+	return "", false
+}
+
+func answerHeader(req interop.NsJsMessageHeader, messageType interop.MessageType) interop.JsNsMessageHeader {
+	return interop.JsNsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: req.ProtocolVersion,
+			TransactionID:   req.TransactionID,
+			MessageType:     messageType,
+		},
+		SenderID:     req.ReceiverID,
+		ReceiverID:   req.SenderID,
+		ReceiverNSID: req.SenderNSID,
+	}
+}
+
+func result(code interop.ResultCode) interop.Result {
+	return interop.Result{ResultCode: code}
+}
+
+func writeAnswer(w http.ResponseWriter, ans any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ans) //nolint:errcheck
+}
+
+func (srv *mockJS) handleClaim(w http.ResponseWriter, r *http.Request) {
+	asID, ok := srv.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var req backendinterfaces.ClaimReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	ans := backendinterfaces.ClaimAns{
+		JsNsMessageHeader: answerHeader(req.NsJsMessageHeader, backendinterfaces.MessageTypeClaimAns),
+		Result:            result(interop.ResultSuccess),
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	dev, ok := srv.provisionedDevices[types.EUI64(req.DevEUI)]
+	switch {
+	case req.MessageType != backendinterfaces.MessageTypeClaimReq:
+		ans.Result = result(interop.ResultMalformedRequest)
+	case !ok:
+		ans.Result = result(interop.ResultUnknownDevEUI)
+	case dev.asID != "" && dev.asID != asID && dev.locked:
+		ans.Result = result(backendinterfaces.ResultClaimDisallowed)
+	case dev.claimAuthenticationCode != req.OwnerToken:
+		ans.Result = result(backendinterfaces.ResultInvalidOwnerToken)
+	default:
+		homeNetID := types.NetID(req.SenderID)
+		dev.homeNetID = &homeNetID
+		dev.homeNSID = (*types.EUI64)(req.SenderNSID)
+		dev.asID = asID
+		dev.locked = req.Lock
+		srv.provisionedDevices[types.EUI64(req.DevEUI)] = dev
+	}
+	writeAnswer(w, ans)
+}
+
+func (srv *mockJS) handleClaimStatus(w http.ResponseWriter, r *http.Request) {
+	asID, ok := srv.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var req backendinterfaces.ClaimStatusReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	ans := backendinterfaces.ClaimStatusAns{
+		JsNsMessageHeader: answerHeader(req.NsJsMessageHeader, backendinterfaces.MessageTypeClaimStatusAns),
+		Result:            result(interop.ResultSuccess),
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	dev, ok := srv.provisionedDevices[types.EUI64(req.DevEUI)]
+	switch {
+	case !ok:
+		ans.Result = result(interop.ResultUnknownDevEUI)
+	case dev.asID == "":
+		ans.Result = result(backendinterfaces.ResultDeviceNotClaimed)
+	case dev.asID != asID:
+		ans.Result = result(backendinterfaces.ResultClaimDisallowed)
+	default:
+		ans.HomeNetID = (*interop.NetID)(dev.homeNetID)
+		ans.HomeNSID = (*interop.EUI64)(dev.homeNSID)
+		ans.Locked = dev.locked
+	}
+	writeAnswer(w, ans)
+}
+
+func (srv *mockJS) handleUnclaim(w http.ResponseWriter, r *http.Request) {
+	asID, ok := srv.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var req backendinterfaces.UnclaimReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	ans := backendinterfaces.UnclaimAns{
+		JsNsMessageHeader: answerHeader(req.NsJsMessageHeader, backendinterfaces.MessageTypeUnclaimAns),
+		Result:            result(interop.ResultSuccess),
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	dev, ok := srv.provisionedDevices[types.EUI64(req.DevEUI)]
+	switch {
+	case !ok:
+		ans.Result = result(interop.ResultUnknownDevEUI)
+	case dev.asID == "":
+		ans.Result = result(backendinterfaces.ResultDeviceNotClaimed)
+	case dev.asID != asID:
+		ans.Result = result(backendinterfaces.ResultClaimDisallowed)
+	default:
+		srv.provisionedDevices[types.EUI64(req.DevEUI)] = device{
+			claimAuthenticationCode: dev.claimAuthenticationCode,
+		}
+	}
+	writeAnswer(w, ans)
+}
@@ -23,6 +23,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/backendinterfaces"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
@@ -63,7 +64,8 @@ type Component interface {
 }
 
 const (
-	ttjsV2Type = "ttjsv2"
+	ttjsV2Type            = "ttjsv2"
+	backendInterfacesType = "backendinterfaces"
 )
 
 // Upstream abstracts EndDeviceClaimingServer.
@@ -127,6 +129,18 @@ func NewUpstream(ctx context.Context, c Component, conf Config, opts ...Option)
 				JoinEUIPrefixes: js.JoinEUIs,
 				ConfigFile:      ttjsConf,
 			})
+		case backendInterfacesType:
+			var biConf backendinterfaces.ConfigFile
+			if err := yaml.UnmarshalStrict(configBytes, &biConf); err != nil {
+				return nil, err
+			}
+			claimer = backendinterfaces.NewClient(c, fetcher, backendinterfaces.Config{
+				NetID:           conf.NetID,
+				NSID:            nsID,
+				ASID:            conf.ASID,
+				JoinEUIPrefixes: js.JoinEUIs,
+				ConfigFile:      biConf,
+			})
 		default:
 			log.FromContext(ctx).WithField("type", js.Type).Warn("Unknown Join Server type")
 			continue
@@ -19,6 +19,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/backendinterfaces"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -55,4 +56,8 @@ func TestUpstream(t *testing.T) {
 	supportedJoinEUI := types.EUI64{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0C}
 	claimer = upstream.JoinEUIClaimer(ctx, supportedJoinEUI)
 	a.So(claimer, should.NotBeNil)
+
+	backendInterfacesJoinEUI := types.EUI64{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0E}
+	claimer = upstream.JoinEUIClaimer(ctx, backendInterfacesJoinEUI)
+	a.So(claimer, should.HaveSameTypeAs, &backendinterfaces.Client{})
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/bleve"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -27,15 +28,17 @@ import (
 type Config struct {
 	Store StoreConfig `name:"store"`
 
-	Source    string `name:"source" description:"Device Repository Source (directory)"`
-	Directory string `name:"directory" description:"OS filesystem directory, which contains the Device Repository"`
+	Source    string   `name:"source" description:"Device Repository Source (directory)"`
+	Directory string   `name:"directory" description:"OS filesystem directory, which contains the Device Repository"`
+	Overlays  []string `name:"overlays" description:"OS filesystem directories with end device definitions to merge on top of the Device Repository, in increasing order of precedence"` //nolint:lll
 
 	AssetsBaseURL string `name:"assets-base-url" description:"The base URL for Device Repository assets"`
 }
 
 // StoreConfig represents configuration for the Device Repository store.
 type StoreConfig struct {
-	Store store.Store `name:"-"`
+	Store        store.Store           `name:"-"`
+	Restrictions *overlay.Restrictions `name:"-"`
 
 	Bleve bleve.Config `name:"bleve"`
 }
@@ -59,5 +62,35 @@ func (c Config) Initialize(ctx context.Context, blobConf config.BlobConfig, over
 		return errUnknownSource.WithAttributes("source", c.Source)
 	}
 
-	return c.Store.Bleve.Initialize(ctx, c.Directory, overwrite)
+	overlays, err := overlay.LoadAll(c.Overlays...)
+	if err != nil {
+		return err
+	}
+	return c.Store.Bleve.Initialize(ctx, c.Directory, overwrite, overlays...)
+}
+
+// NewRestrictions returns the visibility restrictions of the end device definitions.
+func (c Config) NewRestrictions() (*overlay.Restrictions, error) {
+	if c.Store.Restrictions != nil {
+		return c.Store.Restrictions, nil
+	}
+	return c.Store.Bleve.Restrictions()
+}
+
+// ValidateOverlays validates the overlays on top of the Device Repository. It returns the issues by overlay name.
+func (c Config) ValidateOverlays(directories ...string) ([]*overlay.Overlay, map[string][]error, error) {
+	switch c.Source {
+	case "directory":
+	default:
+		return nil, nil, errUnknownSource.WithAttributes("source", c.Source)
+	}
+	overlays, err := overlay.LoadAll(directories...)
+	if err != nil {
+		return nil, nil, err
+	}
+	issues, err := overlay.Validate(c.Directory, overlays...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return overlays, issues, nil
 }
@@ -17,10 +17,13 @@ package devicerepository
 
 import (
 	"context"
+	"time"
 
+	"github.com/bluele/gcache"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
@@ -39,17 +42,32 @@ type DeviceRepository struct {
 
 	config *Config
 
-	store store.Store
+	store        store.Store
+	restrictions *overlay.Restrictions
+	hidden       gcache.Cache
 }
 
+const (
+	// hiddenOverlaysCacheSize is the maximum number of callers of which the hidden overlays are cached.
+	hiddenOverlaysCacheSize = 4096
+	// hiddenOverlaysTTL is the time for which the hidden overlays of a caller are cached.
+	hiddenOverlaysTTL = time.Minute
+)
+
 // New returns a new *DeviceRepository.
 func New(c *component.Component, conf *Config) (*DeviceRepository, error) {
+	restrictions, err := conf.NewRestrictions()
+	if err != nil {
+		return nil, err
+	}
 	dr := &DeviceRepository{
 		Component: c,
 		ctx:       log.NewContextWithField(c.Context(), "namespace", "devicerepository"),
 		config:    conf,
 
-		store: conf.Store.Store,
+		store:        conf.Store.Store,
+		restrictions: restrictions,
+		hidden:       gcache.New(hiddenOverlaysCacheSize).LRU().Expiration(hiddenOverlaysTTL).Build(),
 	}
 
 	c.RegisterGRPC(dr)
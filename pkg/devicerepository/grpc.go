@@ -22,8 +22,10 @@ import (
 
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -58,6 +60,103 @@ func (dr *DeviceRepository) ensureBaseAssetURLs(models []*ttnpb.EndDeviceModel)
 	}
 }
 
+// hiddenOverlaysKey identifies the caller in the cache of hidden overlays.
+type hiddenOverlaysKey struct {
+	AuthType  string
+	AuthValue string
+}
+
+// hiddenOverlays returns the names of the restricted overlays that are not visible to the caller.
+// The end device definitions of a restricted overlay are visible to administrators, to the listed users and
+// to the members of the listed organizations.
+// The hidden overlays are cached by the credentials of the caller for hiddenOverlaysTTL, so that the rights
+// of the caller are not looked up for every restricted overlay on every request.
+func (dr *DeviceRepository) hiddenOverlays(ctx context.Context) ([]string, error) {
+	if len(dr.restrictions.GetOverlays()) == 0 {
+		return nil, nil
+	}
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if md.AuthValue == "" {
+		return dr.lookupHiddenOverlays(ctx)
+	}
+	key := hiddenOverlaysKey{AuthType: md.AuthType, AuthValue: md.AuthValue}
+	if v, err := dr.hidden.Get(key); err == nil {
+		return v.([]string), nil
+	}
+	hidden, err := dr.lookupHiddenOverlays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := dr.hidden.Set(key, hidden); err != nil {
+		return nil, err
+	}
+	return hidden, nil
+}
+
+func (dr *DeviceRepository) lookupHiddenOverlays(ctx context.Context) ([]string, error) {
+	authInfo, err := rights.AuthInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if authInfo.GetIsAdmin() {
+		return nil, nil
+	}
+	var hidden []string
+	for _, o := range dr.restrictions.GetOverlays() {
+		visible, err := isVisible(ctx, o.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			hidden = append(hidden, o.Name)
+		}
+	}
+	return hidden, nil
+}
+
+func isVisible(ctx context.Context, visibility overlay.Visibility) (bool, error) {
+	for _, userID := range visibility.Users {
+		userRights, err := rights.ListUser(ctx, &ttnpb.UserIdentifiers{UserId: userID})
+		if err != nil {
+			return false, err
+		}
+		if len(userRights.GetRights()) > 0 {
+			return true, nil
+		}
+	}
+	for _, organizationID := range visibility.Organizations {
+		organizationRights, err := rights.ListOrganization(ctx, &ttnpb.OrganizationIdentifiers{
+			OrganizationId: organizationID,
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(organizationRights.GetRights()) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// requireModelVisible returns an error if the model is defined by a restricted overlay that is not visible
+// to the caller.
+func (dr *DeviceRepository) requireModelVisible(ctx context.Context, brandID, modelID string) error {
+	name := dr.restrictions.Model(brandID, modelID)
+	if name == "" {
+		return nil
+	}
+	hidden, err := dr.hiddenOverlays(ctx)
+	if err != nil {
+		return err
+	}
+	for _, h := range hidden {
+		if h == name {
+			return errModelNotFound.WithAttributes("brand_id", brandID, "model_id", modelID)
+		}
+	}
+	return nil
+}
+
 const defaultLimit = 1000
 
 // ListBrands implements the ttnpb.DeviceRepositoryServer interface.
@@ -71,12 +170,17 @@ func (dr *DeviceRepository) ListBrands(
 	if req.Limit > defaultLimit || req.Limit == 0 {
 		req.Limit = defaultLimit
 	}
+	hidden, err := dr.hiddenOverlays(ctx)
+	if err != nil {
+		return nil, err
+	}
 	response, err := dr.store.GetBrands(store.GetBrandsRequest{
-		Limit:   req.Limit,
-		Page:    req.Page,
-		OrderBy: req.OrderBy,
-		Paths:   withDefaultBrandFields(req.FieldMask.GetPaths()),
-		Search:  req.Search,
+		Limit:          req.Limit,
+		Page:           req.Page,
+		OrderBy:        req.OrderBy,
+		Paths:          withDefaultBrandFields(req.FieldMask.GetPaths()),
+		Search:         req.Search,
+		HiddenOverlays: hidden,
 	})
 	if err != nil {
 		return nil, err
@@ -100,10 +204,15 @@ func (dr *DeviceRepository) GetBrand(
 	if err := rights.RequireAuthenticated(ctx); err != nil {
 		return nil, err
 	}
+	hidden, err := dr.hiddenOverlays(ctx)
+	if err != nil {
+		return nil, err
+	}
 	response, err := dr.store.GetBrands(store.GetBrandsRequest{
-		BrandID: req.BrandId,
-		Paths:   withDefaultBrandFields(req.FieldMask.GetPaths()),
-		Limit:   1,
+		BrandID:        req.BrandId,
+		Paths:          withDefaultBrandFields(req.FieldMask.GetPaths()),
+		Limit:          1,
+		HiddenOverlays: hidden,
 	})
 	if err != nil {
 		return nil, err
@@ -127,13 +236,18 @@ func (dr *DeviceRepository) ListModels(
 	if req.Limit > defaultLimit || req.Limit == 0 {
 		req.Limit = defaultLimit
 	}
+	hidden, err := dr.hiddenOverlays(ctx)
+	if err != nil {
+		return nil, err
+	}
 	response, err := dr.store.GetModels(store.GetModelsRequest{
-		BrandID: req.BrandId,
-		Limit:   req.Limit,
-		Page:    req.Page,
-		Paths:   withDefaultModelFields(req.FieldMask.GetPaths()),
-		Search:  req.Search,
-		OrderBy: req.OrderBy,
+		BrandID:        req.BrandId,
+		Limit:          req.Limit,
+		Page:           req.Page,
+		Paths:          withDefaultModelFields(req.FieldMask.GetPaths()),
+		Search:         req.Search,
+		OrderBy:        req.OrderBy,
+		HiddenOverlays: hidden,
 	})
 	if err != nil {
 		return nil, err
@@ -155,11 +269,16 @@ func (dr *DeviceRepository) GetModel(
 	if err := rights.RequireAuthenticated(ctx); err != nil {
 		return nil, err
 	}
+	hidden, err := dr.hiddenOverlays(ctx)
+	if err != nil {
+		return nil, err
+	}
 	response, err := dr.store.GetModels(store.GetModelsRequest{
-		BrandID: req.BrandId,
-		ModelID: req.ModelId,
-		Limit:   1,
-		Paths:   withDefaultModelFields(req.FieldMask.GetPaths()),
+		BrandID:        req.BrandId,
+		ModelID:        req.ModelId,
+		Limit:          1,
+		Paths:          withDefaultModelFields(req.FieldMask.GetPaths()),
+		HiddenOverlays: hidden,
 	})
 	if err != nil {
 		return nil, err
@@ -180,18 +299,35 @@ func (dr *DeviceRepository) GetTemplate(
 	if err := rights.RequireAuthenticated(ctx); err != nil {
 		return nil, err
 	}
-	return dr.store.GetTemplate(req)
+	template, err := dr.store.GetTemplate(req)
+	if err != nil {
+		return nil, err
+	}
+	ids := template.GetEndDevice().GetVersionIds()
+	if err := dr.requireModelVisible(ctx, ids.GetBrandId(), ids.GetModelId()); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (dr *DeviceRepository) requireCodecAccess(ctx context.Context, req *ttnpb.GetPayloadFormatterRequest) error {
+	if clusterauth.Authorized(ctx) == nil {
+		return nil
+	}
+	if err := rights.RequireAuthenticated(ctx); err != nil {
+		return err
+	}
+	ids := req.GetVersionIds()
+	return dr.requireModelVisible(ctx, ids.GetBrandId(), ids.GetModelId())
 }
 
-func getDecoder(
+func (dr *DeviceRepository) getDecoder(
 	ctx context.Context,
 	req *ttnpb.GetPayloadFormatterRequest,
 	f func(store.GetCodecRequest) (*ttnpb.MessagePayloadDecoder, error),
 ) (*ttnpb.MessagePayloadDecoder, error) {
-	if clusterauth.Authorized(ctx) != nil {
-		if err := rights.RequireAuthenticated(ctx); err != nil {
-			return nil, err
-		}
+	if err := dr.requireCodecAccess(ctx, req); err != nil {
+		return nil, err
 	}
 	return f(req)
 }
@@ -201,7 +337,7 @@ func (dr *DeviceRepository) GetUplinkDecoder(
 	ctx context.Context,
 	req *ttnpb.GetPayloadFormatterRequest,
 ) (*ttnpb.MessagePayloadDecoder, error) {
-	return getDecoder(ctx, req, dr.store.GetUplinkDecoder)
+	return dr.getDecoder(ctx, req, dr.store.GetUplinkDecoder)
 }
 
 // GetDownlinkDecoder implements the ttnpb.DeviceRepositoryServer interface.
@@ -209,7 +345,7 @@ func (dr *DeviceRepository) GetDownlinkDecoder(
 	ctx context.Context,
 	req *ttnpb.GetPayloadFormatterRequest,
 ) (*ttnpb.MessagePayloadDecoder, error) {
-	return getDecoder(ctx, req, dr.store.GetDownlinkDecoder)
+	return dr.getDecoder(ctx, req, dr.store.GetDownlinkDecoder)
 }
 
 // GetDownlinkEncoder implements the ttnpb.DeviceRepositoryServer interface.
@@ -217,10 +353,8 @@ func (dr *DeviceRepository) GetDownlinkEncoder(
 	ctx context.Context,
 	req *ttnpb.GetPayloadFormatterRequest,
 ) (*ttnpb.MessagePayloadEncoder, error) {
-	if clusterauth.Authorized(ctx) != nil {
-		if err := rights.RequireAuthenticated(ctx); err != nil {
-			return nil, err
-		}
+	if err := dr.requireCodecAccess(ctx, req); err != nil {
+		return nil, err
 	}
 	return dr.store.GetDownlinkEncoder(req)
 }
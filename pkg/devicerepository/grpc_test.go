@@ -15,15 +15,19 @@
 package devicerepository_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/smarty/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
@@ -789,3 +793,130 @@ func TestGRPC(t *testing.T) {
 		})
 	})
 }
+
+func TestOverlayVisibility(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx = clusterauth.NewContext(ctx, errors.New("not a cluster call"))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	st := &mockStore{
+		template: &ttnpb.EndDeviceTemplate{
+			EndDevice: &ttnpb.EndDevice{
+				VersionIds: &ttnpb.EndDeviceVersionIdentifiers{
+					BrandId: "acme-vendor",
+					ModelId: "sensor",
+				},
+			},
+		},
+		uplinkDecoder: &ttnpb.MessagePayloadDecoder{},
+	}
+	dr, err := devicerepository.New(c, &devicerepository.Config{
+		Store: devicerepository.StoreConfig{
+			Store: st,
+			Restrictions: &overlay.Restrictions{
+				Overlays: []*overlay.Overlay{{
+					Name: "acme",
+					Visibility: overlay.Visibility{
+						Users:         []string{"alice"},
+						Organizations: []string{"acme"},
+					},
+				}},
+				Brands: map[string]string{"acme-vendor": "acme"},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	authInfo := &ttnpb.AuthInfoResponse{
+		UniversalRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_INFO),
+	}
+	allRights := ttnpb.RightsFrom(ttnpb.Right_RIGHT_ALL)
+	for _, tc := range []struct {
+		name   string
+		ctx    context.Context
+		hidden []string
+	}{
+		{
+			name:   "Other",
+			ctx:    rights.NewContext(rights.NewContextWithAuthInfo(ctx, authInfo), &rights.Rights{}),
+			hidden: []string{"acme"},
+		},
+		{
+			name: "Admin",
+			ctx: rights.NewContext(rights.NewContextWithAuthInfo(ctx, &ttnpb.AuthInfoResponse{
+				UniversalRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_INFO),
+				IsAdmin:         true,
+			}), &rights.Rights{}),
+		},
+		{
+			name: "User",
+			ctx: rights.NewContext(rights.NewContextWithAuthInfo(ctx, authInfo), &rights.Rights{
+				UserRights: *rights.NewMap(map[string]*ttnpb.Rights{"alice": allRights}),
+			}),
+		},
+		{
+			name: "OrganizationMember",
+			ctx: rights.NewContext(rights.NewContextWithAuthInfo(ctx, authInfo), &rights.Rights{
+				OrganizationRights: *rights.NewMap(map[string]*ttnpb.Rights{"acme": allRights}),
+			}),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			_, err := dr.ListBrands(tc.ctx, &ttnpb.ListEndDeviceBrandsRequest{})
+			if a.So(err, should.BeNil) {
+				a.So(st.lastGetBrandsRequest.HiddenOverlays, should.Resemble, tc.hidden)
+			}
+			_, err = dr.ListModels(tc.ctx, &ttnpb.ListEndDeviceModelsRequest{BrandId: "acme-vendor"})
+			if a.So(err, should.BeNil) {
+				a.So(st.lastGetModelsRequest.HiddenOverlays, should.Resemble, tc.hidden)
+			}
+
+			versionIDs := &ttnpb.EndDeviceVersionIdentifiers{BrandId: "acme-vendor", ModelId: "sensor"}
+			template, err := dr.GetTemplate(tc.ctx, &ttnpb.GetTemplateRequest{VersionIds: versionIDs})
+			decoder, decoderErr := dr.GetUplinkDecoder(tc.ctx, &ttnpb.GetPayloadFormatterRequest{VersionIds: versionIDs})
+			if len(tc.hidden) > 0 {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+				a.So(errors.IsNotFound(decoderErr), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+				a.So(template, should.Resemble, st.template)
+				a.So(decoderErr, should.BeNil)
+				a.So(decoder, should.NotBeNil)
+			}
+		})
+	}
+
+	t.Run("Cached", func(t *testing.T) {
+		a := assertions.New(t)
+
+		withCredentials := func(ctx context.Context, token string) context.Context {
+			return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		userCtx := rights.NewContext(rights.NewContextWithAuthInfo(ctx, authInfo), &rights.Rights{
+			UserRights: *rights.NewMap(map[string]*ttnpb.Rights{"alice": allRights}),
+		})
+		otherCtx := rights.NewContext(rights.NewContextWithAuthInfo(ctx, authInfo), &rights.Rights{})
+
+		_, err := dr.ListBrands(withCredentials(userCtx, "alice-token"), &ttnpb.ListEndDeviceBrandsRequest{})
+		if a.So(err, should.BeNil) {
+			a.So(st.lastGetBrandsRequest.HiddenOverlays, should.BeEmpty)
+		}
+
+		// The visible overlays of the credentials are cached, so the rights are not looked up again.
+		_, err = dr.ListBrands(withCredentials(otherCtx, "alice-token"), &ttnpb.ListEndDeviceBrandsRequest{})
+		if a.So(err, should.BeNil) {
+			a.So(st.lastGetBrandsRequest.HiddenOverlays, should.BeEmpty)
+		}
+
+		_, err = dr.ListBrands(withCredentials(otherCtx, "other-token"), &ttnpb.ListEndDeviceBrandsRequest{})
+		if a.So(err, should.BeNil) {
+			a.So(st.lastGetBrandsRequest.HiddenOverlays, should.Resemble, []string{"acme"})
+		}
+	})
+}
@@ -0,0 +1,456 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overlay
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	errFileNotFound     = errors.DefineNotFound("file_not_found", "file `{filename}` not found")
+	errDuplicateOverlay = errors.DefineAlreadyExists("duplicate_overlay", "duplicate overlay `{overlay}`")
+	errInvalidOverlay   = errors.DefineInvalidArgument(
+		"invalid_overlay",
+		"invalid overlay `{overlay}` with {issues} issue(s)",
+	)
+	errNoVendors      = errors.DefineNotFound("no_vendors", "no vendor directory in overlay `{overlay}`")
+	errInvalidIndex   = errors.DefineInvalidArgument("invalid_index", "invalid index `{filename}`")
+	errUnknownVendor  = errors.DefineNotFound("unknown_vendor", "unknown vendor `{brand_id}`")
+	errOverrideVendor = errors.DefinePermissionDenied(
+		"override_vendor",
+		"restricted overlay `{overlay}` cannot replace vendor `{brand_id}`",
+	)
+	errOverrideFile = errors.DefinePermissionDenied(
+		"override_file",
+		"restricted overlay `{overlay}` cannot replace file `{filename}`",
+	)
+	errOverrideProfileIDs = errors.DefinePermissionDenied(
+		"override_profile_ids",
+		"restricted overlay `{overlay}` cannot replace profile identifiers `{key}` of vendor `{brand_id}`",
+	)
+)
+
+// layer is a directory with end device definitions.
+type layer struct {
+	overlay *Overlay
+	dir     string
+	// files are the files that the overlay provides, except for the index files.
+	// The files of the Device Repository are not enumerated.
+	files map[string]struct{}
+}
+
+// merger merges overlays on top of the Device Repository in memory.
+// The merger implements fetch.Interface, so that the merged end device definitions can be validated.
+type merger struct {
+	layers  []*layer
+	vendors *remote.VendorsIndex
+	// indexes are the vendor end device indexes that are loaded from the Device Repository.
+	// The value is nil if the vendor has no index.
+	indexes      map[string]*remote.VendorEndDevicesIndex
+	restrictions *Restrictions
+}
+
+func newMerger(source string) (*merger, error) {
+	m := &merger{
+		layers:  []*layer{{dir: source}},
+		vendors: &remote.VendorsIndex{},
+		indexes: make(map[string]*remote.VendorEndDevicesIndex),
+		restrictions: &Restrictions{
+			Brands: make(map[string]string),
+			Models: make(map[string]string),
+		},
+	}
+	b, err := os.ReadFile(filepath.Join(source, "vendor", "index.yaml"))
+	switch {
+	case os.IsNotExist(err):
+		return m, nil
+	case err != nil:
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, m.vendors); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// lookup returns the path of the file in the top-most layer that contains it.
+func (m *merger) lookup(filename string) (string, bool) {
+	for i := len(m.layers) - 1; i >= 0; i-- {
+		l := m.layers[i]
+		if l.overlay != nil {
+			if _, ok := l.files[filename]; !ok {
+				continue
+			}
+		}
+		p := filepath.Join(l.dir, filepath.FromSlash(filename))
+		if st, err := os.Stat(p); err == nil && !st.IsDir() {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// index returns the merged end device index of the vendor, or nil if the vendor has no index.
+func (m *merger) index(brandID string) (*remote.VendorEndDevicesIndex, error) {
+	if index, ok := m.indexes[brandID]; ok {
+		return index, nil
+	}
+	b, err := os.ReadFile(filepath.Join(m.layers[0].dir, "vendor", brandID, "index.yaml"))
+	if os.IsNotExist(err) {
+		m.indexes[brandID] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	index := &remote.VendorEndDevicesIndex{}
+	if err := yaml.Unmarshal(b, index); err != nil {
+		return nil, err
+	}
+	m.indexes[brandID] = index
+	return index, nil
+}
+
+func (m *merger) vendor(brandID string) int {
+	for i, vendor := range m.vendors.Vendors {
+		if vendor.ID == brandID {
+			return i
+		}
+	}
+	return -1
+}
+
+// File implements fetch.Interface.
+func (m *merger) File(pathElements ...string) ([]byte, error) {
+	filename := path.Join(pathElements...)
+	if filename == "vendor/index.yaml" {
+		return yaml.Marshal(m.vendors)
+	}
+	if parts := strings.Split(filename, "/"); len(parts) == 3 && parts[0] == "vendor" && parts[2] == "index.yaml" {
+		index, err := m.index(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if index == nil {
+			return nil, errFileNotFound.WithAttributes("filename", filename)
+		}
+		return yaml.Marshal(index)
+	}
+	p, ok := m.lookup(filename)
+	if !ok {
+		return nil, errFileNotFound.WithAttributes("filename", filename)
+	}
+	return os.ReadFile(p)
+}
+
+var _ fetch.Interface = (*merger)(nil)
+
+// apply merges the overlay. It returns the issues found in the overlay.
+// Definitions with issues are merged as far as possible, so that later overlays can be validated.
+func (m *merger) apply(o *Overlay) ([]error, error) { //nolint:gocyclo
+	var (
+		issues     []error
+		restricted = o.Visibility.Restricted()
+		l          = &layer{overlay: o, dir: o.Directory, files: make(map[string]struct{})}
+		hasVendors bool
+		brandIDs   = make(map[string]struct{})
+		indexes    = make(map[string]*remote.VendorEndDevicesIndex)
+	)
+	vendorDir := filepath.Join(o.Directory, "vendor")
+	if st, err := os.Stat(vendorDir); err != nil || !st.IsDir() {
+		return []error{errNoVendors.WithAttributes("overlay", o.Name)}, nil
+	}
+	err := filepath.WalkDir(vendorDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(o.Directory, p)
+		if err != nil {
+			return err
+		}
+		filename := filepath.ToSlash(rel)
+		parts := strings.Split(filename, "/")
+		if filename == "vendor/index.yaml" {
+			hasVendors = true
+			return nil
+		}
+		if len(parts) != 3 || (!strings.HasSuffix(filename, ".yaml") && !strings.HasSuffix(filename, ".js")) {
+			return nil
+		}
+		brandIDs[parts[1]] = struct{}{}
+		if parts[2] == "index.yaml" {
+			b, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			index := &remote.VendorEndDevicesIndex{}
+			if err := yaml.Unmarshal(b, index); err != nil {
+				issues = append(issues, errInvalidIndex.WithAttributes("filename", filename).WithCause(err))
+				return nil
+			}
+			indexes[parts[1]] = index
+			return nil
+		}
+		if _, ok := m.lookup(filename); ok && restricted {
+			issues = append(issues, errOverrideFile.WithAttributes("overlay", o.Name, "filename", filename))
+			return nil
+		}
+		l.files[filename] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.layers = append(m.layers, l)
+
+	if hasVendors {
+		vendors, vendorIssues := remote.ValidateVendors(fetch.FromFilesystem(o.Directory))
+		issues = append(issues, vendorIssues...)
+		for _, vendor := range vendors {
+			if vendor.ID == "" {
+				continue
+			}
+			i := m.vendor(vendor.ID)
+			switch {
+			case i >= 0 && restricted:
+				issues = append(issues, errOverrideVendor.WithAttributes("overlay", o.Name, "brand_id", vendor.ID))
+			case i >= 0:
+				m.vendors.Vendors[i] = vendor
+			default:
+				m.vendors.Vendors = append(m.vendors.Vendors, vendor)
+				if restricted {
+					m.restrictions.Brands[vendor.ID] = o.Name
+				}
+			}
+		}
+	}
+
+	sortedBrandIDs := make([]string, 0, len(brandIDs))
+	for brandID := range brandIDs {
+		sortedBrandIDs = append(sortedBrandIDs, brandID)
+	}
+	sort.Strings(sortedBrandIDs)
+	for _, brandID := range sortedBrandIDs {
+		if m.vendor(brandID) < 0 {
+			issues = append(issues, errUnknownVendor.WithAttributes("brand_id", brandID))
+			continue
+		}
+		index, err := m.index(brandID)
+		if err != nil {
+			return nil, err
+		}
+		if index == nil {
+			index = &remote.VendorEndDevicesIndex{}
+			m.indexes[brandID] = index
+		}
+		endDevices := make(map[string]struct{}, len(index.EndDevices))
+		for _, modelID := range index.EndDevices {
+			endDevices[modelID] = struct{}{}
+		}
+		var modelIDs []string
+		if overlayIndex := indexes[brandID]; overlayIndex != nil {
+			for _, modelID := range overlayIndex.EndDevices {
+				modelIDs = append(modelIDs, modelID)
+				if _, ok := endDevices[modelID]; ok {
+					continue
+				}
+				endDevices[modelID] = struct{}{}
+				index.EndDevices = append(index.EndDevices, modelID)
+				if restricted {
+					m.restrictions.Models[brandID+"/"+modelID] = o.Name
+				}
+			}
+			keys := make([]string, 0, len(overlayIndex.ProfileIDs))
+			for key := range overlayIndex.ProfileIDs {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if _, ok := index.ProfileIDs[key]; ok && restricted {
+					issues = append(issues, errOverrideProfileIDs.WithAttributes(
+						"overlay", o.Name,
+						"key", key,
+						"brand_id", brandID,
+					))
+					continue
+				}
+				if index.ProfileIDs == nil {
+					index.ProfileIDs = make(map[string]*store.EndDeviceProfileIdentifiers)
+				}
+				index.ProfileIDs[key] = overlayIndex.ProfileIDs[key]
+			}
+		}
+		// Models that are not in the index of the overlay, but of which the overlay replaces the definition.
+		for filename := range l.files {
+			if !strings.HasPrefix(filename, "vendor/"+brandID+"/") || !strings.HasSuffix(filename, ".yaml") {
+				continue
+			}
+			modelID := strings.TrimSuffix(path.Base(filename), ".yaml")
+			if _, ok := endDevices[modelID]; ok && !contains(modelIDs, modelID) {
+				modelIDs = append(modelIDs, modelID)
+			}
+		}
+		if len(modelIDs) > 0 {
+			sort.Strings(modelIDs)
+			issues = append(issues, remote.ValidateVendor(m, brandID, modelIDs...)...)
+		}
+	}
+
+	if restricted {
+		m.restrictions.Overlays = append(m.restrictions.Overlays, o)
+	}
+	return issues, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// write writes the files of the overlays and the merged indexes to the working directory.
+func (m *merger) write(ctx context.Context, workingDirectory string) error {
+	logger := log.FromContext(ctx)
+	for _, l := range m.layers[1:] {
+		for filename := range l.files {
+			b, err := os.ReadFile(filepath.Join(l.dir, filepath.FromSlash(filename)))
+			if err != nil {
+				return err
+			}
+			if err := writeFile(workingDirectory, filename, b); err != nil {
+				return err
+			}
+			logger.WithFields(log.Fields(
+				"overlay", l.overlay.Name,
+				"filename", filename,
+			)).Debug("Copied overlay file to working directory")
+		}
+	}
+	b, err := yaml.Marshal(m.vendors)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(workingDirectory, "vendor/index.yaml", b); err != nil {
+		return err
+	}
+	for brandID, index := range m.indexes {
+		if index == nil {
+			continue
+		}
+		b, err := yaml.Marshal(index)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(workingDirectory, path.Join("vendor", brandID, "index.yaml"), b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(workingDirectory, filename string, b []byte) error {
+	destination := filepath.Join(workingDirectory, filepath.FromSlash(filename))
+	if err := os.MkdirAll(filepath.Dir(destination), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(destination, b, 0o644)
+}
+
+func checkNames(overlays []*Overlay) error {
+	names := make(map[string]struct{}, len(overlays))
+	for _, o := range overlays {
+		if _, ok := names[o.Name]; ok {
+			return errDuplicateOverlay.WithAttributes("overlay", o.Name)
+		}
+		names[o.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Merge merges the overlays, in increasing order of precedence, on top of the Device Repository in
+// the source directory. The merged files are written to the working directory, which is expected to
+// contain a copy of the Device Repository. The restrictions are written to the working directory and returned.
+// Merge fails if any of the overlays is invalid.
+func Merge(ctx context.Context, workingDirectory, source string, overlays ...*Overlay) (*Restrictions, error) {
+	if err := checkNames(overlays); err != nil {
+		return nil, err
+	}
+	m, err := newMerger(source)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range overlays {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"overlay", o.Name,
+			"directory", o.Directory,
+			"restricted", o.Visibility.Restricted(),
+		)).Info("Merge overlay")
+		issues, err := m.apply(o)
+		if err != nil {
+			return nil, err
+		}
+		if len(issues) > 0 {
+			return nil, errInvalidOverlay.WithAttributes("overlay", o.Name, "issues", len(issues)).WithCause(issues[0])
+		}
+	}
+	if len(overlays) > 0 {
+		if err := m.write(ctx, workingDirectory); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.restrictions.save(workingDirectory); err != nil {
+		return nil, err
+	}
+	return m.restrictions, nil
+}
+
+// Validate validates the overlays, in increasing order of precedence, on top of the Device Repository
+// in the source directory, without writing any files. It returns the issues by overlay name.
+func Validate(source string, overlays ...*Overlay) (map[string][]error, error) {
+	if err := checkNames(overlays); err != nil {
+		return nil, err
+	}
+	m, err := newMerger(source)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string][]error)
+	for _, o := range overlays {
+		issues, err := m.apply(o)
+		if err != nil {
+			return nil, err
+		}
+		if len(issues) > 0 {
+			res[o.Name] = issues
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,190 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overlay_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+const source = "../store/remote/testdata"
+
+// copyDirectory copies the Device Repository in the source directory to the destination directory.
+func copyDirectory(t *testing.T, source, destination string) {
+	t.Helper()
+	err := filepath.Walk(source, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(destination, rel)), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(destination, rel), b, 0o644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func loadAll(t *testing.T, directories ...string) []*overlay.Overlay {
+	t.Helper()
+	overlays, err := overlay.LoadAll(directories...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return overlays
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	wd := t.TempDir()
+	copyDirectory(t, source, wd)
+
+	restrictions, err := overlay.Merge(ctx, wd, source, loadAll(t, "testdata/public", "testdata/private")...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(restrictions.Brand("foo-vendor"), should.BeEmpty)
+	a.So(restrictions.Brand("acme-vendor"), should.Equal, "acme")
+	a.So(restrictions.Model("foo-vendor", "dev1"), should.BeEmpty)
+	a.So(restrictions.Model("foo-vendor", "dev3"), should.BeEmpty)
+	a.So(restrictions.Model("foo-vendor", "secret"), should.Equal, "acme")
+	a.So(restrictions.Model("acme-vendor", "sensor"), should.Equal, "acme")
+	if a.So(restrictions.GetOverlays(), should.HaveLength, 1) {
+		a.So(restrictions.GetOverlays()[0].Name, should.Equal, "acme")
+	}
+
+	loaded, err := overlay.LoadRestrictions(wd)
+	if a.So(err, should.BeNil) {
+		a.So(loaded.Brands, should.Resemble, restrictions.Brands)
+		a.So(loaded.Models, should.Resemble, restrictions.Models)
+		if a.So(loaded.GetOverlays(), should.HaveLength, 1) {
+			a.So(loaded.GetOverlays()[0].Visibility, should.Resemble, restrictions.GetOverlays()[0].Visibility)
+		}
+	}
+
+	s := remote.NewRemoteStore(fetch.FromFilesystem(wd))
+
+	brands, err := s.GetBrands(store.GetBrandsRequest{Paths: []string{"brand_id", "name"}})
+	if a.So(err, should.BeNil) {
+		names := make(map[string]string)
+		for _, brand := range brands.Brands {
+			names[brand.BrandId] = brand.Name
+		}
+		a.So(names["foo-vendor"], should.Equal, "Foo Vendor Updated")
+		a.So(names["full-vendor"], should.Equal, "Full Vendor")
+		a.So(names["acme-vendor"], should.Equal, "ACME")
+	}
+
+	models, err := s.GetModels(store.GetModelsRequest{BrandID: "foo-vendor", Paths: []string{"model_id"}})
+	if a.So(err, should.BeNil) {
+		modelIDs := make([]string, 0, len(models.Models))
+		for _, model := range models.Models {
+			modelIDs = append(modelIDs, model.ModelId)
+		}
+		a.So(modelIDs, should.Resemble, []string{"dev1", "dev2", "dev3", "secret"})
+	}
+
+	template, err := s.GetTemplate(&ttnpb.GetTemplateRequest{
+		EndDeviceProfileIds: &ttnpb.GetTemplateRequest_EndDeviceProfileIdentifiers{
+			VendorId:        1234,
+			VendorProfileId: 1,
+		},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(template.GetEndDevice().GetVersionIds().GetModelId(), should.Equal, "sensor")
+	}
+
+	decoder, err := s.GetUplinkDecoder(&ttnpb.GetPayloadFormatterRequest{
+		VersionIds: &ttnpb.EndDeviceVersionIdentifiers{
+			BrandId:         "acme-vendor",
+			ModelId:         "sensor",
+			FirmwareVersion: "1.0",
+			BandId:          "EU_863_870",
+		},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(decoder.FormatterParameter, should.ContainSubstring, "decodeUplink")
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+
+		wd := t.TempDir()
+		copyDirectory(t, source, wd)
+		_, err := overlay.Merge(ctx, wd, source, loadAll(t, "testdata/invalid")...)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+
+		_, err := overlay.Merge(ctx, t.TempDir(), source, loadAll(t, "testdata/public", "testdata/public")...)
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	issues, err := overlay.Validate(source, loadAll(t, "testdata/public", "testdata/private")...)
+	if a.So(err, should.BeNil) {
+		a.So(issues, should.BeEmpty)
+	}
+
+	issues, err = overlay.Validate(source, loadAll(t, "testdata/public", "testdata/invalid")...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(issues["public"], should.BeEmpty)
+	var permissionDenied, notFound int
+	for _, issue := range issues["invalid"] {
+		switch {
+		case errors.IsPermissionDenied(issue):
+			permissionDenied++
+		case errors.IsNotFound(issue):
+			notFound++
+		}
+	}
+	// The restricted overlay replaces vendor/foo-vendor/dev1.yaml and vendor foo-vendor.
+	a.So(permissionDenied, should.Equal, 2)
+	// The vendor unknown-vendor is not defined, and broken.yaml references an unknown hardware version,
+	// an unknown region and a missing profile.
+	a.So(notFound, should.Equal, 4)
+	a.So(issues["invalid"], should.HaveLength, 6)
+}
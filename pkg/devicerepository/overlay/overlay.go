@@ -0,0 +1,104 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package overlay implements local overlays of end device definitions on top of the Device Repository.
+//
+// An overlay is a directory with the same vendor, profile and codec layout as the Device Repository.
+// Overlays are merged in increasing order of precedence: files of later overlays replace files of
+// earlier overlays and of the Device Repository, vendors are replaced by ID, and the end devices of
+// vendor indexes are combined.
+//
+// The optional overlay.yaml file in the root of an overlay configures its name and visibility.
+// The end device definitions of an overlay with restricted visibility are only visible to the
+// listed users and organizations, and such an overlay cannot replace existing definitions.
+package overlay
+
+import (
+	"os"
+	"path/filepath"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigFile is the name of the optional configuration file in the root of an overlay.
+const ConfigFile = "overlay.yaml"
+
+var (
+	errOverlayNotFound = errors.DefineNotFound("overlay_not_found", "overlay `{directory}` not found")
+	errInvalidConfig   = errors.DefineInvalidArgument("invalid_config", "invalid overlay configuration `{filename}`")
+	errMissingName     = errors.DefineInvalidArgument("missing_name", "missing name of overlay `{directory}`")
+)
+
+// Visibility defines who can see the end device definitions of an overlay.
+type Visibility struct {
+	// Users are the IDs of the users that can see the end device definitions.
+	Users []string `yaml:"users,omitempty"`
+	// Organizations are the IDs of the organizations whose members can see the end device definitions.
+	Organizations []string `yaml:"organizations,omitempty"`
+}
+
+// Restricted returns whether the visibility is restricted to users or organizations.
+func (v Visibility) Restricted() bool {
+	return len(v.Users) > 0 || len(v.Organizations) > 0
+}
+
+// Overlay is a local directory with end device definitions.
+type Overlay struct {
+	// Name is the name of the overlay. Defaults to the name of the directory.
+	Name string `yaml:"name"`
+	// Visibility is the visibility of the end device definitions. If empty, the end device definitions are public.
+	Visibility Visibility `yaml:"visibility,omitempty"`
+	// Directory is the root directory of the overlay.
+	Directory string `yaml:"-"`
+}
+
+// Load loads the overlay in the given directory.
+func Load(directory string) (*Overlay, error) {
+	if st, err := os.Stat(directory); err != nil || !st.IsDir() {
+		return nil, errOverlayNotFound.WithAttributes("directory", directory)
+	}
+	o := &Overlay{
+		Name:      filepath.Base(filepath.Clean(directory)),
+		Directory: directory,
+	}
+	filename := filepath.Join(directory, ConfigFile)
+	b, err := os.ReadFile(filename)
+	switch {
+	case os.IsNotExist(err):
+		return o, nil
+	case err != nil:
+		return nil, errInvalidConfig.WithAttributes("filename", filename).WithCause(err)
+	}
+	if err := yaml.UnmarshalStrict(b, o); err != nil {
+		return nil, errInvalidConfig.WithAttributes("filename", filename).WithCause(err)
+	}
+	if o.Name == "" {
+		return nil, errMissingName.WithAttributes("directory", directory)
+	}
+	return o, nil
+}
+
+// LoadAll loads the overlays in the given directories.
+func LoadAll(directories ...string) ([]*Overlay, error) {
+	overlays := make([]*Overlay, 0, len(directories))
+	for _, directory := range directories {
+		o, err := Load(directory)
+		if err != nil {
+			return nil, err
+		}
+		overlays = append(overlays, o)
+	}
+	return overlays, nil
+}
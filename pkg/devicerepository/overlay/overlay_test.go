@@ -0,0 +1,59 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overlay_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	o, err := overlay.Load("testdata/public")
+	if a.So(err, should.BeNil) {
+		a.So(o.Name, should.Equal, "public")
+		a.So(o.Directory, should.Equal, "testdata/public")
+		a.So(o.Visibility.Restricted(), should.BeFalse)
+	}
+
+	o, err = overlay.Load("testdata/private")
+	if a.So(err, should.BeNil) {
+		a.So(o.Name, should.Equal, "acme")
+		a.So(o.Visibility, should.Resemble, overlay.Visibility{
+			Users:         []string{"alice"},
+			Organizations: []string{"acme"},
+		})
+		a.So(o.Visibility.Restricted(), should.BeTrue)
+	}
+
+	_, err = overlay.Load("testdata/unknown")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, overlay.ConfigFile), []byte("unknown: true\n"), 0o644)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = overlay.Load(dir)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
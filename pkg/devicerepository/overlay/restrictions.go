@@ -0,0 +1,88 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overlay
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RestrictionsFile is the name of the file in the working directory that contains the restrictions
+// of the merged overlays.
+const RestrictionsFile = "overlays.yaml"
+
+// Restrictions are the visibility restrictions of merged end device definitions.
+type Restrictions struct {
+	// Overlays are the merged overlays with restricted visibility.
+	Overlays []*Overlay `yaml:"overlays,omitempty"`
+	// Brands maps brand IDs to the name of the restricted overlay that defines the brand.
+	Brands map[string]string `yaml:"brands,omitempty"`
+	// Models maps `<brand-id>/<model-id>` to the name of the restricted overlay that defines the model.
+	Models map[string]string `yaml:"models,omitempty"`
+}
+
+// Brand returns the name of the restricted overlay that defines the brand, if any.
+func (r *Restrictions) Brand(brandID string) string {
+	if r == nil {
+		return ""
+	}
+	return r.Brands[brandID]
+}
+
+// Model returns the name of the restricted overlay that defines the model, or the brand of the model, if any.
+func (r *Restrictions) Model(brandID, modelID string) string {
+	if r == nil {
+		return ""
+	}
+	if name, ok := r.Models[brandID+"/"+modelID]; ok {
+		return name
+	}
+	return r.Brands[brandID]
+}
+
+// LoadRestrictions loads the restrictions from the working directory.
+// If the working directory does not contain restrictions, there are no restrictions.
+func LoadRestrictions(workingDirectory string) (*Restrictions, error) {
+	r := &Restrictions{}
+	b, err := os.ReadFile(filepath.Join(workingDirectory, RestrictionsFile))
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Restrictions) save(workingDirectory string) error {
+	b, err := yaml.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(workingDirectory, RestrictionsFile), b, 0o644)
+}
+
+// GetOverlays returns the restricted overlays.
+func (r *Restrictions) GetOverlays() []*Overlay {
+	if r == nil {
+		return nil
+	}
+	return r.Overlays
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/blevesearch/bleve"
 	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -88,3 +89,12 @@ func openIndex(ctx context.Context, indexPath string) (bleve.Index, error) {
 	}
 	return index, nil
 }
+
+// Restrictions returns the visibility restrictions of the overlays that are merged in the index.
+func (c Config) Restrictions() (*overlay.Restrictions, error) {
+	wd, err := getWorkingDirectory(c.SearchPaths)
+	if err != nil {
+		return nil, err
+	}
+	return overlay.LoadRestrictions(wd)
+}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/bleve"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -605,3 +606,62 @@ func TestBleve(t *testing.T) {
 		}
 	})
 }
+
+func TestBleveOverlays(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	overlays, err := overlay.LoadAll("../../overlay/testdata/public", "../../overlay/testdata/private")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	c := bleve.Config{
+		SearchPaths: []string{t.TempDir()},
+	}
+	err = c.Initialize(ctx, "../remote/testdata", true, overlays...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	s, err := c.NewStore(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	restrictions, err := c.Restrictions()
+	if a.So(err, should.BeNil) {
+		a.So(restrictions.Brand("acme-vendor"), should.Equal, "acme")
+	}
+
+	brands, err := s.GetBrands(store.GetBrandsRequest{
+		OrderBy: "brand_id",
+		Paths:   []string{"brand_id"},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(brands, should.Resemble, brandsResponse("acme-vendor", "foo-vendor", "full-vendor", "windsensor-vendor"))
+	}
+	brands, err = s.GetBrands(store.GetBrandsRequest{
+		OrderBy:        "brand_id",
+		Paths:          []string{"brand_id"},
+		HiddenOverlays: []string{"acme"},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(brands, should.Resemble, brandsResponse("foo-vendor", "full-vendor", "windsensor-vendor"))
+	}
+
+	models, err := s.GetModels(store.GetModelsRequest{
+		BrandID: "foo-vendor",
+		OrderBy: "model_id",
+		Paths:   []string{"model_id"},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(models, should.Resemble, modelsResponse("dev1", "dev2", "dev3", "secret"))
+	}
+	models, err = s.GetModels(store.GetModelsRequest{
+		BrandID:        "foo-vendor",
+		OrderBy:        "model_id",
+		Paths:          []string{"model_id"},
+		HiddenOverlays: []string{"acme"},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(models, should.Resemble, modelsResponse("dev1", "dev2", "dev3"))
+	}
+}
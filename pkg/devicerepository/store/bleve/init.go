@@ -25,6 +25,7 @@ import (
 
 	"github.com/blevesearch/bleve"
 	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/overlay"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -49,6 +50,8 @@ type indexableBrand struct {
 	BrandID, BrandName string // stored separately to support queries
 
 	Type string // Index document type, always brandDocumentType
+
+	Overlay string // Name of the restricted overlay that defines the brand, if any.
 }
 
 type indexableModel struct {
@@ -59,6 +62,8 @@ type indexableModel struct {
 	BrandID, ModelID string // stored separately to support queries.
 
 	Type string // Index document type, always modelDocumentType
+
+	Overlay string // Name of the restricted overlay that defines the model, if any.
 }
 
 type indexableTemplate struct {
@@ -68,6 +73,8 @@ type indexableTemplate struct {
 	VendorID, VendorProfileID string // stored separately to support queries.
 
 	Type string // Index document type, always templateDocumentType
+
+	Overlay string // Name of the restricted overlay that defines the template, if any.
 }
 
 func newIndex(indexPath string, overwrite bool, keywords ...string) (bleve.Index, error) {
@@ -100,8 +107,10 @@ func getWorkingDirectory(paths []string) (string, error) {
 	return filepath.Join(wd, "data", "lorawan-devices-index"), nil
 }
 
-// Initialize fetches the Device Repository package file and generates index files.
-func (c Config) Initialize(ctx context.Context, lorawanDevicesPath string, overwrite bool) error { //nolint:gocyclo
+// Initialize fetches the Device Repository package file, merges the overlays and generates index files.
+func (c Config) Initialize( //nolint:gocyclo
+	ctx context.Context, lorawanDevicesPath string, overwrite bool, overlays ...*overlay.Overlay,
+) error {
 	wd, err := getWorkingDirectory(c.SearchPaths)
 	if err != nil {
 		return err
@@ -110,10 +119,14 @@ func (c Config) Initialize(ctx context.Context, lorawanDevicesPath string, overw
 	if err := prepareWorkingDirectory(ctx, wd, lorawanDevicesPath); err != nil {
 		return err
 	}
+	restrictions, err := overlay.Merge(ctx, wd, lorawanDevicesPath, overlays...)
+	if err != nil {
+		return err
+	}
 	s := remote.NewRemoteStore(fetch.FromFilesystem(wd))
 
 	log.FromContext(ctx).WithField("index", path.Join(wd, indexPath)).Info("Creating index")
-	index, err := newIndex(path.Join(wd, indexPath), overwrite, "BrandID", "ModelID", "Type")
+	index, err := newIndex(path.Join(wd, indexPath), overwrite, "BrandID", "ModelID", "Type", "Overlay")
 	if err != nil {
 		return err
 	}
@@ -151,6 +164,7 @@ func (c Config) Initialize(ctx context.Context, lorawanDevicesPath string, overw
 			Models:    models.Models,
 			BrandID:   brand.BrandId,
 			BrandName: brand.Name,
+			Overlay:   restrictions.Brand(brand.BrandId),
 		}
 		if err := batch.Index(brand.BrandId, b); err != nil {
 			return err
@@ -167,6 +181,7 @@ func (c Config) Initialize(ctx context.Context, lorawanDevicesPath string, overw
 				Model:     model,
 				BrandID:   model.BrandId,
 				ModelID:   model.ModelId,
+				Overlay:   restrictions.Model(model.BrandId, model.ModelId),
 			}
 			if err := batch.Index(fmt.Sprintf("%s:%s", model.BrandId, model.ModelId), m); err != nil {
 				return err
@@ -213,6 +228,10 @@ func (c Config) Initialize(ctx context.Context, lorawanDevicesPath string, overw
 					Template:        template,
 					VendorID:        vendorID,
 					VendorProfileID: vendorProfileID,
+					Overlay: restrictions.Model(
+						template.GetEndDevice().GetVersionIds().GetBrandId(),
+						template.GetEndDevice().GetVersionIds().GetModelId(),
+					),
 				}
 				if err := batch.Index(fmt.Sprintf("%s:%s", vendorID, vendorProfileID), t); err != nil {
 					return err
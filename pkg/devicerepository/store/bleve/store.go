@@ -68,6 +68,21 @@ func (s *bleveStore) retrieve(hit *search.DocumentMatch, fieldName string, newVa
 	return cached, nil
 }
 
+// searchQuery returns a query for documents that match all queries and that are not in the hidden overlays.
+func searchQuery(queries []query.Query, hiddenOverlays []string) query.Query {
+	if len(hiddenOverlays) == 0 {
+		return bleve.NewConjunctionQuery(queries...)
+	}
+	q := bleve.NewBooleanQuery()
+	q.AddMust(queries...)
+	for _, name := range hiddenOverlays {
+		overlayQuery := bleve.NewTermQuery(name)
+		overlayQuery.SetField("Overlay")
+		q.AddMustNot(overlayQuery)
+	}
+	return q
+}
+
 // GetBrands lists available end device vendors.
 func (s *bleveStore) GetBrands(req store.GetBrandsRequest) (*store.GetBrandsResponse, error) {
 	documentTypeQuery := bleve.NewTermQuery(brandDocumentType)
@@ -83,7 +98,7 @@ func (s *bleveStore) GetBrands(req store.GetBrandsRequest) (*store.GetBrandsResp
 		queries = append(queries, termQuery)
 	}
 
-	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(queries...))
+	searchRequest := bleve.NewSearchRequest(searchQuery(queries, req.HiddenOverlays))
 	if req.Limit > 0 {
 		searchRequest.Size = int(req.Limit)
 	}
@@ -149,7 +164,7 @@ func (s *bleveStore) GetModels(req store.GetModelsRequest) (*store.GetModelsResp
 		queries = append(queries, queryTerm)
 	}
 
-	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(queries...))
+	searchRequest := bleve.NewSearchRequest(searchQuery(queries, req.HiddenOverlays))
 	if req.Limit > 0 {
 		searchRequest.Size = int(req.Limit)
 	}
@@ -27,6 +27,9 @@ type GetBrandsRequest struct {
 	OrderBy string
 	Paths   []string
 	Search  string
+	// HiddenOverlays are the names of the overlays of which the brands are not returned.
+	// Only supported by indexed stores.
+	HiddenOverlays []string
 }
 
 // GetBrandsResponse is a list of brands, along with pagination information.
@@ -46,6 +49,9 @@ type GetModelsRequest struct {
 	OrderBy string
 	Paths   []string
 	Search  string
+	// HiddenOverlays are the names of the overlays of which the models are not returned.
+	// Only supported by indexed stores.
+	HiddenOverlays []string
 }
 
 // GetEndDeviceProfileIdentifiersRequest is used to retrieve end device profile identifiers.
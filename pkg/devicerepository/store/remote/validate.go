@@ -0,0 +1,273 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"path"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	errInvalidDefinition = errors.DefineInvalidArgument(
+		"invalid_definition",
+		"invalid definition `{filename}`",
+	)
+	errMissingField = errors.DefineInvalidArgument(
+		"missing_field",
+		"missing field `{field}` in `{filename}`",
+	)
+	errDuplicateVendor = errors.DefineAlreadyExists(
+		"duplicate_vendor",
+		"duplicate vendor `{brand_id}` in `{filename}`",
+	)
+	errDuplicateVendorID = errors.DefineAlreadyExists(
+		"duplicate_vendor_id",
+		"duplicate LoRa Alliance vendor ID `{vendor_id}` in `{filename}`",
+	)
+	errDuplicateEndDevice = errors.DefineAlreadyExists(
+		"duplicate_end_device",
+		"duplicate end device `{model_id}` in `{filename}`",
+	)
+	errUnknownEndDevice = errors.DefineNotFound(
+		"unknown_end_device",
+		"unknown end device `{model_id}` in `{filename}`",
+	)
+	errUnknownRegion = errors.DefineNotFound(
+		"unknown_region",
+		"unknown region `{region}` in `{filename}`",
+	)
+	errUnknownHardwareVersion = errors.DefineNotFound(
+		"unknown_hardware_version",
+		"unknown hardware version `{hardware_version}` in `{filename}`",
+	)
+)
+
+// validator validates Device Repository definitions, collecting all issues that it finds.
+type validator struct {
+	fetcher fetch.Interface
+	checked map[string]struct{}
+	issues  []error
+}
+
+func (v *validator) report(err error) {
+	v.issues = append(v.issues, err)
+}
+
+// decode fetches the file and decodes it into out. The first return value is false if
+// the file was checked before, the second is false if the file is missing or invalid.
+func (v *validator) decode(out any, pathElements ...string) (first bool, ok bool) {
+	filename := path.Join(pathElements...)
+	if _, checked := v.checked[filename]; checked {
+		return false, true
+	}
+	v.checked[filename] = struct{}{}
+	b, err := v.fetcher.File(pathElements...)
+	if err != nil {
+		v.report(err)
+		return true, false
+	}
+	if out == nil {
+		return true, true
+	}
+	if err := yaml.Unmarshal(b, out); err != nil {
+		v.report(errInvalidDefinition.WithAttributes("filename", filename).WithCause(err))
+		return true, false
+	}
+	return true, true
+}
+
+// ValidateVendors validates the `vendor/index.yaml` file. It returns the vendors and all issues found.
+func ValidateVendors(fetcher fetch.Interface) ([]Vendor, []error) {
+	v := &validator{fetcher: fetcher, checked: make(map[string]struct{})}
+	const filename = "vendor/index.yaml"
+	index := VendorsIndex{}
+	if _, ok := v.decode(&index, "vendor", "index.yaml"); !ok {
+		return nil, v.issues
+	}
+	brandIDs := make(map[string]struct{}, len(index.Vendors))
+	vendorIDs := make(map[uint32]struct{}, len(index.Vendors))
+	for _, vendor := range index.Vendors {
+		if vendor.ID == "" {
+			v.report(errMissingField.WithAttributes("field", "id", "filename", filename))
+			continue
+		}
+		if _, ok := brandIDs[vendor.ID]; ok {
+			v.report(errDuplicateVendor.WithAttributes("brand_id", vendor.ID, "filename", filename))
+		}
+		brandIDs[vendor.ID] = struct{}{}
+		if vendor.Name == "" {
+			v.report(errMissingField.WithAttributes("field", "name", "filename", filename))
+		}
+		if vendor.VendorID == 0 {
+			continue
+		}
+		if _, ok := vendorIDs[vendor.VendorID]; ok {
+			v.report(errDuplicateVendorID.WithAttributes("vendor_id", vendor.VendorID, "filename", filename))
+		}
+		vendorIDs[vendor.VendorID] = struct{}{}
+	}
+	return index.Vendors, v.issues
+}
+
+// ValidateVendor validates the end device definitions of the vendor with the given brand ID,
+// including the end device profiles and codecs that they reference. If model IDs are given,
+// only the definitions of these end devices are validated. It returns all issues found.
+func ValidateVendor(fetcher fetch.Interface, brandID string, modelIDs ...string) []error {
+	v := &validator{fetcher: fetcher, checked: make(map[string]struct{})}
+	filename := path.Join("vendor", brandID, "index.yaml")
+	index := VendorEndDevicesIndex{}
+	if _, ok := v.decode(&index, "vendor", brandID, "index.yaml"); !ok {
+		return v.issues
+	}
+	selected := make(map[string]struct{}, len(modelIDs))
+	for _, modelID := range modelIDs {
+		selected[modelID] = struct{}{}
+	}
+	isSelected := func(modelID string) bool {
+		_, ok := selected[modelID]
+		return len(selected) == 0 || ok
+	}
+	endDevices := make(map[string]struct{}, len(index.EndDevices))
+	for _, modelID := range index.EndDevices {
+		if _, ok := endDevices[modelID]; ok {
+			v.report(errDuplicateEndDevice.WithAttributes("model_id", modelID, "filename", filename))
+			continue
+		}
+		endDevices[modelID] = struct{}{}
+		if isSelected(modelID) {
+			v.validateModel(brandID, modelID)
+		}
+	}
+	for _, modelID := range modelIDs {
+		if _, ok := endDevices[modelID]; !ok {
+			v.report(errUnknownEndDevice.WithAttributes("model_id", modelID, "filename", filename))
+		}
+	}
+	keys := make([]string, 0, len(index.ProfileIDs))
+	for key := range index.ProfileIDs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		ids := index.ProfileIDs[key]
+		if ids == nil || !isSelected(ids.EndDeviceID) {
+			continue
+		}
+		if ids.EndDeviceID != "" {
+			if _, ok := endDevices[ids.EndDeviceID]; !ok {
+				v.report(errUnknownEndDevice.WithAttributes("model_id", ids.EndDeviceID, "filename", filename))
+			}
+		}
+		if ids.Region != "" {
+			if _, ok := regionToBandID[ids.Region]; !ok {
+				v.report(errUnknownRegion.WithAttributes("region", ids.Region, "filename", filename))
+			}
+		}
+		if ids.ProfileID != "" {
+			v.validateProfile(brandID, ids.ProfileID)
+		}
+		if ids.Codec != "" {
+			v.validateCodec(brandID, ids.Codec)
+		}
+	}
+	return v.issues
+}
+
+func (v *validator) validateModel(brandID, modelID string) {
+	filename := path.Join("vendor", brandID, modelID+".yaml")
+	model := EndDeviceModel{}
+	if _, ok := v.decode(&model, "vendor", brandID, modelID+".yaml"); !ok {
+		return
+	}
+	if model.Name == "" {
+		v.report(errMissingField.WithAttributes("field", "name", "filename", filename))
+	}
+	hardwareVersions := make(map[string]struct{}, len(model.HardwareVersions))
+	for _, ver := range model.HardwareVersions {
+		hardwareVersions[ver.Version] = struct{}{}
+	}
+	for _, ver := range model.FirmwareVersions {
+		if ver.Version == "" {
+			v.report(errMissingField.WithAttributes("field", "firmwareVersions.version", "filename", filename))
+		}
+		for _, hwVersion := range ver.HardwareVersions {
+			if _, ok := hardwareVersions[hwVersion]; !ok {
+				v.report(errUnknownHardwareVersion.WithAttributes(
+					"hardware_version", hwVersion,
+					"filename", filename,
+				))
+			}
+		}
+		regions := make([]string, 0, len(ver.Profiles))
+		for region := range ver.Profiles {
+			regions = append(regions, region)
+		}
+		sort.Strings(regions)
+		for _, region := range regions {
+			profile := ver.Profiles[region]
+			if _, ok := regionToBandID[region]; !ok {
+				v.report(errUnknownRegion.WithAttributes("region", region, "filename", filename))
+			}
+			if profile.ID == "" {
+				v.report(errMissingField.WithAttributes("field", "profiles.id", "filename", filename))
+			} else {
+				profileVendorID := brandID
+				if profile.VendorID != "" {
+					profileVendorID = profile.VendorID
+				}
+				v.validateProfile(profileVendorID, profile.ID)
+			}
+			if profile.Codec != "" {
+				v.validateCodec(brandID, profile.Codec)
+			}
+		}
+	}
+}
+
+func (v *validator) validateProfile(brandID, profileID string) {
+	profile := store.EndDeviceProfile{}
+	first, ok := v.decode(&profile, "vendor", brandID, profileID+".yaml")
+	if !first || !ok {
+		return
+	}
+	if _, err := profile.ToTemplatePB(&ttnpb.EndDeviceVersionIdentifiers{}, nil); err != nil {
+		v.report(errInvalidDefinition.WithAttributes(
+			"filename", path.Join("vendor", brandID, profileID+".yaml"),
+		).WithCause(err))
+	}
+}
+
+func (v *validator) validateCodec(brandID, codecID string) {
+	codecs := EndDeviceCodecs{}
+	first, ok := v.decode(&codecs, "vendor", brandID, codecID+".yaml")
+	if !first || !ok {
+		return
+	}
+	for _, fileName := range []string{
+		codecs.UplinkDecoder.FileName,
+		codecs.DownlinkDecoder.FileName,
+		codecs.DownlinkEncoder.FileName,
+	} {
+		if fileName != "" {
+			v.decode(nil, "vendor", brandID, fileName)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	fetcher := fetch.FromFilesystem("testdata")
+
+	vendors, issues := remote.ValidateVendors(fetcher)
+	a.So(issues, should.BeEmpty)
+	a.So(vendors, should.HaveLength, 4)
+
+	a.So(remote.ValidateVendor(fetcher, "foo-vendor"), should.BeEmpty)
+	a.So(remote.ValidateVendor(fetcher, "foo-vendor", "dev1"), should.BeEmpty)
+
+	issues = remote.ValidateVendor(fetcher, "foo-vendor", "unknown")
+	if a.So(issues, should.HaveLength, 1) {
+		a.So(errors.IsNotFound(issues[0]), should.BeTrue)
+	}
+
+	// The codec of the wind sensor and the profile referenced by the vendor index are not defined.
+	issues = remote.ValidateVendor(fetcher, "windsensor-vendor")
+	if a.So(issues, should.HaveLength, 2) {
+		a.So(errors.IsNotFound(issues[0]), should.BeTrue)
+		a.So(errors.IsNotFound(issues[1]), should.BeTrue)
+	}
+
+	issues = remote.ValidateVendor(fetch.NewMemFetcher(map[string][]byte{
+		"vendor/test-vendor/index.yaml": []byte("endDevices:\n- dev\n- dev\n"),
+		"vendor/test-vendor/dev.yaml":   []byte("firmwareVersions: invalid\n"),
+	}), "test-vendor")
+	if a.So(issues, should.HaveLength, 2) {
+		a.So(errors.IsInvalidArgument(issues[0]), should.BeTrue)
+		a.So(errors.IsAlreadyExists(issues[1]), should.BeTrue)
+	}
+}
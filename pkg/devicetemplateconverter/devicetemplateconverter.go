@@ -45,10 +45,11 @@ var errNotFound = errors.DefineNotFound("converter", "converter `{id}` not found
 
 // New returns a new *DeviceTemplateConverter.
 func New(c *component.Component, conf *Config) (*DeviceTemplateConverter, error) {
-	// Always enable the TTS device template converters.
+	// Always enable the TTS and migration device template converters.
 	conf.Enabled = append(conf.Enabled,
 		devicetemplates.TTSJSON,
 		devicetemplates.TTSCSV,
+		devicetemplates.ChirpStackV4,
 	)
 	converters := make(map[string]devicetemplates.Converter, len(conf.Enabled))
 	for _, id := range conf.Enabled {
@@ -85,8 +85,9 @@ func TestConvertEndDeviceTemplate(t *testing.T) {
 			Name:        "Test",
 			Description: "Test",
 		},
-		devicetemplates.TTSJSON: devicetemplates.GetConverter(devicetemplates.TTSJSON).Format(),
-		devicetemplates.TTSCSV:  devicetemplates.GetConverter(devicetemplates.TTSCSV).Format(),
+		devicetemplates.TTSJSON:      devicetemplates.GetConverter(devicetemplates.TTSJSON).Format(),
+		devicetemplates.TTSCSV:       devicetemplates.GetConverter(devicetemplates.TTSCSV).Format(),
+		devicetemplates.ChirpStackV4: devicetemplates.GetConverter(devicetemplates.ChirpStackV4).Format(),
 	})
 
 	stream, err := client.Convert(ctx, &ttnpb.ConvertEndDeviceTemplateRequest{
@@ -0,0 +1,583 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"golang.org/x/net/html/charset"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ChirpStackV4 is the device template converter ID.
+const ChirpStackV4 = "chirpstack-v4"
+
+// chirpStackExport is a ChirpStack v4 export. The messages are the JSON representations of the ChirpStack v4 API
+// messages, so that an export can be composed from the responses of the ChirpStack v4 REST API:
+// the device profiles from `GET /api/device-profiles/{id}` and, for each device, the responses of
+// `GET /api/devices/{dev_eui}`, `GET /api/devices/{dev_eui}/keys` and `GET /api/devices/{dev_eui}/activation`.
+type chirpStackExport struct {
+	DeviceProfiles []chirpStackDeviceProfile `json:"deviceProfiles"`
+	Devices        []struct {
+		Device           chirpStackDevice            `json:"device"`
+		DeviceKeys       *chirpStackDeviceKeys       `json:"deviceKeys"`
+		DeviceActivation *chirpStackDeviceActivation `json:"deviceActivation"`
+	} `json:"devices"`
+}
+
+type chirpStackDeviceProfile struct {
+	ID                        string            `json:"id"`
+	Name                      string            `json:"name"`
+	Region                    string            `json:"region"`
+	MACVersion                string            `json:"macVersion"`
+	RegParamsRevision         string            `json:"regParamsRevision"`
+	SupportsOTAA              bool              `json:"supportsOtaa"`
+	SupportsClassB            bool              `json:"supportsClassB"`
+	SupportsClassC            bool              `json:"supportsClassC"`
+	ClassBTimeout             uint32            `json:"classBTimeout"`
+	ClassBPingSlotNbK         *uint32           `json:"classBPingSlotNbK"`
+	ClassBPingSlotPeriodicity *uint32           `json:"classBPingSlotPeriodicity"`
+	ClassBPingSlotDR          uint32            `json:"classBPingSlotDr"`
+	ClassBPingSlotFreq        uint64            `json:"classBPingSlotFreq"`
+	ClassCTimeout             uint32            `json:"classCTimeout"`
+	ABPRx1Delay               uint32            `json:"abpRx1Delay"`
+	ABPRx1DROffset            uint32            `json:"abpRx1DrOffset"`
+	ABPRx2DR                  uint32            `json:"abpRx2Dr"`
+	ABPRx2Freq                uint64            `json:"abpRx2Freq"`
+	DeviceStatusReqInterval   uint32            `json:"deviceStatusReqInterval"`
+	PayloadCodecRuntime       string            `json:"payloadCodecRuntime"`
+	PayloadCodecScript        string            `json:"payloadCodecScript"`
+	Tags                      map[string]string `json:"tags"`
+}
+
+type chirpStackDevice struct {
+	DevEUI          string            `json:"devEui"`
+	JoinEUI         string            `json:"joinEui"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	DeviceProfileID string            `json:"deviceProfileId"`
+	SkipFCntCheck   bool              `json:"skipFcntCheck"`
+	Tags            map[string]string `json:"tags"`
+	Variables       map[string]string `json:"variables"`
+}
+
+type chirpStackDeviceKeys struct {
+	NwkKey string `json:"nwkKey"`
+	AppKey string `json:"appKey"`
+}
+
+type chirpStackDeviceActivation struct {
+	DevAddr     string `json:"devAddr"`
+	AppSKey     string `json:"appSKey"`
+	NwkSEncKey  string `json:"nwkSEncKey"`
+	SNwkSIntKey string `json:"sNwkSIntKey"`
+	FNwkSIntKey string `json:"fNwkSIntKey"`
+	FCntUp      uint32 `json:"fCntUp"`
+	NFCntDown   uint32 `json:"nFCntDown"`
+	AFCntDown   uint32 `json:"aFCntDown"`
+}
+
+var chirpStackMACVersions = map[string]ttnpb.MACVersion{
+	"LORAWAN_1_0_0": ttnpb.MACVersion_MAC_V1_0,
+	"LORAWAN_1_0_1": ttnpb.MACVersion_MAC_V1_0_1,
+	"LORAWAN_1_0_2": ttnpb.MACVersion_MAC_V1_0_2,
+	"LORAWAN_1_0_3": ttnpb.MACVersion_MAC_V1_0_3,
+	"LORAWAN_1_0_4": ttnpb.MACVersion_MAC_V1_0_4,
+	"LORAWAN_1_1_0": ttnpb.MACVersion_MAC_V1_1,
+}
+
+// chirpStackPHYVersions maps the ChirpStack regional parameters revisions to PHY versions.
+// The revisions A and B depend on the MAC version.
+var chirpStackPHYVersions = map[string]map[ttnpb.MACVersion]ttnpb.PHYVersion{
+	"A": {
+		ttnpb.MACVersion_MAC_V1_0:   ttnpb.PHYVersion_TS001_V1_0,
+		ttnpb.MACVersion_MAC_V1_0_1: ttnpb.PHYVersion_TS001_V1_0_1,
+		ttnpb.MACVersion_MAC_V1_0_2: ttnpb.PHYVersion_RP001_V1_0_2,
+		ttnpb.MACVersion_MAC_V1_0_3: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+		ttnpb.MACVersion_MAC_V1_1:   ttnpb.PHYVersion_RP001_V1_1_REV_A,
+	},
+	"B": {
+		ttnpb.MACVersion_MAC_V1_0_2: ttnpb.PHYVersion_RP001_V1_0_2_REV_B,
+		ttnpb.MACVersion_MAC_V1_1:   ttnpb.PHYVersion_RP001_V1_1_REV_B,
+	},
+}
+
+var chirpStackRP002PHYVersions = map[string]ttnpb.PHYVersion{
+	"RP002_1_0_0": ttnpb.PHYVersion_RP002_V1_0_0,
+	"RP002_1_0_1": ttnpb.PHYVersion_RP002_V1_0_1,
+	"RP002_1_0_2": ttnpb.PHYVersion_RP002_V1_0_2,
+	"RP002_1_0_3": ttnpb.PHYVersion_RP002_V1_0_3,
+	"RP002_1_0_4": ttnpb.PHYVersion_RP002_V1_0_4,
+}
+
+// chirpStackFrequencyPlanIDs maps the ChirpStack regions to the frequency plan that matches the default
+// ChirpStack region configuration. Regions without an unambiguous frequency plan are not mapped.
+var chirpStackFrequencyPlanIDs = map[string]string{
+	"EU868": "EU_863_870",
+	"US915": "US_902_928_FSB_1",
+	"AU915": "AU_915_928_FSB_1",
+	"AS923": "AS_923_925",
+	"KR920": "KR_920_923_TTN",
+	"IN865": "IN_865_867",
+	"RU864": "RU_864_870_TTN",
+}
+
+var (
+	errChirpStackData          = errors.DefineInvalidArgument("chirpstack_data", "invalid ChirpStack data")
+	errChirpStackDeviceProfile = errors.DefineNotFound(
+		"chirpstack_device_profile",
+		"device profile `{device_profile_id}` of device `{dev_eui}` not found",
+	)
+	errChirpStackMACVersion = errors.DefineInvalidArgument(
+		"chirpstack_mac_version",
+		"unsupported MAC version `{mac_version}` in device profile `{device_profile_id}`",
+	)
+	errChirpStackRegParamsRevision = errors.DefineInvalidArgument(
+		"chirpstack_reg_params_revision",
+		"unsupported regional parameters revision `{revision}` in device profile `{device_profile_id}`",
+	)
+	errChirpStackCodecRuntime = errors.DefineInvalidArgument(
+		"chirpstack_codec_runtime",
+		"unsupported codec runtime `{runtime}` in device profile `{device_profile_id}`",
+	)
+	errChirpStackField = errors.DefineInvalidArgument(
+		"chirpstack_field",
+		"invalid field `{field}` of device `{dev_eui}`",
+	)
+)
+
+type chirpStack struct{}
+
+// Format implements the devicetemplates.Converter interface.
+func (*chirpStack) Format() *ttnpb.EndDeviceTemplateFormat {
+	return &ttnpb.EndDeviceTemplateFormat{
+		Name:           "ChirpStack v4",
+		Description:    "File containing end devices, device profiles, keys and activations exported from ChirpStack v4.",
+		FileExtensions: []string{".json"},
+	}
+}
+
+// Convert implements the devicetemplates.Converter interface.
+func (*chirpStack) Convert(_ context.Context, r io.Reader, f func(*ttnpb.EndDeviceTemplate) error) error {
+	r, err := charset.NewReader(r, "application/json")
+	if err != nil {
+		return err
+	}
+	var export chirpStackExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return errChirpStackData.WithCause(err)
+	}
+	profiles := make(map[string]*chirpStackDeviceProfile, len(export.DeviceProfiles))
+	for i := range export.DeviceProfiles {
+		profiles[export.DeviceProfiles[i].ID] = &export.DeviceProfiles[i]
+	}
+	for _, dev := range export.Devices {
+		profile, ok := profiles[dev.Device.DeviceProfileID]
+		if !ok {
+			return errChirpStackDeviceProfile.WithAttributes(
+				"device_profile_id", dev.Device.DeviceProfileID,
+				"dev_eui", dev.Device.DevEUI,
+			)
+		}
+		tmpl, err := convertChirpStackDevice(&dev.Device, profile, dev.DeviceKeys, dev.DeviceActivation)
+		if err != nil {
+			return err
+		}
+		if err := f(tmpl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chirpStackFields collects the end device and the field mask of a converted ChirpStack device.
+type chirpStackFields struct {
+	dev    *ttnpb.EndDevice
+	devEUI string
+	paths  []string
+	err    error
+}
+
+func (c *chirpStackFields) add(paths ...string) {
+	c.paths = ttnpb.AddFields(c.paths, paths...)
+}
+
+// parse parses the hex encoded value into dst. It returns false if the value is empty or invalid.
+func (c *chirpStackFields) parse(field, val string, dst encoding.TextUnmarshaler) bool {
+	if val == "" || c.err != nil {
+		return false
+	}
+	if err := dst.UnmarshalText([]byte(val)); err != nil {
+		c.err = errChirpStackField.WithCause(err).WithAttributes("field", field, "dev_eui", c.devEUI)
+		return false
+	}
+	return true
+}
+
+func (c *chirpStackFields) macSettings() *ttnpb.MACSettings {
+	if c.dev.MacSettings == nil {
+		c.dev.MacSettings = &ttnpb.MACSettings{}
+	}
+	return c.dev.MacSettings
+}
+
+func convertChirpStackDevice( //nolint:gocyclo
+	device *chirpStackDevice,
+	profile *chirpStackDeviceProfile,
+	keys *chirpStackDeviceKeys,
+	activation *chirpStackDeviceActivation,
+) (*ttnpb.EndDeviceTemplate, error) {
+	c := &chirpStackFields{
+		dev: &ttnpb.EndDevice{
+			Ids: &ttnpb.EndDeviceIdentifiers{},
+		},
+		devEUI: device.DevEUI,
+	}
+	dev := c.dev
+
+	var devEUI types.EUI64
+	if !c.parse("devEui", device.DevEUI, &devEUI) {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, errChirpStackField.WithAttributes("field", "devEui", "dev_eui", device.DevEUI)
+	}
+	dev.Ids.DevEui = devEUI.Bytes()
+	dev.Ids.DeviceId = fmt.Sprintf("eui-%s", strings.ToLower(devEUI.String()))
+	c.add("ids.dev_eui", "ids.device_id")
+	var joinEUI types.EUI64
+	if c.parse("joinEui", device.JoinEUI, &joinEUI) || profile.SupportsOTAA {
+		dev.Ids.JoinEui = joinEUI.Bytes()
+		c.add("ids.join_eui")
+	}
+	if device.Name != "" {
+		dev.Name = device.Name
+		c.add("name")
+	}
+	if device.Description != "" {
+		dev.Description = device.Description
+		c.add("description")
+	}
+	if attributes := chirpStackAttributes(profile.Tags, device.Variables, device.Tags); len(attributes) > 0 {
+		dev.Attributes = attributes
+		c.add("attributes")
+	}
+
+	macVersion, ok := chirpStackMACVersions[profile.MACVersion]
+	if !ok {
+		return nil, errChirpStackMACVersion.WithAttributes(
+			"mac_version", profile.MACVersion,
+			"device_profile_id", profile.ID,
+		)
+	}
+	phyVersion, ok := chirpStackRP002PHYVersions[profile.RegParamsRevision]
+	if !ok {
+		phyVersion, ok = chirpStackPHYVersions[profile.RegParamsRevision][macVersion]
+	}
+	if !ok {
+		return nil, errChirpStackRegParamsRevision.WithAttributes(
+			"revision", profile.RegParamsRevision,
+			"device_profile_id", profile.ID,
+		)
+	}
+	dev.LorawanVersion = macVersion
+	dev.LorawanPhyVersion = phyVersion
+	c.add("lorawan_version", "lorawan_phy_version")
+	if fpID, ok := chirpStackFrequencyPlanIDs[profile.Region]; ok {
+		dev.FrequencyPlanId = fpID
+		c.add("frequency_plan_id")
+	}
+
+	dev.SupportsJoin = profile.SupportsOTAA
+	dev.SupportsClassB = profile.SupportsClassB
+	dev.SupportsClassC = profile.SupportsClassC
+	c.add("supports_join", "supports_class_b", "supports_class_c")
+	if device.SkipFCntCheck {
+		c.macSettings().ResetsFCnt = &ttnpb.BoolValue{Value: true}
+		c.add("mac_settings.resets_f_cnt")
+	}
+	if profile.DeviceStatusReqInterval > 0 {
+		// The device status request interval is the number of requests per day.
+		periodicity := 24 * time.Hour / time.Duration(profile.DeviceStatusReqInterval)
+		c.macSettings().StatusTimePeriodicity = durationpb.New(periodicity)
+		c.add("mac_settings.status_time_periodicity")
+	}
+	if profile.SupportsClassB {
+		settings := c.macSettings()
+		if profile.ClassBTimeout > 0 {
+			settings.ClassBTimeout = durationpb.New(time.Duration(profile.ClassBTimeout) * time.Second)
+			c.add("mac_settings.class_b_timeout")
+		}
+		switch {
+		case profile.ClassBPingSlotPeriodicity != nil && *profile.ClassBPingSlotPeriodicity <= 7:
+			settings.PingSlotPeriodicity = &ttnpb.PingSlotPeriodValue{
+				Value: ttnpb.PingSlotPeriod(*profile.ClassBPingSlotPeriodicity),
+			}
+			c.add("mac_settings.ping_slot_periodicity")
+		case profile.ClassBPingSlotNbK != nil && *profile.ClassBPingSlotNbK <= 7:
+			// The number of ping slots per beacon period is 2^k, so the period is 2^(7-k) seconds.
+			settings.PingSlotPeriodicity = &ttnpb.PingSlotPeriodValue{
+				Value: ttnpb.PingSlotPeriod(7 - *profile.ClassBPingSlotNbK),
+			}
+			c.add("mac_settings.ping_slot_periodicity")
+		}
+		settings.PingSlotDataRateIndex = &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(profile.ClassBPingSlotDR)}
+		c.add("mac_settings.ping_slot_data_rate_index")
+		if profile.ClassBPingSlotFreq > 0 {
+			settings.PingSlotFrequency = &ttnpb.ZeroableFrequencyValue{Value: profile.ClassBPingSlotFreq}
+			c.add("mac_settings.ping_slot_frequency")
+		}
+	}
+	if profile.SupportsClassC && profile.ClassCTimeout > 0 {
+		c.macSettings().ClassCTimeout = durationpb.New(time.Duration(profile.ClassCTimeout) * time.Second)
+		c.add("mac_settings.class_c_timeout")
+	}
+	if !profile.SupportsOTAA {
+		settings := c.macSettings()
+		settings.Rx1Delay = &ttnpb.RxDelayValue{Value: ttnpb.RxDelay(profile.ABPRx1Delay)}
+		settings.Rx1DataRateOffset = &ttnpb.DataRateOffsetValue{Value: ttnpb.DataRateOffset(profile.ABPRx1DROffset)}
+		settings.Rx2DataRateIndex = &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(profile.ABPRx2DR)}
+		c.add(
+			"mac_settings.rx1_delay",
+			"mac_settings.rx1_data_rate_offset",
+			"mac_settings.rx2_data_rate_index",
+		)
+		if profile.ABPRx2Freq > 0 {
+			settings.Rx2Frequency = &ttnpb.FrequencyValue{Value: profile.ABPRx2Freq}
+			c.add("mac_settings.rx2_frequency")
+		}
+	}
+
+	if keys != nil && profile.SupportsOTAA {
+		convertChirpStackKeys(c, keys, macVersion)
+	}
+	if activation != nil && activation.DevAddr != "" {
+		if err := convertChirpStackActivation(c, activation, macVersion); err != nil {
+			return nil, err
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	switch profile.PayloadCodecRuntime {
+	case "", "NONE":
+	case "CAYENNE_LPP":
+		dev.Formatters = &ttnpb.MessagePayloadFormatters{
+			UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+		}
+		c.add("formatters.up_formatter", "formatters.down_formatter")
+	case "JS":
+		script := chirpStackFormatterScript(profile.PayloadCodecScript, device.Variables)
+		dev.Formatters = &ttnpb.MessagePayloadFormatters{
+			UpFormatter:            ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			UpFormatterParameter:   script,
+			DownFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			DownFormatterParameter: script,
+		}
+		c.add(
+			"formatters.up_formatter",
+			"formatters.up_formatter_parameter",
+			"formatters.down_formatter",
+			"formatters.down_formatter_parameter",
+		)
+	default:
+		return nil, errChirpStackCodecRuntime.WithAttributes(
+			"runtime", profile.PayloadCodecRuntime,
+			"device_profile_id", profile.ID,
+		)
+	}
+
+	return &ttnpb.EndDeviceTemplate{
+		EndDevice: dev,
+		FieldMask: ttnpb.FieldMask(c.paths...),
+	}, nil
+}
+
+// convertChirpStackKeys converts the root keys. ChirpStack stores the AppKey of LoRaWAN 1.0.x devices as NwkKey.
+func convertChirpStackKeys(c *chirpStackFields, keys *chirpStackDeviceKeys, macVersion ttnpb.MACVersion) {
+	var nwkKey, appKey types.AES128Key
+	hasNwkKey := c.parse("nwkKey", keys.NwkKey, &nwkKey)
+	hasAppKey := c.parse("appKey", keys.AppKey, &appKey)
+	if !macspec.UseNwkKey(macVersion) {
+		appKey, hasAppKey = nwkKey, hasNwkKey
+		hasNwkKey = false
+	}
+	if !hasNwkKey && !hasAppKey {
+		return
+	}
+	c.dev.RootKeys = &ttnpb.RootKeys{}
+	if hasAppKey {
+		c.dev.RootKeys.AppKey = &ttnpb.KeyEnvelope{Key: appKey.Bytes()}
+		c.add("root_keys.app_key.key")
+	}
+	if hasNwkKey {
+		c.dev.RootKeys.NwkKey = &ttnpb.KeyEnvelope{Key: nwkKey.Bytes()}
+		c.add("root_keys.nwk_key.key")
+	}
+}
+
+// convertChirpStackActivation converts the session. ChirpStack stores the NwkSKey of LoRaWAN 1.0.x devices in all
+// network session keys.
+func convertChirpStackActivation(
+	c *chirpStackFields, activation *chirpStackDeviceActivation, macVersion ttnpb.MACVersion,
+) error {
+	var devAddr types.DevAddr
+	if !c.parse("devAddr", activation.DevAddr, &devAddr) {
+		return c.err
+	}
+	skID, err := ulid.New(ulid.Now(), rand.Reader)
+	if err != nil {
+		return errGenerateSessionKeyID.WithCause(err)
+	}
+	session := &ttnpb.Session{
+		DevAddr:       devAddr.Bytes(),
+		Keys:          &ttnpb.SessionKeys{SessionKeyId: skID[:]},
+		LastFCntUp:    activation.FCntUp,
+		LastNFCntDown: activation.NFCntDown,
+		LastAFCntDown: activation.AFCntDown,
+	}
+	c.add(
+		"session.dev_addr",
+		"session.keys.session_key_id",
+		"session.last_f_cnt_up",
+		"session.last_n_f_cnt_down",
+		"session.last_a_f_cnt_down",
+	)
+	for _, key := range []struct {
+		field string
+		val   string
+		dst   **ttnpb.KeyEnvelope
+		path  string
+		skip  bool
+	}{
+		{"appSKey", activation.AppSKey, &session.Keys.AppSKey, "session.keys.app_s_key.key", false},
+		{"fNwkSIntKey", activation.FNwkSIntKey, &session.Keys.FNwkSIntKey, "session.keys.f_nwk_s_int_key.key", false},
+		{
+			"sNwkSIntKey", activation.SNwkSIntKey, &session.Keys.SNwkSIntKey, "session.keys.s_nwk_s_int_key.key",
+			!macspec.UseNwkKey(macVersion),
+		},
+		{
+			"nwkSEncKey", activation.NwkSEncKey, &session.Keys.NwkSEncKey, "session.keys.nwk_s_enc_key.key",
+			!macspec.UseNwkKey(macVersion),
+		},
+	} {
+		var k types.AES128Key
+		if key.skip || !c.parse(key.field, key.val, &k) {
+			continue
+		}
+		*key.dst = &ttnpb.KeyEnvelope{Key: k.Bytes()}
+		c.add(key.path)
+	}
+	c.dev.Session = session
+	return c.err
+}
+
+var (
+	chirpStackAttributeKeyInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+	chirpStackAttributeKeyRegex        = regexp.MustCompile(`^[a-z0-9](?:[-]?[a-z0-9]){2,}$`)
+)
+
+// chirpStackAttributes merges the tags and variables into end device attributes, in increasing order of precedence.
+// Keys are converted to the attribute key format. Keys that cannot be converted are omitted.
+func chirpStackAttributes(maps ...map[string]string) map[string]string {
+	var attributes map[string]string
+	for _, m := range maps {
+		for k, v := range m {
+			k = strings.Trim(chirpStackAttributeKeyInvalidChars.ReplaceAllString(strings.ToLower(k), "-"), "-")
+			if len(k) > 36 || !chirpStackAttributeKeyRegex.MatchString(k) {
+				continue
+			}
+			if attributes == nil {
+				attributes = make(map[string]string)
+			}
+			attributes[k] = v
+		}
+	}
+	return attributes
+}
+
+var (
+	chirpStackDecodeUplinkRegex   = regexp.MustCompile(`function\s+decodeUplink\s*\(`)
+	chirpStackEncodeDownlinkRegex = regexp.MustCompile(`function\s+encodeDownlink\s*\(`)
+)
+
+// chirpStackFormatterScript returns the JavaScript formatter for the ChirpStack v4 codec script.
+// ChirpStack v4 codecs implement decodeUplink and encodeDownlink like JavaScript formatters, but they may also use
+// the device variables in input.variables. If the device has variables, the codec is wrapped to provide them.
+func chirpStackFormatterScript(script string, variables map[string]string) string {
+	if len(variables) == 0 {
+		return script
+	}
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var vars strings.Builder
+	vars.WriteString("{")
+	for i, k := range keys {
+		if i > 0 {
+			vars.WriteString(",")
+		}
+		// Marshaling strings does not fail.
+		kb, _ := json.Marshal(k)
+		vb, _ := json.Marshal(variables[k])
+		fmt.Fprintf(&vars, "\n  %s: %s", kb, vb)
+	}
+	vars.WriteString("\n}")
+
+	var b strings.Builder
+	b.WriteString("// Device variables imported from ChirpStack.\n")
+	fmt.Fprintf(&b, "var chirpstackVariables = %s;\n\n", vars.String())
+	b.WriteString("var chirpstackCodec = (function () {\n")
+	b.WriteString(script)
+	b.WriteString("\n\n  return {\n")
+	b.WriteString("    decodeUplink: typeof decodeUplink === \"function\" ? decodeUplink : undefined,\n")
+	b.WriteString("    encodeDownlink: typeof encodeDownlink === \"function\" ? encodeDownlink : undefined,\n")
+	b.WriteString("  };\n})();\n")
+	if chirpStackDecodeUplinkRegex.MatchString(script) {
+		b.WriteString("\nfunction decodeUplink(input) {\n")
+		b.WriteString("  input.variables = chirpstackVariables;\n")
+		b.WriteString("  return chirpstackCodec.decodeUplink(input);\n")
+		b.WriteString("}\n")
+	}
+	if chirpStackEncodeDownlinkRegex.MatchString(script) {
+		b.WriteString("\nfunction encodeDownlink(input) {\n")
+		b.WriteString("  input.variables = chirpstackVariables;\n")
+		b.WriteString("  return chirpstackCodec.encodeDownlink(input);\n")
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func init() {
+	RegisterConverter(ChirpStackV4, &chirpStack{})
+}
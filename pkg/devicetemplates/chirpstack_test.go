@@ -0,0 +1,212 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	_ "embed"
+	"strings"
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/goproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+//go:embed testdata/chirpstack.json
+var chirpStackExport string
+
+func TestChirpStackV4(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	converter := GetConverter(ChirpStackV4)
+	if !a.So(converter, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	var templates []*ttnpb.EndDeviceTemplate
+	err := converter.Convert(ctx, strings.NewReader(chirpStackExport), func(tmpl *ttnpb.EndDeviceTemplate) error {
+		templates = append(templates, tmpl)
+		return nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	validateTemplates(t, templates, 3)
+
+	// OTAA LoRaWAN 1.0.3 device with JavaScript codec and variables.
+	otaa := templates[0].EndDevice
+	a.So(otaa.Ids, should.Resemble, &ttnpb.EndDeviceIdentifiers{
+		DeviceId: "eui-0004a30b001c0530",
+		DevEui:   types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30}.Bytes(),
+		JoinEui:  types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}.Bytes(),
+	})
+	a.So(otaa.Name, should.Equal, "Greenhouse")
+	a.So(otaa.Description, should.Equal, "Temperature in the greenhouse")
+	a.So(otaa.Attributes, should.Resemble, map[string]string{
+		"vendor":      "ACME Inc.",
+		"sensor-type": "temperature",
+		"location":    "greenhouse",
+		"scale":       "100",
+	})
+	a.So(otaa.FrequencyPlanId, should.Equal, "EU_863_870")
+	a.So(otaa.LorawanVersion, should.Equal, ttnpb.MACVersion_MAC_V1_0_3)
+	a.So(otaa.LorawanPhyVersion, should.Equal, ttnpb.PHYVersion_RP001_V1_0_3_REV_A)
+	a.So(otaa.SupportsJoin, should.BeTrue)
+	a.So(otaa.SupportsClassC, should.BeTrue)
+	a.So(otaa.MacSettings.ClassCTimeout.AsDuration(), should.Equal, 5*time.Second)
+	a.So(otaa.MacSettings.StatusTimePeriodicity.AsDuration(), should.Equal, 6*time.Hour)
+	a.So(otaa.RootKeys, should.Resemble, &ttnpb.RootKeys{
+		AppKey: &ttnpb.KeyEnvelope{
+			Key: types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(), //nolint:lll
+		},
+	})
+	a.So(otaa.Session, should.BeNil)
+	a.So(otaa.Formatters.UpFormatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT)
+
+	// The wrapped codec runs as a JavaScript formatter and gets the device variables.
+	uplink := &ttnpb.ApplicationUplink{
+		FPort:      1,
+		FrmPayload: []byte{0x08, 0x34},
+	}
+	err = javascript.New().DecodeUplink(ctx, otaa.Ids, nil, uplink, otaa.Formatters.UpFormatterParameter)
+	if a.So(err, should.BeNil) {
+		m, err := goproto.Map(uplink.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]any{
+			"temperature": 21.0,
+		})
+	}
+
+	// OTAA device without variables keeps the codec as is.
+	barn := templates[1].EndDevice
+	a.So(barn.Formatters.UpFormatterParameter, should.StartWith, "function decodeUplink(input) {")
+	a.So(barn.Formatters.UpFormatterParameter, should.NotContainSubstring, "chirpstackVariables")
+	a.So(barn.Ids.JoinEui, should.Resemble, types.EUI64{}.Bytes())
+	a.So(barn.Attributes, should.Resemble, map[string]string{
+		"vendor":      "ACME",
+		"sensor-type": "temperature",
+	})
+
+	// ABP LoRaWAN 1.1 class B device with Cayenne LPP.
+	abp := templates[2].EndDevice
+	a.So(abp.Ids.JoinEui, should.BeNil)
+	a.So(abp.FrequencyPlanId, should.Equal, "US_902_928_FSB_1")
+	a.So(abp.LorawanVersion, should.Equal, ttnpb.MACVersion_MAC_V1_1)
+	a.So(abp.LorawanPhyVersion, should.Equal, ttnpb.PHYVersion_RP002_V1_0_3)
+	a.So(abp.SupportsJoin, should.BeFalse)
+	a.So(abp.SupportsClassB, should.BeTrue)
+	a.So(abp.RootKeys, should.BeNil)
+	a.So(abp.MacSettings.ResetsFCnt.GetValue(), should.BeTrue)
+	a.So(abp.MacSettings.ClassBTimeout.AsDuration(), should.Equal, 10*time.Second)
+	a.So(abp.MacSettings.PingSlotPeriodicity.GetValue(), should.Equal, ttnpb.PingSlotPeriod_PING_EVERY_16S)
+	a.So(abp.MacSettings.PingSlotDataRateIndex.GetValue(), should.Equal, ttnpb.DataRateIndex_DATA_RATE_8)
+	a.So(abp.MacSettings.PingSlotFrequency.GetValue(), should.Equal, 923300000)
+	a.So(abp.MacSettings.Rx1Delay.GetValue(), should.Equal, ttnpb.RxDelay_RX_DELAY_1)
+	a.So(abp.MacSettings.Rx2DataRateIndex.GetValue(), should.Equal, ttnpb.DataRateIndex_DATA_RATE_8)
+	a.So(abp.MacSettings.Rx2Frequency.GetValue(), should.Equal, 923300000)
+	a.So(abp.Formatters, should.Resemble, &ttnpb.MessagePayloadFormatters{
+		UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+		DownFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+	})
+	if a.So(abp.Session, should.NotBeNil) {
+		a.So(abp.Session.DevAddr, should.Resemble, types.DevAddr{0x26, 0x0b, 0x12, 0x34}.Bytes())
+		a.So(abp.Session.LastFCntUp, should.Equal, 42)
+		a.So(abp.Session.LastNFCntDown, should.Equal, 7)
+		a.So(abp.Session.LastAFCntDown, should.Equal, 3)
+		a.So(abp.Session.Keys.SessionKeyId, should.NotBeEmpty)
+		a.So(abp.Session.Keys.AppSKey.GetKey(), should.Resemble, bytesOf(0x11))
+		a.So(abp.Session.Keys.NwkSEncKey.GetKey(), should.Resemble, bytesOf(0x22))
+		a.So(abp.Session.Keys.SNwkSIntKey.GetKey(), should.Resemble, bytesOf(0x33))
+		a.So(abp.Session.Keys.FNwkSIntKey.GetKey(), should.Resemble, bytesOf(0x44))
+	}
+}
+
+func TestChirpStackV4Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name      string
+		Data      string
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "InvalidJSON",
+			Data:      `{"devices": [`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "UnknownDeviceProfile",
+			Data:      `{"devices": [{"device": {"devEui": "0004a30b001c0530", "deviceProfileId": "unknown"}}]}`,
+			Assertion: errors.IsNotFound,
+		},
+		{
+			Name: "InvalidDevEUI",
+			Data: `{
+				"deviceProfiles": [{"id": "p", "macVersion": "LORAWAN_1_0_3", "regParamsRevision": "A"}],
+				"devices": [{"device": {"devEui": "0004a30b", "deviceProfileId": "p"}}]
+			}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnsupportedMACVersion",
+			Data: `{
+				"deviceProfiles": [{"id": "p", "macVersion": "LORAWAN_2_0_0", "regParamsRevision": "A"}],
+				"devices": [{"device": {"devEui": "0004a30b001c0530", "deviceProfileId": "p"}}]
+			}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnsupportedRegParamsRevision",
+			Data: `{
+				"deviceProfiles": [{"id": "p", "macVersion": "LORAWAN_1_0_3", "regParamsRevision": "B"}],
+				"devices": [{"device": {"devEui": "0004a30b001c0530", "deviceProfileId": "p"}}]
+			}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "InvalidKey",
+			Data: `{
+				"deviceProfiles": [{"id": "p", "macVersion": "LORAWAN_1_0_3", "regParamsRevision": "A", "supportsOtaa": true}],
+				"devices": [{"device": {"devEui": "0004a30b001c0530", "deviceProfileId": "p"}, "deviceKeys": {"nwkKey": "01"}}]
+			}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+			err := GetConverter(ChirpStackV4).Convert(ctx, strings.NewReader(tc.Data), func(*ttnpb.EndDeviceTemplate) error {
+				return nil
+			})
+			a.So(tc.Assertion(err), should.BeTrue)
+		})
+	}
+}
+
+func bytesOf(b byte) []byte {
+	var key types.AES128Key
+	for i := range key {
+		key[i] = b
+	}
+	return key.Bytes()
+}
@@ -0,0 +1,70 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func init() {
+	tmpl, err := email.NewTemplateFS(
+		fsys, "gateway_alert",
+		email.FSTemplate{
+			SubjectTemplate:      "Alert for your gateway \"{{ .GatewayIds.GatewayId }}\": {{ enumDesc .Condition }}",
+			HTMLTemplateBaseFile: "base.html.tmpl",
+			HTMLTemplateFile:     "gateway_alert.html.tmpl",
+			TextTemplateFile:     "gateway_alert.txt.tmpl",
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	email.RegisterTemplate(tmpl)
+	email.RegisterNotification("gateway_alert", &email.NotificationBuilder{
+		EmailTemplateName: "gateway_alert",
+		DataBuilder:       newGatewayAlertData,
+	})
+}
+
+func newGatewayAlertData(_ context.Context, data email.NotificationTemplateData) (email.NotificationTemplateData, error) {
+	var nData ttnpb.GatewayAlert
+	if err := data.Notification().GetData().UnmarshalTo(&nData); err != nil {
+		return nil, err
+	}
+	return &GatewayAlertData{
+		NotificationTemplateData: data,
+		GatewayAlert:             &nData,
+	}, nil
+}
+
+// GatewayAlertData is the data for the gateway_alert and gateway_alert_recovered emails.
+type GatewayAlertData struct {
+	email.NotificationTemplateData
+	*ttnpb.GatewayAlert
+}
+
+// ObservedDuration returns the observed duration, rounded to seconds.
+func (d *GatewayAlertData) ObservedDuration() time.Duration {
+	return d.GetObserved().AsDuration().Round(time.Second)
+}
+
+// ObservedTxFailurePercentage returns the observed downlink transmission failure ratio as percentage.
+func (d *GatewayAlertData) ObservedTxFailurePercentage() float32 {
+	return d.GetObservedTxFailureRatio() * 100
+}
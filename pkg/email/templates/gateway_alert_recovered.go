@@ -0,0 +1,37 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import "go.thethings.network/lorawan-stack/v3/pkg/email"
+
+func init() {
+	tmpl, err := email.NewTemplateFS(
+		fsys, "gateway_alert_recovered",
+		email.FSTemplate{
+			SubjectTemplate:      "Your gateway \"{{ .GatewayIds.GatewayId }}\" recovered: {{ enumDesc .Condition }}",
+			HTMLTemplateBaseFile: "base.html.tmpl",
+			HTMLTemplateFile:     "gateway_alert_recovered.html.tmpl",
+			TextTemplateFile:     "gateway_alert_recovered.txt.tmpl",
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	email.RegisterTemplate(tmpl)
+	email.RegisterNotification("gateway_alert_recovered", &email.NotificationBuilder{
+		EmailTemplateName: "gateway_alert_recovered",
+		DataBuilder:       newGatewayAlertData,
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -137,6 +138,9 @@ func TestNotificationEmailTemplates(t *testing.T) {
 	usrIDs := &ttnpb.UserIdentifiers{
 		UserId: "foo-usr",
 	}
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "foo-gtw",
+	}
 	now := timestamppb.Now()
 
 	for _, notification := range []*ttnpb.Notification{
@@ -326,6 +330,52 @@ func TestNotificationEmailTemplates(t *testing.T) {
 			SenderIds: usrIDs,
 		},
 
+		{
+			EntityIds:        gtwIDs.GetEntityIdentifiers(),
+			NotificationType: "gateway_alert",
+			Data: ttnpb.MustMarshalAny(&ttnpb.GatewayAlert{
+				GatewayIds: gtwIDs,
+				RuleIds: &ttnpb.GatewayAlertRuleIdentifiers{
+					EntityIds: gtwIDs.GetEntityIdentifiers(),
+					RuleId:    "offline",
+				},
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				RaisedAt:  now,
+				Observed:  durationpb.New(90 * time.Minute),
+			}),
+		},
+
+		{
+			Id:               "tx_failure_ratio",
+			EntityIds:        gtwIDs.GetEntityIdentifiers(),
+			NotificationType: "gateway_alert",
+			Data: ttnpb.MustMarshalAny(&ttnpb.GatewayAlert{
+				GatewayIds: gtwIDs,
+				RuleIds: &ttnpb.GatewayAlertRuleIdentifiers{
+					EntityIds: gtwIDs.GetEntityIdentifiers(),
+					RuleId:    "tx-failures",
+				},
+				Condition:              ttnpb.GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO,
+				RaisedAt:               now,
+				ObservedTxFailureRatio: 0.25,
+			}),
+		},
+
+		{
+			EntityIds:        gtwIDs.GetEntityIdentifiers(),
+			NotificationType: "gateway_alert_recovered",
+			Data: ttnpb.MustMarshalAny(&ttnpb.GatewayAlert{
+				GatewayIds: gtwIDs,
+				RuleIds: &ttnpb.GatewayAlertRuleIdentifiers{
+					EntityIds: gtwIDs.GetEntityIdentifiers(),
+					RuleId:    "offline",
+				},
+				Condition:   ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				RaisedAt:    now,
+				RecoveredAt: now,
+			}),
+		},
+
 		{
 			EntityIds:        usrIDs.GetEntityIdentifiers(),
 			NotificationType: "password_changed",
@@ -594,6 +594,19 @@ func NewStore(fetcher fetch.Interface) *Store {
 	}
 }
 
+// SetFetcher replaces the fetcher of the store and clears the cached frequency plans.
+func (s *Store) SetFetcher(fetcher fetch.Interface) {
+	s.frequencyPlansMu.Lock()
+	defer s.frequencyPlansMu.Unlock()
+	s.descriptionsMu.Lock()
+	defer s.descriptionsMu.Unlock()
+	s.Fetcher = fetcher
+	s.descriptionsCache = nil
+	s.descriptionsFetchError = nil
+	s.descriptionsFetchErrorTime = time.Time{}
+	s.frequencyPlansCache = map[string]queryResult{}
+}
+
 func (s *Store) fetchDescriptions() (frequencyPlanList, error) {
 	content, err := s.Fetcher.File("frequency-plans.yml")
 	if err != nil {
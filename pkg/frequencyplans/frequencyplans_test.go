@@ -80,6 +80,31 @@ func TestEmptyStore(t *testing.T) {
 	a.So(err, should.NotBeNil)
 }
 
+func TestStoreSetFetcher(t *testing.T) {
+	a := assertions.New(t)
+
+	store := frequencyplans.NewStore(fetch.NewMemFetcher(map[string][]byte{}))
+	_, err := store.GetAllIDs()
+	a.So(err, should.NotBeNil)
+
+	store.SetFetcher(fetch.NewMemFetcher(map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: AS_923
+  description: South East Asia
+  base-frequency: 915
+  file: AS_923.yml
+`),
+		"AS_923.yml": []byte(`band-id: AS_923
+uplink-channels:
+- frequency: 923000000
+`),
+	}))
+	ids, err := store.GetAllIDs()
+	a.So(err, should.BeNil)
+	a.So(ids, should.Resemble, []string{"AS_923"})
+	_, err = store.GetByID("AS_923")
+	a.So(err, should.BeNil)
+}
+
 func TestStore(t *testing.T) {
 	a := assertions.New(t)
 
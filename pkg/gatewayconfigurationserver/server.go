@@ -16,6 +16,7 @@ package gatewayconfigurationserver
 
 import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	bscups "go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver/managed"
@@ -33,6 +34,7 @@ type Server struct {
 	config *Config
 
 	managedServer *managed.Server
+	cupsServer    *bscups.Server
 }
 
 // Roles returns the roles that the Gateway Configuration Server fulfills.
@@ -43,6 +45,7 @@ func (*Server) Roles() []ttnpb.ClusterRole {
 // RegisterServices registers services provided by gcs at s.
 func (s *Server) RegisterServices(grpcServer *grpc.Server) {
 	ttnpb.RegisterGatewayConfigurationServiceServer(grpcServer, s)
+	s.cupsServer.RegisterServices(grpcServer)
 	if s.managedServer != nil {
 		s.managedServer.RegisterServices(grpcServer)
 	}
@@ -53,6 +56,7 @@ func (s *Server) RegisterServices(grpcServer *grpc.Server) {
 //nolint:errcheck
 func (s *Server) RegisterHandlers(mux *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterGatewayConfigurationServiceHandler(s.Context(), mux, conn)
+	s.cupsServer.RegisterHandlers(mux, conn)
 	if s.managedServer != nil {
 		s.managedServer.RegisterHandlers(mux, conn)
 	}
@@ -65,17 +69,20 @@ func New(c *component.Component, conf *Config) (*Server, error) {
 		config:    conf,
 	}
 
-	bsCUPS := conf.BasicStation.NewServer(c)
-	_ = bsCUPS
+	var err error
+	gcs.cupsServer, err = conf.BasicStation.NewServer(c)
+	if err != nil {
+		return nil, err
+	}
 
 	ttkgServer := ttkg.New(c, ttkg.WithConfig(conf.TheThingsKickstarterGateway))
 	_ = ttkgServer
 
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayConfigurationService", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayconfigurationserver")) //nolint:lll
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayConfigurationService", cluster.HookName, c.ClusterAuthUnaryHook())
+	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayFirmwareRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayconfigurationserver/cups")) //nolint:lll
 
 	if ttgcConf := c.GetBaseConfig(c.Context()).TTGC; ttgcConf.Enabled {
-		var err error
 		gcs.managedServer, err = managed.New(c.Context(), c, ttgcConf)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,391 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	gatewayAlertNotificationType          = "gateway_alert"
+	gatewayAlertRecoveredNotificationType = "gateway_alert_recovered"
+
+	evaluateGatewayAlertsTaskName = "evaluate_gateway_alerts"
+)
+
+var (
+	evtGatewayAlertRaise = events.Define(
+		"gs.gateway.alert.raise", "raise gateway alert",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
+	evtGatewayAlertRecover = events.Define(
+		"gs.gateway.alert.recover", "recover gateway alert",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
+)
+
+// GatewayAlertRegistry stores the alert rules of gateways and organizations, and the alerts that they raised.
+type GatewayAlertRegistry interface {
+	// GetRule returns the alert rule identified by ids.
+	GetRule(ctx context.Context, ids *ttnpb.GatewayAlertRuleIdentifiers) (*ttnpb.GatewayAlertRule, error)
+	// ListRules returns the alert rules of the gateway or organization.
+	ListRules(ctx context.Context, ids *ttnpb.EntityIdentifiers) ([]*ttnpb.GatewayAlertRule, error)
+	// RangeRules calls f for all alert rules, until f returns false.
+	RangeRules(ctx context.Context, f func(context.Context, *ttnpb.GatewayAlertRule) bool) error
+	// SetRule creates, updates or deletes the alert rule identified by ids.
+	// The stored rule is nil if it does not exist. The rule and its alerts are deleted if f returns nil.
+	SetRule(
+		ctx context.Context,
+		ids *ttnpb.GatewayAlertRuleIdentifiers,
+		f func(*ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error),
+	) (*ttnpb.GatewayAlertRule, error)
+	// RaiseAlert stores the alert, unless the rule already raised an alert for the gateway.
+	// It returns whether the alert was stored.
+	RaiseAlert(ctx context.Context, alert *ttnpb.GatewayAlert) (bool, error)
+	// RecoverAlert removes the alert that the rule raised for the gateway.
+	// It returns the removed alert, or nil if the rule did not raise an alert for the gateway.
+	RecoverAlert(
+		ctx context.Context, gtwIDs *ttnpb.GatewayIdentifiers, ruleIDs *ttnpb.GatewayAlertRuleIdentifiers,
+	) (*ttnpb.GatewayAlert, error)
+	// RetainAlerts removes the alerts that the rule raised for gateways other than the given gateways.
+	RetainAlerts(
+		ctx context.Context, ruleIDs *ttnpb.GatewayAlertRuleIdentifiers, gtwIDs []*ttnpb.GatewayIdentifiers,
+	) error
+	// ListAlerts returns the alerts of the gateway.
+	ListAlerts(ctx context.Context, ids *ttnpb.GatewayIdentifiers) ([]*ttnpb.GatewayAlert, error)
+}
+
+// gatewayAlertRuleIdentifiers returns the identifiers of the rule.
+func gatewayAlertRuleIdentifiers(rule *ttnpb.GatewayAlertRule) *ttnpb.GatewayAlertRuleIdentifiers {
+	return &ttnpb.GatewayAlertRuleIdentifiers{
+		EntityIds: rule.EntityIds,
+		RuleId:    rule.RuleId,
+	}
+}
+
+// gatewayConnected returns whether the gateway with the given connection stats is connected.
+func gatewayConnected(stats *ttnpb.GatewayConnectionStats) bool {
+	if stats == nil || stats.ConnectedAt == nil {
+		return false
+	}
+	return stats.DisconnectedAt == nil || stats.DisconnectedAt.AsTime().Before(stats.ConnectedAt.AsTime())
+}
+
+// gatewayLastSeen returns the time at which the gateway was last seen by a Gateway Server, which is the latest of the
+// times that the gateway connected, disconnected, and sent a status message, uplink message or transmission
+// acknowledgment. It returns the zero time if it is unknown whether the gateway connected.
+func gatewayLastSeen(stats *ttnpb.GatewayConnectionStats) time.Time {
+	if stats.GetConnectedAt() == nil && stats.GetDisconnectedAt() == nil {
+		return time.Time{}
+	}
+	var lastSeen time.Time
+	for _, t := range []*timestamppb.Timestamp{
+		stats.ConnectedAt,
+		stats.DisconnectedAt,
+		stats.LastStatusReceivedAt,
+		stats.LastUplinkReceivedAt,
+		stats.LastTxAcknowledgmentReceivedAt,
+	} {
+		if t != nil && t.AsTime().After(lastSeen) {
+			lastSeen = t.AsTime()
+		}
+	}
+	return lastSeen
+}
+
+// evaluateGatewayAlertRule evaluates the rule for a gateway with the given connection stats, which are nil if the
+// stats of the gateway are unknown. It returns the alert if the gateway meets the condition, and false if the condition
+// cannot be evaluated, for example because it requires the gateway to be connected, or because it is unknown since when
+// the gateway is disconnected.
+func evaluateGatewayAlertRule(
+	rule *ttnpb.GatewayAlertRule, stats *ttnpb.GatewayConnectionStats, now time.Time,
+) (alert *ttnpb.GatewayAlert, ok bool) {
+	threshold := rule.Threshold.AsDuration()
+	connected := gatewayConnected(stats)
+	newAlert := func() *ttnpb.GatewayAlert {
+		return &ttnpb.GatewayAlert{
+			RuleIds:   gatewayAlertRuleIdentifiers(rule),
+			Condition: rule.Condition,
+			Stats:     stats,
+		}
+	}
+	switch rule.Condition {
+	case ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE:
+		// NOTE: Gateways that appear connected are also offline when they are not seen, as the stats of a gateway remain
+		// connected until they expire if the Gateway Server to which the gateway was connected stops unexpectedly.
+		lastSeen := gatewayLastSeen(stats)
+		if lastSeen.IsZero() {
+			return nil, false
+		}
+		offline := now.Sub(lastSeen)
+		if offline <= threshold {
+			return nil, true
+		}
+		alert = newAlert()
+		alert.Observed = durationpb.New(offline)
+		return alert, true
+
+	case ttnpb.GatewayAlertCondition_GATEWAY_ALERT_NO_UPLINKS:
+		if !connected {
+			return nil, false
+		}
+		since := stats.ConnectedAt.AsTime()
+		if stats.LastUplinkReceivedAt != nil && stats.LastUplinkReceivedAt.AsTime().After(since) {
+			since = stats.LastUplinkReceivedAt.AsTime()
+		}
+		silent := now.Sub(since)
+		if silent <= threshold {
+			return nil, true
+		}
+		alert = newAlert()
+		alert.Observed = durationpb.New(silent)
+		return alert, true
+
+	case ttnpb.GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO:
+		if !connected {
+			return nil, false
+		}
+		min := rule.MinTxAcknowledgments
+		if min == 0 {
+			min = 1
+		}
+		if stats.TxAcknowledgmentCount < min {
+			return nil, false
+		}
+		ratio := float32(stats.TxAcknowledgmentFailureCount) / float32(stats.TxAcknowledgmentCount)
+		if ratio <= rule.TxFailureRatio {
+			return nil, true
+		}
+		alert = newAlert()
+		alert.ObservedTxFailureRatio = ratio
+		return alert, true
+
+	case ttnpb.GatewayAlertCondition_GATEWAY_ALERT_CLOCK_DRIFT:
+		if !connected || stats.LastStatusReceivedAt == nil || stats.LastStatus.GetTime() == nil {
+			return nil, false
+		}
+		drift := stats.LastStatusReceivedAt.AsTime().Sub(stats.LastStatus.Time.AsTime())
+		if drift < 0 {
+			drift = -drift
+		}
+		if drift <= threshold {
+			return nil, true
+		}
+		alert = newAlert()
+		alert.Observed = durationpb.New(drift)
+		return alert, true
+
+	default:
+		return nil, false
+	}
+}
+
+// gatewayAlertStats returns the connection stats of the gateway from the connection stats registry, which is shared
+// by the Gateway Server instances. It returns nil if the registry has no stats of the gateway.
+func (gs *GatewayServer) gatewayAlertStats(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayConnectionStats, error) {
+	stats, err := gs.statsRegistry.Get(ctx, ids)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return stats, err
+}
+
+// gatewayAlertRuleGateways returns the gateways to which the rule applies.
+// The gateways of organizations are resolved when the rules are evaluated, so that the rules apply to the gateways
+// that are added to the organization later. The gateways of each organization are listed once per evaluation.
+func (gs *GatewayServer) gatewayAlertRuleGateways(
+	ctx context.Context, rule *ttnpb.GatewayAlertRule, orgGateways map[string][]*ttnpb.GatewayIdentifiers,
+) ([]*ttnpb.GatewayIdentifiers, error) {
+	switch ids := rule.EntityIds.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_GatewayIds:
+		return []*ttnpb.GatewayIdentifiers{ids.GatewayIds}, nil
+	case *ttnpb.EntityIdentifiers_OrganizationIds:
+		uid := unique.ID(ctx, ids.OrganizationIds)
+		if gtwIDs, ok := orgGateways[uid]; ok {
+			return gtwIDs, nil
+		}
+		gtwIDs, err := gs.listOrganizationGateways(ctx, ids.OrganizationIds)
+		if err != nil {
+			return nil, err
+		}
+		orgGateways[uid] = gtwIDs
+		return gtwIDs, nil
+	default:
+		return nil, nil
+	}
+}
+
+// evaluateGatewayAlerts evaluates all alert rules, raises alerts of gateways that meet the condition and recovers
+// alerts of gateways that no longer meet the condition.
+//
+// The alerts are stored in the registry, so that each alert is raised and recovered only once when multiple Gateway
+// Server instances evaluate the rules.
+func (gs *GatewayServer) evaluateGatewayAlerts(ctx context.Context) error {
+	registry := gs.config.Alerts.Registry
+	now := time.Now()
+	orgGateways := make(map[string][]*ttnpb.GatewayIdentifiers)
+	return registry.RangeRules(ctx, func(ctx context.Context, rule *ttnpb.GatewayAlertRule) bool {
+		if rule.Disabled {
+			return true
+		}
+		ruleIDs := gatewayAlertRuleIdentifiers(rule)
+		ruleLogger := log.FromContext(ctx).WithField("rule_id", rule.RuleId)
+		gateways, err := gs.gatewayAlertRuleGateways(ctx, rule, orgGateways)
+		if err != nil {
+			ruleLogger.WithError(err).Warn("Failed to list gateways of alert rule")
+			return true
+		}
+		// Remove the alerts of the gateways that are no longer in the organization.
+		if err := registry.RetainAlerts(ctx, ruleIDs, gateways); err != nil {
+			ruleLogger.WithError(err).Warn("Failed to remove gateway alerts")
+		}
+		for _, gtwIDs := range gateways {
+			logger := ruleLogger.WithField("gateway_uid", unique.ID(ctx, gtwIDs))
+			stats, err := gs.gatewayAlertStats(ctx, gtwIDs)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to get gateway connection stats")
+				continue
+			}
+			alert, ok := evaluateGatewayAlertRule(rule, stats, now)
+			if !ok {
+				continue
+			}
+			if alert != nil {
+				alert.GatewayIds = gtwIDs
+				alert.RaisedAt = timestamppb.New(now)
+				raised, err := registry.RaiseAlert(ctx, alert)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to raise gateway alert")
+					continue
+				}
+				if raised {
+					logger.WithField("condition", rule.Condition).Info("Raised gateway alert")
+					events.Publish(evtGatewayAlertRaise.NewWithIdentifiersAndData(ctx, gtwIDs, alert))
+					gs.notifyGatewayAlert(ctx, rule, alert, gatewayAlertNotificationType)
+				}
+				continue
+			}
+			recovered, err := registry.RecoverAlert(ctx, gtwIDs, ruleIDs)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to recover gateway alert")
+				continue
+			}
+			if recovered != nil {
+				recovered.RecoveredAt = timestamppb.New(now)
+				recovered.Stats = stats
+				logger.WithField("condition", rule.Condition).Info("Recovered gateway alert")
+				events.Publish(evtGatewayAlertRecover.NewWithIdentifiersAndData(ctx, gtwIDs, recovered))
+				gs.notifyGatewayAlert(ctx, rule, recovered, gatewayAlertRecoveredNotificationType)
+			}
+		}
+		return true
+	})
+}
+
+// runGatewayAlerts evaluates the alert rules at the configured interval.
+func (gs *GatewayServer) runGatewayAlerts(ctx context.Context) error {
+	ticker := time.NewTicker(gs.config.Alerts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := gs.evaluateGatewayAlerts(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to evaluate gateway alerts")
+			}
+		}
+	}
+}
+
+// notifyGatewayAlert creates a notification of the alert in the Identity Server.
+func (gs *GatewayServer) notifyGatewayAlert(
+	ctx context.Context, rule *ttnpb.GatewayAlertRule, alert *ttnpb.GatewayAlert, notificationType string,
+) {
+	logger := log.FromContext(ctx)
+	data, err := anypb.New(alert)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal gateway alert")
+		return
+	}
+	receivers := rule.Receivers
+	if len(receivers) == 0 {
+		receivers = []ttnpb.NotificationReceiver{
+			ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_COLLABORATOR,
+			ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_TECHNICAL_CONTACT,
+		}
+	}
+	cc, err := gs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get Identity Server peer")
+		return
+	}
+	if _, err := ttnpb.NewNotificationServiceClient(cc).Create(ctx, &ttnpb.CreateNotificationRequest{
+		EntityIds:        alert.GatewayIds.GetEntityIdentifiers(),
+		NotificationType: notificationType,
+		Data:             data,
+		Receivers:        receivers,
+		Email:            rule.Email,
+	}, gs.WithClusterAuth()); err != nil {
+		logger.WithError(err).Warn("Failed to create gateway alert notification")
+	}
+}
+
+// listOrganizationGatewaysLimit is the page size when listing the gateways of an organization.
+const listOrganizationGatewaysLimit = 1000
+
+// listOrganizationGateways returns the identifiers of the gateways of the organization.
+func (gs *GatewayServer) listOrganizationGateways(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers,
+) ([]*ttnpb.GatewayIdentifiers, error) {
+	cc, err := gs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	callOpt := gs.WithClusterAuth()
+	cl := ttnpb.NewGatewayRegistryClient(cc)
+	var res []*ttnpb.GatewayIdentifiers
+	for page := uint32(1); ; page++ {
+		gtws, err := cl.List(ctx, &ttnpb.ListGatewaysRequest{
+			Collaborator: ids.GetOrganizationOrUserIdentifiers(),
+			FieldMask:    ttnpb.FieldMask("ids"),
+			Limit:        listOrganizationGatewaysLimit,
+			Page:         page,
+		}, callOpt)
+		if err != nil {
+			return nil, err
+		}
+		for _, gtw := range gtws.Gateways {
+			res = append(res, gtw.Ids)
+		}
+		if len(gtws.Gateways) < listOrganizationGatewaysLimit {
+			return res, nil
+		}
+	}
+}
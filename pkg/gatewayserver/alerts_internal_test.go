@@ -0,0 +1,261 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEvaluateGatewayAlertRule(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1700000000, 0)
+	ago := func(d time.Duration) *timestamppb.Timestamp { return timestamppb.New(now.Add(-d)) }
+	connected := &ttnpb.GatewayConnectionStats{
+		ConnectedAt:                  ago(3 * time.Hour),
+		LastUplinkReceivedAt:         ago(2 * time.Hour),
+		LastStatusReceivedAt:         ago(time.Minute),
+		LastStatus:                   &ttnpb.GatewayStatus{Time: ago(time.Minute + 10*time.Second)},
+		TxAcknowledgmentCount:        10,
+		TxAcknowledgmentFailureCount: 4,
+	}
+	disconnected := &ttnpb.GatewayConnectionStats{
+		ConnectedAt:    ago(3 * time.Hour),
+		DisconnectedAt: ago(time.Hour),
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Rule     *ttnpb.GatewayAlertRule
+		Stats    *ttnpb.GatewayConnectionStats
+		Alert    bool
+		Observed time.Duration
+		OK       bool
+	}{
+		{
+			Name: "OfflineConnected",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(10 * time.Minute),
+			},
+			Stats: connected,
+			OK:    true,
+		},
+		{
+			Name: "OfflineConnectedNotSeen",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(time.Hour),
+			},
+			Stats: &ttnpb.GatewayConnectionStats{
+				ConnectedAt:          ago(3 * time.Hour),
+				LastUplinkReceivedAt: ago(2 * time.Hour),
+			},
+			Alert:    true,
+			Observed: 2 * time.Hour,
+			OK:       true,
+		},
+		{
+			Name: "OfflineDisconnected",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(10 * time.Minute),
+			},
+			Stats:    disconnected,
+			Alert:    true,
+			Observed: time.Hour,
+			OK:       true,
+		},
+		{
+			Name: "OfflineBelowThreshold",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(2 * time.Hour),
+			},
+			Stats: disconnected,
+			OK:    true,
+		},
+		{
+			Name: "OfflineNoStats",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(10 * time.Minute),
+			},
+		},
+		{
+			Name: "OfflineNotConnected",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+			},
+			Stats: &ttnpb.GatewayConnectionStats{
+				LastUplinkReceivedAt: ago(time.Hour),
+			},
+		},
+		{
+			Name: "NoUplinks",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_NO_UPLINKS,
+				Threshold: durationpb.New(time.Hour),
+			},
+			Stats:    connected,
+			Alert:    true,
+			Observed: 2 * time.Hour,
+			OK:       true,
+		},
+		{
+			Name: "NoUplinksDisconnected",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_NO_UPLINKS,
+				Threshold: durationpb.New(time.Hour),
+			},
+			Stats: disconnected,
+		},
+		{
+			Name: "TxFailureRatio",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition:      ttnpb.GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO,
+				TxFailureRatio: 0.3,
+			},
+			Stats: connected,
+			Alert: true,
+			OK:    true,
+		},
+		{
+			Name: "TxFailureRatioTooFewAcknowledgments",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition:            ttnpb.GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO,
+				TxFailureRatio:       0.3,
+				MinTxAcknowledgments: 20,
+			},
+			Stats: connected,
+		},
+		{
+			Name: "ClockDrift",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_CLOCK_DRIFT,
+				Threshold: durationpb.New(5 * time.Second),
+			},
+			Stats:    connected,
+			Alert:    true,
+			Observed: 10 * time.Second,
+			OK:       true,
+		},
+		{
+			Name: "ClockDriftBelowThreshold",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_CLOCK_DRIFT,
+				Threshold: durationpb.New(time.Minute),
+			},
+			Stats: connected,
+			OK:    true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(_ context.Context, _ *testing.T, a *assertions.Assertion) {
+				alert, ok := evaluateGatewayAlertRule(tc.Rule, tc.Stats, now)
+				a.So(ok, should.Equal, tc.OK)
+				if !tc.Alert {
+					a.So(alert, should.BeNil)
+					return
+				}
+				if !a.So(alert, should.NotBeNil) {
+					return
+				}
+				a.So(alert.Condition, should.Equal, tc.Rule.Condition)
+				a.So(alert.GetObserved().AsDuration(), should.Equal, tc.Observed)
+			},
+		})
+	}
+}
+
+func TestValidateGatewayAlertRule(t *testing.T) {
+	t.Parallel()
+	s := &gsGatewayAlerts{
+		gs: &GatewayServer{
+			config: &Config{
+				ConnectionStatsTTL:           12 * time.Hour,
+				ConnectionStatsDisconnectTTL: 48 * time.Hour,
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name  string
+		Rule  *ttnpb.GatewayAlertRule
+		Valid bool
+	}{
+		{
+			Name: "Offline",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(time.Hour),
+			},
+			Valid: true,
+		},
+		{
+			Name: "OfflineNoThreshold",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+			},
+		},
+		{
+			Name: "OfflineExceedsConnectionStatsTTL",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+				Threshold: durationpb.New(24 * time.Hour),
+			},
+		},
+		{
+			Name: "NoUplinks",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_NO_UPLINKS,
+				Threshold: durationpb.New(24 * time.Hour),
+			},
+			Valid: true,
+		},
+		{
+			Name: "TxFailureRatioNoThreshold",
+			Rule: &ttnpb.GatewayAlertRule{
+				Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(_ context.Context, _ *testing.T, a *assertions.Assertion) {
+				err := s.validateRule(tc.Rule)
+				if tc.Valid {
+					a.So(err, should.BeNil)
+				} else {
+					a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				}
+			},
+		})
+	}
+}
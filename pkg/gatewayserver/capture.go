@@ -0,0 +1,239 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/pcap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// captureBufferSize is the number of captured packets that are buffered per subscription.
+const captureBufferSize = 256
+
+// captureSubscription is a subscription to the captured packets of gateways.
+type captureSubscription struct {
+	ch      chan *ttnpb.GatewayCapturePacket
+	dropped uint64
+}
+
+// captureHub distributes captured packets to the subscriptions of the gateways.
+type captureHub struct {
+	mu   sync.RWMutex
+	subs map[string]map[*captureSubscription]struct{}
+}
+
+// subscribe subscribes to the captured packets of the gateways with the given unique IDs.
+// The returned function must be called to unsubscribe.
+func (h *captureHub) subscribe(uids []string) (*captureSubscription, func()) {
+	sub := &captureSubscription{
+		ch: make(chan *ttnpb.GatewayCapturePacket, captureBufferSize),
+	}
+	h.mu.Lock()
+	if h.subs == nil {
+		h.subs = make(map[string]map[*captureSubscription]struct{})
+	}
+	for _, uid := range uids {
+		if h.subs[uid] == nil {
+			h.subs[uid] = make(map[*captureSubscription]struct{})
+		}
+		h.subs[uid][sub] = struct{}{}
+	}
+	h.mu.Unlock()
+	return sub, func() {
+		h.mu.Lock()
+		for _, uid := range uids {
+			delete(h.subs[uid], sub)
+			if len(h.subs[uid]) == 0 {
+				delete(h.subs, uid)
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// active returns whether there are subscriptions to the captured packets of the gateway.
+func (h *captureHub) active(uid string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subs[uid]) > 0
+}
+
+// publish publishes the captured packet to the subscriptions of the gateway.
+// Packets are dropped for subscriptions that do not keep up.
+func (h *captureHub) publish(uid string, pkt *ttnpb.GatewayCapturePacket) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs[uid] {
+		select {
+		case sub.ch <- pkt:
+		default:
+			atomic.AddUint64(&sub.dropped, 1)
+		}
+	}
+}
+
+// codingRateDenominator returns the denominator of the LoRa coding rate, i.e. 5 for 4/5.
+func codingRateDenominator(cr string) uint8 {
+	s, ok := strings.CutPrefix(cr, "4/")
+	if !ok {
+		return 0
+	}
+	s, _, _ = strings.Cut(s, "LI")
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0
+	}
+	return uint8(n)
+}
+
+// loRaTapHeader returns the LoRaTap header of a frame transmitted with the given data rate and frequency.
+// This function returns false if the modulation cannot be represented in LoRaTap.
+func loRaTapHeader(
+	gtwIDs *ttnpb.GatewayIdentifiers, dr *ttnpb.DataRate, frequency uint64, typ ttnpb.GatewayCapturePacketType,
+) (pcap.LoRaTapHeader, bool) {
+	hdr := pcap.LoRaTapHeader{
+		Frequency: uint32(frequency),
+		SyncWord:  pcap.LoRaTapSyncWordPublic,
+		Tag:       uint16(typ) + 1,
+	}
+	if eui := gtwIDs.GetEui(); len(eui) == 8 {
+		hdr.SourceGateway = binary.BigEndian.Uint64(eui)
+	}
+	switch mod := dr.GetModulation().(type) {
+	case *ttnpb.DataRate_Lora:
+		hdr.Bandwidth = mod.Lora.Bandwidth
+		hdr.SpreadingFactor = uint8(mod.Lora.SpreadingFactor)
+		hdr.CodingRate = codingRateDenominator(mod.Lora.CodingRate)
+	case *ttnpb.DataRate_Fsk:
+		hdr.Flags |= pcap.LoRaTapFlagFSK
+		hdr.DataRate = uint16(mod.Fsk.BitRate)
+	default:
+		return pcap.LoRaTapHeader{}, false
+	}
+	return hdr, true
+}
+
+// downlinkLoRaTapHeader returns the LoRaTap header of a downlink message transmitted with the given settings.
+func downlinkLoRaTapHeader(
+	gtwIDs *ttnpb.GatewayIdentifiers, settings *ttnpb.TxSettings, typ ttnpb.GatewayCapturePacketType,
+) (pcap.LoRaTapHeader, bool) {
+	if settings == nil {
+		return pcap.LoRaTapHeader{}, false
+	}
+	hdr, ok := loRaTapHeader(gtwIDs, settings.DataRate, settings.Frequency, typ)
+	if !ok {
+		return pcap.LoRaTapHeader{}, false
+	}
+	hdr.Timestamp = settings.Timestamp
+	hdr.RFChain = uint8(settings.GetDownlink().GetAntennaIndex())
+	if settings.GetDownlink().GetInvertPolarization() {
+		hdr.Flags |= pcap.LoRaTapFlagIQInverted
+	}
+	if !settings.EnableCrc {
+		hdr.Flags |= pcap.LoRaTapFlagNoCRC
+	}
+	return hdr, true
+}
+
+// captureUplink captures the uplink message received by the gateway.
+func (gs *GatewayServer) captureUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.GatewayUplinkMessage) {
+	uid := unique.ID(ctx, gtw.GetIds())
+	if !gs.captures.active(uid) {
+		return
+	}
+	up := msg.GetMessage()
+	hdr, ok := loRaTapHeader(gtw.GetIds(), up.GetSettings().GetDataRate(), up.GetSettings().GetFrequency(),
+		ttnpb.GatewayCapturePacketType_GATEWAY_CAPTURE_UPLINK)
+	if !ok {
+		return
+	}
+	if mds := up.GetRxMetadata(); len(mds) > 0 {
+		md := mds[0]
+		hdr.RSSI = md.Rssi
+		hdr.ChannelRSSI = md.ChannelRssi
+		hdr.SNR = md.Snr
+		hdr.Timestamp = md.Timestamp
+		hdr.IFChannel = uint8(md.ChannelIndex)
+		hdr.RFChain = uint8(md.AntennaIndex)
+	}
+	switch crc := up.GetCrcStatus(); {
+	case crc == nil:
+	case crc.Value:
+		hdr.Flags |= pcap.LoRaTapFlagCRCOK
+	default:
+		hdr.Flags |= pcap.LoRaTapFlagCRCBad
+	}
+	capturedAt := up.GetReceivedAt()
+	if capturedAt == nil {
+		capturedAt = timestamppb.Now()
+	}
+	gs.captures.publish(uid, &ttnpb.GatewayCapturePacket{
+		GatewayIds: gtw.GetIds(),
+		Time:       capturedAt,
+		Type:       ttnpb.GatewayCapturePacketType_GATEWAY_CAPTURE_UPLINK,
+		Data:       pcap.AppendLoRaTap(nil, hdr, up.RawPayload),
+	})
+}
+
+// captureDownlink captures the downlink message scheduled on the gateway.
+func (gs *GatewayServer) captureDownlink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.DownlinkMessage) {
+	uid := unique.ID(ctx, gtw.GetIds())
+	if !gs.captures.active(uid) {
+		return
+	}
+	typ := ttnpb.GatewayCapturePacketType_GATEWAY_CAPTURE_DOWNLINK
+	hdr, ok := downlinkLoRaTapHeader(gtw.GetIds(), msg.GetScheduled(), typ)
+	if !ok {
+		return
+	}
+	gs.captures.publish(uid, &ttnpb.GatewayCapturePacket{
+		GatewayIds: gtw.GetIds(),
+		Time:       timestamppb.Now(),
+		Type:       typ,
+		Data:       pcap.AppendLoRaTap(nil, hdr, msg.RawPayload),
+	})
+}
+
+// captureTxAck captures the transmission acknowledgment of the gateway.
+// The frame contains the acknowledged downlink message if the gateway reports it. The upper byte of the LoRaTap tag
+// is the acknowledgment result.
+func (gs *GatewayServer) captureTxAck(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.TxAcknowledgment) {
+	uid := unique.ID(ctx, gtw.GetIds())
+	if !gs.captures.active(uid) {
+		return
+	}
+	typ := ttnpb.GatewayCapturePacketType_GATEWAY_CAPTURE_TX_ACKNOWLEDGMENT
+	down := msg.GetDownlinkMessage()
+	hdr, ok := downlinkLoRaTapHeader(gtw.GetIds(), down.GetScheduled(), typ)
+	if !ok {
+		hdr = pcap.LoRaTapHeader{Tag: uint16(typ) + 1}
+	}
+	hdr.Tag |= uint16(msg.Result) << 8
+	gs.captures.publish(uid, &ttnpb.GatewayCapturePacket{
+		GatewayIds: gtw.GetIds(),
+		Time:       timestamppb.Now(),
+		Type:       typ,
+		Data:       pcap.AppendLoRaTap(nil, hdr, down.GetRawPayload()),
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/pcap"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCaptureHub(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	var h captureHub
+	a.So(h.active("gtw-a"), should.BeFalse)
+	// Publishing without subscriptions is a no-op.
+	h.publish("gtw-a", &ttnpb.GatewayCapturePacket{})
+
+	sub, unsubscribe := h.subscribe([]string{"gtw-a", "gtw-b"})
+	a.So(h.active("gtw-a"), should.BeTrue)
+	a.So(h.active("gtw-b"), should.BeTrue)
+	a.So(h.active("gtw-c"), should.BeFalse)
+
+	pkt := &ttnpb.GatewayCapturePacket{Data: []byte{0x01}}
+	h.publish("gtw-b", pkt)
+	h.publish("gtw-c", &ttnpb.GatewayCapturePacket{})
+	select {
+	case received := <-sub.ch:
+		a.So(received, should.Equal, pkt)
+	default:
+		t.Fatal("Expected captured packet")
+	}
+	select {
+	case <-sub.ch:
+		t.Fatal("Unexpected captured packet")
+	default:
+	}
+
+	// Packets are dropped when the buffer is full.
+	for i := 0; i < captureBufferSize+2; i++ {
+		h.publish("gtw-a", pkt)
+	}
+	a.So(sub.dropped, should.Equal, 2)
+
+	unsubscribe()
+	a.So(h.active("gtw-a"), should.BeFalse)
+	a.So(h.active("gtw-b"), should.BeFalse)
+}
+
+func TestLoRaTapHeader(t *testing.T) {
+	t.Parallel()
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "gtw",
+		Eui:       []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		DataRate *ttnpb.DataRate
+		Expected pcap.LoRaTapHeader
+		OK       bool
+	}{
+		{
+			Name: "LoRa",
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 9,
+						CodingRate:      "4/5",
+					},
+				},
+			},
+			Expected: pcap.LoRaTapHeader{
+				Frequency:       868100000,
+				Bandwidth:       125000,
+				SpreadingFactor: 9,
+				CodingRate:      5,
+				SyncWord:        pcap.LoRaTapSyncWordPublic,
+				SourceGateway:   0x0102030405060708,
+				Tag:             1,
+			},
+			OK: true,
+		},
+		{
+			Name: "LoRaLongInterleaving",
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       500000,
+						SpreadingFactor: 7,
+						CodingRate:      "4/8LI",
+					},
+				},
+			},
+			Expected: pcap.LoRaTapHeader{
+				Frequency:       868100000,
+				Bandwidth:       500000,
+				SpreadingFactor: 7,
+				CodingRate:      8,
+				SyncWord:        pcap.LoRaTapSyncWordPublic,
+				SourceGateway:   0x0102030405060708,
+				Tag:             1,
+			},
+			OK: true,
+		},
+		{
+			Name: "FSK",
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Fsk{
+					Fsk: &ttnpb.FSKDataRate{
+						BitRate: 50000,
+					},
+				},
+			},
+			Expected: pcap.LoRaTapHeader{
+				Frequency:     868100000,
+				Flags:         pcap.LoRaTapFlagFSK,
+				DataRate:      50000,
+				SyncWord:      pcap.LoRaTapSyncWordPublic,
+				SourceGateway: 0x0102030405060708,
+				Tag:           1,
+			},
+			OK: true,
+		},
+		{
+			Name: "LRFHSS",
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lrfhss{
+					Lrfhss: &ttnpb.LRFHSSDataRate{
+						ModulationType:        0,
+						OperatingChannelWidth: 137000,
+						CodingRate:            "1/3",
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			hdr, ok := loRaTapHeader(gtwIDs, tc.DataRate, 868100000, ttnpb.GatewayCapturePacketType_GATEWAY_CAPTURE_UPLINK)
+			a.So(ok, should.Equal, tc.OK)
+			a.So(hdr, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/semtechws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ttigw"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
 
@@ -39,6 +40,12 @@ type BasicStationConfig struct {
 	ListenTLS               string        `name:"listen-tls" description:"Address for the Basic Station frontend to listen on (with TLS)"`
 }
 
+// MQTTChirpStackConfig defines the ChirpStack Gateway Bridge MQTT configuration of the Gateway Server.
+type MQTTChirpStackConfig struct {
+	config.MQTT   `name:",squash"`
+	TopicPrefixes map[string]string `name:"topic-prefixes" description:"Prefixes of the ChirpStack Gateway Bridge topics by band ID, typically the region (e.g. EU_863_870=eu868)"` //nolint:lll
+}
+
 // TheThingsIndustriesGatewayConfig defines The Things Industries gateway configuration of the Gateway Server.
 type TheThingsIndustriesGatewayConfig struct {
 	ttigw.Config            `name:",squash"`
@@ -54,12 +61,29 @@ type PacketBrokerConfig struct {
 	OnlineTTLMargin       time.Duration `name:"online-ttl-margin" description:"Time to extend the online status before it expires"`
 }
 
+// GatewayAlertsConfig configures the evaluation of gateway alert rules.
+type GatewayAlertsConfig struct {
+	Registry GatewayAlertRegistry `name:"-"`
+	Interval time.Duration        `name:"interval" description:"Interval at which the gateway alert rules are evaluated"`
+}
+
+// DrainConfig configures the draining of gateway connections when the Gateway Server stops.
+type DrainConfig struct {
+	HandoffRegistry GatewayHandoffRegistry `name:"-"`
+	Timeout         time.Duration          `name:"timeout" description:"Maximum time to wait for the gateways to disconnect when the Gateway Server stops"`                                       //nolint:lll
+	HandoffTTL      time.Duration          `name:"handoff-ttl" description:"Time to live of the connection state that is handed off to the Gateway Server to which a drained gateway reconnects"` //nolint:lll
+	BatchSize       int                    `name:"batch-size" description:"Number of gateways that are disconnected at once while draining (0 is unlimited)"`                                     //nolint:lll
+	BatchInterval   time.Duration          `name:"batch-interval" description:"Interval between the batches of gateways that are disconnected while draining"`                                    //nolint:lll
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
 	Stats GatewayConnectionStatsRegistry `name:"-"`
 
+	Metering *metering.Recorder `name:"-"`
+
 	FetchGatewayInterval time.Duration `name:"fetch-gateway-interval" description:"Fetch gateway interval"`
 	FetchGatewayJitter   float64       `name:"fetch-gateway-jitter" description:"Jitter (fraction) to apply to the get interval to randomize intervals"`
 
@@ -76,8 +100,13 @@ type Config struct {
 	Forward      map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 	PacketBroker PacketBrokerConfig  `name:"packetbroker" description:"Packet Broker upstream configuration"`
 
+	Alerts GatewayAlertsConfig `name:"alerts" description:"Gateway alerting configuration"`
+
+	Drain DrainConfig `name:"drain" description:"Gateway connection draining configuration"`
+
 	MQTT                       config.MQTT                      `name:"mqtt"`
 	MQTTV2                     config.MQTT                      `name:"mqtt-v2"`
+	MQTTChirpStack             MQTTChirpStackConfig             `name:"mqtt-chirpstack"`
 	UDP                        UDPConfig                        `name:"udp"`
 	BasicStation               BasicStationConfig               `name:"basic-station"`
 	TheThingsIndustriesGateway TheThingsIndustriesGatewayConfig `name:"ttigw"`
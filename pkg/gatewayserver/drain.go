@@ -0,0 +1,191 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GatewayHandoffRegistry stores the connection state of drained gateways, until the gateways reconnect.
+type GatewayHandoffRegistry interface {
+	// Set stores the handoff of the gateway with the given time to live.
+	Set(ctx context.Context, ids *ttnpb.GatewayIdentifiers, handoff *ttnpb.GatewayConnectionHandoff, ttl time.Duration) error
+	// Pop returns and removes the handoff of the gateway. It returns nil if there is no handoff.
+	Pop(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionHandoff, error)
+}
+
+var (
+	errDraining = errors.DefineUnavailable(
+		"draining", "Gateway Server is draining gateway connections",
+	)
+	errDrainInProgress = errors.DefineUnavailable(
+		"drain_in_progress", "drained `{drained}` of `{total}` gateway connections",
+	)
+)
+
+// drainPollInterval is the interval at which the remaining gateway connections are checked while draining.
+const drainPollInterval = 100 * time.Millisecond
+
+// IsDraining returns whether the Gateway Server is draining gateway connections.
+// Gateway connections are refused while draining.
+func (gs *GatewayServer) IsDraining() bool {
+	return gs.draining.Load()
+}
+
+func (gs *GatewayServer) connectionCount() (n int) {
+	gs.connections.Range(func(any, any) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Drain stops accepting gateway connections and disconnects the connected gateways, so that they reconnect to
+// another Gateway Server. The gateways are disconnected in batches, so that the other Gateway Servers are not
+// overloaded by the reconnecting gateways. The scheduler state and the pending downlink messages of the connections
+// are handed off to the Gateway Server to which the gateways reconnect. Drain returns when all gateways are
+// disconnected, or when the drain timeout passed.
+func (gs *GatewayServer) Drain(ctx context.Context) error {
+	if !gs.draining.CompareAndSwap(false, true) {
+		return nil
+	}
+	if timeout := gs.config.Drain.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	logger := log.FromContext(gs.ctx)
+
+	var entries []connectionEntry
+	gs.connections.Range(func(_, v any) bool {
+		entries = append(entries, v.(connectionEntry))
+		return true
+	})
+	gs.drainTotal.Store(int64(len(entries)))
+	logger.WithField("connections", len(entries)).Info("Drain gateway connections")
+
+	batchSize := gs.config.Drain.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(entries)
+	}
+	for i, entry := range entries {
+		if i > 0 && i%batchSize == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(gs.config.Drain.BatchInterval):
+			}
+		}
+		entry.Drain()
+		gs.handOff(ctx, entry.Connection)
+	}
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		if gs.connectionCount() == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkDrain is a health check that fails while the Gateway Server is draining.
+func (gs *GatewayServer) checkDrain(context.Context) error {
+	if !gs.IsDraining() {
+		return nil
+	}
+	total := gs.drainTotal.Load()
+	drained := total - int64(gs.connectionCount())
+	if drained < 0 {
+		drained = 0
+	}
+	return errDrainInProgress.WithAttributes(
+		"drained", drained,
+		"total", total,
+	)
+}
+
+// handOff stores the scheduler state and the pending downlink messages of the disconnected connection.
+func (gs *GatewayServer) handOff(ctx context.Context, conn *io.Connection) {
+	registry := gs.config.Drain.HandoffRegistry
+	if registry == nil {
+		return
+	}
+	ids := conn.Gateway().GetIds()
+	logger := log.FromContext(conn.Context())
+	handoff := &ttnpb.GatewayConnectionHandoff{
+		GatewayIds:       ids,
+		DrainedAt:        timestamppb.Now(),
+		Scheduler:        conn.SchedulerState(),
+		PendingDownlinks: conn.PendingDownlinks(),
+	}
+	if err := registry.Set(ctx, ids, handoff, gs.config.Drain.HandoffTTL); err != nil {
+		logger.WithError(err).Warn("Failed to hand off gateway connection")
+		return
+	}
+	logger.WithField("pending_downlinks", len(handoff.PendingDownlinks)).Debug("Handed off gateway connection")
+}
+
+// restoreHandoff restores the scheduler state and sends the pending downlink messages that are handed off by the
+// Gateway Server that drained the previous connection of the gateway.
+// Pending downlink messages are only sent if they are scheduled far enough in the future.
+func (gs *GatewayServer) restoreHandoff(ctx context.Context, conn *io.Connection) {
+	registry := gs.config.Drain.HandoffRegistry
+	if registry == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	handoff, err := registry.Pop(ctx, conn.Gateway().GetIds())
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get gateway connection handoff")
+		return
+	}
+	if handoff == nil {
+		return
+	}
+	conn.RestoreSchedulerState(handoff.Scheduler)
+
+	var sent int
+	now, ok := conn.TimeFromServerTime(time.Now())
+	for _, down := range handoff.PendingDownlinks {
+		starts := scheduling.ConcentratorTime(down.GetScheduled().GetConcentratorTimestamp())
+		if !ok || starts-now < scheduling.ConcentratorTime(scheduling.ScheduleTimeShort) {
+			continue
+		}
+		if err := conn.SendDown(down); err != nil {
+			logger.WithError(err).Warn("Failed to send handed off downlink message")
+			continue
+		}
+		sent++
+	}
+	logger.WithFields(log.Fields(
+		"drained_at", handoff.DrainedAt.AsTime(),
+		"pending_downlinks", len(handoff.PendingDownlinks),
+		"sent_downlinks", sent,
+	)).Info("Restored gateway connection handoff")
+}
@@ -0,0 +1,194 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type memoryHandoffRegistry struct {
+	mu       sync.Mutex
+	handoffs map[string]*ttnpb.GatewayConnectionHandoff
+}
+
+func (r *memoryHandoffRegistry) Set(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, handoff *ttnpb.GatewayConnectionHandoff, _ time.Duration,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handoffs[unique.ID(ctx, ids)] = handoff
+	return nil
+}
+
+func (r *memoryHandoffRegistry) Pop(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayConnectionHandoff, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	handoff := r.handoffs[uid]
+	delete(r.handoffs, uid)
+	return handoff, nil
+}
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	registry := &memoryHandoffRegistry{
+		handoffs: make(map[string]*ttnpb.GatewayConnectionHandoff),
+	}
+	gs := &GatewayServer{
+		ctx: ctx,
+		config: &Config{
+			Drain: DrainConfig{
+				HandoffRegistry: registry,
+				Timeout:         test.Delay << 8,
+				BatchSize:       1,
+				BatchInterval:   test.Delay,
+			},
+		},
+	}
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw"}
+	gtw := &ttnpb.Gateway{
+		Ids:             ids,
+		FrequencyPlanId: test.EUFrequencyPlanID,
+	}
+	newConnection := func(gtw *ttnpb.Gateway) *io.Connection {
+		conn, err := io.NewConnection(ctx, &mock.Frontend{}, gtw, fps, true, nil, &ttnpb.GatewayRemoteAddress{})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return conn
+	}
+	storeConnection := func(conn *io.Connection) {
+		uid := unique.ID(ctx, conn.Gateway().GetIds())
+		gs.connections.Store(uid, connectionEntry{Connection: conn})
+		// Remove the connection when it is disconnected, like the upstream handler does.
+		go func() {
+			<-conn.Context().Done()
+			gs.connections.Delete(uid)
+		}()
+	}
+
+	conn := newConnection(gtw)
+	storeConnection(conn)
+	// The gateways are drained in batches of one gateway.
+	otherConn := newConnection(&ttnpb.Gateway{
+		Ids:             &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"},
+		FrequencyPlanId: test.EUFrequencyPlanID,
+	})
+	storeConnection(otherConn)
+
+	a.So(gs.checkDrain(ctx), should.BeNil)
+
+	now := conn.SyncWithGatewayConcentrator(1000, time.Now(), nil, scheduling.ConcentratorTime(time.Second))
+	future := &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x01},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				ConcentratorTimestamp: int64(now + scheduling.ConcentratorTime(10*time.Second)),
+			},
+		},
+	}
+	past := &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x02},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				ConcentratorTimestamp: int64(now),
+			},
+		},
+	}
+	taken := &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x03},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				ConcentratorTimestamp: int64(now + scheduling.ConcentratorTime(20*time.Second)),
+			},
+		},
+	}
+	if !a.So(conn.SendDown(taken), should.BeNil) {
+		t.FailNow()
+	}
+	// The frontend takes the downlink message off the downlink channel, and holds it until it is transmitted.
+	a.So(<-conn.Down(), should.Equal, taken)
+	for _, down := range []*ttnpb.DownlinkMessage{future, past} {
+		if !a.So(conn.SendDown(down), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	if !a.So(gs.Drain(ctx), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(conn.IsDrained(), should.BeTrue)
+	a.So(otherConn.IsDrained(), should.BeTrue)
+	a.So(gs.IsDraining(), should.BeTrue)
+	err := gs.checkDrain(ctx)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(errors.Attributes(err), should.Resemble, map[string]any{
+		"drained": "2",
+		"total":   "2",
+	})
+
+	// New connections are refused while draining.
+	_, err = gs.Connect(ctx, &mock.Frontend{}, ids, &ttnpb.GatewayRemoteAddress{})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	handoff := registry.handoffs[unique.ID(ctx, ids)]
+	if !a.So(handoff, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(handoff.GatewayIds, should.Resemble, ids)
+	a.So(handoff.Scheduler.GetClock().GetAbsolute(), should.Equal, int64(time.Second))
+	// The downlink messages that are transmitted already are not handed off.
+	a.So(handoff.PendingDownlinks, should.Resemble, []*ttnpb.DownlinkMessage{taken, future})
+
+	// The next connection restores the handoff, and only sends the downlink messages that are not too late.
+	next := newConnection(gtw)
+	gs.restoreHandoff(ctx, next)
+	a.So(registry.handoffs, should.NotContainKey, unique.ID(ctx, ids))
+	a.So(registry.handoffs, should.HaveLength, 1)
+	nextNow, ok := next.TimeFromServerTime(time.Now())
+	a.So(ok, should.BeTrue)
+	a.So(nextNow, should.BeGreaterThanOrEqualTo, now)
+	for _, expected := range []*ttnpb.DownlinkMessage{taken, future} {
+		select {
+		case down := <-next.Down():
+			a.So(down, should.Resemble, expected)
+		default:
+			t.Fatal("Expected handed off downlink message")
+		}
+	}
+	select {
+	case down := <-next.Down():
+		t.Fatalf("Unexpected downlink message: %v", down)
+	default:
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -52,6 +53,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewaytokens"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -96,6 +98,13 @@ type GatewayServer struct {
 
 	statsRegistry GatewayConnectionStatsRegistry
 
+	alertsService ttnpb.GsGatewayAlertsServer
+
+	captures captureHub
+
+	draining   atomic.Bool
+	drainTotal atomic.Int64
+
 	certVerifier CertificateVerifier
 }
 
@@ -203,13 +212,36 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			"/ttn.lorawan.v3.Ns",
 			"/ttn.lorawan.v3.NsGs",
 			"/ttn.lorawan.v3.GtwGs",
+			"/ttn.lorawan.v3.GsGatewayAlerts",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
 	}
+	c.GRPC.RegisterStreamHook("/ttn.lorawan.v3.GsGatewayCapture", rpctracer.TracerHook, rpctracer.StreamTracerHook(tracerNamespace))
+	c.GRPC.RegisterStreamHook("/ttn.lorawan.v3.GsGatewayCapture", rpclog.NamespaceHook, rpclog.StreamNamespaceHook(logNamespace))
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook())
 
+	if conf.Alerts.Registry != nil {
+		gs.alertsService = &gsGatewayAlerts{gs: gs, registry: conf.Alerts.Registry}
+		// The alert rules are evaluated with the connection stats of all Gateway Server instances.
+		if gs.statsRegistry != nil {
+			gs.RegisterTask(&task.Config{
+				Context: gs.Context(),
+				ID:      evaluateGatewayAlertsTaskName,
+				Func:    gs.runGatewayAlerts,
+				Restart: task.RestartAlways,
+				Backoff: task.DefaultBackoffConfig,
+			})
+		} else {
+			log.FromContext(ctx).Warn("No connection stats registry configured, gateway alert rules are not evaluated")
+		}
+	}
+
 	c.RegisterGRPC(gs)
+	c.RegisterDrainer("gatewayserver", gs.Drain)
+	if err := c.HealthChecker().AddCheck("gatewayserver_drain", gs.checkDrain); err != nil {
+		return nil, err
+	}
 
 	// Start UDP listeners.
 	for addr, fallbackFrequencyPlanID := range conf.UDP.Listeners {
@@ -252,6 +284,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			Format: mqtt.NewProtobufV2(gs.ctx),
 			Config: conf.MQTTV2,
 		},
+		{
+			Format: mqtt.NewChirpStack(gs.ctx, conf.MQTTChirpStack.TopicPrefixes),
+			Config: conf.MQTTChirpStack.MQTT,
+		},
 	} {
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.Config.Listen, "MQTT"),
@@ -450,12 +486,20 @@ func (gs *GatewayServer) RegisterServices(s *grpc.Server) {
 				return &config.MQTTV2, nil
 			})),
 	))
+	if gs.alertsService != nil {
+		ttnpb.RegisterGsGatewayAlertsServer(s, gs.alertsService)
+	}
+	ttnpb.RegisterGsGatewayCaptureServer(s, &gsGatewayCapture{gs: gs})
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (gs *GatewayServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterGsHandler(gs.Context(), s, conn)
 	ttnpb.RegisterGtwGsHandler(gs.Context(), s, conn)
+	if gs.alertsService != nil {
+		ttnpb.RegisterGsGatewayAlertsHandler(gs.Context(), s, conn)
+	}
+	ttnpb.RegisterGsGatewayCaptureHandler(gs.Context(), s, conn)
 }
 
 // Roles returns the roles that the Gateway Server fulfills.
@@ -566,6 +610,9 @@ func (gs *GatewayServer) Connect(
 	addr *ttnpb.GatewayRemoteAddress,
 	opts ...io.ConnectionOption,
 ) (*io.Connection, error) {
+	if gs.IsDraining() {
+		return nil, errDraining.New()
+	}
 	if err := gs.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_LINK); err != nil {
 		return nil, err
 	}
@@ -655,6 +702,7 @@ func (gs *GatewayServer) Connect(
 		existingConnEntry.Disconnect(errNewConnection.New())
 		existingConnEntry.tasksDone.Wait()
 	}
+	gs.restoreHandoff(ctx, conn)
 
 	registerGatewayConnect(ctx, ids, &ttnpb.GatewayConnectionStats{
 		ConnectedAt:          timestamppb.New(conn.ConnectTime()),
@@ -1022,6 +1070,7 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 				}
 			}
 			registerReceiveUplink(ctx, gtw, msg, protocol)
+			gs.captureUplink(ctx, gtw, msg)
 			if crcStatus := msg.Message.CrcStatus; crcStatus != nil && !crcStatus.Value {
 				registerDropUplink(ctx, gtw, msg, "", errMessageCRC.New())
 				continue
@@ -1030,6 +1079,10 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 				registerDropUplink(ctx, gtw, msg, "", err)
 				continue
 			}
+			gs.config.Metering.Record(gtw.GetIds().GetEntityIdentifiers(), metering.Usage{
+				Uplinks: 1,
+				Airtime: msg.Message.GetConsumedAirtime().AsDuration(),
+			})
 			val = msg
 		case msg := <-conn.Status():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
@@ -1046,6 +1099,7 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 				d.CorrelationIds = events.CorrelationIDsFromContext(ctx)
 			}
 			registerReceiveTxAck(ctx, gtw, msg, protocol)
+			gs.captureTxAck(ctx, gtw, msg)
 			if msg.Result == ttnpb.TxAcknowledgment_SUCCESS {
 				registerSuccessDownlink(ctx, gtw, protocol)
 			} else {
@@ -0,0 +1,207 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	errGatewayAlertRuleEntity = errors.DefineInvalidArgument(
+		"gateway_alert_rule_entity",
+		"alert rules are not supported for entity type `{entity_type}`",
+	)
+	errGatewayAlertRuleThreshold = errors.DefineInvalidArgument(
+		"gateway_alert_rule_threshold",
+		"no threshold specified for condition `{condition}`",
+	)
+	errGatewayAlertRuleOfflineThreshold = errors.DefineInvalidArgument(
+		"gateway_alert_rule_offline_threshold",
+		"offline threshold `{threshold}` must be shorter than the connection stats TTL `{ttl}`",
+	)
+	errGatewayAlertRuleAlreadyExists = errors.DefineAlreadyExists(
+		"gateway_alert_rule_already_exists",
+		"alert rule `{rule_id}` already exists",
+	)
+	errGatewayAlertRuleNotFound = errors.DefineNotFound(
+		"gateway_alert_rule_not_found",
+		"alert rule `{rule_id}` not found",
+	)
+)
+
+type gsGatewayAlerts struct {
+	ttnpb.UnimplementedGsGatewayAlertsServer
+
+	gs       *GatewayServer
+	registry GatewayAlertRegistry
+}
+
+// requireRuleRights checks that the caller has the rights to read or write the alert rules of the entity.
+func (s *gsGatewayAlerts) requireRuleRights(ctx context.Context, ids *ttnpb.EntityIdentifiers, write bool) error {
+	switch entityIDs := ids.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_GatewayIds:
+		right := ttnpb.Right_RIGHT_GATEWAY_INFO
+		if write {
+			right = ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC
+		}
+		return s.gs.AssertGatewayRights(ctx, entityIDs.GatewayIds, right)
+	case *ttnpb.EntityIdentifiers_OrganizationIds:
+		right := ttnpb.Right_RIGHT_ORGANIZATION_INFO
+		if write {
+			right = ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC
+		}
+		return rights.RequireOrganization(ctx, entityIDs.OrganizationIds, right)
+	default:
+		return errGatewayAlertRuleEntity.WithAttributes("entity_type", ids.EntityType())
+	}
+}
+
+// validateRule validates the thresholds of the rule.
+func (s *gsGatewayAlerts) validateRule(rule *ttnpb.GatewayAlertRule) error {
+	switch rule.Condition {
+	case ttnpb.GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO:
+		if rule.TxFailureRatio <= 0 {
+			return errGatewayAlertRuleThreshold.WithAttributes("condition", rule.Condition)
+		}
+	default:
+		if rule.Threshold.AsDuration() <= 0 {
+			return errGatewayAlertRuleThreshold.WithAttributes("condition", rule.Condition)
+		}
+	}
+	if rule.Condition == ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE {
+		// The offline time is evaluated with the connection stats, which expire when they are not updated.
+		for _, ttl := range []time.Duration{
+			s.gs.config.ConnectionStatsTTL,
+			s.gs.config.ConnectionStatsDisconnectTTL,
+		} {
+			if ttl > 0 && rule.Threshold.AsDuration() >= ttl {
+				return errGatewayAlertRuleOfflineThreshold.WithAttributes(
+					"threshold", rule.Threshold.AsDuration(),
+					"ttl", ttl,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateGatewayAlertRule implements ttnpb.GsGatewayAlertsServer.
+func (s *gsGatewayAlerts) CreateGatewayAlertRule(
+	ctx context.Context, req *ttnpb.CreateGatewayAlertRuleRequest,
+) (*ttnpb.GatewayAlertRule, error) {
+	if err := s.requireRuleRights(ctx, req.Rule.EntityIds, true); err != nil {
+		return nil, err
+	}
+	if err := s.validateRule(req.Rule); err != nil {
+		return nil, err
+	}
+	req.Rule.GatewayIds = nil
+	return s.registry.SetRule(ctx, gatewayAlertRuleIdentifiers(req.Rule),
+		func(stored *ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error) {
+			if stored != nil {
+				return nil, errGatewayAlertRuleAlreadyExists.WithAttributes("rule_id", req.Rule.RuleId)
+			}
+			return req.Rule, nil
+		},
+	)
+}
+
+// GetGatewayAlertRule implements ttnpb.GsGatewayAlertsServer.
+func (s *gsGatewayAlerts) GetGatewayAlertRule(
+	ctx context.Context, req *ttnpb.GatewayAlertRuleIdentifiers,
+) (*ttnpb.GatewayAlertRule, error) {
+	if err := s.requireRuleRights(ctx, req.EntityIds, false); err != nil {
+		return nil, err
+	}
+	return s.registry.GetRule(ctx, req)
+}
+
+// ListGatewayAlertRules implements ttnpb.GsGatewayAlertsServer.
+func (s *gsGatewayAlerts) ListGatewayAlertRules(
+	ctx context.Context, req *ttnpb.ListGatewayAlertRulesRequest,
+) (*ttnpb.GatewayAlertRules, error) {
+	if err := s.requireRuleRights(ctx, req.EntityIds, false); err != nil {
+		return nil, err
+	}
+	rules, err := s.registry.ListRules(ctx, req.EntityIds)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayAlertRules{Rules: rules}, nil
+}
+
+// UpdateGatewayAlertRule implements ttnpb.GsGatewayAlertsServer.
+func (s *gsGatewayAlerts) UpdateGatewayAlertRule(
+	ctx context.Context, req *ttnpb.UpdateGatewayAlertRuleRequest,
+) (*ttnpb.GatewayAlertRule, error) {
+	if err := s.requireRuleRights(ctx, req.Rule.EntityIds, true); err != nil {
+		return nil, err
+	}
+	paths := ttnpb.ExcludeFields(req.FieldMask.GetPaths(),
+		"entity_ids", "rule_id", "created_at", "updated_at", "gateway_ids",
+	)
+	return s.registry.SetRule(ctx, gatewayAlertRuleIdentifiers(req.Rule),
+		func(stored *ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error) {
+			if stored == nil {
+				return nil, errGatewayAlertRuleNotFound.WithAttributes("rule_id", req.Rule.RuleId)
+			}
+			if err := stored.SetFields(req.Rule, paths...); err != nil {
+				return nil, err
+			}
+			if err := s.validateRule(stored); err != nil {
+				return nil, err
+			}
+			stored.GatewayIds = nil
+			return stored, nil
+		},
+	)
+}
+
+// DeleteGatewayAlertRule implements ttnpb.GsGatewayAlertsServer.
+func (s *gsGatewayAlerts) DeleteGatewayAlertRule(
+	ctx context.Context, req *ttnpb.GatewayAlertRuleIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := s.requireRuleRights(ctx, req.EntityIds, true); err != nil {
+		return nil, err
+	}
+	if _, err := s.registry.SetRule(ctx, req,
+		func(*ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error) {
+			return nil, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// ListGatewayAlerts implements ttnpb.GsGatewayAlertsServer.
+func (s *gsGatewayAlerts) ListGatewayAlerts(
+	ctx context.Context, req *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayAlerts, error) {
+	if err := s.gs.AssertGatewayRights(ctx, req, ttnpb.Right_RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	alerts, err := s.registry.ListAlerts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayAlerts{Alerts: alerts}, nil
+}
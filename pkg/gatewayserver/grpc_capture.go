@@ -0,0 +1,89 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"sync/atomic"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc/metadata"
+)
+
+type gsGatewayCapture struct {
+	ttnpb.UnimplementedGsGatewayCaptureServer
+
+	gs *GatewayServer
+}
+
+// CaptureGatewayTraffic implements ttnpb.GsGatewayCaptureServer.
+func (s *gsGatewayCapture) CaptureGatewayTraffic(
+	req *ttnpb.CaptureGatewayTrafficRequest, stream ttnpb.GsGatewayCapture_CaptureGatewayTrafficServer,
+) error {
+	ctx := stream.Context()
+	uids := make([]string, 0, len(req.GatewayIds))
+	conns := make([]connectionEntry, 0, len(req.GatewayIds))
+	for _, ids := range req.GatewayIds {
+		if err := s.gs.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+			return err
+		}
+		uid := unique.ID(ctx, ids)
+		// The traffic is captured by the Gateway Server that the gateway is connected to.
+		val, ok := s.gs.connections.Load(uid)
+		if !ok {
+			return errNotConnected.WithAttributes("gateway_uid", uid)
+		}
+		uids = append(uids, uid)
+		conns = append(conns, val.(connectionEntry))
+	}
+
+	sub, unsubscribe := s.gs.captures.subscribe(uids)
+	defer func() {
+		unsubscribe()
+		if dropped := atomic.LoadUint64(&sub.dropped); dropped > 0 {
+			log.FromContext(ctx).WithField("dropped", dropped).Warn("Dropped captured packets")
+		}
+	}()
+
+	// The capture ends when a gateway disconnects, as the gateway may reconnect to another Gateway Server.
+	disconnected := make(chan string, len(conns))
+	for i, conn := range conns {
+		uid, connCtx := uids[i], conn.Context()
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-connCtx.Done():
+				disconnected <- uid
+			}
+		}()
+	}
+
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case uid := <-disconnected:
+			return errNotConnected.WithAttributes("gateway_uid", uid)
+		case pkt := <-sub.ch:
+			if err := stream.Send(pkt); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -94,6 +96,12 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		}
 
 		registerSendDownlink(ctx, conn.Gateway(), connDown, conn.Frontend().Protocol())
+		airtime, _ := toa.Compute(len(connDown.RawPayload), connDown.GetScheduled())
+		gs.config.Metering.Record(conn.Gateway().GetIds().GetEntityIdentifiers(), metering.Usage{
+			Downlinks: 1,
+			Airtime:   airtime,
+		})
+		gs.captureDownlink(ctx, conn.Gateway(), connDown)
 
 		return &ttnpb.ScheduleDownlinkResponse{
 			Delay: durationpb.New(delay),
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"hash/fnv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -98,7 +99,8 @@ type Connection struct {
 	// Align for sync/atomic.
 	uplinks,
 	downlinks,
-	txAcknowledgments uint64
+	txAcknowledgments,
+	txAcknowledgmentFailures uint64
 	lastStatusTime,
 	lastUplinkTime,
 	lastDownlinkTime,
@@ -131,6 +133,12 @@ type Connection struct {
 	statsChangedCh       chan struct{}
 	locChangedCh         chan struct{}
 	versionInfoChangedCh chan struct{}
+
+	// scheduledDowns are the downlink messages that are sent to the frontend and are scheduled, but not yet
+	// transmitted. The frontends take the downlink messages off the downlink channel immediately, and either
+	// hold them until they are transmitted or pass them to the gateway.
+	scheduledDownsMu sync.Mutex
+	scheduledDowns   []*ttnpb.DownlinkMessage
 }
 
 type uplinkMessage struct {
@@ -258,6 +266,19 @@ func (c *Connection) Disconnect(err error) {
 	c.cancelCtx(err)
 }
 
+var errDrained = errors.DefineUnavailable("drained", "connection drained by Gateway Server")
+
+// Drain disconnects the connection because the Gateway Server is draining.
+// Frontends may request the gateway to reconnect, so that the gateway reconnects to another Gateway Server.
+func (c *Connection) Drain() {
+	c.Disconnect(errDrained.New())
+}
+
+// IsDrained returns whether the connection is disconnected because the Gateway Server is draining.
+func (c *Connection) IsDrained() bool {
+	return errors.Is(c.ctx.Err(), errDrained)
+}
+
 // Frontend returns the frontend using this connection.
 func (c *Connection) Frontend() Frontend { return c.frontend }
 
@@ -445,6 +466,9 @@ func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) (err error) {
 		return c.ctx.Err()
 	case c.txAckCh <- ack:
 		atomic.AddUint64(&c.txAcknowledgments, 1)
+		if ack.Result != ttnpb.TxAcknowledgment_SUCCESS {
+			atomic.AddUint64(&c.txAcknowledgmentFailures, 1)
+		}
 		atomic.StoreInt64(&c.lastTxAcknowledgmentTime, time.Now().UnixNano())
 		c.notifyStatsChanged()
 	default:
@@ -503,6 +527,7 @@ func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.downCh <- msg:
+		c.trackScheduledDown(msg)
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
 		c.notifyStatsChanged()
@@ -812,6 +837,11 @@ func (c *Connection) TxAckStats() (total uint64, t time.Time, ok bool) {
 	return
 }
 
+// TxAckFailures returns the number of transmission acknowledgements that reported a failure.
+func (c *Connection) TxAckFailures() uint64 {
+	return atomic.LoadUint64(&c.txAcknowledgmentFailures)
+}
+
 // RTTStats returns the recorded round-trip time statistics.
 func (c *Connection) RTTStats(percentile int, t time.Time) (min, max, median, np time.Duration, count int) {
 	if !c.streamActive(RTTStream) {
@@ -853,7 +883,10 @@ func (c *Connection) Stats() (*ttnpb.GatewayConnectionStats, []string) {
 	if count, t, ok := c.TxAckStats(); ok {
 		stats.LastTxAcknowledgmentReceivedAt = timestamppb.New(t)
 		stats.TxAcknowledgmentCount = count
-		paths = append(paths, "last_tx_acknowledgment_received_at", "tx_acknowledgment_count")
+		stats.TxAcknowledgmentFailureCount = c.TxAckFailures()
+		paths = append(paths,
+			"last_tx_acknowledgment_received_at", "tx_acknowledgment_count", "tx_acknowledgment_failure_count",
+		)
 	}
 	if min, max, median, _, count := c.RTTStats(100, time.Now()); count > 0 {
 		stats.RoundTripTimes = &ttnpb.GatewayConnectionStats_RoundTripTimes{
@@ -895,6 +928,69 @@ func (c *Connection) TimeFromServerTime(t time.Time) (scheduling.ConcentratorTim
 	return c.scheduler.TimeFromServerTime(t)
 }
 
+// SchedulerState returns the state of the scheduler, so that it can be handed off to another connection.
+func (c *Connection) SchedulerState() *ttnpb.GatewaySchedulerState { return c.scheduler.State() }
+
+// RestoreSchedulerState restores the state of the scheduler that is handed off by another connection.
+func (c *Connection) RestoreSchedulerState(state *ttnpb.GatewaySchedulerState) {
+	c.scheduler.RestoreState(state)
+}
+
+// maxScheduledDowns is the maximum number of scheduled downlink messages that are tracked per connection.
+const maxScheduledDowns = 1 << 6
+
+// untransmitted returns the downlink messages of downs that are scheduled to be transmitted after now.
+// All downlink messages are returned if the clock is not synced.
+func (c *Connection) untransmitted(downs []*ttnpb.DownlinkMessage, now time.Time) []*ttnpb.DownlinkMessage {
+	concentratorNow, ok := c.scheduler.TimeFromServerTime(now)
+	if !ok {
+		return downs
+	}
+	res := downs[:0]
+	for _, down := range downs {
+		if scheduling.ConcentratorTime(down.GetScheduled().GetConcentratorTimestamp()) > concentratorNow {
+			res = append(res, down)
+		}
+	}
+	return res
+}
+
+// trackScheduledDown tracks the scheduled downlink message msg until it is transmitted, so that it can be handed
+// off when the connection is drained.
+func (c *Connection) trackScheduledDown(msg *ttnpb.DownlinkMessage) {
+	if msg.GetScheduled() == nil {
+		return
+	}
+	c.scheduledDownsMu.Lock()
+	defer c.scheduledDownsMu.Unlock()
+	downs := append(c.untransmitted(c.scheduledDowns, time.Now()), msg)
+	if n := len(downs); n > maxScheduledDowns {
+		downs = downs[n-maxScheduledDowns:]
+	}
+	c.scheduledDowns = downs
+}
+
+// PendingDownlinks returns the downlink messages that are scheduled, but not yet transmitted. This includes the
+// downlink messages that are taken by the frontend, as the frontend may hold them until they are transmitted.
+// This method should only be called when the connection is disconnected, so that the frontend no longer takes
+// downlink messages.
+func (c *Connection) PendingDownlinks() []*ttnpb.DownlinkMessage {
+	// NOTE: The downlink messages in the downlink channel are tracked as scheduled downlink messages as well.
+drain:
+	for {
+		select {
+		case <-c.downCh:
+		default:
+			break drain
+		}
+	}
+	c.scheduledDownsMu.Lock()
+	defer c.scheduledDownsMu.Unlock()
+	res := c.untransmitted(c.scheduledDowns, time.Now())
+	c.scheduledDowns = nil
+	return res
+}
+
 func (c *Connection) notifyStatsChanged() {
 	select {
 	case c.statsChangedCh <- struct{}{}:
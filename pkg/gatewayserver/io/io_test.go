@@ -16,6 +16,7 @@ package io_test
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"time"
 
@@ -26,8 +27,10 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -159,7 +162,9 @@ func TestFlow(t *testing.T) {
 		a.So(ok, should.BeTrue)
 		a.So(total, should.Equal, 1)
 		a.So(time.Since(t), should.BeLessThan, timeout)
-		assertStatsIncludePaths(a, conn, []string{"last_tx_acknowledgment_received_at", "tx_acknowledgment_count"})
+		assertStatsIncludePaths(a, conn, []string{
+			"last_tx_acknowledgment_received_at", "tx_acknowledgment_count", "tx_acknowledgment_failure_count",
+		})
 	}
 
 	received := 0
@@ -767,3 +772,42 @@ func TestUniqueUplinkMessagesByRSSI(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingDownlinks(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gtw := &ttnpb.Gateway{
+		Ids:             &ttnpb.GatewayIdentifiers{GatewayId: "gtw"},
+		FrequencyPlanId: test.EUFrequencyPlanID,
+	}
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, gtw, fps, true, nil, &ttnpb.GatewayRemoteAddress{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	now := conn.SyncWithGatewayConcentrator(1000, time.Now(), nil, scheduling.ConcentratorTime(time.Second))
+	newDownlink := func(starts scheduling.ConcentratorTime) *ttnpb.DownlinkMessage {
+		return &ttnpb.DownlinkMessage{
+			Settings: &ttnpb.DownlinkMessage_Scheduled{
+				Scheduled: &ttnpb.TxSettings{
+					ConcentratorTimestamp: int64(starts),
+				},
+			},
+		}
+	}
+	taken := newDownlink(now + scheduling.ConcentratorTime(10*time.Second))
+	queued := newDownlink(now + scheduling.ConcentratorTime(20*time.Second))
+	transmitted := newDownlink(now)
+	for _, down := range []*ttnpb.DownlinkMessage{taken, queued, transmitted} {
+		if !a.So(conn.SendDown(down), should.BeNil) {
+			t.FailNow()
+		}
+	}
+	// The frontend takes the downlink message off the downlink channel, and holds it until it is transmitted.
+	a.So(<-conn.Down(), should.Equal, taken)
+
+	conn.Disconnect(context.Canceled)
+	a.So(conn.PendingDownlinks(), should.Resemble, []*ttnpb.DownlinkMessage{taken, queued})
+	a.So(conn.PendingDownlinks(), should.BeEmpty)
+}
@@ -0,0 +1,84 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chirpstack_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestGolden tests the messages against the messages in testdata, which are encoded by the protobuf reference
+// implementation from the text format messages.
+func TestGolden(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name string
+		New  func() proto.Message
+	}{
+		{
+			Name: "uplink_lora",
+			New:  func() proto.Message { return &chirpstack.UplinkFrame{} },
+		},
+		{
+			Name: "uplink_lrfhss",
+			New:  func() proto.Message { return &chirpstack.UplinkFrame{} },
+		},
+		{
+			Name: "downlink",
+			New:  func() proto.Message { return &chirpstack.DownlinkFrame{} },
+		},
+		{
+			Name: "txack",
+			New:  func() proto.Message { return &chirpstack.DownlinkTxAck{} },
+		},
+		{
+			Name: "stats",
+			New:  func() proto.Message { return &chirpstack.GatewayStats{} },
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			text, err := os.ReadFile(filepath.Join("testdata", tc.Name+".txtpb"))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			golden, err := os.ReadFile(filepath.Join("testdata", tc.Name+".binpb"))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			expected := tc.New()
+			if !a.So(prototext.Unmarshal(text, expected), should.BeNil) {
+				t.FailNow()
+			}
+
+			actual := tc.New()
+			a.So(proto.Unmarshal(golden, actual), should.BeNil)
+			a.So(actual, should.Resemble, expected)
+
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(expected)
+			a.So(err, should.BeNil)
+			a.So(b, should.Resemble, golden)
+		})
+	}
+}
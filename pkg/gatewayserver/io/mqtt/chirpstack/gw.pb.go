@@ -0,0 +1,2204 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file contains the subset of the ChirpStack v4 gateway API (api/proto/gw/gw.proto and
+// api/proto/common/common.proto in github.com/chirpstack/chirpstack) that the ChirpStack frontend implements.
+// The field numbers, types and names are those of the ChirpStack API; the legacy fields are omitted.
+//
+// The Go types in gw.pb.go are generated with protoc-gen-go:
+//
+//   protoc --go_out=paths=source_relative:. gw.proto
+//
+// The golden messages in testdata are encoded from the text format messages with the protobuf reference
+// implementation:
+//
+//   buf build -o /tmp/gw.binpb
+//   buf convert /tmp/gw.binpb --type gw.<Message> --from testdata/<name>.txtpb#format=txtpb --to testdata/<name>.binpb
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gw.proto
+
+package chirpstack
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CodeRate int32
+
+const (
+	CodeRate_CR_UNDEFINED CodeRate = 0
+	CodeRate_CR_4_5       CodeRate = 1
+	CodeRate_CR_4_6       CodeRate = 2
+	CodeRate_CR_4_7       CodeRate = 3
+	CodeRate_CR_4_8       CodeRate = 4
+	CodeRate_CR_3_8       CodeRate = 5
+	CodeRate_CR_2_6       CodeRate = 6
+	CodeRate_CR_1_4       CodeRate = 7
+	CodeRate_CR_1_6       CodeRate = 8
+	CodeRate_CR_5_6       CodeRate = 9
+	CodeRate_CR_LI_4_5    CodeRate = 10
+	CodeRate_CR_LI_4_6    CodeRate = 11
+	CodeRate_CR_LI_4_8    CodeRate = 12
+)
+
+// Enum value maps for CodeRate.
+var (
+	CodeRate_name = map[int32]string{
+		0:  "CR_UNDEFINED",
+		1:  "CR_4_5",
+		2:  "CR_4_6",
+		3:  "CR_4_7",
+		4:  "CR_4_8",
+		5:  "CR_3_8",
+		6:  "CR_2_6",
+		7:  "CR_1_4",
+		8:  "CR_1_6",
+		9:  "CR_5_6",
+		10: "CR_LI_4_5",
+		11: "CR_LI_4_6",
+		12: "CR_LI_4_8",
+	}
+	CodeRate_value = map[string]int32{
+		"CR_UNDEFINED": 0,
+		"CR_4_5":       1,
+		"CR_4_6":       2,
+		"CR_4_7":       3,
+		"CR_4_8":       4,
+		"CR_3_8":       5,
+		"CR_2_6":       6,
+		"CR_1_4":       7,
+		"CR_1_6":       8,
+		"CR_5_6":       9,
+		"CR_LI_4_5":    10,
+		"CR_LI_4_6":    11,
+		"CR_LI_4_8":    12,
+	}
+)
+
+func (x CodeRate) Enum() *CodeRate {
+	p := new(CodeRate)
+	*p = x
+	return p
+}
+
+func (x CodeRate) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodeRate) Descriptor() protoreflect.EnumDescriptor {
+	return file_gw_proto_enumTypes[0].Descriptor()
+}
+
+func (CodeRate) Type() protoreflect.EnumType {
+	return &file_gw_proto_enumTypes[0]
+}
+
+func (x CodeRate) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodeRate.Descriptor instead.
+func (CodeRate) EnumDescriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{0}
+}
+
+type CRCStatus int32
+
+const (
+	CRCStatus_NO_CRC  CRCStatus = 0
+	CRCStatus_BAD_CRC CRCStatus = 1
+	CRCStatus_CRC_OK  CRCStatus = 2
+)
+
+// Enum value maps for CRCStatus.
+var (
+	CRCStatus_name = map[int32]string{
+		0: "NO_CRC",
+		1: "BAD_CRC",
+		2: "CRC_OK",
+	}
+	CRCStatus_value = map[string]int32{
+		"NO_CRC":  0,
+		"BAD_CRC": 1,
+		"CRC_OK":  2,
+	}
+)
+
+func (x CRCStatus) Enum() *CRCStatus {
+	p := new(CRCStatus)
+	*p = x
+	return p
+}
+
+func (x CRCStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CRCStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_gw_proto_enumTypes[1].Descriptor()
+}
+
+func (CRCStatus) Type() protoreflect.EnumType {
+	return &file_gw_proto_enumTypes[1]
+}
+
+func (x CRCStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CRCStatus.Descriptor instead.
+func (CRCStatus) EnumDescriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{1}
+}
+
+type TxAckStatus int32
+
+const (
+	TxAckStatus_IGNORED             TxAckStatus = 0
+	TxAckStatus_OK                  TxAckStatus = 1
+	TxAckStatus_TOO_LATE            TxAckStatus = 2
+	TxAckStatus_TOO_EARLY           TxAckStatus = 3
+	TxAckStatus_COLLISION_PACKET    TxAckStatus = 4
+	TxAckStatus_COLLISION_BEACON    TxAckStatus = 5
+	TxAckStatus_TX_FREQ             TxAckStatus = 6
+	TxAckStatus_TX_POWER            TxAckStatus = 7
+	TxAckStatus_GPS_UNLOCKED        TxAckStatus = 8
+	TxAckStatus_QUEUE_FULL          TxAckStatus = 9
+	TxAckStatus_INTERNAL_ERROR      TxAckStatus = 10
+	TxAckStatus_DUTY_CYCLE_OVERFLOW TxAckStatus = 11
+)
+
+// Enum value maps for TxAckStatus.
+var (
+	TxAckStatus_name = map[int32]string{
+		0:  "IGNORED",
+		1:  "OK",
+		2:  "TOO_LATE",
+		3:  "TOO_EARLY",
+		4:  "COLLISION_PACKET",
+		5:  "COLLISION_BEACON",
+		6:  "TX_FREQ",
+		7:  "TX_POWER",
+		8:  "GPS_UNLOCKED",
+		9:  "QUEUE_FULL",
+		10: "INTERNAL_ERROR",
+		11: "DUTY_CYCLE_OVERFLOW",
+	}
+	TxAckStatus_value = map[string]int32{
+		"IGNORED":             0,
+		"OK":                  1,
+		"TOO_LATE":            2,
+		"TOO_EARLY":           3,
+		"COLLISION_PACKET":    4,
+		"COLLISION_BEACON":    5,
+		"TX_FREQ":             6,
+		"TX_POWER":            7,
+		"GPS_UNLOCKED":        8,
+		"QUEUE_FULL":          9,
+		"INTERNAL_ERROR":      10,
+		"DUTY_CYCLE_OVERFLOW": 11,
+	}
+)
+
+func (x TxAckStatus) Enum() *TxAckStatus {
+	p := new(TxAckStatus)
+	*p = x
+	return p
+}
+
+func (x TxAckStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxAckStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_gw_proto_enumTypes[2].Descriptor()
+}
+
+func (TxAckStatus) Type() protoreflect.EnumType {
+	return &file_gw_proto_enumTypes[2]
+}
+
+func (x TxAckStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxAckStatus.Descriptor instead.
+func (TxAckStatus) EnumDescriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{2}
+}
+
+// common.LocationSource
+type LocationSource int32
+
+const (
+	LocationSource_UNKNOWN LocationSource = 0
+	LocationSource_GPS     LocationSource = 1
+	LocationSource_CONFIG  LocationSource = 2
+)
+
+// Enum value maps for LocationSource.
+var (
+	LocationSource_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "GPS",
+		2: "CONFIG",
+	}
+	LocationSource_value = map[string]int32{
+		"UNKNOWN": 0,
+		"GPS":     1,
+		"CONFIG":  2,
+	}
+)
+
+func (x LocationSource) Enum() *LocationSource {
+	p := new(LocationSource)
+	*p = x
+	return p
+}
+
+func (x LocationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_gw_proto_enumTypes[3].Descriptor()
+}
+
+func (LocationSource) Type() protoreflect.EnumType {
+	return &file_gw_proto_enumTypes[3]
+}
+
+func (x LocationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocationSource.Descriptor instead.
+func (LocationSource) EnumDescriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{3}
+}
+
+// common.Location
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64        `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64        `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Altitude  float64        `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	Source    LocationSource `protobuf:"varint,4,opt,name=source,proto3,enum=gw.LocationSource" json:"source,omitempty"`
+	Accuracy  float32        `protobuf:"fixed32,5,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetAltitude() float64 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *Location) GetSource() LocationSource {
+	if x != nil {
+		return x.Source
+	}
+	return LocationSource_UNKNOWN
+}
+
+func (x *Location) GetAccuracy() float32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+type Modulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Parameters:
+	//	*Modulation_Lora
+	//	*Modulation_Fsk
+	//	*Modulation_LrFhss
+	Parameters isModulation_Parameters `protobuf_oneof:"parameters"`
+}
+
+func (x *Modulation) Reset() {
+	*x = Modulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Modulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Modulation) ProtoMessage() {}
+
+func (x *Modulation) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Modulation.ProtoReflect.Descriptor instead.
+func (*Modulation) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Modulation) GetParameters() isModulation_Parameters {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (x *Modulation) GetLora() *LoraModulationInfo {
+	if x, ok := x.GetParameters().(*Modulation_Lora); ok {
+		return x.Lora
+	}
+	return nil
+}
+
+func (x *Modulation) GetFsk() *FskModulationInfo {
+	if x, ok := x.GetParameters().(*Modulation_Fsk); ok {
+		return x.Fsk
+	}
+	return nil
+}
+
+func (x *Modulation) GetLrFhss() *LrFhssModulationInfo {
+	if x, ok := x.GetParameters().(*Modulation_LrFhss); ok {
+		return x.LrFhss
+	}
+	return nil
+}
+
+type isModulation_Parameters interface {
+	isModulation_Parameters()
+}
+
+type Modulation_Lora struct {
+	Lora *LoraModulationInfo `protobuf:"bytes,3,opt,name=lora,proto3,oneof"`
+}
+
+type Modulation_Fsk struct {
+	Fsk *FskModulationInfo `protobuf:"bytes,4,opt,name=fsk,proto3,oneof"`
+}
+
+type Modulation_LrFhss struct {
+	LrFhss *LrFhssModulationInfo `protobuf:"bytes,5,opt,name=lr_fhss,json=lrFhss,proto3,oneof"`
+}
+
+func (*Modulation_Lora) isModulation_Parameters() {}
+
+func (*Modulation_Fsk) isModulation_Parameters() {}
+
+func (*Modulation_LrFhss) isModulation_Parameters() {}
+
+type LoraModulationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bandwidth             uint32   `protobuf:"varint,1,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	SpreadingFactor       uint32   `protobuf:"varint,2,opt,name=spreading_factor,json=spreadingFactor,proto3" json:"spreading_factor,omitempty"`
+	PolarizationInversion bool     `protobuf:"varint,4,opt,name=polarization_inversion,json=polarizationInversion,proto3" json:"polarization_inversion,omitempty"`
+	CodeRate              CodeRate `protobuf:"varint,5,opt,name=code_rate,json=codeRate,proto3,enum=gw.CodeRate" json:"code_rate,omitempty"`
+	Preamble              uint32   `protobuf:"varint,6,opt,name=preamble,proto3" json:"preamble,omitempty"`
+	NoCrc                 bool     `protobuf:"varint,7,opt,name=no_crc,json=noCrc,proto3" json:"no_crc,omitempty"`
+}
+
+func (x *LoraModulationInfo) Reset() {
+	*x = LoraModulationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoraModulationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoraModulationInfo) ProtoMessage() {}
+
+func (x *LoraModulationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoraModulationInfo.ProtoReflect.Descriptor instead.
+func (*LoraModulationInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LoraModulationInfo) GetBandwidth() uint32 {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return 0
+}
+
+func (x *LoraModulationInfo) GetSpreadingFactor() uint32 {
+	if x != nil {
+		return x.SpreadingFactor
+	}
+	return 0
+}
+
+func (x *LoraModulationInfo) GetPolarizationInversion() bool {
+	if x != nil {
+		return x.PolarizationInversion
+	}
+	return false
+}
+
+func (x *LoraModulationInfo) GetCodeRate() CodeRate {
+	if x != nil {
+		return x.CodeRate
+	}
+	return CodeRate_CR_UNDEFINED
+}
+
+func (x *LoraModulationInfo) GetPreamble() uint32 {
+	if x != nil {
+		return x.Preamble
+	}
+	return 0
+}
+
+func (x *LoraModulationInfo) GetNoCrc() bool {
+	if x != nil {
+		return x.NoCrc
+	}
+	return false
+}
+
+type FskModulationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FrequencyDeviation uint32 `protobuf:"varint,1,opt,name=frequency_deviation,json=frequencyDeviation,proto3" json:"frequency_deviation,omitempty"`
+	Datarate           uint32 `protobuf:"varint,2,opt,name=datarate,proto3" json:"datarate,omitempty"`
+}
+
+func (x *FskModulationInfo) Reset() {
+	*x = FskModulationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FskModulationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FskModulationInfo) ProtoMessage() {}
+
+func (x *FskModulationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FskModulationInfo.ProtoReflect.Descriptor instead.
+func (*FskModulationInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FskModulationInfo) GetFrequencyDeviation() uint32 {
+	if x != nil {
+		return x.FrequencyDeviation
+	}
+	return 0
+}
+
+func (x *FskModulationInfo) GetDatarate() uint32 {
+	if x != nil {
+		return x.Datarate
+	}
+	return 0
+}
+
+type LrFhssModulationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperatingChannelWidth uint32   `protobuf:"varint,1,opt,name=operating_channel_width,json=operatingChannelWidth,proto3" json:"operating_channel_width,omitempty"`
+	GridSteps             uint32   `protobuf:"varint,3,opt,name=grid_steps,json=gridSteps,proto3" json:"grid_steps,omitempty"`
+	CodeRate              CodeRate `protobuf:"varint,4,opt,name=code_rate,json=codeRate,proto3,enum=gw.CodeRate" json:"code_rate,omitempty"`
+}
+
+func (x *LrFhssModulationInfo) Reset() {
+	*x = LrFhssModulationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LrFhssModulationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LrFhssModulationInfo) ProtoMessage() {}
+
+func (x *LrFhssModulationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LrFhssModulationInfo.ProtoReflect.Descriptor instead.
+func (*LrFhssModulationInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LrFhssModulationInfo) GetOperatingChannelWidth() uint32 {
+	if x != nil {
+		return x.OperatingChannelWidth
+	}
+	return 0
+}
+
+func (x *LrFhssModulationInfo) GetGridSteps() uint32 {
+	if x != nil {
+		return x.GridSteps
+	}
+	return 0
+}
+
+func (x *LrFhssModulationInfo) GetCodeRate() CodeRate {
+	if x != nil {
+		return x.CodeRate
+	}
+	return CodeRate_CR_UNDEFINED
+}
+
+type UplinkTxInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frequency  uint32      `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Modulation *Modulation `protobuf:"bytes,2,opt,name=modulation,proto3" json:"modulation,omitempty"`
+}
+
+func (x *UplinkTxInfo) Reset() {
+	*x = UplinkTxInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UplinkTxInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UplinkTxInfo) ProtoMessage() {}
+
+func (x *UplinkTxInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UplinkTxInfo.ProtoReflect.Descriptor instead.
+func (*UplinkTxInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UplinkTxInfo) GetFrequency() uint32 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *UplinkTxInfo) GetModulation() *Modulation {
+	if x != nil {
+		return x.Modulation
+	}
+	return nil
+}
+
+type UplinkRxInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayId             string                 `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	UplinkId              uint32                 `protobuf:"varint,2,opt,name=uplink_id,json=uplinkId,proto3" json:"uplink_id,omitempty"`
+	GwTime                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=gw_time,json=gwTime,proto3" json:"gw_time,omitempty"`
+	TimeSinceGpsEpoch     *durationpb.Duration   `protobuf:"bytes,4,opt,name=time_since_gps_epoch,json=timeSinceGpsEpoch,proto3" json:"time_since_gps_epoch,omitempty"`
+	Rssi                  int32                  `protobuf:"varint,5,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	Snr                   float32                `protobuf:"fixed32,6,opt,name=snr,proto3" json:"snr,omitempty"`
+	Channel               uint32                 `protobuf:"varint,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	RfChain               uint32                 `protobuf:"varint,8,opt,name=rf_chain,json=rfChain,proto3" json:"rf_chain,omitempty"`
+	Board                 uint32                 `protobuf:"varint,9,opt,name=board,proto3" json:"board,omitempty"`
+	Antenna               uint32                 `protobuf:"varint,10,opt,name=antenna,proto3" json:"antenna,omitempty"`
+	Location              *Location              `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	Context               []byte                 `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	Metadata              map[string]string      `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CrcStatus             CRCStatus              `protobuf:"varint,16,opt,name=crc_status,json=crcStatus,proto3,enum=gw.CRCStatus" json:"crc_status,omitempty"`
+	FineTimeSinceGpsEpoch *durationpb.Duration   `protobuf:"bytes,18,opt,name=fine_time_since_gps_epoch,json=fineTimeSinceGpsEpoch,proto3" json:"fine_time_since_gps_epoch,omitempty"`
+}
+
+func (x *UplinkRxInfo) Reset() {
+	*x = UplinkRxInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UplinkRxInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UplinkRxInfo) ProtoMessage() {}
+
+func (x *UplinkRxInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UplinkRxInfo.ProtoReflect.Descriptor instead.
+func (*UplinkRxInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UplinkRxInfo) GetGatewayId() string {
+	if x != nil {
+		return x.GatewayId
+	}
+	return ""
+}
+
+func (x *UplinkRxInfo) GetUplinkId() uint32 {
+	if x != nil {
+		return x.UplinkId
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetGwTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GwTime
+	}
+	return nil
+}
+
+func (x *UplinkRxInfo) GetTimeSinceGpsEpoch() *durationpb.Duration {
+	if x != nil {
+		return x.TimeSinceGpsEpoch
+	}
+	return nil
+}
+
+func (x *UplinkRxInfo) GetRssi() int32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetSnr() float32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetRfChain() uint32 {
+	if x != nil {
+		return x.RfChain
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetBoard() uint32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetAntenna() uint32 {
+	if x != nil {
+		return x.Antenna
+	}
+	return 0
+}
+
+func (x *UplinkRxInfo) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UplinkRxInfo) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UplinkRxInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UplinkRxInfo) GetCrcStatus() CRCStatus {
+	if x != nil {
+		return x.CrcStatus
+	}
+	return CRCStatus_NO_CRC
+}
+
+func (x *UplinkRxInfo) GetFineTimeSinceGpsEpoch() *durationpb.Duration {
+	if x != nil {
+		return x.FineTimeSinceGpsEpoch
+	}
+	return nil
+}
+
+type UplinkFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhyPayload []byte        `protobuf:"bytes,1,opt,name=phy_payload,json=phyPayload,proto3" json:"phy_payload,omitempty"`
+	TxInfo     *UplinkTxInfo `protobuf:"bytes,4,opt,name=tx_info,json=txInfo,proto3" json:"tx_info,omitempty"`
+	RxInfo     *UplinkRxInfo `protobuf:"bytes,5,opt,name=rx_info,json=rxInfo,proto3" json:"rx_info,omitempty"`
+}
+
+func (x *UplinkFrame) Reset() {
+	*x = UplinkFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UplinkFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UplinkFrame) ProtoMessage() {}
+
+func (x *UplinkFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UplinkFrame.ProtoReflect.Descriptor instead.
+func (*UplinkFrame) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UplinkFrame) GetPhyPayload() []byte {
+	if x != nil {
+		return x.PhyPayload
+	}
+	return nil
+}
+
+func (x *UplinkFrame) GetTxInfo() *UplinkTxInfo {
+	if x != nil {
+		return x.TxInfo
+	}
+	return nil
+}
+
+func (x *UplinkFrame) GetRxInfo() *UplinkRxInfo {
+	if x != nil {
+		return x.RxInfo
+	}
+	return nil
+}
+
+type ImmediatelyTimingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImmediatelyTimingInfo) Reset() {
+	*x = ImmediatelyTimingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImmediatelyTimingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImmediatelyTimingInfo) ProtoMessage() {}
+
+func (x *ImmediatelyTimingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImmediatelyTimingInfo.ProtoReflect.Descriptor instead.
+func (*ImmediatelyTimingInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{8}
+}
+
+type DelayTimingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delay *durationpb.Duration `protobuf:"bytes,1,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *DelayTimingInfo) Reset() {
+	*x = DelayTimingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayTimingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayTimingInfo) ProtoMessage() {}
+
+func (x *DelayTimingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayTimingInfo.ProtoReflect.Descriptor instead.
+func (*DelayTimingInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DelayTimingInfo) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+type GPSEpochTimingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeSinceGpsEpoch *durationpb.Duration `protobuf:"bytes,1,opt,name=time_since_gps_epoch,json=timeSinceGpsEpoch,proto3" json:"time_since_gps_epoch,omitempty"`
+}
+
+func (x *GPSEpochTimingInfo) Reset() {
+	*x = GPSEpochTimingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPSEpochTimingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPSEpochTimingInfo) ProtoMessage() {}
+
+func (x *GPSEpochTimingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPSEpochTimingInfo.ProtoReflect.Descriptor instead.
+func (*GPSEpochTimingInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GPSEpochTimingInfo) GetTimeSinceGpsEpoch() *durationpb.Duration {
+	if x != nil {
+		return x.TimeSinceGpsEpoch
+	}
+	return nil
+}
+
+type Timing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Parameters:
+	//	*Timing_Immediately
+	//	*Timing_Delay
+	//	*Timing_GpsEpoch
+	Parameters isTiming_Parameters `protobuf_oneof:"parameters"`
+}
+
+func (x *Timing) Reset() {
+	*x = Timing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timing) ProtoMessage() {}
+
+func (x *Timing) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timing.ProtoReflect.Descriptor instead.
+func (*Timing) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *Timing) GetParameters() isTiming_Parameters {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (x *Timing) GetImmediately() *ImmediatelyTimingInfo {
+	if x, ok := x.GetParameters().(*Timing_Immediately); ok {
+		return x.Immediately
+	}
+	return nil
+}
+
+func (x *Timing) GetDelay() *DelayTimingInfo {
+	if x, ok := x.GetParameters().(*Timing_Delay); ok {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *Timing) GetGpsEpoch() *GPSEpochTimingInfo {
+	if x, ok := x.GetParameters().(*Timing_GpsEpoch); ok {
+		return x.GpsEpoch
+	}
+	return nil
+}
+
+type isTiming_Parameters interface {
+	isTiming_Parameters()
+}
+
+type Timing_Immediately struct {
+	Immediately *ImmediatelyTimingInfo `protobuf:"bytes,1,opt,name=immediately,proto3,oneof"`
+}
+
+type Timing_Delay struct {
+	Delay *DelayTimingInfo `protobuf:"bytes,2,opt,name=delay,proto3,oneof"`
+}
+
+type Timing_GpsEpoch struct {
+	GpsEpoch *GPSEpochTimingInfo `protobuf:"bytes,3,opt,name=gps_epoch,json=gpsEpoch,proto3,oneof"`
+}
+
+func (*Timing_Immediately) isTiming_Parameters() {}
+
+func (*Timing_Delay) isTiming_Parameters() {}
+
+func (*Timing_GpsEpoch) isTiming_Parameters() {}
+
+type DownlinkTxInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frequency  uint32      `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Power      int32       `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Modulation *Modulation `protobuf:"bytes,3,opt,name=modulation,proto3" json:"modulation,omitempty"`
+	Board      uint32      `protobuf:"varint,4,opt,name=board,proto3" json:"board,omitempty"`
+	Antenna    uint32      `protobuf:"varint,5,opt,name=antenna,proto3" json:"antenna,omitempty"`
+	Timing     *Timing     `protobuf:"bytes,6,opt,name=timing,proto3" json:"timing,omitempty"`
+	Context    []byte      `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *DownlinkTxInfo) Reset() {
+	*x = DownlinkTxInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkTxInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkTxInfo) ProtoMessage() {}
+
+func (x *DownlinkTxInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkTxInfo.ProtoReflect.Descriptor instead.
+func (*DownlinkTxInfo) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DownlinkTxInfo) GetFrequency() uint32 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *DownlinkTxInfo) GetPower() int32 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *DownlinkTxInfo) GetModulation() *Modulation {
+	if x != nil {
+		return x.Modulation
+	}
+	return nil
+}
+
+func (x *DownlinkTxInfo) GetBoard() uint32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *DownlinkTxInfo) GetAntenna() uint32 {
+	if x != nil {
+		return x.Antenna
+	}
+	return 0
+}
+
+func (x *DownlinkTxInfo) GetTiming() *Timing {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *DownlinkTxInfo) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DownlinkFrameItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhyPayload []byte          `protobuf:"bytes,1,opt,name=phy_payload,json=phyPayload,proto3" json:"phy_payload,omitempty"`
+	TxInfo     *DownlinkTxInfo `protobuf:"bytes,3,opt,name=tx_info,json=txInfo,proto3" json:"tx_info,omitempty"`
+}
+
+func (x *DownlinkFrameItem) Reset() {
+	*x = DownlinkFrameItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkFrameItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkFrameItem) ProtoMessage() {}
+
+func (x *DownlinkFrameItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkFrameItem.ProtoReflect.Descriptor instead.
+func (*DownlinkFrameItem) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DownlinkFrameItem) GetPhyPayload() []byte {
+	if x != nil {
+		return x.PhyPayload
+	}
+	return nil
+}
+
+func (x *DownlinkFrameItem) GetTxInfo() *DownlinkTxInfo {
+	if x != nil {
+		return x.TxInfo
+	}
+	return nil
+}
+
+type DownlinkFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownlinkId uint32               `protobuf:"varint,3,opt,name=downlink_id,json=downlinkId,proto3" json:"downlink_id,omitempty"`
+	Items      []*DownlinkFrameItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	GatewayId  string               `protobuf:"bytes,7,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+}
+
+func (x *DownlinkFrame) Reset() {
+	*x = DownlinkFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkFrame) ProtoMessage() {}
+
+func (x *DownlinkFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkFrame.ProtoReflect.Descriptor instead.
+func (*DownlinkFrame) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DownlinkFrame) GetDownlinkId() uint32 {
+	if x != nil {
+		return x.DownlinkId
+	}
+	return 0
+}
+
+func (x *DownlinkFrame) GetItems() []*DownlinkFrameItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DownlinkFrame) GetGatewayId() string {
+	if x != nil {
+		return x.GatewayId
+	}
+	return ""
+}
+
+type DownlinkTxAckItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status TxAckStatus `protobuf:"varint,1,opt,name=status,proto3,enum=gw.TxAckStatus" json:"status,omitempty"`
+}
+
+func (x *DownlinkTxAckItem) Reset() {
+	*x = DownlinkTxAckItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkTxAckItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkTxAckItem) ProtoMessage() {}
+
+func (x *DownlinkTxAckItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkTxAckItem.ProtoReflect.Descriptor instead.
+func (*DownlinkTxAckItem) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DownlinkTxAckItem) GetStatus() TxAckStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TxAckStatus_IGNORED
+}
+
+type DownlinkTxAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownlinkId uint32               `protobuf:"varint,2,opt,name=downlink_id,json=downlinkId,proto3" json:"downlink_id,omitempty"`
+	Items      []*DownlinkTxAckItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	GatewayId  string               `protobuf:"bytes,6,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+}
+
+func (x *DownlinkTxAck) Reset() {
+	*x = DownlinkTxAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkTxAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkTxAck) ProtoMessage() {}
+
+func (x *DownlinkTxAck) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkTxAck.ProtoReflect.Descriptor instead.
+func (*DownlinkTxAck) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DownlinkTxAck) GetDownlinkId() uint32 {
+	if x != nil {
+		return x.DownlinkId
+	}
+	return 0
+}
+
+func (x *DownlinkTxAck) GetItems() []*DownlinkTxAckItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DownlinkTxAck) GetGatewayId() string {
+	if x != nil {
+		return x.GatewayId
+	}
+	return ""
+}
+
+type GatewayStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time                *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Location            *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	ConfigVersion       string                 `protobuf:"bytes,4,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	RxPacketsReceived   uint32                 `protobuf:"varint,5,opt,name=rx_packets_received,json=rxPacketsReceived,proto3" json:"rx_packets_received,omitempty"`
+	RxPacketsReceivedOk uint32                 `protobuf:"varint,6,opt,name=rx_packets_received_ok,json=rxPacketsReceivedOk,proto3" json:"rx_packets_received_ok,omitempty"`
+	TxPacketsReceived   uint32                 `protobuf:"varint,7,opt,name=tx_packets_received,json=txPacketsReceived,proto3" json:"tx_packets_received,omitempty"`
+	TxPacketsEmitted    uint32                 `protobuf:"varint,8,opt,name=tx_packets_emitted,json=txPacketsEmitted,proto3" json:"tx_packets_emitted,omitempty"`
+	Metadata            map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GatewayId           string                 `protobuf:"bytes,17,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+}
+
+func (x *GatewayStats) Reset() {
+	*x = GatewayStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gw_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayStats) ProtoMessage() {}
+
+func (x *GatewayStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gw_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayStats.ProtoReflect.Descriptor instead.
+func (*GatewayStats) Descriptor() ([]byte, []int) {
+	return file_gw_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GatewayStats) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GatewayStats) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GatewayStats) GetConfigVersion() string {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return ""
+}
+
+func (x *GatewayStats) GetRxPacketsReceived() uint32 {
+	if x != nil {
+		return x.RxPacketsReceived
+	}
+	return 0
+}
+
+func (x *GatewayStats) GetRxPacketsReceivedOk() uint32 {
+	if x != nil {
+		return x.RxPacketsReceivedOk
+	}
+	return 0
+}
+
+func (x *GatewayStats) GetTxPacketsReceived() uint32 {
+	if x != nil {
+		return x.TxPacketsReceived
+	}
+	return 0
+}
+
+func (x *GatewayStats) GetTxPacketsEmitted() uint32 {
+	if x != nil {
+		return x.TxPacketsEmitted
+	}
+	return 0
+}
+
+func (x *GatewayStats) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GatewayStats) GetGatewayId() string {
+	if x != nil {
+		return x.GatewayId
+	}
+	return ""
+}
+
+var File_gw_proto protoreflect.FileDescriptor
+
+var file_gw_proto_rawDesc = []byte{
+	0x0a, 0x08, 0x67, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x67, 0x77, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa8, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x77, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x22, 0xa8, 0x01, 0x0a, 0x0a, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x6f, 0x72,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x77, 0x2e, 0x4c, 0x6f, 0x72,
+	0x61, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x04, 0x6c, 0x6f, 0x72, 0x61, 0x12, 0x29, 0x0a, 0x03, 0x66, 0x73, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x2e, 0x46, 0x73, 0x6b, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x03, 0x66,
+	0x73, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x72, 0x5f, 0x66, 0x68, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x77, 0x2e, 0x4c, 0x72, 0x46, 0x68, 0x73, 0x73, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x06, 0x6c, 0x72, 0x46, 0x68, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x72, 0x61, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x70,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x16, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0c, 0x2e, 0x67, 0x77, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x08, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x61, 0x6d,
+	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x65, 0x61, 0x6d,
+	0x62, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x5f, 0x63, 0x72, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x43, 0x72, 0x63, 0x22, 0x60, 0x0a, 0x11, 0x46, 0x73,
+	0x6b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x2f, 0x0a, 0x13, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x72, 0x61, 0x74, 0x65, 0x22, 0x98, 0x01, 0x0a,
+	0x14, 0x4c, 0x72, 0x46, 0x68, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x67, 0x72, 0x69, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0c, 0x2e, 0x67, 0x77, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x08, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x77, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x05, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x67, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x67, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x70, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x47, 0x70, 0x73, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x73, 0x6e, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x6e, 0x61, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x6e, 0x61, 0x12, 0x28,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x67, 0x77, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x77, 0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c,
+	0x0a, 0x0a, 0x63, 0x72, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x67, 0x77, 0x2e, 0x43, 0x52, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x09, 0x63, 0x72, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x19,
+	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f,
+	0x67, 0x70, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x66, 0x69, 0x6e, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x47, 0x70, 0x73, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84,
+	0x01, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x68, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x29, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x77, 0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x78, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x74, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x78,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x77,
+	0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x72,
+	0x78, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x65, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x42,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x22, 0x60, 0x0a, 0x12, 0x47, 0x50, 0x53, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69,
+	0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x70, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x47, 0x70, 0x73, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0xb9, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x3d, 0x0a, 0x0b, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x77, 0x2e, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x74, 0x65, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x0b, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x12, 0x2b,
+	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x77, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x67,
+	0x70, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x77, 0x2e, 0x47, 0x50, 0x53, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x67, 0x70, 0x73, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0xe2, 0x01, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x78, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x77,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x61, 0x6e, 0x74, 0x65, 0x6e, 0x6e, 0x61, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x77, 0x2e, 0x54, 0x69, 0x6d,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x61, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68,
+	0x79, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x70, 0x68, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x74,
+	0x78, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x77, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x74, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x7c, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x77,
+	0x2e, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x54, 0x78, 0x41, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x77, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x49, 0x64, 0x22, 0xea, 0x03, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x77, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4f, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x78, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x78, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x77, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x49, 0x64, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a,
+	0xb5, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c,
+	0x43, 0x52, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x52, 0x5f, 0x34, 0x5f, 0x35, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52,
+	0x5f, 0x34, 0x5f, 0x36, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x5f, 0x34, 0x5f, 0x37,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x5f, 0x34, 0x5f, 0x38, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x52, 0x5f, 0x33, 0x5f, 0x38, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52,
+	0x5f, 0x32, 0x5f, 0x36, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x5f, 0x31, 0x5f, 0x34,
+	0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x5f, 0x31, 0x5f, 0x36, 0x10, 0x08, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x52, 0x5f, 0x35, 0x5f, 0x36, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x52,
+	0x5f, 0x4c, 0x49, 0x5f, 0x34, 0x5f, 0x35, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x52, 0x5f,
+	0x4c, 0x49, 0x5f, 0x34, 0x5f, 0x36, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x52, 0x5f, 0x4c,
+	0x49, 0x5f, 0x34, 0x5f, 0x38, 0x10, 0x0c, 0x2a, 0x30, 0x0a, 0x09, 0x43, 0x52, 0x43, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x43, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x41, 0x44, 0x5f, 0x43, 0x52, 0x43, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x52, 0x43, 0x5f, 0x4f, 0x4b, 0x10, 0x02, 0x2a, 0xd5, 0x01, 0x0a, 0x0b, 0x54, 0x78,
+	0x41, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x47, 0x4e,
+	0x4f, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x54, 0x4f, 0x4f, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x4f, 0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10,
+	0x04, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42,
+	0x45, 0x41, 0x43, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x58, 0x5f, 0x46, 0x52,
+	0x45, 0x51, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x58, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52,
+	0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x53, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b,
+	0x45, 0x44, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x46, 0x55,
+	0x4c, 0x4c, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x55, 0x54, 0x59,
+	0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x10,
+	0x0b, 0x2a, 0x32, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x50, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x10, 0x02, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x69, 0x6f, 0x2f, 0x6d, 0x71, 0x74, 0x74, 0x2f, 0x63, 0x68, 0x69, 0x72, 0x70, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gw_proto_rawDescOnce sync.Once
+	file_gw_proto_rawDescData = file_gw_proto_rawDesc
+)
+
+func file_gw_proto_rawDescGZIP() []byte {
+	file_gw_proto_rawDescOnce.Do(func() {
+		file_gw_proto_rawDescData = protoimpl.X.CompressGZIP(file_gw_proto_rawDescData)
+	})
+	return file_gw_proto_rawDescData
+}
+
+var file_gw_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_gw_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_gw_proto_goTypes = []interface{}{
+	(CodeRate)(0),                 // 0: gw.CodeRate
+	(CRCStatus)(0),                // 1: gw.CRCStatus
+	(TxAckStatus)(0),              // 2: gw.TxAckStatus
+	(LocationSource)(0),           // 3: gw.LocationSource
+	(*Location)(nil),              // 4: gw.Location
+	(*Modulation)(nil),            // 5: gw.Modulation
+	(*LoraModulationInfo)(nil),    // 6: gw.LoraModulationInfo
+	(*FskModulationInfo)(nil),     // 7: gw.FskModulationInfo
+	(*LrFhssModulationInfo)(nil),  // 8: gw.LrFhssModulationInfo
+	(*UplinkTxInfo)(nil),          // 9: gw.UplinkTxInfo
+	(*UplinkRxInfo)(nil),          // 10: gw.UplinkRxInfo
+	(*UplinkFrame)(nil),           // 11: gw.UplinkFrame
+	(*ImmediatelyTimingInfo)(nil), // 12: gw.ImmediatelyTimingInfo
+	(*DelayTimingInfo)(nil),       // 13: gw.DelayTimingInfo
+	(*GPSEpochTimingInfo)(nil),    // 14: gw.GPSEpochTimingInfo
+	(*Timing)(nil),                // 15: gw.Timing
+	(*DownlinkTxInfo)(nil),        // 16: gw.DownlinkTxInfo
+	(*DownlinkFrameItem)(nil),     // 17: gw.DownlinkFrameItem
+	(*DownlinkFrame)(nil),         // 18: gw.DownlinkFrame
+	(*DownlinkTxAckItem)(nil),     // 19: gw.DownlinkTxAckItem
+	(*DownlinkTxAck)(nil),         // 20: gw.DownlinkTxAck
+	(*GatewayStats)(nil),          // 21: gw.GatewayStats
+	nil,                           // 22: gw.UplinkRxInfo.MetadataEntry
+	nil,                           // 23: gw.GatewayStats.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 25: google.protobuf.Duration
+}
+var file_gw_proto_depIdxs = []int32{
+	3,  // 0: gw.Location.source:type_name -> gw.LocationSource
+	6,  // 1: gw.Modulation.lora:type_name -> gw.LoraModulationInfo
+	7,  // 2: gw.Modulation.fsk:type_name -> gw.FskModulationInfo
+	8,  // 3: gw.Modulation.lr_fhss:type_name -> gw.LrFhssModulationInfo
+	0,  // 4: gw.LoraModulationInfo.code_rate:type_name -> gw.CodeRate
+	0,  // 5: gw.LrFhssModulationInfo.code_rate:type_name -> gw.CodeRate
+	5,  // 6: gw.UplinkTxInfo.modulation:type_name -> gw.Modulation
+	24, // 7: gw.UplinkRxInfo.gw_time:type_name -> google.protobuf.Timestamp
+	25, // 8: gw.UplinkRxInfo.time_since_gps_epoch:type_name -> google.protobuf.Duration
+	4,  // 9: gw.UplinkRxInfo.location:type_name -> gw.Location
+	22, // 10: gw.UplinkRxInfo.metadata:type_name -> gw.UplinkRxInfo.MetadataEntry
+	1,  // 11: gw.UplinkRxInfo.crc_status:type_name -> gw.CRCStatus
+	25, // 12: gw.UplinkRxInfo.fine_time_since_gps_epoch:type_name -> google.protobuf.Duration
+	9,  // 13: gw.UplinkFrame.tx_info:type_name -> gw.UplinkTxInfo
+	10, // 14: gw.UplinkFrame.rx_info:type_name -> gw.UplinkRxInfo
+	25, // 15: gw.DelayTimingInfo.delay:type_name -> google.protobuf.Duration
+	25, // 16: gw.GPSEpochTimingInfo.time_since_gps_epoch:type_name -> google.protobuf.Duration
+	12, // 17: gw.Timing.immediately:type_name -> gw.ImmediatelyTimingInfo
+	13, // 18: gw.Timing.delay:type_name -> gw.DelayTimingInfo
+	14, // 19: gw.Timing.gps_epoch:type_name -> gw.GPSEpochTimingInfo
+	5,  // 20: gw.DownlinkTxInfo.modulation:type_name -> gw.Modulation
+	15, // 21: gw.DownlinkTxInfo.timing:type_name -> gw.Timing
+	16, // 22: gw.DownlinkFrameItem.tx_info:type_name -> gw.DownlinkTxInfo
+	17, // 23: gw.DownlinkFrame.items:type_name -> gw.DownlinkFrameItem
+	2,  // 24: gw.DownlinkTxAckItem.status:type_name -> gw.TxAckStatus
+	19, // 25: gw.DownlinkTxAck.items:type_name -> gw.DownlinkTxAckItem
+	24, // 26: gw.GatewayStats.time:type_name -> google.protobuf.Timestamp
+	4,  // 27: gw.GatewayStats.location:type_name -> gw.Location
+	23, // 28: gw.GatewayStats.metadata:type_name -> gw.GatewayStats.MetadataEntry
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_gw_proto_init() }
+func file_gw_proto_init() {
+	if File_gw_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gw_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Modulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoraModulationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FskModulationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LrFhssModulationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UplinkTxInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UplinkRxInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UplinkFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImmediatelyTimingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayTimingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPSEpochTimingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkTxInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkFrameItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkTxAckItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkTxAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gw_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_gw_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Modulation_Lora)(nil),
+		(*Modulation_Fsk)(nil),
+		(*Modulation_LrFhss)(nil),
+	}
+	file_gw_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*Timing_Immediately)(nil),
+		(*Timing_Delay)(nil),
+		(*Timing_GpsEpoch)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gw_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gw_proto_goTypes,
+		DependencyIndexes: file_gw_proto_depIdxs,
+		EnumInfos:         file_gw_proto_enumTypes,
+		MessageInfos:      file_gw_proto_msgTypes,
+	}.Build()
+	File_gw_proto = out.File
+	file_gw_proto_rawDesc = nil
+	file_gw_proto_goTypes = nil
+	file_gw_proto_depIdxs = nil
+}
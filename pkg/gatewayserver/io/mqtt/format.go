@@ -15,9 +15,12 @@
 package mqtt
 
 import (
+	"context"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
 
 // Format formats topics, downlink, uplink and status messages.
@@ -30,4 +33,30 @@ type Format interface {
 	ToTxAck(message []byte, ids *ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error)
 }
 
+// TopicIdentifier is implemented by formats that identify gateways in topics by other means than their unique ID.
+type TopicIdentifier interface {
+	TopicIdentifier(ctx context.Context, ids *ttnpb.GatewayIdentifiers) string
+}
+
+// topicIdentifier returns the identifier of the gateway in the topics of the given format.
+func topicIdentifier(ctx context.Context, format Format, ids *ttnpb.GatewayIdentifiers) string {
+	if ti, ok := format.(TopicIdentifier); ok {
+		return ti.TopicIdentifier(ctx, ids)
+	}
+	return unique.ID(ctx, ids)
+}
+
+// BandLayout is implemented by formats of which the topic layout depends on the band of the gateway.
+type BandLayout interface {
+	BandLayout(bandID string) topics.Layout
+}
+
+// topicLayout returns the topic layout of the given format for a gateway in the given band.
+func topicLayout(format Format, bandID string) topics.Layout {
+	if bl, ok := format.(BandLayout); ok {
+		return bl.BandLayout(bandID)
+	}
+	return format
+}
+
 var errNotSupported = errors.DefineFailedPrecondition("not_supported", "not supported")
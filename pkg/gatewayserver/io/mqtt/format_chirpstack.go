@@ -0,0 +1,355 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	errTxInfo = errors.DefineInvalidArgument("tx_info", "missing transmission information")
+	errRxInfo = errors.DefineInvalidArgument("rx_info", "missing reception information")
+)
+
+var (
+	codeRateToChirpStack = map[string]chirpstack.CodeRate{
+		"4/5":   chirpstack.CodeRate_CR_4_5,
+		"4/6":   chirpstack.CodeRate_CR_4_6,
+		"4/7":   chirpstack.CodeRate_CR_4_7,
+		"4/8":   chirpstack.CodeRate_CR_4_8,
+		"3/8":   chirpstack.CodeRate_CR_3_8,
+		"2/6":   chirpstack.CodeRate_CR_2_6,
+		"1/4":   chirpstack.CodeRate_CR_1_4,
+		"1/6":   chirpstack.CodeRate_CR_1_6,
+		"5/6":   chirpstack.CodeRate_CR_5_6,
+		"4/5LI": chirpstack.CodeRate_CR_LI_4_5,
+		"4/6LI": chirpstack.CodeRate_CR_LI_4_6,
+		"4/8LI": chirpstack.CodeRate_CR_LI_4_8,
+	}
+	codeRateFromChirpStack = func() map[chirpstack.CodeRate]string {
+		m := make(map[chirpstack.CodeRate]string, len(codeRateToChirpStack))
+		for s, cr := range codeRateToChirpStack {
+			m[cr] = s
+		}
+		return m
+	}()
+
+	txAckStatusToV3 = map[chirpstack.TxAckStatus]ttnpb.TxAcknowledgment_Result{
+		chirpstack.TxAckStatus_OK:               ttnpb.TxAcknowledgment_SUCCESS,
+		chirpstack.TxAckStatus_TOO_LATE:         ttnpb.TxAcknowledgment_TOO_LATE,
+		chirpstack.TxAckStatus_TOO_EARLY:        ttnpb.TxAcknowledgment_TOO_EARLY,
+		chirpstack.TxAckStatus_COLLISION_PACKET: ttnpb.TxAcknowledgment_COLLISION_PACKET,
+		chirpstack.TxAckStatus_COLLISION_BEACON: ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		chirpstack.TxAckStatus_TX_FREQ:          ttnpb.TxAcknowledgment_TX_FREQ,
+		chirpstack.TxAckStatus_TX_POWER:         ttnpb.TxAcknowledgment_TX_POWER,
+		chirpstack.TxAckStatus_GPS_UNLOCKED:     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+	}
+
+	locationSourceToV3 = map[chirpstack.LocationSource]ttnpb.LocationSource{
+		chirpstack.LocationSource_GPS:    ttnpb.LocationSource_SOURCE_GPS,
+		chirpstack.LocationSource_CONFIG: ttnpb.LocationSource_SOURCE_REGISTRY,
+	}
+)
+
+type chirpStack struct {
+	topics.Layout
+	bandLayouts map[string]topics.Layout
+}
+
+// TopicIdentifier implements TopicIdentifier.
+// The ChirpStack Gateway Bridge identifies gateways by their EUI in lowercase hexadecimal format.
+func (chirpStack) TopicIdentifier(ctx context.Context, ids *ttnpb.GatewayIdentifiers) string {
+	if eui := types.MustEUI64(ids.GetEui()).OrZero(); !eui.IsZero() {
+		return chirpStackGatewayID(eui)
+	}
+	return unique.ID(ctx, ids)
+}
+
+// BandLayout implements BandLayout.
+// The ChirpStack Gateway Bridge prefixes the topics with the region, which is configured per band.
+func (cs chirpStack) BandLayout(bandID string) topics.Layout {
+	if layout, ok := cs.bandLayouts[bandID]; ok {
+		return layout
+	}
+	return cs.Layout
+}
+
+func chirpStackGatewayID(eui types.EUI64) string {
+	return strings.ToLower(eui.String())
+}
+
+// chirpStackContext returns the gateway specific context that contains the concentrator timestamp.
+func chirpStackContext(timestamp uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, timestamp)
+	return b
+}
+
+func chirpStackTimestamp(ctx []byte) uint32 {
+	if len(ctx) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(ctx)
+}
+
+func chirpStackLocation(loc *chirpstack.Location) *ttnpb.Location {
+	return &ttnpb.Location{
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Altitude:  int32(loc.Altitude),
+		Accuracy:  int32(loc.Accuracy),
+		Source:    locationSourceToV3[loc.Source],
+	}
+}
+
+func (chirpStack) FromDownlink(down *ttnpb.DownlinkMessage, ids *ttnpb.GatewayIdentifiers) ([]byte, error) {
+	settings := down.GetScheduled()
+	if settings == nil {
+		return nil, errNotScheduled.New()
+	}
+	txInfo := &chirpstack.DownlinkTxInfo{
+		Frequency: uint32(settings.Frequency),
+		Power:     int32(settings.GetDownlink().GetTxPower() - eirpDelta),
+		Antenna:   settings.GetDownlink().GetAntennaIndex(),
+	}
+	switch dr := settings.GetDataRate().GetModulation().(type) {
+	case *ttnpb.DataRate_Lora:
+		txInfo.Modulation = &chirpstack.Modulation{
+			Parameters: &chirpstack.Modulation_Lora{
+				Lora: &chirpstack.LoraModulationInfo{
+					Bandwidth:             dr.Lora.Bandwidth,
+					SpreadingFactor:       dr.Lora.SpreadingFactor,
+					PolarizationInversion: settings.GetDownlink().GetInvertPolarization(),
+					CodeRate:              codeRateToChirpStack[dr.Lora.CodingRate],
+					NoCrc:                 !settings.EnableCrc,
+				},
+			},
+		}
+	case *ttnpb.DataRate_Fsk:
+		txInfo.Modulation = &chirpstack.Modulation{
+			Parameters: &chirpstack.Modulation_Fsk{
+				Fsk: &chirpstack.FskModulationInfo{
+					FrequencyDeviation: dr.Fsk.BitRate / 2,
+					Datarate:           dr.Fsk.BitRate,
+				},
+			},
+		}
+	default:
+		return nil, errModulation.New()
+	}
+	switch {
+	case settings.Timestamp != 0:
+		txInfo.Timing = &chirpstack.Timing{
+			Parameters: &chirpstack.Timing_Delay{
+				Delay: &chirpstack.DelayTimingInfo{
+					Delay: durationpb.New(0),
+				},
+			},
+		}
+		txInfo.Context = chirpStackContext(settings.Timestamp)
+	case settings.Time != nil:
+		txInfo.Timing = &chirpstack.Timing{
+			Parameters: &chirpstack.Timing_GpsEpoch{
+				GpsEpoch: &chirpstack.GPSEpochTimingInfo{
+					TimeSinceGpsEpoch: durationpb.New(gpstime.ToGPS(*ttnpb.StdTime(settings.Time))),
+				},
+			},
+		}
+	default:
+		txInfo.Timing = &chirpstack.Timing{
+			Parameters: &chirpstack.Timing_Immediately{
+				Immediately: &chirpstack.ImmediatelyTimingInfo{},
+			},
+		}
+	}
+	frame := &chirpstack.DownlinkFrame{
+		Items: []*chirpstack.DownlinkFrameItem{
+			{
+				PhyPayload: down.RawPayload,
+				TxInfo:     txInfo,
+			},
+		},
+	}
+	if token, ok := (io.DownlinkTokens{}).ParseTokenFromCorrelationIDs(down.CorrelationIds); ok {
+		frame.DownlinkId = uint32(token)
+	}
+	if eui := types.MustEUI64(ids.GetEui()).OrZero(); !eui.IsZero() {
+		frame.GatewayId = chirpStackGatewayID(eui)
+	}
+	return proto.Marshal(frame)
+}
+
+func (chirpStack) ToUplink(message []byte, ids *ttnpb.GatewayIdentifiers) (*ttnpb.UplinkMessage, error) {
+	frame := &chirpstack.UplinkFrame{}
+	if err := proto.Unmarshal(message, frame); err != nil {
+		return nil, err
+	}
+	txInfo, rxInfo := frame.TxInfo, frame.RxInfo
+	if txInfo == nil {
+		return nil, errTxInfo.New()
+	}
+	if rxInfo == nil {
+		return nil, errRxInfo.New()
+	}
+
+	settings := &ttnpb.TxSettings{
+		Frequency: uint64(txInfo.Frequency),
+		Timestamp: chirpStackTimestamp(rxInfo.Context),
+	}
+	switch mod := txInfo.GetModulation().GetParameters().(type) {
+	case *chirpstack.Modulation_Lora:
+		settings.DataRate = &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{
+					Bandwidth:       mod.Lora.Bandwidth,
+					SpreadingFactor: mod.Lora.SpreadingFactor,
+					CodingRate:      codeRateFromChirpStack[mod.Lora.CodeRate],
+				},
+			},
+		}
+	case *chirpstack.Modulation_Fsk:
+		settings.DataRate = &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Fsk{
+				Fsk: &ttnpb.FSKDataRate{
+					BitRate: mod.Fsk.Datarate,
+				},
+			},
+		}
+	case *chirpstack.Modulation_LrFhss:
+		settings.DataRate = &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lrfhss{
+				Lrfhss: &ttnpb.LRFHSSDataRate{
+					OperatingChannelWidth: mod.LrFhss.OperatingChannelWidth,
+					CodingRate:            codeRateFromChirpStack[mod.LrFhss.CodeRate],
+				},
+			},
+		}
+	default:
+		return nil, errModulation.New()
+	}
+
+	md := &ttnpb.RxMetadata{
+		GatewayIds:   ids,
+		AntennaIndex: rxInfo.Antenna,
+		ChannelIndex: rxInfo.Channel,
+		Rssi:         float32(rxInfo.Rssi),
+		ChannelRssi:  float32(rxInfo.Rssi),
+		Snr:          rxInfo.Snr,
+		Timestamp:    settings.Timestamp,
+	}
+	if rxInfo.TimeSinceGpsEpoch != nil {
+		md.GpsTime = timestamppb.New(gpstime.Parse(rxInfo.TimeSinceGpsEpoch.AsDuration()))
+		md.Time = md.GpsTime
+	} else if rxInfo.GwTime != nil {
+		md.Time = rxInfo.GwTime
+	}
+	if rxInfo.FineTimeSinceGpsEpoch != nil {
+		md.FineTimestamp = uint64(rxInfo.FineTimeSinceGpsEpoch.AsDuration() % time.Second)
+	}
+	if loc := rxInfo.Location; loc != nil {
+		md.Location = chirpStackLocation(loc)
+	}
+	settings.Time = md.Time
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: frame.PhyPayload,
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{md},
+	}
+	switch rxInfo.CrcStatus {
+	case chirpstack.CRCStatus_CRC_OK:
+		up.CrcStatus = wrapperspb.Bool(true)
+	case chirpstack.CRCStatus_BAD_CRC:
+		up.CrcStatus = wrapperspb.Bool(false)
+	}
+	return up, nil
+}
+
+func (chirpStack) ToStatus(message []byte, _ *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayStatus, error) {
+	stats := &chirpstack.GatewayStats{}
+	if err := proto.Unmarshal(message, stats); err != nil {
+		return nil, err
+	}
+	status := &ttnpb.GatewayStatus{
+		Time: stats.Time,
+		Metrics: map[string]float32{
+			"rxin": float32(stats.RxPacketsReceived),
+			"rxok": float32(stats.RxPacketsReceivedOk),
+			"txin": float32(stats.TxPacketsReceived),
+			"txok": float32(stats.TxPacketsEmitted),
+		},
+	}
+	if stats.ConfigVersion != "" {
+		status.Versions = map[string]string{
+			"config": stats.ConfigVersion,
+		}
+	}
+	if loc := stats.Location; loc != nil {
+		status.AntennaLocations = []*ttnpb.Location{chirpStackLocation(loc)}
+	}
+	return status, nil
+}
+
+func (chirpStack) ToTxAck(message []byte, _ *ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error) {
+	ack := &chirpstack.DownlinkTxAck{}
+	if err := proto.Unmarshal(message, ack); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.TxAcknowledgment{
+		CorrelationIds: []string{(io.DownlinkTokens{}).FormatCorrelationID(uint16(ack.DownlinkId))},
+		Result:         ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+	}
+	// The gateway tries the items in order; the first item that is not ignored determines the result.
+	for _, item := range ack.Items {
+		if item.Status == chirpstack.TxAckStatus_IGNORED {
+			continue
+		}
+		if result, ok := txAckStatusToV3[item.Status]; ok {
+			res.Result = result
+		}
+		break
+	}
+	return res, nil
+}
+
+// NewChirpStack returns a format that uses the ChirpStack Gateway Bridge (version 4) Protocol Buffers marshaling and
+// unmarshaling. The topic prefixes are by band ID, and are typically the region, for example eu868 for EU_863_870.
+// Gateways in bands without topic prefix use topics without prefix.
+func NewChirpStack(ctx context.Context, topicPrefixes map[string]string) Format {
+	bandLayouts := make(map[string]topics.Layout, len(topicPrefixes))
+	for bandID, prefix := range topicPrefixes {
+		bandLayouts[bandID] = topics.NewChirpStack(ctx, prefix)
+	}
+	return &chirpStack{
+		Layout:      topics.NewChirpStack(ctx, ""),
+		bandLayouts: bandLayouts,
+	}
+}
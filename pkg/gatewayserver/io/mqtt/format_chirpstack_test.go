@@ -0,0 +1,311 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var chirpStackGatewayIDs = &ttnpb.GatewayIdentifiers{
+	GatewayId: "gateway-id",
+	Eui:       types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(),
+}
+
+func TestChirpStackTopicIdentifier(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	format := mqtt.NewChirpStack(ctx, map[string]string{band.EU_863_870: "eu868"})
+	ti, ok := format.(mqtt.TopicIdentifier)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(ti.TopicIdentifier(ctx, chirpStackGatewayIDs), should.Equal, "0102030405060708")
+	a.So(ti.TopicIdentifier(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "gateway-id"}), should.Equal, "gateway-id")
+	bl, ok := format.(mqtt.BandLayout)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(bl.BandLayout(band.EU_863_870).UplinkTopic("0102030405060708"), should.Resemble,
+		[]string{"eu868", "gateway", "0102030405060708", "event", "up"})
+	a.So(bl.BandLayout(band.US_902_928).UplinkTopic("0102030405060708"), should.Resemble,
+		[]string{"gateway", "0102030405060708", "event", "up"})
+}
+
+func TestChirpStackDownlink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	format := mqtt.NewChirpStack(ctx, nil)
+
+	down := &ttnpb.DownlinkMessage{
+		RawPayload:     []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+		CorrelationIds: []string{"gs:down:token:42"},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 12,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Frequency: 869525000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					TxPower:            16.15,
+					InvertPolarization: true,
+				},
+				Timestamp: 12000,
+			},
+		},
+	}
+	buf, err := format.FromDownlink(down, chirpStackGatewayIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	actual := &chirpstack.DownlinkFrame{}
+	if !a.So(proto.Unmarshal(buf, actual), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(actual, should.Resemble, &chirpstack.DownlinkFrame{
+		DownlinkId: 42,
+		Items: []*chirpstack.DownlinkFrameItem{
+			{
+				PhyPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+				TxInfo: &chirpstack.DownlinkTxInfo{
+					Frequency: 869525000,
+					Power:     14,
+					Modulation: &chirpstack.Modulation{
+						Parameters: &chirpstack.Modulation_Lora{
+							Lora: &chirpstack.LoraModulationInfo{
+								Bandwidth:             125000,
+								SpreadingFactor:       12,
+								CodeRate:              chirpstack.CodeRate_CR_4_5,
+								PolarizationInversion: true,
+								NoCrc:                 true,
+							},
+						},
+					},
+					Timing: &chirpstack.Timing{
+						Parameters: &chirpstack.Timing_Delay{
+							Delay: &chirpstack.DelayTimingInfo{
+								Delay: durationpb.New(0),
+							},
+						},
+					},
+					Context: []byte{0x00, 0x00, 0x2e, 0xe0},
+				},
+			},
+		},
+		GatewayId: "0102030405060708",
+	})
+
+	_, err = format.FromDownlink(&ttnpb.DownlinkMessage{}, chirpStackGatewayIDs)
+	a.So(err, should.NotBeNil)
+}
+
+func TestChirpStackUplink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	format := mqtt.NewChirpStack(ctx, nil)
+
+	gpsTime := 1388000000 * time.Second
+	fineTime := gpsTime + 123456
+	frame := &chirpstack.UplinkFrame{
+		PhyPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		TxInfo: &chirpstack.UplinkTxInfo{
+			Frequency: 868100000,
+			Modulation: &chirpstack.Modulation{
+				Parameters: &chirpstack.Modulation_Lora{
+					Lora: &chirpstack.LoraModulationInfo{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodeRate:        chirpstack.CodeRate_CR_4_5,
+					},
+				},
+			},
+		},
+		RxInfo: &chirpstack.UplinkRxInfo{
+			GatewayId:             "0102030405060708",
+			TimeSinceGpsEpoch:     durationpb.New(gpsTime),
+			FineTimeSinceGpsEpoch: durationpb.New(fineTime),
+			Rssi:                  -112,
+			Snr:                   -2.5,
+			Channel:               3,
+			Antenna:               1,
+			Context:               []byte{0x00, 0x00, 0x03, 0xe8},
+			CrcStatus:             chirpstack.CRCStatus_CRC_OK,
+		},
+	}
+	buf, err := proto.Marshal(frame)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	up, err := format.ToUplink(buf, chirpStackGatewayIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	mdTime := timestamppb.New(gpstime.Parse(gpsTime))
+	a.So(up, should.Resemble, &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodingRate:      band.Cr4_5,
+					},
+				},
+			},
+			Frequency: 868100000,
+			Timestamp: 1000,
+			Time:      mdTime,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIds:    chirpStackGatewayIDs,
+				AntennaIndex:  1,
+				ChannelIndex:  3,
+				Rssi:          -112,
+				ChannelRssi:   -112,
+				Snr:           -2.5,
+				Timestamp:     1000,
+				Time:          mdTime,
+				GpsTime:       mdTime,
+				FineTimestamp: 123456,
+			},
+		},
+		CrcStatus: wrapperspb.Bool(true),
+	})
+
+	_, err = format.ToUplink(nil, chirpStackGatewayIDs)
+	a.So(err, should.NotBeNil)
+}
+
+func TestChirpStackStatus(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	format := mqtt.NewChirpStack(ctx, nil)
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	stats := &chirpstack.GatewayStats{
+		GatewayId:           "0102030405060708",
+		Time:                timestamppb.New(now),
+		ConfigVersion:       "1.2.3",
+		RxPacketsReceived:   10,
+		RxPacketsReceivedOk: 8,
+		TxPacketsReceived:   3,
+		TxPacketsEmitted:    2,
+		Location: &chirpstack.Location{
+			Latitude:  52.37,
+			Longitude: 4.89,
+			Altitude:  12,
+			Source:    chirpstack.LocationSource_GPS,
+		},
+	}
+	buf, err := proto.Marshal(stats)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	status, err := format.ToStatus(buf, chirpStackGatewayIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(status, should.Resemble, &ttnpb.GatewayStatus{
+		Time: timestamppb.New(now),
+		Versions: map[string]string{
+			"config": "1.2.3",
+		},
+		AntennaLocations: []*ttnpb.Location{
+			{
+				Latitude:  52.37,
+				Longitude: 4.89,
+				Altitude:  12,
+				Source:    ttnpb.LocationSource_SOURCE_GPS,
+			},
+		},
+		Metrics: map[string]float32{
+			"rxin": 10,
+			"rxok": 8,
+			"txin": 3,
+			"txok": 2,
+		},
+	})
+}
+
+func TestChirpStackTxAck(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name     string
+		Items    []*chirpstack.DownlinkTxAckItem
+		Expected ttnpb.TxAcknowledgment_Result
+	}{
+		{
+			Name:     "OK",
+			Items:    []*chirpstack.DownlinkTxAckItem{{Status: chirpstack.TxAckStatus_OK}},
+			Expected: ttnpb.TxAcknowledgment_SUCCESS,
+		},
+		{
+			Name: "IgnoredThenTooLate",
+			Items: []*chirpstack.DownlinkTxAckItem{
+				{Status: chirpstack.TxAckStatus_IGNORED},
+				{Status: chirpstack.TxAckStatus_TOO_LATE},
+			},
+			Expected: ttnpb.TxAcknowledgment_TOO_LATE,
+		},
+		{
+			Name:     "QueueFull",
+			Items:    []*chirpstack.DownlinkTxAckItem{{Status: chirpstack.TxAckStatus_QUEUE_FULL}},
+			Expected: ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+			format := mqtt.NewChirpStack(ctx, nil)
+			buf, err := proto.Marshal(&chirpstack.DownlinkTxAck{
+				DownlinkId: 42,
+				Items:      tc.Items,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ack, err := format.ToTxAck(buf, chirpStackGatewayIDs)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ack, should.Resemble, &ttnpb.TxAcknowledgment{
+				CorrelationIds: []string{"gs:down:token:42"},
+				Result:         tc.Expected,
+			})
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
@@ -61,6 +62,7 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 
 type connection struct {
 	format   Format
+	layout   topics.Layout
 	server   io.Server
 	io       *io.Connection
 	tokens   io.DownlinkTokens
@@ -110,7 +112,7 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 					continue
 				}
 				logger.Info("Publish downlink message")
-				topicParts := format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GetIds()))
+				topicParts := c.layout.DownlinkTopic(topicIdentifier(c.io.Context(), format, c.io.Gateway().GetIds()))
 				session.Publish(&packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
 					TopicParts: topicParts,
@@ -135,9 +137,9 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 }
 
 type topicAccess struct {
-	gtwUID string
-	reads  [][]string
-	writes [][]string
+	topicID string
+	reads   [][]string
+	writes  [][]string
 }
 
 func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
@@ -177,18 +179,20 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		return nil, err
 	}
 	c.resource = ratelimit.GatewayUpResource(ctx, ids)
+	c.layout = topicLayout(c.format, c.io.BandID())
 
+	topicID := topicIdentifier(ctx, c.format, c.io.Gateway().GetIds())
 	access := topicAccess{
-		gtwUID: uid,
+		topicID: topicID,
 		reads: [][]string{
-			c.format.DownlinkTopic(uid),
+			c.layout.DownlinkTopic(topicID),
 		},
 		writes: [][]string{
-			c.format.BirthTopic(uid),
-			c.format.LastWillTopic(uid),
-			c.format.UplinkTopic(uid),
-			c.format.StatusTopic(uid),
-			c.format.TxAckTopic(uid),
+			c.layout.BirthTopic(topicID),
+			c.layout.LastWillTopic(topicID),
+			c.layout.UplinkTopic(topicID),
+			c.layout.StatusTopic(topicID),
+			c.layout.TxAckTopic(topicID),
 		},
 	}
 	info.Metadata = access
@@ -200,7 +204,7 @@ var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not auth
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	access := info.Metadata.(topicAccess)
-	acceptedTopicParts := c.format.DownlinkTopic(access.gtwUID)
+	acceptedTopicParts := c.layout.DownlinkTopic(access.topicID)
 	if !topic.MatchPath(acceptedTopicParts, topic.Split(requestedTopic)) {
 		return "", 0, errNotAuthorized.New()
 	}
@@ -239,9 +243,9 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 	}
 
 	switch {
-	case c.format.IsBirthTopic(pkt.TopicParts):
-	case c.format.IsLastWillTopic(pkt.TopicParts):
-	case c.format.IsUplinkTopic(pkt.TopicParts):
+	case c.layout.IsBirthTopic(pkt.TopicParts):
+	case c.layout.IsLastWillTopic(pkt.TopicParts):
+	case c.layout.IsUplinkTopic(pkt.TopicParts):
 		up, err := c.format.ToUplink(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal uplink message")
@@ -251,7 +255,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		if err := c.io.HandleUp(up, nil); err != nil {
 			logger.WithError(err).Warn("Failed to handle uplink message")
 		}
-	case c.format.IsStatusTopic(pkt.TopicParts):
+	case c.layout.IsStatusTopic(pkt.TopicParts):
 		status, err := c.format.ToStatus(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal status message")
@@ -260,7 +264,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		if err := c.io.HandleStatus(status); err != nil {
 			logger.WithError(err).Warn("Failed to handle status message")
 		}
-	case c.format.IsTxAckTopic(pkt.TopicParts):
+	case c.layout.IsTxAckTopic(pkt.TopicParts):
 		ack, err := c.format.ToTxAck(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal Tx acknowledgment message")
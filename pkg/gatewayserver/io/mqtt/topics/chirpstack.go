@@ -0,0 +1,104 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+)
+
+const topicChirpStackGateway = "gateway"
+
+type chirpStack struct {
+	prefix []string
+}
+
+func (cs *chirpStack) BirthTopic(id string) []string {
+	return cs.createTopic(id, []string{"state", "conn"})
+}
+
+func (cs *chirpStack) IsBirthTopic(path []string) bool {
+	return cs.isTopic(path, "state", "conn")
+}
+
+func (cs *chirpStack) LastWillTopic(id string) []string {
+	return cs.createTopic(id, []string{"state", "conn"})
+}
+
+func (cs *chirpStack) IsLastWillTopic(path []string) bool {
+	return cs.isTopic(path, "state", "conn")
+}
+
+func (cs *chirpStack) UplinkTopic(id string) []string {
+	return cs.createTopic(id, []string{"event", "up"})
+}
+
+func (cs *chirpStack) IsUplinkTopic(path []string) bool {
+	return cs.isTopic(path, "event", "up")
+}
+
+func (cs *chirpStack) StatusTopic(id string) []string {
+	return cs.createTopic(id, []string{"event", "stats"})
+}
+
+func (cs *chirpStack) IsStatusTopic(path []string) bool {
+	return cs.isTopic(path, "event", "stats")
+}
+
+func (cs *chirpStack) TxAckTopic(id string) []string {
+	return cs.createTopic(id, []string{"event", "ack"})
+}
+
+func (cs *chirpStack) IsTxAckTopic(path []string) bool {
+	return cs.isTopic(path, "event", "ack")
+}
+
+func (cs *chirpStack) DownlinkTopic(id string) []string {
+	return cs.createTopic(id, []string{"command", "down"})
+}
+
+func (cs *chirpStack) createTopic(id string, path []string) []string {
+	res := make([]string, 0, len(cs.prefix)+2+len(path))
+	res = append(res, cs.prefix...)
+	res = append(res, topicChirpStackGateway, id)
+	return append(res, path...)
+}
+
+func (cs *chirpStack) isTopic(path []string, kind, name string) bool {
+	n := len(cs.prefix)
+	if len(path) != n+4 {
+		return false
+	}
+	for i, p := range cs.prefix {
+		if path[i] != p {
+			return false
+		}
+	}
+	return path[n] == topicChirpStackGateway && path[n+2] == kind && path[n+3] == name
+}
+
+// NewChirpStack returns a topic layout that uses the ChirpStack Gateway Bridge topic structure.
+// The prefix is typically the region, for example eu868. An empty prefix results in topics without prefix.
+// The gateway is identified in topics by its EUI in lowercase hexadecimal format.
+func NewChirpStack(ctx context.Context, prefix string) Layout {
+	var parts []string
+	if prefix != "" {
+		parts = topic.Split(prefix)
+	}
+	return &chirpStack{
+		prefix: parts,
+	}
+}
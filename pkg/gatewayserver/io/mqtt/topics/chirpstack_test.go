@@ -0,0 +1,104 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics_test
+
+import (
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+const gatewayEUIChirpStack = "0102030405060708"
+
+func TestChirpStackTopics(t *testing.T) {
+	ctx := test.Context()
+	for _, prefix := range []struct {
+		Name   string
+		Prefix string
+		Parts  []string
+	}{
+		{Name: "NoPrefix"},
+		{Name: "Region", Prefix: "eu868", Parts: []string{"eu868"}},
+		{Name: "MultiLevel", Prefix: "ttn/eu868", Parts: []string{"ttn", "eu868"}},
+	} {
+		prefix := prefix
+		t.Run(prefix.Name, func(t *testing.T) {
+			cs := topics.NewChirpStack(ctx, prefix.Prefix)
+			expected := func(path ...string) []string {
+				res := append([]string{}, prefix.Parts...)
+				res = append(res, "gateway", gatewayEUIChirpStack)
+				return append(res, path...)
+			}
+			for _, tc := range []struct {
+				Func     func(string) []string
+				Expected []string
+				Is       func([]string) bool
+				IsNot    []func([]string) bool
+			}{
+				{
+					Func:     cs.UplinkTopic,
+					Expected: expected("event", "up"),
+					Is:       cs.IsUplinkTopic,
+					IsNot:    []func([]string) bool{cs.IsStatusTopic, cs.IsTxAckTopic, cs.IsBirthTopic},
+				},
+				{
+					Func:     cs.StatusTopic,
+					Expected: expected("event", "stats"),
+					Is:       cs.IsStatusTopic,
+					IsNot:    []func([]string) bool{cs.IsUplinkTopic, cs.IsTxAckTopic, cs.IsBirthTopic},
+				},
+				{
+					Func:     cs.TxAckTopic,
+					Expected: expected("event", "ack"),
+					Is:       cs.IsTxAckTopic,
+					IsNot:    []func([]string) bool{cs.IsUplinkTopic, cs.IsStatusTopic, cs.IsBirthTopic},
+				},
+				{
+					Func:     cs.BirthTopic,
+					Expected: expected("state", "conn"),
+					Is:       cs.IsBirthTopic,
+					IsNot:    []func([]string) bool{cs.IsUplinkTopic, cs.IsStatusTopic, cs.IsTxAckTopic},
+				},
+				{
+					Func:     cs.DownlinkTopic,
+					Expected: expected("command", "down"),
+					Is:       func([]string) bool { return true },
+					IsNot:    []func([]string) bool{cs.IsUplinkTopic, cs.IsStatusTopic, cs.IsTxAckTopic, cs.IsBirthTopic},
+				},
+			} {
+				t.Run(topic.Join(tc.Expected), func(t *testing.T) {
+					a := assertions.New(t)
+					actual := tc.Func(gatewayEUIChirpStack)
+					a.So(actual, should.Resemble, tc.Expected)
+					a.So(tc.Is(actual), should.BeTrue)
+					for _, isNot := range tc.IsNot {
+						a.So(isNot(actual), should.BeFalse)
+					}
+				})
+			}
+		})
+	}
+
+	t.Run("OtherPrefix", func(t *testing.T) {
+		a := assertions.New(t)
+		cs := topics.NewChirpStack(ctx, "eu868")
+		a.So(cs.IsUplinkTopic([]string{"us915", "gateway", gatewayEUIChirpStack, "event", "up"}), should.BeFalse)
+		a.So(cs.IsUplinkTopic([]string{"gateway", gatewayEUIChirpStack, "event", "up"}), should.BeFalse)
+	})
+}
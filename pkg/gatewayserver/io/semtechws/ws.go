@@ -317,6 +317,13 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 		for {
 			select {
 			case <-conn.Context().Done():
+				if conn.IsDrained() {
+					// Request the gateway to reconnect, so that it reconnects to another Gateway Server.
+					msg := websocket.FormatCloseMessage(websocket.CloseServiceRestart, "drained")
+					if err := ws.WriteControl(websocket.CloseMessage, msg, time.Time{}); err != nil {
+						logger.WithError(err).Debug("Failed to send close message")
+					}
+				}
 				return
 			case <-pingTickerC:
 				if atomic.AddInt64(&missingPongs, 1) > int64(s.cfg.MissedPongThreshold) &&
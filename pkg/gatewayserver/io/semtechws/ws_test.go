@@ -1788,6 +1788,66 @@ func TestPingPong(t *testing.T) {
 	}
 }
 
+func TestDrain(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, _, cancelIS := mockis.New(ctx)
+	defer cancelIS()
+	testGtw := mockis.DefaultGateway(registeredGatewayID, false, false)
+	is.GatewayRegistry().Add(ctx, registeredGatewayID, "Bearer", registeredGatewayToken, testGtw, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	gs := mock.NewServer(c, is)
+
+	web, err := New(ctx, gs, lbslns.NewFormatter(maxValidRoundTripDelay), defaultConfig)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	lis, err := net.Listen("tcp", serverAddress)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	go http.Serve(lis, web) // nolint:errcheck,gosec
+	servAddr := fmt.Sprintf("ws://%s", lis.Addr().String())
+
+	conn, _, err := websocket.DefaultDialer.Dial(servAddr+testTrafficEndPoint, nil)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Connection failed: %v", err)
+	}
+	defer conn.Close()
+
+	var gsConn *io.Connection
+	select {
+	case gsConn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+
+	gsConn.Drain()
+	a.So(gsConn.IsDrained(), should.BeTrue)
+
+	conn.SetReadDeadline(time.Now().Add(timeout)) // nolint:errcheck
+	_, _, err = conn.ReadMessage()
+	a.So(websocket.IsCloseError(err, websocket.CloseServiceRestart), should.BeTrue)
+}
+
 func TestRateLimit(t *testing.T) {
 	t.Run("Accept", func(t *testing.T) {
 		maxRate := uint(3)
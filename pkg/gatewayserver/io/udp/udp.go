@@ -525,7 +525,15 @@ func (s *srv) handleDown(ctx context.Context, st *state) error {
 			st.clockMu.RUnlock()
 			d := time.Until(serverTime.Add(-s.config.ScheduleLateTime))
 			logger.WithField("duration", d).Debug("Wait to schedule downlink message late")
-			time.AfterFunc(d, write)
+			time.AfterFunc(d, func() {
+				// NOTE: Downlink messages that are not yet written when the connection is drained are handed off to
+				// the Gateway Server to which the gateway reconnects.
+				if st.io.IsDrained() {
+					logger.Debug("Skip handed off downlink message")
+					return
+				}
+				write()
+			})
 		case <-healthCheck.C:
 			if st.isPullPathActive(s.config.DownlinkPathExpires) {
 				break
@@ -0,0 +1,361 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+
+// rangeRulesBatchSize is the number of rules that are retrieved at once when ranging over all rules.
+const rangeRulesBatchSize = 100
+
+// GatewayAlertRegistry implements the GatewayAlertRegistry interface.
+type GatewayAlertRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) Init(ctx context.Context) error {
+	return ttnredis.InitMutex(ctx, r.Redis)
+}
+
+func (r *GatewayAlertRegistry) allRulesKey() string {
+	return r.Redis.Key("rules")
+}
+
+func (r *GatewayAlertRegistry) entityRulesKey(ctx context.Context, ids *ttnpb.EntityIdentifiers) string {
+	return r.Redis.Key("rules", ids.EntityType(), unique.ID(ctx, ids))
+}
+
+func (r *GatewayAlertRegistry) ruleKey(ctx context.Context, ids *ttnpb.GatewayAlertRuleIdentifiers) string {
+	return r.Redis.Key("rule", ids.EntityIds.EntityType(), unique.ID(ctx, ids.EntityIds), ids.RuleId)
+}
+
+func (r *GatewayAlertRegistry) alertsKey(uid string) string {
+	return r.Redis.Key("alerts", uid)
+}
+
+// ruleAlertsKey returns the key of the set of the unique IDs of the gateways for which the rule raised an alert.
+func (r *GatewayAlertRegistry) ruleAlertsKey(ctx context.Context, ids *ttnpb.GatewayAlertRuleIdentifiers) string {
+	return r.Redis.Key("rule", ids.EntityIds.EntityType(), unique.ID(ctx, ids.EntityIds), ids.RuleId, "alerts")
+}
+
+// alertField returns the field of the alert that the rule raised in the alerts hash of a gateway.
+func alertField(ctx context.Context, ids *ttnpb.GatewayAlertRuleIdentifiers) string {
+	return ttnredis.Key(ids.EntityIds.EntityType(), unique.ID(ctx, ids.EntityIds), ids.RuleId)
+}
+
+// GetRule implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) GetRule(
+	ctx context.Context, ids *ttnpb.GatewayAlertRuleIdentifiers,
+) (*ttnpb.GatewayAlertRule, error) {
+	defer trace.StartRegion(ctx, "get gateway alert rule").End()
+
+	if err := ids.ValidateFields(); err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.GatewayAlertRule{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.ruleKey(ctx, ids)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (r *GatewayAlertRegistry) getRules(ctx context.Context, keys []string) ([]*ttnpb.GatewayAlertRule, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	vs, err := r.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.GatewayAlertRule, 0, len(vs))
+	for _, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		pb := &ttnpb.GatewayAlertRule{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+// ListRules implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) ListRules(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers,
+) ([]*ttnpb.GatewayAlertRule, error) {
+	defer trace.StartRegion(ctx, "list gateway alert rules").End()
+
+	ruleIDs, err := r.Redis.SMembers(ctx, r.entityRulesKey(ctx, ids)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	keys := make([]string, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		keys = append(keys, r.ruleKey(ctx, &ttnpb.GatewayAlertRuleIdentifiers{EntityIds: ids, RuleId: ruleID}))
+	}
+	return r.getRules(ctx, keys)
+}
+
+// RangeRules implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) RangeRules(
+	ctx context.Context, f func(context.Context, *ttnpb.GatewayAlertRule) bool,
+) error {
+	defer trace.StartRegion(ctx, "range gateway alert rules").End()
+
+	keys, err := r.Redis.SMembers(ctx, r.allRulesKey()).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > rangeRulesBatchSize {
+			n = rangeRulesBatchSize
+		}
+		pbs, err := r.getRules(ctx, keys[:n])
+		if err != nil {
+			return err
+		}
+		for _, pb := range pbs {
+			if !f(ctx, pb) {
+				return nil
+			}
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// SetRule implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) SetRule(
+	ctx context.Context,
+	ids *ttnpb.GatewayAlertRuleIdentifiers,
+	f func(*ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error),
+) (*ttnpb.GatewayAlertRule, error) {
+	defer trace.StartRegion(ctx, "set gateway alert rule").End()
+
+	if err := ids.ValidateFields(); err != nil {
+		return nil, err
+	}
+	rk := r.ruleKey(ctx, ids)
+	ek := r.entityRulesKey(ctx, ids.EntityIds)
+	field := alertField(ctx, ids)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.GatewayAlertRule
+	err = ttnredis.LockedWatch(ctx, r.Redis, rk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored := &ttnpb.GatewayAlertRule{}
+		if err := ttnredis.GetProto(ctx, tx, rk).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var createdAt *timestamppb.Timestamp
+		if stored != nil {
+			createdAt = stored.CreatedAt
+		}
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil {
+			// Remove the alerts that the rule raised.
+			rak := r.ruleAlertsKey(ctx, ids)
+			uids, err := tx.SMembers(ctx, rak).Result()
+			if err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, rk, rak)
+				p.SRem(ctx, ek, ids.RuleId)
+				p.SRem(ctx, r.allRulesKey(), rk)
+				for _, uid := range uids {
+					p.HDel(ctx, r.alertsKey(uid), field)
+				}
+				return nil
+			}
+		} else {
+			if pb.EntityIds.IDString() != ids.EntityIds.IDString() ||
+				pb.EntityIds.EntityType() != ids.EntityIds.EntityType() ||
+				pb.RuleId != ids.RuleId {
+				return errInvalidIdentifiers.New()
+			}
+			pb.UpdatedAt = timestamppb.Now()
+			pb.CreatedAt = createdAt
+			if pb.CreatedAt == nil {
+				pb.CreatedAt = pb.UpdatedAt
+			}
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				if _, err := ttnredis.SetProto(ctx, p, rk, pb, 0); err != nil {
+					return err
+				}
+				p.SAdd(ctx, ek, ids.RuleId)
+				p.SAdd(ctx, r.allRulesKey(), rk)
+				return nil
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
+// RaiseAlert implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) RaiseAlert(ctx context.Context, alert *ttnpb.GatewayAlert) (bool, error) {
+	defer trace.StartRegion(ctx, "raise gateway alert").End()
+
+	if err := alert.ValidateFields(); err != nil {
+		return false, err
+	}
+	s, err := ttnredis.MarshalProto(alert)
+	if err != nil {
+		return false, err
+	}
+	uid := unique.ID(ctx, alert.GatewayIds)
+	var raised *redis.BoolCmd
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		raised = p.HSetNX(ctx, r.alertsKey(uid), alertField(ctx, alert.RuleIds), s)
+		p.SAdd(ctx, r.ruleAlertsKey(ctx, alert.RuleIds), uid)
+		return nil
+	}); err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return raised.Val(), nil
+}
+
+// RecoverAlert implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) RecoverAlert(
+	ctx context.Context, gtwIDs *ttnpb.GatewayIdentifiers, ruleIDs *ttnpb.GatewayAlertRuleIdentifiers,
+) (*ttnpb.GatewayAlert, error) {
+	defer trace.StartRegion(ctx, "recover gateway alert").End()
+
+	uid := unique.ID(ctx, gtwIDs)
+	ak := r.alertsKey(uid)
+	field := alertField(ctx, ruleIDs)
+	s, err := r.Redis.HGet(ctx, ak, field).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	} else if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	// NOTE: Only the instance that removes the alert reports the recovery.
+	var removed *redis.IntCmd
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		removed = p.HDel(ctx, ak, field)
+		p.SRem(ctx, r.ruleAlertsKey(ctx, ruleIDs), uid)
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if removed.Val() == 0 {
+		return nil, nil
+	}
+	pb := &ttnpb.GatewayAlert{}
+	if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// RetainAlerts implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) RetainAlerts(
+	ctx context.Context, ruleIDs *ttnpb.GatewayAlertRuleIdentifiers, gtwIDs []*ttnpb.GatewayIdentifiers,
+) error {
+	defer trace.StartRegion(ctx, "retain gateway alerts").End()
+
+	rak := r.ruleAlertsKey(ctx, ruleIDs)
+	uids, err := r.Redis.SMembers(ctx, rak).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	keep := make(map[string]struct{}, len(gtwIDs))
+	for _, ids := range gtwIDs {
+		keep[unique.ID(ctx, ids)] = struct{}{}
+	}
+	var removed []string
+	for _, uid := range uids {
+		if _, ok := keep[uid]; !ok {
+			removed = append(removed, uid)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	field := alertField(ctx, ruleIDs)
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for _, uid := range removed {
+			p.HDel(ctx, r.alertsKey(uid), field)
+			p.SRem(ctx, rak, uid)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// ListAlerts implements gatewayserver.GatewayAlertRegistry.
+func (r *GatewayAlertRegistry) ListAlerts(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) ([]*ttnpb.GatewayAlert, error) {
+	defer trace.StartRegion(ctx, "list gateway alerts").End()
+
+	vs, err := r.Redis.HVals(ctx, r.alertsKey(unique.ID(ctx, ids))).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.GatewayAlert, 0, len(vs))
+	for _, s := range vs {
+		pb := &ttnpb.GatewayAlert{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGatewayAlertRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &GatewayAlertRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	gtw1 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	gtw2 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}
+	orgIDs := (&ttnpb.OrganizationIdentifiers{OrganizationId: "org"}).GetEntityIdentifiers()
+	ruleIDs := &ttnpb.GatewayAlertRuleIdentifiers{EntityIds: orgIDs, RuleId: "offline"}
+
+	_, err := registry.GetRule(ctx, ruleIDs)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	rule, err := registry.SetRule(ctx, ruleIDs, func(stored *ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error) {
+		a.So(stored, should.BeNil)
+		return &ttnpb.GatewayAlertRule{
+			EntityIds: orgIDs,
+			RuleId:    "offline",
+			Condition: ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+			Threshold: durationpb.New(10 * time.Minute),
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(rule.CreatedAt, should.NotBeNil)
+
+	stored, err := registry.GetRule(ctx, ruleIDs)
+	a.So(err, should.BeNil)
+	a.So(stored, should.Resemble, rule)
+
+	rules, err := registry.ListRules(ctx, orgIDs)
+	a.So(err, should.BeNil)
+	a.So(rules, should.Resemble, []*ttnpb.GatewayAlertRule{rule})
+
+	var ranged []*ttnpb.GatewayAlertRule
+	err = registry.RangeRules(ctx, func(_ context.Context, rule *ttnpb.GatewayAlertRule) bool {
+		ranged = append(ranged, rule)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(ranged, should.Resemble, []*ttnpb.GatewayAlertRule{rule})
+
+	// Alerts are raised and recovered only once.
+	for _, gtwIDs := range []*ttnpb.GatewayIdentifiers{gtw1, gtw2} {
+		alert := &ttnpb.GatewayAlert{
+			GatewayIds: gtwIDs,
+			RuleIds:    ruleIDs,
+			Condition:  ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+			RaisedAt:   timestamppb.Now(),
+		}
+		raised, err := registry.RaiseAlert(ctx, alert)
+		a.So(err, should.BeNil)
+		a.So(raised, should.BeTrue)
+		raised, err = registry.RaiseAlert(ctx, alert)
+		a.So(err, should.BeNil)
+		a.So(raised, should.BeFalse)
+	}
+	alerts, err := registry.ListAlerts(ctx, gtw1)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.HaveLength, 1)
+
+	recovered, err := registry.RecoverAlert(ctx, gtw1, ruleIDs)
+	a.So(err, should.BeNil)
+	if a.So(recovered, should.NotBeNil) {
+		a.So(recovered.GatewayIds, should.Resemble, gtw1)
+	}
+	recovered, err = registry.RecoverAlert(ctx, gtw1, ruleIDs)
+	a.So(err, should.BeNil)
+	a.So(recovered, should.BeNil)
+
+	// Retaining the alerts of other gateways removes the alerts of the gateway.
+	recovered, err = registry.RecoverAlert(ctx, gtw1, ruleIDs)
+	a.So(err, should.BeNil)
+	a.So(recovered, should.BeNil)
+	_, err = registry.RaiseAlert(ctx, &ttnpb.GatewayAlert{
+		GatewayIds: gtw1,
+		RuleIds:    ruleIDs,
+		Condition:  ttnpb.GatewayAlertCondition_GATEWAY_ALERT_OFFLINE,
+		RaisedAt:   timestamppb.Now(),
+	})
+	a.So(err, should.BeNil)
+	a.So(registry.RetainAlerts(ctx, ruleIDs, []*ttnpb.GatewayIdentifiers{gtw2}), should.BeNil)
+	alerts, err = registry.ListAlerts(ctx, gtw1)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.BeEmpty)
+	alerts, err = registry.ListAlerts(ctx, gtw2)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.HaveLength, 1)
+
+	// Deleting the rule removes its alerts.
+	_, err = registry.SetRule(ctx, ruleIDs, func(*ttnpb.GatewayAlertRule) (*ttnpb.GatewayAlertRule, error) {
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	alerts, err = registry.ListAlerts(ctx, gtw2)
+	a.So(err, should.BeNil)
+	a.So(alerts, should.BeEmpty)
+	rules, err = registry.ListRules(ctx, orgIDs)
+	a.So(err, should.BeNil)
+	a.So(rules, should.BeEmpty)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayHandoffRegistry implements the GatewayHandoffRegistry interface.
+type GatewayHandoffRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayHandoffRegistry) key(ctx context.Context, ids *ttnpb.GatewayIdentifiers) string {
+	return r.Redis.Key(unique.ID(ctx, ids))
+}
+
+// Set implements gatewayserver.GatewayHandoffRegistry.
+func (r *GatewayHandoffRegistry) Set(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, handoff *ttnpb.GatewayConnectionHandoff, ttl time.Duration,
+) error {
+	defer trace.StartRegion(ctx, "set gateway connection handoff").End()
+
+	if err := ids.ValidateFields(); err != nil {
+		return err
+	}
+	cmd, err := ttnredis.SetProto(ctx, r.Redis, r.key(ctx, ids), handoff, ttl)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Pop implements gatewayserver.GatewayHandoffRegistry.
+func (r *GatewayHandoffRegistry) Pop(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayConnectionHandoff, error) {
+	defer trace.StartRegion(ctx, "pop gateway connection handoff").End()
+
+	if err := ids.ValidateFields(); err != nil {
+		return nil, err
+	}
+	s, err := r.Redis.GetDel(ctx, r.key(ctx, ids)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	pb := &ttnpb.GatewayConnectionHandoff{}
+	if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -0,0 +1,73 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGatewayHandoffRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &GatewayHandoffRegistry{
+		Redis: cl,
+	}
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+
+	handoff, err := registry.Pop(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(handoff, should.BeNil)
+
+	expected := &ttnpb.GatewayConnectionHandoff{
+		GatewayIds: ids,
+		DrainedAt:  timestamppb.New(time.Unix(100, 0)),
+		Scheduler: &ttnpb.GatewaySchedulerState{
+			Clock: &ttnpb.GatewaySchedulerState_Clock{
+				Relative:   1000000,
+				Absolute:   int64(time.Second),
+				ServerTime: timestamppb.New(time.Unix(99, 0)),
+			},
+			Emissions: []*ttnpb.GatewaySchedulerState_Emission{
+				{Starts: int64(2 * time.Second), Duration: durationpb.New(50 * time.Millisecond)},
+			},
+		},
+		PendingDownlinks: []*ttnpb.DownlinkMessage{
+			{RawPayload: []byte{0x60, 0x01}},
+		},
+	}
+	a.So(registry.Set(ctx, ids, expected, test.Delay<<10), should.BeNil)
+
+	handoff, err = registry.Pop(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(handoff, should.Resemble, expected)
+
+	// The handoff is removed when it is popped.
+	handoff, err = registry.Pop(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(handoff, should.BeNil)
+
+	a.So(registry.Set(ctx, &ttnpb.GatewayIdentifiers{}, expected, test.Delay<<10), should.NotBeNil)
+}
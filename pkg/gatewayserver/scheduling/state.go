@@ -0,0 +1,114 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func emissionsToProto(ems Emissions) []*ttnpb.GatewaySchedulerState_Emission {
+	res := make([]*ttnpb.GatewaySchedulerState_Emission, 0, len(ems))
+	for _, em := range ems {
+		res = append(res, &ttnpb.GatewaySchedulerState_Emission{
+			Starts:   int64(em.t),
+			Duration: durationpb.New(em.d),
+		})
+	}
+	return res
+}
+
+func emissionsFromProto(ems Emissions, pb []*ttnpb.GatewaySchedulerState_Emission) Emissions {
+	for _, em := range pb {
+		ems = ems.Insert(NewEmission(ConcentratorTime(em.Starts), em.Duration.AsDuration()))
+	}
+	return ems
+}
+
+// state returns the state of the clock.
+// This method returns nil if the clock is not synchronized.
+func (c *RolloverClock) state() *ttnpb.GatewaySchedulerState_Clock {
+	if !c.synced {
+		return nil
+	}
+	res := &ttnpb.GatewaySchedulerState_Clock{
+		Relative:   c.relative,
+		Absolute:   int64(c.absolute),
+		ServerTime: timestamppb.New(*c.server),
+	}
+	if c.gateway != nil {
+		res.GatewayTime = timestamppb.New(*c.gateway)
+	}
+	return res
+}
+
+// restore restores the clock from the given state.
+func (c *RolloverClock) restore(pb *ttnpb.GatewaySchedulerState_Clock) {
+	server := pb.ServerTime.AsTime()
+	var gateway *time.Time
+	if pb.GatewayTime != nil {
+		t := pb.GatewayTime.AsTime()
+		gateway = &t
+	}
+	c.SyncWithGatewayConcentrator(pb.Relative, server, gateway, ConcentratorTime(pb.Absolute))
+}
+
+// State returns the clock and the scheduled emissions of the scheduler, so that the state can be restored by another
+// scheduler for the same gateway with RestoreState.
+func (s *Scheduler) State() *ttnpb.GatewaySchedulerState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := &ttnpb.GatewaySchedulerState{
+		Clock:     s.clock.state(),
+		Emissions: emissionsToProto(s.emissions),
+		SubBands:  make([]*ttnpb.GatewaySchedulerState_SubBandEmissions, 0, len(s.subBands)),
+	}
+	for _, sb := range s.subBands {
+		sb.mu.RLock()
+		res.SubBands = append(res.SubBands, &ttnpb.GatewaySchedulerState_SubBandEmissions{
+			MinFrequency: sb.MinFrequency,
+			MaxFrequency: sb.MaxFrequency,
+			Emissions:    emissionsToProto(sb.emissions),
+		})
+		sb.mu.RUnlock()
+	}
+	return res
+}
+
+// RestoreState restores the clock and the scheduled emissions from the given state.
+// The clock is only restored if the scheduler is not synchronized yet; the emissions are merged with the emissions
+// that are already scheduled. Emissions of sub-bands that are not in the scheduler are ignored.
+func (s *Scheduler) RestoreState(state *ttnpb.GatewaySchedulerState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if state.GetClock() != nil && !s.clock.IsSynced() {
+		s.clock.restore(state.Clock)
+	}
+	s.emissions = emissionsFromProto(s.emissions, state.GetEmissions())
+	for _, pb := range state.GetSubBands() {
+		for _, sb := range s.subBands {
+			if sb.MinFrequency != pb.MinFrequency || sb.MaxFrequency != pb.MaxFrequency {
+				continue
+			}
+			sb.mu.Lock()
+			sb.emissions = emissionsFromProto(sb.emissions, pb.Emissions)
+			sb.mu.Unlock()
+			break
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSchedulerState(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	fps := []*frequencyplans.FrequencyPlan{{
+		BandID: band.EU_863_870,
+		TimeOffAir: frequencyplans.TimeOffAir{
+			Duration: time.Second,
+		},
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(100, 0),
+	}
+	settings := &ttnpb.TxSettings{
+		DataRate: &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{
+					Bandwidth:       125000,
+					SpreadingFactor: 7,
+					CodingRate:      band.Cr4_5,
+				},
+			},
+		},
+		Frequency: 869525000,
+		Timestamp: 2000000,
+	}
+
+	from, err := scheduling.NewScheduler(ctx, fps, true, scheduling.DefaultDutyCycleStyle, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(from.State().GetClock(), should.BeNil)
+	from.SyncWithGatewayAbsolute(1000000, timeSource.Time, time.Unix(200, 0))
+	em, _, err := from.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	state := from.State()
+	a.So(state.GetClock().GetRelative(), should.Equal, 1000000)
+	a.So(state.GetEmissions(), should.HaveLength, 1)
+	a.So(state.GetEmissions()[0].GetStarts(), should.Equal, int64(em.Starts()))
+	a.So(state.GetEmissions()[0].GetDuration().AsDuration(), should.Equal, em.Duration())
+	a.So(state.GetSubBands(), should.HaveLength, from.SubBandCount())
+
+	to, err := scheduling.NewScheduler(ctx, fps, true, scheduling.DefaultDutyCycleStyle, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	to.RestoreState(state)
+	a.So(to.IsGatewayTimeSynced(), should.BeTrue)
+	fromNow, _ := from.Now()
+	toNow, ok := to.Now()
+	a.So(ok, should.BeTrue)
+	a.So(toNow, should.Equal, fromNow)
+	a.So(to.State(), should.Resemble, state)
+	a.So(to.SubBandStats(), should.Resemble, from.SubBandStats())
+
+	// The restored emission conflicts with the same transmission.
+	_, _, err = to.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrConflict)
+
+	// The clock is not restored if the scheduler is already synchronized.
+	synced, err := scheduling.NewScheduler(ctx, fps, true, scheduling.DefaultDutyCycleStyle, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	synced.Sync(5000000, timeSource.Time)
+	synced.RestoreState(state)
+	a.So(synced.State().GetClock().GetRelative(), should.Equal, 5000000)
+	a.So(synced.State().GetEmissions(), should.Resemble, state.GetEmissions())
+}
@@ -0,0 +1,293 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotificationChannel is the notification channel model in the database.
+type NotificationChannel struct {
+	bun.BaseModel `bun:"table:notification_channels,alias:nch"`
+
+	Model
+
+	// OwnerType is "organization" or "user".
+	OwnerType string `bun:"owner_type,notnull"`
+	// OwnerID is the human-readable ID of the organization or user.
+	OwnerID   string `bun:"owner_id,notnull"`
+	ChannelID string `bun:"channel_id,notnull"`
+
+	NotificationTypes []string `bun:"notification_types,array,nullzero"`
+	Disabled          bool     `bun:"disabled,notnull"`
+
+	// Data is the encoded NotificationChannel message with only the channel set.
+	Data []byte `bun:"data,type:bytea,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *NotificationChannel) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+func notificationChannelToPB(m *NotificationChannel) (*ttnpb.NotificationChannel, error) {
+	pb := &ttnpb.NotificationChannel{}
+	if err := proto.Unmarshal(m.Data, pb); err != nil {
+		return nil, err
+	}
+	switch m.OwnerType {
+	case store.EntityOrganization:
+		pb.OwnerIds = (&ttnpb.OrganizationIdentifiers{OrganizationId: m.OwnerID}).GetOrganizationOrUserIdentifiers()
+	case store.EntityUser:
+		pb.OwnerIds = (&ttnpb.UserIdentifiers{UserId: m.OwnerID}).GetOrganizationOrUserIdentifiers()
+	default:
+		return nil, store.ErrInvalidEntityType.WithAttributes("entity_type", m.OwnerType)
+	}
+	pb.ChannelId = m.ChannelID
+	pb.CreatedAt = timestamppb.New(m.CreatedAt)
+	pb.UpdatedAt = timestamppb.New(m.UpdatedAt)
+	pb.NotificationTypes = m.NotificationTypes
+	pb.Disabled = m.Disabled
+	return pb, nil
+}
+
+func notificationChannelFromPB(m *NotificationChannel, pb *ttnpb.NotificationChannel) error {
+	data, err := proto.Marshal(&ttnpb.NotificationChannel{Channel: pb.Channel})
+	if err != nil {
+		return err
+	}
+	m.OwnerType = pb.GetOwnerIds().EntityType()
+	m.OwnerID = pb.GetOwnerIds().IDString()
+	m.ChannelID = pb.GetChannelId()
+	m.NotificationTypes = pb.GetNotificationTypes()
+	m.Disabled = pb.GetDisabled()
+	m.Data = data
+	return nil
+}
+
+type notificationChannelStore struct {
+	*baseStore
+}
+
+func newNotificationChannelStore(baseStore *baseStore) *notificationChannelStore {
+	return &notificationChannelStore{
+		baseStore: baseStore,
+	}
+}
+
+func (*notificationChannelStore) selectWithOwnerIDs(
+	_ context.Context, ids ...*ttnpb.OrganizationOrUserIdentifiers,
+) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		ownerByType := make(map[string][]string)
+		for _, id := range ids {
+			ownerByType[id.EntityType()] = append(ownerByType[id.EntityType()], id.IDString())
+		}
+		return q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for ownerType, ownerIDs := range ownerByType {
+				q = q.WhereOr(
+					"?TableAlias.owner_type = ? AND ?TableAlias.owner_id IN (?)",
+					ownerType, bun.In(ownerIDs),
+				)
+			}
+			return q
+		})
+	}
+}
+
+func (s *notificationChannelStore) getNotificationChannelModel(
+	ctx context.Context, ids *ttnpb.NotificationChannelIdentifiers,
+) (*NotificationChannel, error) {
+	model := &NotificationChannel{}
+	err := s.newSelectModel(ctx, model).
+		Where("?TableAlias.owner_type = ?", ids.GetOwnerIds().EntityType()).
+		Where("?TableAlias.owner_id = ?", ids.GetOwnerIds().IDString()).
+		Where("?TableAlias.channel_id = ?", ids.GetChannelId()).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrNotificationChannelNotFound.WithAttributes("channel_id", ids.GetChannelId())
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *notificationChannelStore) CreateNotificationChannel(
+	ctx context.Context, pb *ttnpb.NotificationChannel,
+) (*ttnpb.NotificationChannel, error) {
+	ctx, span := tracer.StartFromContext(ctx, "CreateNotificationChannel", trace.WithAttributes(
+		attribute.String("owner_type", pb.GetOwnerIds().EntityType()),
+		attribute.String("owner_id", pb.GetOwnerIds().IDString()),
+		attribute.String("channel_id", pb.GetChannelId()),
+	))
+	defer span.End()
+
+	model := &NotificationChannel{}
+	if err := notificationChannelFromPB(model, pb); err != nil {
+		return nil, err
+	}
+	_, err := s.DB.NewInsert().
+		Model(model).
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	return notificationChannelToPB(model)
+}
+
+func (s *notificationChannelStore) GetNotificationChannel(
+	ctx context.Context, ids *ttnpb.NotificationChannelIdentifiers,
+) (*ttnpb.NotificationChannel, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetNotificationChannel", trace.WithAttributes(
+		attribute.String("owner_type", ids.GetOwnerIds().EntityType()),
+		attribute.String("owner_id", ids.GetOwnerIds().IDString()),
+		attribute.String("channel_id", ids.GetChannelId()),
+	))
+	defer span.End()
+
+	model, err := s.getNotificationChannelModel(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return notificationChannelToPB(model)
+}
+
+func (s *notificationChannelStore) FindNotificationChannels(
+	ctx context.Context, ownerIDs []*ttnpb.OrganizationOrUserIdentifiers,
+) ([]*ttnpb.NotificationChannel, error) {
+	ctx, span := tracer.StartFromContext(ctx, "FindNotificationChannels", trace.WithAttributes(
+		attribute.Int("count", len(ownerIDs)),
+	))
+	defer span.End()
+
+	if len(ownerIDs) == 0 {
+		return nil, nil
+	}
+	models := []*NotificationChannel{}
+	err := newSelectModels(ctx, s.DB, &models).
+		Apply(s.selectWithOwnerIDs(ctx, ownerIDs...)).
+		Order("owner_type", "owner_id", "channel_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	pbs := make([]*ttnpb.NotificationChannel, len(models))
+	for i, model := range models {
+		pb, err := notificationChannelToPB(model)
+		if err != nil {
+			return nil, err
+		}
+		pbs[i] = pb
+	}
+	return pbs, nil
+}
+
+func (s *notificationChannelStore) UpdateNotificationChannel(
+	ctx context.Context, pb *ttnpb.NotificationChannel, fieldMask store.FieldMask,
+) (*ttnpb.NotificationChannel, error) {
+	ctx, span := tracer.StartFromContext(ctx, "UpdateNotificationChannel", trace.WithAttributes(
+		attribute.String("owner_type", pb.GetOwnerIds().EntityType()),
+		attribute.String("owner_id", pb.GetOwnerIds().IDString()),
+		attribute.String("channel_id", pb.GetChannelId()),
+	))
+	defer span.End()
+
+	model, err := s.getNotificationChannelModel(ctx, &ttnpb.NotificationChannelIdentifiers{
+		OwnerIds:  pb.GetOwnerIds(),
+		ChannelId: pb.GetChannelId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated, err := notificationChannelToPB(model)
+	if err != nil {
+		return nil, err
+	}
+	if err := updated.SetFields(pb, fieldMask...); err != nil {
+		return nil, err
+	}
+	if err := notificationChannelFromPB(model, updated); err != nil {
+		return nil, err
+	}
+	_, err = s.DB.NewUpdate().
+		Model(model).
+		WherePK().
+		Column("updated_at", "notification_types", "disabled", "data").
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	return notificationChannelToPB(model)
+}
+
+func (s *notificationChannelStore) DeleteNotificationChannel(
+	ctx context.Context, ids *ttnpb.NotificationChannelIdentifiers,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteNotificationChannel", trace.WithAttributes(
+		attribute.String("owner_type", ids.GetOwnerIds().EntityType()),
+		attribute.String("owner_id", ids.GetOwnerIds().IDString()),
+		attribute.String("channel_id", ids.GetChannelId()),
+	))
+	defer span.End()
+
+	model, err := s.getNotificationChannelModel(ctx, ids)
+	if err != nil {
+		return err
+	}
+	_, err = s.DB.NewDelete().
+		Model(model).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *notificationChannelStore) DeleteOwnerNotificationChannels(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteOwnerNotificationChannels", trace.WithAttributes(
+		attribute.String("owner_type", ownerIDs.EntityType()),
+		attribute.String("owner_id", ownerIDs.IDString()),
+	))
+	defer span.End()
+
+	_, err := s.DB.NewDelete().
+		Model(&NotificationChannel{}).
+		Where("owner_type = ? AND owner_id = ?", ownerIDs.EntityType(), ownerIDs.IDString()).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
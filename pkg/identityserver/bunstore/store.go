@@ -117,24 +117,26 @@ func newStore(baseStore *baseStore) *Store {
 	return &Store{
 		baseStore: baseStore,
 
-		apiKeyStore:          newAPIKeyStore(baseStore),
-		applicationStore:     newApplicationStore(baseStore),
-		clientStore:          newClientStore(baseStore),
-		contactInfoStore:     newContactInfoStore(baseStore),
-		emailValidationStore: newEmailValidationStore(baseStore),
-		endDeviceStore:       newEndDeviceStore(baseStore),
-		entitySearch:         newEntitySearch(baseStore),
-		euiStore:             newEUIStore(baseStore),
-		gatewayStore:         newGatewayStore(baseStore),
-		invitationStore:      newInvitationStore(baseStore),
-		loginTokenStore:      newLoginTokenStore(baseStore),
-		membershipStore:      newMembershipStore(baseStore),
-		notificationStore:    newNotificationStore(baseStore),
-		oauthStore:           newOAuthStore(baseStore),
-		organizationStore:    newOrganizationStore(baseStore),
-		userBookmarkStore:    newUserBookmarkStore(baseStore),
-		userSessionStore:     newUserSessionStore(baseStore),
-		userStore:            newUserStore(baseStore),
+		apiKeyStore:              newAPIKeyStore(baseStore),
+		applicationStore:         newApplicationStore(baseStore),
+		clientStore:              newClientStore(baseStore),
+		contactInfoStore:         newContactInfoStore(baseStore),
+		emailValidationStore:     newEmailValidationStore(baseStore),
+		endDeviceStore:           newEndDeviceStore(baseStore),
+		entitySearch:             newEntitySearch(baseStore),
+		euiStore:                 newEUIStore(baseStore),
+		gatewayStore:             newGatewayStore(baseStore),
+		invitationStore:          newInvitationStore(baseStore),
+		loginTokenStore:          newLoginTokenStore(baseStore),
+		membershipStore:          newMembershipStore(baseStore),
+		notificationStore:        newNotificationStore(baseStore),
+		notificationChannelStore: newNotificationChannelStore(baseStore),
+		oauthStore:               newOAuthStore(baseStore),
+		organizationStore:        newOrganizationStore(baseStore),
+		usageStore:               newUsageStore(baseStore),
+		userBookmarkStore:        newUserBookmarkStore(baseStore),
+		userSessionStore:         newUserSessionStore(baseStore),
+		userStore:                newUserStore(baseStore),
 	}
 }
 
@@ -166,8 +168,10 @@ type Store struct {
 	*loginTokenStore
 	*membershipStore
 	*notificationStore
+	*notificationChannelStore
 	*oauthStore
 	*organizationStore
+	*usageStore
 	*userBookmarkStore
 	*userSessionStore
 	*userStore
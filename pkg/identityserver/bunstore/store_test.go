@@ -131,6 +131,13 @@ func TestUserSessionStore(t *testing.T) {
 	st.TestUserSessionStorePaginationDefaults(t)
 }
 
+func TestUsageStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestUsageStore(t)
+}
+
 func TestUserBookmarkStore(t *testing.T) {
 	t.Parallel()
 
@@ -217,3 +224,10 @@ func TestNotificationStore(t *testing.T) {
 	st := storetest.New(t, newTestStore)
 	st.TestNotificationStore(t)
 }
+
+func TestNotificationChannelStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestNotificationChannelStore(t)
+}
@@ -0,0 +1,212 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UsageRecord is the usage record model in the database.
+type UsageRecord struct {
+	bun.BaseModel `bun:"table:usage_records,alias:ur"`
+
+	Model
+
+	EntityType string    `bun:"entity_type,notnull"`
+	EntityID   string    `bun:"entity_id,notnull"`
+	Day        time.Time `bun:"day,notnull"`
+
+	Uplinks   int64 `bun:"uplinks,notnull"`
+	Downlinks int64 `bun:"downlinks,notnull"`
+	Joins     int64 `bun:"joins,notnull"`
+	// Airtime is the time on air in nanoseconds.
+	Airtime           int64 `bun:"airtime,notnull"`
+	WebhookDeliveries int64 `bun:"webhook_deliveries,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *UsageRecord) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+func usageRecordToPB(m *UsageRecord) (*ttnpb.UsageRecord, error) {
+	pb := &ttnpb.UsageRecord{
+		Day:               timestamppb.New(m.Day.UTC()),
+		Uplinks:           uint64(m.Uplinks),
+		Downlinks:         uint64(m.Downlinks),
+		Joins:             uint64(m.Joins),
+		WebhookDeliveries: uint64(m.WebhookDeliveries),
+	}
+	switch m.EntityType {
+	case store.EntityApplication:
+		pb.EntityIds = (&ttnpb.ApplicationIdentifiers{ApplicationId: m.EntityID}).GetEntityIdentifiers()
+	case store.EntityGateway:
+		pb.EntityIds = (&ttnpb.GatewayIdentifiers{GatewayId: m.EntityID}).GetEntityIdentifiers()
+	default:
+		return nil, store.ErrInvalidEntityType.WithAttributes("entity_type", m.EntityType)
+	}
+	if m.Airtime > 0 {
+		pb.Airtime = durationpb.New(time.Duration(m.Airtime))
+	}
+	return pb, nil
+}
+
+type usageStore struct {
+	*baseStore
+}
+
+func newUsageStore(baseStore *baseStore) *usageStore {
+	return &usageStore{
+		baseStore: baseStore,
+	}
+}
+
+func (*usageStore) selectWithEntityIDs(
+	_ context.Context, ids ...*ttnpb.EntityIdentifiers,
+) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		if len(ids) == 0 {
+			return q
+		}
+		entityByType := make(map[string][]string)
+		for _, id := range ids {
+			entityByType[id.EntityType()] = append(entityByType[id.EntityType()], id.IDString())
+		}
+		return q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for entityType, entityIDs := range entityByType {
+				q = q.WhereOr(
+					"?TableAlias.entity_type = ? AND ?TableAlias.entity_id IN (?)",
+					entityType, bun.In(entityIDs),
+				)
+			}
+			return q
+		})
+	}
+}
+
+func (s *usageStore) AddUsage(ctx context.Context, records []*ttnpb.UsageRecord) error {
+	ctx, span := tracer.StartFromContext(ctx, "AddUsage", trace.WithAttributes(
+		attribute.Int("count", len(records)),
+	))
+	defer span.End()
+
+	if len(records) == 0 {
+		return nil
+	}
+	// NOTE: A row can not be updated twice by the same statement, so the records of the same entity and day are merged.
+	type key struct {
+		entityType, entityID string
+		day                  time.Time
+	}
+	models := make([]*UsageRecord, 0, len(records))
+	byKey := make(map[key]*UsageRecord, len(records))
+	for _, pb := range records {
+		k := key{
+			entityType: pb.GetEntityIds().EntityType(),
+			entityID:   pb.GetEntityIds().IDString(),
+			day:        pb.GetDay().AsTime(),
+		}
+		model, ok := byKey[k]
+		if !ok {
+			model = &UsageRecord{
+				EntityType: k.entityType,
+				EntityID:   k.entityID,
+				Day:        k.day,
+			}
+			byKey[k] = model
+			models = append(models, model)
+		}
+		model.Uplinks += int64(pb.GetUplinks())
+		model.Downlinks += int64(pb.GetDownlinks())
+		model.Joins += int64(pb.GetJoins())
+		model.Airtime += int64(pb.GetAirtime().AsDuration())
+		model.WebhookDeliveries += int64(pb.GetWebhookDeliveries())
+	}
+
+	_, err := s.DB.NewInsert().
+		Model(&models).
+		On("CONFLICT (entity_type, entity_id, day) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("uplinks = ?TableAlias.uplinks + EXCLUDED.uplinks").
+		Set("downlinks = ?TableAlias.downlinks + EXCLUDED.downlinks").
+		Set("joins = ?TableAlias.joins + EXCLUDED.joins").
+		Set("airtime = ?TableAlias.airtime + EXCLUDED.airtime").
+		Set("webhook_deliveries = ?TableAlias.webhook_deliveries + EXCLUDED.webhook_deliveries").
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *usageStore) FindUsage(
+	ctx context.Context, ids []*ttnpb.EntityIdentifiers, from, to time.Time,
+) ([]*ttnpb.UsageRecord, error) {
+	ctx, span := tracer.StartFromContext(ctx, "FindUsage", trace.WithAttributes(
+		attribute.Int("count", len(ids)),
+	))
+	defer span.End()
+
+	models := []*UsageRecord{}
+	selectQuery := newSelectModels(ctx, s.DB, &models).
+		Apply(s.selectWithEntityIDs(ctx, ids...))
+	if !from.IsZero() {
+		selectQuery = selectQuery.Where("?TableAlias.day >= ?", from)
+	}
+	if !to.IsZero() {
+		selectQuery = selectQuery.Where("?TableAlias.day < ?", to)
+	}
+
+	// Count the total number of results.
+	count, err := selectQuery.Count(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	store.SetTotal(ctx, uint64(count))
+
+	// Apply ordering and paging.
+	selectQuery = selectQuery.
+		Order("day", "entity_type", "entity_id").
+		Apply(selectWithLimitAndOffsetFromContext(ctx))
+
+	// Scan the results.
+	if err := selectQuery.Scan(ctx); err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	pbs := make([]*ttnpb.UsageRecord, len(models))
+	for i, model := range models {
+		pb, err := usageRecordToPB(model)
+		if err != nil {
+			return nil, err
+		}
+		pbs[i] = pb
+	}
+	return pbs, nil
+}
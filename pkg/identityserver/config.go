@@ -25,6 +25,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/email/smtp"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	"go.thethings.network/lorawan-stack/v3/pkg/notificationchannel"
 	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -124,6 +126,11 @@ type Config struct {
 	Pagination     struct {
 		DefaultLimit uint32 `name:"default-limit" description:"The default limit applied to paginated requests if not specified"` // nolint:lll
 	} `name:"pagination" description:"Pagination settings"`
+	Metering struct {
+		Registry      metering.Registry `name:"-"`
+		FlushInterval time.Duration     `name:"flush-interval" description:"Interval at which the usage is flushed to the database"` // nolint:lll
+	} `name:"metering" description:"Usage metering settings"`
+	NotificationChannels notificationchannel.Config `name:"notification-channels" description:"Notification channel settings"` // nolint:lll
 }
 
 type emailTemplatesConfig struct {
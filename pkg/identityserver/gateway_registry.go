@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -382,7 +383,12 @@ func (is *IdentityServer) listGateways( // nolint:gocyclo
 		return &ttnpb.Gateways{}, nil
 	}
 
-	if usrIDs := req.Collaborator.GetUserIds(); usrIDs != nil {
+	// If the request comes from the cluster, only the identifiers of the gateways of the collaborator are listed.
+	clusterAuth := clusterauth.Authorized(ctx) == nil
+	if clusterAuth {
+		req.FieldMask = cleanFieldMaskPaths([]string{"ids"}, req.FieldMask, nil, []string{"created_at", "updated_at"})
+		contactInfoInPath = false
+	} else if usrIDs := req.Collaborator.GetUserIds(); usrIDs != nil {
 		if err = rights.RequireUser(ctx, usrIDs, ttnpb.Right_RIGHT_USER_GATEWAYS_LIST); err != nil {
 			return nil, err
 		}
@@ -424,9 +430,11 @@ func (is *IdentityServer) listGateways( // nolint:gocyclo
 		if len(ids) == 0 {
 			return nil
 		}
-		callerMemberships, err = st.FindAccountMembershipChains(ctx, callerAccountID, "gateway", idStrings(ids...)...)
-		if err != nil {
-			return err
+		if !clusterAuth {
+			callerMemberships, err = st.FindAccountMembershipChains(ctx, callerAccountID, "gateway", idStrings(ids...)...)
+			if err != nil {
+				return err
+			}
 		}
 		gtwIDs := make([]*ttnpb.GatewayIdentifiers, 0, len(ids))
 		for _, id := range ids {
@@ -369,6 +369,18 @@ func TestGatewaysPagination(t *testing.T) {
 		if a.So(err, should.BeNil) && a.So(list, should.NotBeNil) {
 			a.So(list.Gateways, should.BeEmpty)
 		}
+
+		// The identifiers of the gateways of a collaborator are listed with cluster authorization.
+		list, err = reg.List(ctx, &ttnpb.ListGatewaysRequest{
+			FieldMask:    ttnpb.FieldMask("ids", "name"),
+			Collaborator: usr1.GetOrganizationOrUserIdentifiers(),
+		}, is.WithClusterAuth())
+		if a.So(err, should.BeNil) && a.So(list.Gateways, should.HaveLength, 3) {
+			for _, gtw := range list.Gateways {
+				a.So(gtw.Ids, should.NotBeNil)
+				a.So(gtw.Name, should.BeEmpty)
+			}
+		}
 	}, withPrivateTestDatabase(p))
 }
 
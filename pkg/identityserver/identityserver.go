@@ -36,6 +36,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpctracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -181,6 +182,15 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	if err := is.initializeTelemetryTasks(is.Context()); err != nil {
 		return nil, err
 	}
+	if is.config.Metering.Registry != nil {
+		is.RegisterTask(&task.Config{
+			Context: is.Context(),
+			ID:      flushUsageTaskName,
+			Func:    is.flushUsage,
+			Restart: task.RestartAlways,
+			Backoff: task.DefaultBackoffConfig,
+		})
+	}
 
 	for _, hook := range []struct {
 		name       string
@@ -228,6 +238,7 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 			"/ttn.lorawan.v3.ContactInfoRegistry",
 			"/ttn.lorawan.v3.EmailValidationRegistry",
 			"/ttn.lorawan.v3.OAuthAuthorizationRegistry",
+			"/ttn.lorawan.v3.UsageMetering",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
@@ -268,6 +279,7 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterEmailValidationRegistryServer(s, &emailValidationRegistry{IdentityServer: is})
 	ttnpb.RegisterNotificationServiceServer(s, &notificationRegistry{IdentityServer: is})
 	ttnpb.RegisterEndDeviceBatchRegistryServer(s, &endDeviceBatchRegistry{IdentityServer: is})
+	ttnpb.RegisterUsageMeteringServer(s, &usageMetering{IdentityServer: is})
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -297,6 +309,7 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterEmailValidationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterNotificationServiceHandler(is.Context(), s, conn)
 	ttnpb.RegisterEndDeviceBatchRegistryHandler(is.Context(), s, conn) // nolint:errcheck
+	ttnpb.RegisterUsageMeteringHandler(is.Context(), s, conn)          // nolint:errcheck
 }
 
 // RegisterInterop registers the LoRaWAN Backend Interfaces interoperability services.
@@ -0,0 +1,304 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/notificationchannel"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// requireNotificationChannelRights checks that the caller may manage the notification channels of the owner.
+// Notification channels contain secrets, so this requires the rights to manage the settings of the owner.
+func requireNotificationChannelRights(ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers) error {
+	if orgIDs := ownerIDs.GetOrganizationIds(); orgIDs != nil {
+		return rights.RequireOrganization(ctx, orgIDs, ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC)
+	}
+	return rights.RequireUser(ctx, ownerIDs.GetUserIds(), ttnpb.Right_RIGHT_USER_SETTINGS_BASIC)
+}
+
+// canReadNotificationChannelSecrets returns whether the caller may read the secrets of the notification channels
+// of the owner. This requires the rights to manage the API keys of the owner, which are secrets as well.
+func canReadNotificationChannelSecrets(ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers) bool {
+	if orgIDs := ownerIDs.GetOrganizationIds(); orgIDs != nil {
+		return rights.RequireOrganization(ctx, orgIDs, ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_API_KEYS) == nil
+	}
+	return rights.RequireUser(ctx, ownerIDs.GetUserIds(), ttnpb.Right_RIGHT_USER_SETTINGS_API_KEYS) == nil
+}
+
+// notificationChannelSecrets returns the secrets of the notification channel that are set.
+func notificationChannelSecrets(channel *ttnpb.NotificationChannel) []*ttnpb.Secret {
+	var secret *ttnpb.Secret
+	switch c := channel.GetChannel().(type) {
+	case *ttnpb.NotificationChannel_Webhook_:
+		secret = c.Webhook.GetSecret()
+	case *ttnpb.NotificationChannel_Matrix_:
+		secret = c.Matrix.GetAccessToken()
+	}
+	if secret == nil {
+		return nil
+	}
+	return []*ttnpb.Secret{secret}
+}
+
+// stripNotificationChannelSecrets removes the secrets from the notification channel.
+func stripNotificationChannelSecrets(channel *ttnpb.NotificationChannel) {
+	switch c := channel.GetChannel().(type) {
+	case *ttnpb.NotificationChannel_Webhook_:
+		c.Webhook.Secret = nil
+	case *ttnpb.NotificationChannel_Matrix_:
+		c.Matrix.AccessToken = nil
+	}
+}
+
+// encryptNotificationChannelSecrets encrypts the secrets of the notification channel before they are stored.
+func (is *IdentityServer) encryptNotificationChannelSecrets(
+	ctx context.Context, channel *ttnpb.NotificationChannel,
+) error {
+	keyID := is.configFromContext(ctx).NotificationChannels.EncryptionKeyID
+	for _, secret := range notificationChannelSecrets(channel) {
+		if keyID == "" {
+			log.FromContext(ctx).Warn("No encryption key defined, store notification channel secret in plaintext")
+			secret.KeyId = ""
+			continue
+		}
+		value, err := is.KeyService().Encrypt(ctx, secret.Value, keyID)
+		if err != nil {
+			return err
+		}
+		secret.Value, secret.KeyId = value, keyID
+	}
+	return nil
+}
+
+// decryptNotificationChannelSecrets decrypts the stored secrets of the notification channel.
+func (is *IdentityServer) decryptNotificationChannelSecrets(
+	ctx context.Context, channel *ttnpb.NotificationChannel,
+) error {
+	for _, secret := range notificationChannelSecrets(channel) {
+		if secret.KeyId == "" {
+			continue
+		}
+		value, err := is.KeyService().Decrypt(ctx, secret.Value, secret.KeyId)
+		if err != nil {
+			return err
+		}
+		secret.Value, secret.KeyId = value, ""
+	}
+	return nil
+}
+
+// readNotificationChannels prepares the stored notification channels of the owner for the response.
+// The secrets are decrypted if the caller may read them, and removed otherwise.
+func (is *IdentityServer) readNotificationChannels(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, channels ...*ttnpb.NotificationChannel,
+) error {
+	if !canReadNotificationChannelSecrets(ctx, ownerIDs) {
+		for _, channel := range channels {
+			stripNotificationChannelSecrets(channel)
+		}
+		return nil
+	}
+	for _, channel := range channels {
+		if err := is.decryptNotificationChannelSecrets(ctx, channel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (is *IdentityServer) createNotificationChannel(
+	ctx context.Context, req *ttnpb.CreateNotificationChannelRequest,
+) (*ttnpb.NotificationChannel, error) {
+	if err := requireNotificationChannelRights(ctx, req.GetChannel().GetOwnerIds()); err != nil {
+		return nil, err
+	}
+	if err := is.encryptNotificationChannelSecrets(ctx, req.GetChannel()); err != nil {
+		return nil, err
+	}
+	channel, err := is.store.CreateNotificationChannel(ctx, req.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+	if err := is.readNotificationChannels(ctx, channel.GetOwnerIds(), channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (is *IdentityServer) getNotificationChannel(
+	ctx context.Context, req *ttnpb.NotificationChannelIdentifiers,
+) (*ttnpb.NotificationChannel, error) {
+	if err := requireNotificationChannelRights(ctx, req.GetOwnerIds()); err != nil {
+		return nil, err
+	}
+	channel, err := is.store.GetNotificationChannel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := is.readNotificationChannels(ctx, req.GetOwnerIds(), channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (is *IdentityServer) listNotificationChannels(
+	ctx context.Context, req *ttnpb.ListNotificationChannelsRequest,
+) (*ttnpb.NotificationChannels, error) {
+	if err := requireNotificationChannelRights(ctx, req.GetOwnerIds()); err != nil {
+		return nil, err
+	}
+	channels, err := is.store.FindNotificationChannels(ctx, []*ttnpb.OrganizationOrUserIdentifiers{req.GetOwnerIds()})
+	if err != nil {
+		return nil, err
+	}
+	if err := is.readNotificationChannels(ctx, req.GetOwnerIds(), channels...); err != nil {
+		return nil, err
+	}
+	return &ttnpb.NotificationChannels{Channels: channels}, nil
+}
+
+func (is *IdentityServer) updateNotificationChannel(
+	ctx context.Context, req *ttnpb.UpdateNotificationChannelRequest,
+) (*ttnpb.NotificationChannel, error) {
+	if err := requireNotificationChannelRights(ctx, req.GetChannel().GetOwnerIds()); err != nil {
+		return nil, err
+	}
+	if err := is.encryptNotificationChannelSecrets(ctx, req.GetChannel()); err != nil {
+		return nil, err
+	}
+	var channel *ttnpb.NotificationChannel
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		channel, err = st.UpdateNotificationChannel(ctx, req.GetChannel(), req.GetFieldMask().GetPaths())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := is.readNotificationChannels(ctx, channel.GetOwnerIds(), channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (is *IdentityServer) deleteNotificationChannel(
+	ctx context.Context, req *ttnpb.NotificationChannelIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := requireNotificationChannelRights(ctx, req.GetOwnerIds()); err != nil {
+		return nil, err
+	}
+	if err := is.store.DeleteNotificationChannel(ctx, req); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func notificationChannelMatches(channel *ttnpb.NotificationChannel, notification *ttnpb.Notification) bool {
+	if channel.GetDisabled() {
+		return false
+	}
+	if len(channel.GetNotificationTypes()) == 0 {
+		return true
+	}
+	for _, notificationType := range channel.GetNotificationTypes() {
+		if notificationType == notification.GetNotificationType() {
+			return true
+		}
+	}
+	return false
+}
+
+// sendToNotificationChannels sends the notification to the notification channels of the owners.
+// The notification is delivered asynchronously by a task per channel, which logs delivery failures.
+func (is *IdentityServer) sendToNotificationChannels(
+	ctx context.Context, notification *ttnpb.Notification, ownerIDs ...*ttnpb.OrganizationOrUserIdentifiers,
+) {
+	logger := log.FromContext(ctx).WithField("notification_id", notification.GetId())
+	channels, err := is.store.FindNotificationChannels(ctx, uniqueOrganizationOrUserIdentifiers(ctx, ownerIDs))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to find notification channels")
+		return
+	}
+	if len(channels) == 0 {
+		return
+	}
+	httpClient, err := is.HTTPClient(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create HTTP client for notification channels")
+		return
+	}
+	sender := notificationchannel.NewSender(httpClient, is.configFromContext(ctx).NotificationChannels)
+	for _, channel := range channels {
+		if !notificationChannelMatches(channel, notification) {
+			continue
+		}
+		channel := channel
+		ownerUID := unique.ID(ctx, channel.GetOwnerIds())
+		logger := logger.WithFields(log.Fields(
+			"owner_uid", ownerUID,
+			"channel_id", channel.GetChannelId(),
+		))
+		if err := is.decryptNotificationChannelSecrets(ctx, channel); err != nil {
+			logger.WithError(err).Warn("Failed to decrypt notification channel secrets")
+			continue
+		}
+		is.StartTask(&task.Config{
+			Context: log.NewContext(is.Context(), logger),
+			ID:      fmt.Sprintf("send_notification_%s_%s", ownerUID, channel.GetChannelId()),
+			Func: func(ctx context.Context) error {
+				return sender.Deliver(ctx, channel, notification)
+			},
+			Restart: task.RestartNever,
+		})
+	}
+}
+
+func (cr *notificationRegistry) CreateChannel(
+	ctx context.Context, req *ttnpb.CreateNotificationChannelRequest,
+) (*ttnpb.NotificationChannel, error) {
+	return cr.createNotificationChannel(ctx, req)
+}
+
+func (cr *notificationRegistry) GetChannel(
+	ctx context.Context, req *ttnpb.NotificationChannelIdentifiers,
+) (*ttnpb.NotificationChannel, error) {
+	return cr.getNotificationChannel(ctx, req)
+}
+
+func (cr *notificationRegistry) ListChannels(
+	ctx context.Context, req *ttnpb.ListNotificationChannelsRequest,
+) (*ttnpb.NotificationChannels, error) {
+	return cr.listNotificationChannels(ctx, req)
+}
+
+func (cr *notificationRegistry) UpdateChannel(
+	ctx context.Context, req *ttnpb.UpdateNotificationChannelRequest,
+) (*ttnpb.NotificationChannel, error) {
+	return cr.updateNotificationChannel(ctx, req)
+}
+
+func (cr *notificationRegistry) DeleteChannel(
+	ctx context.Context, req *ttnpb.NotificationChannelIdentifiers,
+) (*emptypb.Empty, error) {
+	return cr.deleteNotificationChannel(ctx, req)
+}
@@ -0,0 +1,246 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestNotificationChannelRegistry(t *testing.T) {
+	t.Parallel()
+
+	p := &storetest.Population{}
+
+	usr1 := p.NewUser()
+	usr1Key, _ := p.NewAPIKey(usr1.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	usr1Creds := rpcCreds(usr1Key)
+	usr1KeyWithoutRights, _ := p.NewAPIKey(usr1.GetEntityIdentifiers(), ttnpb.Right_RIGHT_USER_INFO)
+	usr1CredsWithoutRights := rpcCreds(usr1KeyWithoutRights)
+	usr1KeyWithoutSecretRights, _ := p.NewAPIKey(usr1.GetEntityIdentifiers(), ttnpb.Right_RIGHT_USER_SETTINGS_BASIC)
+	usr1CredsWithoutSecretRights := rpcCreds(usr1KeyWithoutSecretRights)
+
+	org1 := p.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
+	gtw1 := p.NewGateway(org1.GetOrganizationOrUserIdentifiers())
+
+	texts := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var msg struct {
+			Text string `json:"text"`
+		}
+		_ = json.Unmarshal(body, &msg)
+		texts <- r.URL.Path + " " + msg.Text
+	}))
+	t.Cleanup(srv.Close)
+
+	slackChannel := func(ownerIDs *ttnpb.OrganizationOrUserIdentifiers, channelID string) *ttnpb.NotificationChannel {
+		return &ttnpb.NotificationChannel{
+			OwnerIds:  ownerIDs,
+			ChannelId: channelID,
+			Channel: &ttnpb.NotificationChannel_Slack_{
+				Slack: &ttnpb.NotificationChannel_Slack{Url: srv.URL + "/" + channelID},
+			},
+		}
+	}
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		svc := ttnpb.NewNotificationServiceClient(cc)
+
+		t.Run("Create/WithoutRights", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := svc.CreateChannel(ctx, &ttnpb.CreateNotificationChannelRequest{
+				Channel: slackChannel(usr1.GetOrganizationOrUserIdentifiers(), "usr-slack"),
+			}, usr1CredsWithoutRights)
+			a.So(got, should.BeNil)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		})
+
+		t.Run("Create", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := svc.CreateChannel(ctx, &ttnpb.CreateNotificationChannelRequest{
+				Channel: slackChannel(usr1.GetOrganizationOrUserIdentifiers(), "usr-slack"),
+			}, usr1Creds)
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.GetChannelId(), should.Equal, "usr-slack")
+			}
+
+			orgChannel := slackChannel(org1.GetOrganizationOrUserIdentifiers(), "org-slack")
+			orgChannel.NotificationTypes = []string{"gateway_alert"}
+			_, err = svc.CreateChannel(ctx, &ttnpb.CreateNotificationChannelRequest{
+				Channel: orgChannel,
+			}, usr1Creds)
+			a.So(err, should.BeNil)
+		})
+
+		t.Run("Get", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := svc.GetChannel(ctx, &ttnpb.NotificationChannelIdentifiers{
+				OwnerIds:  org1.GetOrganizationOrUserIdentifiers(),
+				ChannelId: "org-slack",
+			}, usr1Creds)
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.GetNotificationTypes(), should.Resemble, []string{"gateway_alert"})
+				a.So(got.GetSlack().GetUrl(), should.Equal, srv.URL+"/org-slack")
+			}
+		})
+
+		t.Run("List", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			list, err := svc.ListChannels(ctx, &ttnpb.ListNotificationChannelsRequest{
+				OwnerIds: usr1.GetOrganizationOrUserIdentifiers(),
+			}, usr1Creds)
+			if a.So(err, should.BeNil) && a.So(list.GetChannels(), should.HaveLength, 1) {
+				a.So(list.GetChannels()[0].GetChannelId(), should.Equal, "usr-slack")
+			}
+		})
+
+		t.Run("Deliver", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			for _, notificationType := range []string{"test_notification", "gateway_alert"} {
+				_, err := svc.Create(ctx, &ttnpb.CreateNotificationRequest{
+					EntityIds:        gtw1.GetIds().GetEntityIdentifiers(),
+					NotificationType: notificationType,
+					Receivers: []ttnpb.NotificationReceiver{
+						ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_COLLABORATOR,
+					},
+				}, is.Component.WithClusterAuth())
+				a.So(err, should.BeNil)
+			}
+
+			received := make(map[string]bool)
+			for i := 0; i < 3; i++ {
+				select {
+				case <-ctx.Done():
+					t.Fatal("Timed out waiting for notifications")
+				case text := <-texts:
+					received[text] = true
+				}
+			}
+			gtwID := gtw1.GetIds().GetGatewayId()
+			a.So(received, should.Resemble, map[string]bool{
+				"/usr-slack Test notification: gateway " + gtwID: true,
+				"/usr-slack Gateway alert: gateway " + gtwID:     true,
+				"/org-slack Gateway alert: gateway " + gtwID:     true,
+			})
+		})
+
+		t.Run("Update", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := svc.UpdateChannel(ctx, &ttnpb.UpdateNotificationChannelRequest{
+				Channel: &ttnpb.NotificationChannel{
+					OwnerIds:  usr1.GetOrganizationOrUserIdentifiers(),
+					ChannelId: "usr-slack",
+					Disabled:  true,
+				},
+				FieldMask: ttnpb.FieldMask("disabled"),
+			}, usr1Creds)
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.GetDisabled(), should.BeTrue)
+				a.So(got.GetSlack().GetUrl(), should.Equal, srv.URL+"/usr-slack")
+			}
+
+			_, err = svc.Create(ctx, &ttnpb.CreateNotificationRequest{
+				EntityIds:        gtw1.GetIds().GetEntityIdentifiers(),
+				NotificationType: "test_notification",
+				Receivers: []ttnpb.NotificationReceiver{
+					ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_COLLABORATOR,
+				},
+			}, is.Component.WithClusterAuth())
+			a.So(err, should.BeNil)
+
+			select {
+			case text := <-texts:
+				t.Fatalf("Unexpected notification delivered to disabled channel: %s", text)
+			case <-time.After(test.Delay << 3):
+			}
+		})
+
+		t.Run("Delete", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			ids := &ttnpb.NotificationChannelIdentifiers{
+				OwnerIds:  usr1.GetOrganizationOrUserIdentifiers(),
+				ChannelId: "usr-slack",
+			}
+			_, err := svc.DeleteChannel(ctx, ids, usr1Creds)
+			a.So(err, should.BeNil)
+
+			_, err = svc.GetChannel(ctx, ids, usr1Creds)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		})
+
+		t.Run("Secrets", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			ids := &ttnpb.NotificationChannelIdentifiers{
+				OwnerIds:  usr1.GetOrganizationOrUserIdentifiers(),
+				ChannelId: "usr-webhook",
+			}
+			secret := &ttnpb.Secret{Value: []byte("secret")}
+			got, err := svc.CreateChannel(ctx, &ttnpb.CreateNotificationChannelRequest{
+				Channel: &ttnpb.NotificationChannel{
+					OwnerIds:  ids.OwnerIds,
+					ChannelId: ids.ChannelId,
+					Channel: &ttnpb.NotificationChannel_Webhook_{
+						Webhook: &ttnpb.NotificationChannel_Webhook{
+							Url:    srv.URL + "/usr-webhook",
+							Secret: secret,
+						},
+					},
+					Disabled: true,
+				},
+			}, usr1Creds)
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.GetWebhook().GetSecret(), should.Resemble, secret)
+			}
+
+			stored, err := is.store.GetNotificationChannel(ctx, ids)
+			if a.So(err, should.BeNil) {
+				a.So(stored.GetWebhook().GetSecret().GetKeyId(), should.Equal, "is-test")
+				a.So(stored.GetWebhook().GetSecret().GetValue(), should.NotResemble, secret.Value)
+			}
+
+			got, err = svc.GetChannel(ctx, ids, usr1Creds)
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.GetWebhook().GetSecret(), should.Resemble, secret)
+			}
+
+			got, err = svc.GetChannel(ctx, ids, usr1CredsWithoutSecretRights)
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.GetWebhook().GetUrl(), should.Equal, srv.URL+"/usr-webhook")
+				a.So(got.GetWebhook().GetSecret(), should.BeNil)
+			}
+
+			list, err := svc.ListChannels(ctx, &ttnpb.ListNotificationChannelsRequest{
+				OwnerIds: ids.OwnerIds,
+			}, usr1CredsWithoutSecretRights)
+			if a.So(err, should.BeNil) && a.So(list.GetChannels(), should.HaveLength, 1) {
+				a.So(list.GetChannels()[0].GetWebhook().GetSecret(), should.BeNil)
+			}
+		})
+	}, withPrivateTestDatabase(p), func(o *testOptions) {
+		o.isConfig.NotificationChannels.EncryptionKeyID = "is-test"
+	})
+}
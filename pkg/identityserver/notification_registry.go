@@ -69,6 +69,17 @@ func uniqueOrganizationOrUserIdentifiers(ctx context.Context, ids []*ttnpb.Organ
 	return out
 }
 
+func filterOrganizationIdentifiers(ids []*ttnpb.OrganizationOrUserIdentifiers) []*ttnpb.OrganizationOrUserIdentifiers {
+	out := make([]*ttnpb.OrganizationOrUserIdentifiers, 0, len(ids))
+	for _, id := range ids {
+		if id.EntityType() != "organization" {
+			continue
+		}
+		out = append(out, id)
+	}
+	return out
+}
+
 func filterUserIdentifiers(ids []*ttnpb.OrganizationOrUserIdentifiers) []*ttnpb.UserIdentifiers {
 	out := make([]*ttnpb.UserIdentifiers, 0, len(ids))
 	for _, id := range ids {
@@ -124,8 +135,12 @@ func (is *IdentityServer) getContactReceivers(
 	return entityID, nil
 }
 
-func (is *IdentityServer) lookupNotificationReceivers(ctx context.Context, req *ttnpb.CreateNotificationRequest) ([]*ttnpb.UserIdentifiers, error) {
-	var receiverIDs []*ttnpb.OrganizationOrUserIdentifiers
+// lookupNotificationReceivers returns the users that receive the notification,
+// and the organizations that were among the receivers before they were expanded to their members.
+func (is *IdentityServer) lookupNotificationReceivers(
+	ctx context.Context, req *ttnpb.CreateNotificationRequest,
+) ([]*ttnpb.UserIdentifiers, []*ttnpb.OrganizationOrUserIdentifiers, error) {
+	var receiverIDs, receiverOrganizationIDs []*ttnpb.OrganizationOrUserIdentifiers
 	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
 		// Collect user ID for user notifications.
 		if req.EntityIds.EntityType() == "user" {
@@ -204,10 +219,8 @@ func (is *IdentityServer) lookupNotificationReceivers(ctx context.Context, req *
 		}
 
 		// Expand organization IDs to organization collaborator IDs.
-		for _, ids := range uniqueOrganizationOrUserIdentifiers(ctx, receiverIDs) {
-			if ids.EntityType() != store.EntityOrganization {
-				continue
-			}
+		receiverOrganizationIDs = filterOrganizationIdentifiers(uniqueOrganizationOrUserIdentifiers(ctx, receiverIDs))
+		for _, ids := range receiverOrganizationIDs {
 			members, err := st.FindMembers(ctx, ids.GetEntityIdentifiers())
 			if err != nil {
 				return err
@@ -220,17 +233,17 @@ func (is *IdentityServer) lookupNotificationReceivers(ctx context.Context, req *
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Filter only user identifiers and remove duplicates.
 	receiverUserIDs := filterUserIdentifiers(uniqueOrganizationOrUserIdentifiers(ctx, receiverIDs))
 
 	if len(receiverUserIDs) == 0 {
-		return nil, errNoReceiverUserIDs.New()
+		return nil, nil, errNoReceiverUserIDs.New()
 	}
 
-	return receiverUserIDs, nil
+	return receiverUserIDs, receiverOrganizationIDs, nil
 }
 
 func (is *IdentityServer) storeNotification(ctx context.Context, req *ttnpb.CreateNotificationRequest, receiverUserIDs ...*ttnpb.UserIdentifiers) (*ttnpb.Notification, error) {
@@ -261,7 +274,7 @@ func (is *IdentityServer) createNotification(ctx context.Context, req *ttnpb.Cre
 		req.Email = false
 	}
 
-	receiverUserIDs, err := is.lookupNotificationReceivers(ctx, req)
+	receiverUserIDs, receiverOrganizationIDs, err := is.lookupNotificationReceivers(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -271,6 +284,13 @@ func (is *IdentityServer) createNotification(ctx context.Context, req *ttnpb.Cre
 		return nil, err
 	}
 
+	channelOwnerIDs := make([]*ttnpb.OrganizationOrUserIdentifiers, 0, len(receiverUserIDs)+len(receiverOrganizationIDs))
+	for _, ids := range receiverUserIDs {
+		channelOwnerIDs = append(channelOwnerIDs, ids.GetOrganizationOrUserIdentifiers())
+	}
+	channelOwnerIDs = append(channelOwnerIDs, receiverOrganizationIDs...)
+	is.sendToNotificationChannels(ctx, notification, channelOwnerIDs...)
+
 	if req.Email {
 		if err := is.SendNotificationEmailToUserIDs(ctx, notification, receiverUserIDs...); err != nil {
 			return nil, err
@@ -324,6 +344,12 @@ func (is *IdentityServer) notifyAdminsInternal(ctx context.Context, req *ttnpb.C
 		return err
 	}
 
+	channelOwnerIDs := make([]*ttnpb.OrganizationOrUserIdentifiers, len(receiverUserIDs))
+	for i, ids := range receiverUserIDs {
+		channelOwnerIDs[i] = ids.GetOrganizationOrUserIdentifiers()
+	}
+	is.sendToNotificationChannels(ctx, notification, channelOwnerIDs...)
+
 	if req.Email {
 		if err := is.SendNotificationEmailToUsers(ctx, notification, receivers...); err != nil {
 			return err
@@ -391,6 +391,9 @@ func (is *IdentityServer) purgeOrganization(
 		if err := st.PurgeEntityBookmarks(ctx, ids.GetEntityIdentifiers()); err != nil {
 			return err
 		}
+		if err := st.DeleteOwnerNotificationChannels(ctx, ids.GetOrganizationOrUserIdentifiers()); err != nil {
+			return err
+		}
 		return st.PurgeOrganization(ctx, ids)
 	})
 	if err != nil {
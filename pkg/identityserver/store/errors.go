@@ -66,6 +66,9 @@ var (
 	ErrUserBookmarkNotFound = errors.DefineNotFound(
 		"user_bookmark_not_found", "user's bookmark not found",
 	)
+	ErrNotificationChannelNotFound = errors.DefineNotFound(
+		"notification_channel_not_found", "notification channel `{channel_id}` not found",
+	)
 
 	ErrAPIKeyNotFound = errors.DefineNotFound(
 		"api_key_not_found", "api key with id `{api_key_id}` not found", "entity_type", "entity_id",
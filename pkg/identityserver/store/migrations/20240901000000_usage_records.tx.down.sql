@@ -0,0 +1 @@
+DROP TABLE IF EXISTS usage_records CASCADE;
@@ -0,0 +1,21 @@
+CREATE TABLE usage_records (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+
+  entity_type character varying(32) NOT NULL,
+  entity_id character varying(36) NOT NULL,
+  day timestamp with time zone NOT NULL,
+
+  uplinks bigint DEFAULT 0 NOT NULL,
+  downlinks bigint DEFAULT 0 NOT NULL,
+  joins bigint DEFAULT 0 NOT NULL,
+  airtime bigint DEFAULT 0 NOT NULL,
+  webhook_deliveries bigint DEFAULT 0 NOT NULL
+);
+
+CREATE UNIQUE INDEX usage_records_entity_type_entity_id_day_idx
+  ON usage_records (entity_type, entity_id, day);
+
+CREATE INDEX usage_records_day_idx
+  ON usage_records (day);
@@ -0,0 +1,16 @@
+CREATE TABLE notification_channels (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+
+  owner_type character varying(32) NOT NULL,
+  owner_id character varying(36) NOT NULL,
+  channel_id character varying(36) NOT NULL,
+
+  notification_types character varying(100)[],
+  disabled boolean DEFAULT false NOT NULL,
+  data bytea NOT NULL
+);
+
+CREATE UNIQUE INDEX notification_channels_owner_type_owner_id_channel_id_idx
+  ON notification_channels (owner_type, owner_id, channel_id);
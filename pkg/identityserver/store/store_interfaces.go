@@ -413,6 +413,34 @@ type NotificationStore interface {
 	) error
 }
 
+// NotificationChannelStore interface for storing the notification channels of users and organizations.
+type NotificationChannelStore interface {
+	CreateNotificationChannel(ctx context.Context, pb *ttnpb.NotificationChannel) (*ttnpb.NotificationChannel, error)
+	GetNotificationChannel(
+		ctx context.Context, ids *ttnpb.NotificationChannelIdentifiers,
+	) (*ttnpb.NotificationChannel, error)
+	// FindNotificationChannels finds the notification channels of the users and organizations.
+	FindNotificationChannels(
+		ctx context.Context, ownerIDs []*ttnpb.OrganizationOrUserIdentifiers,
+	) ([]*ttnpb.NotificationChannel, error)
+	UpdateNotificationChannel(
+		ctx context.Context, pb *ttnpb.NotificationChannel, fieldMask FieldMask,
+	) (*ttnpb.NotificationChannel, error)
+	DeleteNotificationChannel(ctx context.Context, ids *ttnpb.NotificationChannelIdentifiers) error
+	// DeleteOwnerNotificationChannels deletes the notification channels of the user or organization.
+	DeleteOwnerNotificationChannels(ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers) error
+}
+
+// UsageStore interface for storing the usage of applications and gateways.
+type UsageStore interface {
+	// AddUsage adds the counters of the usage records to the stored usage of the entities.
+	AddUsage(ctx context.Context, records []*ttnpb.UsageRecord) error
+	// FindUsage finds the usage of the entities on the days from (inclusive) until to (exclusive), ordered by day.
+	// If no entities are given, the usage of all entities is returned.
+	// If from or to are zero, the usage is not limited by them.
+	FindUsage(ctx context.Context, ids []*ttnpb.EntityIdentifiers, from, to time.Time) ([]*ttnpb.UsageRecord, error)
+}
+
 // Store interface combines the interfaces of all individual stores.
 type Store interface {
 	ApplicationStore
@@ -431,8 +459,10 @@ type Store interface {
 	ContactInfoStore
 	EUIStore
 	NotificationStore
+	NotificationChannelStore
 	EntitySearch
 	EmailValidationStore
+	UsageStore
 }
 
 // TransactionalStore is Store, but with a method that uses a transaction.
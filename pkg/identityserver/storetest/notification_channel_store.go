@@ -0,0 +1,161 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// TestNotificationChannelStore tests the NotificationChannelStore interface.
+func (st *StoreTest) TestNotificationChannelStore(t *testing.T) { // nolint:paralleltest
+	usr1 := st.population.NewUser()
+	org1 := st.population.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.NotificationChannelStore
+	})
+	defer st.DestroyDB(t, true)
+	if !ok {
+		t.Skip("Store does not implement NotificationChannelStore")
+	}
+	defer s.Close()
+
+	usrChannel := &ttnpb.NotificationChannel{
+		OwnerIds:          usr1.GetOrganizationOrUserIdentifiers(),
+		ChannelId:         "foo-slack",
+		NotificationTypes: []string{"api_key_created"},
+		Channel: &ttnpb.NotificationChannel_Slack_{
+			Slack: &ttnpb.NotificationChannel_Slack{Url: "https://hooks.example.com/services/foo"},
+		},
+	}
+	orgChannel := &ttnpb.NotificationChannel{
+		OwnerIds:  org1.GetOrganizationOrUserIdentifiers(),
+		ChannelId: "foo-webhook",
+		Channel: &ttnpb.NotificationChannel_Webhook_{
+			Webhook: &ttnpb.NotificationChannel_Webhook{
+				Url:     "https://example.com/notifications",
+				Secret:  &ttnpb.Secret{KeyId: "test", Value: []byte("secret")},
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+	}
+	usrChannelIDs := &ttnpb.NotificationChannelIdentifiers{
+		OwnerIds:  usrChannel.OwnerIds,
+		ChannelId: usrChannel.ChannelId,
+	}
+
+	t.Run("CreateNotificationChannel", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		created, err := s.CreateNotificationChannel(ctx, usrChannel)
+		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+			a.So(created.GetCreatedAt(), should.NotBeNil)
+			a.So(created.GetSlack().GetUrl(), should.Equal, "https://hooks.example.com/services/foo")
+		}
+
+		_, err = s.CreateNotificationChannel(ctx, orgChannel)
+		a.So(err, should.BeNil)
+
+		_, err = s.CreateNotificationChannel(ctx, usrChannel)
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+	})
+
+	t.Run("GetNotificationChannel", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		got, err := s.GetNotificationChannel(ctx, usrChannelIDs)
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.GetOwnerIds(), should.Resemble, usrChannel.GetOwnerIds())
+			a.So(got.GetNotificationTypes(), should.Resemble, usrChannel.GetNotificationTypes())
+			a.So(got.GetSlack(), should.Resemble, usrChannel.GetSlack())
+		}
+
+		_, err = s.GetNotificationChannel(ctx, &ttnpb.NotificationChannelIdentifiers{
+			OwnerIds:  usrChannel.OwnerIds,
+			ChannelId: "other",
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("FindNotificationChannels", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		got, err := s.FindNotificationChannels(ctx, []*ttnpb.OrganizationOrUserIdentifiers{
+			usr1.GetOrganizationOrUserIdentifiers(),
+			org1.GetOrganizationOrUserIdentifiers(),
+		})
+		if a.So(err, should.BeNil) && a.So(got, should.HaveLength, 2) {
+			a.So(got[0].GetChannelId(), should.Equal, "foo-webhook")
+			a.So(got[0].GetWebhook(), should.Resemble, orgChannel.GetWebhook())
+			a.So(got[1].GetChannelId(), should.Equal, "foo-slack")
+		}
+	})
+
+	t.Run("UpdateNotificationChannel", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		updated, err := s.UpdateNotificationChannel(ctx, &ttnpb.NotificationChannel{
+			OwnerIds:  usrChannel.OwnerIds,
+			ChannelId: usrChannel.ChannelId,
+			Disabled:  true,
+			Channel: &ttnpb.NotificationChannel_Matrix_{
+				Matrix: &ttnpb.NotificationChannel_Matrix{
+					HomeserverUrl: "https://matrix.example.com",
+					RoomId:        "!room:example.com",
+					AccessToken:   &ttnpb.Secret{KeyId: "test", Value: []byte("token")},
+				},
+			},
+		}, []string{"disabled", "channel"})
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.GetDisabled(), should.BeTrue)
+			a.So(updated.GetNotificationTypes(), should.Resemble, usrChannel.GetNotificationTypes())
+			a.So(updated.GetSlack(), should.BeNil)
+			a.So(updated.GetMatrix().GetRoomId(), should.Equal, "!room:example.com")
+		}
+
+		got, err := s.GetNotificationChannel(ctx, usrChannelIDs)
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.GetDisabled(), should.BeTrue)
+			a.So(got.GetMatrix(), should.Resemble, updated.GetMatrix())
+		}
+	})
+
+	t.Run("DeleteNotificationChannel", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		err := s.DeleteNotificationChannel(ctx, usrChannelIDs)
+		a.So(err, should.BeNil)
+
+		_, err = s.GetNotificationChannel(ctx, usrChannelIDs)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		err = s.DeleteNotificationChannel(ctx, usrChannelIDs)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("DeleteOwnerNotificationChannels", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		err := s.DeleteOwnerNotificationChannels(ctx, org1.GetOrganizationOrUserIdentifiers())
+		a.So(err, should.BeNil)
+
+		got, err := s.FindNotificationChannels(ctx, []*ttnpb.OrganizationOrUserIdentifiers{
+			org1.GetOrganizationOrUserIdentifiers(),
+		})
+		a.So(err, should.BeNil)
+		a.So(got, should.BeEmpty)
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestUsageStore tests the UsageStore interface.
+func (st *StoreTest) TestUsageStore(t *testing.T) { // nolint:paralleltest
+	app1 := st.population.NewApplication(nil)
+	gtw1 := st.population.NewGateway(nil)
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.UsageStore
+	})
+	defer st.DestroyDB(t, true)
+	if !ok {
+		t.Skip("Store does not implement UsageStore")
+	}
+	defer s.Close()
+
+	day := time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := day.Add(24 * time.Hour)
+
+	t.Run("AddUsage", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		err := s.AddUsage(ctx, []*ttnpb.UsageRecord{
+			{
+				EntityIds: app1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(day),
+				Uplinks:   2,
+				Airtime:   durationpb.New(100 * time.Millisecond),
+			},
+			{
+				EntityIds: gtw1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(day),
+				Uplinks:   3,
+				Downlinks: 1,
+			},
+			{
+				EntityIds: app1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(nextDay),
+				Joins:     1,
+			},
+		})
+		a.So(err, should.BeNil)
+
+		// The counters are added to the stored usage.
+		err = s.AddUsage(ctx, []*ttnpb.UsageRecord{
+			{
+				EntityIds:         app1.GetEntityIdentifiers(),
+				Day:               timestamppb.New(day),
+				Uplinks:           1,
+				Airtime:           durationpb.New(50 * time.Millisecond),
+				WebhookDeliveries: 2,
+			},
+			{
+				EntityIds:         app1.GetEntityIdentifiers(),
+				Day:               timestamppb.New(day),
+				WebhookDeliveries: 1,
+			},
+		})
+		a.So(err, should.BeNil)
+	})
+
+	t.Run("FindUsage", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		got, err := s.FindUsage(ctx, []*ttnpb.EntityIdentifiers{app1.GetEntityIdentifiers()}, time.Time{}, time.Time{})
+		if a.So(err, should.BeNil) && a.So(got, should.HaveLength, 2) {
+			a.So(got[0], should.Resemble, &ttnpb.UsageRecord{
+				EntityIds:         app1.GetEntityIdentifiers(),
+				Day:               timestamppb.New(day),
+				Uplinks:           3,
+				Airtime:           durationpb.New(150 * time.Millisecond),
+				WebhookDeliveries: 3,
+			})
+			a.So(got[1], should.Resemble, &ttnpb.UsageRecord{
+				EntityIds: app1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(nextDay),
+				Joins:     1,
+			})
+		}
+
+		got, err = s.FindUsage(ctx, nil, day, nextDay)
+		if a.So(err, should.BeNil) && a.So(got, should.HaveLength, 2) {
+			a.So(got[0].GetEntityIds(), should.Resemble, app1.GetEntityIdentifiers())
+			a.So(got[1], should.Resemble, &ttnpb.UsageRecord{
+				EntityIds: gtw1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(day),
+				Uplinks:   3,
+				Downlinks: 1,
+			})
+		}
+
+		got, err = s.FindUsage(ctx, []*ttnpb.EntityIdentifiers{gtw1.GetEntityIdentifiers()}, nextDay, time.Time{})
+		a.So(err, should.BeNil)
+		a.So(got, should.BeEmpty)
+	})
+
+	t.Run("FindUsage_Paginated", func(t *testing.T) { // nolint:paralleltest
+		a, ctx := test.New(t)
+		var total uint64
+		paginateCtx := is.WithPagination(ctx, 1, 2, &total)
+		got, err := s.FindUsage(paginateCtx, nil, time.Time{}, time.Time{})
+		if a.So(err, should.BeNil) && a.So(got, should.HaveLength, 1) {
+			a.So(got[0].GetEntityIds(), should.Resemble, gtw1.GetEntityIdentifiers())
+		}
+		a.So(total, should.Equal, 3)
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errUsageEntityType = errors.DefineInvalidArgument(
+	"usage_entity_type", "usage of entity type `{entity_type}` is not metered",
+)
+
+const flushUsageTaskName = "flush_usage"
+
+// flushUsage periodically flushes the usage from the metering registry to the database.
+func (is *IdentityServer) flushUsage(ctx context.Context) error {
+	ticker := time.NewTicker(is.config.Metering.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := is.config.Metering.Registry.Flush(ctx, is.store.AddUsage); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to flush usage")
+			}
+		}
+	}
+}
+
+// organizationUsage returns the usage of the applications and gateways of the organization, summed per day.
+func (is *IdentityServer) organizationUsage(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers, from, to time.Time,
+) ([]*ttnpb.UsageRecord, error) {
+	var entityIDs []*ttnpb.EntityIdentifiers
+	for _, entityType := range []string{store.EntityApplication, store.EntityGateway} {
+		memberships, err := is.store.FindMemberships(ctx, ids.GetOrganizationOrUserIdentifiers(), entityType, false)
+		if err != nil {
+			return nil, err
+		}
+		entityIDs = append(entityIDs, memberships...)
+	}
+	if len(entityIDs) == 0 {
+		return nil, nil
+	}
+	records, err := is.store.FindUsage(ctx, entityIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: The records are ordered by day.
+	var res []*ttnpb.UsageRecord
+	for _, record := range records {
+		if n := len(res); n > 0 && res[n-1].Day.AsTime().Equal(record.Day.AsTime()) {
+			metering.Add(res[n-1], record)
+			continue
+		}
+		pb := &ttnpb.UsageRecord{
+			EntityIds: ids.GetEntityIdentifiers(),
+			Day:       record.Day,
+		}
+		metering.Add(pb, record)
+		res = append(res, pb)
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) listUsage(ctx context.Context, req *ttnpb.ListUsageRequest) (*ttnpb.UsageRecords, error) {
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	var total uint64
+	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	var (
+		records []*ttnpb.UsageRecord
+		err     error
+	)
+	switch ids := req.GetEntityIds().GetIds().(type) {
+	case nil:
+		if err := is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+		records, err = is.store.FindUsage(paginateCtx, nil, from, to)
+	case *ttnpb.EntityIdentifiers_ApplicationIds:
+		if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_INFO); err != nil {
+			return nil, err
+		}
+		records, err = is.store.FindUsage(paginateCtx, []*ttnpb.EntityIdentifiers{req.EntityIds}, from, to)
+	case *ttnpb.EntityIdentifiers_GatewayIds:
+		if err := rights.RequireGateway(ctx, ids.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_INFO); err != nil {
+			return nil, err
+		}
+		records, err = is.store.FindUsage(paginateCtx, []*ttnpb.EntityIdentifiers{req.EntityIds}, from, to)
+	case *ttnpb.EntityIdentifiers_OrganizationIds:
+		if err := rights.RequireOrganization(ctx, ids.OrganizationIds, ttnpb.Right_RIGHT_ORGANIZATION_INFO); err != nil {
+			return nil, err
+		}
+		records, err = is.organizationUsage(ctx, ids.OrganizationIds, from, to)
+		if err == nil {
+			store.SetTotal(paginateCtx, uint64(len(records)))
+			limit, offset := store.LimitAndOffsetFromContext(paginateCtx)
+			records = paginate(records, limit, offset)
+		}
+	default:
+		return nil, errUsageEntityType.WithAttributes("entity_type", req.GetEntityIds().EntityType())
+	}
+	if err != nil {
+		return nil, err
+	}
+	setTotalHeader(ctx, total)
+	return &ttnpb.UsageRecords{Records: records}, nil
+}
+
+func paginate[T any](items []T, limit, offset uint32) []T {
+	if offset >= uint32(len(items)) {
+		return nil
+	}
+	items = items[offset:]
+	if limit > 0 && limit < uint32(len(items)) {
+		items = items[:limit]
+	}
+	return items
+}
+
+type usageMetering struct {
+	ttnpb.UnimplementedUsageMeteringServer
+
+	*IdentityServer
+}
+
+func (um *usageMetering) ListUsage(ctx context.Context, req *ttnpb.ListUsageRequest) (*ttnpb.UsageRecords, error) {
+	return um.listUsage(ctx, req)
+}
@@ -0,0 +1,149 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUsageMetering(t *testing.T) {
+	t.Parallel()
+
+	p := &storetest.Population{}
+
+	admin := p.NewUser()
+	admin.Admin = true
+	adminKey, _ := p.NewAPIKey(admin.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	adminCreds := rpcCreds(adminKey)
+
+	usr1 := p.NewUser()
+	org1 := p.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
+	app1 := p.NewApplication(org1.GetOrganizationOrUserIdentifiers())
+	gtw1 := p.NewGateway(org1.GetOrganizationOrUserIdentifiers())
+	app2 := p.NewApplication(usr1.GetOrganizationOrUserIdentifiers())
+
+	key, _ := p.NewAPIKey(usr1.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	creds := rpcCreds(key)
+
+	keyWithoutRights, _ := p.NewAPIKey(usr1.GetEntityIdentifiers())
+	credsWithoutRights := rpcCreds(keyWithoutRights)
+
+	day := time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := day.Add(24 * time.Hour)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewUsageMeteringClient(cc)
+
+		err := is.store.AddUsage(test.Context(), []*ttnpb.UsageRecord{
+			{
+				EntityIds: app1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(day),
+				Uplinks:   2,
+				Airtime:   durationpb.New(100 * time.Millisecond),
+			},
+			{
+				EntityIds: gtw1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(day),
+				Uplinks:   3,
+				Downlinks: 1,
+				Airtime:   durationpb.New(200 * time.Millisecond),
+			},
+			{
+				EntityIds: app1.GetEntityIdentifiers(),
+				Day:       timestamppb.New(nextDay),
+				Joins:     1,
+			},
+			{
+				EntityIds: app2.GetEntityIdentifiers(),
+				Day:       timestamppb.New(day),
+				Uplinks:   5,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run("Application/WithoutRights", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			_, err := reg.ListUsage(ctx, &ttnpb.ListUsageRequest{
+				EntityIds: app1.GetEntityIdentifiers(),
+			}, credsWithoutRights)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		})
+
+		t.Run("Application", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := reg.ListUsage(ctx, &ttnpb.ListUsageRequest{
+				EntityIds: app1.GetEntityIdentifiers(),
+				From:      timestamppb.New(nextDay),
+			}, creds)
+			a.So(err, should.BeNil)
+			a.So(got.GetRecords(), should.Resemble, []*ttnpb.UsageRecord{
+				{
+					EntityIds: app1.GetEntityIdentifiers(),
+					Day:       timestamppb.New(nextDay),
+					Joins:     1,
+				},
+			})
+		})
+
+		t.Run("Organization", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := reg.ListUsage(ctx, &ttnpb.ListUsageRequest{
+				EntityIds: org1.GetEntityIdentifiers(),
+			}, creds)
+			a.So(err, should.BeNil)
+			a.So(got.GetRecords(), should.Resemble, []*ttnpb.UsageRecord{
+				{
+					EntityIds: org1.GetEntityIdentifiers(),
+					Day:       timestamppb.New(day),
+					Uplinks:   5,
+					Downlinks: 1,
+					Airtime:   durationpb.New(300 * time.Millisecond),
+				},
+				{
+					EntityIds: org1.GetEntityIdentifiers(),
+					Day:       timestamppb.New(nextDay),
+					Joins:     1,
+				},
+			})
+		})
+
+		t.Run("All/WithoutAdmin", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			_, err := reg.ListUsage(ctx, &ttnpb.ListUsageRequest{}, creds)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		})
+
+		t.Run("All", func(t *testing.T) { // nolint:paralleltest
+			a, ctx := test.New(t)
+			got, err := reg.ListUsage(ctx, &ttnpb.ListUsageRequest{
+				To: timestamppb.New(nextDay),
+			}, adminCreds)
+			a.So(err, should.BeNil)
+			a.So(got.GetRecords(), should.HaveLength, 3)
+		})
+	}, withPrivateTestDatabase(p))
+}
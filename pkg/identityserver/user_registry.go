@@ -776,6 +776,9 @@ func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifi
 		if err := st.PurgeUserBookmarks(ctx, ids); err != nil {
 			return err
 		}
+		if err := st.DeleteOwnerNotificationChannels(ctx, ids.GetOrganizationOrUserIdentifiers()); err != nil {
+			return err
+		}
 		return st.PurgeUser(ctx, ids)
 	})
 	if err != nil {
@@ -40,11 +40,14 @@ var (
 	errNoJoinEUI                      = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoJoinRequest                  = errors.DefineInvalidArgument("no_join_request", "no JoinRequest specified")
 	errNoNetID                        = errors.DefineFailedPrecondition("no_net_id", "no NetID specified")
+	errNoRejoinRequest                = errors.DefineInvalidArgument("no_rejoin_request", "no RejoinRequest specified")
 	errNoNwkKey                       = errors.DefineFailedPrecondition("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey                   = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoSNwkSIntKey                  = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
 	errProvisionerNotFound            = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
 	errRegistryOperation              = errors.Define("registry_operation", "registry operation failed")
+	errRejoinRequestType              = errors.DefineUnimplemented("rejoin_request_type", "rejoin-request type `{type}` is not supported")
+	errRejoinRequestMACVersion        = errors.DefineInvalidArgument("rejoin_request_mac_version", "rejoin-request is not supported in MAC version `{version}`")
 	errReuseDevNonce                  = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
 	errUnauthenticated                = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnknownJoinEUI                 = errors.DefineInvalidArgument("unknown_join_eui", "JoinEUI specified is not known")
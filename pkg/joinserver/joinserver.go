@@ -263,19 +263,54 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 	if req.Payload.MHdr.Major != ttnpb.Major_LORAWAN_R1 {
 		return nil, errUnsupportedLoRaWANMajorVersion.WithAttributes("major", req.Payload.MHdr.Major)
 	}
-	if req.Payload.MHdr.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MHdr.MType)
-	}
 
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, errNoJoinRequest.New()
+	// The join-accept MIC and the session keys are computed with the DevNonce of the join-request, or the RJcount0 of
+	// the rejoin-request of type 0 or 2. The JoinEUI of a rejoin-request of type 0 or 2 is set by the Network Server.
+	// See LoRaWAN 1.1 section 6.2.3.
+	var (
+		joinEUI, devEUI types.EUI64
+		devNonce        types.DevNonce
+		joinReqType     byte = 0xff
+		rejoinPld       *ttnpb.RejoinRequestPayload
+	)
+	switch req.Payload.MHdr.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		pld := req.Payload.GetJoinRequestPayload()
+		if pld == nil {
+			return nil, errNoJoinRequest.New()
+		}
+		joinEUI, devEUI = types.MustEUI64(pld.JoinEui).OrZero(), types.MustEUI64(pld.DevEui).OrZero()
+		devNonce = types.MustDevNonce(pld.DevNonce).OrZero()
+
+	case ttnpb.MType_REJOIN_REQUEST:
+		rejoinPld = req.Payload.GetRejoinRequestPayload()
+		if rejoinPld == nil {
+			return nil, errNoRejoinRequest.New()
+		}
+		switch rejoinPld.RejoinType {
+		case ttnpb.RejoinRequestType_CONTEXT, ttnpb.RejoinRequestType_KEYS:
+		default:
+			return nil, errRejoinRequestType.WithAttributes("type", rejoinPld.RejoinType)
+		}
+		if !macspec.UseNwkKey(req.SelectedMacVersion) {
+			return nil, errRejoinRequestMACVersion.WithAttributes("version", req.SelectedMacVersion)
+		}
+		if !bytes.Equal(rejoinPld.NetId, req.NetId) {
+			return nil, errNetIDMismatch.WithAttributes("net_id", types.MustNetID(rejoinPld.NetId).OrZero())
+		}
+		joinEUI, devEUI = types.MustEUI64(req.JoinEui).OrZero(), types.MustEUI64(rejoinPld.DevEui).OrZero()
+		if joinEUI.IsZero() {
+			return nil, errNoJoinEUI.New()
+		}
+		binary.BigEndian.PutUint16(devNonce[:], uint16(rejoinPld.RejoinCnt))
+		joinReqType = byte(rejoinPld.RejoinType)
+
+	default:
+		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MHdr.MType)
 	}
-	devEUI := types.MustEUI64(pld.DevEui).OrZero()
 	if devEUI.IsZero() {
 		return nil, errNoDevEUI.New()
 	}
-	joinEUI := types.MustEUI64(pld.JoinEui).OrZero()
 	logger = logger.WithFields(log.Fields(
 		"join_eui", joinEUI,
 		"dev_eui", devEUI,
@@ -361,8 +396,13 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 
 			paths := make([]string, 0, 3)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if macspec.IncrementDevNonce(req.SelectedMacVersion) {
+			// The Network Server verifies the MIC and the RJcount0 of rejoin-requests of type 0 and 2, which are
+			// computed with the network session keys.
+			dn := uint32(binary.BigEndian.Uint16(devNonce[:]))
+			if rejoinPld != nil {
+				dev.LastRjCount_0 = rejoinPld.RejoinCnt
+				paths = append(paths, "last_rj_count_0")
+			} else if macspec.IncrementDevNonce(req.SelectedMacVersion) {
 				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
 					if dn <= dev.LastDevNonce {
 						registerDevNonceTooSmall(ctx, req)
@@ -499,19 +539,24 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			if err := cryptoDev.SetFields(dev, "ids", "provisioner_id", "provisioning_data"); err != nil {
 				return nil, nil, err
 			}
-			reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMacVersion, req.RawPayload[:19])
-			if err != nil {
-				return nil, nil, errComputeMIC.WithCause(err)
-			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
-				return nil, nil, errMICMismatch.New()
+			if rejoinPld == nil {
+				reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMacVersion, req.RawPayload[:19])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+					return nil, nil, errMICMismatch.New()
+				}
 			}
-			devNonce := types.MustDevNonce(pld.DevNonce).OrZero()
-			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMacVersion, 0xff, devNonce, b)
+			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMacVersion, joinReqType, devNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMacVersion, append(b[1:], resMIC[:]...))
+			encrypt := networkCryptoService.EncryptJoinAccept
+			if rejoinPld != nil {
+				encrypt = networkCryptoService.EncryptRejoinAccept
+			}
+			enc, err := encrypt(ctx, cryptoDev, req.SelectedMacVersion, append(b[1:], resMIC[:]...))
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
@@ -2560,6 +2560,233 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func TestHandleRejoin(t *testing.T) {
+	t.Parallel()
+
+	var (
+		joinEUI = types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+		devEUI  = types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	)
+	rejoinRequest := func(rejoinType byte) *ttnpb.JoinRequest {
+		return &ttnpb.JoinRequest{
+			SelectedMacVersion: ttnpb.MACVersion_MAC_V1_1,
+			RawPayload: []byte{
+				/* MHDR */
+				0xc0,
+				/* MACPayload */
+				/** RejoinType **/
+				rejoinType,
+				/** NetID **/
+				0xff, 0xff, 0x42,
+				/** DevEUI **/
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+				/** RJcount0 **/
+				0x03, 0x00,
+				/* MIC */
+				0x01, 0x02, 0x03, 0x04,
+			},
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff}.Bytes(),
+			NetId:   types.NetID{0x42, 0xff, 0xff}.Bytes(),
+			JoinEui: joinEUI.Bytes(),
+			DownlinkSettings: &ttnpb.DLSettings{
+				OptNeg:      true,
+				Rx1DrOffset: 0x7,
+				Rx2Dr:       0xf,
+			},
+			RxDelay: 0x42,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		JoinRequest    *ttnpb.JoinRequest
+		JoinReqType    byte
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:        "Type 0",
+			JoinRequest: rejoinRequest(0x00),
+			JoinReqType: 0x00,
+		},
+		{
+			Name:        "Type 2",
+			JoinRequest: rejoinRequest(0x02),
+			JoinReqType: 0x02,
+		},
+		{
+			Name: "Type 1",
+			JoinRequest: func() *ttnpb.JoinRequest {
+				req := rejoinRequest(0x01)
+				req.RawPayload = []byte{
+					/* MHDR */
+					0xc0,
+					/* MACPayload */
+					/** RejoinType **/
+					0x01,
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** RJcount1 **/
+					0x03, 0x00,
+					/* MIC */
+					0x01, 0x02, 0x03, 0x04,
+				}
+				return req
+			}(),
+			ErrorAssertion: errors.IsUnimplemented,
+		},
+		{
+			Name: "No JoinEUI",
+			JoinRequest: func() *ttnpb.JoinRequest {
+				req := rejoinRequest(0x02)
+				req.JoinEui = nil
+				return req
+			}(),
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NetID mismatch",
+			JoinRequest: func() *ttnpb.JoinRequest {
+				req := rejoinRequest(0x02)
+				req.NetId = types.NetID{0x42, 0x42, 0xff}.Bytes()
+				return req
+			}(),
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "LoRaWAN 1.0.3",
+			JoinRequest: func() *ttnpb.JoinRequest {
+				req := rejoinRequest(0x02)
+				req.SelectedMacVersion = ttnpb.MACVersion_MAC_V1_0_3
+				return req
+			}(),
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+			ctx = clusterauth.NewContext(ctx, nil)
+
+			redisClient, flush := test.NewRedis(ctx, "joinserver_test")
+			t.Cleanup(func() {
+				flush()
+				redisClient.Close()
+			})
+			devReg := &redis.DeviceRegistry{Redis: redisClient, LockTTL: test.Delay << 10}
+			if err := devReg.Init(ctx); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			keyReg := &redis.KeyRegistry{Redis: redisClient, LockTTL: test.Delay << 10}
+			if err := keyReg.Init(ctx); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			aasReg, aasRegCloseFn := NewRedisApplicationActivationSettingRegistry(ctx)
+			t.Cleanup(aasRegCloseFn)
+
+			c := componenttest.NewComponent(t, &component.Config{})
+			js := test.Must(joinserver.New(c, &joinserver.Config{
+				ApplicationActivationSettings: aasReg,
+				Devices:                       devReg,
+				Keys:                          keyReg,
+				JoinEUIPrefixes:               joinEUIPrefixes,
+				DevNonceLimit:                 defaultDevNonceLimit,
+			}))
+			componenttest.StartComponent(t, c)
+
+			ids := &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       "test-dev",
+				JoinEui:        joinEUI.Bytes(),
+				DevEui:         devEUI.Bytes(),
+			}
+			_, err := devReg.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, nil,
+				func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+					return &ttnpb.EndDevice{
+						Ids: ids,
+						RootKeys: &ttnpb.RootKeys{
+							AppKey: &ttnpb.KeyEnvelope{Key: appKey.Bytes()},
+							NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey.Bytes()},
+						},
+						LorawanVersion:       ttnpb.MACVersion_MAC_V1_1,
+						NetworkServerAddress: nsAddr,
+						LastDevNonce:         0x42,
+						LastJoinNonce:        0x42,
+					}, []string{
+						"ids.application_ids",
+						"ids.dev_eui",
+						"ids.device_id",
+						"ids.join_eui",
+						"last_dev_nonce",
+						"last_join_nonce",
+						"lorawan_version",
+						"network_server_address",
+						"root_keys",
+					}, nil
+				},
+			)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			res, err := js.HandleJoin(ctx, ttnpb.Clone(tc.JoinRequest), joinserver.ClusterAuthorizer(ctx))
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				a.So(res, should.BeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+				t.FailNow()
+			}
+
+			// The rejoin-accept is encrypted with JSEncKey and its MIC is computed with JSIntKey over the rejoin type
+			// and RJcount0. The session keys are derived with RJcount0 in place of DevNonce.
+			jn := types.JoinNonce{0x00, 0x00, 0x43}
+			dn := types.DevNonce{0x00, 0x03}
+			dec, err := crypto.DecryptJoinAccept(crypto.DeriveJSEncKey(nwkKey, devEUI), res.RawPayload[1:])
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.RawPayload[0], should.Equal, 0x20)
+			a.So(dec[:3], should.Resemble, []byte{0x43, 0x00, 0x00})
+			mic, err := crypto.ComputeJoinAcceptMIC(
+				crypto.DeriveJSIntKey(nwkKey, devEUI), tc.JoinReqType, joinEUI, dn,
+				append(res.RawPayload[:1:1], dec[:len(dec)-4]...),
+			)
+			a.So(err, should.BeNil)
+			a.So(dec[len(dec)-4:], should.Resemble, mic[:])
+			a.So(res.SessionKeys.FNwkSIntKey.GetKey(), should.Resemble,
+				crypto.DeriveFNwkSIntKey(nwkKey, jn, joinEUI, dn).Bytes(),
+			)
+			a.So(res.SessionKeys.SNwkSIntKey.GetKey(), should.Resemble,
+				crypto.DeriveSNwkSIntKey(nwkKey, jn, joinEUI, dn).Bytes(),
+			)
+			a.So(res.SessionKeys.NwkSEncKey.GetKey(), should.Resemble,
+				crypto.DeriveNwkSEncKey(nwkKey, jn, joinEUI, dn).Bytes(),
+			)
+			a.So(res.SessionKeys.AppSKey.GetKey(), should.Resemble,
+				crypto.DeriveAppSKey(appKey, jn, joinEUI, dn).Bytes(),
+			)
+
+			stored, err := devReg.GetByEUI(ctx, joinEUI, devEUI, []string{
+				"last_dev_nonce",
+				"last_join_nonce",
+				"last_rj_count_0",
+				"session",
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(stored.LastDevNonce, should.Equal, 0x42)
+			a.So(stored.LastJoinNonce, should.Equal, 0x43)
+			a.So(stored.LastRjCount_0, should.Equal, 0x03)
+			a.So(stored.Session.GetKeys().GetSessionKeyId(), should.Resemble, res.SessionKeys.SessionKeyId)
+		})
+	}
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	_, ctx := test.New(t)
 	errTest := errors.New("test")
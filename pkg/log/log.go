@@ -40,3 +40,9 @@ type Stack interface {
 	// Use installs the specified middleware in the middleware stack.
 	Use(Middleware)
 }
+
+// LevelSetter is the interface of loggers of which the level can be changed at runtime.
+type LevelSetter interface {
+	// SetLevel sets the minimum level of the log messages to be shown.
+	SetLevel(Level)
+}
@@ -62,6 +62,15 @@ func (l *Logger) Use(middleware Middleware) {
 	l.stack = handler
 }
 
+// SetLevel implements LevelSetter.
+func (l *Logger) SetLevel(level Level) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if level != invalid {
+		l.Level = level
+	}
+}
+
 // commit comits the entry to the handler.
 func (l *Logger) commit(e *entry) {
 	handler := l.stack
@@ -69,10 +78,12 @@ func (l *Logger) commit(e *entry) {
 		handler = l.Handler
 	}
 
-	if handler != nil && l.Level <= e.level {
+	if handler != nil {
 		l.mutex.RLock()
-		defer l.mutex.RUnlock()
-		_ = handler.HandleLog(e)
+		if l.Level <= e.level {
+			_ = handler.HandleLog(e)
+		}
+		l.mutex.RUnlock()
 	}
 
 	if e.Level() == FatalLevel {
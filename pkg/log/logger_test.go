@@ -140,3 +140,22 @@ func TestMiddleware(t *testing.T) {
 
 	a.So(rec.entries, should.HaveLength, 2)
 }
+
+func TestLoggerSetLevel(t *testing.T) {
+	a := assertions.New(t)
+
+	rec := newRecorder()
+	logger := NewLogger(rec, WithLevel(InfoLevel))
+
+	logger.Debug("Yo!")
+	a.So(rec.entries, should.HaveLength, 0)
+
+	var setter LevelSetter = logger
+	setter.SetLevel(DebugLevel)
+	logger.Debug("Yo!")
+	a.So(rec.entries, should.HaveLength, 1)
+
+	setter.SetLevel(ErrorLevel)
+	logger.Warn("Hi!")
+	a.So(rec.entries, should.HaveLength, 1)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metering aggregates the usage of applications and gateways for billing.
+//
+// The Gateway Server, Network Server and Application Server record usage in a Recorder, which periodically adds
+// the usage to a shared Registry. The Identity Server flushes the Registry to its database.
+package metering
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Config is the configuration of usage metering.
+type Config struct {
+	Enable        bool          `name:"enable" description:"Enable usage metering of applications and gateways"`
+	FlushInterval time.Duration `name:"flush-interval" description:"Interval at which the recorded usage is added to the registry"` //nolint:lll
+}
+
+// Registry stores the usage counters until they are flushed to persistent storage.
+type Registry interface {
+	// Add adds the usage records to the counters.
+	Add(ctx context.Context, records []*ttnpb.UsageRecord) error
+	// Flush calls f with the usage records and removes the counters from the registry if f succeeds.
+	// If f returns an error, the counters are kept.
+	Flush(ctx context.Context, f func(context.Context, []*ttnpb.UsageRecord) error) error
+}
+
+// Usage is the usage of an application or gateway.
+type Usage struct {
+	Uplinks           uint64
+	Downlinks         uint64
+	Joins             uint64
+	Airtime           time.Duration
+	WebhookDeliveries uint64
+}
+
+// Day returns the start of the day (UTC) of t.
+func Day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// Add adds the counters of src to dst.
+func Add(dst, src *ttnpb.UsageRecord) {
+	dst.Uplinks += src.GetUplinks()
+	dst.Downlinks += src.GetDownlinks()
+	dst.Joins += src.GetJoins()
+	if src.GetAirtime() != nil {
+		dst.Airtime = durationpb.New(dst.GetAirtime().AsDuration() + src.GetAirtime().AsDuration())
+	}
+	dst.WebhookDeliveries += src.GetWebhookDeliveries()
+}
+
+func (u Usage) toProto(ids *ttnpb.EntityIdentifiers, day time.Time) *ttnpb.UsageRecord {
+	pb := &ttnpb.UsageRecord{
+		EntityIds:         ids,
+		Day:               timestamppb.New(day),
+		Uplinks:           u.Uplinks,
+		Downlinks:         u.Downlinks,
+		Joins:             u.Joins,
+		WebhookDeliveries: u.WebhookDeliveries,
+	}
+	if u.Airtime > 0 {
+		pb.Airtime = durationpb.New(u.Airtime)
+	}
+	return pb
+}
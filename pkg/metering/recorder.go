@@ -0,0 +1,122 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const finalFlushTimeout = 10 * time.Second
+
+type recordKey struct {
+	day        int64
+	entityType string
+	entityID   string
+}
+
+// Recorder aggregates the usage in memory and periodically adds it to the registry.
+// A nil Recorder does not record usage.
+type Recorder struct {
+	registry Registry
+
+	mu      sync.Mutex
+	records map[recordKey]*ttnpb.UsageRecord
+}
+
+// NewRecorder returns a new Recorder that adds the recorded usage to the registry.
+func NewRecorder(registry Registry) *Recorder {
+	return &Recorder{
+		registry: registry,
+		records:  make(map[recordKey]*ttnpb.UsageRecord),
+	}
+}
+
+// Record records the usage of the application or gateway.
+func (r *Recorder) Record(ids *ttnpb.EntityIdentifiers, usage Usage) {
+	if r == nil {
+		return
+	}
+	day := Day(time.Now())
+	pb := usage.toProto(ids, day)
+	key := recordKey{
+		day:        day.Unix(),
+		entityType: ids.EntityType(),
+		entityID:   ids.IDString(),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.add(key, pb)
+}
+
+func (r *Recorder) add(key recordKey, pb *ttnpb.UsageRecord) {
+	if stored, ok := r.records[key]; ok {
+		Add(stored, pb)
+		return
+	}
+	r.records[key] = pb
+}
+
+// Flush adds the recorded usage to the registry.
+// If the registry returns an error, the usage is kept in memory until the next flush.
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	records := r.records
+	r.records = make(map[recordKey]*ttnpb.UsageRecord)
+	r.mu.Unlock()
+	if len(records) == 0 {
+		return nil
+	}
+
+	pbs := make([]*ttnpb.UsageRecord, 0, len(records))
+	for _, pb := range records {
+		pbs = append(pbs, pb)
+	}
+	if err := r.registry.Add(ctx, pbs); err != nil {
+		r.mu.Lock()
+		for key, pb := range records {
+			r.add(key, pb)
+		}
+		r.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Run flushes the recorded usage at the given interval until the context is done.
+// When the context is done, the remaining usage is flushed.
+func (r *Recorder) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finalFlushTimeout)
+			defer cancel()
+			if err := r.Flush(flushCtx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to flush usage")
+			}
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to flush usage")
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/metering"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockRegistry struct {
+	err     error
+	records []*ttnpb.UsageRecord
+}
+
+func (r *mockRegistry) Add(_ context.Context, records []*ttnpb.UsageRecord) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.records = append(r.records, records...)
+	return nil
+}
+
+func (*mockRegistry) Flush(context.Context, func(context.Context, []*ttnpb.UsageRecord) error) error {
+	return nil
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var nilRecorder *Recorder
+	nilRecorder.Record((&ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}).GetEntityIdentifiers(), Usage{
+		Uplinks: 1,
+	})
+
+	reg := &mockRegistry{err: errors.New("add failed")}
+	recorder := NewRecorder(reg)
+
+	appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}).GetEntityIdentifiers()
+	gtwIDs := (&ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}).GetEntityIdentifiers()
+	recorder.Record(appIDs, Usage{Uplinks: 1, Airtime: 50 * time.Millisecond})
+	recorder.Record(gtwIDs, Usage{Uplinks: 1, Airtime: 50 * time.Millisecond})
+	recorder.Record(appIDs, Usage{Joins: 1})
+
+	// The usage is kept when the registry fails.
+	a.So(recorder.Flush(ctx), should.NotBeNil)
+	recorder.Record(appIDs, Usage{Downlinks: 1, WebhookDeliveries: 2})
+
+	reg.err = nil
+	a.So(recorder.Flush(ctx), should.BeNil)
+	sort.Slice(reg.records, func(i, j int) bool {
+		return reg.records[i].EntityIds.EntityType() < reg.records[j].EntityIds.EntityType()
+	})
+	day := timestamppb.New(Day(time.Now()))
+	a.So(reg.records, should.Resemble, []*ttnpb.UsageRecord{
+		{
+			EntityIds:         appIDs,
+			Day:               day,
+			Uplinks:           1,
+			Downlinks:         1,
+			Joins:             1,
+			Airtime:           durationpb.New(50 * time.Millisecond),
+			WebhookDeliveries: 2,
+		},
+		{
+			EntityIds: gtwIDs,
+			Day:       day,
+			Uplinks:   1,
+			Airtime:   durationpb.New(50 * time.Millisecond),
+		},
+	})
+
+	reg.records = nil
+	a.So(recorder.Flush(ctx), should.BeNil)
+	a.So(reg.records, should.BeEmpty)
+}
@@ -0,0 +1,353 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the usage metering registry in Redis.
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"runtime/trace"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidEntityType = errors.DefineInvalidArgument("invalid_entity_type", "invalid entity type `{entity_type}`")
+	errInvalidMember     = errors.DefineCorruption("invalid_member", "invalid usage member `{member}`")
+)
+
+const (
+	uplinksField           = "uplinks"
+	downlinksField         = "downlinks"
+	joinsField             = "joins"
+	airtimeField           = "airtime"
+	webhookDeliveriesField = "webhook_deliveries"
+)
+
+// DefaultClaimTimeout is the default time after which a claimed batch of usage that is not flushed is restored.
+const DefaultClaimTimeout = 10 * time.Minute
+
+// UsageRegistry is an implementation of metering.Registry.
+// The counters of an entity on a day are stored in a hash. A set contains the members of the hashes that are pending
+// to be flushed. Flushing atomically moves the counters to a batch, which is deleted only after the usage has been
+// flushed, so that the usage is counted exactly once by concurrent instances. A batch of an instance that stopped
+// while flushing is restored after the claim timeout. If the instance stopped after the usage was flushed but before
+// the batch was deleted, the usage is counted again.
+type UsageRegistry struct {
+	Redis *ttnredis.Client
+	// ClaimTimeout is the time after which a claimed batch is restored. If zero, DefaultClaimTimeout is used.
+	ClaimTimeout time.Duration
+}
+
+var _ metering.Registry = (*UsageRegistry)(nil)
+
+func (r *UsageRegistry) pendingKey() string {
+	return r.Redis.Key("pending")
+}
+
+func (r *UsageRegistry) usageKey(member string) string {
+	return r.Redis.Key("usage", member)
+}
+
+func (r *UsageRegistry) batchesKey() string {
+	return r.Redis.Key("batches")
+}
+
+func (r *UsageRegistry) batchKey(batch string) string {
+	return r.Redis.Key("batch", batch)
+}
+
+func (r *UsageRegistry) batchUsageKey(batch, member string) string {
+	return r.Redis.Key("batch", batch, "usage", member)
+}
+
+func member(pb *ttnpb.UsageRecord) (string, error) {
+	ids := pb.GetEntityIds()
+	switch entityType := ids.EntityType(); entityType {
+	case "application", "gateway":
+		return strings.Join([]string{
+			strconv.FormatInt(pb.GetDay().AsTime().Unix(), 10),
+			entityType,
+			ids.IDString(),
+		}, ":"), nil
+	default:
+		return "", errInvalidEntityType.WithAttributes("entity_type", entityType)
+	}
+}
+
+func parseMember(member string) (*ttnpb.EntityIdentifiers, time.Time, error) {
+	parts := strings.SplitN(member, ":", 3)
+	if len(parts) != 3 {
+		return nil, time.Time{}, errInvalidMember.WithAttributes("member", member)
+	}
+	day, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, time.Time{}, errInvalidMember.WithAttributes("member", member).WithCause(err)
+	}
+	var ids *ttnpb.EntityIdentifiers
+	switch parts[1] {
+	case "application":
+		ids = (&ttnpb.ApplicationIdentifiers{ApplicationId: parts[2]}).GetEntityIdentifiers()
+	case "gateway":
+		ids = (&ttnpb.GatewayIdentifiers{GatewayId: parts[2]}).GetEntityIdentifiers()
+	default:
+		return nil, time.Time{}, errInvalidMember.WithAttributes("member", member)
+	}
+	return ids, time.Unix(day, 0).UTC(), nil
+}
+
+// Add implements metering.Registry.
+func (r *UsageRegistry) Add(ctx context.Context, records []*ttnpb.UsageRecord) error {
+	defer trace.StartRegion(ctx, "add usage").End()
+
+	if len(records) == 0 {
+		return nil
+	}
+	_, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for _, pb := range records {
+			m, err := member(pb)
+			if err != nil {
+				return err
+			}
+			k := r.usageKey(m)
+			for field, v := range map[string]int64{
+				uplinksField:           int64(pb.GetUplinks()),
+				downlinksField:         int64(pb.GetDownlinks()),
+				joinsField:             int64(pb.GetJoins()),
+				airtimeField:           int64(pb.GetAirtime().AsDuration()),
+				webhookDeliveriesField: int64(pb.GetWebhookDeliveries()),
+			} {
+				if v > 0 {
+					p.HIncrBy(ctx, k, field, v)
+				}
+			}
+			p.SAdd(ctx, r.pendingKey(), m)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+func parseCounter(fields map[string]string, field string) (uint64, error) {
+	s, ok := fields[field]
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func parseRecord(m string, fields map[string]string) (*ttnpb.UsageRecord, error) {
+	ids, day, err := parseMember(m)
+	if err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.UsageRecord{
+		EntityIds: ids,
+		Day:       timestamppb.New(day),
+	}
+	var airtime uint64
+	for field, dst := range map[string]*uint64{
+		uplinksField:           &pb.Uplinks,
+		downlinksField:         &pb.Downlinks,
+		joinsField:             &pb.Joins,
+		airtimeField:           &airtime,
+		webhookDeliveriesField: &pb.WebhookDeliveries,
+	} {
+		if *dst, err = parseCounter(fields, field); err != nil {
+			return nil, errInvalidMember.WithAttributes("member", m).WithCause(err)
+		}
+	}
+	if airtime > 0 {
+		pb.Airtime = durationpb.New(time.Duration(airtime))
+	}
+	return pb, nil
+}
+
+var claimUsageScript = redis.NewScript(`local members = redis.call('spop', KEYS[1], ARGV[1])
+if #members == 0 then
+	return members
+end
+for _, m in ipairs(members) do
+	local k = ARGV[2] .. m
+	if redis.call('exists', k) == 1 then
+		redis.call('rename', k, ARGV[3] .. m)
+		redis.call('sadd', KEYS[2], m)
+	end
+end
+redis.call('zadd', KEYS[3], ARGV[5], ARGV[4])
+return members`)
+
+var restoreUsageScript = redis.NewScript(`for _, m in ipairs(redis.call('smembers', KEYS[2])) do
+	local src = ARGV[2] .. m
+	local fields = redis.call('hgetall', src)
+	if #fields > 0 then
+		local dst = ARGV[1] .. m
+		for i = 1, #fields, 2 do
+			redis.call('hincrby', dst, fields[i], fields[i+1])
+		end
+		redis.call('sadd', KEYS[1], m)
+		redis.call('del', src)
+	end
+end
+redis.call('del', KEYS[2])
+redis.call('zrem', KEYS[3], ARGV[3])`)
+
+// claim moves up to ttnredis.DefaultRangeCount pending members and their counters to the batch.
+// It returns the popped members, which is empty if there is no pending usage.
+func (r *UsageRegistry) claim(ctx context.Context, batch string, now time.Time) ([]string, error) {
+	members, err := claimUsageScript.Run(ctx, r.Redis,
+		[]string{r.pendingKey(), r.batchKey(batch), r.batchesKey()},
+		ttnredis.DefaultRangeCount,
+		r.usageKey(""),
+		r.batchUsageKey(batch, ""),
+		batch,
+		now.UnixNano(),
+	).StringSlice()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return members, nil
+}
+
+// restore merges the counters of the batch back into the pending usage and removes the batch.
+func (r *UsageRegistry) restore(ctx context.Context, batch string) error {
+	err := restoreUsageScript.Run(ctx, r.Redis,
+		[]string{r.pendingKey(), r.batchKey(batch), r.batchesKey()},
+		r.usageKey(""),
+		r.batchUsageKey(batch, ""),
+		batch,
+	).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// release removes the batch after its usage has been flushed.
+func (r *UsageRegistry) release(ctx context.Context, batch string, members []string) error {
+	_, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for _, m := range members {
+			p.Del(ctx, r.batchUsageKey(batch, m))
+		}
+		p.Del(ctx, r.batchKey(batch))
+		p.ZRem(ctx, r.batchesKey(), batch)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// restoreAbandoned restores the batches that were claimed longer than the claim timeout ago.
+// These are left behind by instances that stopped while flushing.
+func (r *UsageRegistry) restoreAbandoned(ctx context.Context, now time.Time) error {
+	timeout := r.ClaimTimeout
+	if timeout == 0 {
+		timeout = DefaultClaimTimeout
+	}
+	batches, err := r.Redis.ZRangeByScore(ctx, r.batchesKey(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(now.Add(-timeout).UnixNano(), 10),
+	}).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, batch := range batches {
+		log.FromContext(ctx).WithField("batch", batch).Warn("Restore abandoned usage")
+		if err := r.restore(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush implements metering.Registry.
+// The pending usage is claimed in batches. The counters of a batch are removed only after f succeeds, and merged
+// back into the pending usage if f fails.
+func (r *UsageRegistry) Flush(ctx context.Context, f func(context.Context, []*ttnpb.UsageRecord) error) error {
+	defer trace.StartRegion(ctx, "flush usage").End()
+
+	if err := r.restoreAbandoned(ctx, time.Now()); err != nil {
+		return err
+	}
+	for {
+		now := time.Now()
+		batchID, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+		if err != nil {
+			return err
+		}
+		batch := batchID.String()
+		members, err := r.claim(ctx, batch, now)
+		if err != nil {
+			return err
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		cmds := make([]*redis.MapStringStringCmd, len(members))
+		_, err = r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+			for i, m := range members {
+				cmds[i] = p.HGetAll(ctx, r.batchUsageKey(batch, m))
+			}
+			return nil
+		})
+		if err != nil {
+			if err := r.restore(ctx, batch); err != nil {
+				log.FromContext(ctx).WithError(err).Error("Failed to restore usage")
+			}
+			return ttnredis.ConvertError(err)
+		}
+		records := make([]*ttnpb.UsageRecord, 0, len(members))
+		for i, m := range members {
+			fields := cmds[i].Val()
+			if len(fields) == 0 {
+				// NOTE: The counters were claimed together with an earlier pop of the same member.
+				continue
+			}
+			pb, err := parseRecord(m, fields)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to parse usage")
+				continue
+			}
+			records = append(records, pb)
+		}
+		if len(records) > 0 {
+			if err := f(ctx, records); err != nil {
+				if err := r.restore(ctx, batch); err != nil {
+					log.FromContext(ctx).WithError(err).Error("Failed to restore usage")
+				}
+				return err
+			}
+		}
+		if err := r.release(ctx, batch, members); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	. "go.thethings.network/lorawan-stack/v3/pkg/metering/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUsageRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "metering")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+	reg := &UsageRegistry{Redis: cl}
+
+	day := metering.Day(time.Now())
+	appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}).GetEntityIdentifiers()
+	gtwIDs := (&ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}).GetEntityIdentifiers()
+
+	a.So(reg.Add(ctx, []*ttnpb.UsageRecord{
+		{
+			EntityIds: appIDs,
+			Day:       timestamppb.New(day),
+			Uplinks:   2,
+			Joins:     1,
+			Airtime:   durationpb.New(100 * time.Millisecond),
+		},
+		{
+			EntityIds: gtwIDs,
+			Day:       timestamppb.New(day),
+			Uplinks:   3,
+		},
+	}), should.BeNil)
+	a.So(reg.Add(ctx, []*ttnpb.UsageRecord{
+		{
+			EntityIds:         appIDs,
+			Day:               timestamppb.New(day),
+			Uplinks:           1,
+			Airtime:           durationpb.New(50 * time.Millisecond),
+			WebhookDeliveries: 4,
+		},
+	}), should.BeNil)
+
+	err := reg.Add(ctx, []*ttnpb.UsageRecord{
+		{
+			EntityIds: (&ttnpb.UserIdentifiers{UserId: "test-usr"}).GetEntityIdentifiers(),
+			Day:       timestamppb.New(day),
+			Uplinks:   1,
+		},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// The usage is restored when flushing fails.
+	errFlush := errors.New("flush failed")
+	err = reg.Flush(ctx, func(context.Context, []*ttnpb.UsageRecord) error {
+		return errFlush
+	})
+	a.So(err, should.Equal, errFlush)
+
+	var flushed []*ttnpb.UsageRecord
+	a.So(reg.Flush(ctx, func(_ context.Context, records []*ttnpb.UsageRecord) error {
+		flushed = append(flushed, records...)
+		return nil
+	}), should.BeNil)
+	sort.Slice(flushed, func(i, j int) bool {
+		return flushed[i].EntityIds.EntityType() < flushed[j].EntityIds.EntityType()
+	})
+	a.So(flushed, should.Resemble, []*ttnpb.UsageRecord{
+		{
+			EntityIds:         appIDs,
+			Day:               timestamppb.New(day),
+			Uplinks:           3,
+			Joins:             1,
+			Airtime:           durationpb.New(150 * time.Millisecond),
+			WebhookDeliveries: 4,
+		},
+		{
+			EntityIds: gtwIDs,
+			Day:       timestamppb.New(day),
+			Uplinks:   3,
+		},
+	})
+
+	// The usage is flushed only once.
+	a.So(reg.Flush(ctx, func(context.Context, []*ttnpb.UsageRecord) error {
+		t.Error("Unexpected flush")
+		return nil
+	}), should.BeNil)
+}
+
+func TestUsageRegistryClaim(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "metering", "claim")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+	reg := &UsageRegistry{Redis: cl}
+
+	day := metering.Day(time.Now())
+	appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}).GetEntityIdentifiers()
+	record := func(uplinks uint64) *ttnpb.UsageRecord {
+		return &ttnpb.UsageRecord{
+			EntityIds: appIDs,
+			Day:       timestamppb.New(day),
+			Uplinks:   uplinks,
+		}
+	}
+	collect := func(flushed *[]*ttnpb.UsageRecord) func(context.Context, []*ttnpb.UsageRecord) error {
+		return func(_ context.Context, records []*ttnpb.UsageRecord) error {
+			*flushed = append(*flushed, records...)
+			return nil
+		}
+	}
+
+	// Usage that is added while flushing is kept and flushed in the next batch.
+	a.So(reg.Add(ctx, []*ttnpb.UsageRecord{record(1)}), should.BeNil)
+	var flushed []*ttnpb.UsageRecord
+	a.So(reg.Flush(ctx, func(ctx context.Context, records []*ttnpb.UsageRecord) error {
+		if len(flushed) == 0 {
+			if err := reg.Add(ctx, []*ttnpb.UsageRecord{record(2)}); err != nil {
+				return err
+			}
+		}
+		flushed = append(flushed, records...)
+		return nil
+	}), should.BeNil)
+	a.So(flushed, should.Resemble, []*ttnpb.UsageRecord{record(1), record(2)})
+
+	// A batch that is claimed longer than the claim timeout ago is restored by another instance. The usage is restored
+	// only once if the original flush fails afterwards.
+	a.So(reg.Add(ctx, []*ttnpb.UsageRecord{record(3)}), should.BeNil)
+	other := &UsageRegistry{Redis: cl, ClaimTimeout: time.Nanosecond}
+	errFlush := errors.New("flush failed")
+	flushed = nil
+	a.So(reg.Flush(ctx, func(ctx context.Context, records []*ttnpb.UsageRecord) error {
+		time.Sleep(test.Delay)
+		if err := other.Flush(ctx, collect(&flushed)); err != nil {
+			return err
+		}
+		return errFlush
+	}), should.Equal, errFlush)
+	a.So(flushed, should.Resemble, []*ttnpb.UsageRecord{record(3)})
+
+	a.So(reg.Flush(ctx, func(context.Context, []*ttnpb.UsageRecord) error {
+		t.Error("Unexpected flush")
+		return nil
+	}), should.BeNil)
+}
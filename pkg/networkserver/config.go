@@ -17,6 +17,7 @@ package networkserver
 import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -40,6 +41,38 @@ type DownlinkTaskQueueConfig struct {
 	NumConsumers uint64            `name:"num-consumers"`
 }
 
+// LinkStatsConfig defines the configuration of the link statistics of end devices.
+type LinkStatsConfig struct {
+	Registry      LinkStatsRegistry `name:"-"`
+	HourRetention time.Duration     `name:"hour-retention" description:"Retention of hourly link statistics"`
+	DayRetention  time.Duration     `name:"day-retention" description:"Retention of daily link statistics"`
+}
+
+// RekeyCampaignsConfig defines the configuration of session rekey campaigns.
+type RekeyCampaignsConfig struct {
+	Registry RekeyCampaignRegistry `name:"-"`
+	Interval time.Duration         `name:"interval" description:"Interval at which running rekey campaigns are processed"`
+}
+
+// SecurityMonitorConfig defines the configuration of the security monitor.
+// The thresholds and windows are the defaults for applications that do not override them.
+type SecurityMonitorConfig struct {
+	Settings                SecurityMonitorSettingsRegistry `name:"-"`
+	State                   SecurityMonitorStateRegistry    `name:"-"`
+	Enable                  bool                            `name:"enable" description:"Enable detection of security anomalies of joins and frame counters"`
+	DevNonceReplayThreshold uint32                          `name:"dev-nonce-replay-threshold" description:"Number of DevNonce replays of an end device within the window that is reported"`
+	DevNonceReplayWindow    time.Duration                   `name:"dev-nonce-replay-window" description:"Window in which DevNonce replays are counted"`
+	JoinFloodThreshold      uint32                          `name:"join-flood-threshold" description:"Number of join-requests of an end device within the window that is reported"`
+	JoinFloodWindow         time.Duration                   `name:"join-flood-window" description:"Window in which join-requests are counted"`
+	MICFailureThreshold     uint32                          `name:"mic-failure-threshold" description:"Number of MIC failures of an end device within the window that is reported"`
+	MICFailureWindow        time.Duration                   `name:"mic-failure-window" description:"Window in which MIC failures are counted"`
+	MaxTravelSpeed          float32                         `name:"max-travel-speed" description:"Maximum travel speed of an end device in km/h"`
+	TravelDistanceMargin    float32                         `name:"travel-distance-margin" description:"Distance in km between gateways that is not considered travel"`
+	Notify                  bool                            `name:"notify" description:"Notify collaborators of anomalies if not configured by the application"`
+	StateTTL                time.Duration                   `name:"state-ttl" description:"Time for which the last position and accepted join of an end device are kept"`
+	CacheSize               int                             `name:"cache-size" description:"Number of end devices and applications for which the security monitor keeps state in memory"`
+}
+
 // MACSettingConfig defines MAC-layer configuration.
 type MACSettingConfig struct {
 	ADRMargin                  *float32                   `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
@@ -135,9 +168,11 @@ type InteropConfig struct {
 type Config struct {
 	ApplicationUplinkQueue   ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
 	Devices                  DeviceRegistry               `name:"-"`
+	MulticastGroups          MulticastGroupRegistry       `name:"-"`
 	DownlinkTaskQueue        DownlinkTaskQueueConfig      `name:"downlink-task-queue"`
 	UplinkDeduplicator       UplinkDeduplicator           `name:"-"`
 	ScheduledDownlinkMatcher ScheduledDownlinkMatcher     `name:"-"`
+	Metering                 *metering.Recorder           `name:"-"`
 	NetID                    types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
 	ClusterID                string                       `name:"cluster-id" description:"Cluster ID of this Network Server"`
 	DevAddrPrefixes          []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
@@ -148,6 +183,9 @@ type Config struct {
 	Interop                  InteropConfig                `name:"interop" description:"Interop client configuration"`
 	DeviceKEKLabel           string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity    int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
+	LinkStats                LinkStatsConfig              `name:"link-stats" description:"Link statistics of end devices"`
+	RekeyCampaigns           RekeyCampaignsConfig         `name:"rekey-campaigns" description:"Session rekey campaigns of end devices"`
+	SecurityMonitor          SecurityMonitorConfig        `name:"security-monitor" description:"Security anomaly detection of joins and frame counters"`
 }
 
 // DefaultConfig is the default Network Server configuration.
@@ -178,4 +216,23 @@ var DefaultConfig = Config{
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
 	DownlinkQueueCapacity: 10000,
+	LinkStats: LinkStatsConfig{
+		HourRetention: 7 * 24 * time.Hour,
+		DayRetention:  90 * 24 * time.Hour,
+	},
+	RekeyCampaigns: RekeyCampaignsConfig{
+		Interval: 10 * time.Second,
+	},
+	SecurityMonitor: SecurityMonitorConfig{
+		DevNonceReplayThreshold: 3,
+		DevNonceReplayWindow:    time.Hour,
+		JoinFloodThreshold:      20,
+		JoinFloodWindow:         time.Hour,
+		MICFailureThreshold:     10,
+		MICFailureWindow:        time.Hour,
+		MaxTravelSpeed:          1000,
+		TravelDistanceMargin:    50,
+		StateTTL:                7 * 24 * time.Hour,
+		CacheSize:               100000,
+	},
 }
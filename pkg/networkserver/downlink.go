@@ -30,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
@@ -304,6 +305,15 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			genState.EventBuilders = append(genState.EventBuilders, st.QueuedEvents...)
 		}
 
+		// NOTE: MAC commands enqueued by the network operator are sent after the MAC commands
+		// enqueued by the Network Server, such that these do not interfere with the MAC state.
+		operatorAnswers, st := mac.EnqueueOperatorCommands(ctx, dev, maxDownLen, maxUpLen)
+		maxDownLen = st.MaxDownLen
+		maxUpLen = st.MaxUpLen
+		fPending = fPending || !st.Ok
+		genState.EventBuilders = append(genState.EventBuilders, st.QueuedEvents...)
+		cmds = append(cmds, operatorAnswers...)
+
 		b := make([]byte, 0, maxDownPayloadLen-maxDownLen)
 		cmds = append(cmds, dev.MacState.PendingRequests...)
 		for _, cmd := range cmds {
@@ -327,6 +337,27 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		for i, req := range dev.MacState.PendingRequests {
 			dev.MacState.PendingRequests[i] = req.Sanitized()
 		}
+	} else if mac.DeviceNeedsForceRejoinReq(dev) {
+		// NOTE: The end device does not answer ForceRejoinReq, so it can be sent in class B and C downlinks,
+		// which do not carry any other MAC commands.
+		n := len(dev.MacState.PendingRequests)
+		st := mac.EnqueueForceRejoinReq(ctx, dev, maxDownLen, maxUpLen)
+		maxDownLen = st.MaxDownLen
+		maxUpLen = st.MaxUpLen
+		genState.EventBuilders = append(genState.EventBuilders, st.QueuedEvents...)
+
+		b := make([]byte, 0, maxDownPayloadLen-maxDownLen)
+		for _, cmd := range dev.MacState.PendingRequests[n:] {
+			logger.WithField("cid", cmd.Cid).Debug("Add MAC command to buffer")
+			var err error
+			b, err = lorawan.DefaultMACCommands.AppendDownlink(*phy, b, cmd)
+			if err != nil {
+				return nil, generateDownlinkState{}, errEncodeMAC.WithCause(err)
+			}
+		}
+		if len(b) > 0 {
+			cmdBuf = b
+		}
 	}
 
 	var needsDownlink bool
@@ -1059,6 +1090,9 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 			)).Debug("Scheduled downlink")
 			queuedEvents = append(queuedEvents, successEvent.With(events.WithData(res)).New(ctx, eventIDOpt))
 			registerSuccess(ctx)
+			ns.metering.Record(req.EndDeviceIdentifiers.GetApplicationIds().GetEntityIdentifiers(), metering.Usage{
+				Downlinks: 1,
+			})
 			// Report to the upper layer only the latest (chronological) transmission
 			// for book keeping purposes (such as transmission times).
 			if latestScheduledDownlink == nil || transmitAt.Sub(latestScheduledDownlink.TransmitAt) > 0 {
@@ -1641,8 +1675,11 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 			"mac_state.last_confirmed_downlink_at",
 			"mac_state.last_downlink_at",
 			"mac_state.pending_application_downlink",
+			"mac_state.pending_operator_requests",
 			"mac_state.pending_relay_downlink",
 			"mac_state.pending_requests",
+			"mac_state.queued_force_rejoin_req",
+			"mac_state.queued_operator_commands",
 			"mac_state.queued_responses",
 			"mac_state.recent_downlinks",
 			"mac_state.rx_windows_available",
@@ -1876,7 +1913,10 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 			"mac_state.last_downlink_at",
 			"mac_state.last_network_initiated_downlink_at",
 			"mac_state.pending_application_downlink",
+			"mac_state.pending_operator_requests",
 			"mac_state.pending_requests",
+			"mac_state.queued_force_rejoin_req",
+			"mac_state.queued_operator_commands",
 			"mac_state.queued_responses",
 			"mac_state.recent_downlinks",
 			"mac_state.rx_windows_available",
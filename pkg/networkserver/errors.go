@@ -67,14 +67,20 @@ var (
 	errInvalidFixedPaths = errors.DefineInvalidArgument(
 		"fixed_paths", "invalid fixed paths set in application downlink",
 	)
-	errInvalidPayload     = errors.DefineInvalidArgument("payload", "invalid payload")
-	errJoinServerNotFound = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errInvalidPayload       = errors.DefineInvalidArgument("payload", "invalid payload")
+	errJoinServerNotFound   = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errMulticastMACCommands = errors.DefineFailedPrecondition(
+		"multicast_mac_commands", "MAC commands cannot be enqueued for multicast device",
+	)
 	errNoPath             = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errOutdatedData       = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
 	errRawPayloadTooShort = errors.Define(
 		"raw_payload_too_short", "length of RawPayload must not be less than 4",
 	)
-	errSchedule          = errors.Define("schedule", "all downlink scheduling attempts failed")
+	errSchedule           = errors.Define("schedule", "all downlink scheduling attempts failed")
+	errTooManyMACCommands = errors.DefineResourceExhausted(
+		"too_many_mac_commands", "number of queued MAC commands exceeds maximum of `{max}`",
+	)
 	errUnknownMACState   = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
 	errUnknownMACVersion = errors.DefineFailedPrecondition(
 		"unknown_mac_version", "MAC version is unknown",
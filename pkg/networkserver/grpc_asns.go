@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
@@ -74,8 +75,13 @@ func (ns *NetworkServer) sendApplicationUplinks(ctx context.Context, cl ttnpb.Ns
 		switch pld := up.Up.(type) {
 		case *ttnpb.ApplicationUp_UplinkMessage:
 			registerForwardDataUplink(ctx, pld.UplinkMessage)
+			ns.metering.Record(up.EndDeviceIds.GetApplicationIds().GetEntityIdentifiers(), metering.Usage{
+				Uplinks: 1,
+				Airtime: pld.UplinkMessage.GetConsumedAirtime().AsDuration(),
+			})
 			evs = append(evs, evtForwardDataUplink.NewWithIdentifiersAndData(ctx, up.EndDeviceIds, up))
 		case *ttnpb.ApplicationUp_JoinAccept:
+			ns.metering.Record(up.EndDeviceIds.GetApplicationIds().GetEntityIdentifiers(), metering.Usage{Joins: 1})
 			evs = append(evs, evtForwardJoinAccept.NewWithIdentifiersAndData(ctx, up.EndDeviceIds, &ttnpb.ApplicationUp{
 				EndDeviceIds:   up.EndDeviceIds,
 				CorrelationIds: up.CorrelationIds,
@@ -287,6 +293,9 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 			if dev == nil {
 				return nil, nil, errDeviceNotFound.New()
 			}
+			if err := ns.setMulticastGroupDownlinkGateways(ctx, dev, req.Downlinks...); err != nil {
+				return nil, nil, err
+			}
 			if dev.Session != nil {
 				dev.Session.QueuedApplicationDownlinks = nil
 			}
@@ -347,6 +356,9 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 			if dev == nil {
 				return nil, nil, errDeviceNotFound.New()
 			}
+			if err := ns.setMulticastGroupDownlinkGateways(ctx, dev, req.Downlinks...); err != nil {
+				return nil, nil, err
+			}
 			fps, err := ns.FrequencyPlansStore(ctx)
 			if err != nil {
 				return nil, nil, err
@@ -70,6 +70,13 @@ var (
 		events.WithClientInfoFromContext(),
 		events.WithPropagateToParent(),
 	)
+	evtEnqueueOperatorMACCommands = events.Define(
+		"ns.mac.operator.enqueue", "enqueue operator MAC commands",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.MACCommands{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
 )
 
 const maxRequiredDeviceReadRightCount = 3
@@ -3646,6 +3653,72 @@ func (ns *NetworkServer) ResetFactoryDefaults(ctx context.Context, req *ttnpb.Re
 	return ttnpb.FilterGetEndDevice(dev, req.FieldMask.GetPaths()...)
 }
 
+// maxQueuedOperatorMACCommands is the maximum number of MAC commands that can be enqueued by the network operator.
+const maxQueuedOperatorMACCommands = 16
+
+// EnqueueMACCommands implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) EnqueueMACCommands(
+	ctx context.Context, req *ttnpb.EnqueueMACCommandsRequest,
+) (*ttnpb.OperatorMACCommands, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dev, ctx, err := ns.devices.SetByID(ctx, req.EndDeviceIds.ApplicationIds, req.EndDeviceIds.DeviceId,
+		deviceDownlinkFullPaths[:],
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			switch {
+			case stored == nil:
+				return nil, nil, errDeviceNotFound.New()
+			case stored.Multicast:
+				return nil, nil, errMulticastMACCommands.New()
+			case stored.MacState == nil:
+				return nil, nil, errUnknownMACState.New()
+			}
+			phy, err := DeviceBand(stored, fps)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, cmd := range req.Commands {
+				if err := mac.ValidateOperatorCommand(stored, phy, cmd); err != nil {
+					return nil, nil, err
+				}
+			}
+			queued := stored.MacState.QueuedOperatorCommands
+			if req.Replace {
+				queued = nil
+			}
+			if n := len(queued) + len(req.Commands); n > maxQueuedOperatorMACCommands {
+				return nil, nil, errTooManyMACCommands.WithAttributes("max", maxQueuedOperatorMACCommands)
+			}
+			stored.MacState.QueuedOperatorCommands = append(queued, req.Commands...)
+			return stored, []string{
+				"mac_state.queued_operator_commands",
+			}, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to enqueue MAC commands in registry")
+		return nil, err
+	}
+	events.Publish(evtEnqueueOperatorMACCommands.NewWithIdentifiersAndData(ctx, req.EndDeviceIds, &ttnpb.MACCommands{
+		Commands: req.Commands,
+	}))
+
+	if err := ns.updateDataDownlinkTask(ctx, dev, time.Time{}); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after MAC command enqueue")
+	}
+	return &ttnpb.OperatorMACCommands{
+		QueuedCommands:  dev.MacState.QueuedOperatorCommands,
+		PendingRequests: dev.MacState.PendingOperatorRequests,
+	}, nil
+}
+
 // Delete implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*emptypb.Empty, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
@@ -3666,13 +3739,15 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 	if evt != nil {
 		events.Publish(evt)
 	}
+	removeDevicesFromMulticastGroups(ctx, ns.multicastGroups, req.ApplicationIds, req.DeviceId)
 	return ttnpb.Empty, nil
 }
 
 type nsEndDeviceBatchRegistry struct {
 	ttnpb.UnimplementedNsEndDeviceBatchRegistryServer
 
-	devices DeviceRegistry
+	devices         DeviceRegistry
+	multicastGroups MulticastGroupRegistry
 }
 
 // Delete implements ttipb.NsEndDeviceBatchRegistryServer.
@@ -3702,6 +3777,11 @@ func (srv *nsEndDeviceBatchRegistry) Delete(
 				},
 			),
 		)
+		deviceIDs := make([]string, 0, len(deleted))
+		for _, ids := range deleted {
+			deviceIDs = append(deviceIDs, ids.DeviceId)
+		}
+		removeDevicesFromMulticastGroups(ctx, srv.multicastGroups, req.ApplicationIds, deviceIDs...)
 	}
 
 	return ttnpb.Empty, nil
@@ -529,6 +529,7 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 	}
 	var setPaths []string
 	recentMACCommandIdentifiers := make([]ttnpb.MACCommandIdentifier, 0, 1)
+	var handledMACCommands []*ttnpb.MACCommand
 	dev.MacState.QueuedResponses = dev.MacState.QueuedResponses[:0]
 macLoop:
 	for len(cmds) > 0 {
@@ -639,8 +640,12 @@ macLoop:
 		}
 		queuedEventBuilders = append(queuedEventBuilders, evs...)
 		recentMACCommandIdentifiers = appendRecentMACCommandIdentifier(recentMACCommandIdentifiers, cmd.Cid)
+		handledMACCommands = append(handledMACCommands, cmd)
 	}
 	dev.MacState.RecentMacCommandIdentifiers = recentMACCommandIdentifiers
+	queuedEventBuilders = append(queuedEventBuilders,
+		mac.HandleOperatorRequestAnswers(ctx, dev, handledMACCommands...)...,
+	)
 	if n := len(dev.MacState.PendingRequests); n > 0 {
 		logger.WithField("unanswered_request_count", n).Debug("MAC command buffer not fully answered")
 		queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnansweredMACCommand.BindData(&ttnpb.MACCommands{
@@ -928,6 +933,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	defer flushMatchStats()
 
 	var matched *matchResult
+	var micFailures []*ttnpb.EndDeviceIdentifiers
 	if err := ns.devices.RangeByUplinkMatches(ctx, up,
 		func(ctx context.Context, match *UplinkMatch) (bool, error) {
 			defer trace.StartRegion(ctx, "iterate uplink match").End()
@@ -962,6 +968,10 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			}
 			if !ok {
 				trace.Log(ctx, "ns", "no mic match")
+				micFailures = appendSecurityCandidate(micFailures, &ttnpb.EndDeviceIdentifiers{
+					ApplicationIds: match.ApplicationIdentifiers,
+					DeviceId:       match.DeviceID,
+				})
 				return false, nil
 			}
 			trace.Log(ctx, "ns", "mic match")
@@ -990,6 +1000,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		return errDeviceNotFound.WithCause(err)
 	}
 	if !ok {
+		ns.handleSecurityMICFailures(ctx, micFailures, up)
 		return errDeviceNotFound.New()
 	}
 
@@ -1114,6 +1125,9 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after data uplink")
 	}
 	if !matched.IsRetransmission {
+		ns.recordLinkStats(ctx, stored.Ids, matched.cmacFMatchingResult, up)
+		ns.handleSecurityDataUplink(ctx, stored.Ids, matched.cmacFMatchingResult, up)
+
 		var frmPayload []byte
 		switch pld.FPort {
 		case 0:
@@ -1198,7 +1212,8 @@ func (ns *NetworkServer) sendJoinRequest(ctx context.Context, ids *ttnpb.EndDevi
 			return nil, queuedEvents, err
 		}
 	}
-	if ns.interopClient != nil {
+	// NOTE: Rejoin-requests are only handled by the cluster-local Join Server.
+	if ns.interopClient != nil && req.Payload.GetMHdr().GetMType() == ttnpb.MType_JOIN_REQUEST {
 		queuedEvents = append(queuedEvents, evtInteropJoinAttempt.NewWithIdentifiersAndData(ctx, ids, req))
 		resp, err := ns.interopClient.HandleJoinRequest(ctx, ns.netID(ctx), ns.nsID(ctx), req)
 		if err == nil {
@@ -1281,6 +1296,24 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		return nil
 	}
 
+	return ns.acceptJoinRequest(ctx, up, matched, nil, &queuedEvents)
+}
+
+// rejoinRequest is the rejoin-request of type 0 or 2 for which a join-accept is requested.
+type rejoinRequest struct {
+	rejoinType ttnpb.RejoinRequestType
+	rejoinCnt  uint32
+}
+
+// acceptJoinRequest requests a join-accept for the join-request, or the rejoin-request if rejoin is set, in up from
+// the Join Server and schedules its transmission to the matched device.
+func (ns *NetworkServer) acceptJoinRequest(
+	ctx context.Context,
+	up *ttnpb.UplinkMessage,
+	matched *ttnpb.EndDevice,
+	rejoin *rejoinRequest,
+	queuedEvents *[]events.Event,
+) error {
 	fps, err := ns.FrequencyPlansStore(ctx)
 	if err != nil {
 		return err
@@ -1298,6 +1331,14 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		log.FromContext(ctx).WithError(err).Warn("Failed to reset device's MAC state")
 		return err
 	}
+	keepsParameters := rejoin != nil && rejoin.rejoinType == ttnpb.RejoinRequestType_KEYS
+	params := macState.DesiredParameters
+	if keepsParameters {
+		// NOTE: A rejoin-request of type 2 only renews the session, the radio parameters are kept.
+		macState.CurrentParameters = matched.MacState.CurrentParameters
+		macState.DesiredParameters = matched.MacState.DesiredParameters
+		params = macState.CurrentParameters
+	}
 
 	chIdx, err := searchUplinkChannel(up.Settings.Frequency, macState)
 	if err != nil {
@@ -1334,15 +1375,19 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	// than 19 bytes. For such bands, we need to always omit the CFList.
 	// NOTE: The 5 bytes added to the maximum represent the MHDR (1 byte) and the MIC (4 bytes).
 	var cfList *ttnpb.CFList
-	if maxMACPayloadSize+5 >= lorawan.JoinAcceptWithCFListLength {
-		cfList = mac.CFList(phy, macState.DesiredParameters.Channels...)
+	if maxMACPayloadSize+5 >= lorawan.JoinAcceptWithCFListLength && !keepsParameters {
+		cfList = mac.CFList(phy, params.Channels...)
 	}
 	dlSettings := &ttnpb.DLSettings{
-		Rx1DrOffset: macState.DesiredParameters.Rx1DataRateOffset,
-		Rx2Dr:       macState.DesiredParameters.Rx2DataRateIndex,
+		Rx1DrOffset: params.Rx1DataRateOffset,
+		Rx2Dr:       params.Rx2DataRateIndex,
 		OptNeg:      macspec.UseRekeyInd(matched.LorawanVersion),
 	}
 
+	var joinEUI []byte
+	if rejoin != nil {
+		joinEUI = matched.Ids.JoinEui
+	}
 	resp, joinEvents, err := ns.sendJoinRequest(ctx, matched.Ids, &ttnpb.JoinRequest{
 		Payload:            up.Payload,
 		CfList:             cfList,
@@ -1350,13 +1395,15 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		DevAddr:            devAddr.Bytes(),
 		NetId:              ns.netID(ctx).Bytes(),
 		RawPayload:         up.RawPayload,
-		RxDelay:            macState.DesiredParameters.Rx1Delay,
+		RxDelay:            params.Rx1Delay,
 		SelectedMacVersion: matched.LorawanVersion, // Assume NS version is always higher than the version of the device
 		ConsumedAirtime:    up.ConsumedAirtime,
 		DownlinkSettings:   dlSettings,
+		JoinEui:            joinEUI,
 	})
 
-	queuedEvents = append(queuedEvents, joinEvents...)
+	*queuedEvents = append(*queuedEvents, joinEvents...)
+	ns.handleSecurityJoinRequest(ctx, matched.Ids, up, err)
 	if err != nil {
 		return err
 	}
@@ -1389,7 +1436,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		DevAddr:        devAddr.Bytes(),
 		NetId:          ns.netID(ctx).Bytes(),
 		Request: &ttnpb.MACState_JoinRequest{
-			RxDelay:          macState.DesiredParameters.Rx1Delay,
+			RxDelay:          params.Rx1Delay,
 			CfList:           cfList,
 			DownlinkSettings: dlSettings,
 		},
@@ -1401,8 +1448,8 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		return err
 	}
 
-	publishEvents(ctx, queuedEvents...)
-	queuedEvents = nil
+	publishEvents(ctx, *queuedEvents...)
+	*queuedEvents = nil
 	up = ttnpb.Clone(up)
 	select {
 	case <-ctx.Done():
@@ -1414,22 +1461,30 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	macState.RecentUplinks = appendRecentUplink(nil, up, recentUplinkCount)
 
 	logger := log.FromContext(ctx)
-	stored, storedCtx, err := ns.devices.SetByID(ctx, matched.Ids.ApplicationIds, matched.Ids.DeviceId,
-		[]string{
-			"frequency_plan_id",
-			"lorawan_phy_version",
-			"pending_session.queued_application_downlinks",
-			"session.queued_application_downlinks",
-		},
+	gets := []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"pending_session.queued_application_downlinks",
+		"session.queued_application_downlinks",
+	}
+	if rejoin != nil {
+		gets = ttnpb.AddFields(gets, "mac_state.min_rj_count_0")
+	}
+	stored, storedCtx, err := ns.devices.SetByID(ctx, matched.Ids.ApplicationIds, matched.Ids.DeviceId, gets,
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if stored == nil {
 				logger.Warn("Device deleted during join-request handling, drop")
 				return nil, nil, errOutdatedData.New()
 			}
 			stored.PendingMacState = macState
-			return stored, []string{
+			paths := []string{
 				"pending_mac_state",
-			}, nil
+			}
+			if rejoin != nil && stored.MacState != nil {
+				stored.MacState.MinRjCount_0 = rejoin.rejoinCnt + 1
+				paths = ttnpb.AddFields(paths, "mac_state.min_rj_count_0")
+			}
+			return stored, paths, nil
 		})
 	if err != nil {
 		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
@@ -1440,7 +1495,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	ctx = storedCtx
 
 	// TODO: Extract this into a utility function shared with mac.HandleRejoinRequest. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
-	downAt := ttnpb.StdTime(up.ReceivedAt).Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - macState.DesiredParameters.Rx1Delay.Duration()/2 - nsScheduleWindow())
+	downAt := ttnpb.StdTime(up.ReceivedAt).Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - params.Rx1Delay.Duration()/2 - nsScheduleWindow())
 	if earliestAt := time.Now().Add(nsScheduleWindow()); downAt.Before(earliestAt) {
 		downAt = earliestAt
 	}
@@ -1448,18 +1503,124 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	if err := ns.downlinkTasks.Add(ctx, stored.Ids, downAt, true); err != nil {
 		logger.WithError(err).Error("Failed to add downlink task after join-request")
 	}
-	queuedEvents = append(queuedEvents, evtProcessJoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, up))
+	evtProcess := evtProcessJoinRequest
+	if rejoin != nil {
+		evtProcess = evtProcessRejoinRequest
+	}
+	*queuedEvents = append(*queuedEvents, evtProcess.NewWithIdentifiersAndData(ctx, matched.Ids, up))
 	registerProcessUplink(ctx, up)
 	return nil
 }
 
-var errRejoinRequest = errors.DefineUnavailable("rejoin_request", "rejoin-request handling is not implemented")
+var (
+	errRejoinRequestType = errors.DefineUnimplemented(
+		"rejoin_request_type", "rejoin-request type `{type}` is not supported",
+	)
+	errRejoinRequestNetID = errors.DefineInvalidArgument(
+		"rejoin_request_net_id", "rejoin-request NetID `{net_id}` does not match",
+	)
+	errRejoinRequestCount = errors.DefineInvalidArgument(
+		"rejoin_request_count", "rejoin-request RJcount0 `{count}` is lower than the expected `{min}`",
+	)
+)
 
-func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) error {
+func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	defer trace.StartRegion(ctx, "handle rejoin request").End()
 
-	// TODO: Implement https://github.com/TheThingsNetwork/lorawan-stack/issues/8
-	return errRejoinRequest.New()
+	pld := up.Payload.GetRejoinRequestPayload()
+	devEUI := types.MustEUI64(pld.DevEui).OrZero()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", devEUI,
+		"rejoin_type", pld.RejoinType,
+		"rejoin_cnt", pld.RejoinCnt,
+	))
+
+	switch pld.RejoinType {
+	case ttnpb.RejoinRequestType_CONTEXT, ttnpb.RejoinRequestType_KEYS:
+	default:
+		return errRejoinRequestType.WithAttributes("type", pld.RejoinType)
+	}
+	if netID := types.MustNetID(pld.NetId).OrZero(); !netID.Equal(ns.netID(ctx)) {
+		return errRejoinRequestNetID.WithAttributes("net_id", netID)
+	}
+
+	ok, err := ns.deduplicateUplink(ctx, up, joinRequestCollectionWindow, deduplicationLimit, initialDeduplicationRound)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		trace.Log(ctx, "ns", "message is duplicate")
+		return errDuplicateUplink.New()
+	}
+	trace.Log(ctx, "ns", "message is original")
+
+	// NOTE: The rejoin-request does not contain the JoinEUI, so the device is matched by DevEUI and the MIC,
+	// which is computed with the SNwkSIntKey of the current session.
+	var (
+		matched    *ttnpb.EndDevice
+		matchedCtx context.Context
+	)
+	if err := ns.devices.RangeByDevEUI(ctx, devEUI,
+		[]string{
+			"frequency_plan_id",
+			"lorawan_phy_version",
+			"lorawan_version",
+			"mac_settings",
+			"mac_state.current_parameters",
+			"mac_state.desired_parameters",
+			"mac_state.min_rj_count_0",
+			"session.dev_addr",
+			"session.keys.s_nwk_s_int_key",
+			"supports_class_b",
+			"supports_class_c",
+			"supports_join",
+		},
+		func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+			if !dev.SupportsJoin || !macspec.UseNwkKey(dev.LorawanVersion) ||
+				dev.MacState == nil || dev.GetSession().GetKeys().GetSNwkSIntKey() == nil {
+				return true
+			}
+			key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.SNwkSIntKey, ns.KeyService())
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to unwrap SNwkSIntKey")
+				return true
+			}
+			n := len(up.RawPayload) - 4
+			mic, err := crypto.ComputeRejoinRequestMIC(key, up.RawPayload[:n])
+			if err != nil || !bytes.Equal(mic[:], up.RawPayload[n:]) {
+				return true
+			}
+			matched, matchedCtx = dev, ctx
+			return false
+		},
+	); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to load devices from registry by DevEUI")
+		return err
+	}
+	if matched == nil {
+		return errDeviceNotFound.New()
+	}
+	ctx = matchedCtx
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, matched.Ids))
+
+	queuedEvents := []events.Event{
+		evtReceiveRejoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, up),
+	}
+	defer func() {
+		if err != nil {
+			queuedEvents = append(queuedEvents, evtDropRejoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, err))
+		}
+		publishEvents(ctx, queuedEvents...)
+	}()
+
+	if minCnt := matched.MacState.MinRjCount_0; pld.RejoinCnt < minCnt {
+		log.FromContext(ctx).Info("Replayed rejoin-request, drop")
+		return errRejoinRequestCount.WithAttributes("count", pld.RejoinCnt, "min", minCnt)
+	}
+	return ns.acceptJoinRequest(ctx, up, matched, &rejoinRequest{
+		rejoinType: pld.RejoinType,
+		rejoinCnt:  pld.RejoinCnt,
+	}, &queuedEvents)
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
@@ -18,9 +18,15 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -118,3 +124,159 @@ func TestAppendRecentUplink(t *testing.T) {
 		})
 	}
 }
+
+type acceptingUplinkDeduplicator struct{}
+
+func (acceptingUplinkDeduplicator) DeduplicateUplink(
+	context.Context, *ttnpb.UplinkMessage, time.Duration, int, uint64,
+) (bool, error) {
+	return true, nil
+}
+
+func (acceptingUplinkDeduplicator) AccumulatedMetadata(
+	context.Context, *ttnpb.UplinkMessage, uint64,
+) ([]*ttnpb.RxMetadata, error) {
+	return nil, nil
+}
+
+func TestHandleRejoinRequest(t *testing.T) {
+	t.Parallel()
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	sNwkSIntKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	otherKey := types.AES128Key{0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43}
+
+	makeUplink := func(rejoinType ttnpb.RejoinRequestType, netID types.NetID, cnt uint16) *ttnpb.UplinkMessage {
+		raw := []byte{
+			/* MHDR */
+			0xc0,
+			/** RejoinType **/
+			byte(rejoinType),
+			/** NetID **/
+			netID[2], netID[1], netID[0],
+			/** DevEUI **/
+			devEUI[7], devEUI[6], devEUI[5], devEUI[4], devEUI[3], devEUI[2], devEUI[1], devEUI[0],
+			/** RJcount0 **/
+			byte(cnt), byte(cnt >> 8),
+		}
+		var mic [4]byte
+		if rejoinType != ttnpb.RejoinRequestType_SESSION {
+			mic = test.Must(crypto.ComputeRejoinRequestMIC(sNwkSIntKey, raw))
+		}
+		raw = append(raw, mic[:]...)
+		return &ttnpb.UplinkMessage{
+			RawPayload: raw,
+			Payload: &ttnpb.Message{
+				MHdr: &ttnpb.MHDR{MType: ttnpb.MType_REJOIN_REQUEST, Major: ttnpb.Major_LORAWAN_R1},
+				Mic:  mic[:],
+				Payload: &ttnpb.Message_RejoinRequestPayload{
+					RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+						RejoinType: rejoinType,
+						NetId:      netID.Bytes(),
+						DevEui:     devEUI.Bytes(),
+						RejoinCnt:  uint32(cnt),
+					},
+				},
+			},
+		}
+	}
+	makeDevice := func(key types.AES128Key, minRjCount0 uint32) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			Ids: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       "test-dev",
+				JoinEui:        types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}.Bytes(),
+				DevEui:         devEUI.Bytes(),
+			},
+			LorawanVersion: ttnpb.MACVersion_MAC_V1_1,
+			SupportsJoin:   true,
+			Session: &ttnpb.Session{
+				Keys: &ttnpb.SessionKeys{
+					SNwkSIntKey: &ttnpb.KeyEnvelope{Key: key.Bytes()},
+				},
+			},
+			MacState: &ttnpb.MACState{
+				MinRjCount_0: minRjCount0,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Uplink         *ttnpb.UplinkMessage
+		Devices        []*ttnpb.EndDevice
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "Type 1",
+			Uplink:         makeUplink(ttnpb.RejoinRequestType_SESSION, netID, 0),
+			ErrorAssertion: errors.IsUnimplemented,
+		},
+		{
+			Name:   "NetID mismatch",
+			Uplink: makeUplink(ttnpb.RejoinRequestType_KEYS, types.NetID{0x00, 0x00, 0x42}, 0),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errRejoinRequestNetID)
+			},
+		},
+		{
+			Name:    "MIC mismatch",
+			Uplink:  makeUplink(ttnpb.RejoinRequestType_KEYS, netID, 0),
+			Devices: []*ttnpb.EndDevice{makeDevice(otherKey, 0)},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errDeviceNotFound)
+			},
+		},
+		{
+			Name:   "LoRaWAN 1.0.3",
+			Uplink: makeUplink(ttnpb.RejoinRequestType_KEYS, netID, 0),
+			Devices: []*ttnpb.EndDevice{func() *ttnpb.EndDevice {
+				dev := makeDevice(sNwkSIntKey, 0)
+				dev.LorawanVersion = ttnpb.MACVersion_MAC_V1_0_3
+				return dev
+			}()},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errDeviceNotFound)
+			},
+		},
+		{
+			Name:    "Replay",
+			Uplink:  makeUplink(ttnpb.RejoinRequestType_CONTEXT, netID, 3),
+			Devices: []*ttnpb.EndDevice{makeDevice(otherKey, 0), makeDevice(sNwkSIntKey, 4)},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errRejoinRequestCount)
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				ns := &NetworkServer{
+					Component:          componenttest.NewComponent(t, &component.Config{}),
+					netID:              func(context.Context) types.NetID { return netID },
+					uplinkDeduplicator: acceptingUplinkDeduplicator{},
+					devices: MockDeviceRegistry{
+						RangeByDevEUIFunc: func(
+							ctx context.Context, eui types.EUI64, _ []string, f func(context.Context, *ttnpb.EndDevice) bool,
+						) error {
+							a.So(eui, should.Equal, devEUI)
+							for _, dev := range tc.Devices {
+								if !f(ctx, ttnpb.Clone(dev)) {
+									break
+								}
+							}
+							return nil
+						},
+					},
+				}
+				err := ns.handleRejoinRequest(ctx, tc.Uplink)
+				if !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			},
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+type nsEndDeviceLinkStats struct {
+	ttnpb.UnimplementedNsEndDeviceLinkStatsServer
+
+	devices DeviceRegistry
+	stats   LinkStatsRegistry
+}
+
+// GetLinkStats implements ttnpb.NsEndDeviceLinkStatsServer.
+func (s *nsEndDeviceLinkStats) GetLinkStats(
+	ctx context.Context, req *ttnpb.GetEndDeviceLinkStatsRequest,
+) (*ttnpb.EndDeviceLinkStats, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	if _, _, err := s.devices.GetByID(ctx, req.EndDeviceIds.ApplicationIds, req.EndDeviceIds.DeviceId, []string{
+		"ids",
+	}); err != nil {
+		return nil, err
+	}
+	var since time.Time
+	if req.Since != nil {
+		since = LinkStatsBucketStart(*ttnpb.StdTime(req.Since), req.Granularity)
+	}
+	buckets, err := s.stats.Range(ctx, req.EndDeviceIds, req.Granularity, since)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.EndDeviceLinkStats{
+		EndDeviceIds: req.EndDeviceIds,
+		Granularity:  req.Granularity,
+		Buckets:      buckets,
+		Total:        mergeLinkStatsBuckets(buckets...),
+	}, nil
+}
@@ -0,0 +1,454 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	errMulticastGroupAlreadyExists = errors.DefineAlreadyExists(
+		"multicast_group_already_exists", "multicast group already exists",
+	)
+	errMulticastGroupNotFound = errors.DefineNotFound("multicast_group_not_found", "multicast group not found")
+	errNotMulticastDevice     = errors.DefineFailedPrecondition(
+		"not_multicast_device", "end device `{device_id}` is not a multicast end device",
+	)
+	errMulticastMemberNotFound = errors.DefineNotFound(
+		"multicast_member_not_found", "member end device `{device_id}` not found",
+	)
+	errMulticastMember = errors.DefineInvalidArgument(
+		"multicast_member", "multicast end device `{device_id}` cannot be a member of a multicast group",
+	)
+	errTooManyMulticastMembers = errors.DefineInvalidArgument(
+		"too_many_multicast_members", "multicast group has more than `{max}` members",
+	)
+	errMulticastEndDeviceIdentifiers = errors.DefineInvalidArgument(
+		"multicast_end_device_identifiers", "end device identifiers do not match the multicast group",
+	)
+	errMulticastDeviceClass = errors.DefineInvalidArgument(
+		"multicast_device_class", "multicast end device `{device_id}` does not support class B or class C",
+	)
+	errMulticastJoinServerDevice = errors.DefineFailedPrecondition(
+		"multicast_join_server_device", "multicast end device `{device_id}` is registered in the Join Server",
+	)
+	errMulticastApplicationServerSession = errors.DefineFailedPrecondition(
+		"multicast_application_server_session",
+		"session of multicast end device `{device_id}` in the Application Server does not match the Network Server",
+	)
+)
+
+// maxMulticastGroupMembers is the maximum number of members of a multicast group.
+const maxMulticastGroupMembers = 1000
+
+type peerConnFunc func(ctx context.Context, role ttnpb.ClusterRole) (*grpc.ClientConn, grpc.CallOption, error)
+
+// forwardedAuthPeerConn returns the connection to the cluster peer with the given role and the call option that
+// forwards the credentials of the caller.
+func (ns *NetworkServer) forwardedAuthPeerConn(
+	ctx context.Context, role ttnpb.ClusterRole,
+) (*grpc.ClientConn, grpc.CallOption, error) {
+	cc, err := ns.GetPeerConn(ctx, role, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, ns.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, nil, err
+	}
+	return cc, callOpt, nil
+}
+
+type nsMulticastGroupRegistry struct {
+	ttnpb.UnimplementedNsMulticastGroupRegistryServer
+
+	devices    DeviceRegistry
+	groups     MulticastGroupRegistry
+	newDevAddr newDevAddrFunc
+	// setDevice and deleteDevice set and delete end devices in the Network Server, with the checks of the end device
+	// registry.
+	setDevice    func(context.Context, *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error)
+	deleteDevice func(context.Context, *ttnpb.EndDeviceIdentifiers) (*emptypb.Empty, error)
+	peerConn     peerConnFunc
+}
+
+// checkJoinServerDevice checks that the multicast end device identified by ids is not registered in the Join Server
+// of the cluster, if any.
+func (s *nsMulticastGroupRegistry) checkJoinServerDevice(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	cc, callOpt, err := s.peerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER)
+	if err != nil {
+		if errors.IsUnavailable(err) {
+			return nil
+		}
+		return err
+	}
+	_, err = ttnpb.NewJsEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask("ids"),
+	}, callOpt)
+	switch {
+	case err == nil:
+		return errMulticastJoinServerDevice.WithAttributes("device_id", ids.DeviceId)
+	case errors.IsNotFound(err):
+		return nil
+	default:
+		return err
+	}
+}
+
+// checkMulticastDevice checks that the end device identified by ids exists and is a multicast end device, that it is
+// not registered in the Join Server and that its session in the Application Server matches the Network Server.
+func (s *nsMulticastGroupRegistry) checkMulticastDevice(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	dev, _, err := s.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{
+		"multicast",
+		"session.dev_addr",
+	})
+	if err != nil {
+		return err
+	}
+	if !dev.Multicast {
+		return errNotMulticastDevice.WithAttributes("device_id", ids.DeviceId)
+	}
+	if err := s.checkJoinServerDevice(ctx, ids); err != nil {
+		return err
+	}
+	cc, callOpt, err := s.peerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER)
+	if err != nil {
+		return err
+	}
+	asDev, err := ttnpb.NewAsEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask("session.dev_addr"),
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(asDev.GetSession().GetDevAddr(), dev.GetSession().GetDevAddr()) {
+		return errMulticastApplicationServerSession.WithAttributes("device_id", ids.DeviceId)
+	}
+	return nil
+}
+
+// createMulticastDevice registers the multicast end device that represents the multicast group identified by ids in
+// the Network Server and Application Server. The end device must not exist in the Network Server.
+// It returns a function that deletes the registered end device.
+func (s *nsMulticastGroupRegistry) createMulticastDevice(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice,
+) (func(), error) {
+	dev, nsPaths, asPaths, err := newMulticastEndDevice(ctx, ids, dev, s.newDevAddr)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := s.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{"ids"}); err == nil {
+		return nil, errDeviceExists.New()
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err := s.checkJoinServerDevice(ctx, ids); err != nil {
+		return nil, err
+	}
+	cc, callOpt, err := s.peerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.setDevice(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: dev,
+		FieldMask: ttnpb.FieldMask(nsPaths...),
+	}); err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx)
+	// The end device is deleted also if the request is canceled.
+	rollbackCtx := context.WithoutCancel(ctx)
+	deleteNSDevice := func() {
+		if _, err := s.deleteDevice(rollbackCtx, ids); err != nil {
+			logger.WithError(err).Warn("Failed to delete multicast end device from Network Server")
+		}
+	}
+	client := ttnpb.NewAsEndDeviceRegistryClient(cc)
+	if _, err := client.Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: dev,
+		FieldMask: ttnpb.FieldMask(asPaths...),
+	}, callOpt); err != nil {
+		deleteNSDevice()
+		return nil, err
+	}
+	return func() {
+		if _, err := client.Delete(rollbackCtx, ids, callOpt); err != nil {
+			logger.WithError(err).Warn("Failed to delete multicast end device from Application Server")
+		}
+		deleteNSDevice()
+	}, nil
+}
+
+// checkMembers checks that the given end devices exist and are not multicast end devices.
+func (s *nsMulticastGroupRegistry) checkMembers(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, deviceIDs []string,
+) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+	devs, err := s.devices.BatchGetByID(ctx, appIDs, deviceIDs, []string{"multicast"})
+	if err != nil {
+		return err
+	}
+	for i, dev := range devs {
+		switch {
+		case dev == nil:
+			return errMulticastMemberNotFound.WithAttributes("device_id", deviceIDs[i])
+		case dev.Multicast:
+			return errMulticastMember.WithAttributes("device_id", deviceIDs[i])
+		}
+	}
+	return nil
+}
+
+// CreateMulticastGroup implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) CreateMulticastGroup(
+	ctx context.Context, req *ttnpb.CreateMulticastGroupRequest,
+) (*ttnpb.MulticastGroup, error) {
+	ids := req.Group.Ids
+	requiredRights := []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE}
+	if req.EndDevice != nil {
+		requiredRights = append(requiredRights, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS)
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, requiredRights...); err != nil {
+		return nil, err
+	}
+	if err := s.checkMembers(ctx, ids.ApplicationIds, req.Group.MemberDeviceIds); err != nil {
+		return nil, err
+	}
+	if _, err := s.groups.Get(ctx, ids); err == nil {
+		return nil, errMulticastGroupAlreadyExists.New()
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	rollback := func() {}
+	if req.EndDevice != nil {
+		var err error
+		if rollback, err = s.createMulticastDevice(ctx, ids, req.EndDevice); err != nil {
+			return nil, err
+		}
+	} else if err := s.checkMulticastDevice(ctx, ids); err != nil {
+		return nil, err
+	}
+	group, err := s.groups.Set(ctx, ids, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		if stored != nil {
+			return nil, errMulticastGroupAlreadyExists.New()
+		}
+		return &ttnpb.MulticastGroup{
+			Ids:             ids,
+			MemberDeviceIds: req.Group.MemberDeviceIds,
+			Gateways:        req.Group.Gateways,
+		}, nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to create multicast group")
+		rollback()
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetMulticastGroup implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) GetMulticastGroup(
+	ctx context.Context, req *ttnpb.GetMulticastGroupRequest,
+) (*ttnpb.MulticastGroup, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	group, err := s.groups.Get(ctx, req.EndDeviceIds)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get multicast group")
+		return nil, err
+	}
+	return group, nil
+}
+
+// ListMulticastGroups implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) ListMulticastGroups(
+	ctx context.Context, req *ttnpb.ListMulticastGroupsRequest,
+) (*ttnpb.MulticastGroups, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	groups, err := s.groups.List(ctx, req.ApplicationIds)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to list multicast groups")
+		return nil, err
+	}
+	return &ttnpb.MulticastGroups{Groups: groups}, nil
+}
+
+// UpdateMulticastGroupMembers implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) UpdateMulticastGroupMembers(
+	ctx context.Context, req *ttnpb.UpdateMulticastGroupMembersRequest,
+) (*ttnpb.MulticastGroup, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if err := s.checkMembers(ctx, req.EndDeviceIds.ApplicationIds, req.AddDeviceIds); err != nil {
+		return nil, err
+	}
+	remove := make(map[string]struct{}, len(req.RemoveDeviceIds))
+	for _, devID := range req.RemoveDeviceIds {
+		remove[devID] = struct{}{}
+	}
+	group, err := s.groups.Set(ctx, req.EndDeviceIds, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		if stored == nil {
+			return nil, errMulticastGroupNotFound.New()
+		}
+		members := make([]string, 0, len(stored.MemberDeviceIds)+len(req.AddDeviceIds))
+		seen := make(map[string]struct{}, cap(members))
+		for _, devIDs := range [][]string{stored.MemberDeviceIds, req.AddDeviceIds} {
+			for _, devID := range devIDs {
+				if _, ok := remove[devID]; ok {
+					continue
+				}
+				if _, ok := seen[devID]; ok {
+					continue
+				}
+				seen[devID] = struct{}{}
+				members = append(members, devID)
+			}
+		}
+		if len(members) > maxMulticastGroupMembers {
+			return nil, errTooManyMulticastMembers.WithAttributes("max", maxMulticastGroupMembers)
+		}
+		stored.MemberDeviceIds = members
+		return stored, nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to update multicast group members")
+		return nil, err
+	}
+	return group, nil
+}
+
+// SetMulticastGroupGateways implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) SetMulticastGroupGateways(
+	ctx context.Context, req *ttnpb.SetMulticastGroupGatewaysRequest,
+) (*ttnpb.MulticastGroup, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	group, err := s.groups.Set(ctx, req.EndDeviceIds, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		if stored == nil {
+			return nil, errMulticastGroupNotFound.New()
+		}
+		stored.Gateways = req.Gateways
+		return stored, nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to set multicast group gateways")
+		return nil, err
+	}
+	return group, nil
+}
+
+// ComputeMulticastGroupGateways implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) ComputeMulticastGroupGateways(
+	ctx context.Context, req *ttnpb.ComputeMulticastGroupGatewaysRequest,
+) (*ttnpb.ComputeMulticastGroupGatewaysResponse, error) {
+	requiredRights := []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ}
+	if req.Apply {
+		requiredRights = append(requiredRights, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE)
+	}
+	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIds, requiredRights...); err != nil {
+		return nil, err
+	}
+	group, err := s.groups.Get(ctx, req.EndDeviceIds)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get multicast group")
+		return nil, err
+	}
+	var members []*ttnpb.EndDevice
+	if len(group.MemberDeviceIds) > 0 {
+		devs, err := s.devices.BatchGetByID(
+			ctx, req.EndDeviceIds.ApplicationIds, group.MemberDeviceIds, []string{"ids", "mac_state.recent_uplinks"},
+		)
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to get multicast group members")
+			return nil, err
+		}
+		members = make([]*ttnpb.EndDevice, len(devs))
+		for i, dev := range devs {
+			if dev == nil {
+				dev = &ttnpb.EndDevice{
+					Ids: &ttnpb.EndDeviceIdentifiers{
+						ApplicationIds: req.EndDeviceIds.ApplicationIds,
+						DeviceId:       group.MemberDeviceIds[i],
+					},
+				}
+			}
+			members[i] = dev
+		}
+	}
+	gtws, uncovered := multicastGroupCoverage(members, maxMulticastGroupGateways)
+	if req.Apply {
+		if _, err := s.groups.Set(ctx, req.EndDeviceIds, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+			if stored == nil {
+				return nil, errMulticastGroupNotFound.New()
+			}
+			stored.Gateways = gtws
+			return stored, nil
+		}); err != nil {
+			logRegistryRPCError(ctx, err, "Failed to set multicast group gateways")
+			return nil, err
+		}
+	}
+	return &ttnpb.ComputeMulticastGroupGatewaysResponse{
+		Gateways:           gtws,
+		UncoveredDeviceIds: uncovered,
+	}, nil
+}
+
+// DeleteMulticastGroup implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistry) DeleteMulticastGroup(
+	ctx context.Context, req *ttnpb.EndDeviceIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if _, err := s.groups.Set(ctx, req, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		if stored == nil {
+			return nil, errMulticastGroupNotFound.New()
+		}
+		return nil, nil
+	}); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to delete multicast group")
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,275 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	errRekeyCampaignAlreadyExists = errors.DefineAlreadyExists(
+		"rekey_campaign_already_exists", "rekey campaign `{campaign_id}` already exists",
+	)
+	errRekeyCampaignNotFound = errors.DefineNotFound(
+		"rekey_campaign_not_found", "rekey campaign `{campaign_id}` not found",
+	)
+	errRekeyCampaignNoDevices = errors.DefineFailedPrecondition(
+		"rekey_campaign_no_devices", "no end devices selected",
+	)
+	errTooManyRekeyCampaignDevices = errors.DefineInvalidArgument(
+		"too_many_rekey_campaign_devices", "more than `{max}` end devices selected",
+	)
+)
+
+// listEndDevicesLimit is the number of end devices that are listed from the Entity Registry per page.
+const listEndDevicesLimit = 1000
+
+type listEndDevicesFunc func(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string, limit, page uint32,
+) ([]*ttnpb.EndDevice, error)
+
+// listEndDevices lists the end devices of the application identified by ids from the Entity Registry,
+// using the credentials of the caller.
+func (ns *NetworkServer) listEndDevices(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string, limit, page uint32,
+) ([]*ttnpb.EndDevice, error) {
+	cc, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, ns.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	devs, err := ttnpb.NewEndDeviceRegistryClient(cc).List(ctx, &ttnpb.ListEndDevicesRequest{
+		ApplicationIds: ids,
+		FieldMask:      &fieldmaskpb.FieldMask{Paths: paths},
+		Limit:          limit,
+		Page:           page,
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	return devs.EndDevices, nil
+}
+
+type nsRekeyCampaigns struct {
+	ttnpb.UnimplementedNsRekeyCampaignsServer
+
+	devices        DeviceRegistry
+	campaigns      RekeyCampaignRegistry
+	listEndDevices listEndDevicesFunc
+}
+
+// selectDeviceIDs returns the IDs of the end devices selected by the device IDs and attributes of the selector.
+func (s *nsRekeyCampaigns) selectDeviceIDs(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, sel *ttnpb.RekeyCampaign_Selector,
+) ([]string, error) {
+	if len(sel.Attributes) == 0 && len(sel.DeviceIds) > 0 {
+		return sel.DeviceIds, nil
+	}
+	var selected map[string]struct{}
+	if len(sel.DeviceIds) > 0 {
+		selected = make(map[string]struct{}, len(sel.DeviceIds))
+		for _, devID := range sel.DeviceIds {
+			selected[devID] = struct{}{}
+		}
+	}
+	var deviceIDs []string
+	for page := uint32(1); ; page++ {
+		devs, err := s.listEndDevices(ctx, ids, []string{"attributes"}, listEndDevicesLimit, page)
+		if err != nil {
+			return nil, err
+		}
+	outer:
+		for _, dev := range devs {
+			if selected != nil {
+				if _, ok := selected[dev.Ids.DeviceId]; !ok {
+					continue
+				}
+			}
+			for k, v := range sel.Attributes {
+				if dev.Attributes[k] != v {
+					continue outer
+				}
+			}
+			deviceIDs = append(deviceIDs, dev.Ids.DeviceId)
+		}
+		if len(devs) < listEndDevicesLimit {
+			return deviceIDs, nil
+		}
+	}
+}
+
+// CreateRekeyCampaign implements ttnpb.NsRekeyCampaignsServer.
+func (s *nsRekeyCampaigns) CreateRekeyCampaign(
+	ctx context.Context, req *ttnpb.CreateRekeyCampaignRequest,
+) (*ttnpb.RekeyCampaign, error) {
+	ids := req.Campaign.ApplicationIds
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	deviceIDs, err := s.selectDeviceIDs(ctx, ids, req.Campaign.Selector)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to select end devices")
+		return nil, err
+	}
+	var devs []*ttnpb.RekeyCampaignDevice
+	for len(deviceIDs) > 0 {
+		n := len(deviceIDs)
+		if n > rekeyCampaignBatchSize {
+			n = rekeyCampaignBatchSize
+		}
+		batch, err := s.devices.BatchGetByID(ctx, ids, deviceIDs[:n], rekeyCampaignDevicePaths)
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to get end devices")
+			return nil, err
+		}
+		for _, dev := range batch {
+			if dev == nil {
+				continue
+			}
+			if d, ok := newRekeyCampaignDevice(req.Campaign, dev); ok {
+				devs = append(devs, d)
+			}
+		}
+		deviceIDs = deviceIDs[n:]
+	}
+	switch {
+	case len(devs) == 0:
+		return nil, errRekeyCampaignNoDevices.New()
+	case len(devs) > maxRekeyCampaignDevices:
+		return nil, errTooManyRekeyCampaignDevices.WithAttributes("max", maxRekeyCampaignDevices)
+	}
+	campaignID := req.Campaign.CampaignId
+	c, err := s.campaigns.Set(ctx, ids, campaignID, func(stored *ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+		if stored != nil {
+			return nil, errRekeyCampaignAlreadyExists.WithAttributes("campaign_id", campaignID)
+		}
+		return &ttnpb.RekeyCampaign{
+			ApplicationIds:   ids,
+			CampaignId:       campaignID,
+			Selector:         req.Campaign.Selector,
+			DevicesPerMinute: req.Campaign.DevicesPerMinute,
+			RejoinTimeout:    req.Campaign.RejoinTimeout,
+			ForceRejoinReq:   req.Campaign.ForceRejoinReq,
+			AppCommand:       req.Campaign.AppCommand,
+			State:            ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING,
+			Summary:          rekeyCampaignSummary(devs...),
+			Devices:          devs,
+		}, nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to create rekey campaign")
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetRekeyCampaign implements ttnpb.NsRekeyCampaignsServer.
+func (s *nsRekeyCampaigns) GetRekeyCampaign(
+	ctx context.Context, req *ttnpb.GetRekeyCampaignRequest,
+) (*ttnpb.RekeyCampaign, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	c, err := s.campaigns.Get(ctx, req.ApplicationIds, req.CampaignId)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get rekey campaign")
+		return nil, err
+	}
+	c.Devices, err = s.campaigns.Devices(ctx, req.ApplicationIds, req.CampaignId)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get rekey campaign end devices")
+		return nil, err
+	}
+	return c, nil
+}
+
+// ListRekeyCampaigns implements ttnpb.NsRekeyCampaignsServer.
+func (s *nsRekeyCampaigns) ListRekeyCampaigns(
+	ctx context.Context, req *ttnpb.ListRekeyCampaignsRequest,
+) (*ttnpb.RekeyCampaigns, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	cs, err := s.campaigns.List(ctx, req.ApplicationIds)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to list rekey campaigns")
+		return nil, err
+	}
+	return &ttnpb.RekeyCampaigns{Campaigns: cs}, nil
+}
+
+// CancelRekeyCampaign implements ttnpb.NsRekeyCampaignsServer.
+func (s *nsRekeyCampaigns) CancelRekeyCampaign(
+	ctx context.Context, req *ttnpb.GetRekeyCampaignRequest,
+) (*ttnpb.RekeyCampaign, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	c, err := s.campaigns.Set(ctx, req.ApplicationIds, req.CampaignId,
+		func(stored *ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+			if stored == nil {
+				return nil, errRekeyCampaignNotFound.WithAttributes("campaign_id", req.CampaignId)
+			}
+			if stored.State == ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING {
+				stored.State = ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_CANCELLED
+			}
+			return stored, nil
+		},
+	)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to cancel rekey campaign")
+		return nil, err
+	}
+	return c, nil
+}
+
+// DeleteRekeyCampaign implements ttnpb.NsRekeyCampaignsServer.
+func (s *nsRekeyCampaigns) DeleteRekeyCampaign(
+	ctx context.Context, req *ttnpb.GetRekeyCampaignRequest,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if _, err := s.campaigns.Set(ctx, req.ApplicationIds, req.CampaignId,
+		func(stored *ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+			if stored == nil {
+				return nil, errRekeyCampaignNotFound.WithAttributes("campaign_id", req.CampaignId)
+			}
+			return nil, nil
+		},
+	); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to delete rekey campaign")
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type nsSecurityMonitor struct {
+	ttnpb.UnimplementedNsSecurityMonitorServer
+
+	conf    SecurityMonitorConfig
+	monitor *securityMonitor
+}
+
+// GetSecurityMonitorSettings implements ttnpb.NsSecurityMonitorServer.
+func (s *nsSecurityMonitor) GetSecurityMonitorSettings(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.SecurityMonitorSettings, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_INFO); err != nil {
+		return nil, err
+	}
+	settings, err := s.conf.Settings.Get(ctx, ids)
+	if err != nil && !errors.IsNotFound(err) {
+		logRegistryRPCError(ctx, err, "Failed to get security monitor settings from registry")
+		return nil, err
+	}
+	return defaultSecurityMonitorSettings(s.conf, ids, settings), nil
+}
+
+// SetSecurityMonitorSettings implements ttnpb.NsSecurityMonitorServer.
+func (s *nsSecurityMonitor) SetSecurityMonitorSettings(
+	ctx context.Context, req *ttnpb.SetSecurityMonitorSettingsRequest,
+) (*ttnpb.SecurityMonitorSettings, error) {
+	ids := req.Settings.ApplicationIds
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	paths := req.FieldMask.GetPaths()
+	if len(paths) == 0 {
+		paths = ttnpb.SecurityMonitorSettingsFieldPathsTopLevel
+	}
+	paths = ttnpb.ExcludeFields(paths, "application_ids", "created_at", "updated_at")
+	settings, err := s.conf.Settings.Set(ctx, ids,
+		func(stored *ttnpb.SecurityMonitorSettings) (*ttnpb.SecurityMonitorSettings, error) {
+			if stored == nil {
+				stored = &ttnpb.SecurityMonitorSettings{ApplicationIds: ids}
+			}
+			if err := stored.SetFields(req.Settings, paths...); err != nil {
+				return nil, err
+			}
+			return stored, nil
+		},
+	)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to set security monitor settings in registry")
+		return nil, err
+	}
+	s.monitor.settings.Remove(unique.ID(ctx, ids))
+	return defaultSecurityMonitorSettings(s.conf, ids, settings), nil
+}
+
+// DeleteSecurityMonitorSettings implements ttnpb.NsSecurityMonitorServer.
+func (s *nsSecurityMonitor) DeleteSecurityMonitorSettings(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	if _, err := s.conf.Settings.Set(ctx, ids,
+		func(*ttnpb.SecurityMonitorSettings) (*ttnpb.SecurityMonitorSettings, error) {
+			return nil, nil
+		},
+	); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to delete security monitor settings from registry")
+		return nil, err
+	}
+	s.monitor.settings.Remove(unique.ID(ctx, ids))
+	return ttnpb.Empty, nil
+}
@@ -130,6 +130,22 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 		}
 		return !a.Failed()
 	}
+	rangeByDevEUI := func(ctx context.Context, pb *ttnpb.EndDevice) ([]*ttnpb.EndDevice, error) {
+		_, a := test.MustNewTFromContext(ctx)
+
+		var devs []*ttnpb.EndDevice
+		err := reg.RangeByDevEUI(
+			ctx,
+			types.MustEUI64(pb.Ids.DevEui).OrZero(),
+			ttnpb.EndDeviceFieldPathsTopLevel,
+			func(storedCtx context.Context, dev *ttnpb.EndDevice) bool {
+				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
+				devs = append(devs, dev)
+				return true
+			},
+		)
+		return devs, err
+	}
 	assertNoDevice := func(ctx context.Context, pb *ttnpb.EndDevice) bool {
 		t, a := test.MustNewTFromContext(ctx)
 		t.Helper()
@@ -176,6 +192,15 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 			return false
 		}
 
+		devs, err := rangeByDevEUI(ctx, pb)
+		if !test.AllTrue(
+			a.So(err, should.BeNil) || a.So(errors.Stack(err), should.BeEmpty),
+			a.So(devs, should.BeEmpty),
+		) {
+			t.Error("RangeByDevEUI assertion failed with empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.Ids.ApplicationIds, pb.Ids.DeviceId, ttnpb.EndDeviceFieldPathsTopLevel,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
@@ -259,6 +284,15 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 		}
 		ctx = storedCtx
 
+		devs, err := rangeByDevEUI(ctx, pb)
+		if !test.AllTrue(
+			a.So(err, should.BeNil) || a.So(errors.Stack(err), should.BeEmpty),
+			a.So(devs, should.Resemble, []*ttnpb.EndDevice{pb}),
+		) {
+			t.Error("RangeByDevEUI assertion failed with non-empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.Ids.ApplicationIds, pb.Ids.DeviceId, fields,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
@@ -18,6 +18,7 @@ package internal
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -60,6 +61,15 @@ var LoRaWANBands = func() map[string]map[ttnpb.PHYVersion]*band.Band {
 	return bands
 }()
 
+type customBandKey struct {
+	ID         string
+	PHYVersion ttnpb.PHYVersion
+}
+
+// customBands caches the custom bands resolved by FrequencyPlanAndBand by customBandKey.
+// Custom bands are registered on startup, after LoRaWANBands is initialized, and are not replaced afterwards.
+var customBands sync.Map
+
 var errNoBandVersion = errors.DefineInvalidArgument("no_band_version", "specified version `{ver}` of band `{id}` does not exist")
 
 func FrequencyPlanAndBand(frequencyPlanID string, phyVersion ttnpb.PHYVersion, fps *frequencyplans.Store) (*frequencyplans.FrequencyPlan, *band.Band, error) {
@@ -68,13 +78,22 @@ func FrequencyPlanAndBand(frequencyPlanID string, phyVersion ttnpb.PHYVersion, f
 		return nil, nil, err
 	}
 	b, ok := LoRaWANBands[fp.BandID][phyVersion]
-	if !ok || b == nil {
+	if ok && b != nil {
+		return fp, b, nil
+	}
+	key := customBandKey{ID: fp.BandID, PHYVersion: phyVersion}
+	if v, ok := customBands.Load(key); ok {
+		return fp, v.(*band.Band), nil
+	}
+	custom, err := band.Get(fp.BandID, phyVersion)
+	if err != nil {
 		return nil, nil, errNoBandVersion.WithAttributes(
 			"ver", phyVersion,
 			"id", fp.BandID,
 		)
 	}
-	return fp, b, nil
+	v, _ := customBands.LoadOrStore(key, &custom)
+	return fp, v.(*band.Band), nil
 }
 
 func DeviceFrequencyPlanAndBand(dev *ttnpb.EndDevice, fps *frequencyplans.Store) (*frequencyplans.FrequencyPlan, *band.Band, error) {
@@ -0,0 +1,187 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// MaxLinkStatsGatewayIDs is the maximum number of gateway IDs stored in a link statistics bucket.
+const MaxLinkStatsGatewayIDs = 100
+
+// LinkStatsGranularities are the granularities of the link statistics that are recorded for each uplink message.
+var LinkStatsGranularities = [...]ttnpb.LinkStatsGranularity{
+	ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR,
+	ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_DAY,
+}
+
+// LinkStatsRegistry is a registry, containing aggregated link statistics of end devices.
+type LinkStatsRegistry interface {
+	// Range returns the buckets with the given granularity of the end device identified by ids,
+	// which start at or after since. The buckets are ordered by start time.
+	Range(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		granularity ttnpb.LinkStatsGranularity,
+		since time.Time,
+	) ([]*ttnpb.EndDeviceLinkStatsBucket, error)
+	// Add adds the sample to the buckets of all LinkStatsGranularities of the end device identified by ids,
+	// which contain receivedAt. The buckets are created if they do not exist.
+	Add(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		receivedAt time.Time,
+		sample *LinkStatsSample,
+	) error
+}
+
+// LinkStatsBucketStart returns the start time of the bucket with the given granularity that contains t.
+func LinkStatsBucketStart(t time.Time, granularity ttnpb.LinkStatsGranularity) time.Time {
+	switch granularity {
+	case ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_DAY:
+		// NOTE: Truncation is relative to the zero time, so days start at midnight UTC.
+		return t.UTC().Truncate(24 * time.Hour)
+	default:
+		return t.UTC().Truncate(time.Hour)
+	}
+}
+
+// LinkStatsSample is the link quality of a single uplink message.
+type LinkStatsSample struct {
+	// MissedUplinkCount is the number of uplink messages that were not received before this uplink message.
+	MissedUplinkCount uint32
+	// FCntReset indicates whether the frame counter was reset.
+	FCntReset  bool
+	SNR        *float32
+	RSSI       *float32
+	GatewayIDs []string
+}
+
+// newLinkStatsSample returns the link quality of the data uplink message matched by the given result.
+func newLinkStatsSample(res cmacFMatchingResult, up *ttnpb.UplinkMessage) *LinkStatsSample {
+	s := &LinkStatsSample{}
+	switch {
+	case res.IsPending:
+		// NOTE: The frame counter of a new session starts at 0.
+		s.MissedUplinkCount = res.FullFCnt
+	case res.FullFCnt > res.LastFCnt:
+		s.MissedUplinkCount = res.FullFCnt - res.LastFCnt - 1
+	case res.FullFCnt < res.LastFCnt:
+		s.FCntReset = true
+	}
+	isLoRa := up.GetSettings().GetDataRate().GetLora() != nil
+	seen := make(map[string]struct{}, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		rssi, snr := md.ChannelRssi, md.Snr
+		if s.RSSI == nil || rssi > *s.RSSI {
+			s.RSSI = &rssi
+		}
+		if isLoRa && (s.SNR == nil || snr > *s.SNR) {
+			s.SNR = &snr
+		}
+		gtwID := md.GetGatewayIds().GetGatewayId()
+		if gtwID == "" {
+			continue
+		}
+		if _, ok := seen[gtwID]; ok {
+			continue
+		}
+		seen[gtwID] = struct{}{}
+		s.GatewayIDs = append(s.GatewayIDs, gtwID)
+	}
+	return s
+}
+
+func mergeSignalStats(a, b *ttnpb.SignalStats) *ttnpb.SignalStats {
+	switch {
+	case a.GetCount() == 0:
+		return ttnpb.Clone(b)
+	case b.GetCount() == 0:
+		return ttnpb.Clone(a)
+	}
+	count := a.Count + b.Count
+	return &ttnpb.SignalStats{
+		Min:   min(a.Min, b.Min),
+		Max:   max(a.Max, b.Max),
+		Mean:  (a.Mean*float32(a.Count) + b.Mean*float32(b.Count)) / float32(count),
+		Count: count,
+	}
+}
+
+func appendLinkStatsGatewayIDs(ids []string, add ...string) []string {
+outer:
+	for _, id := range add {
+		if len(ids) >= MaxLinkStatsGatewayIDs {
+			break
+		}
+		for _, existing := range ids {
+			if existing == id {
+				continue outer
+			}
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func linkStatsPacketErrorRate(b *ttnpb.EndDeviceLinkStatsBucket) float32 {
+	total := b.UplinkCount + b.MissedUplinkCount
+	if total == 0 {
+		return 0
+	}
+	return float32(b.MissedUplinkCount) / float32(total)
+}
+
+// mergeLinkStatsBuckets returns the aggregate of the given buckets, which are ordered by start time.
+func mergeLinkStatsBuckets(bs ...*ttnpb.EndDeviceLinkStatsBucket) *ttnpb.EndDeviceLinkStatsBucket {
+	if len(bs) == 0 {
+		return nil
+	}
+	total := &ttnpb.EndDeviceLinkStatsBucket{
+		StartAt: bs[0].StartAt,
+	}
+	for _, b := range bs {
+		total.UplinkCount += b.UplinkCount
+		total.MissedUplinkCount += b.MissedUplinkCount
+		total.FCntResetCount += b.FCntResetCount
+		total.Snr = mergeSignalStats(total.Snr, b.Snr)
+		total.Rssi = mergeSignalStats(total.Rssi, b.Rssi)
+		total.GatewayCount = mergeSignalStats(total.GatewayCount, b.GatewayCount)
+		total.GatewayIds = appendLinkStatsGatewayIDs(total.GatewayIds, b.GatewayIds...)
+	}
+	total.PacketErrorRate = linkStatsPacketErrorRate(total)
+	return total
+}
+
+// recordLinkStats adds the link quality of the data uplink message to the link statistics of the end device.
+func (ns *NetworkServer) recordLinkStats(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, res cmacFMatchingResult, up *ttnpb.UplinkMessage,
+) {
+	if ns.linkStats == nil {
+		return
+	}
+	receivedAt := ttnpb.StdTime(up.ReceivedAt)
+	if receivedAt == nil {
+		now := time.Now()
+		receivedAt = &now
+	}
+	if err := ns.linkStats.Add(ctx, ids, *receivedAt, newLinkStatsSample(res, up)); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to record link statistics")
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLinkStatsBucketStart(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	at := time.Date(2024, 3, 14, 15, 9, 26, 0, time.FixedZone("CET", 3600))
+	a.So(
+		LinkStatsBucketStart(at, ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR),
+		should.Equal,
+		time.Date(2024, 3, 14, 14, 0, 0, 0, time.UTC),
+	)
+	a.So(
+		LinkStatsBucketStart(at, ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_DAY),
+		should.Equal,
+		time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC),
+	)
+}
+
+func TestNewLinkStatsSample(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	up := &ttnpb.UplinkMessage{
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000},
+				},
+			},
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}, ChannelRssi: -100, Snr: -5},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-b"}, ChannelRssi: -80, Snr: 3},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}, ChannelRssi: -90, Snr: 7},
+		},
+	}
+
+	s := newLinkStatsSample(cmacFMatchingResult{LastFCnt: 10, FullFCnt: 13}, up)
+	a.So(s.MissedUplinkCount, should.Equal, 2)
+	a.So(s.FCntReset, should.BeFalse)
+	a.So(*s.RSSI, should.Equal, float32(-80))
+	a.So(*s.SNR, should.Equal, float32(7))
+	a.So(s.GatewayIDs, should.Resemble, []string{"gtw-a", "gtw-b"})
+
+	s = newLinkStatsSample(cmacFMatchingResult{LastFCnt: 10, FullFCnt: 2}, up)
+	a.So(s.MissedUplinkCount, should.Equal, 0)
+	a.So(s.FCntReset, should.BeTrue)
+
+	s = newLinkStatsSample(cmacFMatchingResult{IsPending: true, FullFCnt: 1}, up)
+	a.So(s.MissedUplinkCount, should.Equal, 1)
+	a.So(s.FCntReset, should.BeFalse)
+}
+
+func TestMergeLinkStatsBuckets(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	startAt := time.Date(2024, 3, 14, 15, 0, 0, 0, time.UTC)
+	b := &ttnpb.EndDeviceLinkStatsBucket{
+		StartAt:           timestamppb.New(startAt),
+		UplinkCount:       2,
+		MissedUplinkCount: 2,
+		Snr:               &ttnpb.SignalStats{Min: -1, Max: 5, Mean: 2, Count: 2},
+		Rssi:              &ttnpb.SignalStats{Min: -100, Max: -80, Mean: -90, Count: 2},
+		GatewayCount:      &ttnpb.SignalStats{Min: 1, Max: 2, Mean: 1.5, Count: 2},
+		GatewayIds:        []string{"gtw-a", "gtw-b", "gtw-c"},
+		PacketErrorRate:   0.5,
+	}
+	other := &ttnpb.EndDeviceLinkStatsBucket{
+		StartAt:        timestamppb.New(startAt.Add(time.Hour)),
+		UplinkCount:    1,
+		FCntResetCount: 1,
+		Rssi:           &ttnpb.SignalStats{Min: -60, Max: -60, Mean: -60, Count: 1},
+		GatewayCount:   &ttnpb.SignalStats{Min: 1, Max: 1, Mean: 1, Count: 1},
+		GatewayIds:     []string{"gtw-b", "gtw-d"},
+	}
+	total := mergeLinkStatsBuckets(b, other)
+	a.So(total.StartAt.AsTime(), should.Equal, startAt)
+	a.So(total.UplinkCount, should.Equal, 3)
+	a.So(total.MissedUplinkCount, should.Equal, 2)
+	a.So(total.FCntResetCount, should.Equal, 1)
+	a.So(total.PacketErrorRate, should.Equal, float32(0.4))
+	a.So(total.Snr, should.Resemble, b.Snr)
+	a.So(total.Rssi, should.Resemble, &ttnpb.SignalStats{Min: -100, Max: -60, Mean: -80, Count: 3})
+	a.So(total.GatewayCount, should.Resemble, &ttnpb.SignalStats{Min: 1, Max: 2, Mean: float32(4) / 3, Count: 3})
+	a.So(total.GatewayIds, should.Resemble, []string{"gtw-a", "gtw-b", "gtw-c", "gtw-d"})
+
+	a.So(mergeLinkStatsBuckets(), should.BeNil)
+}
@@ -17,17 +17,57 @@ package mac
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
-var EvtEnqueueForceRejoinRequest = defineEnqueueMACRequestEvent("force_rejoin", "force rejoin")()
+var EvtEnqueueForceRejoinRequest = defineEnqueueMACRequestEvent(
+	"force_rejoin", "force rejoin",
+	events.WithDataType(&ttnpb.MACCommand_ForceRejoinReq{}),
+)()
 
+// DeviceNeedsForceRejoinReq returns whether a ForceRejoinReq is queued for the end device.
+func DeviceNeedsForceRejoinReq(dev *ttnpb.EndDevice) bool {
+	return !dev.GetMulticast() &&
+		dev.GetMacState().GetQueuedForceRejoinReq() != nil &&
+		macspec.UseForceRejoinReq(dev.MacState.LorawanVersion)
+}
+
+// EnqueueForceRejoinReq enqueues the ForceRejoinReq queued in the MAC state of the end device.
+// The end device does not answer the ForceRejoinReq, so the queued request is cleared once it is enqueued.
 func EnqueueForceRejoinReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
-	// TODO: Support rejoins. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
-	_ = EvtEnqueueForceRejoinRequest
-	return EnqueueState{
-		MaxDownLen: maxDownLen,
-		MaxUpLen:   maxUpLen,
-		Ok:         true,
+	if !DeviceNeedsForceRejoinReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
 	}
+
+	var st EnqueueState
+	dev.MacState.PendingRequests, st = enqueueMACCommand(ttnpb.MACCommandIdentifier_CID_FORCE_REJOIN, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 {
+			return nil, 0, nil, false
+		}
+
+		req := dev.MacState.QueuedForceRejoinReq
+		dev.MacState.QueuedForceRejoinReq = nil
+		log.FromContext(ctx).WithFields(log.Fields(
+			"rejoin_type", req.RejoinType,
+			"data_rate_index", req.DataRateIndex,
+			"max_retries", req.MaxRetries,
+			"period_exponent", req.PeriodExponent,
+		)).Debug("Enqueued ForceRejoinReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			0,
+			events.Builders{
+				EvtEnqueueForceRejoinRequest.With(events.WithData(req)),
+			},
+			true
+	}, dev.MacState.PendingRequests...)
+	return st
 }
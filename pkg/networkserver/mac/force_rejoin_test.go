@@ -0,0 +1,145 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEnqueueForceRejoinReq(t *testing.T) {
+	t.Parallel()
+	req := &ttnpb.MACCommand_ForceRejoinReq{
+		RejoinType:     ttnpb.RejoinRequestType_KEYS,
+		DataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_2,
+		MaxRetries:     3,
+		PeriodExponent: ttnpb.RejoinPeriodExponent_REJOIN_PERIOD_1,
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "no request queued",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion: ttnpb.MACVersion_MAC_V1_1,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion: ttnpb.MACVersion_MAC_V1_1,
+				},
+			},
+			MaxDownlinkLength: 42,
+			State: EnqueueState{
+				MaxDownLen: 42,
+				MaxUpLen:   24,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "LoRaWAN 1.0.3",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_0_3,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_0_3,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			MaxDownlinkLength: 42,
+			State: EnqueueState{
+				MaxDownLen: 42,
+				MaxUpLen:   24,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "payload fits",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_1,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion: ttnpb.MACVersion_MAC_V1_1,
+					PendingRequests: []*ttnpb.MACCommand{
+						req.MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 42,
+			State: EnqueueState{
+				MaxDownLen: 39,
+				MaxUpLen:   24,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueForceRejoinRequest.With(events.WithData(req)),
+				},
+			},
+		},
+		{
+			Name: "downlink does not fit",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_1,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_1,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			MaxDownlinkLength: 2,
+			State: EnqueueState{
+				MaxDownLen: 2,
+				MaxUpLen:   24,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := ttnpb.Clone(tc.InputDevice)
+
+				st := EnqueueForceRejoinReq(ctx, dev, tc.MaxDownlinkLength, 24)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtSendOperatorCommand = events.Define(
+		"ns.mac.operator.send", "operator MAC command sent",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)
+	EvtReceiveOperatorAnswer = events.Define(
+		"ns.mac.operator.answer", "operator MAC request answer received",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)
+	EvtOperatorRequestFail = events.Define(
+		"ns.mac.operator.fail", "operator MAC request not answered",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)
+)
+
+var (
+	errUnsupportedOperatorCommand = errors.DefineInvalidArgument(
+		"unsupported_operator_command", "MAC command `{cid}` is not supported",
+	)
+	errOperatorCommandVersion = errors.DefineInvalidArgument(
+		"operator_command_version", "MAC command `{cid}` is not supported by LoRaWAN version `{version}`",
+	)
+	errOperatorCommandBand = errors.DefineInvalidArgument(
+		"operator_command_band", "MAC command `{cid}` is not supported by band `{band_id}`",
+	)
+	errOperatorCommandField = errors.DefineInvalidArgument(
+		"operator_command_field", "invalid `{field}` of MAC command `{cid}`",
+	)
+)
+
+// ValidateOperatorCommand validates the MAC command enqueued by the network operator
+// against the band and the LoRaWAN version of the end device.
+func ValidateOperatorCommand(dev *ttnpb.EndDevice, phy *band.Band, cmd *ttnpb.MACCommand) error {
+	if err := cmd.ValidateFields(); err != nil {
+		return err
+	}
+	ver := dev.GetMacState().GetLorawanVersion()
+	newFieldError := func(field string) error {
+		return errOperatorCommandField.WithAttributes("field", field, "cid", cmd.Cid)
+	}
+	switch cmd.Cid {
+	case ttnpb.MACCommandIdentifier_CID_DEV_STATUS:
+		return nil
+
+	case ttnpb.MACCommandIdentifier_CID_LINK_CHECK:
+		if cmd.GetLinkCheckAns() == nil {
+			return ErrNoPayload.New()
+		}
+		return nil
+
+	case ttnpb.MACCommandIdentifier_CID_DEVICE_TIME:
+		if !macspec.UseDeviceTimeReq(ver) {
+			return errOperatorCommandVersion.WithAttributes("cid", cmd.Cid, "version", ver)
+		}
+		if cmd.GetDeviceTimeAns() == nil {
+			return ErrNoPayload.New()
+		}
+		return nil
+
+	case ttnpb.MACCommandIdentifier_CID_LINK_ADR:
+		req := cmd.GetLinkAdrReq()
+		if req == nil {
+			return ErrNoPayload.New()
+		}
+		noChange := macspec.HasNoChangeADRIndices(ver)
+		if _, ok := phy.DataRates[req.DataRateIndex]; !ok &&
+			!(noChange && req.DataRateIndex == ttnpb.DataRateIndex_DATA_RATE_15) {
+			return newFieldError("data_rate_index")
+		}
+		if req.TxPowerIndex > uint32(phy.MaxTxPowerIndex()) && !(noChange && req.TxPowerIndex == 15) {
+			return newFieldError("tx_power_index")
+		}
+		if len(req.ChannelMask) != 16 {
+			return newFieldError("channel_mask")
+		}
+		var chMask [16]bool
+		copy(chMask[:], req.ChannelMask)
+		if _, err := phy.ParseChMask(chMask, uint8(req.ChannelMaskControl)); err != nil {
+			return errOperatorCommandField.WithAttributes("field", "channel_mask", "cid", cmd.Cid).WithCause(err)
+		}
+		return nil
+
+	case ttnpb.MACCommandIdentifier_CID_NEW_CHANNEL:
+		req := cmd.GetNewChannelReq()
+		if req == nil {
+			return ErrNoPayload.New()
+		}
+		if phy.CFListType != ttnpb.CFListType_FREQUENCIES {
+			return errOperatorCommandBand.WithAttributes("cid", cmd.Cid, "band_id", phy.ID)
+		}
+		if req.ChannelIndex < uint32(len(phy.UplinkChannels)) || req.ChannelIndex >= uint32(phy.MaxUplinkChannels) {
+			return newFieldError("channel_index")
+		}
+		if req.Frequency == 0 {
+			return nil
+		}
+		if _, ok := phy.FindSubBand(req.Frequency); !ok {
+			return newFieldError("frequency")
+		}
+		if _, ok := phy.DataRates[req.MinDataRateIndex]; !ok {
+			return newFieldError("min_data_rate_index")
+		}
+		if _, ok := phy.DataRates[req.MaxDataRateIndex]; !ok || req.MaxDataRateIndex < req.MinDataRateIndex {
+			return newFieldError("max_data_rate_index")
+		}
+		return nil
+
+	default:
+		return errUnsupportedOperatorCommand.WithAttributes("cid", cmd.Cid)
+	}
+}
+
+func isOperatorRequestPending(req *ttnpb.MACCommand, cmds ...*ttnpb.MACCommand) bool {
+	for _, cmd := range cmds {
+		if cmd.Cid != req.Cid {
+			continue
+		}
+		if req.Cid != ttnpb.MACCommandIdentifier_CID_NEW_CHANNEL ||
+			cmd.GetNewChannelReq().GetChannelIndex() == req.GetNewChannelReq().GetChannelIndex() {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceNeedsOperatorCommands returns true iff the device has MAC commands queued by the network operator,
+// which can be sent in the next downlink.
+func DeviceNeedsOperatorCommands(dev *ttnpb.EndDevice) bool {
+	for _, cmd := range dev.GetMacState().GetQueuedOperatorCommands() {
+		desc, ok := lorawan.DefaultMACCommands[cmd.Cid]
+		if !ok {
+			continue
+		}
+		if desc.InitiatedByDevice || !isOperatorRequestPending(cmd, dev.MacState.PendingRequests...) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnqueueOperatorCommands enqueues the MAC commands queued by the network operator.
+// MAC requests are appended to the pending requests of the MAC state, unless a request with the same
+// CID is already pending. MAC answers are returned and should be appended to the MAC command buffer.
+func EnqueueOperatorCommands(
+	ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16,
+) ([]*ttnpb.MACCommand, EnqueueState) {
+	st := EnqueueState{
+		MaxDownLen: maxDownLen,
+		MaxUpLen:   maxUpLen,
+		Ok:         true,
+	}
+	if len(dev.MacState.QueuedOperatorCommands) == 0 {
+		return nil, st
+	}
+	var (
+		answers []*ttnpb.MACCommand
+		queued  = dev.MacState.QueuedOperatorCommands[:0:0]
+	)
+	for i, cmd := range dev.MacState.QueuedOperatorCommands {
+		desc, ok := lorawan.DefaultMACCommands[cmd.Cid]
+		if !ok {
+			log.FromContext(ctx).WithField("cid", cmd.Cid).Warn("Drop unknown operator MAC command")
+			continue
+		}
+		if 1+desc.DownlinkLength > st.MaxDownLen {
+			st.Ok = false
+			queued = append(queued, dev.MacState.QueuedOperatorCommands[i:]...)
+			break
+		}
+		if desc.InitiatedByDevice {
+			st.MaxDownLen -= 1 + desc.DownlinkLength
+			answers = append(answers, cmd)
+			st.QueuedEvents = append(st.QueuedEvents, EvtSendOperatorCommand.With(events.WithData(cmd)))
+			continue
+		}
+		if isOperatorRequestPending(cmd, dev.MacState.PendingRequests...) {
+			queued = append(queued, cmd)
+			continue
+		}
+		if 1+desc.UplinkLength > st.MaxUpLen {
+			st.Ok = false
+			queued = append(queued, dev.MacState.QueuedOperatorCommands[i:]...)
+			break
+		}
+		st.MaxDownLen -= 1 + desc.DownlinkLength
+		st.MaxUpLen -= 1 + desc.UplinkLength
+		dev.MacState.PendingRequests = append(dev.MacState.PendingRequests, cmd)
+		dev.MacState.PendingOperatorRequests = append(dev.MacState.PendingOperatorRequests, cmd)
+		st.QueuedEvents = append(st.QueuedEvents, EvtSendOperatorCommand.With(events.WithData(cmd)))
+	}
+	if len(queued) == 0 {
+		queued = nil
+	}
+	dev.MacState.QueuedOperatorCommands = queued
+	log.FromContext(ctx).WithFields(log.Fields(
+		"answer_count", len(answers),
+		"pending_request_count", len(dev.MacState.PendingOperatorRequests),
+		"queued_count", len(queued),
+	)).Debug("Enqueued operator MAC commands")
+	return answers, st
+}
+
+// HandleOperatorRequestAnswers matches the pending operator requests with the MAC answers handled in an uplink.
+// Requests that are not answered are dropped, like the MAC requests issued by the Network Server.
+func HandleOperatorRequestAnswers(
+	ctx context.Context, dev *ttnpb.EndDevice, answers ...*ttnpb.MACCommand,
+) events.Builders {
+	if len(dev.MacState.PendingOperatorRequests) == 0 {
+		return nil
+	}
+	var (
+		evs        events.Builders
+		unanswered int
+		matched    = make([]bool, len(answers))
+	)
+outer:
+	for _, req := range dev.MacState.PendingOperatorRequests {
+		for i, ans := range answers {
+			if matched[i] || ans.Cid != req.Cid {
+				continue
+			}
+			matched[i] = true
+			evs = append(evs, EvtReceiveOperatorAnswer.With(events.WithData(ans)))
+			continue outer
+		}
+		unanswered++
+		evs = append(evs, EvtOperatorRequestFail.With(events.WithData(req)))
+	}
+	if unanswered > 0 {
+		log.FromContext(ctx).WithField("unanswered_count", unanswered).Debug(
+			"Operator MAC requests not answered, drop",
+		)
+	}
+	dev.MacState.PendingOperatorRequests = nil
+	return evs
+}
@@ -0,0 +1,278 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidateOperatorCommand(t *testing.T) {
+	t.Parallel()
+	phy := &band.EU_863_870_RP1_V1_0_2_Rev_B
+	chMask := func(n int) []bool {
+		mask := make([]bool, n)
+		mask[0] = true
+		return mask
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Version   ttnpb.MACVersion
+		Command   *ttnpb.MACCommand
+		Assertion func(error) bool
+	}{
+		{
+			Name:    "DevStatusReq",
+			Command: ttnpb.MACCommandIdentifier_CID_DEV_STATUS.MACCommand(),
+		},
+		{
+			Name: "LinkCheckAns",
+			Command: (&ttnpb.MACCommand_LinkCheckAns{
+				Margin:       20,
+				GatewayCount: 2,
+			}).MACCommand(),
+		},
+		{
+			Name:      "LinkCheckAns/no payload",
+			Command:   ttnpb.MACCommandIdentifier_CID_LINK_CHECK.MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "DeviceTimeAns/1.0.2",
+			Version:   ttnpb.MACVersion_MAC_V1_0_2,
+			Command:   ttnpb.MACCommandIdentifier_CID_DEVICE_TIME.MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "LinkADRReq",
+			Command: (&ttnpb.MACCommand_LinkADRReq{
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+				TxPowerIndex:  1,
+				ChannelMask:   chMask(16),
+				NbTrans:       1,
+			}).MACCommand(),
+		},
+		{
+			Name: "LinkADRReq/invalid data rate",
+			Command: (&ttnpb.MACCommand_LinkADRReq{
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_15,
+				ChannelMask:   chMask(16),
+			}).MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:    "LinkADRReq/no change data rate",
+			Version: ttnpb.MACVersion_MAC_V1_0_4,
+			Command: (&ttnpb.MACCommand_LinkADRReq{
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_15,
+				TxPowerIndex:  15,
+				ChannelMask:   chMask(16),
+			}).MACCommand(),
+		},
+		{
+			Name: "LinkADRReq/invalid channel mask",
+			Command: (&ttnpb.MACCommand_LinkADRReq{
+				ChannelMask: chMask(8),
+			}).MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NewChannelReq",
+			Command: (&ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     3,
+				Frequency:        867100000,
+				MaxDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+			}).MACCommand(),
+		},
+		{
+			Name: "NewChannelReq/delete",
+			Command: (&ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex: 3,
+			}).MACCommand(),
+		},
+		{
+			Name: "NewChannelReq/default channel",
+			Command: (&ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     1,
+				Frequency:        867100000,
+				MaxDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+			}).MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NewChannelReq/invalid frequency",
+			Command: (&ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     3,
+				Frequency:        915000000,
+				MaxDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+			}).MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NewChannelReq/invalid data rate range",
+			Command: (&ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     3,
+				Frequency:        867100000,
+				MinDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+				MaxDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+			}).MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "RxTimingSetupReq",
+			Command:   (&ttnpb.MACCommand_RxTimingSetupReq{Delay: ttnpb.RxDelay_RX_DELAY_2}).MACCommand(),
+			Assertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				t.Helper()
+				ver := tc.Version
+				if ver == ttnpb.MACVersion_MAC_UNKNOWN {
+					ver = ttnpb.MACVersion_MAC_V1_0_3
+				}
+				dev := &ttnpb.EndDevice{
+					MacState: &ttnpb.MACState{LorawanVersion: ver},
+				}
+				err := ValidateOperatorCommand(dev, phy, tc.Command)
+				if tc.Assertion == nil {
+					a.So(err, should.BeNil)
+				} else {
+					a.So(tc.Assertion(err), should.BeTrue)
+				}
+			},
+		})
+	}
+}
+
+func TestDeviceNeedsOperatorCommands(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	newChannelReq := (&ttnpb.MACCommand_NewChannelReq{ChannelIndex: 3}).MACCommand()
+	linkCheckAns := (&ttnpb.MACCommand_LinkCheckAns{Margin: 10, GatewayCount: 1}).MACCommand()
+
+	a.So(DeviceNeedsOperatorCommands(&ttnpb.EndDevice{}), should.BeFalse)
+	a.So(DeviceNeedsOperatorCommands(&ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{},
+	}), should.BeFalse)
+	a.So(DeviceNeedsOperatorCommands(&ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{
+			QueuedOperatorCommands: []*ttnpb.MACCommand{newChannelReq},
+		},
+	}), should.BeTrue)
+	a.So(DeviceNeedsOperatorCommands(&ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{
+			PendingRequests:        []*ttnpb.MACCommand{newChannelReq},
+			QueuedOperatorCommands: []*ttnpb.MACCommand{newChannelReq},
+		},
+	}), should.BeFalse)
+	a.So(DeviceNeedsOperatorCommands(&ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{
+			PendingRequests:        []*ttnpb.MACCommand{newChannelReq},
+			QueuedOperatorCommands: []*ttnpb.MACCommand{newChannelReq, linkCheckAns},
+		},
+	}), should.BeTrue)
+}
+
+func TestEnqueueOperatorCommands(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	devStatusReq := ttnpb.MACCommandIdentifier_CID_DEV_STATUS.MACCommand()
+	linkCheckAns := (&ttnpb.MACCommand_LinkCheckAns{Margin: 10, GatewayCount: 1}).MACCommand()
+	newChannelReq := (&ttnpb.MACCommand_NewChannelReq{ChannelIndex: 3}).MACCommand()
+	pendingNewChannelReq := (&ttnpb.MACCommand_NewChannelReq{ChannelIndex: 4}).MACCommand()
+	dev := &ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{
+			PendingRequests: []*ttnpb.MACCommand{pendingNewChannelReq},
+			QueuedOperatorCommands: []*ttnpb.MACCommand{
+				devStatusReq,
+				linkCheckAns,
+				pendingNewChannelReq,
+				newChannelReq,
+			},
+		},
+	}
+
+	answers, st := EnqueueOperatorCommands(ctx, dev, 16, 16)
+	a.So(st.Ok, should.BeTrue)
+	a.So(st.MaxDownLen, should.Equal, uint16(16-1-(1+2)-(1+5)))
+	a.So(st.MaxUpLen, should.Equal, uint16(16-(1+2)-(1+1)))
+	a.So(st.QueuedEvents, should.HaveLength, 3)
+	a.So(answers, should.Resemble, []*ttnpb.MACCommand{linkCheckAns})
+	a.So(dev.MacState.PendingRequests, should.Resemble, []*ttnpb.MACCommand{
+		pendingNewChannelReq,
+		devStatusReq,
+		newChannelReq,
+	})
+	a.So(dev.MacState.PendingOperatorRequests, should.Resemble, []*ttnpb.MACCommand{
+		devStatusReq,
+		newChannelReq,
+	})
+	// The NewChannelReq for the channel with a pending request is sent in a later downlink.
+	a.So(dev.MacState.QueuedOperatorCommands, should.Resemble, []*ttnpb.MACCommand{
+		pendingNewChannelReq,
+	})
+
+	// Commands which do not fit stay queued.
+	dev.MacState.PendingRequests = nil
+	dev.MacState.PendingOperatorRequests = nil
+	dev.MacState.QueuedOperatorCommands = []*ttnpb.MACCommand{newChannelReq, devStatusReq}
+	answers, st = EnqueueOperatorCommands(ctx, dev, 4, 16)
+	a.So(st.Ok, should.BeFalse)
+	a.So(answers, should.BeEmpty)
+	a.So(dev.MacState.PendingRequests, should.BeEmpty)
+	a.So(dev.MacState.QueuedOperatorCommands, should.Resemble, []*ttnpb.MACCommand{newChannelReq, devStatusReq})
+}
+
+func TestHandleOperatorRequestAnswers(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	devStatusReq := ttnpb.MACCommandIdentifier_CID_DEV_STATUS.MACCommand()
+	newChannelReq := (&ttnpb.MACCommand_NewChannelReq{ChannelIndex: 3}).MACCommand()
+	queuedReq := (&ttnpb.MACCommand_NewChannelReq{ChannelIndex: 4}).MACCommand()
+	dev := &ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{
+			PendingOperatorRequests: []*ttnpb.MACCommand{devStatusReq, newChannelReq},
+			QueuedOperatorCommands:  []*ttnpb.MACCommand{queuedReq},
+		},
+	}
+
+	evs := HandleOperatorRequestAnswers(ctx, dev,
+		(&ttnpb.MACCommand_DevStatusAns{Battery: 42, Margin: 4}).MACCommand(),
+		ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE.MACCommand(),
+	)
+	a.So(evs, should.HaveLength, 2)
+	a.So(evs[0].Definition(), should.Equal, EvtReceiveOperatorAnswer.Definition())
+	a.So(evs[1].Definition(), should.Equal, EvtOperatorRequestFail.Definition())
+	a.So(dev.MacState.PendingOperatorRequests, should.BeNil)
+	a.So(dev.MacState.QueuedOperatorCommands, should.Resemble, []*ttnpb.MACCommand{queuedReq})
+
+	a.So(HandleOperatorRequestAnswers(ctx, dev), should.BeNil)
+}
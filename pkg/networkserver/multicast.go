@@ -0,0 +1,304 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/lora"
+)
+
+// maxMulticastGroupGateways is the maximum number of gateways of a multicast group.
+const maxMulticastGroupGateways = 100
+
+// MulticastGroupRegistry is a registry, containing multicast groups.
+type MulticastGroupRegistry interface {
+	// Get returns the multicast group of the multicast end device identified by ids.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.MulticastGroup, error)
+	// List returns the multicast groups of the application identified by ids.
+	List(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) ([]*ttnpb.MulticastGroup, error)
+	// Set creates, updates or deletes the multicast group of the multicast end device identified by ids.
+	// The stored group is nil if it does not exist. The group is deleted if f returns nil.
+	Set(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error),
+	) (*ttnpb.MulticastGroup, error)
+}
+
+// multicastGroupCoverage computes a set of gateways that covers the given end devices, based on the gateways that
+// received their recent uplink messages. Gateways are selected greedily: the gateway that covers most of the
+// uncovered end devices is selected first. Ties are broken by the best adjusted RSSI and then by gateway ID.
+// It returns the selected gateways and the IDs of the end devices that are not covered.
+func multicastGroupCoverage(
+	devs []*ttnpb.EndDevice, maxGateways int,
+) ([]*ttnpb.ClassBCGatewayIdentifiers, []string) {
+	type candidate struct {
+		ids *ttnpb.GatewayIdentifiers
+		// rssi is the best adjusted RSSI of the uplink messages of each end device received by the gateway.
+		rssi map[int]float32
+	}
+	candidates := make(map[string]*candidate)
+	uncovered := make(map[int]struct{}, len(devs))
+	for i, dev := range devs {
+		uncovered[i] = struct{}{}
+		for _, up := range dev.GetMacState().GetRecentUplinks() {
+			for _, md := range up.RxMetadata {
+				if md.GatewayIds == nil || md.PacketBroker != nil || md.Relay != nil ||
+					md.DownlinkPathConstraint == ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER {
+					continue
+				}
+				c, ok := candidates[md.GatewayIds.GatewayId]
+				if !ok {
+					c = &candidate{ids: md.GatewayIds, rssi: make(map[int]float32)}
+					candidates[md.GatewayIds.GatewayId] = c
+				}
+				rssi := md.ChannelRssi
+				if up.GetSettings().GetDataRate().GetLora() != nil {
+					rssi = lora.AdjustedRSSI(md.ChannelRssi, md.Snr)
+				}
+				if best, ok := c.rssi[i]; !ok || rssi > best {
+					c.rssi[i] = rssi
+				}
+			}
+		}
+	}
+
+	var gtws []*ttnpb.ClassBCGatewayIdentifiers
+	for len(uncovered) > 0 && len(gtws) < maxGateways {
+		var (
+			best      *candidate
+			bestCount int
+			bestRSSI  float32
+		)
+		for _, c := range candidates {
+			var (
+				count int
+				rssi  float32
+			)
+			for i, v := range c.rssi {
+				if _, ok := uncovered[i]; !ok {
+					continue
+				}
+				if count == 0 || v > rssi {
+					rssi = v
+				}
+				count++
+			}
+			switch {
+			case count == 0:
+				continue
+			case best == nil,
+				count > bestCount,
+				count == bestCount && rssi > bestRSSI,
+				count == bestCount && rssi == bestRSSI && c.ids.GatewayId < best.ids.GatewayId:
+				best, bestCount, bestRSSI = c, count, rssi
+			}
+		}
+		if best == nil {
+			break
+		}
+		for i := range best.rssi {
+			delete(uncovered, i)
+		}
+		delete(candidates, best.ids.GatewayId)
+		gtws = append(gtws, &ttnpb.ClassBCGatewayIdentifiers{
+			GatewayIds: best.ids,
+		})
+	}
+
+	uncoveredIDs := make([]string, 0, len(uncovered))
+	for i := range uncovered {
+		uncoveredIDs = append(uncoveredIDs, devs[i].GetIds().GetDeviceId())
+	}
+	sort.Strings(uncoveredIDs)
+	return gtws, uncoveredIDs
+}
+
+var (
+	setEndDeviceToNS = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.NsEndDeviceRegistry/Set"].Allowed
+	setEndDeviceToAS = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.AsEndDeviceRegistry/Set"].Allowed
+)
+
+// newMulticastEndDevice returns the multicast end device that represents the multicast group identified by ids,
+// based on dev, and the paths to set in the Network Server and Application Server.
+// The multicast address and the session keys that are not set in dev are generated.
+func newMulticastEndDevice(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice, newDevAddr newDevAddrFunc,
+) (*ttnpb.EndDevice, []string, []string, error) {
+	if dev.Ids != nil && (dev.Ids.DeviceId != ids.DeviceId ||
+		dev.Ids.GetApplicationIds().GetApplicationId() != ids.ApplicationIds.ApplicationId) {
+		return nil, nil, nil, errMulticastEndDeviceIdentifiers.New()
+	}
+	if !dev.SupportsClassB && !dev.SupportsClassC {
+		return nil, nil, nil, errMulticastDeviceClass.WithAttributes("device_id", ids.DeviceId)
+	}
+	dev = ttnpb.Clone(dev)
+	dev.Ids = ttnpb.Clone(ids)
+	dev.Multicast = true
+	dev.SupportsJoin = false
+	if dev.Session == nil {
+		dev.Session = &ttnpb.Session{}
+	}
+	if len(dev.Session.DevAddr) == 0 {
+		dev.Session.DevAddr = newDevAddr(ctx).Bytes()
+	}
+	dev.Ids.DevAddr = dev.Session.DevAddr
+	if dev.Session.Keys == nil {
+		dev.Session.Keys = &ttnpb.SessionKeys{}
+	}
+	keys := dev.Session.Keys
+	if len(keys.AppSKey.GetKey()) == 0 {
+		keys.AppSKey = &ttnpb.KeyEnvelope{Key: random.Bytes(16)}
+	}
+	if len(keys.FNwkSIntKey.GetKey()) == 0 {
+		keys.FNwkSIntKey = &ttnpb.KeyEnvelope{Key: random.Bytes(16)}
+	}
+	paths := []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"multicast",
+		"session.dev_addr",
+		"session.keys.app_s_key.key",
+		"session.keys.f_nwk_s_int_key.key",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	}
+	if macspec.UseNwkKey(dev.LorawanVersion) {
+		// Multicast end devices use the same network session key for integrity and encryption.
+		if len(keys.SNwkSIntKey.GetKey()) == 0 {
+			keys.SNwkSIntKey = &ttnpb.KeyEnvelope{Key: keys.FNwkSIntKey.Key}
+		}
+		if len(keys.NwkSEncKey.GetKey()) == 0 {
+			keys.NwkSEncKey = &ttnpb.KeyEnvelope{Key: keys.FNwkSIntKey.Key}
+		}
+		paths = append(paths,
+			"session.keys.nwk_s_enc_key.key",
+			"session.keys.s_nwk_s_int_key.key",
+		)
+	}
+	if dev.MacSettings != nil {
+		paths = append(paths, "mac_settings")
+	}
+	return dev, ttnpb.AllowedFields(paths, setEndDeviceToNS), ttnpb.AllowedFields(paths, setEndDeviceToAS), nil
+}
+
+// setMulticastGroupDownlinkGateways sets the gateways of the multicast group of the multicast end device dev
+// on the application downlinks that do not specify class B/C gateways.
+func (ns *NetworkServer) setMulticastGroupDownlinkGateways(
+	ctx context.Context, dev *ttnpb.EndDevice, downs ...*ttnpb.ApplicationDownlink,
+) error {
+	if !dev.GetMulticast() || ns.multicastGroups == nil {
+		return nil
+	}
+	needsGateways := false
+	for _, down := range downs {
+		if len(down.GetClassBC().GetGateways()) == 0 {
+			needsGateways = true
+			break
+		}
+	}
+	if !needsGateways {
+		return nil
+	}
+	group, err := ns.multicastGroups.Get(ctx, dev.Ids)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(group.Gateways) == 0 {
+		return nil
+	}
+	for _, down := range downs {
+		if len(down.GetClassBC().GetGateways()) > 0 {
+			continue
+		}
+		if down.ClassBC == nil {
+			down.ClassBC = &ttnpb.ApplicationDownlink_ClassBC{}
+		}
+		down.ClassBC.Gateways = group.Gateways
+	}
+	return nil
+}
+
+// removeDevicesFromMulticastGroups deletes the multicast groups of the given end devices and removes the end
+// devices from the members of the multicast groups of the application.
+func removeDevicesFromMulticastGroups(
+	ctx context.Context, groups MulticastGroupRegistry, appIDs *ttnpb.ApplicationIdentifiers, deviceIDs ...string,
+) {
+	if groups == nil || len(deviceIDs) == 0 {
+		return
+	}
+	logger := log.FromContext(ctx)
+	deleted := make(map[string]struct{}, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		deleted[devID] = struct{}{}
+	}
+	stored, err := groups.List(ctx, appIDs)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to list multicast groups")
+		return
+	}
+	for _, group := range stored {
+		ids := group.Ids
+		if _, ok := deleted[ids.DeviceId]; ok {
+			if _, err := groups.Set(ctx, ids, func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+				return nil, nil
+			}); err != nil {
+				logger.WithError(err).WithField("multicast_device_id", ids.DeviceId).Warn(
+					"Failed to delete multicast group",
+				)
+			}
+			continue
+		}
+		isMember := false
+		for _, devID := range group.MemberDeviceIds {
+			if _, ok := deleted[devID]; ok {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			continue
+		}
+		if _, err := groups.Set(ctx, ids, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+			if stored == nil {
+				return nil, nil
+			}
+			members := stored.MemberDeviceIds[:0]
+			for _, devID := range stored.MemberDeviceIds {
+				if _, ok := deleted[devID]; !ok {
+					members = append(members, devID)
+				}
+			}
+			stored.MemberDeviceIds = members
+			return stored, nil
+		}); err != nil {
+			logger.WithError(err).WithField("multicast_device_id", ids.DeviceId).Warn(
+				"Failed to remove end devices from multicast group",
+			)
+		}
+	}
+}
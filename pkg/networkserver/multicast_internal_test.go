@@ -0,0 +1,292 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockMulticastGroupRegistry struct {
+	groups map[string]*ttnpb.MulticastGroup
+}
+
+func (r *mockMulticastGroupRegistry) Get(
+	_ context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.MulticastGroup, error) {
+	group, ok := r.groups[ids.DeviceId]
+	if !ok {
+		return nil, errMulticastGroupNotFound.New()
+	}
+	return group, nil
+}
+
+func (r *mockMulticastGroupRegistry) List(
+	context.Context, *ttnpb.ApplicationIdentifiers,
+) ([]*ttnpb.MulticastGroup, error) {
+	groups := make([]*ttnpb.MulticastGroup, 0, len(r.groups))
+	for _, group := range r.groups {
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func (r *mockMulticastGroupRegistry) Set(
+	_ context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error),
+) (*ttnpb.MulticastGroup, error) {
+	group, err := f(r.groups[ids.DeviceId])
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		delete(r.groups, ids.DeviceId)
+		return nil, nil
+	}
+	r.groups[ids.DeviceId] = group
+	return group, nil
+}
+
+func makeMulticastCoverageDevice(devID string, mds ...*ttnpb.MACState_UplinkMessage_RxMetadata) *ttnpb.EndDevice {
+	return &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:       devID,
+		},
+		MacState: &ttnpb.MACState{
+			RecentUplinks: []*ttnpb.MACState_UplinkMessage{
+				{
+					Settings: &ttnpb.MACState_UplinkMessage_TxSettings{
+						DataRate: &ttnpb.DataRate{
+							Modulation: &ttnpb.DataRate_Lora{
+								Lora: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000},
+							},
+						},
+					},
+					RxMetadata: mds,
+				},
+			},
+		},
+	}
+}
+
+func makeMulticastCoverageMetadata(gtwID string, rssi float32) *ttnpb.MACState_UplinkMessage_RxMetadata {
+	return &ttnpb.MACState_UplinkMessage_RxMetadata{
+		GatewayIds:  &ttnpb.GatewayIdentifiers{GatewayId: gtwID},
+		ChannelRssi: rssi,
+		Snr:         5,
+	}
+}
+
+func TestMulticastGroupCoverage(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	devs := []*ttnpb.EndDevice{
+		makeMulticastCoverageDevice("dev-1",
+			makeMulticastCoverageMetadata("gtw-a", -100),
+			makeMulticastCoverageMetadata("gtw-b", -60),
+		),
+		makeMulticastCoverageDevice("dev-2",
+			makeMulticastCoverageMetadata("gtw-a", -90),
+			makeMulticastCoverageMetadata("gtw-c", -50),
+		),
+		makeMulticastCoverageDevice("dev-3",
+			makeMulticastCoverageMetadata("gtw-c", -80),
+			makeMulticastCoverageMetadata("gtw-d", -40),
+		),
+		makeMulticastCoverageDevice("dev-4",
+			makeMulticastCoverageMetadata("gtw-e", -70),
+		),
+		makeMulticastCoverageDevice("dev-5",
+			&ttnpb.MACState_UplinkMessage_RxMetadata{
+				GatewayIds:             &ttnpb.GatewayIdentifiers{GatewayId: "gtw-f"},
+				DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+			},
+			&ttnpb.MACState_UplinkMessage_RxMetadata{
+				GatewayIds:   &ttnpb.GatewayIdentifiers{GatewayId: "packetbroker"},
+				PacketBroker: &ttnpb.MACState_UplinkMessage_RxMetadata_PacketBrokerMetadata{},
+			},
+		),
+		{
+			Ids: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       "dev-6",
+			},
+		},
+	}
+
+	gtws, uncovered := multicastGroupCoverage(devs, maxMulticastGroupGateways)
+	gtwIDs := make([]string, 0, len(gtws))
+	for _, gtw := range gtws {
+		gtwIDs = append(gtwIDs, gtw.GatewayIds.GatewayId)
+	}
+	// gtw-a and gtw-c both cover two end devices; gtw-c has the better signal.
+	a.So(gtwIDs, should.Resemble, []string{"gtw-c", "gtw-b", "gtw-e"})
+	a.So(uncovered, should.Resemble, []string{"dev-5", "dev-6"})
+
+	gtws, uncovered = multicastGroupCoverage(devs, 1)
+	a.So(gtws, should.HaveLength, 1)
+	a.So(uncovered, should.Resemble, []string{"dev-1", "dev-4", "dev-5", "dev-6"})
+
+	gtws, uncovered = multicastGroupCoverage(nil, maxMulticastGroupGateways)
+	a.So(gtws, should.BeEmpty)
+	a.So(uncovered, should.BeEmpty)
+}
+
+func TestNewMulticastEndDevice(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-mc",
+	}
+	devAddr := types.DevAddr{0x26, 0x01, 0x02, 0x03}
+	newDevAddr := func(context.Context) types.DevAddr { return devAddr }
+
+	// The multicast address and session keys are generated.
+	dev, nsPaths, asPaths, err := newMulticastEndDevice(ctx, ids, &ttnpb.EndDevice{
+		FrequencyPlanId:   "EU_863_870",
+		LorawanVersion:    ttnpb.MACVersion_MAC_V1_1,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_1_REV_B,
+		SupportsClassC:    true,
+		SupportsJoin:      true,
+	}, newDevAddr)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(dev.Multicast, should.BeTrue)
+	a.So(dev.SupportsJoin, should.BeFalse)
+	a.So(dev.Session.DevAddr, should.Resemble, devAddr.Bytes())
+	a.So(dev.Ids.DevAddr, should.Resemble, devAddr.Bytes())
+	a.So(dev.Session.Keys.AppSKey.GetKey(), should.HaveLength, 16)
+	a.So(dev.Session.Keys.FNwkSIntKey.GetKey(), should.HaveLength, 16)
+	a.So(dev.Session.Keys.SNwkSIntKey.GetKey(), should.Resemble, dev.Session.Keys.FNwkSIntKey.Key)
+	a.So(dev.Session.Keys.NwkSEncKey.GetKey(), should.Resemble, dev.Session.Keys.FNwkSIntKey.Key)
+	a.So(nsPaths, should.NotContain, "session.keys.app_s_key.key")
+	a.So(nsPaths, should.Contain, "session.keys.nwk_s_enc_key.key")
+	a.So(asPaths, should.Resemble, []string{"session.dev_addr", "session.keys.app_s_key.key"})
+
+	// The multicast address and session keys that are set are kept.
+	appSKey := types.AES128Key{0x01, 0x02}
+	dev, _, _, err = newMulticastEndDevice(ctx, ids, &ttnpb.EndDevice{
+		Ids:            ids,
+		LorawanVersion: ttnpb.MACVersion_MAC_V1_0_3,
+		SupportsClassB: true,
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x26, 0xff, 0xff, 0xff}.Bytes(),
+			Keys: &ttnpb.SessionKeys{
+				AppSKey: &ttnpb.KeyEnvelope{Key: appSKey.Bytes()},
+			},
+		},
+	}, newDevAddr)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(dev.Session.DevAddr, should.Resemble, types.DevAddr{0x26, 0xff, 0xff, 0xff}.Bytes())
+	a.So(dev.Session.Keys.AppSKey.Key, should.Resemble, appSKey.Bytes())
+	a.So(dev.Session.Keys.SNwkSIntKey, should.BeNil)
+
+	// The end device must support class B or C and match the multicast group.
+	_, _, _, err = newMulticastEndDevice(ctx, ids, &ttnpb.EndDevice{}, newDevAddr)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, _, _, err = newMulticastEndDevice(ctx, ids, &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: ids.ApplicationIds,
+			DeviceId:       "other-mc",
+		},
+		SupportsClassC: true,
+	}, newDevAddr)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestSetMulticastGroupDownlinkGateways(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-mc",
+	}
+	groupGateways := []*ttnpb.ClassBCGatewayIdentifiers{
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}},
+	}
+	fixedGateways := []*ttnpb.ClassBCGatewayIdentifiers{
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-b"}},
+	}
+	ns := &NetworkServer{
+		multicastGroups: &mockMulticastGroupRegistry{
+			groups: map[string]*ttnpb.MulticastGroup{
+				ids.DeviceId: {Ids: ids, Gateways: groupGateways},
+			},
+		},
+	}
+
+	downs := []*ttnpb.ApplicationDownlink{
+		{FCnt: 1},
+		{FCnt: 2, ClassBC: &ttnpb.ApplicationDownlink_ClassBC{}},
+		{FCnt: 3, ClassBC: &ttnpb.ApplicationDownlink_ClassBC{Gateways: fixedGateways}},
+	}
+	a.So(ns.setMulticastGroupDownlinkGateways(ctx, &ttnpb.EndDevice{Ids: ids, Multicast: true}, downs...), should.BeNil)
+	a.So(downs[0].ClassBC.GetGateways(), should.Resemble, groupGateways)
+	a.So(downs[1].ClassBC.GetGateways(), should.Resemble, groupGateways)
+	a.So(downs[2].ClassBC.GetGateways(), should.Resemble, fixedGateways)
+
+	// Multicast end devices without multicast group are not affected.
+	downs = []*ttnpb.ApplicationDownlink{{FCnt: 1}}
+	a.So(ns.setMulticastGroupDownlinkGateways(ctx, &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: ids.ApplicationIds,
+			DeviceId:       "test-dev",
+		},
+		Multicast: true,
+	}, downs...), should.BeNil)
+	a.So(downs[0].ClassBC, should.BeNil)
+
+	// Unicast end devices are not affected.
+	downs = []*ttnpb.ApplicationDownlink{{FCnt: 1}}
+	a.So(ns.setMulticastGroupDownlinkGateways(ctx, &ttnpb.EndDevice{Ids: ids}, downs...), should.BeNil)
+	a.So(downs[0].ClassBC, should.BeNil)
+}
+
+func TestRemoveDevicesFromMulticastGroups(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	reg := &mockMulticastGroupRegistry{
+		groups: map[string]*ttnpb.MulticastGroup{
+			"mc-1": {
+				Ids:             &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "mc-1"},
+				MemberDeviceIds: []string{"dev-1", "dev-2", "dev-3"},
+			},
+			"mc-2": {
+				Ids:             &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "mc-2"},
+				MemberDeviceIds: []string{"dev-1"},
+			},
+		},
+	}
+	removeDevicesFromMulticastGroups(ctx, reg, appIDs, "dev-2", "mc-2")
+	a.So(reg.groups, should.HaveLength, 1)
+	a.So(reg.groups["mc-1"].MemberDeviceIds, should.Resemble, []string{"dev-1", "dev-3"})
+}
@@ -27,6 +27,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -161,6 +162,14 @@ type NetworkServer struct {
 
 	batchDevices       ttnpb.NsEndDeviceBatchRegistryServer
 	relayConfiguration ttnpb.NsRelayConfigurationServiceServer
+	multicastGroups    MulticastGroupRegistry
+	multicastRegistry  ttnpb.NsMulticastGroupRegistryServer
+	linkStats          LinkStatsRegistry
+	linkStatsService   ttnpb.NsEndDeviceLinkStatsServer
+	rekeyCampaigns     RekeyCampaignRegistry
+	rekeyService       ttnpb.NsRekeyCampaignsServer
+	securityMonitor    *securityMonitor
+	securityService    ttnpb.NsSecurityMonitorServer
 
 	netID           netIDFunc
 	nsID            nsIDFunc
@@ -187,6 +196,8 @@ type NetworkServer struct {
 
 	scheduledDownlinkMatcher ScheduledDownlinkMatcher
 
+	metering *metering.Recorder
+
 	uplinkSubmissionPool workerpool.WorkerPool[[]*ttnpb.ApplicationUp]
 }
 
@@ -207,6 +218,7 @@ const (
 	downlinkProcessTaskName           = "process_downlink"
 	applicationUplinkDispatchTaskName = "dispatch_application_uplink"
 	downlinkDispatchTaskName          = "dispatch_downlink"
+	rekeyCampaignsProcessTaskName     = "process_rekey_campaigns"
 
 	maxInt = int(^uint(0) >> 1)
 )
@@ -286,7 +298,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		deduplicationWindow:      makeWindowDurationFunc(conf.DeduplicationWindow),
 		collectionWindow:         makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
 		devices:                  wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
-		batchDevices:             &nsEndDeviceBatchRegistry{devices: conf.Devices},
+		batchDevices:             &nsEndDeviceBatchRegistry{devices: conf.Devices, multicastGroups: conf.MulticastGroups},
 		relayConfiguration:       &nsRelayConfigurationService{devices: conf.Devices, frequencyPlans: c.FrequencyPlansStore},
 		downlinkTasks:            conf.DownlinkTaskQueue.Queue,
 		downlinkPriorities:       downlinkPriorities,
@@ -296,6 +308,39 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		deviceKEKLabel:           conf.DeviceKEKLabel,
 		downlinkQueueCapacity:    conf.DownlinkQueueCapacity,
 		scheduledDownlinkMatcher: conf.ScheduledDownlinkMatcher,
+		multicastGroups:          conf.MulticastGroups,
+		linkStats:                conf.LinkStats.Registry,
+		rekeyCampaigns:           conf.RekeyCampaigns.Registry,
+		metering:                 conf.Metering,
+	}
+	if conf.MulticastGroups != nil {
+		ns.multicastRegistry = &nsMulticastGroupRegistry{
+			devices:      conf.Devices,
+			groups:       conf.MulticastGroups,
+			newDevAddr:   ns.newDevAddr,
+			setDevice:    ns.Set,
+			deleteDevice: ns.Delete,
+			peerConn:     ns.forwardedAuthPeerConn,
+		}
+	}
+	if conf.LinkStats.Registry != nil {
+		ns.linkStatsService = &nsEndDeviceLinkStats{devices: conf.Devices, stats: conf.LinkStats.Registry}
+	}
+	if conf.RekeyCampaigns.Registry != nil {
+		ns.rekeyService = &nsRekeyCampaigns{
+			devices:        conf.Devices,
+			campaigns:      conf.RekeyCampaigns.Registry,
+			listEndDevices: ns.listEndDevices,
+		}
+	}
+	if conf.SecurityMonitor.Enable {
+		ns.securityMonitor = newSecurityMonitor(conf.SecurityMonitor)
+		if conf.SecurityMonitor.Settings != nil {
+			ns.securityService = &nsSecurityMonitor{
+				conf:    conf.SecurityMonitor,
+				monitor: ns.securityMonitor,
+			}
+		}
 	}
 	ns.uplinkSubmissionPool = workerpool.NewWorkerPool(workerpool.Config[[]*ttnpb.ApplicationUp]{
 		Component:  c,
@@ -328,6 +373,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			"/ttn.lorawan.v3.NsEndDeviceBatchRegistry",
 			"/ttn.lorawan.v3.Ns",
 			"/ttn.lorawan.v3.RelayConfigurationService",
+			"/ttn.lorawan.v3.NsMulticastGroupRegistry",
+			"/ttn.lorawan.v3.NsEndDeviceLinkStats",
+			"/ttn.lorawan.v3.NsRekeyCampaigns",
+			"/ttn.lorawan.v3.NsSecurityMonitor",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
@@ -381,6 +430,17 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			Backoff: processTaskBackoff,
 		})
 	}
+	if conf.RekeyCampaigns.Registry != nil {
+		ns.RegisterTask(&task.Config{
+			Context: ctx,
+			ID:      rekeyCampaignsProcessTaskName,
+			Func: func(ctx context.Context) error {
+				return ns.processRekeyCampaigns(ctx, conf.RekeyCampaigns.Interval)
+			},
+			Restart: task.RestartAlways,
+			Backoff: processTaskBackoff,
+		})
+	}
 	c.RegisterGRPC(ns)
 	return ns, nil
 }
@@ -398,6 +458,18 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterNsEndDeviceBatchRegistryServer(s, ns.batchDevices)
 	ttnpb.RegisterNsServer(s, ns)
 	ttnpb.RegisterNsRelayConfigurationServiceServer(s, ns.relayConfiguration)
+	if ns.multicastRegistry != nil {
+		ttnpb.RegisterNsMulticastGroupRegistryServer(s, ns.multicastRegistry)
+	}
+	if ns.linkStatsService != nil {
+		ttnpb.RegisterNsEndDeviceLinkStatsServer(s, ns.linkStatsService)
+	}
+	if ns.rekeyService != nil {
+		ttnpb.RegisterNsRekeyCampaignsServer(s, ns.rekeyService)
+	}
+	if ns.securityService != nil {
+		ttnpb.RegisterNsSecurityMonitorServer(s, ns.securityService)
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -406,6 +478,18 @@ func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Client
 	ttnpb.RegisterNsEndDeviceBatchRegistryHandler(ns.Context(), s, conn) // nolint:errcheck
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 	ttnpb.RegisterNsRelayConfigurationServiceHandler(ns.Context(), s, conn) // nolint:errcheck
+	if ns.multicastRegistry != nil {
+		ttnpb.RegisterNsMulticastGroupRegistryHandler(ns.Context(), s, conn) // nolint:errcheck
+	}
+	if ns.linkStatsService != nil {
+		ttnpb.RegisterNsEndDeviceLinkStatsHandler(ns.Context(), s, conn) // nolint:errcheck
+	}
+	if ns.rekeyService != nil {
+		ttnpb.RegisterNsRekeyCampaignsHandler(ns.Context(), s, conn) // nolint:errcheck
+	}
+	if ns.securityService != nil {
+		ttnpb.RegisterNsSecurityMonitorHandler(ns.Context(), s, conn) // nolint:errcheck
+	}
 }
 
 // Roles returns the roles that the Network Server fulfills.
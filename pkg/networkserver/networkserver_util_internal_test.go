@@ -78,7 +78,7 @@ var (
 	ErrDeviceNotFound             = errDeviceNotFound
 	ErrInvalidAbsoluteTime        = errInvalidAbsoluteTime
 	ErrOutdatedData               = errOutdatedData
-	ErrRejoinRequest              = errRejoinRequest
+	ErrRejoinRequestType          = errRejoinRequestType
 
 	EvtClusterJoinAttempt          = evtClusterJoinAttempt
 	EvtClusterJoinFail             = evtClusterJoinFail
@@ -571,13 +571,13 @@ func (env TestEnvironment) AssertListApplicationRights(ctx context.Context, appI
 		func(ctx, _ context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (test.ClusterGetPeerResponse, bool) {
 			_, a := test.MustNewTFromContext(ctx)
 			return test.ClusterGetPeerResponse{
-					Peer: NewISPeer(ctx, &test.MockApplicationAccessServer{
-						ListRightsFunc: test.MakeApplicationAccessListRightsChFunc(listRightsCh),
-					}),
-				}, test.AllTrue(
-					a.So(role, should.Equal, ttnpb.ClusterRole_ACCESS),
-					a.So(ids, should.BeNil),
-				)
+				Peer: NewISPeer(ctx, &test.MockApplicationAccessServer{
+					ListRightsFunc: test.MakeApplicationAccessListRightsChFunc(listRightsCh),
+				}),
+			}, test.AllTrue(
+				a.So(role, should.Equal, ttnpb.ClusterRole_ACCESS),
+				a.So(ids, should.BeNil),
+			)
 		},
 	), should.BeTrue) {
 		return false
@@ -761,13 +761,13 @@ func (env TestEnvironment) AssertNsAsHandleUplink(ctx context.Context, appID *tt
 				func(ctx, reqCtx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (test.ClusterGetPeerResponse, bool) {
 					_, a := test.MustNewTFromContext(ctx)
 					return test.ClusterGetPeerResponse{
-							Peer: NewASPeer(ctx, &MockNsAsServer{
-								HandleUplinkFunc: MakeNsAsHandleUplinkChFunc(handleUplinkCh),
-							}),
-						}, test.AllTrue(
-							a.So(role, should.Equal, ttnpb.ClusterRole_APPLICATION_SERVER),
-							a.So(ids, should.BeNil),
-						)
+						Peer: NewASPeer(ctx, &MockNsAsServer{
+							HandleUplinkFunc: MakeNsAsHandleUplinkChFunc(handleUplinkCh),
+						}),
+					}, test.AllTrue(
+						a.So(role, should.Equal, ttnpb.ClusterRole_APPLICATION_SERVER),
+						a.So(ids, should.BeNil),
+					)
 				},
 			), should.BeTrue) {
 				t.Error("Application Server peer look-up assertion failed")
@@ -1515,13 +1515,13 @@ func (env TestEnvironment) AssertNsJsJoin(ctx context.Context, getPeerAssert fun
 			if !a.So(test.AssertClusterGetPeerRequest(ctx, env.Cluster.GetPeer, func(ctx, reqCtx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (test.ClusterGetPeerResponse, bool) {
 				_, a := test.MustNewTFromContext(ctx)
 				return test.ClusterGetPeerResponse{
-						Peer: NewJSPeer(ctx, &MockNsJsServer{
-							HandleJoinFunc: MakeNsJsHandleJoinChFunc(joinReqCh),
-						}),
-					}, test.AllTrue(
-						a.So(role, should.Equal, ttnpb.ClusterRole_JOIN_SERVER),
-						getPeerAssert(ctx, reqCtx, ids),
-					)
+					Peer: NewJSPeer(ctx, &MockNsJsServer{
+						HandleJoinFunc: MakeNsJsHandleJoinChFunc(joinReqCh),
+					}),
+				}, test.AllTrue(
+					a.So(role, should.Equal, ttnpb.ClusterRole_JOIN_SERVER),
+					getPeerAssert(ctx, reqCtx, ids),
+				)
 			}), should.BeTrue) {
 				t.Error("Join Server peer look-up assertion failed")
 				return
@@ -2523,6 +2523,12 @@ type MockDeviceRegistry struct {
 		paths []string,
 		f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
 	) (*ttnpb.EndDevice, context.Context, error)
+	RangeByDevEUIFunc func(
+		ctx context.Context,
+		devEUI types.EUI64,
+		paths []string,
+		f func(context.Context, *ttnpb.EndDevice) bool,
+	) error
 	BatchGetByIDFunc func(
 		ctx context.Context,
 		appIDs *ttnpb.ApplicationIdentifiers,
@@ -2557,6 +2563,14 @@ func (m MockDeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.Applicatio
 	return m.SetByIDFunc(ctx, appID, devID, paths, f)
 }
 
+// RangeByDevEUI calls RangeByDevEUIFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	if m.RangeByDevEUIFunc == nil {
+		panic("RangeByDevEUI called, but not set")
+	}
+	return m.RangeByDevEUIFunc(ctx, devEUI, paths, f)
+}
+
 // RangeByUplinkMatches panics.
 func (m MockDeviceRegistry) RangeByUplinkMatches(context.Context, *ttnpb.UplinkMessage, func(context.Context, *UplinkMatch) (bool, error)) error {
 	panic("RangeByUplinkMatches must not be called")
@@ -98,6 +98,22 @@ var (
 		events.WithDataType(&ttnpb.UplinkMessage{}),
 		events.WithPropagateToParent(),
 	)
+	evtReceiveRejoinRequest = events.Define(
+		"ns.up.rejoin.receive", "receive rejoin-request",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+	evtDropRejoinRequest = events.Define(
+		"ns.up.rejoin.drop", "drop rejoin-request",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtProcessRejoinRequest = events.Define(
+		"ns.up.rejoin.process", "successfully processed rejoin-request",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+		events.WithPropagateToParent(),
+	)
 	evtClusterJoinAttempt = events.Define(
 		"ns.up.join.cluster.attempt", "send join-request to cluster-local Join Server",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
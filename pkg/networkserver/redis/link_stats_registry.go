@@ -0,0 +1,296 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"sort"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errInvalidLinkStatsGranularity = errors.DefineInvalidArgument(
+	"invalid_link_stats_granularity", "invalid link statistics granularity `{granularity}`",
+)
+
+const (
+	uplinkCountField       = "uplink_count"
+	missedUplinkCountField = "missed_uplink_count"
+	fCntResetCountField    = "f_cnt_reset_count"
+	snrField               = "snr"
+	rssiField              = "rssi"
+	gatewayCountField      = "gateway_count"
+)
+
+// LinkStatsRegistry is an implementation of networkserver.LinkStatsRegistry.
+// The buckets of each granularity of an end device are stored in separate hashes of counters, and the gateways of
+// each bucket in a set. Both expire after the retention of the granularity. A sorted set per granularity indexes the
+// buckets by start time.
+type LinkStatsRegistry struct {
+	Redis         *ttnredis.Client
+	HourRetention time.Duration
+	DayRetention  time.Duration
+}
+
+func (r *LinkStatsRegistry) retention(granularity ttnpb.LinkStatsGranularity) (time.Duration, string, error) {
+	switch granularity {
+	case ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR:
+		return r.HourRetention, "hour", nil
+	case ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_DAY:
+		return r.DayRetention, "day", nil
+	default:
+		return 0, "", errInvalidLinkStatsGranularity.WithAttributes("granularity", granularity)
+	}
+}
+
+func (r *LinkStatsRegistry) indexKey(uid, granularity string) string {
+	return r.Redis.Key("uid", uid, granularity)
+}
+
+func (r *LinkStatsRegistry) bucketKey(uid, granularity, startAt string) string {
+	return r.Redis.Key("uid", uid, granularity, startAt)
+}
+
+func (r *LinkStatsRegistry) bucketGatewaysKey(uid, granularity, startAt string) string {
+	return r.Redis.Key("uid", uid, granularity, startAt, "gateways")
+}
+
+func parseSignalStats(fields map[string]string, name string) (*ttnpb.SignalStats, error) {
+	s, ok := fields[name+"_count"]
+	if !ok {
+		return nil, nil
+	}
+	count, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	var vs [3]float64
+	for i, suffix := range [...]string{"_min", "_max", "_sum"} {
+		if vs[i], err = strconv.ParseFloat(fields[name+suffix], 32); err != nil {
+			return nil, err
+		}
+	}
+	return &ttnpb.SignalStats{
+		Min:   float32(vs[0]),
+		Max:   float32(vs[1]),
+		Mean:  float32(vs[2] / float64(count)),
+		Count: uint32(count),
+	}, nil
+}
+
+func parseLinkStatsBucket(
+	startAt time.Time, fields map[string]string, gatewayIDs []string,
+) (*ttnpb.EndDeviceLinkStatsBucket, error) {
+	pb := &ttnpb.EndDeviceLinkStatsBucket{
+		StartAt: timestamppb.New(startAt),
+	}
+	for name, v := range map[string]*uint32{
+		uplinkCountField:       &pb.UplinkCount,
+		missedUplinkCountField: &pb.MissedUplinkCount,
+		fCntResetCountField:    &pb.FCntResetCount,
+	} {
+		n, err := strconv.ParseUint(fields[name], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		*v = uint32(n)
+	}
+	for name, v := range map[string]**ttnpb.SignalStats{
+		snrField:          &pb.Snr,
+		rssiField:         &pb.Rssi,
+		gatewayCountField: &pb.GatewayCount,
+	} {
+		st, err := parseSignalStats(fields, name)
+		if err != nil {
+			return nil, err
+		}
+		*v = st
+	}
+	if total := pb.UplinkCount + pb.MissedUplinkCount; total > 0 {
+		pb.PacketErrorRate = float32(pb.MissedUplinkCount) / float32(total)
+	}
+	if len(gatewayIDs) > 0 {
+		sort.Strings(gatewayIDs)
+		pb.GatewayIds = gatewayIDs
+	}
+	return pb, nil
+}
+
+// Range implements networkserver.LinkStatsRegistry.
+func (r *LinkStatsRegistry) Range(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	granularity ttnpb.LinkStatsGranularity,
+	since time.Time,
+) ([]*ttnpb.EndDeviceLinkStatsBucket, error) {
+	defer trace.StartRegion(ctx, "range link stats").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	_, g, err := r.retention(granularity)
+	if err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+	minScore := "-inf"
+	if !since.IsZero() {
+		minScore = strconv.FormatInt(since.Unix(), 10)
+	}
+	starts, err := r.Redis.ZRangeByScore(ctx, r.indexKey(uid, g), &redis.ZRangeBy{
+		Min: minScore,
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(starts) == 0 {
+		return nil, nil
+	}
+	fieldCmds := make([]*redis.MapStringStringCmd, 0, len(starts))
+	gatewayCmds := make([]*redis.StringSliceCmd, 0, len(starts))
+	if _, err := r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, start := range starts {
+			fieldCmds = append(fieldCmds, p.HGetAll(ctx, r.bucketKey(uid, g, start)))
+			gatewayCmds = append(gatewayCmds, p.SMembers(ctx, r.bucketGatewaysKey(uid, g, start)))
+		}
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.EndDeviceLinkStatsBucket, 0, len(starts))
+	for i, start := range starts {
+		fields := fieldCmds[i].Val()
+		if len(fields) == 0 {
+			// NOTE: The bucket expired, but the index is not pruned yet.
+			continue
+		}
+		sec, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		pb, err := parseLinkStatsBucket(time.Unix(sec, 0), fields, gatewayCmds[i].Val())
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+// addLinkStatsScript adds a sample to the buckets of the granularities.
+// KEYS are the bucket, gateways and index keys of each granularity.
+// ARGV are the start time, the retention in milliseconds and the start time before which the index is pruned of each
+// granularity, followed by the missed uplink count, the frame counter reset count, the SNR, the RSSI, the gateway
+// count, the maximum number of gateway IDs and the gateway IDs. The SNR and RSSI are empty if they are unknown.
+var addLinkStatsScript = redis.NewScript(`local n = #KEYS / 3
+local s = n * 3
+local function add_stats(k, name, v)
+	local count = redis.call('hincrby', k, name .. '_count', 1)
+	redis.call('hincrbyfloat', k, name .. '_sum', v)
+	if count == 1 or tonumber(v) < tonumber(redis.call('hget', k, name .. '_min')) then
+		redis.call('hset', k, name .. '_min', v)
+	end
+	if count == 1 or tonumber(v) > tonumber(redis.call('hget', k, name .. '_max')) then
+		redis.call('hset', k, name .. '_max', v)
+	end
+end
+for i = 0, n - 1 do
+	local bk, gk, ik = KEYS[3*i+1], KEYS[3*i+2], KEYS[3*i+3]
+	redis.call('hincrby', bk, 'uplink_count', 1)
+	redis.call('hincrby', bk, 'missed_uplink_count', ARGV[s+1])
+	redis.call('hincrby', bk, 'f_cnt_reset_count', ARGV[s+2])
+	if ARGV[s+3] ~= '' then
+		add_stats(bk, 'snr', ARGV[s+3])
+	end
+	if ARGV[s+4] ~= '' then
+		add_stats(bk, 'rssi', ARGV[s+4])
+	end
+	add_stats(bk, 'gateway_count', ARGV[s+5])
+	for j = s + 7, #ARGV do
+		if redis.call('scard', gk) >= tonumber(ARGV[s+6]) then
+			break
+		end
+		redis.call('sadd', gk, ARGV[j])
+	end
+	redis.call('pexpire', bk, ARGV[3*i+2])
+	redis.call('pexpire', gk, ARGV[3*i+2])
+	redis.call('zadd', ik, ARGV[3*i+1], ARGV[3*i+1])
+	redis.call('zremrangebyscore', ik, '-inf', '(' .. ARGV[3*i+3])
+	redis.call('pexpire', ik, ARGV[3*i+2])
+end
+return redis.status_reply('OK')`)
+
+func formatSignalSample(v *float32) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(float64(*v), 'f', -1, 32)
+}
+
+// Add implements networkserver.LinkStatsRegistry.
+func (r *LinkStatsRegistry) Add(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	receivedAt time.Time,
+	sample *networkserver.LinkStatsSample,
+) error {
+	defer trace.StartRegion(ctx, "add link stats").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	uid := unique.ID(ctx, ids)
+	now := time.Now()
+	keys := make([]string, 0, 3*len(networkserver.LinkStatsGranularities))
+	args := make([]any, 0, 3*len(networkserver.LinkStatsGranularities)+6+len(sample.GatewayIDs))
+	for _, granularity := range networkserver.LinkStatsGranularities {
+		retention, g, err := r.retention(granularity)
+		if err != nil {
+			return err
+		}
+		start := strconv.FormatInt(networkserver.LinkStatsBucketStart(receivedAt, granularity).Unix(), 10)
+		keys = append(keys, r.bucketKey(uid, g, start), r.bucketGatewaysKey(uid, g, start), r.indexKey(uid, g))
+		args = append(args, start, retention.Milliseconds(), now.Add(-retention).Unix())
+	}
+	var fCntResetCount int
+	if sample.FCntReset {
+		fCntResetCount = 1
+	}
+	args = append(args,
+		sample.MissedUplinkCount,
+		fCntResetCount,
+		formatSignalSample(sample.SNR),
+		formatSignalSample(sample.RSSI),
+		len(sample.GatewayIDs),
+		networkserver.MaxLinkStatsGatewayIDs,
+	)
+	for _, id := range sample.GatewayIDs {
+		args = append(args, id)
+	}
+	if err := addLinkStatsScript.Run(ctx, r.Redis, keys, args...).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ networkserver.LinkStatsRegistry = &LinkStatsRegistry{}
+
+func TestLinkStatsRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "link-stats")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+	reg := &LinkStatsRegistry{
+		Redis:         cl,
+		HourRetention: time.Hour << 2,
+		DayRetention:  time.Hour << 10,
+	}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	hour := ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR
+	now := time.Now().UTC().Truncate(time.Hour)
+
+	buckets, err := reg.Range(ctx, ids, hour, time.Time{})
+	a.So(err, should.BeNil)
+	a.So(buckets, should.BeEmpty)
+
+	f32 := func(v float32) *float32 { return &v }
+	for _, tc := range []struct {
+		ReceivedAt time.Time
+		Sample     *networkserver.LinkStatsSample
+	}{
+		{
+			ReceivedAt: now.Add(-2*time.Hour + time.Minute),
+			Sample: &networkserver.LinkStatsSample{
+				SNR:        f32(5),
+				RSSI:       f32(-80),
+				GatewayIDs: []string{"gtw-b", "gtw-a"},
+			},
+		},
+		{
+			ReceivedAt: now.Add(-2*time.Hour + 2*time.Minute),
+			Sample: &networkserver.LinkStatsSample{
+				MissedUplinkCount: 2,
+				SNR:               f32(-1.5),
+				RSSI:              f32(-100),
+				GatewayIDs:        []string{"gtw-c", "gtw-a"},
+			},
+		},
+		{
+			ReceivedAt: now.Add(-time.Hour),
+			Sample: &networkserver.LinkStatsSample{
+				FCntReset: true,
+				RSSI:      f32(-60),
+			},
+		},
+		{
+			ReceivedAt: now.Add(time.Minute),
+			Sample: &networkserver.LinkStatsSample{
+				RSSI:       f32(-70),
+				GatewayIDs: []string{"gtw-a"},
+			},
+		},
+	} {
+		if !a.So(reg.Add(ctx, ids, tc.ReceivedAt, tc.Sample), should.BeNil) {
+			t.FailNow()
+		}
+	}
+	stored := []*ttnpb.EndDeviceLinkStatsBucket{
+		{
+			StartAt:           timestamppb.New(now.Add(-2 * time.Hour)),
+			UplinkCount:       2,
+			MissedUplinkCount: 2,
+			Snr:               &ttnpb.SignalStats{Min: -1.5, Max: 5, Mean: 1.75, Count: 2},
+			Rssi:              &ttnpb.SignalStats{Min: -100, Max: -80, Mean: -90, Count: 2},
+			GatewayCount:      &ttnpb.SignalStats{Min: 2, Max: 2, Mean: 2, Count: 2},
+			GatewayIds:        []string{"gtw-a", "gtw-b", "gtw-c"},
+			PacketErrorRate:   0.5,
+		},
+		{
+			StartAt:        timestamppb.New(now.Add(-time.Hour)),
+			UplinkCount:    1,
+			FCntResetCount: 1,
+			Rssi:           &ttnpb.SignalStats{Min: -60, Max: -60, Mean: -60, Count: 1},
+			GatewayCount:   &ttnpb.SignalStats{Min: 0, Max: 0, Mean: 0, Count: 1},
+		},
+		{
+			StartAt:      timestamppb.New(now),
+			UplinkCount:  1,
+			Rssi:         &ttnpb.SignalStats{Min: -70, Max: -70, Mean: -70, Count: 1},
+			GatewayCount: &ttnpb.SignalStats{Min: 1, Max: 1, Mean: 1, Count: 1},
+			GatewayIds:   []string{"gtw-a"},
+		},
+	}
+
+	buckets, err = reg.Range(ctx, ids, hour, time.Time{})
+	a.So(err, should.BeNil)
+	a.So(buckets, should.Resemble, stored)
+
+	buckets, err = reg.Range(ctx, ids, hour, now.Add(-time.Hour))
+	a.So(err, should.BeNil)
+	a.So(buckets, should.Resemble, stored[1:])
+
+	// The sample is added to the buckets of all granularities.
+	buckets, err = reg.Range(ctx, ids, ttnpb.LinkStatsGranularity_LINK_STATS_GRANULARITY_DAY, time.Time{})
+	a.So(err, should.BeNil)
+	var uplinkCount uint32
+	for _, b := range buckets {
+		uplinkCount += b.UplinkCount
+	}
+	a.So(uplinkCount, should.Equal, 4)
+
+	// Buckets of other end devices are stored separately.
+	buckets, err = reg.Range(ctx, &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: ids.ApplicationIds,
+		DeviceId:       "other-dev",
+	}, hour, time.Time{})
+	a.So(err, should.BeNil)
+	a.So(buckets, should.BeEmpty)
+
+	_, err = reg.Range(ctx, ids, ttnpb.LinkStatsGranularity(42), time.Time{})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MulticastGroupRegistry is an implementation of networkserver.MulticastGroupRegistry.
+type MulticastGroupRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the MulticastGroupRegistry.
+func (r *MulticastGroupRegistry) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *MulticastGroupRegistry) appKey(appUID string) string {
+	return r.Redis.Key("uid", appUID)
+}
+
+func (r *MulticastGroupRegistry) idKey(appUID, devID string) string {
+	return r.Redis.Key("uid", appUID, devID)
+}
+
+// Get implements networkserver.MulticastGroupRegistry.
+func (r *MulticastGroupRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.MulticastGroup, error) {
+	defer trace.StartRegion(ctx, "get multicast group").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.MulticastGroup{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.idKey(unique.ID(ctx, ids.ApplicationIds), ids.DeviceId)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// List implements networkserver.MulticastGroupRegistry.
+func (r *MulticastGroupRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) ([]*ttnpb.MulticastGroup, error) {
+	defer trace.StartRegion(ctx, "list multicast groups").End()
+
+	var pbs []*ttnpb.MulticastGroup
+	appUID := unique.ID(ctx, ids)
+	err := ttnredis.FindProtos(ctx, r.Redis, r.appKey(appUID), func(devID string) string {
+		return r.idKey(appUID, devID)
+	}).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.MulticastGroup{}
+		return pb, func() (bool, error) {
+			pbs = append(pbs, pb)
+			return true, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements networkserver.MulticastGroupRegistry.
+func (r *MulticastGroupRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error),
+) (*ttnpb.MulticastGroup, error) {
+	defer trace.StartRegion(ctx, "set multicast group").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	appUID := unique.ID(ctx, ids.ApplicationIds)
+	ik := r.idKey(appUID, ids.DeviceId)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.MulticastGroup
+	err = ttnredis.LockedWatch(ctx, r.Redis, ik, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored := &ttnpb.MulticastGroup{}
+		if err := ttnredis.GetProto(ctx, tx, ik).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var createdAt *timestamppb.Timestamp
+		if stored != nil {
+			createdAt = stored.CreatedAt
+		}
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, ik)
+				p.SRem(ctx, r.appKey(appUID), ids.DeviceId)
+				return nil
+			}
+		} else {
+			if pb.Ids.GetApplicationIds().GetApplicationId() != ids.ApplicationIds.ApplicationId ||
+				pb.Ids.GetDeviceId() != ids.DeviceId {
+				return errInvalidIdentifiers.New()
+			}
+			pb.UpdatedAt = timestamppb.Now()
+			pb.CreatedAt = createdAt
+			if pb.CreatedAt == nil {
+				pb.CreatedAt = pb.UpdatedAt
+			}
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				if _, err := ttnredis.SetProto(ctx, p, ik, pb, 0); err != nil {
+					return err
+				}
+				p.SAdd(ctx, r.appKey(appUID), ids.DeviceId)
+				return nil
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
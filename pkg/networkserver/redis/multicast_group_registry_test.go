@@ -0,0 +1,103 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.MulticastGroupRegistry = &MulticastGroupRegistry{}
+
+func TestMulticastGroupRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "multicast-groups")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+	reg := &MulticastGroupRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := reg.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	ids := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-mc"}
+
+	_, err := reg.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	created, err := reg.Set(ctx, ids, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		a.So(stored, should.BeNil)
+		return &ttnpb.MulticastGroup{
+			Ids:             ids,
+			MemberDeviceIds: []string{"test-dev-1", "test-dev-2"},
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(created.CreatedAt, should.NotBeNil)
+	a.So(created.UpdatedAt, should.Resemble, created.CreatedAt)
+
+	got, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(got, should.Resemble, created)
+
+	groups, err := reg.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(groups, should.Resemble, []*ttnpb.MulticastGroup{created})
+
+	updated, err := reg.Set(ctx, ids, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		a.So(stored, should.Resemble, created)
+		stored.Gateways = []*ttnpb.ClassBCGatewayIdentifiers{
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}},
+		}
+		return stored, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(updated.CreatedAt, should.Resemble, created.CreatedAt)
+	a.So(updated.Gateways, should.HaveLength, 1)
+
+	// The identifiers cannot be changed.
+	_, err = reg.Set(ctx, ids, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		stored.Ids = &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "other-mc"}
+		return stored, nil
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	deleted, err := reg.Set(ctx, ids, func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, error) {
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(deleted, should.BeNil)
+
+	_, err = reg.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	groups, err = reg.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(groups, should.BeEmpty)
+}
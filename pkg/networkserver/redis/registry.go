@@ -81,6 +81,10 @@ func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
 	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
 }
 
+func (r *DeviceRegistry) devEUIKey(devEUI types.EUI64) string {
+	return r.Redis.Key("dev_eui", devEUI.String())
+}
+
 func (r *DeviceRegistry) relayRulesMapping(ctx context.Context, dev *ttnpb.EndDevice) map[string]string {
 	m := make(map[string]string)
 	add := func(rules []*ttnpb.RelayUplinkForwardingRule) {
@@ -191,6 +195,37 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI
 	return pb, ctx, nil
 }
 
+// RangeByDevEUI ranges over the devices with DevEUI devEUI.
+func (r *DeviceRegistry) RangeByDevEUI(
+	ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool,
+) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_eui").End()
+
+	uids, err := r.Redis.SMembers(ctx, r.devEUIKey(devEUI)).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, uid := range uids {
+		pb := &ttnpb.EndDevice{}
+		if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(uid)).ScanProto(pb); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !bytes.Equal(pb.Ids.DevEui, devEUI.Bytes()) {
+			continue
+		}
+		pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
 type UplinkMatchSession struct {
 	FNwkSIntKey       *ttnpb.KeyEnvelope
 	ResetsFCnt        *ttnpb.BoolValue
@@ -708,6 +743,7 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationId
 							types.MustEUI64(stored.Ids.DevEui).OrZero(),
 						),
 					)
+					p.SRem(ctx, r.devEUIKey(types.MustEUI64(stored.Ids.DevEui).OrZero()), uid)
 				}
 				if stored.PendingSession != nil {
 					devAddr := types.MustDevAddr(stored.PendingSession.DevAddr).OrZero()
@@ -996,6 +1032,11 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationId
 				}
 			}
 
+			if updated.Ids.JoinEui != nil && updated.Ids.DevEui != nil {
+				// NOTE: Devices are added to the DevEUI index on every update, so that devices created before the
+				// index existed are indexed lazily.
+				p.SAdd(ctx, r.devEUIKey(types.MustEUI64(updated.Ids.DevEui).OrZero()), uid)
+			}
 			_, err := ttnredis.SetProto(ctx, p, uk, updated, 0)
 			if err != nil {
 				return err
@@ -1078,6 +1119,7 @@ func (r *DeviceRegistry) BatchDelete(
 			return err
 		}
 		euiKeys := make([]string, 0, len(raw))
+		devEUIKeys := make(map[string]string, len(raw))
 		relayRulesKeys := make([]string, 0, len(raw))
 		for _, raw := range raw {
 			switch val := raw.(type) {
@@ -1096,6 +1138,7 @@ func (r *DeviceRegistry) BatchDelete(
 						types.MustEUI64(dev.GetIds().GetJoinEui()).OrZero(),
 						types.MustEUI64(dev.GetIds().GetDevEui()).OrZero(),
 					))
+					devEUIKeys[uid] = r.devEUIKey(types.MustEUI64(dev.GetIds().GetDevEui()).OrZero())
 				}
 				if dev.PendingSession != nil {
 					devAddr := types.MustDevAddr(dev.PendingSession.DevAddr).OrZero()
@@ -1131,6 +1174,9 @@ func (r *DeviceRegistry) BatchDelete(
 		}
 		if _, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
 			p.Del(ctx, append(append(uidKeys, euiKeys...), relayRulesKeys...)...)
+			for uid, key := range devEUIKeys {
+				p.SRem(ctx, key, uid)
+			}
 			for uid, keys := range addrMapping {
 				for _, key := range keys {
 					removeAddrMapping(ctx, p, key, uid)
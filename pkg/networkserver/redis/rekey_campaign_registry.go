@@ -0,0 +1,467 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"sort"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errInvalidRekeyCampaignDeviceState = errors.DefineInvalidArgument(
+	"invalid_rekey_campaign_device_state", "end devices in state `{state}` are not indexed",
+)
+
+// RekeyCampaignRegistry is an implementation of networkserver.RekeyCampaignRegistry.
+// The end devices of a campaign are stored in a hash by device ID. The pending and triggered end devices are indexed
+// in a sorted set per state.
+type RekeyCampaignRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RekeyCampaignRegistry) appKey(appUID string) string {
+	return r.Redis.Key("uid", appUID)
+}
+
+func (r *RekeyCampaignRegistry) idKey(appUID, campaignID string) string {
+	return r.Redis.Key("uid", appUID, campaignID)
+}
+
+func (r *RekeyCampaignRegistry) devicesKey(appUID, campaignID string) string {
+	return r.Redis.Key("uid", appUID, campaignID, "devices")
+}
+
+// deviceStateKey returns the key of the sorted set of the IDs of the end devices of the campaign in state.
+// Only pending and triggered end devices are indexed. Pending end devices are scored by the order in which they were
+// added, triggered end devices by the time in milliseconds at which they were triggered.
+func (r *RekeyCampaignRegistry) deviceStateKey(
+	appUID, campaignID string, state ttnpb.RekeyCampaignDeviceState,
+) (string, bool) {
+	switch state {
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING:
+		return r.Redis.Key("uid", appUID, campaignID, "devices", "pending"), true
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED:
+		return r.Redis.Key("uid", appUID, campaignID, "devices", "triggered"), true
+	default:
+		return "", false
+	}
+}
+
+func (r *RekeyCampaignRegistry) claimKey(appUID, campaignID string) string {
+	return r.Redis.Key("claim", appUID, campaignID)
+}
+
+// runningKey returns the key of the set of running campaigns. The members are of the form `<app-uid>:<campaign-id>`.
+func (r *RekeyCampaignRegistry) runningKey() string {
+	return r.Redis.Key("running")
+}
+
+func runningMember(appUID, campaignID string) string {
+	return appUID + ":" + campaignID
+}
+
+// Get implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string,
+) (*ttnpb.RekeyCampaign, error) {
+	defer trace.StartRegion(ctx, "get rekey campaign").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.RekeyCampaign{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.idKey(unique.ID(ctx, ids), campaignID)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// List implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) ([]*ttnpb.RekeyCampaign, error) {
+	defer trace.StartRegion(ctx, "list rekey campaigns").End()
+
+	var pbs []*ttnpb.RekeyCampaign
+	appUID := unique.ID(ctx, ids)
+	err := ttnredis.FindProtos(ctx, r.Redis, r.appKey(appUID), func(campaignID string) string {
+		return r.idKey(appUID, campaignID)
+	}).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.RekeyCampaign{}
+		return pb, func() (bool, error) {
+			pbs = append(pbs, pb)
+			return true, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// RangeRunning implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) RangeRunning(
+	ctx context.Context, f func(context.Context, *ttnpb.ApplicationIdentifiers, string) bool,
+) error {
+	defer trace.StartRegion(ctx, "range running rekey campaigns").End()
+
+	members, err := r.Redis.SMembers(ctx, r.runningKey()).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, member := range members {
+		i := strings.LastIndexByte(member, ':')
+		if i < 0 {
+			log.FromContext(ctx).WithField("member", member).Warn("Invalid running rekey campaign")
+			continue
+		}
+		ids, err := unique.ToApplicationID(member[:i])
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("member", member).Warn("Invalid running rekey campaign")
+			continue
+		}
+		if !f(ctx, ids, member[i+1:]) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Claim implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) Claim(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string, ttl time.Duration,
+) (bool, error) {
+	defer trace.StartRegion(ctx, "claim rekey campaign").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return false, err
+	}
+	claimed, err := r.Redis.SetNX(ctx, r.claimKey(unique.ID(ctx, ids), campaignID), "", ttl).Result()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return claimed, nil
+}
+
+// Set implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	campaignID string,
+	f func(*ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error),
+) (*ttnpb.RekeyCampaign, error) {
+	defer trace.StartRegion(ctx, "set rekey campaign").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	appUID := unique.ID(ctx, ids)
+	ik := r.idKey(appUID, campaignID)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.RekeyCampaign
+	err = ttnredis.LockedWatch(ctx, r.Redis, ik, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored := &ttnpb.RekeyCampaign{}
+		if err := ttnredis.GetProto(ctx, tx, ik).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var createdAt *timestamppb.Timestamp
+		if stored != nil {
+			createdAt = stored.CreatedAt
+		}
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		member := runningMember(appUID, campaignID)
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				pk, _ := r.deviceStateKey(appUID, campaignID, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING)
+				tk, _ := r.deviceStateKey(appUID, campaignID, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED)
+				p.Del(ctx, ik, r.claimKey(appUID, campaignID), r.devicesKey(appUID, campaignID), pk, tk)
+				p.SRem(ctx, r.appKey(appUID), campaignID)
+				p.SRem(ctx, r.runningKey(), member)
+				return nil
+			}
+		} else {
+			if pb.ApplicationIds.GetApplicationId() != ids.ApplicationId || pb.CampaignId != campaignID {
+				return errInvalidIdentifiers.New()
+			}
+			pb.UpdatedAt = timestamppb.Now()
+			pb.CreatedAt = createdAt
+			if pb.CreatedAt == nil {
+				pb.CreatedAt = pb.UpdatedAt
+			}
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			var devs []*ttnpb.RekeyCampaignDevice
+			if stored == nil {
+				devs = pb.Devices
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				if err := r.setDevices(ctx, p, appUID, campaignID, nil, devs...); err != nil {
+					return err
+				}
+				if _, err := ttnredis.SetProto(ctx, p, ik, withoutDevices(pb), 0); err != nil {
+					return err
+				}
+				p.SAdd(ctx, r.appKey(appUID), campaignID)
+				setRunning(ctx, p, r.runningKey(), member, pb.State)
+				return nil
+			}
+			if stored != nil {
+				pb.Devices = nil
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
+// withoutDevices returns a shallow copy of the campaign pb without its end devices.
+func withoutDevices(pb *ttnpb.RekeyCampaign) *ttnpb.RekeyCampaign {
+	if len(pb.Devices) == 0 {
+		return pb
+	}
+	pb = proto.Clone(pb).(*ttnpb.RekeyCampaign)
+	pb.Devices = nil
+	return pb
+}
+
+func setRunning(ctx context.Context, p redis.Pipeliner, k, member string, state ttnpb.RekeyCampaignState) {
+	if state == ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING {
+		p.SAdd(ctx, k, member)
+	} else {
+		p.SRem(ctx, k, member)
+	}
+}
+
+// setDevices stores the end devices devs of the campaign and updates the state indexes. stored contains the states
+// of the end devices before they were updated, by device ID. End devices that are not in stored are new, and are
+// indexed by their position in devs if they are pending.
+func (r *RekeyCampaignRegistry) setDevices(
+	ctx context.Context,
+	p redis.Pipeliner,
+	appUID, campaignID string,
+	stored map[string]ttnpb.RekeyCampaignDeviceState,
+	devs ...*ttnpb.RekeyCampaignDevice,
+) error {
+	if len(devs) == 0 {
+		return nil
+	}
+	values := make([]any, 0, 2*len(devs))
+	for i, d := range devs {
+		s, err := ttnredis.MarshalProto(d)
+		if err != nil {
+			return err
+		}
+		values = append(values, d.DeviceId, s)
+
+		storedState, ok := stored[d.DeviceId]
+		if ok && storedState == d.State {
+			continue
+		}
+		if ok {
+			if k, ok := r.deviceStateKey(appUID, campaignID, storedState); ok {
+				p.ZRem(ctx, k, d.DeviceId)
+			}
+		}
+		k, ok := r.deviceStateKey(appUID, campaignID, d.State)
+		if !ok {
+			continue
+		}
+		score := float64(i)
+		if d.State == ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED {
+			score = float64(ttnpb.StdTime(d.TriggeredAt).UnixMilli())
+		}
+		p.ZAdd(ctx, k, redis.Z{Score: score, Member: d.DeviceId})
+	}
+	p.HSet(ctx, r.devicesKey(appUID, campaignID), values...)
+	return nil
+}
+
+// Devices implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) Devices(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string,
+) ([]*ttnpb.RekeyCampaignDevice, error) {
+	defer trace.StartRegion(ctx, "get rekey campaign devices").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	vs, err := r.Redis.HGetAll(ctx, r.devicesKey(unique.ID(ctx, ids), campaignID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.RekeyCampaignDevice, 0, len(vs))
+	for _, v := range vs {
+		pb := &ttnpb.RekeyCampaignDevice{}
+		if err := ttnredis.UnmarshalProto(v, pb); err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	sort.Slice(pbs, func(i, j int) bool { return pbs[i].DeviceId < pbs[j].DeviceId })
+	return pbs, nil
+}
+
+// DeviceIDs implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) DeviceIDs(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	campaignID string,
+	state ttnpb.RekeyCampaignDeviceState,
+	limit int,
+) ([]string, error) {
+	defer trace.StartRegion(ctx, "get rekey campaign device IDs").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	k, ok := r.deviceStateKey(unique.ID(ctx, ids), campaignID, state)
+	if !ok {
+		return nil, errInvalidRekeyCampaignDeviceState.WithAttributes("state", state)
+	}
+	devIDs, err := r.Redis.ZRange(ctx, k, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return devIDs, nil
+}
+
+// UpdateDevices implements networkserver.RekeyCampaignRegistry.
+func (r *RekeyCampaignRegistry) UpdateDevices(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	campaignID string,
+	deviceIDs []string,
+	f func(*ttnpb.RekeyCampaign, []*ttnpb.RekeyCampaignDevice) error,
+) (*ttnpb.RekeyCampaign, error) {
+	defer trace.StartRegion(ctx, "update rekey campaign devices").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	appUID := unique.ID(ctx, ids)
+	ik := r.idKey(appUID, campaignID)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.RekeyCampaign
+	err = ttnredis.LockedWatch(ctx, r.Redis, ik, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		pb = &ttnpb.RekeyCampaign{}
+		if err := ttnredis.GetProto(ctx, tx, ik).ScanProto(pb); err != nil {
+			return err
+		}
+		var devs []*ttnpb.RekeyCampaignDevice
+		if len(deviceIDs) > 0 {
+			vs, err := tx.HMGet(ctx, r.devicesKey(appUID, campaignID), deviceIDs...).Result()
+			if err != nil {
+				return err
+			}
+			devs = make([]*ttnpb.RekeyCampaignDevice, 0, len(vs))
+			for _, v := range vs {
+				s, ok := v.(string)
+				if !ok {
+					continue
+				}
+				d := &ttnpb.RekeyCampaignDevice{}
+				if err := ttnredis.UnmarshalProto(s, d); err != nil {
+					return err
+				}
+				devs = append(devs, d)
+			}
+		}
+		storedDevs := make([]*ttnpb.RekeyCampaignDevice, 0, len(devs))
+		for _, d := range devs {
+			storedDevs = append(storedDevs, ttnpb.Clone(d))
+		}
+		if err := f(pb, devs); err != nil {
+			return err
+		}
+		stored := make(map[string]ttnpb.RekeyCampaignDeviceState, len(devs))
+		changed := make([]*ttnpb.RekeyCampaignDevice, 0, len(devs))
+		for i, d := range devs {
+			if proto.Equal(d, storedDevs[i]) {
+				continue
+			}
+			if err := d.ValidateFields(); err != nil {
+				return err
+			}
+			stored[d.DeviceId] = storedDevs[i].State
+			changed = append(changed, d)
+		}
+		pb.UpdatedAt = timestamppb.Now()
+		if err := pb.ValidateFields(); err != nil {
+			return err
+		}
+		_, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			if err := r.setDevices(ctx, p, appUID, campaignID, stored, changed...); err != nil {
+				return err
+			}
+			if _, err := ttnredis.SetProto(ctx, p, ik, pb, 0); err != nil {
+				return err
+			}
+			setRunning(ctx, p, r.runningKey(), runningMember(appUID, campaignID), pb.State)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
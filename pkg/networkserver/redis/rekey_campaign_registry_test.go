@@ -0,0 +1,180 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ networkserver.RekeyCampaignRegistry = &RekeyCampaignRegistry{}
+
+func TestRekeyCampaignRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "rekey-campaigns")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+	reg := &RekeyCampaignRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := reg.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	running := func() []string {
+		var ids []string
+		err := reg.RangeRunning(ctx, func(_ context.Context, appIDs *ttnpb.ApplicationIdentifiers, id string) bool {
+			ids = append(ids, appIDs.ApplicationId+"/"+id)
+			return true
+		})
+		a.So(err, should.BeNil)
+		return ids
+	}
+
+	_, err := reg.Get(ctx, appIDs, "test-campaign")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	created, err := reg.Set(ctx, appIDs, "test-campaign", func(stored *ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+		a.So(stored, should.BeNil)
+		return &ttnpb.RekeyCampaign{
+			ApplicationIds:   appIDs,
+			CampaignId:       "test-campaign",
+			Selector:         &ttnpb.RekeyCampaign_Selector{},
+			DevicesPerMinute: 10,
+			RejoinTimeout:    durationpb.New(time.Hour),
+			Devices: []*ttnpb.RekeyCampaignDevice{
+				{DeviceId: "test-dev-2"},
+				{DeviceId: "test-dev-1"},
+				{DeviceId: "test-dev-3", State: ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED},
+			},
+			Summary: &ttnpb.RekeyCampaignSummary{Pending: 2, Skipped: 1},
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(created.CreatedAt, should.NotBeNil)
+	a.So(created.UpdatedAt, should.Resemble, created.CreatedAt)
+	a.So(created.Devices, should.HaveLength, 3)
+	createdDevices := created.Devices
+	created.Devices = nil
+
+	// The end devices are stored separately from the campaign.
+	got, err := reg.Get(ctx, appIDs, "test-campaign")
+	a.So(err, should.BeNil)
+	a.So(got, should.Resemble, created)
+	a.So(running(), should.Resemble, []string{"test-app/test-campaign"})
+	devs, err := reg.Devices(ctx, appIDs, "test-campaign")
+	a.So(err, should.BeNil)
+	a.So(devs, should.Resemble, []*ttnpb.RekeyCampaignDevice{
+		createdDevices[1], createdDevices[0], createdDevices[2],
+	})
+
+	// Pending end devices are indexed in the order in which they were added.
+	pendingIDs, err := reg.DeviceIDs(ctx, appIDs, "test-campaign", ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING, 1)
+	a.So(err, should.BeNil)
+	a.So(pendingIDs, should.Resemble, []string{"test-dev-2"})
+	pendingIDs, err = reg.DeviceIDs(ctx, appIDs, "test-campaign", ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING, 0)
+	a.So(err, should.BeNil)
+	a.So(pendingIDs, should.Resemble, []string{"test-dev-2", "test-dev-1"})
+	_, err = reg.DeviceIDs(ctx, appIDs, "test-campaign", ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED, 0)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	triggeredAt := timestamppb.Now()
+	updated, err := reg.UpdateDevices(ctx, appIDs, "test-campaign", []string{"test-dev-1", "unknown-dev"},
+		func(stored *ttnpb.RekeyCampaign, ds []*ttnpb.RekeyCampaignDevice) error {
+			a.So(stored.Devices, should.BeEmpty)
+			if !a.So(ds, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			ds[0].State = ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED
+			ds[0].TriggeredAt = triggeredAt
+			stored.Summary = &ttnpb.RekeyCampaignSummary{Pending: 1, Triggered: 1, Skipped: 1}
+			return nil
+		},
+	)
+	a.So(err, should.BeNil)
+	a.So(updated.Summary, should.Resemble, &ttnpb.RekeyCampaignSummary{Pending: 1, Triggered: 1, Skipped: 1})
+	pendingIDs, err = reg.DeviceIDs(ctx, appIDs, "test-campaign", ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING, 0)
+	a.So(err, should.BeNil)
+	a.So(pendingIDs, should.Resemble, []string{"test-dev-2"})
+	triggeredIDs, err := reg.DeviceIDs(
+		ctx, appIDs, "test-campaign", ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED, 0,
+	)
+	a.So(err, should.BeNil)
+	a.So(triggeredIDs, should.Resemble, []string{"test-dev-1"})
+	devs, err = reg.Devices(ctx, appIDs, "test-campaign")
+	a.So(err, should.BeNil)
+	if a.So(devs, should.HaveLength, 3) {
+		a.So(devs[0].TriggeredAt, should.Resemble, triggeredAt)
+	}
+	created.UpdatedAt = updated.UpdatedAt
+	created.Summary = updated.Summary
+
+	// The processing of the campaign can be claimed once within the TTL.
+	claimed, err := reg.Claim(ctx, appIDs, "test-campaign", time.Hour)
+	a.So(err, should.BeNil)
+	a.So(claimed, should.BeTrue)
+	claimed, err = reg.Claim(ctx, appIDs, "test-campaign", time.Hour)
+	a.So(err, should.BeNil)
+	a.So(claimed, should.BeFalse)
+
+	updated, err = reg.Set(ctx, appIDs, "test-campaign", func(stored *ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+		a.So(stored, should.Resemble, created)
+		stored.State = ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_CANCELLED
+		return stored, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(updated.CreatedAt, should.Resemble, created.CreatedAt)
+	a.So(running(), should.BeEmpty)
+
+	// The identifiers cannot be changed.
+	_, err = reg.Set(ctx, appIDs, "test-campaign", func(stored *ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+		stored.CampaignId = "other-campaign"
+		return stored, nil
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	deleted, err := reg.Set(ctx, appIDs, "test-campaign", func(*ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error) {
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(deleted, should.BeNil)
+
+	_, err = reg.Get(ctx, appIDs, "test-campaign")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	devs, err = reg.Devices(ctx, appIDs, "test-campaign")
+	a.So(err, should.BeNil)
+	a.So(devs, should.BeEmpty)
+	campaigns, err := reg.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(campaigns, should.BeEmpty)
+}
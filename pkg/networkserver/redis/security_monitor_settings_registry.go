@@ -0,0 +1,134 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SecurityMonitorSettingsRegistry is an implementation of networkserver.SecurityMonitorSettingsRegistry.
+type SecurityMonitorSettingsRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the SecurityMonitorSettingsRegistry.
+func (r *SecurityMonitorSettingsRegistry) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *SecurityMonitorSettingsRegistry) uidKey(appUID string) string {
+	return r.Redis.Key("uid", appUID)
+}
+
+// Get implements networkserver.SecurityMonitorSettingsRegistry.
+func (r *SecurityMonitorSettingsRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.SecurityMonitorSettings, error) {
+	defer trace.StartRegion(ctx, "get security monitor settings").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.SecurityMonitorSettings{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set implements networkserver.SecurityMonitorSettingsRegistry.
+func (r *SecurityMonitorSettingsRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	f func(*ttnpb.SecurityMonitorSettings) (*ttnpb.SecurityMonitorSettings, error),
+) (*ttnpb.SecurityMonitorSettings, error) {
+	defer trace.StartRegion(ctx, "set security monitor settings").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uk := r.uidKey(unique.ID(ctx, ids))
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.SecurityMonitorSettings
+	err = ttnredis.LockedWatch(ctx, r.Redis, uk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored := &ttnpb.SecurityMonitorSettings{}
+		if err := ttnredis.GetProto(ctx, tx, uk).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var createdAt *timestamppb.Timestamp
+		if stored != nil {
+			createdAt = stored.CreatedAt
+		}
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, uk)
+				return nil
+			}
+		} else {
+			if pb.GetApplicationIds().GetApplicationId() != ids.ApplicationId {
+				return errInvalidIdentifiers.New()
+			}
+			pb.UpdatedAt = timestamppb.Now()
+			pb.CreatedAt = createdAt
+			if pb.CreatedAt == nil {
+				pb.CreatedAt = pb.UpdatedAt
+			}
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(ctx, p, uk, pb, 0)
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
@@ -0,0 +1,235 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"runtime/trace"
+	"strconv"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	// maxSecurityAnomalyGateways is the maximum number of gateways that are stored per potential anomaly.
+	maxSecurityAnomalyGateways = 100
+
+	positionAtField        = "at"
+	positionGatewayIDField = "gateway_id"
+	positionLocationField  = "location"
+)
+
+// SecurityMonitorStateRegistry is an implementation of networkserver.SecurityMonitorStateRegistry.
+// The occurrences of each potential anomaly of an end device are stored in a sorted set scored by time, and the
+// gateways that received them in a set. Both expire after the window. The positions and the gateways of accepted
+// join-requests expire after the TTL.
+type SecurityMonitorStateRegistry struct {
+	Redis *ttnredis.Client
+	TTL   time.Duration
+}
+
+func (r *SecurityMonitorStateRegistry) occurrencesKey(uid string, t ttnpb.SecurityAnomalyType) string {
+	return r.Redis.Key("uid", uid, "occurrences", t.String())
+}
+
+func (r *SecurityMonitorStateRegistry) occurrenceGatewaysKey(uid string, t ttnpb.SecurityAnomalyType) string {
+	return r.Redis.Key("uid", uid, "occurrences", t.String(), "gateways")
+}
+
+func (r *SecurityMonitorStateRegistry) positionKey(uid string) string {
+	return r.Redis.Key("uid", uid, "position")
+}
+
+func (r *SecurityMonitorStateRegistry) joinGatewaysKey(uid string) string {
+	return r.Redis.Key("uid", uid, "join", "gateways")
+}
+
+// countScript records an occurrence and returns the number of occurrences within the window. If the threshold is
+// reached, it also returns the gateways and clears the occurrences.
+// KEYS[1] is the sorted set of occurrences and KEYS[2] the set of gateways.
+// ARGV is the time of the occurrence, the start of the window, the threshold, the window in milliseconds, the maximum
+// number of gateways, the member of the occurrence and the gateway identifiers.
+var countScript = redis.NewScript(`redis.call('zremrangebyscore', KEYS[1], '-inf', ARGV[2])
+if redis.call('zcard', KEYS[1]) == 0 then
+	redis.call('del', KEYS[2])
+end
+redis.call('zadd', KEYS[1], ARGV[1], ARGV[6])
+for i = 7, #ARGV do
+	if redis.call('scard', KEYS[2]) >= tonumber(ARGV[5]) then
+		break
+	end
+	redis.call('sadd', KEYS[2], ARGV[i])
+end
+local n = redis.call('zcard', KEYS[1])
+if n < tonumber(ARGV[3]) then
+	redis.call('pexpire', KEYS[1], ARGV[4])
+	redis.call('pexpire', KEYS[2], ARGV[4])
+	return { n }
+end
+local gtws = redis.call('smembers', KEYS[2])
+redis.call('del', KEYS[1], KEYS[2])
+return { n, gtws }`)
+
+// Count implements networkserver.SecurityMonitorStateRegistry.
+func (r *SecurityMonitorStateRegistry) Count(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	t ttnpb.SecurityAnomalyType,
+	now time.Time,
+	window time.Duration,
+	threshold uint32,
+	gatewayIDs ...string,
+) (uint32, []string, bool, error) {
+	defer trace.StartRegion(ctx, "count security anomaly occurrence").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return 0, nil, false, err
+	}
+	member, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return 0, nil, false, err
+	}
+	uid := unique.ID(ctx, ids)
+	args := make([]any, 0, 6+len(gatewayIDs))
+	args = append(args,
+		now.UnixMilli(),
+		now.Add(-window).UnixMilli(),
+		threshold,
+		window.Milliseconds(),
+		maxSecurityAnomalyGateways,
+		member.String(),
+	)
+	for _, id := range gatewayIDs {
+		args = append(args, id)
+	}
+	res, err := countScript.Run(ctx, r.Redis,
+		[]string{r.occurrencesKey(uid, t), r.occurrenceGatewaysKey(uid, t)},
+		args...,
+	).Slice()
+	if err != nil {
+		return 0, nil, false, ttnredis.ConvertError(err)
+	}
+	n := uint32(res[0].(int64))
+	if len(res) < 2 {
+		return 0, nil, false, nil
+	}
+	vs := res[1].([]any)
+	gtwIDs := make([]string, 0, len(vs))
+	for _, v := range vs {
+		gtwIDs = append(gtwIDs, v.(string))
+	}
+	return n, gtwIDs, true, nil
+}
+
+// SwapPosition implements networkserver.SecurityMonitorStateRegistry.
+func (r *SecurityMonitorStateRegistry) SwapPosition(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, pos *networkserver.SecurityPosition,
+) (*networkserver.SecurityPosition, error) {
+	defer trace.StartRegion(ctx, "swap security position").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	loc, err := ttnredis.MarshalProto(pos.Location)
+	if err != nil {
+		return nil, err
+	}
+	k := r.positionKey(unique.ID(ctx, ids))
+	var getCmd *redis.MapStringStringCmd
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		getCmd = p.HGetAll(ctx, k)
+		p.Del(ctx, k)
+		p.HSet(ctx, k,
+			positionAtField, pos.At.UnixNano(),
+			positionGatewayIDField, pos.GatewayID,
+			positionLocationField, loc,
+		)
+		p.PExpire(ctx, k, r.TTL)
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	fields := getCmd.Val()
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	at, err := strconv.ParseInt(fields[positionAtField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	prev := &networkserver.SecurityPosition{
+		At:        time.Unix(0, at),
+		GatewayID: fields[positionGatewayIDField],
+		Location:  &ttnpb.Location{},
+	}
+	if err := ttnredis.UnmarshalProto(fields[positionLocationField], prev.Location); err != nil {
+		return nil, err
+	}
+	return prev, nil
+}
+
+// SetJoinGateways implements networkserver.SecurityMonitorStateRegistry.
+func (r *SecurityMonitorStateRegistry) SetJoinGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, gatewayIDs ...string,
+) error {
+	defer trace.StartRegion(ctx, "set join gateways").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	k := r.joinGatewaysKey(unique.ID(ctx, ids))
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Del(ctx, k)
+		if len(gatewayIDs) == 0 {
+			return nil
+		}
+		members := make([]any, 0, len(gatewayIDs))
+		for _, id := range gatewayIDs {
+			members = append(members, id)
+		}
+		p.SAdd(ctx, k, members...)
+		p.PExpire(ctx, k, r.TTL)
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// GetJoinGateways implements networkserver.SecurityMonitorStateRegistry.
+func (r *SecurityMonitorStateRegistry) GetJoinGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) ([]string, error) {
+	defer trace.StartRegion(ctx, "get join gateways").End()
+
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	gatewayIDs, err := r.Redis.SMembers(ctx, r.joinGatewaysKey(unique.ID(ctx, ids))).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(gatewayIDs) == 0 {
+		return nil, nil
+	}
+	return gatewayIDs, nil
+}
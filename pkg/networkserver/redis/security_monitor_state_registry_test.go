@@ -0,0 +1,154 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.SecurityMonitorStateRegistry = &SecurityMonitorStateRegistry{}
+
+func TestSecurityMonitorStateRegistry(t *testing.T) {
+	t.Parallel()
+	_, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "security-monitor-state")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+	reg := &SecurityMonitorStateRegistry{
+		Redis: cl,
+		TTL:   time.Hour,
+	}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	otherIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "other-dev",
+	}
+	joinFlood := ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD
+	now := time.Now()
+
+	t.Run("Count", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		_, _, ok, err := reg.Count(ctx, ids, joinFlood, now, time.Minute, 3, "gtw-1")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+
+		// Occurrences of other end devices and anomaly types are counted separately.
+		_, _, ok, err = reg.Count(ctx, otherIDs, joinFlood, now, time.Minute, 2, "gtw-1")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+		_, _, ok, err = reg.Count(
+			ctx, ids, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES, now, time.Minute, 2, "gtw-1",
+		)
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+
+		_, _, ok, err = reg.Count(ctx, ids, joinFlood, now.Add(time.Second), time.Minute, 3, "gtw-1", "gtw-2")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+
+		n, gtwIDs, ok, err := reg.Count(ctx, ids, joinFlood, now.Add(2*time.Second), time.Minute, 3, "gtw-3")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeTrue)
+		a.So(n, should.Equal, 3)
+		sort.Strings(gtwIDs)
+		a.So(gtwIDs, should.Resemble, []string{"gtw-1", "gtw-2", "gtw-3"})
+
+		// The occurrences are cleared when the threshold is reached.
+		_, _, ok, err = reg.Count(ctx, ids, joinFlood, now.Add(3*time.Second), time.Minute, 3, "gtw-1")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+		_, _, ok, err = reg.Count(ctx, ids, joinFlood, now.Add(4*time.Second), time.Minute, 3, "gtw-1")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+
+		// The occurrences leave the window, which also clears the gateways.
+		_, _, ok, err = reg.Count(ctx, ids, joinFlood, now.Add(time.Hour), time.Minute, 3, "gtw-4")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+		_, _, ok, err = reg.Count(ctx, ids, joinFlood, now.Add(time.Hour+time.Second), time.Minute, 3, "gtw-4")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+		n, gtwIDs, ok, err = reg.Count(ctx, ids, joinFlood, now.Add(time.Hour+2*time.Second), time.Minute, 3)
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeTrue)
+		a.So(n, should.Equal, 3)
+		a.So(gtwIDs, should.Resemble, []string{"gtw-4"})
+	})
+
+	t.Run("SwapPosition", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		first := &networkserver.SecurityPosition{
+			At:        now.Truncate(time.Millisecond),
+			GatewayID: "gtw-ams",
+			Location:  &ttnpb.Location{Latitude: 52.3676, Longitude: 4.9041},
+		}
+		prev, err := reg.SwapPosition(ctx, ids, first)
+		a.So(err, should.BeNil)
+		a.So(prev, should.BeNil)
+
+		second := &networkserver.SecurityPosition{
+			At:        now.Add(time.Minute),
+			GatewayID: "gtw-par",
+			Location:  &ttnpb.Location{Latitude: 48.8566, Longitude: 2.3522},
+		}
+		prev, err = reg.SwapPosition(ctx, ids, second)
+		if a.So(err, should.BeNil) && a.So(prev, should.NotBeNil) {
+			a.So(prev.At.Equal(first.At), should.BeTrue)
+			a.So(prev.GatewayID, should.Equal, first.GatewayID)
+			a.So(prev.Location, should.Resemble, first.Location)
+		}
+	})
+
+	t.Run("JoinGateways", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		gtwIDs, err := reg.GetJoinGateways(ctx, ids)
+		a.So(err, should.BeNil)
+		a.So(gtwIDs, should.BeNil)
+
+		a.So(reg.SetJoinGateways(ctx, ids, "gtw-1", "gtw-2"), should.BeNil)
+		gtwIDs, err = reg.GetJoinGateways(ctx, ids)
+		a.So(err, should.BeNil)
+		sort.Strings(gtwIDs)
+		a.So(gtwIDs, should.Resemble, []string{"gtw-1", "gtw-2"})
+
+		a.So(reg.SetJoinGateways(ctx, ids, "gtw-3"), should.BeNil)
+		gtwIDs, err = reg.GetJoinGateways(ctx, ids)
+		a.So(err, should.BeNil)
+		a.So(gtwIDs, should.Resemble, []string{"gtw-3"})
+
+		a.So(reg.SetJoinGateways(ctx, ids), should.BeNil)
+		gtwIDs, err = reg.GetJoinGateways(ctx, ids)
+		a.So(err, should.BeNil)
+		a.So(gtwIDs, should.BeNil)
+	})
+}
@@ -0,0 +1,536 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	evtTriggerRekey = events.Define(
+		"ns.rekey.trigger", "trigger end device to rejoin",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.RekeyCampaignDevice{}),
+	)
+	evtTriggerRekeyFail = events.Define(
+		"ns.rekey.trigger.fail", "trigger end device to rejoin",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithErrorDataType(),
+	)
+	evtRekeyRejoin = events.Define(
+		"ns.rekey.rejoin", "end device rejoined",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.RekeyCampaignDevice{}),
+	)
+	evtRekeyTimeout = events.Define(
+		"ns.rekey.timeout", "end device did not rejoin in time",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.RekeyCampaignDevice{}),
+	)
+)
+
+var (
+	errRekeyMulticast = errors.DefineFailedPrecondition(
+		"rekey_multicast", "multicast end device cannot rejoin",
+	)
+	errRekeyABP = errors.DefineFailedPrecondition(
+		"rekey_abp", "ABP end device cannot rejoin",
+	)
+	errRekeyNoSession = errors.DefineFailedPrecondition(
+		"rekey_no_session", "end device has no session",
+	)
+	errTriggerRekey      = errors.Define("trigger_rekey", "trigger end device to rejoin")
+	errRekeyNoAppCommand = errors.DefineFailedPrecondition(
+		"rekey_no_app_command", "no application command to trigger LoRaWAN `{version}` end device to rejoin",
+	)
+)
+
+const (
+	// maxRekeyCampaignDevices is the maximum number of end devices of a rekey campaign.
+	maxRekeyCampaignDevices = 10000
+	// rekeyCampaignBatchSize is the number of end devices that are read from the device registry at once.
+	rekeyCampaignBatchSize = 100
+)
+
+// defaultRekeyForceRejoinReq is the ForceRejoinReq sent to LoRaWAN 1.1 end devices if the campaign does not
+// specify one.
+var defaultRekeyForceRejoinReq = &ttnpb.MACCommand_ForceRejoinReq{
+	RejoinType:     ttnpb.RejoinRequestType_KEYS,
+	DataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_0,
+	MaxRetries:     3,
+	PeriodExponent: ttnpb.RejoinPeriodExponent_REJOIN_PERIOD_0,
+}
+
+// rekeyCampaignDevicePaths are the paths of the end devices that are read when selecting and processing
+// the end devices of a rekey campaign.
+var rekeyCampaignDevicePaths = []string{
+	"ids",
+	"lorawan_version",
+	"multicast",
+	"session.dev_addr",
+	"session.keys.session_key_id",
+	"session.started_at",
+	"supports_join",
+}
+
+// RekeyCampaignRegistry is a registry, containing session rekey campaigns.
+// The end devices of a campaign are stored separately from the campaign, so that processing the campaign only reads
+// and writes the end devices that are pending or triggered.
+type RekeyCampaignRegistry interface {
+	// Get returns the campaign identified by campaignID of the application identified by ids.
+	// The end devices of the campaign are not included.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string) (*ttnpb.RekeyCampaign, error)
+	// List returns the campaigns of the application identified by ids. The end devices are not included.
+	List(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) ([]*ttnpb.RekeyCampaign, error)
+	// RangeRunning calls f for each running campaign, until f returns false.
+	RangeRunning(ctx context.Context, f func(context.Context, *ttnpb.ApplicationIdentifiers, string) bool) error
+	// Claim claims the processing of the campaign identified by campaignID of the application identified by ids
+	// for ttl. It returns false if the processing is claimed by another Network Server.
+	Claim(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string, ttl time.Duration) (bool, error)
+	// Set creates, updates or deletes the campaign identified by campaignID of the application identified by ids.
+	// The stored campaign is nil if it does not exist, and does not include the end devices.
+	// The end devices returned by f are only stored when the campaign is created. The campaign and its end devices
+	// are deleted if f returns nil.
+	Set(
+		ctx context.Context,
+		ids *ttnpb.ApplicationIdentifiers,
+		campaignID string,
+		f func(*ttnpb.RekeyCampaign) (*ttnpb.RekeyCampaign, error),
+	) (*ttnpb.RekeyCampaign, error)
+	// Devices returns the end devices of the campaign identified by campaignID of the application identified by ids.
+	Devices(
+		ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string,
+	) ([]*ttnpb.RekeyCampaignDevice, error)
+	// DeviceIDs returns the IDs of at most limit end devices in the given state of the campaign identified by
+	// campaignID of the application identified by ids. All end devices in the state are returned if limit is 0.
+	// The state must be pending or triggered. Pending end devices are ordered as they were added to the campaign,
+	// triggered end devices by the time they were triggered.
+	DeviceIDs(
+		ctx context.Context,
+		ids *ttnpb.ApplicationIdentifiers,
+		campaignID string,
+		state ttnpb.RekeyCampaignDeviceState,
+		limit int,
+	) ([]string, error)
+	// UpdateDevices calls f with the stored campaign identified by campaignID of the application identified by ids
+	// and its stored end devices with the given device IDs. End devices that do not exist are omitted.
+	// f may update the campaign and the end devices in place. Only the end devices that changed are written.
+	UpdateDevices(
+		ctx context.Context,
+		ids *ttnpb.ApplicationIdentifiers,
+		campaignID string,
+		deviceIDs []string,
+		f func(*ttnpb.RekeyCampaign, []*ttnpb.RekeyCampaignDevice) error,
+	) (*ttnpb.RekeyCampaign, error)
+}
+
+func rekeyErrorDetails(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errTriggerRekey.WithCause(err))
+}
+
+// newRekeyCampaignDevice returns the status of the end device dev at the creation of the campaign c.
+// It returns false if the end device is not selected by the DevAddr prefixes of the campaign.
+func newRekeyCampaignDevice(c *ttnpb.RekeyCampaign, dev *ttnpb.EndDevice) (*ttnpb.RekeyCampaignDevice, bool) {
+	if prefixes := c.Selector.GetDevAddrPrefixes(); len(prefixes) > 0 {
+		if dev.Session == nil {
+			return nil, false
+		}
+		devAddr := types.MustDevAddr(dev.Session.DevAddr).OrZero()
+		var matched bool
+		for _, p := range prefixes {
+			prefix := types.DevAddrPrefix{
+				DevAddr: types.MustDevAddr(p.DevAddr).OrZero(),
+				Length:  uint8(p.Length),
+			}
+			if matched = devAddr.HasPrefix(prefix); matched {
+				break
+			}
+		}
+		if !matched {
+			return nil, false
+		}
+	}
+	d := &ttnpb.RekeyCampaignDevice{
+		DeviceId:       dev.Ids.DeviceId,
+		LorawanVersion: dev.LorawanVersion,
+	}
+	var err error
+	switch {
+	case dev.Multicast:
+		err = errRekeyMulticast.New()
+	case !dev.SupportsJoin:
+		err = errRekeyABP.New()
+	case dev.Session == nil:
+		err = errRekeyNoSession.New()
+	case !macspec.UseForceRejoinReq(dev.LorawanVersion) && c.AppCommand == nil:
+		err = errRekeyNoAppCommand.WithAttributes("version", dev.LorawanVersion)
+	}
+	if err != nil {
+		d.State = ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED
+		d.Error = rekeyErrorDetails(err)
+	}
+	return d, true
+}
+
+// rekeyCampaignSummaryCount returns the counter of the end devices in state in the summary s.
+func rekeyCampaignSummaryCount(s *ttnpb.RekeyCampaignSummary, state ttnpb.RekeyCampaignDeviceState) *uint32 {
+	switch state {
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING:
+		return &s.Pending
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED:
+		return &s.Triggered
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_REJOINED:
+		return &s.Rejoined
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TIMED_OUT:
+		return &s.TimedOut
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED:
+		return &s.Skipped
+	case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_FAILED:
+		return &s.Failed
+	default:
+		panic(fmt.Sprintf("invalid rekey campaign device state: %s", state))
+	}
+}
+
+// rekeyCampaignSummary counts the end devices of the campaign per state.
+func rekeyCampaignSummary(devs ...*ttnpb.RekeyCampaignDevice) *ttnpb.RekeyCampaignSummary {
+	s := &ttnpb.RekeyCampaignSummary{}
+	for _, d := range devs {
+		*rekeyCampaignSummaryCount(s, d.State)++
+	}
+	return s
+}
+
+// setRekeyCampaignDeviceState sets the state of the end device d of the campaign c and updates the summary of c.
+func setRekeyCampaignDeviceState(
+	c *ttnpb.RekeyCampaign, d *ttnpb.RekeyCampaignDevice, state ttnpb.RekeyCampaignDeviceState,
+) {
+	if c.Summary == nil {
+		c.Summary = &ttnpb.RekeyCampaignSummary{}
+	}
+	if n := rekeyCampaignSummaryCount(c.Summary, d.State); *n > 0 {
+		*n--
+	}
+	*rekeyCampaignSummaryCount(c.Summary, state)++
+	d.State = state
+}
+
+// completeRekeyCampaign completes the running campaign c if there are no pending and triggered end devices left.
+func completeRekeyCampaign(c *ttnpb.RekeyCampaign) {
+	if c.State == ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING &&
+		c.Summary.GetPending() == 0 && c.Summary.GetTriggered() == 0 {
+		c.State = ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_COMPLETED
+	}
+}
+
+// rekeyCampaignBudget returns the number of end devices of the campaign c that may be triggered at now.
+// The campaign triggers at most DevicesPerMinute end devices per minute since it was created, and does not
+// trigger more than DevicesPerMinute end devices at once. End devices that failed to be triggered do not count.
+func rekeyCampaignBudget(c *ttnpb.RekeyCampaign, now time.Time) int {
+	triggered := int(c.Summary.GetTriggered() + c.Summary.GetRejoined() + c.Summary.GetTimedOut())
+	elapsed := now.Sub(*ttnpb.StdTime(c.CreatedAt))
+	budget := int(float64(c.DevicesPerMinute)*elapsed.Minutes()) + 1 - triggered
+	if budget > int(c.DevicesPerMinute) {
+		budget = int(c.DevicesPerMinute)
+	}
+	if budget < 0 {
+		budget = 0
+	}
+	return budget
+}
+
+// rekeyCampaignUpdate is the result of updateRekeyCampaign.
+type rekeyCampaignUpdate struct {
+	// Triggered are the end devices that should be triggered to rejoin.
+	Triggered []*ttnpb.RekeyCampaignDevice
+	// Rejoined are the end devices that rejoined.
+	Rejoined []*ttnpb.RekeyCampaignDevice
+	// TimedOut are the end devices that did not rejoin in time.
+	TimedOut []*ttnpb.RekeyCampaignDevice
+}
+
+// updateRekeyCampaign updates the state of the end devices ds of the running campaign c at now.
+// devs contains the end devices that are read from the device registry, by device ID. A nil end device is deleted.
+// Triggered end devices of which the session changed are rejoined, or timed out after the rejoin timeout.
+// Pending end devices in devs are triggered within the budget of the campaign.
+func updateRekeyCampaign(
+	c *ttnpb.RekeyCampaign, ds []*ttnpb.RekeyCampaignDevice, devs map[string]*ttnpb.EndDevice, now time.Time,
+) rekeyCampaignUpdate {
+	var (
+		res     rekeyCampaignUpdate
+		budget  = rekeyCampaignBudget(c, now)
+		timeout = ttnpb.StdDurationOrZero(c.RejoinTimeout)
+	)
+	for _, d := range ds {
+		dev, ok := devs[d.DeviceId]
+		switch d.State {
+		case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED:
+			switch {
+			case ok && dev == nil:
+				setRekeyCampaignDeviceState(c, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_FAILED)
+				d.Error = rekeyErrorDetails(errDeviceNotFound.New())
+
+			case ok && dev.Session != nil && !bytes.Equal(dev.Session.GetKeys().GetSessionKeyId(), d.SessionKeyId):
+				setRekeyCampaignDeviceState(c, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_REJOINED)
+				d.RejoinedAt = dev.Session.StartedAt
+				if d.RejoinedAt == nil {
+					d.RejoinedAt = timestamppb.New(now)
+				}
+				res.Rejoined = append(res.Rejoined, d)
+
+			case !now.Before(ttnpb.StdTime(d.TriggeredAt).Add(timeout)):
+				setRekeyCampaignDeviceState(c, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TIMED_OUT)
+				res.TimedOut = append(res.TimedOut, d)
+			}
+
+		case ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING:
+			switch {
+			case !ok || budget == 0:
+			case dev == nil:
+				setRekeyCampaignDeviceState(c, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_FAILED)
+				d.Error = rekeyErrorDetails(errDeviceNotFound.New())
+			case dev.Session == nil:
+				setRekeyCampaignDeviceState(c, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED)
+				d.Error = rekeyErrorDetails(errRekeyNoSession.New())
+			default:
+				budget--
+				setRekeyCampaignDeviceState(c, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED)
+				d.TriggeredAt = timestamppb.New(now)
+				d.SessionKeyId = dev.Session.GetKeys().GetSessionKeyId()
+				res.Triggered = append(res.Triggered, d)
+			}
+		}
+	}
+	completeRekeyCampaign(c)
+	return res
+}
+
+// rekeyCampaignDevices reads the end devices with the given IDs from the device registry.
+// The end devices that do not exist are nil.
+func (ns *NetworkServer) rekeyCampaignDevices(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string,
+) (map[string]*ttnpb.EndDevice, error) {
+	devs := make(map[string]*ttnpb.EndDevice, len(deviceIDs))
+	for len(deviceIDs) > 0 {
+		n := len(deviceIDs)
+		if n > rekeyCampaignBatchSize {
+			n = rekeyCampaignBatchSize
+		}
+		batch, err := ns.devices.BatchGetByID(ctx, ids, deviceIDs[:n], rekeyCampaignDevicePaths)
+		if err != nil {
+			return nil, err
+		}
+		for i, dev := range batch {
+			devs[deviceIDs[i]] = dev
+		}
+		deviceIDs = deviceIDs[n:]
+	}
+	return devs, nil
+}
+
+// triggerRekey triggers the end device d of the campaign c to rejoin.
+// LoRaWAN 1.1 end devices are sent a ForceRejoinReq. LoRaWAN 1.0.x end devices are sent the application command of
+// the campaign, via the Application Server.
+func (ns *NetworkServer) triggerRekey(ctx context.Context, c *ttnpb.RekeyCampaign, d *ttnpb.RekeyCampaignDevice) error {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: c.ApplicationIds,
+		DeviceId:       d.DeviceId,
+	}
+	if !macspec.UseForceRejoinReq(d.LorawanVersion) {
+		if c.AppCommand == nil {
+			return errRekeyNoAppCommand.WithAttributes("version", d.LorawanVersion)
+		}
+		conn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		_, err = ttnpb.NewNsAsClient(conn).PushDownlinks(ctx, &ttnpb.NsAsPushDownlinksRequest{
+			EndDeviceIds: ids,
+			Downlinks:    []*ttnpb.ApplicationDownlink{ttnpb.Clone(c.AppCommand)},
+		}, ns.WithClusterAuth())
+		return err
+	}
+	req := c.ForceRejoinReq
+	if req == nil {
+		req = defaultRekeyForceRejoinReq
+	}
+	dev, ctx, err := ns.devices.SetByID(ctx, c.ApplicationIds, d.DeviceId, deviceDownlinkFullPaths[:],
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			switch {
+			case stored == nil:
+				return nil, nil, errDeviceNotFound.New()
+			case stored.MacState == nil:
+				return nil, nil, errUnknownMACState.New()
+			}
+			stored.MacState.QueuedForceRejoinReq = ttnpb.Clone(req)
+			return stored, []string{
+				"mac_state.queued_force_rejoin_req",
+			}, nil
+		})
+	if err != nil {
+		return err
+	}
+	if err := ns.updateDataDownlinkTask(ctx, dev, time.Time{}); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after ForceRejoinReq enqueue")
+	}
+	return nil
+}
+
+// processRekeyCampaign processes the running campaign identified by campaignID of the application identified by ids.
+func (ns *NetworkServer) processRekeyCampaign(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string,
+) error {
+	c, err := ns.rekeyCampaigns.Get(ctx, ids, campaignID)
+	if err != nil {
+		return err
+	}
+	if c.State != ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING {
+		return nil
+	}
+
+	// NOTE: Only the triggered end devices and the pending end devices within the budget are read, so that the end
+	// devices that rejoined, timed out, failed or were skipped are not read again. The end devices are read outside of
+	// the transaction of the campaign, so the pending end devices that are read may exceed the budget when the
+	// campaign is updated.
+	now := time.Now()
+	deviceIDs, err := ns.rekeyCampaigns.DeviceIDs(
+		ctx, ids, campaignID, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED, 0,
+	)
+	if err != nil {
+		return err
+	}
+	if budget := rekeyCampaignBudget(c, now); budget > 0 {
+		pendingIDs, err := ns.rekeyCampaigns.DeviceIDs(
+			ctx, ids, campaignID, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING, budget,
+		)
+		if err != nil {
+			return err
+		}
+		deviceIDs = append(deviceIDs, pendingIDs...)
+	}
+	devs, err := ns.rekeyCampaignDevices(ctx, ids, deviceIDs)
+	if err != nil {
+		return err
+	}
+
+	var upd rekeyCampaignUpdate
+	c, err = ns.rekeyCampaigns.UpdateDevices(ctx, ids, campaignID, deviceIDs,
+		func(stored *ttnpb.RekeyCampaign, ds []*ttnpb.RekeyCampaignDevice) error {
+			if stored.State != ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING {
+				upd = rekeyCampaignUpdate{}
+				return nil
+			}
+			upd = updateRekeyCampaign(stored, ds, devs, now)
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	devIDs := func(d *ttnpb.RekeyCampaignDevice) *ttnpb.EndDeviceIdentifiers {
+		return &ttnpb.EndDeviceIdentifiers{ApplicationIds: ids, DeviceId: d.DeviceId}
+	}
+	var evs []events.Event
+	for _, d := range upd.Rejoined {
+		evs = append(evs, evtRekeyRejoin.NewWithIdentifiersAndData(ctx, devIDs(d), d))
+	}
+	for _, d := range upd.TimedOut {
+		evs = append(evs, evtRekeyTimeout.NewWithIdentifiersAndData(ctx, devIDs(d), d))
+	}
+	failed := make(map[string]error)
+	for _, d := range upd.Triggered {
+		if err := ns.triggerRekey(ctx, c, d); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_id", d.DeviceId).Warn(
+				"Failed to trigger end device to rejoin",
+			)
+			failed[d.DeviceId] = err
+			evs = append(evs, evtTriggerRekeyFail.NewWithIdentifiersAndData(ctx, devIDs(d), err))
+			continue
+		}
+		evs = append(evs, evtTriggerRekey.NewWithIdentifiersAndData(ctx, devIDs(d), d))
+	}
+	publishEvents(ctx, evs...)
+	if len(failed) == 0 {
+		return nil
+	}
+	failedIDs := make([]string, 0, len(failed))
+	for devID := range failed {
+		failedIDs = append(failedIDs, devID)
+	}
+	_, err = ns.rekeyCampaigns.UpdateDevices(ctx, ids, campaignID, failedIDs,
+		func(stored *ttnpb.RekeyCampaign, ds []*ttnpb.RekeyCampaignDevice) error {
+			for _, d := range ds {
+				if d.State != ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED {
+					continue
+				}
+				setRekeyCampaignDeviceState(stored, d, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_FAILED)
+				d.Error = rekeyErrorDetails(failed[d.DeviceId])
+			}
+			completeRekeyCampaign(stored)
+			return nil
+		},
+	)
+	return err
+}
+
+// processRekeyCampaigns processes the running rekey campaigns every interval, until ctx is done.
+// Each campaign is claimed for the interval before it is processed, so that a campaign is processed by only one
+// Network Server in the cluster.
+func (ns *NetworkServer) processRekeyCampaigns(ctx context.Context, interval time.Duration) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		if err := ns.rekeyCampaigns.RangeRunning(ctx,
+			func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, campaignID string) bool {
+				logger := log.FromContext(ctx).WithFields(log.Fields(
+					"application_id", ids.ApplicationId,
+					"campaign_id", campaignID,
+				))
+				claimed, err := ns.rekeyCampaigns.Claim(ctx, ids, campaignID, interval)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to claim rekey campaign")
+					return true
+				}
+				if !claimed {
+					return true
+				}
+				if err := ns.processRekeyCampaign(ctx, ids, campaignID); err != nil {
+					logger.WithError(err).Warn("Failed to process rekey campaign")
+				}
+				return true
+			},
+		); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to range rekey campaigns")
+		}
+	}
+}
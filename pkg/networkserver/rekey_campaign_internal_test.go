@@ -0,0 +1,285 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewRekeyCampaignDevice(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	newDevice := func(version ttnpb.MACVersion) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			Ids:            &ttnpb.EndDeviceIdentifiers{DeviceId: "test-dev"},
+			LorawanVersion: version,
+			SupportsJoin:   true,
+			Session: &ttnpb.Session{
+				DevAddr: []byte{0x26, 0x01, 0x02, 0x03},
+			},
+		}
+	}
+	c := &ttnpb.RekeyCampaign{Selector: &ttnpb.RekeyCampaign_Selector{}}
+
+	d, ok := newRekeyCampaignDevice(c, newDevice(ttnpb.MACVersion_MAC_V1_1))
+	a.So(ok, should.BeTrue)
+	a.So(d.State, should.Equal, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_PENDING)
+	a.So(d.Error, should.BeNil)
+
+	d, ok = newRekeyCampaignDevice(c, newDevice(ttnpb.MACVersion_MAC_V1_0_3))
+	a.So(ok, should.BeTrue)
+	a.So(d.State, should.Equal, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED)
+	a.So(d.Error.GetName(), should.Equal, "rekey_no_app_command")
+
+	abp := newDevice(ttnpb.MACVersion_MAC_V1_1)
+	abp.SupportsJoin = false
+	d, ok = newRekeyCampaignDevice(c, abp)
+	a.So(ok, should.BeTrue)
+	a.So(d.State, should.Equal, ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED)
+	a.So(d.Error.GetName(), should.Equal, "rekey_abp")
+
+	c.Selector.DevAddrPrefixes = []*ttnpb.DevAddrPrefix{
+		{DevAddr: []byte{0x26, 0x00, 0x00, 0x00}, Length: 8},
+	}
+	_, ok = newRekeyCampaignDevice(c, newDevice(ttnpb.MACVersion_MAC_V1_1))
+	a.So(ok, should.BeTrue)
+
+	c.Selector.DevAddrPrefixes[0].DevAddr = []byte{0x27, 0x00, 0x00, 0x00}
+	_, ok = newRekeyCampaignDevice(c, newDevice(ttnpb.MACVersion_MAC_V1_1))
+	a.So(ok, should.BeFalse)
+}
+
+func TestUpdateRekeyCampaign(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	createdAt := time.Date(2024, 3, 14, 15, 0, 0, 0, time.UTC)
+	triggeredAt := createdAt.Add(time.Minute)
+	ds := []*ttnpb.RekeyCampaignDevice{
+		{
+			DeviceId:     "rejoined",
+			State:        ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED,
+			SessionKeyId: []byte{0x01},
+			TriggeredAt:  timestamppb.New(createdAt),
+		},
+		{
+			DeviceId:     "timed-out",
+			State:        ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED,
+			SessionKeyId: []byte{0x02},
+			TriggeredAt:  timestamppb.New(createdAt.Add(-time.Hour)),
+		},
+		{
+			DeviceId:     "waiting",
+			State:        ttnpb.RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED,
+			SessionKeyId: []byte{0x03},
+			TriggeredAt:  timestamppb.New(createdAt),
+		},
+		{DeviceId: "pending-1"},
+		{DeviceId: "pending-2"},
+	}
+	c := &ttnpb.RekeyCampaign{
+		CreatedAt:        timestamppb.New(createdAt),
+		DevicesPerMinute: 1,
+		RejoinTimeout:    durationpb.New(time.Hour),
+		Summary:          rekeyCampaignSummary(ds...),
+	}
+	session := func(id byte) *ttnpb.Session {
+		return &ttnpb.Session{Keys: &ttnpb.SessionKeys{SessionKeyId: []byte{id}}}
+	}
+	devs := map[string]*ttnpb.EndDevice{
+		"rejoined":  {Session: session(0x11)},
+		"timed-out": {Session: session(0x02)},
+		"waiting":   {Session: session(0x03)},
+		"pending-1": {Session: session(0x04)},
+		"pending-2": {Session: session(0x05)},
+	}
+
+	// Three end devices are already triggered, so no pending end device may be triggered after one minute.
+	upd := updateRekeyCampaign(c, ds, devs, triggeredAt)
+	a.So(upd.Rejoined, should.HaveLength, 1)
+	a.So(upd.Rejoined[0].DeviceId, should.Equal, "rejoined")
+	a.So(upd.Rejoined[0].RejoinedAt.AsTime(), should.Equal, triggeredAt)
+	a.So(upd.TimedOut, should.HaveLength, 1)
+	a.So(upd.TimedOut[0].DeviceId, should.Equal, "timed-out")
+	a.So(upd.Triggered, should.BeEmpty)
+	a.So(c.Summary, should.Resemble, &ttnpb.RekeyCampaignSummary{
+		Pending:   2,
+		Triggered: 1,
+		Rejoined:  1,
+		TimedOut:  1,
+	})
+	a.So(c.State, should.Equal, ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_RUNNING)
+
+	upd = updateRekeyCampaign(c, ds, devs, createdAt.Add(4*time.Minute))
+	a.So(upd.Triggered, should.HaveLength, 1)
+	a.So(upd.Triggered[0].DeviceId, should.Equal, "pending-1")
+	a.So(upd.Triggered[0].SessionKeyId, should.Resemble, []byte{0x04})
+
+	devs["waiting"] = nil
+	upd = updateRekeyCampaign(c, ds, devs, createdAt.Add(10*time.Hour))
+	a.So(upd.TimedOut, should.HaveLength, 1)
+	a.So(upd.Triggered, should.HaveLength, 1)
+	a.So(c.Summary, should.Resemble, &ttnpb.RekeyCampaignSummary{
+		Triggered: 1,
+		Rejoined:  1,
+		TimedOut:  2,
+		Failed:    1,
+	})
+
+	updateRekeyCampaign(c, ds, devs, createdAt.Add(20*time.Hour))
+	a.So(c.Summary.Triggered, should.Equal, 0)
+	a.So(c.State, should.Equal, ttnpb.RekeyCampaignState_REKEY_CAMPAIGN_COMPLETED)
+}
+
+func TestTriggerRekeyForceRejoinReq(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{
+			ServiceBase: config.ServiceBase{
+				FrequencyPlans: config.FrequencyPlansConfig{
+					ConfigSource: "static",
+					Static:       test.StaticFrequencyPlans,
+				},
+			},
+		},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	componenttest.StartComponent(t, c)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	stored := &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       "test-dev",
+			DevAddr:        test.DefaultDevAddr.Bytes(),
+		},
+		FrequencyPlanId:   test.EUFrequencyPlanID,
+		LorawanVersion:    ttnpb.MACVersion_MAC_V1_1,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_1_REV_B,
+		MacState: &ttnpb.MACState{
+			CurrentParameters: &ttnpb.MACParameters{},
+			DesiredParameters: &ttnpb.MACParameters{},
+			LorawanVersion:    ttnpb.MACVersion_MAC_V1_1,
+			DeviceClass:       ttnpb.Class_CLASS_C,
+			RecentUplinks: []*ttnpb.MACState_UplinkMessage{{
+				Payload: &ttnpb.Message{
+					MHdr: &ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_UP},
+					Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+						FHdr: &ttnpb.FHDR{FCtrl: &ttnpb.FCtrl{}},
+					}},
+				},
+				Settings:   ToMACStateTxSettings(DefaultTxSettings),
+				RxMetadata: ToMACStateRxMetadata(DefaultRxMetadata[:]),
+				ReceivedAt: timestamppb.New(time.Now().Add(-time.Minute)),
+			}},
+		},
+		Session: &ttnpb.Session{
+			DevAddr: test.DefaultDevAddr.Bytes(),
+			Keys: &ttnpb.SessionKeys{
+				FNwkSIntKey: &ttnpb.KeyEnvelope{Key: test.DefaultFNwkSIntKey.Bytes()},
+				SNwkSIntKey: &ttnpb.KeyEnvelope{Key: test.DefaultSNwkSIntKey.Bytes()},
+				NwkSEncKey:  &ttnpb.KeyEnvelope{Key: test.DefaultNwkSEncKey.Bytes()},
+			},
+		},
+	}
+	var taskAt time.Time
+	ns := &NetworkServer{
+		Component: c,
+		ctx:       ctx,
+		defaultMACSettings: &ttnpb.MACSettings{
+			StatusTimePeriodicity:  durationpb.New(0),
+			StatusCountPeriodicity: &wrapperspb.UInt32Value{Value: 0},
+		},
+		devices: &MockDeviceRegistry{
+			SetByIDFunc: func(
+				ctx context.Context,
+				_ *ttnpb.ApplicationIdentifiers,
+				_ string,
+				_ []string,
+				f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+			) (*ttnpb.EndDevice, context.Context, error) {
+				dev, _, err := f(ctx, ttnpb.Clone(stored))
+				if err != nil {
+					return nil, ctx, err
+				}
+				stored = dev
+				return ttnpb.Clone(dev), ctx, nil
+			},
+		},
+		downlinkTasks: MockDownlinkTaskQueue{
+			AddFunc: func(_ context.Context, ids *ttnpb.EndDeviceIdentifiers, startAt time.Time, _ bool) error {
+				a.So(ids, should.Resemble, stored.Ids)
+				taskAt = startAt
+				return nil
+			},
+		},
+	}
+
+	err := ns.triggerRekey(ctx, &ttnpb.RekeyCampaign{ApplicationIds: appIDs}, &ttnpb.RekeyCampaignDevice{
+		DeviceId:       "test-dev",
+		LorawanVersion: ttnpb.MACVersion_MAC_V1_1,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(stored.MacState.QueuedForceRejoinReq, should.Resemble, defaultRekeyForceRejoinReq)
+	// The downlink task is added, so the ForceRejoinReq is sent without waiting for another downlink.
+	if !a.So(taskAt.IsZero(), should.BeFalse) {
+		t.FailNow()
+	}
+
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	phy, err := DeviceBand(stored, fps)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, _, err = ns.generateDataDownlink(
+		ctx, stored, phy, ttnpb.Class_CLASS_C, taskAt, math.MaxUint16, math.MaxUint16,
+	)
+	if a.So(err, should.BeNil) {
+		a.So(stored.MacState.PendingRequests, should.Resemble, []*ttnpb.MACCommand{
+			defaultRekeyForceRejoinReq.MACCommand(),
+		})
+		a.So(stored.MacState.QueuedForceRejoinReq, should.BeNil)
+	}
+}
@@ -0,0 +1,540 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"math"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	evtSecurityDevNonceReplay = events.Define(
+		"security.join.dev_nonce_replay", "DevNonce replayed",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.SecurityAnomaly{}),
+	)
+	evtSecurityJoinFlood = events.Define(
+		"security.join.flood", "join-request flood",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.SecurityAnomaly{}),
+	)
+	evtSecurityMICFailures = events.Define(
+		"security.uplink.mic_failures", "repeated MIC failures",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.SecurityAnomaly{}),
+	)
+	evtSecurityImpossibleTravel = events.Define(
+		"security.uplink.impossible_travel", "impossible travel",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.SecurityAnomaly{}),
+	)
+	evtSecurityFCntReset = events.Define(
+		"security.uplink.f_cnt_reset", "frame counter reset",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.SecurityAnomaly{}),
+	)
+)
+
+var securityAnomalyEvents = map[ttnpb.SecurityAnomalyType]events.Builder{
+	ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY:  evtSecurityDevNonceReplay,
+	ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD:        evtSecurityJoinFlood,
+	ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES:      evtSecurityMICFailures,
+	ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL: evtSecurityImpossibleTravel,
+	ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET:       evtSecurityFCntReset,
+}
+
+const (
+	// securityAnomalyNotificationType is the type of the notifications of security anomalies.
+	securityAnomalyNotificationType = "security_anomaly"
+	// securityMonitorSettingsTTL is the time for which the security monitor settings of an application are cached.
+	securityMonitorSettingsTTL = time.Minute
+	// maxSecurityAnomalyGateways is the maximum number of gateways reported in a security anomaly.
+	maxSecurityAnomalyGateways = 100
+	// maxSecurityMICFailureCandidates is the maximum number of end devices for which a MIC failure is recorded per
+	// uplink message.
+	maxSecurityMICFailureCandidates = 16
+	// earthRadius is the mean radius of the earth in km.
+	earthRadius = 6371.0
+)
+
+// devNonceTooSmallErrorName is the full name of the error that the Join Server returns for a reused DevNonce.
+const devNonceTooSmallErrorName = "pkg/joinserver:dev_nonce_too_small"
+
+// SecurityMonitorSettingsRegistry is a registry, containing security monitor settings of applications.
+type SecurityMonitorSettingsRegistry interface {
+	// Get returns the security monitor settings of the application identified by ids.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.SecurityMonitorSettings, error)
+	// Set creates, updates or deletes the security monitor settings of the application identified by ids.
+	// The stored settings are nil if they do not exist. The settings are deleted if f returns nil.
+	Set(
+		ctx context.Context,
+		ids *ttnpb.ApplicationIdentifiers,
+		f func(*ttnpb.SecurityMonitorSettings) (*ttnpb.SecurityMonitorSettings, error),
+	) (*ttnpb.SecurityMonitorSettings, error)
+}
+
+// defaultSecurityMonitorSettings returns the security monitor settings of the application identified by ids,
+// with the zero thresholds and windows of settings replaced by the defaults in conf.
+func defaultSecurityMonitorSettings(
+	conf SecurityMonitorConfig, ids *ttnpb.ApplicationIdentifiers, settings *ttnpb.SecurityMonitorSettings,
+) *ttnpb.SecurityMonitorSettings {
+	if settings == nil {
+		settings = &ttnpb.SecurityMonitorSettings{
+			ApplicationIds: ids,
+			Notify:         conf.Notify,
+		}
+	} else {
+		settings = ttnpb.Clone(settings)
+	}
+	if settings.DevNonceReplayThreshold == 0 {
+		settings.DevNonceReplayThreshold = conf.DevNonceReplayThreshold
+	}
+	if settings.DevNonceReplayWindow == nil {
+		settings.DevNonceReplayWindow = durationpb.New(conf.DevNonceReplayWindow)
+	}
+	if settings.JoinFloodThreshold == 0 {
+		settings.JoinFloodThreshold = conf.JoinFloodThreshold
+	}
+	if settings.JoinFloodWindow == nil {
+		settings.JoinFloodWindow = durationpb.New(conf.JoinFloodWindow)
+	}
+	if settings.MicFailureThreshold == 0 {
+		settings.MicFailureThreshold = conf.MICFailureThreshold
+	}
+	if settings.MicFailureWindow == nil {
+		settings.MicFailureWindow = durationpb.New(conf.MICFailureWindow)
+	}
+	if settings.MaxTravelSpeed == 0 {
+		settings.MaxTravelSpeed = conf.MaxTravelSpeed
+	}
+	return settings
+}
+
+// securityAnomalyEnabled returns whether anomalies of type t are reported with the given settings.
+func securityAnomalyEnabled(settings *ttnpb.SecurityMonitorSettings, t ttnpb.SecurityAnomalyType) bool {
+	for _, disabled := range settings.DisabledTypes {
+		if disabled == t {
+			return false
+		}
+	}
+	return true
+}
+
+func securityGatewayIdentifiers(gatewayIDs []string) []*ttnpb.GatewayIdentifiers {
+	ids := make([]*ttnpb.GatewayIdentifiers, 0, len(gatewayIDs))
+	for _, id := range gatewayIDs {
+		ids = append(ids, &ttnpb.GatewayIdentifiers{GatewayId: id})
+	}
+	return ids
+}
+
+// uplinkSecurityPosition returns the position of the end device that sent up, which is the location of the gateway
+// with the best signal. It returns false if none of the gateways have a location.
+func uplinkSecurityPosition(up *ttnpb.UplinkMessage) (*SecurityPosition, bool) {
+	var best *ttnpb.RxMetadata
+	for _, md := range up.RxMetadata {
+		if md.Location == nil {
+			continue
+		}
+		if best == nil || md.Snr > best.Snr {
+			best = md
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+	at := time.Now()
+	if receivedAt := ttnpb.StdTime(up.ReceivedAt); receivedAt != nil {
+		at = *receivedAt
+	}
+	return &SecurityPosition{
+		At:        at,
+		GatewayID: best.GetGatewayIds().GetGatewayId(),
+		Location:  best.Location,
+	}, true
+}
+
+// distance returns the great-circle distance between a and b in km.
+func distance(a, b *ttnpb.Location) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := rad(b.Latitude - a.Latitude)
+	dLon := rad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(rad(a.Latitude))*math.Cos(rad(b.Latitude))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// travelSpeed returns the apparent travel speed in km/h between the positions prev and cur.
+// The distance margin accounts for the range of the gateways.
+func travelSpeed(prev, cur *SecurityPosition, margin float64) float64 {
+	d := distance(prev.Location, cur.Location) - margin
+	if d <= 0 {
+		return 0
+	}
+	dt := cur.At.Sub(prev.At)
+	if dt <= 0 {
+		return math.Inf(1)
+	}
+	return d / dt.Hours()
+}
+
+// securityMonitor detects security anomalies of joins and frame counters.
+// The occurrences are tracked in the state registry, so that they are shared between Network Server instances.
+type securityMonitor struct {
+	conf     SecurityMonitorConfig
+	state    SecurityMonitorStateRegistry
+	settings gcache.Cache
+}
+
+func newSecurityMonitor(conf SecurityMonitorConfig) *securityMonitor {
+	state := conf.State
+	if state == nil {
+		state = newMemorySecurityMonitorState(conf.CacheSize, conf.StateTTL)
+	}
+	return &securityMonitor{
+		conf:     conf,
+		state:    state,
+		settings: gcache.New(conf.CacheSize).LRU().Expiration(securityMonitorSettingsTTL).Build(),
+	}
+}
+
+// applicationSettings returns the security monitor settings of the application identified by ids.
+func (m *securityMonitor) applicationSettings(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) *ttnpb.SecurityMonitorSettings {
+	if m.conf.Settings == nil {
+		return defaultSecurityMonitorSettings(m.conf, ids, nil)
+	}
+	uid := unique.ID(ctx, ids)
+	if v, err := m.settings.Get(uid); err == nil {
+		return v.(*ttnpb.SecurityMonitorSettings)
+	}
+	stored, err := m.conf.Settings.Get(ctx, ids)
+	if err != nil && !errors.IsNotFound(err) {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get security monitor settings")
+	}
+	settings := defaultSecurityMonitorSettings(m.conf, ids, stored)
+	m.settings.Set(uid, settings) // nolint:errcheck
+	return settings
+}
+
+// count records an occurrence of the potential anomaly of type t of the end device identified by ids and returns the
+// number of occurrences and the gateways that received them if the threshold is reached. The occurrences are cleared
+// when the threshold is reached.
+func (m *securityMonitor) count(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	t ttnpb.SecurityAnomalyType,
+	now time.Time,
+	threshold uint32,
+	window time.Duration,
+	gatewayIDs ...string,
+) (uint32, []*ttnpb.GatewayIdentifiers, bool) {
+	if threshold == 0 {
+		return 0, nil, false
+	}
+	n, gtwIDs, ok, err := m.state.Count(ctx, ids, t, now, window, threshold, gatewayIDs...)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to count security anomaly occurrence")
+		return 0, nil, false
+	}
+	if !ok {
+		return 0, nil, false
+	}
+	return n, securityGatewayIdentifiers(gtwIDs), true
+}
+
+// isDevNonceReplay returns whether the join-request, of which the Join Server rejected the DevNonce, is a potential
+// replay. The join-request is not considered a replay if any of the gateways that received it also received the last
+// accepted join-request, since the end device is then in range of the same gateways.
+func (m *securityMonitor) isDevNonceReplay(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, gatewayIDs []string,
+) bool {
+	joinGatewayIDs, err := m.state.GetJoinGateways(ctx, ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get gateways of accepted join-request")
+		return true
+	}
+	for _, id := range gatewayIDs {
+		for _, joinID := range joinGatewayIDs {
+			if id == joinID {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func uplinkGatewayIDs(up *ttnpb.UplinkMessage) []string {
+	ids := make([]string, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		if id := md.GetGatewayIds().GetGatewayId(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// HandleJoinRequest records a join-request of the end device and returns the detected anomalies.
+// err is the error returned by the Join Server, if any.
+func (m *securityMonitor) HandleJoinRequest(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage, err error,
+) (*ttnpb.SecurityMonitorSettings, []*ttnpb.SecurityAnomaly) {
+	settings := m.applicationSettings(ctx, ids.ApplicationIds)
+	now := time.Now()
+	gatewayIDs := uplinkGatewayIDs(up)
+	var anomalies []*ttnpb.SecurityAnomaly
+	if securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD) {
+		window := settings.JoinFloodWindow.AsDuration()
+		if n, gtwIDs, ok := m.count(
+			ctx, ids, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD,
+			now, settings.JoinFloodThreshold, window, gatewayIDs...,
+		); ok {
+			anomalies = append(anomalies, &ttnpb.SecurityAnomaly{
+				Type:         ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD,
+				EndDeviceIds: ids,
+				Count:        n,
+				Window:       durationpb.New(window),
+				GatewayIds:   gtwIDs,
+				DetectedAt:   timestamppb.New(now),
+			})
+		}
+	}
+	if err == nil {
+		if err := m.state.SetJoinGateways(ctx, ids, gatewayIDs...); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to store gateways of accepted join-request")
+		}
+		return settings, anomalies
+	}
+	if ttnErr, ok := errors.From(err); ok && ttnErr.FullName() == devNonceTooSmallErrorName &&
+		securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY) &&
+		m.isDevNonceReplay(ctx, ids, gatewayIDs) {
+		window := settings.DevNonceReplayWindow.AsDuration()
+		if n, gtwIDs, ok := m.count(
+			ctx, ids, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY,
+			now, settings.DevNonceReplayThreshold, window, gatewayIDs...,
+		); ok {
+			anomalies = append(anomalies, &ttnpb.SecurityAnomaly{
+				Type:         ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY,
+				EndDeviceIds: ids,
+				Count:        n,
+				Window:       durationpb.New(window),
+				GatewayIds:   gtwIDs,
+				DetectedAt:   timestamppb.New(now),
+			})
+		}
+	}
+	return settings, anomalies
+}
+
+// HandleMICFailure records a data uplink message of which the MIC did not match the end device.
+func (m *securityMonitor) HandleMICFailure(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage,
+) (*ttnpb.SecurityMonitorSettings, []*ttnpb.SecurityAnomaly) {
+	settings := m.applicationSettings(ctx, ids.ApplicationIds)
+	if !securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES) {
+		return settings, nil
+	}
+	now := time.Now()
+	window := settings.MicFailureWindow.AsDuration()
+	n, gtwIDs, ok := m.count(
+		ctx, ids, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES,
+		now, settings.MicFailureThreshold, window, uplinkGatewayIDs(up)...,
+	)
+	if !ok {
+		return settings, nil
+	}
+	return settings, []*ttnpb.SecurityAnomaly{{
+		Type:         ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES,
+		EndDeviceIds: ids,
+		DevAddr:      up.Payload.GetMacPayload().GetFHdr().GetDevAddr(),
+		Count:        n,
+		Window:       durationpb.New(window),
+		GatewayIds:   gtwIDs,
+		DetectedAt:   timestamppb.New(now),
+	}}
+}
+
+// HandleDataUplink records a data uplink message of the end device, matched by res, and returns the detected
+// anomalies.
+func (m *securityMonitor) HandleDataUplink(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, res cmacFMatchingResult, up *ttnpb.UplinkMessage,
+) (*ttnpb.SecurityMonitorSettings, []*ttnpb.SecurityAnomaly) {
+	settings := m.applicationSettings(ctx, ids.ApplicationIds)
+	now := time.Now()
+	devAddr := up.Payload.GetMacPayload().GetFHdr().GetDevAddr()
+	var anomalies []*ttnpb.SecurityAnomaly
+	if !res.IsPending && res.FullFCnt < res.LastFCnt &&
+		securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET) {
+		anomalies = append(anomalies, &ttnpb.SecurityAnomaly{
+			Type:         ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET,
+			EndDeviceIds: ids,
+			DevAddr:      devAddr,
+			FCnt:         res.FullFCnt,
+			LastFCnt:     res.LastFCnt,
+			DetectedAt:   timestamppb.New(now),
+		})
+	}
+	cur, ok := uplinkSecurityPosition(up)
+	if !ok || !securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL) {
+		return settings, anomalies
+	}
+	prev, err := m.state.SwapPosition(ctx, ids, cur)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to swap position")
+		return settings, anomalies
+	}
+	if prev == nil {
+		return settings, anomalies
+	}
+	if speed := travelSpeed(prev, cur, float64(m.conf.TravelDistanceMargin)); speed > float64(settings.MaxTravelSpeed) {
+		if math.IsInf(speed, 1) {
+			speed = math.MaxFloat32
+		}
+		anomalies = append(anomalies, &ttnpb.SecurityAnomaly{
+			Type:         ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL,
+			EndDeviceIds: ids,
+			DevAddr:      devAddr,
+			GatewayIds: []*ttnpb.GatewayIdentifiers{
+				{GatewayId: prev.GatewayID},
+				{GatewayId: cur.GatewayID},
+			},
+			Locations:  []*ttnpb.Location{prev.Location, cur.Location},
+			Speed:      float32(speed),
+			DetectedAt: timestamppb.New(now),
+		})
+	}
+	return settings, anomalies
+}
+
+// appendSecurityCandidate appends the end device identified by ids to the MIC failure candidates, unless it is
+// already a candidate or the maximum number of candidates is reached.
+func appendSecurityCandidate(candidates []*ttnpb.EndDeviceIdentifiers, ids *ttnpb.EndDeviceIdentifiers) []*ttnpb.EndDeviceIdentifiers {
+	if len(candidates) >= maxSecurityMICFailureCandidates {
+		return candidates
+	}
+	for _, candidate := range candidates {
+		if candidate.DeviceId == ids.DeviceId && candidate.ApplicationIds.GetApplicationId() == ids.ApplicationIds.GetApplicationId() {
+			return candidates
+		}
+	}
+	return append(candidates, ids)
+}
+
+func (ns *NetworkServer) handleSecurityJoinRequest(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage, err error,
+) {
+	if ns.securityMonitor == nil {
+		return
+	}
+	settings, anomalies := ns.securityMonitor.HandleJoinRequest(ctx, ids, up, err)
+	ns.reportSecurityAnomalies(ctx, settings, anomalies...)
+}
+
+func (ns *NetworkServer) handleSecurityMICFailures(
+	ctx context.Context, candidates []*ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage,
+) {
+	if ns.securityMonitor == nil {
+		return
+	}
+	for _, ids := range candidates {
+		settings, anomalies := ns.securityMonitor.HandleMICFailure(ctx, ids, up)
+		ns.reportSecurityAnomalies(ctx, settings, anomalies...)
+	}
+}
+
+func (ns *NetworkServer) handleSecurityDataUplink(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, res cmacFMatchingResult, up *ttnpb.UplinkMessage,
+) {
+	if ns.securityMonitor == nil {
+		return
+	}
+	settings, anomalies := ns.securityMonitor.HandleDataUplink(ctx, ids, res, up)
+	ns.reportSecurityAnomalies(ctx, settings, anomalies...)
+}
+
+// reportSecurityAnomalies publishes the events of the anomalies and notifies the collaborators of the application if
+// enabled in the settings.
+func (ns *NetworkServer) reportSecurityAnomalies(
+	ctx context.Context, settings *ttnpb.SecurityMonitorSettings, anomalies ...*ttnpb.SecurityAnomaly,
+) {
+	if len(anomalies) == 0 {
+		return
+	}
+	evs := make([]events.Event, 0, len(anomalies))
+	for _, a := range anomalies {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"anomaly_type", a.Type,
+			"device_uid", unique.ID(ctx, a.EndDeviceIds),
+		)).Warn("Security anomaly detected")
+		evs = append(evs, securityAnomalyEvents[a.Type].NewWithIdentifiersAndData(ctx, a.EndDeviceIds, a))
+	}
+	publishEvents(ctx, evs...)
+	if !settings.Notify {
+		return
+	}
+	ns.StartTask(&task.Config{
+		Context: ns.FromRequestContext(ctx),
+		ID:      "notify_security_anomalies",
+		Func: func(ctx context.Context) error {
+			return ns.notifySecurityAnomalies(ctx, settings.ApplicationIds, anomalies...)
+		},
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+}
+
+// notifySecurityAnomalies creates a notification for each anomaly in the Identity Server.
+func (ns *NetworkServer) notifySecurityAnomalies(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, anomalies ...*ttnpb.SecurityAnomaly,
+) error {
+	cc, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get Identity Server peer")
+		return err
+	}
+	cl := ttnpb.NewNotificationServiceClient(cc)
+	for _, a := range anomalies {
+		data, err := anypb.New(a)
+		if err != nil {
+			return err
+		}
+		if _, err := cl.Create(ctx, &ttnpb.CreateNotificationRequest{
+			EntityIds:        ids.GetEntityIdentifiers(),
+			NotificationType: securityAnomalyNotificationType,
+			Data:             data,
+			Receivers: []ttnpb.NotificationReceiver{
+				ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_COLLABORATOR,
+				ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_TECHNICAL_CONTACT,
+			},
+		}, ns.WithClusterAuth()); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to notify security anomaly")
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSecurityWindow(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	now := time.Unix(1700000000, 0)
+	w := &securityWindow{}
+	a.So(w.add(now, time.Minute, "gtw-1"), should.Equal, 1)
+	a.So(w.add(now.Add(30*time.Second), time.Minute, "gtw-1", "gtw-2"), should.Equal, 2)
+	a.So(w.gatewayIDs, should.Resemble, []string{"gtw-1", "gtw-2"})
+
+	// The first occurrence leaves the window.
+	a.So(w.add(now.Add(70*time.Second), time.Minute, "gtw-3"), should.Equal, 2)
+	a.So(w.gatewayIDs, should.Resemble, []string{"gtw-1", "gtw-2", "gtw-3"})
+
+	// All occurrences leave the window, which also clears the gateways.
+	a.So(w.add(now.Add(time.Hour), time.Minute, "gtw-4"), should.Equal, 1)
+	a.So(w.gatewayIDs, should.Resemble, []string{"gtw-4"})
+
+	w.reset()
+	a.So(w.times, should.BeEmpty)
+	a.So(w.gatewayIDs, should.BeEmpty)
+}
+
+func TestTravelSpeed(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	amsterdam := &ttnpb.Location{Latitude: 52.3676, Longitude: 4.9041}
+	paris := &ttnpb.Location{Latitude: 48.8566, Longitude: 2.3522}
+	a.So(distance(amsterdam, paris), should.AlmostEqual, 430, 5)
+	a.So(distance(amsterdam, amsterdam), should.Equal, 0)
+
+	now := time.Unix(1700000000, 0)
+	prev := &SecurityPosition{At: now, Location: amsterdam}
+	cur := &SecurityPosition{At: now.Add(time.Hour), Location: paris}
+	a.So(travelSpeed(prev, cur, 30), should.AlmostEqual, 400, 5)
+	a.So(travelSpeed(prev, cur, 500), should.Equal, 0)
+}
+
+func TestDefaultSecurityMonitorSettings(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	conf := DefaultConfig.SecurityMonitor
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+
+	settings := defaultSecurityMonitorSettings(conf, ids, nil)
+	a.So(settings.ApplicationIds, should.Resemble, ids)
+	a.So(settings.JoinFloodThreshold, should.Equal, conf.JoinFloodThreshold)
+	a.So(settings.JoinFloodWindow.AsDuration(), should.Equal, conf.JoinFloodWindow)
+	a.So(settings.MaxTravelSpeed, should.Equal, conf.MaxTravelSpeed)
+
+	stored := &ttnpb.SecurityMonitorSettings{
+		ApplicationIds:     ids,
+		JoinFloodThreshold: 5,
+		DisabledTypes:      []ttnpb.SecurityAnomalyType{ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET},
+	}
+	settings = defaultSecurityMonitorSettings(conf, ids, stored)
+	a.So(settings.JoinFloodThreshold, should.Equal, 5)
+	a.So(settings.MicFailureThreshold, should.Equal, conf.MICFailureThreshold)
+	a.So(stored.MicFailureThreshold, should.BeZeroValue)
+	a.So(securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET), should.BeFalse)
+	a.So(securityAnomalyEnabled(settings, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD), should.BeTrue)
+}
+
+func TestSecurityMonitor(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	conf := DefaultConfig.SecurityMonitor
+	conf.JoinFloodThreshold = 3
+	conf.MICFailureThreshold = 2
+	m := newSecurityMonitor(conf)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	newUplink := func(gtwID string, loc *ttnpb.Location, receivedAt time.Time) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: gtwID},
+				Location:   loc,
+			}},
+			ReceivedAt: timestamppb.New(receivedAt),
+		}
+	}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		_, anomalies := m.HandleJoinRequest(ctx, ids, newUplink("gtw-1", nil, now), nil)
+		a.So(anomalies, should.BeEmpty)
+	}
+	_, anomalies := m.HandleJoinRequest(ctx, ids, newUplink("gtw-2", nil, now), nil)
+	if a.So(anomalies, should.HaveLength, 1) {
+		a.So(anomalies[0].Type, should.Equal, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD)
+		a.So(anomalies[0].Count, should.Equal, 3)
+		a.So(anomalies[0].GatewayIds, should.HaveLength, 2)
+	}
+
+	_, anomalies = m.HandleMICFailure(ctx, ids, newUplink("gtw-1", nil, now))
+	a.So(anomalies, should.BeEmpty)
+	_, anomalies = m.HandleMICFailure(ctx, ids, newUplink("gtw-1", nil, now))
+	if a.So(anomalies, should.HaveLength, 1) {
+		a.So(anomalies[0].Type, should.Equal, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES)
+	}
+
+	amsterdam := &ttnpb.Location{Latitude: 52.3676, Longitude: 4.9041}
+	paris := &ttnpb.Location{Latitude: 48.8566, Longitude: 2.3522}
+	res := cmacFMatchingResult{LastFCnt: 10, FullFCnt: 11}
+	_, anomalies = m.HandleDataUplink(ctx, ids, res, newUplink("gtw-ams", amsterdam, now))
+	a.So(anomalies, should.BeEmpty)
+	_, anomalies = m.HandleDataUplink(ctx, ids, res, newUplink("gtw-par", paris, now.Add(time.Minute)))
+	if a.So(anomalies, should.HaveLength, 1) {
+		a.So(anomalies[0].Type, should.Equal, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL)
+		a.So(anomalies[0].Locations, should.HaveLength, 2)
+		a.So(anomalies[0].Speed, should.BeGreaterThan, conf.MaxTravelSpeed)
+	}
+
+	res = cmacFMatchingResult{LastFCnt: 12, FullFCnt: 0}
+	_, anomalies = m.HandleDataUplink(ctx, ids, res, newUplink("gtw-par", nil, now.Add(time.Hour)))
+	if a.So(anomalies, should.HaveLength, 1) {
+		a.So(anomalies[0].Type, should.Equal, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET)
+		a.So(anomalies[0].LastFCnt, should.Equal, 12)
+	}
+}
+
+func TestSecurityMonitorDevNonceReplay(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	conf := DefaultConfig.SecurityMonitor
+	conf.DevNonceReplayThreshold = 2
+	m := newSecurityMonitor(conf)
+
+	st, err := status.New(codes.InvalidArgument, "DevNonce is too small").WithDetails(&ttnpb.ErrorDetails{
+		Namespace: "pkg/joinserver",
+		Name:      "dev_nonce_too_small",
+		Code:      uint32(codes.InvalidArgument),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	errDevNonce := errors.FromGRPCStatus(st)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	newUplink := func(gtwIDs ...string) *ttnpb.UplinkMessage {
+		up := &ttnpb.UplinkMessage{}
+		for _, id := range gtwIDs {
+			up.RxMetadata = append(up.RxMetadata, &ttnpb.RxMetadata{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: id},
+			})
+		}
+		return up
+	}
+
+	// Without an accepted join-request, all replays are counted.
+	_, anomalies := m.HandleJoinRequest(ctx, ids, newUplink("gtw-1"), errDevNonce)
+	a.So(anomalies, should.BeEmpty)
+	_, anomalies = m.HandleJoinRequest(ctx, ids, newUplink("gtw-1"), errDevNonce)
+	if a.So(anomalies, should.HaveLength, 1) {
+		a.So(anomalies[0].Type, should.Equal, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY)
+		a.So(anomalies[0].Count, should.Equal, 2)
+	}
+
+	_, anomalies = m.HandleJoinRequest(ctx, ids, newUplink("gtw-1", "gtw-2"), nil)
+	a.So(anomalies, should.BeEmpty)
+
+	// Replays received by a gateway that received the accepted join-request are not counted.
+	for i := 0; i < 3; i++ {
+		_, anomalies = m.HandleJoinRequest(ctx, ids, newUplink("gtw-2", "gtw-3"), errDevNonce)
+		a.So(anomalies, should.BeEmpty)
+	}
+
+	// Replays received by other gateways are counted.
+	_, anomalies = m.HandleJoinRequest(ctx, ids, newUplink("gtw-3"), errDevNonce)
+	a.So(anomalies, should.BeEmpty)
+	_, anomalies = m.HandleJoinRequest(ctx, ids, newUplink("gtw-4"), errDevNonce)
+	if a.So(anomalies, should.HaveLength, 1) {
+		a.So(anomalies[0].Type, should.Equal, ttnpb.SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY)
+		a.So(anomalies[0].GatewayIds, should.Resemble, []*ttnpb.GatewayIdentifiers{
+			{GatewayId: "gtw-3"},
+			{GatewayId: "gtw-4"},
+		})
+	}
+}
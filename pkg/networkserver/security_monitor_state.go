@@ -0,0 +1,176 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// SecurityPosition is the position of an end device, derived from the gateway that received an uplink message.
+type SecurityPosition struct {
+	At        time.Time
+	GatewayID string
+	Location  *ttnpb.Location
+}
+
+// SecurityMonitorStateRegistry is a registry, containing the occurrences of potential security anomalies, positions
+// and joins of end devices. The registry is shared by the Network Server instances.
+type SecurityMonitorStateRegistry interface {
+	// Count records an occurrence at now of the potential anomaly of type t of the end device identified by ids,
+	// received by the given gateways. Occurrences before the window are dropped.
+	// If the number of occurrences within the window reaches threshold, Count returns the number of occurrences and
+	// the gateways that received them, and clears the occurrences.
+	Count(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		t ttnpb.SecurityAnomalyType,
+		now time.Time,
+		window time.Duration,
+		threshold uint32,
+		gatewayIDs ...string,
+	) (uint32, []string, bool, error)
+	// SwapPosition stores the position of the end device identified by ids and returns the previous position.
+	// The previous position is nil if it does not exist.
+	SwapPosition(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, pos *SecurityPosition) (*SecurityPosition, error)
+	// SetJoinGateways stores the gateways that received the last accepted join-request of the end device identified
+	// by ids.
+	SetJoinGateways(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, gatewayIDs ...string) error
+	// GetJoinGateways returns the gateways that received the last accepted join-request of the end device identified
+	// by ids. The gateways are nil if they do not exist.
+	GetJoinGateways(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]string, error)
+}
+
+// securityWindow is a sliding window of occurrences of a potential security anomaly.
+type securityWindow struct {
+	times      []time.Time
+	gatewayIDs []string
+}
+
+// add records an occurrence at now, received by the given gateways, and returns the number of occurrences within the
+// window. Occurrences before the window are dropped.
+func (w *securityWindow) add(now time.Time, window time.Duration, gatewayIDs ...string) int {
+	i := 0
+	for i < len(w.times) && !w.times[i].After(now.Add(-window)) {
+		i++
+	}
+	if i == len(w.times) {
+		w.gatewayIDs = w.gatewayIDs[:0]
+	}
+	w.times = append(w.times[i:], now)
+outer:
+	for _, id := range gatewayIDs {
+		if len(w.gatewayIDs) >= maxSecurityAnomalyGateways {
+			break
+		}
+		for _, seen := range w.gatewayIDs {
+			if seen == id {
+				continue outer
+			}
+		}
+		w.gatewayIDs = append(w.gatewayIDs, id)
+	}
+	return len(w.times)
+}
+
+// reset clears the occurrences, so that the anomaly is only reported again once the threshold is reached again.
+func (w *securityWindow) reset() {
+	w.times = w.times[:0]
+	w.gatewayIDs = w.gatewayIDs[:0]
+}
+
+// memorySecurityMonitorState is an in-memory implementation of SecurityMonitorStateRegistry.
+// The state is not shared between Network Server instances, so it is only used if no registry is configured.
+type memorySecurityMonitorState struct {
+	mu        sync.Mutex
+	windows   gcache.Cache
+	positions gcache.Cache
+	joins     gcache.Cache
+}
+
+func newMemorySecurityMonitorState(size int, ttl time.Duration) *memorySecurityMonitorState {
+	return &memorySecurityMonitorState{
+		windows:   gcache.New(size).LRU().Build(),
+		positions: gcache.New(size).LRU().Expiration(ttl).Build(),
+		joins:     gcache.New(size).LRU().Expiration(ttl).Build(),
+	}
+}
+
+// Count implements SecurityMonitorStateRegistry.
+func (s *memorySecurityMonitorState) Count(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	t ttnpb.SecurityAnomalyType,
+	now time.Time,
+	window time.Duration,
+	threshold uint32,
+	gatewayIDs ...string,
+) (uint32, []string, bool, error) {
+	key := t.String() + ":" + unique.ID(ctx, ids)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var w *securityWindow
+	if v, err := s.windows.Get(key); err == nil {
+		w = v.(*securityWindow)
+	} else {
+		w = &securityWindow{}
+		s.windows.Set(key, w) // nolint:errcheck
+	}
+	n := w.add(now, window, gatewayIDs...)
+	if uint32(n) < threshold {
+		return 0, nil, false, nil
+	}
+	gtwIDs := append([]string(nil), w.gatewayIDs...)
+	w.reset()
+	return uint32(n), gtwIDs, true, nil
+}
+
+// SwapPosition implements SecurityMonitorStateRegistry.
+func (s *memorySecurityMonitorState) SwapPosition(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, pos *SecurityPosition,
+) (*SecurityPosition, error) {
+	uid := unique.ID(ctx, ids)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.positions.Get(uid)
+	s.positions.Set(uid, pos) // nolint:errcheck
+	if err != nil {
+		return nil, nil
+	}
+	return v.(*SecurityPosition), nil
+}
+
+// SetJoinGateways implements SecurityMonitorStateRegistry.
+func (s *memorySecurityMonitorState) SetJoinGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, gatewayIDs ...string,
+) error {
+	return s.joins.Set(unique.ID(ctx, ids), append([]string(nil), gatewayIDs...))
+}
+
+// GetJoinGateways implements SecurityMonitorStateRegistry.
+func (s *memorySecurityMonitorState) GetJoinGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) ([]string, error) {
+	v, err := s.joins.Get(unique.ID(ctx, ids))
+	if err != nil {
+		return nil, nil
+	}
+	return v.([]string), nil
+}
@@ -277,6 +277,9 @@ func nextDataDownlinkSlot(ctx context.Context, dev *ttnpb.EndDevice, phy *band.B
 		case mac.DeviceNeedsDutyCycleReq(dev):
 			logger.Debug("Device needs DutyCycleReq, choose class A downlink slot")
 			return classA, true
+		case mac.DeviceNeedsForceRejoinReq(dev):
+			logger.Debug("Device needs ForceRejoinReq, choose class A downlink slot")
+			return classA, true
 		case mac.DeviceNeedsLinkADRReq(ctx, dev, phy):
 			logger.Debug("Device needs LinkADRReq, choose class A downlink slot")
 			return classA, true
@@ -313,6 +316,9 @@ func nextDataDownlinkSlot(ctx context.Context, dev *ttnpb.EndDevice, phy *band.B
 		case mac.DeviceNeedsRelayConfigureFwdLimitReq(dev):
 			logger.Debug("Device needs RelayConfigureFwdLimitReq, choose class A downlink slot")
 			return classA, true
+		case mac.DeviceNeedsOperatorCommands(dev):
+			logger.Debug("Operator MAC commands enqueued, choose class A downlink slot")
+			return classA, true
 		}
 	}
 
@@ -349,6 +355,38 @@ func nextDataDownlinkSlot(ctx context.Context, dev *ttnpb.EndDevice, phy *band.B
 			}, true
 		}
 	}
+	if mac.DeviceNeedsForceRejoinReq(dev) && deviceHasPathForDownlink(ctx, dev, nil) {
+		switch {
+		case hasNwkUnconf:
+			logger.Debug("Device needs ForceRejoinReq, choose unconfirmed network-initiated downlink slot")
+			return &networkInitiatedDownlinkSlot{
+				Time:  nwkUnconf,
+				Class: dev.MacState.DeviceClass,
+			}, true
+		case hasNwkConf:
+			logger.Debug("Device needs ForceRejoinReq, choose confirmed network-initiated downlink slot")
+			return &networkInitiatedDownlinkSlot{
+				Time:  nwkConf,
+				Class: dev.MacState.DeviceClass,
+			}, true
+		}
+	}
+	if mac.DeviceNeedsOperatorCommands(dev) && deviceHasPathForDownlink(ctx, dev, nil) {
+		switch {
+		case hasNwkUnconf:
+			logger.Debug("Operator MAC commands enqueued, choose unconfirmed network-initiated downlink slot")
+			return &networkInitiatedDownlinkSlot{
+				Time:  nwkUnconf,
+				Class: dev.MacState.DeviceClass,
+			}, true
+		case hasNwkConf:
+			logger.Debug("Operator MAC commands enqueued, choose confirmed network-initiated downlink slot")
+			return &networkInitiatedDownlinkSlot{
+				Time:  nwkConf,
+				Class: dev.MacState.DeviceClass,
+			}, true
+		}
+	}
 	for _, down := range dev.GetSession().GetQueuedApplicationDownlinks() {
 		if !deviceHasPathForDownlink(ctx, dev, down) {
 			logger.Debug("Skip downlink, for which no path is available")
@@ -349,6 +349,84 @@ func TestNextDataDownlinkSlot(t *testing.T) {
 			},
 			ExpectedOk: true,
 		},
+		{
+			Name:       "unicast/class C/RX windows closed/operator MAC command",
+			EarliestAt: rx1,
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: &ttnpb.MACParameters{
+						Rx1Delay: rxDelay,
+					},
+					DesiredParameters: &ttnpb.MACParameters{},
+					LorawanVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+					DeviceClass:       ttnpb.Class_CLASS_C,
+					RecentUplinks:     ups,
+					QueuedOperatorCommands: []*ttnpb.MACCommand{
+						ttnpb.MACCommandIdentifier_CID_DEV_STATUS.MACCommand(),
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: test.DefaultDevAddr.Bytes(),
+				},
+			},
+			ExpectedSlot: &networkInitiatedDownlinkSlot{
+				Time:  rx2,
+				Class: ttnpb.Class_CLASS_C,
+			},
+			ExpectedOk: true,
+		},
+		{
+			Name:       "unicast/class A/RX1,RX2 available/ForceRejoinReq",
+			EarliestAt: beforeRX1,
+			Device: &ttnpb.EndDevice{
+				MacSettings: &ttnpb.MACSettings{
+					StatusCountPeriodicity: &wrapperspb.UInt32Value{Value: 0},
+					StatusTimePeriodicity:  durationpb.New(0),
+				},
+				MacState: &ttnpb.MACState{
+					CurrentParameters: &ttnpb.MACParameters{
+						Rx1Delay: rxDelay,
+					},
+					DesiredParameters: &ttnpb.MACParameters{
+						Rx1Delay: rxDelay,
+					},
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_1,
+					DeviceClass:          ttnpb.Class_CLASS_A,
+					RxWindowsAvailable:   true,
+					RecentUplinks:        ups,
+					QueuedForceRejoinReq: &ttnpb.MACCommand_ForceRejoinReq{},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: test.DefaultDevAddr.Bytes(),
+				},
+			},
+			ExpectedSlot: classA,
+			ExpectedOk:   true,
+		},
+		{
+			Name:       "unicast/class C/RX windows closed/ForceRejoinReq",
+			EarliestAt: rx1,
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: &ttnpb.MACParameters{
+						Rx1Delay: rxDelay,
+					},
+					DesiredParameters:    &ttnpb.MACParameters{},
+					LorawanVersion:       ttnpb.MACVersion_MAC_V1_1,
+					DeviceClass:          ttnpb.Class_CLASS_C,
+					RecentUplinks:        ups,
+					QueuedForceRejoinReq: &ttnpb.MACCommand_ForceRejoinReq{},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: test.DefaultDevAddr.Bytes(),
+				},
+			},
+			ExpectedSlot: &networkInitiatedDownlinkSlot{
+				Time:  rx2,
+				Class: ttnpb.Class_CLASS_C,
+			},
+			ExpectedOk: true,
+		},
 		{
 			Name:       "unicast/class C/no uplink/no application downlink",
 			EarliestAt: rx1,
@@ -0,0 +1,80 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notificationchannel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func newJSONRequest(ctx context.Context, method, url string, v any) (*http.Request, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func newSlackRequest(
+	ctx context.Context, slack *ttnpb.NotificationChannel_Slack, notification *ttnpb.Notification,
+) (*http.Request, error) {
+	return newJSONRequest(ctx, http.MethodPost, slack.GetUrl(), &slackMessage{
+		Text: Message(notification),
+	})
+}
+
+type matrixMessage struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// newMatrixRequest returns a request that sends a message event to the room.
+// The transaction ID is derived from the channel and the notification, so that retries are idempotent.
+func newMatrixRequest(
+	ctx context.Context, matrix *ttnpb.NotificationChannel_Matrix, channelID string, notification *ttnpb.Notification,
+) (*http.Request, error) {
+	txnID := fmt.Sprintf("%s.%s", channelID, notification.GetId())
+	u := fmt.Sprintf(
+		"%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		strings.TrimSuffix(matrix.GetHomeserverUrl(), "/"),
+		url.PathEscape(matrix.GetRoomId()),
+		url.PathEscape(txnID),
+	)
+	req, err := newJSONRequest(ctx, http.MethodPut, u, &matrixMessage{
+		MsgType: "m.text",
+		Body:    Message(notification),
+	})
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+string(matrix.GetAccessToken().GetValue()))
+	return req, nil
+}
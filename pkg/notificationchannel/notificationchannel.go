@@ -0,0 +1,147 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notificationchannel delivers notifications to HTTP webhooks, Slack-compatible incoming webhooks
+// and Matrix rooms.
+package notificationchannel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errUnknownChannel  = errors.DefineInvalidArgument("unknown_channel", "unknown notification channel `{channel_id}`")
+	errRequest         = errors.DefineUnavailable("request", "request to notification channel")
+	errTemporaryStatus = errors.DefineUnavailable(
+		"temporary_status", "notification channel responded with temporary status `{status}`",
+	)
+	errStatus = errors.DefineFailedPrecondition(
+		"status", "notification channel responded with status `{status}`",
+	)
+)
+
+// Config is the configuration of notification channels.
+type Config struct {
+	Attempts int           `name:"attempts" description:"Maximum number of delivery attempts of a notification to a channel"`    //nolint:lll
+	Backoff  time.Duration `name:"backoff" description:"Time to wait before the first retry, which doubles on every next retry"` //nolint:lll
+	Timeout  time.Duration `name:"timeout" description:"Timeout of a delivery attempt"`
+
+	EncryptionKeyID string `name:"encryption-key-id" description:"ID of the key used to encrypt notification channel secrets at rest"` //nolint:lll
+}
+
+// Sender sends notifications to notification channels.
+type Sender struct {
+	client *http.Client
+	config Config
+}
+
+// NewSender returns a new Sender that uses the given HTTP client.
+func NewSender(client *http.Client, config Config) *Sender {
+	return &Sender{
+		client: client,
+		config: config,
+	}
+}
+
+// Message returns the text message of the notification that is sent to chat channels.
+func Message(notification *ttnpb.Notification) string {
+	notificationType := strings.ReplaceAll(notification.GetNotificationType(), "_", " ")
+	if notificationType != "" {
+		notificationType = strings.ToUpper(notificationType[:1]) + notificationType[1:]
+	}
+	ids := notification.GetEntityIds()
+	msg := fmt.Sprintf("%s: %s %s", notificationType, strings.ReplaceAll(ids.EntityType(), "_", " "), ids.IDString())
+	if sender := notification.GetSenderIds(); sender != nil {
+		msg += fmt.Sprintf(" (by %s)", sender.GetUserId())
+	}
+	return msg
+}
+
+func (s *Sender) newRequest(
+	ctx context.Context, channel *ttnpb.NotificationChannel, notification *ttnpb.Notification,
+) (*http.Request, error) {
+	switch c := channel.GetChannel().(type) {
+	case *ttnpb.NotificationChannel_Webhook_:
+		return newWebhookRequest(ctx, c.Webhook, notification)
+	case *ttnpb.NotificationChannel_Slack_:
+		return newSlackRequest(ctx, c.Slack, notification)
+	case *ttnpb.NotificationChannel_Matrix_:
+		return newMatrixRequest(ctx, c.Matrix, channel.GetChannelId(), notification)
+	default:
+		return nil, errUnknownChannel.WithAttributes("channel_id", channel.GetChannelId())
+	}
+}
+
+// Send makes a single attempt to send the notification to the channel.
+func (s *Sender) Send(ctx context.Context, channel *ttnpb.NotificationChannel, notification *ttnpb.Notification) error {
+	if s.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.Timeout)
+		defer cancel()
+	}
+	req, err := s.newRequest(ctx, channel, notification)
+	if err != nil {
+		return err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return errRequest.WithCause(err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode <= 299:
+		return nil
+	case res.StatusCode == http.StatusRequestTimeout,
+		res.StatusCode == http.StatusTooManyRequests,
+		res.StatusCode >= 500:
+		return errTemporaryStatus.WithAttributes("status", res.StatusCode)
+	default:
+		return errStatus.WithAttributes("status", res.StatusCode)
+	}
+}
+
+// Deliver sends the notification to the channel.
+// Attempts that fail because the channel is temporarily unavailable are retried with exponential backoff.
+func (s *Sender) Deliver(
+	ctx context.Context, channel *ttnpb.NotificationChannel, notification *ttnpb.Notification,
+) error {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"channel_id", channel.GetChannelId(),
+		"notification_id", notification.GetId(),
+	))
+	backoff := s.config.Backoff
+	for attempt := 1; ; attempt++ {
+		err := s.Send(ctx, channel, notification)
+		if err == nil || !errors.IsUnavailable(err) || attempt >= s.config.Attempts {
+			return err
+		}
+		logger.WithError(err).WithField("attempt", attempt).Debug("Failed to deliver notification, retry")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notificationchannel_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/notificationchannel"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type request struct {
+	method string
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func newServer(t *testing.T, statuses ...int) (*httptest.Server, <-chan request) {
+	t.Helper()
+	reqCh := make(chan request, 10)
+	var n int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		reqCh <- request{
+			method: r.Method,
+			path:   r.URL.EscapedPath(),
+			header: r.Header,
+			body:   body,
+		}
+		status := http.StatusOK
+		if n < len(statuses) {
+			status = statuses[n]
+		}
+		n++
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, reqCh
+}
+
+func TestSender(t *testing.T) {
+	t.Parallel()
+
+	notification := &ttnpb.Notification{
+		Id:               "a7b1c3d4-0000-0000-0000-000000000000",
+		EntityIds:        (&ttnpb.GatewayIdentifiers{GatewayId: "foo-gtw"}).GetEntityIdentifiers(),
+		NotificationType: "gateway_alert",
+		SenderIds:        &ttnpb.UserIdentifiers{UserId: "foo-usr"},
+	}
+	config := Config{
+		Attempts: 3,
+		Backoff:  test.Delay,
+		Timeout:  (1 << 10) * test.Delay,
+	}
+
+	t.Run("Message", func(t *testing.T) {
+		t.Parallel()
+		a, _ := test.New(t)
+		a.So(Message(notification), should.Equal, "Gateway alert: gateway foo-gtw (by foo-usr)")
+	})
+
+	t.Run("Webhook", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		srv, reqCh := newServer(t)
+		sender := NewSender(srv.Client(), config)
+		err := sender.Deliver(ctx, &ttnpb.NotificationChannel{
+			ChannelId: "foo-webhook",
+			Channel: &ttnpb.NotificationChannel_Webhook_{
+				Webhook: &ttnpb.NotificationChannel_Webhook{
+					Url:     srv.URL + "/notify",
+					Secret:  &ttnpb.Secret{Value: []byte("secret")},
+					Headers: map[string]string{"X-Foo": "bar"},
+				},
+			},
+		}, notification)
+		a.So(err, should.BeNil)
+		req := <-reqCh
+		a.So(req.method, should.Equal, http.MethodPost)
+		a.So(req.path, should.Equal, "/notify")
+		a.So(req.header.Get("X-Foo"), should.Equal, "bar")
+		a.So(req.header.Get(SignatureHeader), should.Equal, Sign([]byte("secret"), req.body))
+		var body map[string]any
+		if a.So(json.Unmarshal(req.body, &body), should.BeNil) {
+			a.So(body["notification_type"], should.Equal, "gateway_alert")
+		}
+	})
+
+	t.Run("Slack", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		srv, reqCh := newServer(t)
+		sender := NewSender(srv.Client(), config)
+		err := sender.Deliver(ctx, &ttnpb.NotificationChannel{
+			ChannelId: "foo-slack",
+			Channel: &ttnpb.NotificationChannel_Slack_{
+				Slack: &ttnpb.NotificationChannel_Slack{Url: srv.URL + "/services/T0/B0/X0"},
+			},
+		}, notification)
+		a.So(err, should.BeNil)
+		req := <-reqCh
+		a.So(req.method, should.Equal, http.MethodPost)
+		a.So(req.path, should.Equal, "/services/T0/B0/X0")
+		a.So(string(req.body), should.Equal, `{"text":"Gateway alert: gateway foo-gtw (by foo-usr)"}`)
+	})
+
+	t.Run("Matrix", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		srv, reqCh := newServer(t)
+		sender := NewSender(srv.Client(), config)
+		err := sender.Deliver(ctx, &ttnpb.NotificationChannel{
+			ChannelId: "foo-matrix",
+			Channel: &ttnpb.NotificationChannel_Matrix_{
+				Matrix: &ttnpb.NotificationChannel_Matrix{
+					HomeserverUrl: srv.URL + "/",
+					RoomId:        "!room:example.com",
+					AccessToken:   &ttnpb.Secret{Value: []byte("token")},
+				},
+			},
+		}, notification)
+		a.So(err, should.BeNil)
+		req := <-reqCh
+		a.So(req.method, should.Equal, http.MethodPut)
+		a.So(req.path, should.Equal,
+			"/_matrix/client/v3/rooms/%21room:example.com/send/m.room.message/foo-matrix.a7b1c3d4-0000-0000-0000-000000000000",
+		)
+		a.So(req.header.Get("Authorization"), should.Equal, "Bearer token")
+		a.So(string(req.body), should.Equal, `{"msgtype":"m.text","body":"Gateway alert: gateway foo-gtw (by foo-usr)"}`)
+	})
+
+	slackChannel := func(url string) *ttnpb.NotificationChannel {
+		return &ttnpb.NotificationChannel{
+			ChannelId: "foo-slack",
+			Channel: &ttnpb.NotificationChannel_Slack_{
+				Slack: &ttnpb.NotificationChannel_Slack{Url: url},
+			},
+		}
+	}
+
+	t.Run("Retry", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		srv, reqCh := newServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		sender := NewSender(srv.Client(), config)
+		err := sender.Deliver(ctx, slackChannel(srv.URL), notification)
+		a.So(err, should.BeNil)
+		a.So(reqCh, should.HaveLength, 3)
+	})
+
+	t.Run("RetryExhausted", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		srv, reqCh := newServer(t,
+			http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+		)
+		sender := NewSender(srv.Client(), config)
+		err := sender.Deliver(ctx, slackChannel(srv.URL), notification)
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+		a.So(reqCh, should.HaveLength, 3)
+	})
+
+	t.Run("NoRetry", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		srv, reqCh := newServer(t, http.StatusNotFound)
+		sender := NewSender(srv.Client(), config)
+		err := sender.Deliver(ctx, slackChannel(srv.URL), notification)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		a.So(reqCh, should.HaveLength, 1)
+		<-reqCh
+		select {
+		case <-time.After(2 * test.Delay):
+		case <-reqCh:
+			t.Fatal("Unexpected retry")
+		}
+	})
+}
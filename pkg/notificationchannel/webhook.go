@@ -0,0 +1,58 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notificationchannel
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// SignatureHeader is the HTTP header that contains the HMAC-SHA256 signature of the webhook request body.
+const SignatureHeader = "X-TTS-Signature"
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookRequest(
+	ctx context.Context, webhook *ttnpb.NotificationChannel_Webhook, notification *ttnpb.Notification,
+) (*http.Request, error) {
+	body, err := jsonpb.TTN().Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.GetUrl(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range webhook.GetHeaders() {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret := webhook.GetSecret().GetValue(); len(secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+	return req, nil
+}
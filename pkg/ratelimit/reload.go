@@ -0,0 +1,45 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import "sync/atomic"
+
+type limiterHolder struct {
+	Interface
+}
+
+// ReloadableRateLimiter is a ratelimit.Interface of which the underlying rate limiter can be replaced at runtime.
+// This allows changing the rate limiting profiles without recreating the middleware that holds the rate limiter.
+type ReloadableRateLimiter struct {
+	limiter atomic.Value
+}
+
+// NewReloadable returns a new ReloadableRateLimiter that delegates to the given rate limiter.
+func NewReloadable(limiter Interface) *ReloadableRateLimiter {
+	l := &ReloadableRateLimiter{}
+	l.Set(limiter)
+	return l
+}
+
+// Set replaces the underlying rate limiter.
+// Note that the state of the in-memory rate limiting store is not carried over.
+func (l *ReloadableRateLimiter) Set(limiter Interface) {
+	l.limiter.Store(limiterHolder{limiter})
+}
+
+// RateLimit implements ratelimit.Interface.
+func (l *ReloadableRateLimiter) RateLimit(resource Resource) (bool, Result) {
+	return l.limiter.Load().(limiterHolder).RateLimit(resource)
+}
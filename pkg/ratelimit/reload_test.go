@@ -0,0 +1,53 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestReloadableRateLimiter(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	resource := &mockResource{"key", []string{"default"}}
+	limiter := ratelimit.NewReloadable(&ratelimit.NoopRateLimiter{})
+	limit, _ := limiter.RateLimit(resource)
+	a.So(limit, should.BeFalse)
+
+	profile, err := ratelimit.New(ctx, config.RateLimiting{
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "Reloaded profile",
+				MaxPerMin:    1,
+				Associations: []string{"default"},
+			},
+		},
+	}, config.BlobConfig{}, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	limiter.Set(profile)
+	limit, _ = limiter.RateLimit(resource)
+	a.So(limit, should.BeFalse)
+	limit, result := limiter.RateLimit(resource)
+	a.So(limit, should.BeTrue)
+	a.So(result.Limit, should.Equal, 1)
+}
@@ -101,6 +101,12 @@ func UseSharedFCntDown(v ttnpb.MACVersion) bool {
 	return compareMACVersion(v, ttnpb.MACVersion_MAC_V1_1) < 0
 }
 
+// UseDeviceTimeReq reports whether v is allowed to use the
+// DeviceTime{Req|Ans} MAC commands.
+func UseDeviceTimeReq(v ttnpb.MACVersion) bool {
+	return compareMACVersion(v, ttnpb.MACVersion_MAC_V1_0_3) >= 0
+}
+
 // UseDLChannelReq reports whether v is allowed to use the
 // DLChannel{Req|Ans} MAC commands.
 func UseDLChannelReq(v ttnpb.MACVersion) bool {
@@ -125,6 +131,12 @@ func UseRejoinParamSetupReq(v ttnpb.MACVersion) bool {
 	return compareMACVersion(v, ttnpb.MACVersion_MAC_V1_1) >= 0
 }
 
+// UseForceRejoinReq reports whether v is allowed to use the
+// ForceRejoinReq MAC command.
+func UseForceRejoinReq(v ttnpb.MACVersion) bool {
+	return compareMACVersion(v, ttnpb.MACVersion_MAC_V1_1) >= 0
+}
+
 // UseDeviceModeInd reports whether v is allowed to use the
 // DeviceModeInd MAC command.
 func UseDeviceModeInd(v ttnpb.MACVersion) bool {
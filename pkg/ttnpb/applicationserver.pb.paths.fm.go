@@ -63,6 +63,8 @@ var ApplicationLinkStatsFieldPathsTopLevel = []string{
 var AsConfigurationFieldPathsNested = []string{
 	"pubsub",
 	"pubsub.providers",
+	"pubsub.providers.cloud",
+	"pubsub.providers.kafka",
 	"pubsub.providers.mqtt",
 	"pubsub.providers.nats",
 	"webhooks",
@@ -80,6 +82,8 @@ var GetAsConfigurationResponseFieldPathsNested = []string{
 	"configuration",
 	"configuration.pubsub",
 	"configuration.pubsub.providers",
+	"configuration.pubsub.providers.cloud",
+	"configuration.pubsub.providers.kafka",
 	"configuration.pubsub.providers.mqtt",
 	"configuration.pubsub.providers.nats",
 	"configuration.webhooks",
@@ -97,6 +101,21 @@ var NsAsHandleUplinkRequestFieldPathsNested = []string{
 var NsAsHandleUplinkRequestFieldPathsTopLevel = []string{
 	"application_ups",
 }
+var NsAsPushDownlinksRequestFieldPathsNested = []string{
+	"downlinks",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+}
+
+var NsAsPushDownlinksRequestFieldPathsTopLevel = []string{
+	"downlinks",
+	"end_device_ids",
+}
 var EncodeDownlinkRequestFieldPathsNested = []string{
 	"downlink",
 	"downlink.class_b_c",
@@ -366,6 +385,8 @@ var DecodeDownlinkResponseFieldPathsTopLevel = []string{
 }
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
+	"providers.cloud",
+	"providers.kafka",
 	"providers.mqtt",
 	"providers.nats",
 }
@@ -383,11 +404,15 @@ var AsConfiguration_WebhooksFieldPathsTopLevel = []string{
 	"unhealthy_retry_interval",
 }
 var AsConfiguration_PubSub_ProvidersFieldPathsNested = []string{
+	"cloud",
+	"kafka",
 	"mqtt",
 	"nats",
 }
 
 var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
+	"cloud",
+	"kafka",
 	"mqtt",
 	"nats",
 }
@@ -356,6 +356,51 @@ func (dst *NsAsHandleUplinkRequest) SetFields(src *NsAsHandleUplinkRequest, path
 	return nil
 }
 
+func (dst *NsAsPushDownlinksRequest) SetFields(src *NsAsPushDownlinksRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				dst.Downlinks = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EncodeDownlinkRequest) SetFields(src *EncodeDownlinkRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -866,6 +911,24 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 			} else {
 				dst.Nats = 0
 			}
+		case "kafka":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kafka' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Kafka = src.Kafka
+			} else {
+				dst.Kafka = 0
+			}
+		case "cloud":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cloud' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Cloud = src.Cloud
+			} else {
+				dst.Cloud = 0
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
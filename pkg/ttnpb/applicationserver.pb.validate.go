@@ -881,6 +881,131 @@ var _ interface {
 	ErrorName() string
 } = NsAsHandleUplinkRequestValidationError{}
 
+// ValidateFields checks the field values on NsAsPushDownlinksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NsAsPushDownlinksRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NsAsPushDownlinksRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return NsAsPushDownlinksRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NsAsPushDownlinksRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlinks":
+
+			if l := len(m.GetDownlinks()); l < 1 || l > 16 {
+				return NsAsPushDownlinksRequestValidationError{
+					field:  "downlinks",
+					reason: "value must contain between 1 and 16 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetDownlinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return NsAsPushDownlinksRequestValidationError{
+							field:  fmt.Sprintf("downlinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return NsAsPushDownlinksRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NsAsPushDownlinksRequestValidationError is the validation error returned by
+// NsAsPushDownlinksRequest.ValidateFields if the designated constraints
+// aren't met.
+type NsAsPushDownlinksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NsAsPushDownlinksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NsAsPushDownlinksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NsAsPushDownlinksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NsAsPushDownlinksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NsAsPushDownlinksRequestValidationError) ErrorName() string {
+	return "NsAsPushDownlinksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NsAsPushDownlinksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNsAsPushDownlinksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NsAsPushDownlinksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NsAsPushDownlinksRequestValidationError{}
+
 // ValidateFields checks the field values on EncodeDownlinkRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1794,6 +1919,10 @@ func (m *AsConfiguration_PubSub_Providers) ValidateFields(paths ...string) error
 			// no validation rules for Mqtt
 		case "nats":
 			// no validation rules for Nats
+		case "kafka":
+			// no validation rules for Kafka
+		case "cloud":
+			// no validation rules for Cloud
 		default:
 			return AsConfiguration_PubSub_ProvidersValidationError{
 				field:  name,
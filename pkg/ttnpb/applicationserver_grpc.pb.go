@@ -292,7 +292,8 @@ var As_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	NsAs_HandleUplink_FullMethodName = "/ttn.lorawan.v3.NsAs/HandleUplink"
+	NsAs_HandleUplink_FullMethodName  = "/ttn.lorawan.v3.NsAs/HandleUplink"
+	NsAs_PushDownlinks_FullMethodName = "/ttn.lorawan.v3.NsAs/PushDownlinks"
 )
 
 // NsAsClient is the client API for NsAs service.
@@ -301,6 +302,8 @@ const (
 type NsAsClient interface {
 	// Handle Application uplink messages.
 	HandleUplink(ctx context.Context, in *NsAsHandleUplinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Push application downlink messages to the downlink queue of an end device on behalf of the Network Server.
+	PushDownlinks(ctx context.Context, in *NsAsPushDownlinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type nsAsClient struct {
@@ -320,12 +323,23 @@ func (c *nsAsClient) HandleUplink(ctx context.Context, in *NsAsHandleUplinkReque
 	return out, nil
 }
 
+func (c *nsAsClient) PushDownlinks(ctx context.Context, in *NsAsPushDownlinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NsAs_PushDownlinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsAsServer is the server API for NsAs service.
 // All implementations must embed UnimplementedNsAsServer
 // for forward compatibility
 type NsAsServer interface {
 	// Handle Application uplink messages.
 	HandleUplink(context.Context, *NsAsHandleUplinkRequest) (*emptypb.Empty, error)
+	// Push application downlink messages to the downlink queue of an end device on behalf of the Network Server.
+	PushDownlinks(context.Context, *NsAsPushDownlinksRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedNsAsServer()
 }
 
@@ -336,6 +350,9 @@ type UnimplementedNsAsServer struct {
 func (UnimplementedNsAsServer) HandleUplink(context.Context, *NsAsHandleUplinkRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
 }
+func (UnimplementedNsAsServer) PushDownlinks(context.Context, *NsAsPushDownlinksRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushDownlinks not implemented")
+}
 func (UnimplementedNsAsServer) mustEmbedUnimplementedNsAsServer() {}
 
 // UnsafeNsAsServer may be embedded to opt out of forward compatibility for this service.
@@ -367,6 +384,24 @@ func _NsAs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsAs_PushDownlinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NsAsPushDownlinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsAsServer).PushDownlinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsAs_PushDownlinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsAsServer).PushDownlinks(ctx, req.(*NsAsPushDownlinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NsAs_ServiceDesc is the grpc.ServiceDesc for NsAs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -378,6 +413,10 @@ var NsAs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleUplink",
 			Handler:    _NsAs_HandleUplink_Handler,
 		},
+		{
+			MethodName: "PushDownlinks",
+			Handler:    _NsAs_PushDownlinks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/applicationserver.proto",
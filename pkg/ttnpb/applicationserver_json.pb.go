@@ -288,6 +288,16 @@ func (x *AsConfiguration_PubSub_Providers) MarshalProtoJSON(s *jsonplugin.Marsha
 		s.WriteObjectField("nats")
 		x.Nats.MarshalProtoJSON(s)
 	}
+	if x.Kafka != 0 || s.HasField("kafka") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("kafka")
+		x.Kafka.MarshalProtoJSON(s)
+	}
+	if x.Cloud != 0 || s.HasField("cloud") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("cloud")
+		x.Cloud.MarshalProtoJSON(s)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -311,6 +321,12 @@ func (x *AsConfiguration_PubSub_Providers) UnmarshalProtoJSON(s *jsonplugin.Unma
 		case "nats":
 			s.AddField("nats")
 			x.Nats.UnmarshalProtoJSON(s)
+		case "kafka":
+			s.AddField("kafka")
+			x.Kafka.UnmarshalProtoJSON(s)
+		case "cloud":
+			s.AddField("cloud")
+			x.Cloud.UnmarshalProtoJSON(s)
 		}
 	})
 }
@@ -537,6 +553,81 @@ func (x *NsAsHandleUplinkRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the NsAsPushDownlinksRequest message to JSON.
+func (x *NsAsPushDownlinksRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.Downlinks) > 0 || s.HasField("downlinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Downlinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("downlinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the NsAsPushDownlinksRequest to JSON.
+func (x *NsAsPushDownlinksRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the NsAsPushDownlinksRequest message from JSON.
+func (x *NsAsPushDownlinksRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "downlinks":
+			s.AddField("downlinks")
+			if s.ReadNil() {
+				x.Downlinks = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Downlinks = append(x.Downlinks, nil)
+					return
+				}
+				v := &ApplicationDownlink{}
+				v.UnmarshalProtoJSON(s.WithField("downlinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Downlinks = append(x.Downlinks, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the NsAsPushDownlinksRequest from JSON.
+func (x *NsAsPushDownlinksRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the EncodeDownlinkRequest message to JSON.
 func (x *EncodeDownlinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
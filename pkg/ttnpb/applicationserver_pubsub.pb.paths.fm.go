@@ -55,6 +55,22 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.aws_iot.deployment.default.stack_name",
 	"provider.aws_iot.endpoint_address",
 	"provider.aws_iot.region",
+	"provider.cloud",
+	"provider.cloud.publish_url",
+	"provider.cloud.subscribe_url",
+	"provider.kafka",
+	"provider.kafka.brokers",
+	"provider.kafka.client_id",
+	"provider.kafka.consumer_group",
+	"provider.kafka.idempotent_producer",
+	"provider.kafka.sasl",
+	"provider.kafka.sasl.mechanism",
+	"provider.kafka.sasl.password",
+	"provider.kafka.sasl.username",
+	"provider.kafka.tls_ca",
+	"provider.kafka.tls_client_cert",
+	"provider.kafka.tls_client_key",
+	"provider.kafka.use_tls",
 	"provider.mqtt",
 	"provider.mqtt.client_id",
 	"provider.mqtt.headers",
@@ -180,6 +196,22 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.aws_iot.deployment.default.stack_name",
 	"pubsub.provider.aws_iot.endpoint_address",
 	"pubsub.provider.aws_iot.region",
+	"pubsub.provider.cloud",
+	"pubsub.provider.cloud.publish_url",
+	"pubsub.provider.cloud.subscribe_url",
+	"pubsub.provider.kafka",
+	"pubsub.provider.kafka.brokers",
+	"pubsub.provider.kafka.client_id",
+	"pubsub.provider.kafka.consumer_group",
+	"pubsub.provider.kafka.idempotent_producer",
+	"pubsub.provider.kafka.sasl",
+	"pubsub.provider.kafka.sasl.mechanism",
+	"pubsub.provider.kafka.sasl.password",
+	"pubsub.provider.kafka.sasl.username",
+	"pubsub.provider.kafka.tls_ca",
+	"pubsub.provider.kafka.tls_client_cert",
+	"pubsub.provider.kafka.tls_client_key",
+	"pubsub.provider.kafka.use_tls",
 	"pubsub.provider.mqtt",
 	"pubsub.provider.mqtt.client_id",
 	"pubsub.provider.mqtt.headers",
@@ -241,6 +273,41 @@ var ApplicationPubSub_MQTTProviderFieldPathsTopLevel = []string{
 	"use_tls",
 	"username",
 }
+var ApplicationPubSub_KafkaProviderFieldPathsNested = []string{
+	"brokers",
+	"client_id",
+	"consumer_group",
+	"idempotent_producer",
+	"sasl",
+	"sasl.mechanism",
+	"sasl.password",
+	"sasl.username",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_KafkaProviderFieldPathsTopLevel = []string{
+	"brokers",
+	"client_id",
+	"consumer_group",
+	"idempotent_producer",
+	"sasl",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+var ApplicationPubSub_CloudProviderFieldPathsNested = []string{
+	"publish_url",
+	"subscribe_url",
+}
+
+var ApplicationPubSub_CloudProviderFieldPathsTopLevel = []string{
+	"publish_url",
+	"subscribe_url",
+}
 var ApplicationPubSub_AWSIoTProviderFieldPathsNested = []string{
 	"access_key",
 	"access_key.access_key_id",
@@ -271,6 +338,17 @@ var ApplicationPubSub_MessageFieldPathsNested = []string{
 var ApplicationPubSub_MessageFieldPathsTopLevel = []string{
 	"topic",
 }
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsNested = []string{
+	"mechanism",
+	"password",
+	"username",
+}
+
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsTopLevel = []string{
+	"mechanism",
+	"password",
+	"username",
+}
 var ApplicationPubSub_AWSIoTProvider_AccessKeyFieldPathsNested = []string{
 	"access_key_id",
 	"secret_access_key",
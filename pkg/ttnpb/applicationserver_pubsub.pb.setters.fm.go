@@ -529,6 +529,78 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 							dst.Provider = nil
 						}
 					}
+				case "kafka":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Provider.(*ApplicationPubSub_Kafka)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Provider == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Provider.(*ApplicationPubSub_Kafka)
+					if dstValid := dstTypeOk || dst.Provider == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationPubSub_KafkaProvider
+						if srcTypeOk {
+							newSrc = src.Provider.(*ApplicationPubSub_Kafka).Kafka
+						}
+						if dstTypeOk {
+							newDst = dst.Provider.(*ApplicationPubSub_Kafka).Kafka
+						} else if srcTypeOk {
+							newDst = &ApplicationPubSub_KafkaProvider{}
+							dst.Provider = &ApplicationPubSub_Kafka{Kafka: newDst}
+						} else {
+							dst.Provider = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Provider = src.Provider
+						} else {
+							dst.Provider = nil
+						}
+					}
+				case "cloud":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Provider.(*ApplicationPubSub_Cloud)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Provider == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'cloud', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Provider.(*ApplicationPubSub_Cloud)
+					if dstValid := dstTypeOk || dst.Provider == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'cloud', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationPubSub_CloudProvider
+						if srcTypeOk {
+							newSrc = src.Provider.(*ApplicationPubSub_Cloud).Cloud
+						}
+						if dstTypeOk {
+							newDst = dst.Provider.(*ApplicationPubSub_Cloud).Cloud
+						} else if srcTypeOk {
+							newDst = &ApplicationPubSub_CloudProvider{}
+							dst.Provider = &ApplicationPubSub_Cloud{Cloud: newDst}
+						} else {
+							dst.Provider = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Provider = src.Provider
+						} else {
+							dst.Provider = nil
+						}
+					}
 				case "aws_iot":
 					var srcTypeOk bool
 					if src != nil {
@@ -889,6 +961,149 @@ func (dst *ApplicationPubSub_MQTTProvider) SetFields(src *ApplicationPubSub_MQTT
 	return nil
 }
 
+func (dst *ApplicationPubSub_KafkaProvider) SetFields(src *ApplicationPubSub_KafkaProvider, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "brokers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brokers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Brokers = src.Brokers
+			} else {
+				dst.Brokers = nil
+			}
+		case "client_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientId = src.ClientId
+			} else {
+				var zero string
+				dst.ClientId = zero
+			}
+		case "sasl":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationPubSub_KafkaProvider_SASL
+				if (src == nil || src.Sasl == nil) && dst.Sasl == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Sasl
+				}
+				if dst.Sasl != nil {
+					newDst = dst.Sasl
+				} else {
+					newDst = &ApplicationPubSub_KafkaProvider_SASL{}
+					dst.Sasl = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Sasl = src.Sasl
+				} else {
+					dst.Sasl = nil
+				}
+			}
+		case "use_tls":
+			if len(subs) > 0 {
+				return fmt.Errorf("'use_tls' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UseTls = src.UseTls
+			} else {
+				var zero bool
+				dst.UseTls = zero
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TlsCa = src.TlsCa
+			} else {
+				dst.TlsCa = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TlsClientCert = src.TlsClientCert
+			} else {
+				dst.TlsClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TlsClientKey = src.TlsClientKey
+			} else {
+				dst.TlsClientKey = nil
+			}
+		case "consumer_group":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer_group' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConsumerGroup = src.ConsumerGroup
+			} else {
+				var zero string
+				dst.ConsumerGroup = zero
+			}
+		case "idempotent_producer":
+			if len(subs) > 0 {
+				return fmt.Errorf("'idempotent_producer' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IdempotentProducer = src.IdempotentProducer
+			} else {
+				var zero bool
+				dst.IdempotentProducer = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_CloudProvider) SetFields(src *ApplicationPubSub_CloudProvider, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "publish_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'publish_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PublishUrl = src.PublishUrl
+			} else {
+				var zero string
+				dst.PublishUrl = zero
+			}
+		case "subscribe_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'subscribe_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubscribeUrl = src.SubscribeUrl
+			} else {
+				var zero string
+				dst.SubscribeUrl = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationPubSub_AWSIoTProvider) SetFields(src *ApplicationPubSub_AWSIoTProvider, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1048,6 +1263,46 @@ func (dst *ApplicationPubSub_Message) SetFields(src *ApplicationPubSub_Message,
 	return nil
 }
 
+func (dst *ApplicationPubSub_KafkaProvider_SASL) SetFields(src *ApplicationPubSub_KafkaProvider_SASL, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "mechanism":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mechanism' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mechanism = src.Mechanism
+			} else {
+				dst.Mechanism = 0
+			}
+		case "username":
+			if len(subs) > 0 {
+				return fmt.Errorf("'username' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Username = src.Username
+			} else {
+				var zero string
+				dst.Username = zero
+			}
+		case "password":
+			if len(subs) > 0 {
+				return fmt.Errorf("'password' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Password = src.Password
+			} else {
+				var zero string
+				dst.Password = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationPubSub_AWSIoTProvider_AccessKey) SetFields(src *ApplicationPubSub_AWSIoTProvider_AccessKey, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
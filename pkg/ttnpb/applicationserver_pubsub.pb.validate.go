@@ -399,7 +399,7 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 			}
 			if len(subs) == 0 {
 				subs = []string{
-					"nats", "mqtt", "aws_iot",
+					"nats", "mqtt", "kafka", "cloud", "aws_iot",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -437,6 +437,38 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "kafka":
+					w, ok := m.Provider.(*ApplicationPubSub_Kafka)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetKafka()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "kafka",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "cloud":
+					w, ok := m.Provider.(*ApplicationPubSub_Cloud)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetCloud()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "cloud",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				case "aws_iot":
 					w, ok := m.Provider.(*ApplicationPubSub_AwsIot)
 					if !ok || w == nil {
@@ -1300,6 +1332,297 @@ var _ interface {
 	ErrorName() string
 } = ApplicationPubSub_MQTTProviderValidationError{}
 
+// ValidateFields checks the field values on ApplicationPubSub_KafkaProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_KafkaProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "brokers":
+
+			if l := len(m.GetBrokers()); l < 1 || l > 16 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "brokers",
+					reason: "value must contain between 1 and 16 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetBrokers() {
+				_, _ = idx, item
+
+				if l := utf8.RuneCountInString(item); l < 1 || l > 256 {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  fmt.Sprintf("brokers[%v]", idx),
+						reason: "value length must be between 1 and 256 runes, inclusive",
+					}
+				}
+
+			}
+
+		case "client_id":
+
+			if utf8.RuneCountInString(m.GetClientId()) > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "client_id",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "sasl":
+
+			if v, ok := interface{}(m.GetSasl()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  "sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "use_tls":
+			// no validation rules for UseTls
+		case "tls_ca":
+
+			if len(m.GetTlsCa()) > 8192 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "tls_ca",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_cert":
+
+			if len(m.GetTlsClientCert()) > 8192 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "tls_client_cert",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_key":
+
+			if len(m.GetTlsClientKey()) > 8192 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "tls_client_key",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "consumer_group":
+
+			if utf8.RuneCountInString(m.GetConsumerGroup()) > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "consumer_group",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+			if !_ApplicationPubSub_KafkaProvider_ConsumerGroup_Pattern.MatchString(m.GetConsumerGroup()) {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "consumer_group",
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9._-]*$\"",
+				}
+			}
+
+		case "idempotent_producer":
+			// no validation rules for IdempotentProducer
+		default:
+			return ApplicationPubSub_KafkaProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_KafkaProviderValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_KafkaProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_KafkaProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_KafkaProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_KafkaProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_KafkaProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_KafkaProviderValidationError{}
+
+var _ApplicationPubSub_KafkaProvider_ConsumerGroup_Pattern = regexp.MustCompile("^[a-zA-Z0-9._-]*$")
+
+// ValidateFields checks the field values on ApplicationPubSub_CloudProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_CloudProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_CloudProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "publish_url":
+
+			if utf8.RuneCountInString(m.GetPublishUrl()) > 2048 {
+				return ApplicationPubSub_CloudProviderValidationError{
+					field:  "publish_url",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+			if !_ApplicationPubSub_CloudProvider_PublishUrl_Pattern.MatchString(m.GetPublishUrl()) {
+				return ApplicationPubSub_CloudProviderValidationError{
+					field:  "publish_url",
+					reason: "value does not match regex pattern \"^$|^[a-z][a-z0-9+.-]*://\"",
+				}
+			}
+
+		case "subscribe_url":
+
+			if utf8.RuneCountInString(m.GetSubscribeUrl()) > 2048 {
+				return ApplicationPubSub_CloudProviderValidationError{
+					field:  "subscribe_url",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+			if !_ApplicationPubSub_CloudProvider_SubscribeUrl_Pattern.MatchString(m.GetSubscribeUrl()) {
+				return ApplicationPubSub_CloudProviderValidationError{
+					field:  "subscribe_url",
+					reason: "value does not match regex pattern \"^$|^[a-z][a-z0-9+.-]*://\"",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_CloudProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_CloudProviderValidationError is the validation error
+// returned by ApplicationPubSub_CloudProvider.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_CloudProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_CloudProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_CloudProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_CloudProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_CloudProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_CloudProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_CloudProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_CloudProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_CloudProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_CloudProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_CloudProviderValidationError{}
+
+var _ApplicationPubSub_CloudProvider_PublishUrl_Pattern = regexp.MustCompile("^$|^[a-z][a-z0-9+.-]*://")
+
+var _ApplicationPubSub_CloudProvider_SubscribeUrl_Pattern = regexp.MustCompile("^$|^[a-z][a-z0-9+.-]*://")
+
 // ValidateFields checks the field values on ApplicationPubSub_AWSIoTProvider
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -1574,6 +1897,108 @@ var _ interface {
 	ErrorName() string
 } = ApplicationPubSub_MessageValidationError{}
 
+// ValidateFields checks the field values on
+// ApplicationPubSub_KafkaProvider_SASL with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider_SASL) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_KafkaProvider_SASLFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mechanism":
+			// no validation rules for Mechanism
+		case "username":
+
+			if utf8.RuneCountInString(m.GetUsername()) > 100 {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "username",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "password":
+
+			if utf8.RuneCountInString(m.GetPassword()) > 100 {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "password",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_KafkaProvider_SASLValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_KafkaProvider_SASLValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider_SASL.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_KafkaProvider_SASLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProvider_SASLValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_KafkaProvider_SASL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_KafkaProvider_SASLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_KafkaProvider_SASLValidationError{}
+
 // ValidateFields checks the field values on
 // ApplicationPubSub_AWSIoTProvider_AccessKey with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
@@ -239,6 +239,256 @@ func (m *ApplicationPubSub_MQTTProvider) SetFromFlags(flags *pflag.FlagSet, pref
 	return paths, nil
 }
 
+// AddSelectFlagsForApplicationPubSub_KafkaProvider_SASL adds flags to select fields in ApplicationPubSub_KafkaProvider_SASL.
+func AddSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("mechanism", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("mechanism", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("username", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("username", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("password", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("password", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationPubSub_KafkaProvider_SASL message from select flags.
+func PathsFromSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("mechanism", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("mechanism", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("username", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("username", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("password", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("password", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationPubSub_KafkaProvider_SASL adds flags to select fields in ApplicationPubSub_KafkaProvider_SASL.
+func AddSetFlagsForApplicationPubSub_KafkaProvider_SASL(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("mechanism", prefix), flagsplugin.EnumValueDesc(ApplicationPubSub_KafkaProvider_SASL_Mechanism_value), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("username", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("password", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationPubSub_KafkaProvider_SASL message from flags.
+func (m *ApplicationPubSub_KafkaProvider_SASL) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("mechanism", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		enumValue, err := flagsplugin.SetEnumString(val, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+		if err != nil {
+			return nil, err
+		}
+		m.Mechanism = ApplicationPubSub_KafkaProvider_SASL_Mechanism(enumValue)
+		paths = append(paths, flagsplugin.Prefix("mechanism", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("username", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Username = val
+		paths = append(paths, flagsplugin.Prefix("username", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("password", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Password = val
+		paths = append(paths, flagsplugin.Prefix("password", prefix))
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationPubSub_KafkaProvider adds flags to select fields in ApplicationPubSub_KafkaProvider.
+func AddSelectFlagsForApplicationPubSub_KafkaProvider(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("brokers", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("brokers", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("client-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("client-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("sasl", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("sasl", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags, flagsplugin.Prefix("sasl", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("use-tls", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("use-tls", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tls-ca", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tls-ca", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tls-client-cert", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tls-client-cert", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tls-client-key", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tls-client-key", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("consumer-group", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("consumer-group", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("idempotent-producer", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("idempotent-producer", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationPubSub_KafkaProvider message from select flags.
+func PathsFromSelectFlagsForApplicationPubSub_KafkaProvider(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("brokers", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("brokers", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("client_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("client_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("sasl", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("sasl", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags, flagsplugin.Prefix("sasl", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("use_tls", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("use_tls", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("tls_ca", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("tls_ca", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("tls_client_cert", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("tls_client_cert", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("tls_client_key", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("tls_client_key", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("consumer_group", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("consumer_group", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("idempotent_producer", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("idempotent_producer", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationPubSub_KafkaProvider adds flags to select fields in ApplicationPubSub_KafkaProvider.
+func AddSetFlagsForApplicationPubSub_KafkaProvider(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("brokers", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("client-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationPubSub_KafkaProvider_SASL(flags, flagsplugin.Prefix("sasl", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("use-tls", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewHexBytesFlag(flagsplugin.Prefix("tls-ca", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewHexBytesFlag(flagsplugin.Prefix("tls-client-cert", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewHexBytesFlag(flagsplugin.Prefix("tls-client-key", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("consumer-group", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("idempotent-producer", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationPubSub_KafkaProvider message from flags.
+func (m *ApplicationPubSub_KafkaProvider) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("brokers", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Brokers = val
+		paths = append(paths, flagsplugin.Prefix("brokers", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("client_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.ClientId = val
+		paths = append(paths, flagsplugin.Prefix("client_id", prefix))
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("sasl", prefix)); changed {
+		if m.Sasl == nil {
+			m.Sasl = &ApplicationPubSub_KafkaProvider_SASL{}
+		}
+		if setPaths, err := m.Sasl.SetFromFlags(flags, flagsplugin.Prefix("sasl", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("use_tls", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.UseTls = val
+		paths = append(paths, flagsplugin.Prefix("use_tls", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("tls_ca", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TlsCa = val
+		paths = append(paths, flagsplugin.Prefix("tls_ca", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("tls_client_cert", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TlsClientCert = val
+		paths = append(paths, flagsplugin.Prefix("tls_client_cert", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("tls_client_key", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TlsClientKey = val
+		paths = append(paths, flagsplugin.Prefix("tls_client_key", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("consumer_group", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.ConsumerGroup = val
+		paths = append(paths, flagsplugin.Prefix("consumer_group", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("idempotent_producer", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.IdempotentProducer = val
+		paths = append(paths, flagsplugin.Prefix("idempotent_producer", prefix))
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationPubSub_CloudProvider adds flags to select fields in ApplicationPubSub_CloudProvider.
+func AddSelectFlagsForApplicationPubSub_CloudProvider(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("publish-url", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("publish-url", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("subscribe-url", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("subscribe-url", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationPubSub_CloudProvider message from select flags.
+func PathsFromSelectFlagsForApplicationPubSub_CloudProvider(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("publish_url", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("publish_url", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("subscribe_url", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("subscribe_url", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationPubSub_CloudProvider adds flags to select fields in ApplicationPubSub_CloudProvider.
+func AddSetFlagsForApplicationPubSub_CloudProvider(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("publish-url", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("subscribe-url", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationPubSub_CloudProvider message from flags.
+func (m *ApplicationPubSub_CloudProvider) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("publish_url", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.PublishUrl = val
+		paths = append(paths, flagsplugin.Prefix("publish_url", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("subscribe_url", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.SubscribeUrl = val
+		paths = append(paths, flagsplugin.Prefix("subscribe_url", prefix))
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForApplicationPubSub_AWSIoTProvider_AccessKey adds flags to select fields in ApplicationPubSub_AWSIoTProvider_AccessKey.
 func AddSelectFlagsForApplicationPubSub_AWSIoTProvider_AccessKey(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("access-key-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("access-key-id", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -537,6 +787,10 @@ func AddSelectFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix string, hid
 	AddSelectFlagsForApplicationPubSub_NATSProvider(flags, flagsplugin.Prefix("provider.nats", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("provider.mqtt", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("provider.mqtt", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationPubSub_MQTTProvider(flags, flagsplugin.Prefix("provider.mqtt", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("provider.kafka", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("provider.kafka", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationPubSub_KafkaProvider(flags, flagsplugin.Prefix("provider.kafka", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("provider.cloud", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("provider.cloud", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationPubSub_CloudProvider(flags, flagsplugin.Prefix("provider.cloud", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("provider.aws-iot", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("provider.aws-iot", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationPubSub_AWSIoTProvider(flags, flagsplugin.Prefix("provider.aws-iot", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("base-topic", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("base-topic", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -595,6 +849,26 @@ func PathsFromSelectFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix strin
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("provider.kafka", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("provider.kafka", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationPubSub_KafkaProvider(flags, flagsplugin.Prefix("provider.kafka", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("provider.cloud", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("provider.cloud", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationPubSub_CloudProvider(flags, flagsplugin.Prefix("provider.cloud", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("provider.aws_iot", prefix)); err != nil {
 		return nil, err
 	} else if selected && val {
@@ -749,6 +1023,8 @@ func AddSetFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix string, hidden
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("format", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForApplicationPubSub_NATSProvider(flags, flagsplugin.Prefix("provider.nats", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_MQTTProvider(flags, flagsplugin.Prefix("provider.mqtt", prefix), hidden)
+	AddSetFlagsForApplicationPubSub_KafkaProvider(flags, flagsplugin.Prefix("provider.kafka", prefix), hidden)
+	AddSetFlagsForApplicationPubSub_CloudProvider(flags, flagsplugin.Prefix("provider.cloud", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_AWSIoTProvider(flags, flagsplugin.Prefix("provider.aws-iot", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("base-topic", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("downlink-push", prefix), hidden)
@@ -808,6 +1084,30 @@ func (m *ApplicationPubSub) SetFromFlags(flags *pflag.FlagSet, prefix string) (p
 		}
 		m.Provider = ov
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("provider.kafka", prefix)); changed {
+		ov := &ApplicationPubSub_Kafka{}
+		if ov.Kafka == nil {
+			ov.Kafka = &ApplicationPubSub_KafkaProvider{}
+		}
+		if setPaths, err := ov.Kafka.SetFromFlags(flags, flagsplugin.Prefix("provider.kafka", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+		m.Provider = ov
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("provider.cloud", prefix)); changed {
+		ov := &ApplicationPubSub_Cloud{}
+		if ov.Cloud == nil {
+			ov.Cloud = &ApplicationPubSub_CloudProvider{}
+		}
+		if setPaths, err := ov.Cloud.SetFromFlags(flags, flagsplugin.Prefix("provider.cloud", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+		m.Provider = ov
+	}
 	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("provider.aws_iot", prefix)); changed {
 		ov := &ApplicationPubSub_AwsIot{}
 		if ov.AwsIot == nil {
@@ -187,6 +187,218 @@ func (x *ApplicationPubSub_MQTTProvider) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the ApplicationPubSub_KafkaProvider_SASL_Mechanism to JSON.
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), ApplicationPubSub_KafkaProvider_SASL_Mechanism_name)
+}
+
+// MarshalText marshals the ApplicationPubSub_KafkaProvider_SASL_Mechanism to text.
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), ApplicationPubSub_KafkaProvider_SASL_Mechanism_name)), nil
+}
+
+// MarshalJSON marshals the ApplicationPubSub_KafkaProvider_SASL_Mechanism to JSON.
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationPubSub_KafkaProvider_SASL_Mechanism from JSON.
+func (x *ApplicationPubSub_KafkaProvider_SASL_Mechanism) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Mechanism enum: %v", err)
+		return
+	}
+	*x = ApplicationPubSub_KafkaProvider_SASL_Mechanism(v)
+}
+
+// UnmarshalText unmarshals the ApplicationPubSub_KafkaProvider_SASL_Mechanism from text.
+func (x *ApplicationPubSub_KafkaProvider_SASL_Mechanism) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+	if err != nil {
+		return err
+	}
+	*x = ApplicationPubSub_KafkaProvider_SASL_Mechanism(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the ApplicationPubSub_KafkaProvider_SASL_Mechanism from JSON.
+func (x *ApplicationPubSub_KafkaProvider_SASL_Mechanism) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationPubSub_KafkaProvider_SASL message to JSON.
+func (x *ApplicationPubSub_KafkaProvider_SASL) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Mechanism != 0 || s.HasField("mechanism") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mechanism")
+		x.Mechanism.MarshalProtoJSON(s)
+	}
+	if x.Username != "" || s.HasField("username") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("username")
+		s.WriteString(x.Username)
+	}
+	if x.Password != "" || s.HasField("password") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("password")
+		s.WriteString(x.Password)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationPubSub_KafkaProvider_SASL to JSON.
+func (x *ApplicationPubSub_KafkaProvider_SASL) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationPubSub_KafkaProvider_SASL message from JSON.
+func (x *ApplicationPubSub_KafkaProvider_SASL) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "mechanism":
+			s.AddField("mechanism")
+			x.Mechanism.UnmarshalProtoJSON(s)
+		case "username":
+			s.AddField("username")
+			x.Username = s.ReadString()
+		case "password":
+			s.AddField("password")
+			x.Password = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationPubSub_KafkaProvider_SASL from JSON.
+func (x *ApplicationPubSub_KafkaProvider_SASL) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationPubSub_KafkaProvider message to JSON.
+func (x *ApplicationPubSub_KafkaProvider) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Brokers) > 0 || s.HasField("brokers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("brokers")
+		s.WriteStringArray(x.Brokers)
+	}
+	if x.ClientId != "" || s.HasField("client_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("client_id")
+		s.WriteString(x.ClientId)
+	}
+	if x.Sasl != nil || s.HasField("sasl") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("sasl")
+		x.Sasl.MarshalProtoJSON(s.WithField("sasl"))
+	}
+	if x.UseTls || s.HasField("use_tls") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("use_tls")
+		s.WriteBool(x.UseTls)
+	}
+	if len(x.TlsCa) > 0 || s.HasField("tls_ca") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tls_ca")
+		s.WriteBytes(x.TlsCa)
+	}
+	if len(x.TlsClientCert) > 0 || s.HasField("tls_client_cert") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tls_client_cert")
+		s.WriteBytes(x.TlsClientCert)
+	}
+	if len(x.TlsClientKey) > 0 || s.HasField("tls_client_key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tls_client_key")
+		s.WriteBytes(x.TlsClientKey)
+	}
+	if x.ConsumerGroup != "" || s.HasField("consumer_group") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("consumer_group")
+		s.WriteString(x.ConsumerGroup)
+	}
+	if x.IdempotentProducer || s.HasField("idempotent_producer") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("idempotent_producer")
+		s.WriteBool(x.IdempotentProducer)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationPubSub_KafkaProvider to JSON.
+func (x *ApplicationPubSub_KafkaProvider) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationPubSub_KafkaProvider message from JSON.
+func (x *ApplicationPubSub_KafkaProvider) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "brokers":
+			s.AddField("brokers")
+			if s.ReadNil() {
+				x.Brokers = nil
+				return
+			}
+			x.Brokers = s.ReadStringArray()
+		case "client_id", "clientId":
+			s.AddField("client_id")
+			x.ClientId = s.ReadString()
+		case "sasl":
+			if s.ReadNil() {
+				x.Sasl = nil
+				return
+			}
+			x.Sasl = &ApplicationPubSub_KafkaProvider_SASL{}
+			x.Sasl.UnmarshalProtoJSON(s.WithField("sasl", true))
+		case "use_tls", "useTls":
+			s.AddField("use_tls")
+			x.UseTls = s.ReadBool()
+		case "tls_ca", "tlsCa":
+			s.AddField("tls_ca")
+			x.TlsCa = s.ReadBytes()
+		case "tls_client_cert", "tlsClientCert":
+			s.AddField("tls_client_cert")
+			x.TlsClientCert = s.ReadBytes()
+		case "tls_client_key", "tlsClientKey":
+			s.AddField("tls_client_key")
+			x.TlsClientKey = s.ReadBytes()
+		case "consumer_group", "consumerGroup":
+			s.AddField("consumer_group")
+			x.ConsumerGroup = s.ReadString()
+		case "idempotent_producer", "idempotentProducer":
+			s.AddField("idempotent_producer")
+			x.IdempotentProducer = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationPubSub_KafkaProvider from JSON.
+func (x *ApplicationPubSub_KafkaProvider) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the ApplicationPubSub message to JSON.
 func (x *ApplicationPubSub) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -235,6 +447,15 @@ func (x *ApplicationPubSub) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteMoreIf(&wroteField)
 			s.WriteObjectField("mqtt")
 			ov.Mqtt.MarshalProtoJSON(s.WithField("mqtt"))
+		case *ApplicationPubSub_Kafka:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("kafka")
+			ov.Kafka.MarshalProtoJSON(s.WithField("kafka"))
+		case *ApplicationPubSub_Cloud:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("cloud")
+			// NOTE: ApplicationPubSub_CloudProvider does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Cloud)
 		case *ApplicationPubSub_AwsIot:
 			s.WriteMoreIf(&wroteField)
 			s.WriteObjectField("aws_iot")
@@ -398,6 +619,27 @@ func (x *ApplicationPubSub) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			}
 			ov.Mqtt = &ApplicationPubSub_MQTTProvider{}
 			ov.Mqtt.UnmarshalProtoJSON(s.WithField("mqtt", true))
+		case "kafka":
+			ov := &ApplicationPubSub_Kafka{}
+			x.Provider = ov
+			if s.ReadNil() {
+				ov.Kafka = nil
+				return
+			}
+			ov.Kafka = &ApplicationPubSub_KafkaProvider{}
+			ov.Kafka.UnmarshalProtoJSON(s.WithField("kafka", true))
+		case "cloud":
+			s.AddField("cloud")
+			ov := &ApplicationPubSub_Cloud{}
+			x.Provider = ov
+			if s.ReadNil() {
+				ov.Cloud = nil
+				return
+			}
+			// NOTE: ApplicationPubSub_CloudProvider does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationPubSub_CloudProvider
+			golang.UnmarshalMessage(s, &v)
+			ov.Cloud = &v
 		case "aws_iot", "awsIot":
 			s.AddField("aws_iot")
 			ov := &ApplicationPubSub_AwsIot{}
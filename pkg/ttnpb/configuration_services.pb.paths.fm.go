@@ -138,6 +138,13 @@ var ListBandsResponseFieldPathsNested = []string{
 var ListBandsResponseFieldPathsTopLevel = []string{
 	"descriptions",
 }
+var ReloadConfigurationResponseFieldPathsNested = []string{
+	"changed_keys",
+}
+
+var ReloadConfigurationResponseFieldPathsTopLevel = []string{
+	"changed_keys",
+}
 var GetPhyVersionsResponse_VersionInfoFieldPathsNested = []string{
 	"band_id",
 	"phy_versions",
@@ -571,6 +571,26 @@ func (dst *ListBandsResponse) SetFields(src *ListBandsResponse, paths ...string)
 	return nil
 }
 
+func (dst *ReloadConfigurationResponse) SetFields(src *ReloadConfigurationResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "changed_keys":
+			if len(subs) > 0 {
+				return fmt.Errorf("'changed_keys' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChangedKeys = src.ChangedKeys
+			} else {
+				dst.ChangedKeys = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *GetPhyVersionsResponse_VersionInfo) SetFields(src *GetPhyVersionsResponse_VersionInfo, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -25,6 +25,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -33,9 +34,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Configuration_ListFrequencyPlans_FullMethodName = "/ttn.lorawan.v3.Configuration/ListFrequencyPlans"
-	Configuration_GetPhyVersions_FullMethodName     = "/ttn.lorawan.v3.Configuration/GetPhyVersions"
-	Configuration_ListBands_FullMethodName          = "/ttn.lorawan.v3.Configuration/ListBands"
+	Configuration_ListFrequencyPlans_FullMethodName  = "/ttn.lorawan.v3.Configuration/ListFrequencyPlans"
+	Configuration_GetPhyVersions_FullMethodName      = "/ttn.lorawan.v3.Configuration/GetPhyVersions"
+	Configuration_ListBands_FullMethodName           = "/ttn.lorawan.v3.Configuration/ListBands"
+	Configuration_ReloadConfiguration_FullMethodName = "/ttn.lorawan.v3.Configuration/ReloadConfiguration"
 )
 
 // ConfigurationClient is the client API for Configuration service.
@@ -46,6 +48,10 @@ type ConfigurationClient interface {
 	// Returns a list of supported LoRaWAN PHY Versions for the given Band ID.
 	GetPhyVersions(ctx context.Context, in *GetPhyVersionsRequest, opts ...grpc.CallOption) (*GetPhyVersionsResponse, error)
 	ListBands(ctx context.Context, in *ListBandsRequest, opts ...grpc.CallOption) (*ListBandsResponse, error)
+	// Reload the configuration of the component that serves the request.
+	// Only changes to hot-reloadable configuration are applied; changes to other configuration require a restart.
+	// This requires admin rights.
+	ReloadConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error)
 }
 
 type configurationClient struct {
@@ -83,6 +89,15 @@ func (c *configurationClient) ListBands(ctx context.Context, in *ListBandsReques
 	return out, nil
 }
 
+func (c *configurationClient) ReloadConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error) {
+	out := new(ReloadConfigurationResponse)
+	err := c.cc.Invoke(ctx, Configuration_ReloadConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigurationServer is the server API for Configuration service.
 // All implementations must embed UnimplementedConfigurationServer
 // for forward compatibility
@@ -91,6 +106,10 @@ type ConfigurationServer interface {
 	// Returns a list of supported LoRaWAN PHY Versions for the given Band ID.
 	GetPhyVersions(context.Context, *GetPhyVersionsRequest) (*GetPhyVersionsResponse, error)
 	ListBands(context.Context, *ListBandsRequest) (*ListBandsResponse, error)
+	// Reload the configuration of the component that serves the request.
+	// Only changes to hot-reloadable configuration are applied; changes to other configuration require a restart.
+	// This requires admin rights.
+	ReloadConfiguration(context.Context, *emptypb.Empty) (*ReloadConfigurationResponse, error)
 	mustEmbedUnimplementedConfigurationServer()
 }
 
@@ -107,6 +126,9 @@ func (UnimplementedConfigurationServer) GetPhyVersions(context.Context, *GetPhyV
 func (UnimplementedConfigurationServer) ListBands(context.Context, *ListBandsRequest) (*ListBandsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBands not implemented")
 }
+func (UnimplementedConfigurationServer) ReloadConfiguration(context.Context, *emptypb.Empty) (*ReloadConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfiguration not implemented")
+}
 func (UnimplementedConfigurationServer) mustEmbedUnimplementedConfigurationServer() {}
 
 // UnsafeConfigurationServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +196,24 @@ func _Configuration_ListBands_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Configuration_ReloadConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).ReloadConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Configuration_ReloadConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).ReloadConfiguration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Configuration_ServiceDesc is the grpc.ServiceDesc for Configuration service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +233,10 @@ var Configuration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBands",
 			Handler:    _Configuration_ListBands_Handler,
 		},
+		{
+			MethodName: "ReloadConfiguration",
+			Handler:    _Configuration_ReloadConfiguration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/configuration_services.proto",
@@ -1445,6 +1445,24 @@ func (v *MACParameters) FieldIsZero(p string) bool {
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *MACCommand_ForceRejoinReq) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "data_rate_index":
+		return v.DataRateIndex == 0
+	case "max_retries":
+		return v.MaxRetries == 0
+	case "period_exponent":
+		return v.PeriodExponent == 0
+	case "rejoin_type":
+		return v.RejoinType == 0
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // FieldIsZero returns whether path p is zero.
 func (v *MACState_JoinRequest) FieldIsZero(p string) bool {
 	if v == nil {
@@ -1827,6 +1845,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.LastNetworkInitiatedDownlinkAt == nil
 	case "lorawan_version":
 		return v.LorawanVersion == 0
+	case "min_rj_count_0":
+		return v.MinRjCount_0 == 0
 	case "pending_application_downlink":
 		return v.PendingApplicationDownlink == nil
 	case "pending_application_downlink.class_b_c":
@@ -1981,6 +2001,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.PendingJoinRequest.FieldIsZero("rx_delay")
 	case "pending_join_request.selected_mac_version":
 		return v.PendingJoinRequest.FieldIsZero("selected_mac_version")
+	case "pending_operator_requests":
+		return v.PendingOperatorRequests == nil
 	case "pending_relay_downlink":
 		return v.PendingRelayDownlink == nil
 	case "pending_relay_downlink.raw_payload":
@@ -1991,6 +2013,16 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.PingSlotPeriodicity == nil
 	case "ping_slot_periodicity.value":
 		return v.PingSlotPeriodicity.FieldIsZero("value")
+	case "queued_force_rejoin_req":
+		return v.QueuedForceRejoinReq == nil
+	case "queued_force_rejoin_req.data_rate_index":
+		return v.QueuedForceRejoinReq.FieldIsZero("data_rate_index")
+	case "queued_force_rejoin_req.max_retries":
+		return v.QueuedForceRejoinReq.FieldIsZero("max_retries")
+	case "queued_force_rejoin_req.period_exponent":
+		return v.QueuedForceRejoinReq.FieldIsZero("period_exponent")
+	case "queued_force_rejoin_req.rejoin_type":
+		return v.QueuedForceRejoinReq.FieldIsZero("rejoin_type")
 	case "queued_join_accept":
 		return v.QueuedJoinAccept == nil
 	case "queued_join_accept.correlation_ids":
@@ -2057,6 +2089,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.QueuedJoinAccept.FieldIsZero("request.downlink_settings.rx2_dr")
 	case "queued_join_accept.request.rx_delay":
 		return v.QueuedJoinAccept.FieldIsZero("request.rx_delay")
+	case "queued_operator_commands":
+		return v.QueuedOperatorCommands == nil
 	case "queued_responses":
 		return v.QueuedResponses == nil
 	case "recent_downlinks":
@@ -1054,6 +1054,7 @@ var MACStateFieldPathsNested = []string{
 	"last_downlink_at",
 	"last_network_initiated_downlink_at",
 	"lorawan_version",
+	"min_rj_count_0",
 	"pending_application_downlink",
 	"pending_application_downlink.class_b_c",
 	"pending_application_downlink.class_b_c.absolute_time",
@@ -1080,11 +1081,17 @@ var MACStateFieldPathsNested = []string{
 	"pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_join_request.downlink_settings.rx2_dr",
 	"pending_join_request.rx_delay",
+	"pending_operator_requests",
 	"pending_relay_downlink",
 	"pending_relay_downlink.raw_payload",
 	"pending_requests",
 	"ping_slot_periodicity",
 	"ping_slot_periodicity.value",
+	"queued_force_rejoin_req",
+	"queued_force_rejoin_req.data_rate_index",
+	"queued_force_rejoin_req.max_retries",
+	"queued_force_rejoin_req.period_exponent",
+	"queued_force_rejoin_req.rejoin_type",
 	"queued_join_accept",
 	"queued_join_accept.correlation_ids",
 	"queued_join_accept.dev_addr",
@@ -1118,6 +1125,7 @@ var MACStateFieldPathsNested = []string{
 	"queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"queued_join_accept.request.downlink_settings.rx2_dr",
 	"queued_join_accept.request.rx_delay",
+	"queued_operator_commands",
 	"queued_responses",
 	"recent_downlinks",
 	"recent_mac_command_identifiers",
@@ -1139,12 +1147,16 @@ var MACStateFieldPathsTopLevel = []string{
 	"last_downlink_at",
 	"last_network_initiated_downlink_at",
 	"lorawan_version",
+	"min_rj_count_0",
 	"pending_application_downlink",
 	"pending_join_request",
+	"pending_operator_requests",
 	"pending_relay_downlink",
 	"pending_requests",
 	"ping_slot_periodicity",
+	"queued_force_rejoin_req",
 	"queued_join_accept",
+	"queued_operator_commands",
 	"queued_responses",
 	"recent_downlinks",
 	"recent_mac_command_identifiers",
@@ -1544,6 +1556,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.last_downlink_at",
 	"mac_state.last_network_initiated_downlink_at",
 	"mac_state.lorawan_version",
+	"mac_state.min_rj_count_0",
 	"mac_state.pending_application_downlink",
 	"mac_state.pending_application_downlink.class_b_c",
 	"mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -1570,11 +1583,17 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"mac_state.pending_join_request.rx_delay",
+	"mac_state.pending_operator_requests",
 	"mac_state.pending_relay_downlink",
 	"mac_state.pending_relay_downlink.raw_payload",
 	"mac_state.pending_requests",
 	"mac_state.ping_slot_periodicity",
 	"mac_state.ping_slot_periodicity.value",
+	"mac_state.queued_force_rejoin_req",
+	"mac_state.queued_force_rejoin_req.data_rate_index",
+	"mac_state.queued_force_rejoin_req.max_retries",
+	"mac_state.queued_force_rejoin_req.period_exponent",
+	"mac_state.queued_force_rejoin_req.rejoin_type",
 	"mac_state.queued_join_accept",
 	"mac_state.queued_join_accept.correlation_ids",
 	"mac_state.queued_join_accept.dev_addr",
@@ -1608,6 +1627,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"mac_state.queued_join_accept.request.rx_delay",
+	"mac_state.queued_operator_commands",
 	"mac_state.queued_responses",
 	"mac_state.recent_downlinks",
 	"mac_state.recent_mac_command_identifiers",
@@ -1760,6 +1780,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.last_downlink_at",
 	"pending_mac_state.last_network_initiated_downlink_at",
 	"pending_mac_state.lorawan_version",
+	"pending_mac_state.min_rj_count_0",
 	"pending_mac_state.pending_application_downlink",
 	"pending_mac_state.pending_application_downlink.class_b_c",
 	"pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -1786,11 +1807,17 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"pending_mac_state.pending_join_request.rx_delay",
+	"pending_mac_state.pending_operator_requests",
 	"pending_mac_state.pending_relay_downlink",
 	"pending_mac_state.pending_relay_downlink.raw_payload",
 	"pending_mac_state.pending_requests",
 	"pending_mac_state.ping_slot_periodicity",
 	"pending_mac_state.ping_slot_periodicity.value",
+	"pending_mac_state.queued_force_rejoin_req",
+	"pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"pending_mac_state.queued_force_rejoin_req.max_retries",
+	"pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"pending_mac_state.queued_join_accept",
 	"pending_mac_state.queued_join_accept.correlation_ids",
 	"pending_mac_state.queued_join_accept.dev_addr",
@@ -1824,6 +1851,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"pending_mac_state.queued_join_accept.request.rx_delay",
+	"pending_mac_state.queued_operator_commands",
 	"pending_mac_state.queued_responses",
 	"pending_mac_state.recent_downlinks",
 	"pending_mac_state.recent_mac_command_identifiers",
@@ -2372,6 +2400,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
 	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.min_rj_count_0",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -2398,11 +2427,17 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_operator_requests",
 	"end_device.mac_state.pending_relay_downlink",
 	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.correlation_ids",
 	"end_device.mac_state.queued_join_accept.dev_addr",
@@ -2436,6 +2471,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.recent_downlinks",
 	"end_device.mac_state.recent_mac_command_identifiers",
@@ -2588,6 +2624,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
 	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.min_rj_count_0",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -2614,11 +2651,17 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_operator_requests",
 	"end_device.pending_mac_state.pending_relay_downlink",
 	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.correlation_ids",
 	"end_device.pending_mac_state.queued_join_accept.dev_addr",
@@ -2652,6 +2695,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.recent_downlinks",
 	"end_device.pending_mac_state.recent_mac_command_identifiers",
@@ -3132,6 +3176,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
 	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.min_rj_count_0",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -3158,11 +3203,17 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_operator_requests",
 	"end_device.mac_state.pending_relay_downlink",
 	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.correlation_ids",
 	"end_device.mac_state.queued_join_accept.dev_addr",
@@ -3196,6 +3247,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.recent_downlinks",
 	"end_device.mac_state.recent_mac_command_identifiers",
@@ -3348,6 +3400,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
 	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.min_rj_count_0",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -3374,11 +3427,17 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_operator_requests",
 	"end_device.pending_mac_state.pending_relay_downlink",
 	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.correlation_ids",
 	"end_device.pending_mac_state.queued_join_accept.dev_addr",
@@ -3412,6 +3471,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.recent_downlinks",
 	"end_device.pending_mac_state.recent_mac_command_identifiers",
@@ -3943,6 +4003,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
 	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.min_rj_count_0",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -3969,11 +4030,17 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_operator_requests",
 	"end_device.mac_state.pending_relay_downlink",
 	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.correlation_ids",
 	"end_device.mac_state.queued_join_accept.dev_addr",
@@ -4007,6 +4074,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.recent_downlinks",
 	"end_device.mac_state.recent_mac_command_identifiers",
@@ -4159,6 +4227,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
 	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.min_rj_count_0",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -4185,11 +4254,17 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_operator_requests",
 	"end_device.pending_mac_state.pending_relay_downlink",
 	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.correlation_ids",
 	"end_device.pending_mac_state.queued_join_accept.dev_addr",
@@ -4223,6 +4298,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.recent_downlinks",
 	"end_device.pending_mac_state.recent_mac_command_identifiers",
@@ -4720,6 +4796,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
 	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.min_rj_count_0",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -4746,11 +4823,17 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_operator_requests",
 	"end_device.mac_state.pending_relay_downlink",
 	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.correlation_ids",
 	"end_device.mac_state.queued_join_accept.dev_addr",
@@ -4784,6 +4867,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.recent_downlinks",
 	"end_device.mac_state.recent_mac_command_identifiers",
@@ -4936,6 +5020,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
 	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.min_rj_count_0",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -4962,11 +5047,17 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_operator_requests",
 	"end_device.pending_mac_state.pending_relay_downlink",
 	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.correlation_ids",
 	"end_device.pending_mac_state.queued_join_accept.dev_addr",
@@ -5000,6 +5091,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.recent_downlinks",
 	"end_device.pending_mac_state.recent_mac_command_identifiers",
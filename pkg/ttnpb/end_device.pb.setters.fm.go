@@ -2845,6 +2845,59 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 					dst.PendingRelayDownlink = nil
 				}
 			}
+		case "queued_operator_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queued_operator_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueuedOperatorCommands = src.QueuedOperatorCommands
+			} else {
+				dst.QueuedOperatorCommands = nil
+			}
+		case "pending_operator_requests":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending_operator_requests' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PendingOperatorRequests = src.PendingOperatorRequests
+			} else {
+				dst.PendingOperatorRequests = nil
+			}
+		case "queued_force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.QueuedForceRejoinReq == nil) && dst.QueuedForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.QueuedForceRejoinReq
+				}
+				if dst.QueuedForceRejoinReq != nil {
+					newDst = dst.QueuedForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.QueuedForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.QueuedForceRejoinReq = src.QueuedForceRejoinReq
+				} else {
+					dst.QueuedForceRejoinReq = nil
+				}
+			}
+		case "min_rj_count_0":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_rj_count_0' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinRjCount_0 = src.MinRjCount_0
+			} else {
+				var zero uint32
+				dst.MinRjCount_0 = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2873,6 +2873,68 @@ func (m *MACState) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "queued_operator_commands":
+
+			if len(m.GetQueuedOperatorCommands()) > 16 {
+				return MACStateValidationError{
+					field:  "queued_operator_commands",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetQueuedOperatorCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACStateValidationError{
+							field:  fmt.Sprintf("queued_operator_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "pending_operator_requests":
+
+			if len(m.GetPendingOperatorRequests()) > 16 {
+				return MACStateValidationError{
+					field:  "pending_operator_requests",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetPendingOperatorRequests() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACStateValidationError{
+							field:  fmt.Sprintf("pending_operator_requests[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "queued_force_rejoin_req":
+
+			if v, ok := interface{}(m.GetQueuedForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACStateValidationError{
+						field:  "queued_force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "min_rj_count_0":
+			// no validation rules for MinRjCount_0
 		default:
 			return MACStateValidationError{
 				field:  name,
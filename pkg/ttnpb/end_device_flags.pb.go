@@ -3547,6 +3547,11 @@ func AddSelectFlagsForMACState(flags *pflag.FlagSet, prefix string, hidden bool)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("recent-mac-command-identifiers", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("recent-mac-command-identifiers", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("pending-relay-downlink", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("pending-relay-downlink", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForRelayForwardDownlinkReq(flags, flagsplugin.Prefix("pending-relay-downlink", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("queued-operator-commands", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("queued-operator-commands", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("pending-operator-requests", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("pending-operator-requests", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("queued-force-rejoin-req", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("queued-force-rejoin-req", prefix), true), flagsplugin.WithHidden(hidden)))
+	// NOTE: queued_force_rejoin_req (MACCommand_ForceRejoinReq) does not seem to have select flags.
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("min-rj-count-0", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("min-rj-count-0", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forMACState message from select flags.
@@ -3706,6 +3711,27 @@ func PathsFromSelectFlagsForMACState(flags *pflag.FlagSet, prefix string) (paths
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("queued_operator_commands", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("queued_operator_commands", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("pending_operator_requests", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("pending_operator_requests", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("queued_force_rejoin_req", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("queued_force_rejoin_req", prefix))
+	}
+	// NOTE: queued_force_rejoin_req (MACCommand_ForceRejoinReq) does not seem to have select flags.
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("min_rj_count_0", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("min_rj_count_0", prefix))
+	}
 	return paths, nil
 }
 
@@ -3736,6 +3762,10 @@ func AddSetFlagsForMACState(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("last-adr-change-f-cnt-up", prefix), "", flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("recent-mac-command-identifiers", prefix), flagsplugin.EnumValueDesc(MACCommandIdentifier_value), flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForRelayForwardDownlinkReq(flags, flagsplugin.Prefix("pending-relay-downlink", prefix), hidden)
+	// FIXME: Skipping QueuedOperatorCommands because repeated messages are currently not supported.
+	// FIXME: Skipping PendingOperatorRequests because repeated messages are currently not supported.
+	// FIXME: Skipping QueuedForceRejoinReq because it does not seem to implement AddSetFlags.
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("min-rj-count-0", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the MACState message from flags.
@@ -3909,6 +3939,15 @@ func (m *MACState) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []st
 			paths = append(paths, setPaths...)
 		}
 	}
+	// FIXME: Skipping QueuedOperatorCommands because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping PendingOperatorRequests because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping QueuedForceRejoinReq because it does not seem to implement AddSetFlags.
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("min_rj_count_0", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.MinRjCount_0 = val
+		paths = append(paths, flagsplugin.Prefix("min_rj_count_0", prefix))
+	}
 	return paths, nil
 }
 
@@ -3199,6 +3199,38 @@ func (x *MACState) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: RelayForwardDownlinkReq does not seem to implement MarshalProtoJSON.
 		golang.MarshalMessage(s, x.PendingRelayDownlink)
 	}
+	if len(x.QueuedOperatorCommands) > 0 || s.HasField("queued_operator_commands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("queued_operator_commands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.QueuedOperatorCommands {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("queued_operator_commands"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.PendingOperatorRequests) > 0 || s.HasField("pending_operator_requests") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_operator_requests")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.PendingOperatorRequests {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("pending_operator_requests"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.QueuedForceRejoinReq != nil || s.HasField("queued_force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("queued_force_rejoin_req")
+		x.QueuedForceRejoinReq.MarshalProtoJSON(s.WithField("queued_force_rejoin_req"))
+	}
+	if x.MinRjCount_0 != 0 || s.HasField("min_rj_count_0") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("min_rj_count_0")
+		s.WriteUint32(x.MinRjCount_0)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -3437,6 +3469,52 @@ func (x *MACState) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			var v RelayForwardDownlinkReq
 			golang.UnmarshalMessage(s, &v)
 			x.PendingRelayDownlink = &v
+		case "queued_operator_commands", "queuedOperatorCommands":
+			s.AddField("queued_operator_commands")
+			if s.ReadNil() {
+				x.QueuedOperatorCommands = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.QueuedOperatorCommands = append(x.QueuedOperatorCommands, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("queued_operator_commands", false))
+				if s.Err() != nil {
+					return
+				}
+				x.QueuedOperatorCommands = append(x.QueuedOperatorCommands, v)
+			})
+		case "pending_operator_requests", "pendingOperatorRequests":
+			s.AddField("pending_operator_requests")
+			if s.ReadNil() {
+				x.PendingOperatorRequests = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.PendingOperatorRequests = append(x.PendingOperatorRequests, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("pending_operator_requests", false))
+				if s.Err() != nil {
+					return
+				}
+				x.PendingOperatorRequests = append(x.PendingOperatorRequests, v)
+			})
+		case "queued_force_rejoin_req", "queuedForceRejoinReq":
+			if s.ReadNil() {
+				x.QueuedForceRejoinReq = nil
+				return
+			}
+			x.QueuedForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.QueuedForceRejoinReq.UnmarshalProtoJSON(s.WithField("queued_force_rejoin_req", true))
+		case "min_rj_count_0", "minRjCount0":
+			s.AddField("min_rj_count_0")
+			x.MinRjCount_0 = s.ReadUint32()
 		}
 	})
 }
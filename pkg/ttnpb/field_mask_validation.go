@@ -449,11 +449,18 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
 	"mac_state.pending_application_downlink.session_key_id",
+	"mac_state.pending_operator_requests",
 	"mac_state.pending_relay_downlink",
 	"mac_state.pending_relay_downlink.raw_payload",
 	"mac_state.pending_requests",
 	"mac_state.ping_slot_periodicity",
 	"mac_state.ping_slot_periodicity.value",
+	"mac_state.queued_force_rejoin_req",
+	"mac_state.queued_force_rejoin_req.data_rate_index",
+	"mac_state.queued_force_rejoin_req.max_retries",
+	"mac_state.queued_force_rejoin_req.period_exponent",
+	"mac_state.queued_force_rejoin_req.rejoin_type",
+	"mac_state.queued_operator_commands",
 	"mac_state.queued_responses",
 	"mac_state.recent_downlinks",
 	"mac_state.recent_mac_command_identifiers",
@@ -1625,6 +1632,32 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		Set:     true,
 	},
 
+	// Network Server security monitor:
+	"/ttn.lorawan.v3.NsSecurityMonitor/SetSecurityMonitorSettings": {
+		All: SecurityMonitorSettingsFieldPathsNested,
+		Allowed: omitFields(SecurityMonitorSettingsFieldPathsNested,
+			"application_ids",
+			"application_ids.application_id",
+			"created_at",
+			"updated_at"),
+		Set: true,
+	},
+
+	// Gateway Server gateway alerts:
+	"/ttn.lorawan.v3.GsGatewayAlerts/UpdateGatewayAlertRule": {
+		All: GatewayAlertRuleFieldPathsNested,
+		Allowed: []string{
+			"condition",
+			"disabled",
+			"email",
+			"min_tx_acknowledgments",
+			"receivers",
+			"threshold",
+			"tx_failure_ratio",
+		},
+		Set: true,
+	},
+
 	// Gateways:
 	"/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways": {
 		All: GatewayFieldPathsNested,
@@ -1689,6 +1722,20 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 	},
 
 	// Gateway API Keys:
+	// Gateway Configuration Server gateway firmware:
+	"/ttn.lorawan.v3.GatewayFirmwareRegistry/Update": {
+		All: GatewayFirmwareFieldPathsNested,
+		Allowed: []string{
+			"description",
+			"model",
+			"package",
+			"rollout_percentage",
+			"station",
+			"update_channel",
+		},
+		Set: true,
+	},
+
 	"/ttn.lorawan.v3.GatewayAccess/UpdateAPIKey": {
 		All:     APIKeyFieldPathsNested,
 		Allowed: APIKeyFieldPathsNested,
@@ -1793,6 +1840,27 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		Allowed: UserBookmarkFieldPathsNested,
 	},
 
+	// Notification Channels:
+	"/ttn.lorawan.v3.NotificationService/UpdateChannel": {
+		All: NotificationChannelFieldPathsNested,
+		Allowed: []string{
+			"channel",
+			"channel.matrix",
+			"channel.matrix.access_token",
+			"channel.matrix.homeserver_url",
+			"channel.matrix.room_id",
+			"channel.slack",
+			"channel.slack.url",
+			"channel.webhook",
+			"channel.webhook.headers",
+			"channel.webhook.secret",
+			"channel.webhook.url",
+			"disabled",
+			"notification_types",
+		},
+		Set: true,
+	},
+
 	// Storage Integration:
 	"/ttn.lorawan.v3.ApplicationUpStorage/GetStoredApplicationUp": {
 		All:     ApplicationUpFieldPathsNested,
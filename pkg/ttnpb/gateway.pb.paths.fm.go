@@ -851,6 +851,7 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 	"round_trip_times.min",
 	"sub_bands",
 	"tx_acknowledgment_count",
+	"tx_acknowledgment_failure_count",
 	"uplink_count",
 }
 
@@ -868,6 +869,7 @@ var GatewayConnectionStatsFieldPathsTopLevel = []string{
 	"round_trip_times",
 	"sub_bands",
 	"tx_acknowledgment_count",
+	"tx_acknowledgment_failure_count",
 	"uplink_count",
 }
 var GatewayRadio_TxConfigurationFieldPathsNested = []string{
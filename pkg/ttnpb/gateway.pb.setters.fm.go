@@ -2885,6 +2885,16 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 				var zero uint64
 				dst.TxAcknowledgmentCount = zero
 			}
+		case "tx_acknowledgment_failure_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_acknowledgment_failure_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxAcknowledgmentFailureCount = src.TxAcknowledgmentFailureCount
+			} else {
+				var zero uint64
+				dst.TxAcknowledgmentFailureCount = zero
+			}
 		case "round_trip_times":
 			if len(subs) > 0 {
 				var newDst, newSrc *GatewayConnectionStats_RoundTripTimes
@@ -5389,6 +5389,8 @@ func (m *GatewayConnectionStats) ValidateFields(paths ...string) error {
 
 		case "tx_acknowledgment_count":
 			// no validation rules for TxAcknowledgmentCount
+		case "tx_acknowledgment_failure_count":
+			// no validation rules for TxAcknowledgmentFailureCount
 		case "round_trip_times":
 
 			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
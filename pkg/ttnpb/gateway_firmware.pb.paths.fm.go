@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var GatewayFirmwareFieldPathsNested = []string{
+	"created_at",
+	"description",
+	"firmware_id",
+	"model",
+	"package",
+	"rollout_percentage",
+	"sha512",
+	"size",
+	"station",
+	"update_channel",
+	"updated_at",
+}
+
+var GatewayFirmwareFieldPathsTopLevel = []string{
+	"created_at",
+	"description",
+	"firmware_id",
+	"model",
+	"package",
+	"rollout_percentage",
+	"sha512",
+	"size",
+	"station",
+	"update_channel",
+	"updated_at",
+}
+var GatewayFirmwaresFieldPathsNested = []string{
+	"firmwares",
+}
+
+var GatewayFirmwaresFieldPathsTopLevel = []string{
+	"firmwares",
+}
+var CreateGatewayFirmwareRequestFieldPathsNested = []string{
+	"data",
+	"firmware",
+	"firmware.created_at",
+	"firmware.description",
+	"firmware.firmware_id",
+	"firmware.model",
+	"firmware.package",
+	"firmware.rollout_percentage",
+	"firmware.sha512",
+	"firmware.size",
+	"firmware.station",
+	"firmware.update_channel",
+	"firmware.updated_at",
+}
+
+var CreateGatewayFirmwareRequestFieldPathsTopLevel = []string{
+	"data",
+	"firmware",
+}
+var GetGatewayFirmwareRequestFieldPathsNested = []string{
+	"firmware_id",
+}
+
+var GetGatewayFirmwareRequestFieldPathsTopLevel = []string{
+	"firmware_id",
+}
+var ListGatewayFirmwaresRequestFieldPathsNested = []string{
+	"model",
+}
+
+var ListGatewayFirmwaresRequestFieldPathsTopLevel = []string{
+	"model",
+}
+var UpdateGatewayFirmwareRequestFieldPathsNested = []string{
+	"field_mask",
+	"firmware",
+	"firmware.created_at",
+	"firmware.description",
+	"firmware.firmware_id",
+	"firmware.model",
+	"firmware.package",
+	"firmware.rollout_percentage",
+	"firmware.sha512",
+	"firmware.size",
+	"firmware.station",
+	"firmware.update_channel",
+	"firmware.updated_at",
+}
+
+var UpdateGatewayFirmwareRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"firmware",
+}
+var DeleteGatewayFirmwareRequestFieldPathsNested = []string{
+	"firmware_id",
+}
+
+var DeleteGatewayFirmwareRequestFieldPathsTopLevel = []string{
+	"firmware_id",
+}
+var GatewayFirmwareUpdateFieldPathsNested = []string{
+	"model",
+	"package",
+	"previous_package",
+	"station",
+}
+
+var GatewayFirmwareUpdateFieldPathsTopLevel = []string{
+	"model",
+	"package",
+	"previous_package",
+	"station",
+}
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import fmt "fmt"
+
+func (dst *GatewayFirmware) SetFields(src *GatewayFirmware, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "firmware_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'firmware_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FirmwareId = src.FirmwareId
+			} else {
+				var zero string
+				dst.FirmwareId = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "station":
+			if len(subs) > 0 {
+				return fmt.Errorf("'station' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Station = src.Station
+			} else {
+				var zero string
+				dst.Station = zero
+			}
+		case "model":
+			if len(subs) > 0 {
+				return fmt.Errorf("'model' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Model = src.Model
+			} else {
+				var zero string
+				dst.Model = zero
+			}
+		case "package":
+			if len(subs) > 0 {
+				return fmt.Errorf("'package' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Package = src.Package
+			} else {
+				var zero string
+				dst.Package = zero
+			}
+		case "update_channel":
+			if len(subs) > 0 {
+				return fmt.Errorf("'update_channel' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdateChannel = src.UpdateChannel
+			} else {
+				var zero string
+				dst.UpdateChannel = zero
+			}
+		case "rollout_percentage":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rollout_percentage' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RolloutPercentage = src.RolloutPercentage
+			} else {
+				var zero uint32
+				dst.RolloutPercentage = zero
+			}
+		case "size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Size = src.Size
+			} else {
+				var zero uint64
+				dst.Size = zero
+			}
+		case "sha512":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sha512' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Sha512 = src.Sha512
+			} else {
+				dst.Sha512 = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayFirmwares) SetFields(src *GatewayFirmwares, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "firmwares":
+			if len(subs) > 0 {
+				return fmt.Errorf("'firmwares' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Firmwares = src.Firmwares
+			} else {
+				dst.Firmwares = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateGatewayFirmwareRequest) SetFields(src *CreateGatewayFirmwareRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "firmware":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayFirmware
+				if (src == nil || src.Firmware == nil) && dst.Firmware == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Firmware
+				}
+				if dst.Firmware != nil {
+					newDst = dst.Firmware
+				} else {
+					newDst = &GatewayFirmware{}
+					dst.Firmware = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Firmware = src.Firmware
+				} else {
+					dst.Firmware = nil
+				}
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayFirmwareRequest) SetFields(src *GetGatewayFirmwareRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "firmware_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'firmware_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FirmwareId = src.FirmwareId
+			} else {
+				var zero string
+				dst.FirmwareId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListGatewayFirmwaresRequest) SetFields(src *ListGatewayFirmwaresRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "model":
+			if len(subs) > 0 {
+				return fmt.Errorf("'model' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Model = src.Model
+			} else {
+				var zero string
+				dst.Model = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UpdateGatewayFirmwareRequest) SetFields(src *UpdateGatewayFirmwareRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "firmware":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayFirmware
+				if (src == nil || src.Firmware == nil) && dst.Firmware == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Firmware
+				}
+				if dst.Firmware != nil {
+					newDst = dst.Firmware
+				} else {
+					newDst = &GatewayFirmware{}
+					dst.Firmware = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Firmware = src.Firmware
+				} else {
+					dst.Firmware = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteGatewayFirmwareRequest) SetFields(src *DeleteGatewayFirmwareRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "firmware_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'firmware_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FirmwareId = src.FirmwareId
+			} else {
+				var zero string
+				dst.FirmwareId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayFirmwareUpdate) SetFields(src *GatewayFirmwareUpdate, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "previous_package":
+			if len(subs) > 0 {
+				return fmt.Errorf("'previous_package' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PreviousPackage = src.PreviousPackage
+			} else {
+				var zero string
+				dst.PreviousPackage = zero
+			}
+		case "package":
+			if len(subs) > 0 {
+				return fmt.Errorf("'package' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Package = src.Package
+			} else {
+				var zero string
+				dst.Package = zero
+			}
+		case "station":
+			if len(subs) > 0 {
+				return fmt.Errorf("'station' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Station = src.Station
+			} else {
+				var zero string
+				dst.Station = zero
+			}
+		case "model":
+			if len(subs) > 0 {
+				return fmt.Errorf("'model' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Model = src.Model
+			} else {
+				var zero string
+				dst.Model = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
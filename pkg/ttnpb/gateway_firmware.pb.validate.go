@@ -0,0 +1,911 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on GatewayFirmware with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayFirmware) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayFirmwareFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "firmware_id":
+
+			if utf8.RuneCountInString(m.GetFirmwareId()) > 36 {
+				return GatewayFirmwareValidationError{
+					field:  "firmware_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayFirmware_FirmwareId_Pattern.MatchString(m.GetFirmwareId()) {
+				return GatewayFirmwareValidationError{
+					field:  "firmware_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayFirmwareValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayFirmwareValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+				return GatewayFirmwareValidationError{
+					field:  "description",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		case "station":
+
+			if utf8.RuneCountInString(m.GetStation()) > 100 {
+				return GatewayFirmwareValidationError{
+					field:  "station",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "model":
+
+			if l := utf8.RuneCountInString(m.GetModel()); l < 1 || l > 100 {
+				return GatewayFirmwareValidationError{
+					field:  "model",
+					reason: "value length must be between 1 and 100 runes, inclusive",
+				}
+			}
+
+		case "package":
+
+			if l := utf8.RuneCountInString(m.GetPackage()); l < 1 || l > 100 {
+				return GatewayFirmwareValidationError{
+					field:  "package",
+					reason: "value length must be between 1 and 100 runes, inclusive",
+				}
+			}
+
+		case "update_channel":
+
+			if utf8.RuneCountInString(m.GetUpdateChannel()) > 128 {
+				return GatewayFirmwareValidationError{
+					field:  "update_channel",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "rollout_percentage":
+
+			if m.GetRolloutPercentage() > 100 {
+				return GatewayFirmwareValidationError{
+					field:  "rollout_percentage",
+					reason: "value must be less than or equal to 100",
+				}
+			}
+
+		case "size":
+			// no validation rules for Size
+		case "sha512":
+			// no validation rules for Sha512
+		default:
+			return GatewayFirmwareValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayFirmwareValidationError is the validation error returned by
+// GatewayFirmware.ValidateFields if the designated constraints aren't met.
+type GatewayFirmwareValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayFirmwareValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayFirmwareValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayFirmwareValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayFirmwareValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayFirmwareValidationError) ErrorName() string { return "GatewayFirmwareValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayFirmwareValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayFirmware.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayFirmwareValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayFirmwareValidationError{}
+
+var _GatewayFirmware_FirmwareId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on GatewayFirmwares with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayFirmwares) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayFirmwaresFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "firmwares":
+
+			for idx, item := range m.GetFirmwares() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayFirmwaresValidationError{
+							field:  fmt.Sprintf("firmwares[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayFirmwaresValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayFirmwaresValidationError is the validation error returned by
+// GatewayFirmwares.ValidateFields if the designated constraints aren't met.
+type GatewayFirmwaresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayFirmwaresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayFirmwaresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayFirmwaresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayFirmwaresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayFirmwaresValidationError) ErrorName() string { return "GatewayFirmwaresValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayFirmwaresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayFirmwares.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayFirmwaresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayFirmwaresValidationError{}
+
+// ValidateFields checks the field values on CreateGatewayFirmwareRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateGatewayFirmwareRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateGatewayFirmwareRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "firmware":
+
+			if m.GetFirmware() == nil {
+				return CreateGatewayFirmwareRequestValidationError{
+					field:  "firmware",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetFirmware()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateGatewayFirmwareRequestValidationError{
+						field:  "firmware",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "data":
+
+			if l := len(m.GetData()); l < 1 || l > 15728640 {
+				return CreateGatewayFirmwareRequestValidationError{
+					field:  "data",
+					reason: "value length must be between 1 and 15728640 bytes, inclusive",
+				}
+			}
+
+		default:
+			return CreateGatewayFirmwareRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateGatewayFirmwareRequestValidationError is the validation error returned
+// by CreateGatewayFirmwareRequest.ValidateFields if the designated
+// constraints aren't met.
+type CreateGatewayFirmwareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGatewayFirmwareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGatewayFirmwareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGatewayFirmwareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGatewayFirmwareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGatewayFirmwareRequestValidationError) ErrorName() string {
+	return "CreateGatewayFirmwareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGatewayFirmwareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGatewayFirmwareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGatewayFirmwareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGatewayFirmwareRequestValidationError{}
+
+// ValidateFields checks the field values on GetGatewayFirmwareRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetGatewayFirmwareRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayFirmwareRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "firmware_id":
+
+			if utf8.RuneCountInString(m.GetFirmwareId()) > 36 {
+				return GetGatewayFirmwareRequestValidationError{
+					field:  "firmware_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GetGatewayFirmwareRequest_FirmwareId_Pattern.MatchString(m.GetFirmwareId()) {
+				return GetGatewayFirmwareRequestValidationError{
+					field:  "firmware_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return GetGatewayFirmwareRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayFirmwareRequestValidationError is the validation error returned by
+// GetGatewayFirmwareRequest.ValidateFields if the designated constraints
+// aren't met.
+type GetGatewayFirmwareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayFirmwareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayFirmwareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayFirmwareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayFirmwareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayFirmwareRequestValidationError) ErrorName() string {
+	return "GetGatewayFirmwareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayFirmwareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayFirmwareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayFirmwareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayFirmwareRequestValidationError{}
+
+var _GetGatewayFirmwareRequest_FirmwareId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ListGatewayFirmwaresRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListGatewayFirmwaresRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListGatewayFirmwaresRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "model":
+
+			if utf8.RuneCountInString(m.GetModel()) > 100 {
+				return ListGatewayFirmwaresRequestValidationError{
+					field:  "model",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ListGatewayFirmwaresRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListGatewayFirmwaresRequestValidationError is the validation error returned
+// by ListGatewayFirmwaresRequest.ValidateFields if the designated constraints
+// aren't met.
+type ListGatewayFirmwaresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGatewayFirmwaresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGatewayFirmwaresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGatewayFirmwaresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGatewayFirmwaresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGatewayFirmwaresRequestValidationError) ErrorName() string {
+	return "ListGatewayFirmwaresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGatewayFirmwaresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGatewayFirmwaresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGatewayFirmwaresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGatewayFirmwaresRequestValidationError{}
+
+// ValidateFields checks the field values on UpdateGatewayFirmwareRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpdateGatewayFirmwareRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UpdateGatewayFirmwareRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "firmware":
+
+			if m.GetFirmware() == nil {
+				return UpdateGatewayFirmwareRequestValidationError{
+					field:  "firmware",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetFirmware()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateGatewayFirmwareRequestValidationError{
+						field:  "firmware",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateGatewayFirmwareRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return UpdateGatewayFirmwareRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateGatewayFirmwareRequestValidationError is the validation error returned
+// by UpdateGatewayFirmwareRequest.ValidateFields if the designated
+// constraints aren't met.
+type UpdateGatewayFirmwareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGatewayFirmwareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGatewayFirmwareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGatewayFirmwareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGatewayFirmwareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGatewayFirmwareRequestValidationError) ErrorName() string {
+	return "UpdateGatewayFirmwareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGatewayFirmwareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGatewayFirmwareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGatewayFirmwareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGatewayFirmwareRequestValidationError{}
+
+// ValidateFields checks the field values on DeleteGatewayFirmwareRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DeleteGatewayFirmwareRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteGatewayFirmwareRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "firmware_id":
+
+			if utf8.RuneCountInString(m.GetFirmwareId()) > 36 {
+				return DeleteGatewayFirmwareRequestValidationError{
+					field:  "firmware_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_DeleteGatewayFirmwareRequest_FirmwareId_Pattern.MatchString(m.GetFirmwareId()) {
+				return DeleteGatewayFirmwareRequestValidationError{
+					field:  "firmware_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return DeleteGatewayFirmwareRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteGatewayFirmwareRequestValidationError is the validation error returned
+// by DeleteGatewayFirmwareRequest.ValidateFields if the designated
+// constraints aren't met.
+type DeleteGatewayFirmwareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGatewayFirmwareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGatewayFirmwareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGatewayFirmwareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGatewayFirmwareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGatewayFirmwareRequestValidationError) ErrorName() string {
+	return "DeleteGatewayFirmwareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGatewayFirmwareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGatewayFirmwareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGatewayFirmwareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGatewayFirmwareRequestValidationError{}
+
+var _DeleteGatewayFirmwareRequest_FirmwareId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on GatewayFirmwareUpdate with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayFirmwareUpdate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayFirmwareUpdateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "previous_package":
+			// no validation rules for PreviousPackage
+		case "package":
+			// no validation rules for Package
+		case "station":
+			// no validation rules for Station
+		case "model":
+			// no validation rules for Model
+		default:
+			return GatewayFirmwareUpdateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayFirmwareUpdateValidationError is the validation error returned by
+// GatewayFirmwareUpdate.ValidateFields if the designated constraints aren't met.
+type GatewayFirmwareUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayFirmwareUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayFirmwareUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayFirmwareUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayFirmwareUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayFirmwareUpdateValidationError) ErrorName() string {
+	return "GatewayFirmwareUpdateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayFirmwareUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayFirmwareUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayFirmwareUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayFirmwareUpdateValidationError{}
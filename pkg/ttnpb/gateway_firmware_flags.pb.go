@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-flags. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-flags v1.2.0
+// - protoc              v4.23.4
+// source: ttn/lorawan/v3/gateway_firmware.proto
+
+package ttnpb
+
+import (
+	flagsplugin "github.com/TheThingsIndustries/protoc-gen-go-flags/flagsplugin"
+	pflag "github.com/spf13/pflag"
+)
+
+// AddSelectFlagsForGatewayFirmware adds flags to select fields in GatewayFirmware.
+func AddSelectFlagsForGatewayFirmware(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("firmware-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("firmware-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("description", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("description", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("station", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("station", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("model", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("model", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("package", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("package", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("update-channel", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("update-channel", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("rollout-percentage", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("rollout-percentage", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forGatewayFirmware message from select flags.
+func PathsFromSelectFlagsForGatewayFirmware(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("firmware_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("firmware_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("description", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("description", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("station", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("station", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("model", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("model", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("package", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("package", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("update_channel", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("update_channel", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("rollout_percentage", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("rollout_percentage", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForGatewayFirmware adds flags to select fields in GatewayFirmware.
+func AddSetFlagsForGatewayFirmware(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("firmware-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("description", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("station", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("model", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("package", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("update-channel", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("rollout-percentage", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the GatewayFirmware message from flags.
+func (m *GatewayFirmware) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("firmware_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.FirmwareId = val
+		paths = append(paths, flagsplugin.Prefix("firmware_id", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("description", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Description = val
+		paths = append(paths, flagsplugin.Prefix("description", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("station", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Station = val
+		paths = append(paths, flagsplugin.Prefix("station", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("model", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Model = val
+		paths = append(paths, flagsplugin.Prefix("model", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("package", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Package = val
+		paths = append(paths, flagsplugin.Prefix("package", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("update_channel", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.UpdateChannel = val
+		paths = append(paths, flagsplugin.Prefix("update_channel", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("rollout_percentage", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.RolloutPercentage = val
+		paths = append(paths, flagsplugin.Prefix("rollout_percentage", prefix))
+	}
+	return paths, nil
+}
@@ -0,0 +1,275 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: ttn/lorawan/v3/gateway_firmware.proto
+
+package ttnpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GatewayFirmwareRegistry_Create_FullMethodName = "/ttn.lorawan.v3.GatewayFirmwareRegistry/Create"
+	GatewayFirmwareRegistry_Get_FullMethodName    = "/ttn.lorawan.v3.GatewayFirmwareRegistry/Get"
+	GatewayFirmwareRegistry_List_FullMethodName   = "/ttn.lorawan.v3.GatewayFirmwareRegistry/List"
+	GatewayFirmwareRegistry_Update_FullMethodName = "/ttn.lorawan.v3.GatewayFirmwareRegistry/Update"
+	GatewayFirmwareRegistry_Delete_FullMethodName = "/ttn.lorawan.v3.GatewayFirmwareRegistry/Delete"
+)
+
+// GatewayFirmwareRegistryClient is the client API for GatewayFirmwareRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GatewayFirmwareRegistryClient interface {
+	Create(ctx context.Context, in *CreateGatewayFirmwareRequest, opts ...grpc.CallOption) (*GatewayFirmware, error)
+	Get(ctx context.Context, in *GetGatewayFirmwareRequest, opts ...grpc.CallOption) (*GatewayFirmware, error)
+	List(ctx context.Context, in *ListGatewayFirmwaresRequest, opts ...grpc.CallOption) (*GatewayFirmwares, error)
+	// Update the firmware metadata, for example to extend the rollout percentage.
+	Update(ctx context.Context, in *UpdateGatewayFirmwareRequest, opts ...grpc.CallOption) (*GatewayFirmware, error)
+	Delete(ctx context.Context, in *DeleteGatewayFirmwareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gatewayFirmwareRegistryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayFirmwareRegistryClient(cc grpc.ClientConnInterface) GatewayFirmwareRegistryClient {
+	return &gatewayFirmwareRegistryClient{cc}
+}
+
+func (c *gatewayFirmwareRegistryClient) Create(ctx context.Context, in *CreateGatewayFirmwareRequest, opts ...grpc.CallOption) (*GatewayFirmware, error) {
+	out := new(GatewayFirmware)
+	err := c.cc.Invoke(ctx, GatewayFirmwareRegistry_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayFirmwareRegistryClient) Get(ctx context.Context, in *GetGatewayFirmwareRequest, opts ...grpc.CallOption) (*GatewayFirmware, error) {
+	out := new(GatewayFirmware)
+	err := c.cc.Invoke(ctx, GatewayFirmwareRegistry_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayFirmwareRegistryClient) List(ctx context.Context, in *ListGatewayFirmwaresRequest, opts ...grpc.CallOption) (*GatewayFirmwares, error) {
+	out := new(GatewayFirmwares)
+	err := c.cc.Invoke(ctx, GatewayFirmwareRegistry_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayFirmwareRegistryClient) Update(ctx context.Context, in *UpdateGatewayFirmwareRequest, opts ...grpc.CallOption) (*GatewayFirmware, error) {
+	out := new(GatewayFirmware)
+	err := c.cc.Invoke(ctx, GatewayFirmwareRegistry_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayFirmwareRegistryClient) Delete(ctx context.Context, in *DeleteGatewayFirmwareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayFirmwareRegistry_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayFirmwareRegistryServer is the server API for GatewayFirmwareRegistry service.
+// All implementations must embed UnimplementedGatewayFirmwareRegistryServer
+// for forward compatibility
+type GatewayFirmwareRegistryServer interface {
+	Create(context.Context, *CreateGatewayFirmwareRequest) (*GatewayFirmware, error)
+	Get(context.Context, *GetGatewayFirmwareRequest) (*GatewayFirmware, error)
+	List(context.Context, *ListGatewayFirmwaresRequest) (*GatewayFirmwares, error)
+	// Update the firmware metadata, for example to extend the rollout percentage.
+	Update(context.Context, *UpdateGatewayFirmwareRequest) (*GatewayFirmware, error)
+	Delete(context.Context, *DeleteGatewayFirmwareRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGatewayFirmwareRegistryServer()
+}
+
+// UnimplementedGatewayFirmwareRegistryServer must be embedded to have forward compatible implementations.
+type UnimplementedGatewayFirmwareRegistryServer struct {
+}
+
+func (UnimplementedGatewayFirmwareRegistryServer) Create(context.Context, *CreateGatewayFirmwareRequest) (*GatewayFirmware, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGatewayFirmwareRegistryServer) Get(context.Context, *GetGatewayFirmwareRequest) (*GatewayFirmware, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGatewayFirmwareRegistryServer) List(context.Context, *ListGatewayFirmwaresRequest) (*GatewayFirmwares, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGatewayFirmwareRegistryServer) Update(context.Context, *UpdateGatewayFirmwareRequest) (*GatewayFirmware, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGatewayFirmwareRegistryServer) Delete(context.Context, *DeleteGatewayFirmwareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGatewayFirmwareRegistryServer) mustEmbedUnimplementedGatewayFirmwareRegistryServer() {
+}
+
+// UnsafeGatewayFirmwareRegistryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GatewayFirmwareRegistryServer will
+// result in compilation errors.
+type UnsafeGatewayFirmwareRegistryServer interface {
+	mustEmbedUnimplementedGatewayFirmwareRegistryServer()
+}
+
+func RegisterGatewayFirmwareRegistryServer(s grpc.ServiceRegistrar, srv GatewayFirmwareRegistryServer) {
+	s.RegisterService(&GatewayFirmwareRegistry_ServiceDesc, srv)
+}
+
+func _GatewayFirmwareRegistry_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGatewayFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayFirmwareRegistryServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayFirmwareRegistry_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayFirmwareRegistryServer).Create(ctx, req.(*CreateGatewayFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayFirmwareRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayFirmwareRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayFirmwareRegistry_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayFirmwareRegistryServer).Get(ctx, req.(*GetGatewayFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayFirmwareRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayFirmwaresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayFirmwareRegistryServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayFirmwareRegistry_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayFirmwareRegistryServer).List(ctx, req.(*ListGatewayFirmwaresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayFirmwareRegistry_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGatewayFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayFirmwareRegistryServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayFirmwareRegistry_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayFirmwareRegistryServer).Update(ctx, req.(*UpdateGatewayFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayFirmwareRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGatewayFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayFirmwareRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayFirmwareRegistry_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayFirmwareRegistryServer).Delete(ctx, req.(*DeleteGatewayFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GatewayFirmwareRegistry_ServiceDesc is the grpc.ServiceDesc for GatewayFirmwareRegistry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GatewayFirmwareRegistry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GatewayFirmwareRegistry",
+	HandlerType: (*GatewayFirmwareRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GatewayFirmwareRegistry_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GatewayFirmwareRegistry_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GatewayFirmwareRegistry_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GatewayFirmwareRegistry_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GatewayFirmwareRegistry_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ttn/lorawan/v3/gateway_firmware.proto",
+}
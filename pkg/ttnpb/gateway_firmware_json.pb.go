@@ -0,0 +1,82 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/gateway_firmware.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the UpdateGatewayFirmwareRequest message to JSON.
+func (x *UpdateGatewayFirmwareRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Firmware != nil || s.HasField("firmware") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("firmware")
+		// NOTE: GatewayFirmware does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Firmware)
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UpdateGatewayFirmwareRequest to JSON.
+func (x *UpdateGatewayFirmwareRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UpdateGatewayFirmwareRequest message from JSON.
+func (x *UpdateGatewayFirmwareRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "firmware":
+			s.AddField("firmware")
+			if s.ReadNil() {
+				x.Firmware = nil
+				return
+			}
+			// NOTE: GatewayFirmware does not seem to implement UnmarshalProtoJSON.
+			var v GatewayFirmware
+			golang.UnmarshalMessage(s, &v)
+			x.Firmware = &v
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UpdateGatewayFirmwareRequest from JSON.
+func (x *UpdateGatewayFirmwareRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -2752,6 +2752,11 @@ func (x *GatewayConnectionStats) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("tx_acknowledgment_count")
 		s.WriteUint64(x.TxAcknowledgmentCount)
 	}
+	if x.TxAcknowledgmentFailureCount != 0 || s.HasField("tx_acknowledgment_failure_count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tx_acknowledgment_failure_count")
+		s.WriteUint64(x.TxAcknowledgmentFailureCount)
+	}
 	if x.RoundTripTimes != nil || s.HasField("round_trip_times") {
 		s.WriteMoreIf(&wroteField)
 		s.WriteObjectField("round_trip_times")
@@ -2878,6 +2883,9 @@ func (x *GatewayConnectionStats) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState
 		case "tx_acknowledgment_count", "txAcknowledgmentCount":
 			s.AddField("tx_acknowledgment_count")
 			x.TxAcknowledgmentCount = s.ReadUint64()
+		case "tx_acknowledgment_failure_count", "txAcknowledgmentFailureCount":
+			s.AddField("tx_acknowledgment_failure_count")
+			x.TxAcknowledgmentFailureCount = s.ReadUint64()
 		case "round_trip_times", "roundTripTimes":
 			s.AddField("round_trip_times")
 			if s.ReadNil() {
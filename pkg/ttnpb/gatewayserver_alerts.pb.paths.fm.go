@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var GatewayAlertRuleFieldPathsNested = []string{
+	"condition",
+	"created_at",
+	"disabled",
+	"email",
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+	"gateway_ids",
+	"min_tx_acknowledgments",
+	"receivers",
+	"rule_id",
+	"threshold",
+	"tx_failure_ratio",
+	"updated_at",
+}
+
+var GatewayAlertRuleFieldPathsTopLevel = []string{
+	"condition",
+	"created_at",
+	"disabled",
+	"email",
+	"entity_ids",
+	"gateway_ids",
+	"min_tx_acknowledgments",
+	"receivers",
+	"rule_id",
+	"threshold",
+	"tx_failure_ratio",
+	"updated_at",
+}
+var GatewayAlertRulesFieldPathsNested = []string{
+	"rules",
+}
+
+var GatewayAlertRulesFieldPathsTopLevel = []string{
+	"rules",
+}
+var GatewayAlertRuleIdentifiersFieldPathsNested = []string{
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+	"rule_id",
+}
+
+var GatewayAlertRuleIdentifiersFieldPathsTopLevel = []string{
+	"entity_ids",
+	"rule_id",
+}
+var CreateGatewayAlertRuleRequestFieldPathsNested = []string{
+	"rule",
+	"rule.condition",
+	"rule.created_at",
+	"rule.disabled",
+	"rule.email",
+	"rule.entity_ids",
+	"rule.entity_ids.ids",
+	"rule.entity_ids.ids.application_ids",
+	"rule.entity_ids.ids.application_ids.application_id",
+	"rule.entity_ids.ids.client_ids",
+	"rule.entity_ids.ids.client_ids.client_id",
+	"rule.entity_ids.ids.device_ids",
+	"rule.entity_ids.ids.device_ids.application_ids",
+	"rule.entity_ids.ids.device_ids.application_ids.application_id",
+	"rule.entity_ids.ids.device_ids.dev_addr",
+	"rule.entity_ids.ids.device_ids.dev_eui",
+	"rule.entity_ids.ids.device_ids.device_id",
+	"rule.entity_ids.ids.device_ids.join_eui",
+	"rule.entity_ids.ids.gateway_ids",
+	"rule.entity_ids.ids.gateway_ids.eui",
+	"rule.entity_ids.ids.gateway_ids.gateway_id",
+	"rule.entity_ids.ids.organization_ids",
+	"rule.entity_ids.ids.organization_ids.organization_id",
+	"rule.entity_ids.ids.user_ids",
+	"rule.entity_ids.ids.user_ids.email",
+	"rule.entity_ids.ids.user_ids.user_id",
+	"rule.gateway_ids",
+	"rule.min_tx_acknowledgments",
+	"rule.receivers",
+	"rule.rule_id",
+	"rule.threshold",
+	"rule.tx_failure_ratio",
+	"rule.updated_at",
+}
+
+var CreateGatewayAlertRuleRequestFieldPathsTopLevel = []string{
+	"rule",
+}
+var UpdateGatewayAlertRuleRequestFieldPathsNested = []string{
+	"field_mask",
+	"rule",
+	"rule.condition",
+	"rule.created_at",
+	"rule.disabled",
+	"rule.email",
+	"rule.entity_ids",
+	"rule.entity_ids.ids",
+	"rule.entity_ids.ids.application_ids",
+	"rule.entity_ids.ids.application_ids.application_id",
+	"rule.entity_ids.ids.client_ids",
+	"rule.entity_ids.ids.client_ids.client_id",
+	"rule.entity_ids.ids.device_ids",
+	"rule.entity_ids.ids.device_ids.application_ids",
+	"rule.entity_ids.ids.device_ids.application_ids.application_id",
+	"rule.entity_ids.ids.device_ids.dev_addr",
+	"rule.entity_ids.ids.device_ids.dev_eui",
+	"rule.entity_ids.ids.device_ids.device_id",
+	"rule.entity_ids.ids.device_ids.join_eui",
+	"rule.entity_ids.ids.gateway_ids",
+	"rule.entity_ids.ids.gateway_ids.eui",
+	"rule.entity_ids.ids.gateway_ids.gateway_id",
+	"rule.entity_ids.ids.organization_ids",
+	"rule.entity_ids.ids.organization_ids.organization_id",
+	"rule.entity_ids.ids.user_ids",
+	"rule.entity_ids.ids.user_ids.email",
+	"rule.entity_ids.ids.user_ids.user_id",
+	"rule.gateway_ids",
+	"rule.min_tx_acknowledgments",
+	"rule.receivers",
+	"rule.rule_id",
+	"rule.threshold",
+	"rule.tx_failure_ratio",
+	"rule.updated_at",
+}
+
+var UpdateGatewayAlertRuleRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"rule",
+}
+var ListGatewayAlertRulesRequestFieldPathsNested = []string{
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+}
+
+var ListGatewayAlertRulesRequestFieldPathsTopLevel = []string{
+	"entity_ids",
+}
+var GatewayAlertFieldPathsNested = []string{
+	"condition",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"observed",
+	"observed_tx_failure_ratio",
+	"raised_at",
+	"recovered_at",
+	"rule_ids",
+	"rule_ids.entity_ids",
+	"rule_ids.entity_ids.ids",
+	"rule_ids.entity_ids.ids.application_ids",
+	"rule_ids.entity_ids.ids.application_ids.application_id",
+	"rule_ids.entity_ids.ids.client_ids",
+	"rule_ids.entity_ids.ids.client_ids.client_id",
+	"rule_ids.entity_ids.ids.device_ids",
+	"rule_ids.entity_ids.ids.device_ids.application_ids",
+	"rule_ids.entity_ids.ids.device_ids.application_ids.application_id",
+	"rule_ids.entity_ids.ids.device_ids.dev_addr",
+	"rule_ids.entity_ids.ids.device_ids.dev_eui",
+	"rule_ids.entity_ids.ids.device_ids.device_id",
+	"rule_ids.entity_ids.ids.device_ids.join_eui",
+	"rule_ids.entity_ids.ids.gateway_ids",
+	"rule_ids.entity_ids.ids.gateway_ids.eui",
+	"rule_ids.entity_ids.ids.gateway_ids.gateway_id",
+	"rule_ids.entity_ids.ids.organization_ids",
+	"rule_ids.entity_ids.ids.organization_ids.organization_id",
+	"rule_ids.entity_ids.ids.user_ids",
+	"rule_ids.entity_ids.ids.user_ids.email",
+	"rule_ids.entity_ids.ids.user_ids.user_id",
+	"rule_ids.rule_id",
+	"stats",
+	"stats.connected_at",
+	"stats.disconnected_at",
+	"stats.downlink_count",
+	"stats.gateway_remote_address",
+	"stats.gateway_remote_address.ip",
+	"stats.last_downlink_received_at",
+	"stats.last_status",
+	"stats.last_status.advanced",
+	"stats.last_status.antenna_locations",
+	"stats.last_status.boot_time",
+	"stats.last_status.ip",
+	"stats.last_status.metrics",
+	"stats.last_status.time",
+	"stats.last_status.versions",
+	"stats.last_status_received_at",
+	"stats.last_tx_acknowledgment_received_at",
+	"stats.last_uplink_received_at",
+	"stats.protocol",
+	"stats.round_trip_times",
+	"stats.round_trip_times.count",
+	"stats.round_trip_times.max",
+	"stats.round_trip_times.median",
+	"stats.round_trip_times.min",
+	"stats.sub_bands",
+	"stats.tx_acknowledgment_count",
+	"stats.tx_acknowledgment_failure_count",
+	"stats.uplink_count",
+}
+
+var GatewayAlertFieldPathsTopLevel = []string{
+	"condition",
+	"gateway_ids",
+	"observed",
+	"observed_tx_failure_ratio",
+	"raised_at",
+	"recovered_at",
+	"rule_ids",
+	"stats",
+}
+var GatewayAlertsFieldPathsNested = []string{
+	"alerts",
+}
+
+var GatewayAlertsFieldPathsTopLevel = []string{
+	"alerts",
+}
@@ -0,0 +1,480 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import fmt "fmt"
+
+func (dst *GatewayAlertRule) SetFields(src *GatewayAlertRule, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EntityIdentifiers
+				if (src == nil || src.EntityIds == nil) && dst.EntityIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EntityIds
+				}
+				if dst.EntityIds != nil {
+					newDst = dst.EntityIds
+				} else {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIds = src.EntityIds
+				} else {
+					dst.EntityIds = nil
+				}
+			}
+		case "rule_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rule_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RuleId = src.RuleId
+			} else {
+				var zero string
+				dst.RuleId = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+		case "condition":
+			if len(subs) > 0 {
+				return fmt.Errorf("'condition' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Condition = src.Condition
+			} else {
+				dst.Condition = 0
+			}
+		case "threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Threshold = src.Threshold
+			} else {
+				dst.Threshold = nil
+			}
+		case "tx_failure_ratio":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_failure_ratio' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxFailureRatio = src.TxFailureRatio
+			} else {
+				var zero float32
+				dst.TxFailureRatio = zero
+			}
+		case "min_tx_acknowledgments":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_tx_acknowledgments' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinTxAcknowledgments = src.MinTxAcknowledgments
+			} else {
+				var zero uint64
+				dst.MinTxAcknowledgments = zero
+			}
+		case "receivers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'receivers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Receivers = src.Receivers
+			} else {
+				dst.Receivers = nil
+			}
+		case "email":
+			if len(subs) > 0 {
+				return fmt.Errorf("'email' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Email = src.Email
+			} else {
+				var zero bool
+				dst.Email = zero
+			}
+		case "disabled":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disabled' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Disabled = src.Disabled
+			} else {
+				var zero bool
+				dst.Disabled = zero
+			}
+		case "gateway_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayIds = src.GatewayIds
+			} else {
+				dst.GatewayIds = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayAlertRules) SetFields(src *GatewayAlertRules, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "rules":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rules' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rules = src.Rules
+			} else {
+				dst.Rules = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayAlertRuleIdentifiers) SetFields(src *GatewayAlertRuleIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EntityIdentifiers
+				if (src == nil || src.EntityIds == nil) && dst.EntityIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EntityIds
+				}
+				if dst.EntityIds != nil {
+					newDst = dst.EntityIds
+				} else {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIds = src.EntityIds
+				} else {
+					dst.EntityIds = nil
+				}
+			}
+		case "rule_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rule_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RuleId = src.RuleId
+			} else {
+				var zero string
+				dst.RuleId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateGatewayAlertRuleRequest) SetFields(src *CreateGatewayAlertRuleRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "rule":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayAlertRule
+				if (src == nil || src.Rule == nil) && dst.Rule == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Rule
+				}
+				if dst.Rule != nil {
+					newDst = dst.Rule
+				} else {
+					newDst = &GatewayAlertRule{}
+					dst.Rule = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Rule = src.Rule
+				} else {
+					dst.Rule = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UpdateGatewayAlertRuleRequest) SetFields(src *UpdateGatewayAlertRuleRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "rule":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayAlertRule
+				if (src == nil || src.Rule == nil) && dst.Rule == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Rule
+				}
+				if dst.Rule != nil {
+					newDst = dst.Rule
+				} else {
+					newDst = &GatewayAlertRule{}
+					dst.Rule = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Rule = src.Rule
+				} else {
+					dst.Rule = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListGatewayAlertRulesRequest) SetFields(src *ListGatewayAlertRulesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EntityIdentifiers
+				if (src == nil || src.EntityIds == nil) && dst.EntityIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EntityIds
+				}
+				if dst.EntityIds != nil {
+					newDst = dst.EntityIds
+				} else {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIds = src.EntityIds
+				} else {
+					dst.EntityIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayAlert) SetFields(src *GatewayAlert, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "rule_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayAlertRuleIdentifiers
+				if (src == nil || src.RuleIds == nil) && dst.RuleIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RuleIds
+				}
+				if dst.RuleIds != nil {
+					newDst = dst.RuleIds
+				} else {
+					newDst = &GatewayAlertRuleIdentifiers{}
+					dst.RuleIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RuleIds = src.RuleIds
+				} else {
+					dst.RuleIds = nil
+				}
+			}
+		case "condition":
+			if len(subs) > 0 {
+				return fmt.Errorf("'condition' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Condition = src.Condition
+			} else {
+				dst.Condition = 0
+			}
+		case "raised_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raised_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RaisedAt = src.RaisedAt
+			} else {
+				dst.RaisedAt = nil
+			}
+		case "recovered_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'recovered_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RecoveredAt = src.RecoveredAt
+			} else {
+				dst.RecoveredAt = nil
+			}
+		case "observed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'observed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Observed = src.Observed
+			} else {
+				dst.Observed = nil
+			}
+		case "observed_tx_failure_ratio":
+			if len(subs) > 0 {
+				return fmt.Errorf("'observed_tx_failure_ratio' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ObservedTxFailureRatio = src.ObservedTxFailureRatio
+			} else {
+				var zero float32
+				dst.ObservedTxFailureRatio = zero
+			}
+		case "stats":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStats
+				if (src == nil || src.Stats == nil) && dst.Stats == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Stats
+				}
+				if dst.Stats != nil {
+					newDst = dst.Stats
+				} else {
+					newDst = &GatewayConnectionStats{}
+					dst.Stats = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Stats = src.Stats
+				} else {
+					dst.Stats = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayAlerts) SetFields(src *GatewayAlerts, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "alerts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'alerts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Alerts = src.Alerts
+			} else {
+				dst.Alerts = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1073 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on GatewayAlertRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlertRule) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertRuleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return GatewayAlertRuleValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertRuleValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rule_id":
+
+			if utf8.RuneCountInString(m.GetRuleId()) > 36 {
+				return GatewayAlertRuleValidationError{
+					field:  "rule_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayAlertRule_RuleId_Pattern.MatchString(m.GetRuleId()) {
+				return GatewayAlertRuleValidationError{
+					field:  "rule_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertRuleValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertRuleValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "condition":
+
+			if _, ok := GatewayAlertCondition_name[int32(m.GetCondition())]; !ok {
+				return GatewayAlertRuleValidationError{
+					field:  "condition",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "threshold":
+
+			if v, ok := interface{}(m.GetThreshold()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertRuleValidationError{
+						field:  "threshold",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "tx_failure_ratio":
+
+			if val := m.GetTxFailureRatio(); val < 0 || val > 1 {
+				return GatewayAlertRuleValidationError{
+					field:  "tx_failure_ratio",
+					reason: "value must be inside range [0, 1]",
+				}
+			}
+
+		case "min_tx_acknowledgments":
+			// no validation rules for MinTxAcknowledgments
+		case "receivers":
+
+			_GatewayAlertRule_Receivers_Unique := make(map[NotificationReceiver]struct{}, len(m.GetReceivers()))
+
+			for idx, item := range m.GetReceivers() {
+				_, _ = idx, item
+
+				if _, exists := _GatewayAlertRule_Receivers_Unique[item]; exists {
+					return GatewayAlertRuleValidationError{
+						field:  fmt.Sprintf("receivers[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_GatewayAlertRule_Receivers_Unique[item] = struct{}{}
+				}
+
+				if _, ok := _GatewayAlertRule_Receivers_NotInLookup[item]; ok {
+					return GatewayAlertRuleValidationError{
+						field:  fmt.Sprintf("receivers[%v]", idx),
+						reason: "value must not be in list [0]",
+					}
+				}
+
+				if _, ok := NotificationReceiver_name[int32(item)]; !ok {
+					return GatewayAlertRuleValidationError{
+						field:  fmt.Sprintf("receivers[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "email":
+			// no validation rules for Email
+		case "disabled":
+			// no validation rules for Disabled
+		case "gateway_ids":
+
+			if len(m.GetGatewayIds()) > 1000 {
+				return GatewayAlertRuleValidationError{
+					field:  "gateway_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetGatewayIds() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayAlertRuleValidationError{
+							field:  fmt.Sprintf("gateway_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayAlertRuleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertRuleValidationError is the validation error returned by
+// GatewayAlertRule.ValidateFields if the designated constraints aren't met.
+type GatewayAlertRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertRuleValidationError) ErrorName() string { return "GatewayAlertRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlertRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertRuleValidationError{}
+
+var _GatewayAlertRule_RuleId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _GatewayAlertRule_Receivers_NotInLookup = map[NotificationReceiver]struct{}{
+	0: {},
+}
+
+// ValidateFields checks the field values on GatewayAlertRules with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlertRules) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertRulesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rules":
+
+			for idx, item := range m.GetRules() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayAlertRulesValidationError{
+							field:  fmt.Sprintf("rules[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayAlertRulesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertRulesValidationError is the validation error returned by
+// GatewayAlertRules.ValidateFields if the designated constraints aren't met.
+type GatewayAlertRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertRulesValidationError) ErrorName() string {
+	return "GatewayAlertRulesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlertRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertRulesValidationError{}
+
+// ValidateFields checks the field values on GatewayAlertRuleIdentifiers with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayAlertRuleIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertRuleIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return GatewayAlertRuleIdentifiersValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertRuleIdentifiersValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rule_id":
+
+			if utf8.RuneCountInString(m.GetRuleId()) > 36 {
+				return GatewayAlertRuleIdentifiersValidationError{
+					field:  "rule_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayAlertRuleIdentifiers_RuleId_Pattern.MatchString(m.GetRuleId()) {
+				return GatewayAlertRuleIdentifiersValidationError{
+					field:  "rule_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return GatewayAlertRuleIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertRuleIdentifiersValidationError is the validation error returned
+// by GatewayAlertRuleIdentifiers.ValidateFields if the designated constraints
+// aren't met.
+type GatewayAlertRuleIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertRuleIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertRuleIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertRuleIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertRuleIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertRuleIdentifiersValidationError) ErrorName() string {
+	return "GatewayAlertRuleIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertRuleIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlertRuleIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertRuleIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertRuleIdentifiersValidationError{}
+
+var _GatewayAlertRuleIdentifiers_RuleId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on CreateGatewayAlertRuleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateGatewayAlertRuleRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateGatewayAlertRuleRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rule":
+
+			if m.GetRule() == nil {
+				return CreateGatewayAlertRuleRequestValidationError{
+					field:  "rule",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRule()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateGatewayAlertRuleRequestValidationError{
+						field:  "rule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return CreateGatewayAlertRuleRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateGatewayAlertRuleRequestValidationError is the validation error
+// returned by CreateGatewayAlertRuleRequest.ValidateFields if the designated
+// constraints aren't met.
+type CreateGatewayAlertRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGatewayAlertRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGatewayAlertRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGatewayAlertRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGatewayAlertRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGatewayAlertRuleRequestValidationError) ErrorName() string {
+	return "CreateGatewayAlertRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGatewayAlertRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGatewayAlertRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGatewayAlertRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGatewayAlertRuleRequestValidationError{}
+
+// ValidateFields checks the field values on UpdateGatewayAlertRuleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpdateGatewayAlertRuleRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UpdateGatewayAlertRuleRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rule":
+
+			if m.GetRule() == nil {
+				return UpdateGatewayAlertRuleRequestValidationError{
+					field:  "rule",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRule()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateGatewayAlertRuleRequestValidationError{
+						field:  "rule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateGatewayAlertRuleRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return UpdateGatewayAlertRuleRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateGatewayAlertRuleRequestValidationError is the validation error
+// returned by UpdateGatewayAlertRuleRequest.ValidateFields if the designated
+// constraints aren't met.
+type UpdateGatewayAlertRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGatewayAlertRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGatewayAlertRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGatewayAlertRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGatewayAlertRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGatewayAlertRuleRequestValidationError) ErrorName() string {
+	return "UpdateGatewayAlertRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGatewayAlertRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGatewayAlertRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGatewayAlertRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGatewayAlertRuleRequestValidationError{}
+
+// ValidateFields checks the field values on ListGatewayAlertRulesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListGatewayAlertRulesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListGatewayAlertRulesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return ListGatewayAlertRulesRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListGatewayAlertRulesRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListGatewayAlertRulesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListGatewayAlertRulesRequestValidationError is the validation error returned
+// by ListGatewayAlertRulesRequest.ValidateFields if the designated
+// constraints aren't met.
+type ListGatewayAlertRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGatewayAlertRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGatewayAlertRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGatewayAlertRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGatewayAlertRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGatewayAlertRulesRequestValidationError) ErrorName() string {
+	return "ListGatewayAlertRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGatewayAlertRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGatewayAlertRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGatewayAlertRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGatewayAlertRulesRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayAlert with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlert) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayAlertValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rule_ids":
+
+			if m.GetRuleIds() == nil {
+				return GatewayAlertValidationError{
+					field:  "rule_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRuleIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "rule_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "condition":
+
+			if _, ok := GatewayAlertCondition_name[int32(m.GetCondition())]; !ok {
+				return GatewayAlertValidationError{
+					field:  "condition",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "raised_at":
+
+			if v, ok := interface{}(m.GetRaisedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "raised_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "recovered_at":
+
+			if v, ok := interface{}(m.GetRecoveredAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "recovered_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "observed":
+
+			if v, ok := interface{}(m.GetObserved()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "observed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "observed_tx_failure_ratio":
+			// no validation rules for ObservedTxFailureRatio
+		case "stats":
+
+			if v, ok := interface{}(m.GetStats()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "stats",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayAlertValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertValidationError is the validation error returned by
+// GatewayAlert.ValidateFields if the designated constraints aren't met.
+type GatewayAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertValidationError) ErrorName() string { return "GatewayAlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertValidationError{}
+
+// ValidateFields checks the field values on GatewayAlerts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlerts) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "alerts":
+
+			for idx, item := range m.GetAlerts() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayAlertsValidationError{
+							field:  fmt.Sprintf("alerts[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayAlertsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertsValidationError is the validation error returned by
+// GatewayAlerts.ValidateFields if the designated constraints aren't met.
+type GatewayAlertsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertsValidationError) ErrorName() string { return "GatewayAlertsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlerts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertsValidationError{}
@@ -0,0 +1,749 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/gatewayserver_alerts.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the GatewayAlertCondition to JSON.
+func (x GatewayAlertCondition) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), GatewayAlertCondition_name)
+}
+
+// MarshalText marshals the GatewayAlertCondition to text.
+func (x GatewayAlertCondition) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), GatewayAlertCondition_name)), nil
+}
+
+// MarshalJSON marshals the GatewayAlertCondition to JSON.
+func (x GatewayAlertCondition) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// GatewayAlertCondition_customvalue contains custom string values that extend GatewayAlertCondition_value.
+var GatewayAlertCondition_customvalue = map[string]int32{
+	"OFFLINE":          0,
+	"NO_UPLINKS":       1,
+	"TX_FAILURE_RATIO": 2,
+	"CLOCK_DRIFT":      3,
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayAlertCondition from JSON.
+func (x *GatewayAlertCondition) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(GatewayAlertCondition_value, GatewayAlertCondition_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read GatewayAlertCondition enum: %v", err)
+		return
+	}
+	*x = GatewayAlertCondition(v)
+}
+
+// UnmarshalText unmarshals the GatewayAlertCondition from text.
+func (x *GatewayAlertCondition) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), GatewayAlertCondition_customvalue, GatewayAlertCondition_value)
+	if err != nil {
+		return err
+	}
+	*x = GatewayAlertCondition(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the GatewayAlertCondition from JSON.
+func (x *GatewayAlertCondition) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayAlertRule message to JSON.
+func (x *GatewayAlertRule) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EntityIds != nil || s.HasField("entity_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_ids")
+		x.EntityIds.MarshalProtoJSON(s.WithField("entity_ids"))
+	}
+	if x.RuleId != "" || s.HasField("rule_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule_id")
+		s.WriteString(x.RuleId)
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.UpdatedAt != nil || s.HasField("updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		if x.UpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.UpdatedAt)
+		}
+	}
+	if x.Condition != 0 || s.HasField("condition") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("condition")
+		x.Condition.MarshalProtoJSON(s)
+	}
+	if x.Threshold != nil || s.HasField("threshold") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("threshold")
+		if x.Threshold == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Threshold)
+		}
+	}
+	if x.TxFailureRatio != 0 || s.HasField("tx_failure_ratio") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tx_failure_ratio")
+		s.WriteFloat32(x.TxFailureRatio)
+	}
+	if x.MinTxAcknowledgments != 0 || s.HasField("min_tx_acknowledgments") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("min_tx_acknowledgments")
+		s.WriteUint64(x.MinTxAcknowledgments)
+	}
+	if len(x.Receivers) > 0 || s.HasField("receivers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("receivers")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Receivers {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Email || s.HasField("email") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("email")
+		s.WriteBool(x.Email)
+	}
+	if x.Disabled || s.HasField("disabled") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("disabled")
+		s.WriteBool(x.Disabled)
+	}
+	if len(x.GatewayIds) > 0 || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.GatewayIds {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateway_ids"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayAlertRule to JSON.
+func (x *GatewayAlertRule) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayAlertRule message from JSON.
+func (x *GatewayAlertRule) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "entity_ids", "entityIds":
+			if s.ReadNil() {
+				x.EntityIds = nil
+				return
+			}
+			x.EntityIds = &EntityIdentifiers{}
+			x.EntityIds.UnmarshalProtoJSON(s.WithField("entity_ids", true))
+		case "rule_id", "ruleId":
+			s.AddField("rule_id")
+			x.RuleId = s.ReadString()
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			if s.ReadNil() {
+				x.UpdatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = v
+		case "condition":
+			s.AddField("condition")
+			x.Condition.UnmarshalProtoJSON(s)
+		case "threshold":
+			s.AddField("threshold")
+			if s.ReadNil() {
+				x.Threshold = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Threshold = v
+		case "tx_failure_ratio", "txFailureRatio":
+			s.AddField("tx_failure_ratio")
+			x.TxFailureRatio = s.ReadFloat32()
+		case "min_tx_acknowledgments", "minTxAcknowledgments":
+			s.AddField("min_tx_acknowledgments")
+			x.MinTxAcknowledgments = s.ReadUint64()
+		case "receivers":
+			s.AddField("receivers")
+			if s.ReadNil() {
+				x.Receivers = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v NotificationReceiver
+				v.UnmarshalProtoJSON(s)
+				x.Receivers = append(x.Receivers, v)
+			})
+		case "email":
+			s.AddField("email")
+			x.Email = s.ReadBool()
+		case "disabled":
+			s.AddField("disabled")
+			x.Disabled = s.ReadBool()
+		case "gateway_ids", "gatewayIds":
+			s.AddField("gateway_ids")
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.GatewayIds = append(x.GatewayIds, nil)
+					return
+				}
+				v := &GatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateway_ids", false))
+				if s.Err() != nil {
+					return
+				}
+				x.GatewayIds = append(x.GatewayIds, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayAlertRule from JSON.
+func (x *GatewayAlertRule) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayAlertRules message to JSON.
+func (x *GatewayAlertRules) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Rules) > 0 || s.HasField("rules") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rules")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Rules {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("rules"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayAlertRules to JSON.
+func (x *GatewayAlertRules) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayAlertRules message from JSON.
+func (x *GatewayAlertRules) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rules":
+			s.AddField("rules")
+			if s.ReadNil() {
+				x.Rules = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Rules = append(x.Rules, nil)
+					return
+				}
+				v := &GatewayAlertRule{}
+				v.UnmarshalProtoJSON(s.WithField("rules", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Rules = append(x.Rules, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayAlertRules from JSON.
+func (x *GatewayAlertRules) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayAlertRuleIdentifiers message to JSON.
+func (x *GatewayAlertRuleIdentifiers) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EntityIds != nil || s.HasField("entity_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_ids")
+		x.EntityIds.MarshalProtoJSON(s.WithField("entity_ids"))
+	}
+	if x.RuleId != "" || s.HasField("rule_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule_id")
+		s.WriteString(x.RuleId)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayAlertRuleIdentifiers to JSON.
+func (x *GatewayAlertRuleIdentifiers) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayAlertRuleIdentifiers message from JSON.
+func (x *GatewayAlertRuleIdentifiers) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "entity_ids", "entityIds":
+			if s.ReadNil() {
+				x.EntityIds = nil
+				return
+			}
+			x.EntityIds = &EntityIdentifiers{}
+			x.EntityIds.UnmarshalProtoJSON(s.WithField("entity_ids", true))
+		case "rule_id", "ruleId":
+			s.AddField("rule_id")
+			x.RuleId = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayAlertRuleIdentifiers from JSON.
+func (x *GatewayAlertRuleIdentifiers) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the CreateGatewayAlertRuleRequest message to JSON.
+func (x *CreateGatewayAlertRuleRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Rule != nil || s.HasField("rule") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule")
+		x.Rule.MarshalProtoJSON(s.WithField("rule"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CreateGatewayAlertRuleRequest to JSON.
+func (x *CreateGatewayAlertRuleRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CreateGatewayAlertRuleRequest message from JSON.
+func (x *CreateGatewayAlertRuleRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rule":
+			if s.ReadNil() {
+				x.Rule = nil
+				return
+			}
+			x.Rule = &GatewayAlertRule{}
+			x.Rule.UnmarshalProtoJSON(s.WithField("rule", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CreateGatewayAlertRuleRequest from JSON.
+func (x *CreateGatewayAlertRuleRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UpdateGatewayAlertRuleRequest message to JSON.
+func (x *UpdateGatewayAlertRuleRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Rule != nil || s.HasField("rule") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule")
+		x.Rule.MarshalProtoJSON(s.WithField("rule"))
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UpdateGatewayAlertRuleRequest to JSON.
+func (x *UpdateGatewayAlertRuleRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UpdateGatewayAlertRuleRequest message from JSON.
+func (x *UpdateGatewayAlertRuleRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rule":
+			if s.ReadNil() {
+				x.Rule = nil
+				return
+			}
+			x.Rule = &GatewayAlertRule{}
+			x.Rule.UnmarshalProtoJSON(s.WithField("rule", true))
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UpdateGatewayAlertRuleRequest from JSON.
+func (x *UpdateGatewayAlertRuleRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ListGatewayAlertRulesRequest message to JSON.
+func (x *ListGatewayAlertRulesRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EntityIds != nil || s.HasField("entity_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_ids")
+		x.EntityIds.MarshalProtoJSON(s.WithField("entity_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ListGatewayAlertRulesRequest to JSON.
+func (x *ListGatewayAlertRulesRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ListGatewayAlertRulesRequest message from JSON.
+func (x *ListGatewayAlertRulesRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "entity_ids", "entityIds":
+			if s.ReadNil() {
+				x.EntityIds = nil
+				return
+			}
+			x.EntityIds = &EntityIdentifiers{}
+			x.EntityIds.UnmarshalProtoJSON(s.WithField("entity_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ListGatewayAlertRulesRequest from JSON.
+func (x *ListGatewayAlertRulesRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayAlert message to JSON.
+func (x *GatewayAlert) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.RuleIds != nil || s.HasField("rule_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule_ids")
+		x.RuleIds.MarshalProtoJSON(s.WithField("rule_ids"))
+	}
+	if x.Condition != 0 || s.HasField("condition") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("condition")
+		x.Condition.MarshalProtoJSON(s)
+	}
+	if x.RaisedAt != nil || s.HasField("raised_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("raised_at")
+		if x.RaisedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.RaisedAt)
+		}
+	}
+	if x.RecoveredAt != nil || s.HasField("recovered_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("recovered_at")
+		if x.RecoveredAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.RecoveredAt)
+		}
+	}
+	if x.Observed != nil || s.HasField("observed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("observed")
+		if x.Observed == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Observed)
+		}
+	}
+	if x.ObservedTxFailureRatio != 0 || s.HasField("observed_tx_failure_ratio") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("observed_tx_failure_ratio")
+		s.WriteFloat32(x.ObservedTxFailureRatio)
+	}
+	if x.Stats != nil || s.HasField("stats") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stats")
+		x.Stats.MarshalProtoJSON(s.WithField("stats"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayAlert to JSON.
+func (x *GatewayAlert) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayAlert message from JSON.
+func (x *GatewayAlert) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "rule_ids", "ruleIds":
+			if s.ReadNil() {
+				x.RuleIds = nil
+				return
+			}
+			x.RuleIds = &GatewayAlertRuleIdentifiers{}
+			x.RuleIds.UnmarshalProtoJSON(s.WithField("rule_ids", true))
+		case "condition":
+			s.AddField("condition")
+			x.Condition.UnmarshalProtoJSON(s)
+		case "raised_at", "raisedAt":
+			s.AddField("raised_at")
+			if s.ReadNil() {
+				x.RaisedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.RaisedAt = v
+		case "recovered_at", "recoveredAt":
+			s.AddField("recovered_at")
+			if s.ReadNil() {
+				x.RecoveredAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.RecoveredAt = v
+		case "observed":
+			s.AddField("observed")
+			if s.ReadNil() {
+				x.Observed = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Observed = v
+		case "observed_tx_failure_ratio", "observedTxFailureRatio":
+			s.AddField("observed_tx_failure_ratio")
+			x.ObservedTxFailureRatio = s.ReadFloat32()
+		case "stats":
+			if s.ReadNil() {
+				x.Stats = nil
+				return
+			}
+			x.Stats = &GatewayConnectionStats{}
+			x.Stats.UnmarshalProtoJSON(s.WithField("stats", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayAlert from JSON.
+func (x *GatewayAlert) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayAlerts message to JSON.
+func (x *GatewayAlerts) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Alerts) > 0 || s.HasField("alerts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("alerts")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Alerts {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("alerts"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayAlerts to JSON.
+func (x *GatewayAlerts) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayAlerts message from JSON.
+func (x *GatewayAlerts) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "alerts":
+			s.AddField("alerts")
+			if s.ReadNil() {
+				x.Alerts = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Alerts = append(x.Alerts, nil)
+					return
+				}
+				v := &GatewayAlert{}
+				v.UnmarshalProtoJSON(s.WithField("alerts", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Alerts = append(x.Alerts, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayAlerts from JSON.
+func (x *GatewayAlerts) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
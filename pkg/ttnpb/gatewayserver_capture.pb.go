@@ -0,0 +1,373 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: ttn/lorawan/v3/gatewayserver_capture.proto
+
+package ttnpb
+
+import (
+	_ "github.com/TheThingsIndustries/protoc-gen-go-json/annotations"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GatewayCapturePacketType int32
+
+const (
+	// Uplink message received by the gateway.
+	GatewayCapturePacketType_GATEWAY_CAPTURE_UPLINK GatewayCapturePacketType = 0
+	// Downlink message scheduled on the gateway.
+	GatewayCapturePacketType_GATEWAY_CAPTURE_DOWNLINK GatewayCapturePacketType = 1
+	// Transmission acknowledgment of a downlink message by the gateway.
+	GatewayCapturePacketType_GATEWAY_CAPTURE_TX_ACKNOWLEDGMENT GatewayCapturePacketType = 2
+)
+
+// Enum value maps for GatewayCapturePacketType.
+var (
+	GatewayCapturePacketType_name = map[int32]string{
+		0: "GATEWAY_CAPTURE_UPLINK",
+		1: "GATEWAY_CAPTURE_DOWNLINK",
+		2: "GATEWAY_CAPTURE_TX_ACKNOWLEDGMENT",
+	}
+	GatewayCapturePacketType_value = map[string]int32{
+		"GATEWAY_CAPTURE_UPLINK":            0,
+		"GATEWAY_CAPTURE_DOWNLINK":          1,
+		"GATEWAY_CAPTURE_TX_ACKNOWLEDGMENT": 2,
+	}
+)
+
+func (x GatewayCapturePacketType) Enum() *GatewayCapturePacketType {
+	p := new(GatewayCapturePacketType)
+	*p = x
+	return p
+}
+
+func (x GatewayCapturePacketType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewayCapturePacketType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_gatewayserver_capture_proto_enumTypes[0].Descriptor()
+}
+
+func (GatewayCapturePacketType) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_gatewayserver_capture_proto_enumTypes[0]
+}
+
+func (x GatewayCapturePacketType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewayCapturePacketType.Descriptor instead.
+func (GatewayCapturePacketType) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescGZIP(), []int{0}
+}
+
+type CaptureGatewayTrafficRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds []*GatewayIdentifiers `protobuf:"bytes,1,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+}
+
+func (x *CaptureGatewayTrafficRequest) Reset() {
+	*x = CaptureGatewayTrafficRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureGatewayTrafficRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureGatewayTrafficRequest) ProtoMessage() {}
+
+func (x *CaptureGatewayTrafficRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureGatewayTrafficRequest.ProtoReflect.Descriptor instead.
+func (*CaptureGatewayTrafficRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CaptureGatewayTrafficRequest) GetGatewayIds() []*GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+type GatewayCapturePacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Time at which the Gateway Server captured the packet.
+	Time *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type GatewayCapturePacketType `protobuf:"varint,3,opt,name=type,proto3,enum=ttn.lorawan.v3.GatewayCapturePacketType" json:"type,omitempty"`
+	// The LoRaTap (version 1) encapsulated frame.
+	// The lower byte of the tag field of the LoRaTap header is the packet type plus one.
+	// For transmission acknowledgments, the upper byte is the acknowledgment result.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GatewayCapturePacket) Reset() {
+	*x = GatewayCapturePacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayCapturePacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayCapturePacket) ProtoMessage() {}
+
+func (x *GatewayCapturePacket) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayCapturePacket.ProtoReflect.Descriptor instead.
+func (*GatewayCapturePacket) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GatewayCapturePacket) GetGatewayIds() *GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *GatewayCapturePacket) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GatewayCapturePacket) GetType() GatewayCapturePacketType {
+	if x != nil {
+		return x.Type
+	}
+	return GatewayCapturePacketType_GATEWAY_CAPTURE_UPLINK
+}
+
+func (x *GatewayCapturePacket) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_ttn_lorawan_v3_gatewayserver_capture_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x68, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74,
+	0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x1c, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x42, 0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x08, 0x01, 0x10, 0x64, 0x22, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73,
+	0x22, 0xfb, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x94,
+	0x01, 0x0a, 0x18, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x47,
+	0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x55,
+	0x50, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x41, 0x54, 0x45, 0x57,
+	0x41, 0x59, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c,
+	0x49, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59,
+	0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x43, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x1a, 0x17, 0xea, 0xaa,
+	0x19, 0x13, 0x18, 0x01, 0x2a, 0x0f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x43, 0x41,
+	0x50, 0x54, 0x55, 0x52, 0x45, 0x32, 0xc2, 0x01, 0x0a, 0x10, 0x47, 0x73, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x15, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x67, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x73, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x30, 0x01, 0x1a, 0x1d, 0x92, 0x41, 0x1a,
+	0x12, 0x18, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x20, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f,
+	0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescData = file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_gatewayserver_capture_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ttn_lorawan_v3_gatewayserver_capture_proto_goTypes = []interface{}{
+	(GatewayCapturePacketType)(0),        // 0: ttn.lorawan.v3.GatewayCapturePacketType
+	(*CaptureGatewayTrafficRequest)(nil), // 1: ttn.lorawan.v3.CaptureGatewayTrafficRequest
+	(*GatewayCapturePacket)(nil),         // 2: ttn.lorawan.v3.GatewayCapturePacket
+	(*GatewayIdentifiers)(nil),           // 3: ttn.lorawan.v3.GatewayIdentifiers
+	(*timestamppb.Timestamp)(nil),        // 4: google.protobuf.Timestamp
+}
+var file_ttn_lorawan_v3_gatewayserver_capture_proto_depIdxs = []int32{
+	3, // 0: ttn.lorawan.v3.CaptureGatewayTrafficRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	3, // 1: ttn.lorawan.v3.GatewayCapturePacket.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	4, // 2: ttn.lorawan.v3.GatewayCapturePacket.time:type_name -> google.protobuf.Timestamp
+	0, // 3: ttn.lorawan.v3.GatewayCapturePacket.type:type_name -> ttn.lorawan.v3.GatewayCapturePacketType
+	1, // 4: ttn.lorawan.v3.GsGatewayCapture.CaptureGatewayTraffic:input_type -> ttn.lorawan.v3.CaptureGatewayTrafficRequest
+	2, // 5: ttn.lorawan.v3.GsGatewayCapture.CaptureGatewayTraffic:output_type -> ttn.lorawan.v3.GatewayCapturePacket
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_gatewayserver_capture_proto_init() }
+func file_ttn_lorawan_v3_gatewayserver_capture_proto_init() {
+	if File_ttn_lorawan_v3_gatewayserver_capture_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureGatewayTrafficRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCapturePacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_gatewayserver_capture_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_gatewayserver_capture_proto_depIdxs,
+		EnumInfos:         file_ttn_lorawan_v3_gatewayserver_capture_proto_enumTypes,
+		MessageInfos:      file_ttn_lorawan_v3_gatewayserver_capture_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_gatewayserver_capture_proto = out.File
+	file_ttn_lorawan_v3_gatewayserver_capture_proto_rawDesc = nil
+	file_ttn_lorawan_v3_gatewayserver_capture_proto_goTypes = nil
+	file_ttn_lorawan_v3_gatewayserver_capture_proto_depIdxs = nil
+}
@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: ttn/lorawan/v3/gatewayserver_capture.proto
+
+/*
+Package ttnpb is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package ttnpb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Suppress "imported and not used" errors
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+var _ = metadata.Join
+
+func request_GsGatewayCapture_CaptureGatewayTraffic_0(ctx context.Context, marshaler runtime.Marshaler, client GsGatewayCaptureClient, req *http.Request, pathParams map[string]string) (GsGatewayCapture_CaptureGatewayTrafficClient, runtime.ServerMetadata, error) {
+	var protoReq CaptureGatewayTrafficRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.CaptureGatewayTraffic(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+// RegisterGsGatewayCaptureHandlerServer registers the http handlers for service GsGatewayCapture to "mux".
+// UnaryRPC     :call GsGatewayCaptureServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterGsGatewayCaptureHandlerFromEndpoint instead.
+func RegisterGsGatewayCaptureHandlerServer(ctx context.Context, mux *runtime.ServeMux, server GsGatewayCaptureServer) error {
+
+	mux.Handle("POST", pattern_GsGatewayCapture_CaptureGatewayTraffic_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	return nil
+}
+
+// RegisterGsGatewayCaptureHandlerFromEndpoint is same as RegisterGsGatewayCaptureHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterGsGatewayCaptureHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterGsGatewayCaptureHandler(ctx, mux, conn)
+}
+
+// RegisterGsGatewayCaptureHandler registers the http handlers for service GsGatewayCapture to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterGsGatewayCaptureHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterGsGatewayCaptureHandlerClient(ctx, mux, NewGsGatewayCaptureClient(conn))
+}
+
+// RegisterGsGatewayCaptureHandlerClient registers the http handlers for service GsGatewayCapture
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "GsGatewayCaptureClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "GsGatewayCaptureClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "GsGatewayCaptureClient" to call the correct interceptors.
+func RegisterGsGatewayCaptureHandlerClient(ctx context.Context, mux *runtime.ServeMux, client GsGatewayCaptureClient) error {
+
+	mux.Handle("POST", pattern_GsGatewayCapture_CaptureGatewayTraffic_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/ttn.lorawan.v3.GsGatewayCapture/CaptureGatewayTraffic", runtime.WithHTTPPathPattern("/gs/gateways/capture"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_GsGatewayCapture_CaptureGatewayTraffic_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_GsGatewayCapture_CaptureGatewayTraffic_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_GsGatewayCapture_CaptureGatewayTraffic_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"gs", "gateways", "capture"}, ""))
+)
+
+var (
+	forward_GsGatewayCapture_CaptureGatewayTraffic_0 = runtime.ForwardResponseStream
+)
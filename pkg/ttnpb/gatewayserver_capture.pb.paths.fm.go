@@ -0,0 +1,26 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var CaptureGatewayTrafficRequestFieldPathsNested = []string{
+	"gateway_ids",
+}
+
+var CaptureGatewayTrafficRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+}
+var GatewayCapturePacketFieldPathsNested = []string{
+	"data",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"time",
+	"type",
+}
+
+var GatewayCapturePacketFieldPathsTopLevel = []string{
+	"data",
+	"gateway_ids",
+	"time",
+	"type",
+}
@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on CaptureGatewayTrafficRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CaptureGatewayTrafficRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CaptureGatewayTrafficRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if l := len(m.GetGatewayIds()); l < 1 || l > 100 {
+				return CaptureGatewayTrafficRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetGatewayIds() {
+				_, _ = idx, item
+
+				if item == nil {
+					return CaptureGatewayTrafficRequestValidationError{
+						field:  fmt.Sprintf("gateway_ids[%v]", idx),
+						reason: "value is required",
+					}
+				}
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return CaptureGatewayTrafficRequestValidationError{
+							field:  fmt.Sprintf("gateway_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return CaptureGatewayTrafficRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CaptureGatewayTrafficRequestValidationError is the validation error returned
+// by CaptureGatewayTrafficRequest.ValidateFields if the designated
+// constraints aren't met.
+type CaptureGatewayTrafficRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureGatewayTrafficRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureGatewayTrafficRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureGatewayTrafficRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureGatewayTrafficRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureGatewayTrafficRequestValidationError) ErrorName() string {
+	return "CaptureGatewayTrafficRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureGatewayTrafficRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureGatewayTrafficRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureGatewayTrafficRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureGatewayTrafficRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayCapturePacket with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayCapturePacket) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayCapturePacketFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayCapturePacketValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCapturePacketValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "time":
+
+			if m.GetTime() == nil {
+				return GatewayCapturePacketValidationError{
+					field:  "time",
+					reason: "value is required",
+				}
+			}
+
+		case "type":
+
+			if _, ok := GatewayCapturePacketType_name[int32(m.GetType())]; !ok {
+				return GatewayCapturePacketValidationError{
+					field:  "type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayCapturePacketValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayCapturePacketValidationError is the validation error returned by
+// GatewayCapturePacket.ValidateFields if the designated constraints aren't met.
+type GatewayCapturePacketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayCapturePacketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayCapturePacketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayCapturePacketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayCapturePacketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayCapturePacketValidationError) ErrorName() string {
+	return "GatewayCapturePacketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayCapturePacketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayCapturePacket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayCapturePacketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayCapturePacketValidationError{}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/gatewayserver_capture.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the GatewayCapturePacketType to JSON.
+func (x GatewayCapturePacketType) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), GatewayCapturePacketType_name)
+}
+
+// MarshalText marshals the GatewayCapturePacketType to text.
+func (x GatewayCapturePacketType) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), GatewayCapturePacketType_name)), nil
+}
+
+// MarshalJSON marshals the GatewayCapturePacketType to JSON.
+func (x GatewayCapturePacketType) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// GatewayCapturePacketType_customvalue contains custom string values that extend GatewayCapturePacketType_value.
+var GatewayCapturePacketType_customvalue = map[string]int32{
+	"UPLINK":            0,
+	"DOWNLINK":          1,
+	"TX_ACKNOWLEDGMENT": 2,
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayCapturePacketType from JSON.
+func (x *GatewayCapturePacketType) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(GatewayCapturePacketType_value, GatewayCapturePacketType_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read GatewayCapturePacketType enum: %v", err)
+		return
+	}
+	*x = GatewayCapturePacketType(v)
+}
+
+// UnmarshalText unmarshals the GatewayCapturePacketType from text.
+func (x *GatewayCapturePacketType) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), GatewayCapturePacketType_customvalue, GatewayCapturePacketType_value)
+	if err != nil {
+		return err
+	}
+	*x = GatewayCapturePacketType(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the GatewayCapturePacketType from JSON.
+func (x *GatewayCapturePacketType) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the CaptureGatewayTrafficRequest message to JSON.
+func (x *CaptureGatewayTrafficRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.GatewayIds) > 0 || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.GatewayIds {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateway_ids"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CaptureGatewayTrafficRequest to JSON.
+func (x *CaptureGatewayTrafficRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CaptureGatewayTrafficRequest message from JSON.
+func (x *CaptureGatewayTrafficRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			s.AddField("gateway_ids")
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.GatewayIds = append(x.GatewayIds, nil)
+					return
+				}
+				v := &GatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateway_ids", false))
+				if s.Err() != nil {
+					return
+				}
+				x.GatewayIds = append(x.GatewayIds, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CaptureGatewayTrafficRequest from JSON.
+func (x *CaptureGatewayTrafficRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayCapturePacket message to JSON.
+func (x *GatewayCapturePacket) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Time != nil || s.HasField("time") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("time")
+		if x.Time == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.Time)
+		}
+	}
+	if x.Type != 0 || s.HasField("type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("type")
+		x.Type.MarshalProtoJSON(s)
+	}
+	if len(x.Data) > 0 || s.HasField("data") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("data")
+		s.WriteBytes(x.Data)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayCapturePacket to JSON.
+func (x *GatewayCapturePacket) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayCapturePacket message from JSON.
+func (x *GatewayCapturePacket) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "time":
+			s.AddField("time")
+			if s.ReadNil() {
+				x.Time = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Time = v
+		case "type":
+			s.AddField("type")
+			x.Type.UnmarshalProtoJSON(s)
+		case "data":
+			s.AddField("data")
+			x.Data = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayCapturePacket from JSON.
+func (x *GatewayCapturePacket) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -0,0 +1,593 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: ttn/lorawan/v3/gatewayserver_handoff.proto
+
+package ttnpb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// State of the downlink scheduler of a gateway connection.
+type GatewaySchedulerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Clock of the scheduler. The scheduler is not synchronized if this is empty.
+	Clock *GatewaySchedulerState_Clock `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Scheduled emissions, used for time conflict detection.
+	Emissions []*GatewaySchedulerState_Emission `protobuf:"bytes,2,rep,name=emissions,proto3" json:"emissions,omitempty"`
+	// Scheduled emissions per sub-band, used for duty-cycle enforcement.
+	SubBands []*GatewaySchedulerState_SubBandEmissions `protobuf:"bytes,3,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+}
+
+func (x *GatewaySchedulerState) Reset() {
+	*x = GatewaySchedulerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewaySchedulerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaySchedulerState) ProtoMessage() {}
+
+func (x *GatewaySchedulerState) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaySchedulerState.ProtoReflect.Descriptor instead.
+func (*GatewaySchedulerState) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GatewaySchedulerState) GetClock() *GatewaySchedulerState_Clock {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+func (x *GatewaySchedulerState) GetEmissions() []*GatewaySchedulerState_Emission {
+	if x != nil {
+		return x.Emissions
+	}
+	return nil
+}
+
+func (x *GatewaySchedulerState) GetSubBands() []*GatewaySchedulerState_SubBandEmissions {
+	if x != nil {
+		return x.SubBands
+	}
+	return nil
+}
+
+// Connection state of a gateway that is handed off by a draining Gateway Server to the Gateway Server
+// to which the gateway reconnects.
+type GatewayConnectionHandoff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Time at which the connection was drained.
+	DrainedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=drained_at,json=drainedAt,proto3" json:"drained_at,omitempty"`
+	Scheduler *GatewaySchedulerState `protobuf:"bytes,3,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// Downlink messages that were scheduled, but not yet sent to the gateway.
+	PendingDownlinks []*DownlinkMessage `protobuf:"bytes,4,rep,name=pending_downlinks,json=pendingDownlinks,proto3" json:"pending_downlinks,omitempty"`
+}
+
+func (x *GatewayConnectionHandoff) Reset() {
+	*x = GatewayConnectionHandoff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayConnectionHandoff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayConnectionHandoff) ProtoMessage() {}
+
+func (x *GatewayConnectionHandoff) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayConnectionHandoff.ProtoReflect.Descriptor instead.
+func (*GatewayConnectionHandoff) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GatewayConnectionHandoff) GetGatewayIds() *GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *GatewayConnectionHandoff) GetDrainedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrainedAt
+	}
+	return nil
+}
+
+func (x *GatewayConnectionHandoff) GetScheduler() *GatewaySchedulerState {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *GatewayConnectionHandoff) GetPendingDownlinks() []*DownlinkMessage {
+	if x != nil {
+		return x.PendingDownlinks
+	}
+	return nil
+}
+
+type GatewaySchedulerState_Clock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Last synchronized concentrator timestamp (microseconds).
+	Relative uint32 `protobuf:"varint,1,opt,name=relative,proto3" json:"relative,omitempty"`
+	// Last synchronized concentrator time, taking roll-over into account (nanoseconds).
+	Absolute int64 `protobuf:"varint,2,opt,name=absolute,proto3" json:"absolute,omitempty"`
+	// Server time of the last synchronization.
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// Gateway time of the last synchronization, if available.
+	GatewayTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=gateway_time,json=gatewayTime,proto3" json:"gateway_time,omitempty"`
+}
+
+func (x *GatewaySchedulerState_Clock) Reset() {
+	*x = GatewaySchedulerState_Clock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewaySchedulerState_Clock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaySchedulerState_Clock) ProtoMessage() {}
+
+func (x *GatewaySchedulerState_Clock) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaySchedulerState_Clock.ProtoReflect.Descriptor instead.
+func (*GatewaySchedulerState_Clock) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GatewaySchedulerState_Clock) GetRelative() uint32 {
+	if x != nil {
+		return x.Relative
+	}
+	return 0
+}
+
+func (x *GatewaySchedulerState_Clock) GetAbsolute() int64 {
+	if x != nil {
+		return x.Absolute
+	}
+	return 0
+}
+
+func (x *GatewaySchedulerState_Clock) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+func (x *GatewaySchedulerState_Clock) GetGatewayTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GatewayTime
+	}
+	return nil
+}
+
+type GatewaySchedulerState_Emission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Concentrator time at which the emission starts (nanoseconds).
+	Starts   int64                `protobuf:"varint,1,opt,name=starts,proto3" json:"starts,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *GatewaySchedulerState_Emission) Reset() {
+	*x = GatewaySchedulerState_Emission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewaySchedulerState_Emission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaySchedulerState_Emission) ProtoMessage() {}
+
+func (x *GatewaySchedulerState_Emission) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaySchedulerState_Emission.ProtoReflect.Descriptor instead.
+func (*GatewaySchedulerState_Emission) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GatewaySchedulerState_Emission) GetStarts() int64 {
+	if x != nil {
+		return x.Starts
+	}
+	return 0
+}
+
+func (x *GatewaySchedulerState_Emission) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type GatewaySchedulerState_SubBandEmissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinFrequency uint64                            `protobuf:"varint,1,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
+	MaxFrequency uint64                            `protobuf:"varint,2,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
+	Emissions    []*GatewaySchedulerState_Emission `protobuf:"bytes,3,rep,name=emissions,proto3" json:"emissions,omitempty"`
+}
+
+func (x *GatewaySchedulerState_SubBandEmissions) Reset() {
+	*x = GatewaySchedulerState_SubBandEmissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewaySchedulerState_SubBandEmissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaySchedulerState_SubBandEmissions) ProtoMessage() {}
+
+func (x *GatewaySchedulerState_SubBandEmissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaySchedulerState_SubBandEmissions.ProtoReflect.Descriptor instead.
+func (*GatewaySchedulerState_SubBandEmissions) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *GatewaySchedulerState_SubBandEmissions) GetMinFrequency() uint64 {
+	if x != nil {
+		return x.MinFrequency
+	}
+	return 0
+}
+
+func (x *GatewaySchedulerState_SubBandEmissions) GetMaxFrequency() uint64 {
+	if x != nil {
+		return x.MaxFrequency
+	}
+	return 0
+}
+
+func (x *GatewaySchedulerState_SubBandEmissions) GetEmissions() []*GatewaySchedulerState_Emission {
+	if x != nil {
+		return x.Emissions
+	}
+	return nil
+}
+
+var File_ttn_lorawan_v3_gatewayserver_handoff_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x68,
+	0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74,
+	0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x05, 0x0a, 0x15, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x41, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4c, 0x0a, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x53, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62,
+	0x42, 0x61, 0x6e, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x1a, 0xbb, 0x01, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x59, 0x0a, 0x08, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0xaa, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x42, 0x61, 0x6e, 0x64, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x4c, 0x0a, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb7, 0x02,
+	0x0a, 0x18, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x72, 0x61, 0x69, 0x6e,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x11, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68,
+	0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescData = file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ttn_lorawan_v3_gatewayserver_handoff_proto_goTypes = []interface{}{
+	(*GatewaySchedulerState)(nil),                  // 0: ttn.lorawan.v3.GatewaySchedulerState
+	(*GatewayConnectionHandoff)(nil),               // 1: ttn.lorawan.v3.GatewayConnectionHandoff
+	(*GatewaySchedulerState_Clock)(nil),            // 2: ttn.lorawan.v3.GatewaySchedulerState.Clock
+	(*GatewaySchedulerState_Emission)(nil),         // 3: ttn.lorawan.v3.GatewaySchedulerState.Emission
+	(*GatewaySchedulerState_SubBandEmissions)(nil), // 4: ttn.lorawan.v3.GatewaySchedulerState.SubBandEmissions
+	(*GatewayIdentifiers)(nil),                     // 5: ttn.lorawan.v3.GatewayIdentifiers
+	(*timestamppb.Timestamp)(nil),                  // 6: google.protobuf.Timestamp
+	(*DownlinkMessage)(nil),                        // 7: ttn.lorawan.v3.DownlinkMessage
+	(*durationpb.Duration)(nil),                    // 8: google.protobuf.Duration
+}
+var file_ttn_lorawan_v3_gatewayserver_handoff_proto_depIdxs = []int32{
+	2,  // 0: ttn.lorawan.v3.GatewaySchedulerState.clock:type_name -> ttn.lorawan.v3.GatewaySchedulerState.Clock
+	3,  // 1: ttn.lorawan.v3.GatewaySchedulerState.emissions:type_name -> ttn.lorawan.v3.GatewaySchedulerState.Emission
+	4,  // 2: ttn.lorawan.v3.GatewaySchedulerState.sub_bands:type_name -> ttn.lorawan.v3.GatewaySchedulerState.SubBandEmissions
+	5,  // 3: ttn.lorawan.v3.GatewayConnectionHandoff.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	6,  // 4: ttn.lorawan.v3.GatewayConnectionHandoff.drained_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: ttn.lorawan.v3.GatewayConnectionHandoff.scheduler:type_name -> ttn.lorawan.v3.GatewaySchedulerState
+	7,  // 6: ttn.lorawan.v3.GatewayConnectionHandoff.pending_downlinks:type_name -> ttn.lorawan.v3.DownlinkMessage
+	6,  // 7: ttn.lorawan.v3.GatewaySchedulerState.Clock.server_time:type_name -> google.protobuf.Timestamp
+	6,  // 8: ttn.lorawan.v3.GatewaySchedulerState.Clock.gateway_time:type_name -> google.protobuf.Timestamp
+	8,  // 9: ttn.lorawan.v3.GatewaySchedulerState.Emission.duration:type_name -> google.protobuf.Duration
+	3,  // 10: ttn.lorawan.v3.GatewaySchedulerState.SubBandEmissions.emissions:type_name -> ttn.lorawan.v3.GatewaySchedulerState.Emission
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_gatewayserver_handoff_proto_init() }
+func file_ttn_lorawan_v3_gatewayserver_handoff_proto_init() {
+	if File_ttn_lorawan_v3_gatewayserver_handoff_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	file_ttn_lorawan_v3_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySchedulerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayConnectionHandoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySchedulerState_Clock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySchedulerState_Emission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySchedulerState_SubBandEmissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ttn_lorawan_v3_gatewayserver_handoff_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_gatewayserver_handoff_proto_depIdxs,
+		MessageInfos:      file_ttn_lorawan_v3_gatewayserver_handoff_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_gatewayserver_handoff_proto = out.File
+	file_ttn_lorawan_v3_gatewayserver_handoff_proto_rawDesc = nil
+	file_ttn_lorawan_v3_gatewayserver_handoff_proto_goTypes = nil
+	file_ttn_lorawan_v3_gatewayserver_handoff_proto_depIdxs = nil
+}
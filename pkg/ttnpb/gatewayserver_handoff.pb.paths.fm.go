@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var GatewaySchedulerStateFieldPathsNested = []string{
+	"clock",
+	"clock.absolute",
+	"clock.gateway_time",
+	"clock.relative",
+	"clock.server_time",
+	"emissions",
+	"sub_bands",
+}
+
+var GatewaySchedulerStateFieldPathsTopLevel = []string{
+	"clock",
+	"emissions",
+	"sub_bands",
+}
+var GatewayConnectionHandoffFieldPathsNested = []string{
+	"drained_at",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"pending_downlinks",
+	"scheduler",
+	"scheduler.clock",
+	"scheduler.clock.absolute",
+	"scheduler.clock.gateway_time",
+	"scheduler.clock.relative",
+	"scheduler.clock.server_time",
+	"scheduler.emissions",
+	"scheduler.sub_bands",
+}
+
+var GatewayConnectionHandoffFieldPathsTopLevel = []string{
+	"drained_at",
+	"gateway_ids",
+	"pending_downlinks",
+	"scheduler",
+}
+var GatewaySchedulerState_ClockFieldPathsNested = []string{
+	"absolute",
+	"gateway_time",
+	"relative",
+	"server_time",
+}
+
+var GatewaySchedulerState_ClockFieldPathsTopLevel = []string{
+	"absolute",
+	"gateway_time",
+	"relative",
+	"server_time",
+}
+var GatewaySchedulerState_EmissionFieldPathsNested = []string{
+	"duration",
+	"starts",
+}
+
+var GatewaySchedulerState_EmissionFieldPathsTopLevel = []string{
+	"duration",
+	"starts",
+}
+var GatewaySchedulerState_SubBandEmissionsFieldPathsNested = []string{
+	"emissions",
+	"max_frequency",
+	"min_frequency",
+}
+
+var GatewaySchedulerState_SubBandEmissionsFieldPathsTopLevel = []string{
+	"emissions",
+	"max_frequency",
+	"min_frequency",
+}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import fmt "fmt"
+
+func (dst *GatewaySchedulerState) SetFields(src *GatewaySchedulerState, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "clock":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewaySchedulerState_Clock
+				if (src == nil || src.Clock == nil) && dst.Clock == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Clock
+				}
+				if dst.Clock != nil {
+					newDst = dst.Clock
+				} else {
+					newDst = &GatewaySchedulerState_Clock{}
+					dst.Clock = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Clock = src.Clock
+				} else {
+					dst.Clock = nil
+				}
+			}
+		case "emissions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'emissions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Emissions = src.Emissions
+			} else {
+				dst.Emissions = nil
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionHandoff) SetFields(src *GatewayConnectionHandoff, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "drained_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'drained_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DrainedAt = src.DrainedAt
+			} else {
+				dst.DrainedAt = nil
+			}
+		case "scheduler":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewaySchedulerState
+				if (src == nil || src.Scheduler == nil) && dst.Scheduler == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Scheduler
+				}
+				if dst.Scheduler != nil {
+					newDst = dst.Scheduler
+				} else {
+					newDst = &GatewaySchedulerState{}
+					dst.Scheduler = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Scheduler = src.Scheduler
+				} else {
+					dst.Scheduler = nil
+				}
+			}
+		case "pending_downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending_downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PendingDownlinks = src.PendingDownlinks
+			} else {
+				dst.PendingDownlinks = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySchedulerState_Clock) SetFields(src *GatewaySchedulerState_Clock, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "relative":
+			if len(subs) > 0 {
+				return fmt.Errorf("'relative' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Relative = src.Relative
+			} else {
+				var zero uint32
+				dst.Relative = zero
+			}
+		case "absolute":
+			if len(subs) > 0 {
+				return fmt.Errorf("'absolute' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Absolute = src.Absolute
+			} else {
+				var zero int64
+				dst.Absolute = zero
+			}
+		case "server_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'server_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServerTime = src.ServerTime
+			} else {
+				dst.ServerTime = nil
+			}
+		case "gateway_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayTime = src.GatewayTime
+			} else {
+				dst.GatewayTime = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySchedulerState_Emission) SetFields(src *GatewaySchedulerState_Emission, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "starts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'starts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Starts = src.Starts
+			} else {
+				var zero int64
+				dst.Starts = zero
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewaySchedulerState_SubBandEmissions) SetFields(src *GatewaySchedulerState_SubBandEmissions, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinFrequency = src.MinFrequency
+			} else {
+				var zero uint64
+				dst.MinFrequency = zero
+			}
+		case "max_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxFrequency = src.MaxFrequency
+			} else {
+				var zero uint64
+				dst.MaxFrequency = zero
+			}
+		case "emissions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'emissions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Emissions = src.Emissions
+			} else {
+				dst.Emissions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
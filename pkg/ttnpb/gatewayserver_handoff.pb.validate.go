@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on GatewaySchedulerState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewaySchedulerState) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySchedulerStateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "clock":
+
+			if v, ok := interface{}(m.GetClock()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySchedulerStateValidationError{
+						field:  "clock",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "emissions":
+
+			for idx, item := range m.GetEmissions() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySchedulerStateValidationError{
+							field:  fmt.Sprintf("emissions[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySchedulerStateValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewaySchedulerStateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySchedulerStateValidationError is the validation error returned by
+// GatewaySchedulerState.ValidateFields if the designated constraints aren't met.
+type GatewaySchedulerStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySchedulerStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySchedulerStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySchedulerStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySchedulerStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySchedulerStateValidationError) ErrorName() string {
+	return "GatewaySchedulerStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySchedulerStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySchedulerState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySchedulerStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySchedulerStateValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionHandoff with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionHandoff) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionHandoffFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayConnectionHandoffValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHandoffValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "drained_at":
+
+			if v, ok := interface{}(m.GetDrainedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHandoffValidationError{
+						field:  "drained_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "scheduler":
+
+			if v, ok := interface{}(m.GetScheduler()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHandoffValidationError{
+						field:  "scheduler",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "pending_downlinks":
+
+			for idx, item := range m.GetPendingDownlinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionHandoffValidationError{
+							field:  fmt.Sprintf("pending_downlinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionHandoffValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionHandoffValidationError is the validation error returned by
+// GatewayConnectionHandoff.ValidateFields if the designated constraints
+// aren't met.
+type GatewayConnectionHandoffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionHandoffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionHandoffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionHandoffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionHandoffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionHandoffValidationError) ErrorName() string {
+	return "GatewayConnectionHandoffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionHandoffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionHandoff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionHandoffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionHandoffValidationError{}
+
+// ValidateFields checks the field values on GatewaySchedulerState_Clock with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewaySchedulerState_Clock) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySchedulerState_ClockFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "relative":
+			// no validation rules for Relative
+		case "absolute":
+			// no validation rules for Absolute
+		case "server_time":
+
+			if v, ok := interface{}(m.GetServerTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySchedulerState_ClockValidationError{
+						field:  "server_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateway_time":
+
+			if v, ok := interface{}(m.GetGatewayTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySchedulerState_ClockValidationError{
+						field:  "gateway_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewaySchedulerState_ClockValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySchedulerState_ClockValidationError is the validation error returned
+// by GatewaySchedulerState_Clock.ValidateFields if the designated constraints
+// aren't met.
+type GatewaySchedulerState_ClockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySchedulerState_ClockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySchedulerState_ClockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySchedulerState_ClockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySchedulerState_ClockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySchedulerState_ClockValidationError) ErrorName() string {
+	return "GatewaySchedulerState_ClockValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySchedulerState_ClockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySchedulerState_Clock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySchedulerState_ClockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySchedulerState_ClockValidationError{}
+
+// ValidateFields checks the field values on GatewaySchedulerState_Emission
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewaySchedulerState_Emission) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySchedulerState_EmissionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "starts":
+			// no validation rules for Starts
+		case "duration":
+
+			if v, ok := interface{}(m.GetDuration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewaySchedulerState_EmissionValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewaySchedulerState_EmissionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySchedulerState_EmissionValidationError is the validation error
+// returned by GatewaySchedulerState_Emission.ValidateFields if the designated
+// constraints aren't met.
+type GatewaySchedulerState_EmissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySchedulerState_EmissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySchedulerState_EmissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySchedulerState_EmissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySchedulerState_EmissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySchedulerState_EmissionValidationError) ErrorName() string {
+	return "GatewaySchedulerState_EmissionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySchedulerState_EmissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySchedulerState_Emission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySchedulerState_EmissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySchedulerState_EmissionValidationError{}
+
+// ValidateFields checks the field values on
+// GatewaySchedulerState_SubBandEmissions with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GatewaySchedulerState_SubBandEmissions) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewaySchedulerState_SubBandEmissionsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min_frequency":
+			// no validation rules for MinFrequency
+		case "max_frequency":
+			// no validation rules for MaxFrequency
+		case "emissions":
+
+			for idx, item := range m.GetEmissions() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewaySchedulerState_SubBandEmissionsValidationError{
+							field:  fmt.Sprintf("emissions[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewaySchedulerState_SubBandEmissionsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewaySchedulerState_SubBandEmissionsValidationError is the validation
+// error returned by GatewaySchedulerState_SubBandEmissions.ValidateFields if
+// the designated constraints aren't met.
+type GatewaySchedulerState_SubBandEmissionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewaySchedulerState_SubBandEmissionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewaySchedulerState_SubBandEmissionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewaySchedulerState_SubBandEmissionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewaySchedulerState_SubBandEmissionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewaySchedulerState_SubBandEmissionsValidationError) ErrorName() string {
+	return "GatewaySchedulerState_SubBandEmissionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewaySchedulerState_SubBandEmissionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewaySchedulerState_SubBandEmissions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewaySchedulerState_SubBandEmissionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewaySchedulerState_SubBandEmissionsValidationError{}
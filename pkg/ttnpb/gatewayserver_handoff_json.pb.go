@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/gatewayserver_handoff.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the GatewayConnectionHandoff message to JSON.
+func (x *GatewayConnectionHandoff) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.DrainedAt != nil || s.HasField("drained_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("drained_at")
+		if x.DrainedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.DrainedAt)
+		}
+	}
+	if x.Scheduler != nil || s.HasField("scheduler") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("scheduler")
+		// NOTE: GatewaySchedulerState does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Scheduler)
+	}
+	if len(x.PendingDownlinks) > 0 || s.HasField("pending_downlinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_downlinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.PendingDownlinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("pending_downlinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayConnectionHandoff to JSON.
+func (x *GatewayConnectionHandoff) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayConnectionHandoff message from JSON.
+func (x *GatewayConnectionHandoff) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "drained_at", "drainedAt":
+			s.AddField("drained_at")
+			if s.ReadNil() {
+				x.DrainedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.DrainedAt = v
+		case "scheduler":
+			s.AddField("scheduler")
+			if s.ReadNil() {
+				x.Scheduler = nil
+				return
+			}
+			// NOTE: GatewaySchedulerState does not seem to implement UnmarshalProtoJSON.
+			var v GatewaySchedulerState
+			golang.UnmarshalMessage(s, &v)
+			x.Scheduler = &v
+		case "pending_downlinks", "pendingDownlinks":
+			s.AddField("pending_downlinks")
+			if s.ReadNil() {
+				x.PendingDownlinks = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.PendingDownlinks = append(x.PendingDownlinks, nil)
+					return
+				}
+				v := &DownlinkMessage{}
+				v.UnmarshalProtoJSON(s.WithField("pending_downlinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.PendingDownlinks = append(x.PendingDownlinks, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayConnectionHandoff from JSON.
+func (x *GatewayConnectionHandoff) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
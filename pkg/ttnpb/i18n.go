@@ -180,4 +180,13 @@ func init() {
 	defineEnum(Right_RIGHT_SEND_INVITES, "send user invites")
 
 	defineEnum(Right_RIGHT_ALL, "all possible rights")
+
+	defineEnum(GatewayAlertCondition_GATEWAY_ALERT_OFFLINE, "offline")
+	defineEnum(GatewayAlertCondition_GATEWAY_ALERT_NO_UPLINKS, "no uplinks")
+	defineEnum(GatewayAlertCondition_GATEWAY_ALERT_TX_FAILURE_RATIO, "high downlink transmission failure ratio")
+	defineEnum(GatewayAlertCondition_GATEWAY_ALERT_CLOCK_DRIFT, "clock drift")
+
+	defineEnum(GatewayCapturePacketType_GATEWAY_CAPTURE_UPLINK, "uplink")
+	defineEnum(GatewayCapturePacketType_GATEWAY_CAPTURE_DOWNLINK, "downlink")
+	defineEnum(GatewayCapturePacketType_GATEWAY_CAPTURE_TX_ACKNOWLEDGMENT, "transmission acknowledgment")
 }
@@ -38,6 +38,8 @@ func TestEnumMessages(t *testing.T) {
 		{desc: "LocationSource", names: LocationSource_name},
 		{desc: "PayloadFormatter", names: PayloadFormatter_name},
 		{desc: "Right", names: Right_name},
+		{desc: "GatewayAlertCondition", names: GatewayAlertCondition_name},
+		{desc: "GatewayCapturePacketType", names: GatewayCapturePacketType_name},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
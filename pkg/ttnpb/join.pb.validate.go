@@ -49,10 +49,10 @@ func (m *JoinRequest) ValidateFields(paths ...string) error {
 		switch name {
 		case "raw_payload":
 
-			if len(m.GetRawPayload()) != 23 {
+			if l := len(m.GetRawPayload()); l < 19 || l > 23 {
 				return JoinRequestValidationError{
 					field:  "raw_payload",
-					reason: "value length must be 23 bytes",
+					reason: "value length must be between 19 and 23 bytes, inclusive",
 				}
 			}
 
@@ -162,6 +162,19 @@ func (m *JoinRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "join_eui":
+
+			if len(m.GetJoinEui()) > 0 {
+
+				if len(m.GetJoinEui()) != 8 {
+					return JoinRequestValidationError{
+						field:  "join_eui",
+						reason: "value length must be 8 bytes",
+					}
+				}
+
+			}
+
 		default:
 			return JoinRequestValidationError{
 				field:  name,
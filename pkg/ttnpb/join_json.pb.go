@@ -74,6 +74,11 @@ func (x *JoinRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			golang.MarshalDuration(s, x.ConsumedAirtime)
 		}
 	}
+	if len(x.JoinEui) > 0 || s.HasField("join_eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_eui")
+		types.MarshalHEXBytes(s.WithField("join_eui"), x.JoinEui)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -145,6 +150,9 @@ func (x *JoinRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.ConsumedAirtime = v
+		case "join_eui", "joinEui":
+			s.AddField("join_eui")
+			x.JoinEui = types.Unmarshal8Bytes(s.WithField("join_eui", false))
 		}
 	})
 }
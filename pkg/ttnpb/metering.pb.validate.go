@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on UsageRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UsageRecord) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UsageRecordFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return UsageRecordValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UsageRecordValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "day":
+
+			if m.GetDay() == nil {
+				return UsageRecordValidationError{
+					field:  "day",
+					reason: "value is required",
+				}
+			}
+
+		case "uplinks":
+			// no validation rules for Uplinks
+		case "downlinks":
+			// no validation rules for Downlinks
+		case "joins":
+			// no validation rules for Joins
+		case "airtime":
+
+			if v, ok := interface{}(m.GetAirtime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UsageRecordValidationError{
+						field:  "airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "webhook_deliveries":
+			// no validation rules for WebhookDeliveries
+		default:
+			return UsageRecordValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UsageRecordValidationError is the validation error returned by
+// UsageRecord.ValidateFields if the designated constraints aren't met.
+type UsageRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageRecordValidationError) ErrorName() string { return "UsageRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsageRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageRecordValidationError{}
+
+// ValidateFields checks the field values on UsageRecords with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UsageRecords) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UsageRecordsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "records":
+
+			for idx, item := range m.GetRecords() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return UsageRecordsValidationError{
+							field:  fmt.Sprintf("records[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return UsageRecordsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UsageRecordsValidationError is the validation error returned by
+// UsageRecords.ValidateFields if the designated constraints aren't met.
+type UsageRecordsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageRecordsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageRecordsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageRecordsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageRecordsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageRecordsValidationError) ErrorName() string { return "UsageRecordsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsageRecordsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageRecords.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageRecordsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageRecordsValidationError{}
+
+// ValidateFields checks the field values on ListUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListUsageRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListUsageRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListUsageRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "from":
+
+			if v, ok := interface{}(m.GetFrom()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListUsageRequestValidationError{
+						field:  "from",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "to":
+
+			if v, ok := interface{}(m.GetTo()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListUsageRequestValidationError{
+						field:  "to",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListUsageRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListUsageRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListUsageRequestValidationError is the validation error returned by
+// ListUsageRequest.ValidateFields if the designated constraints aren't met.
+type ListUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsageRequestValidationError) ErrorName() string { return "ListUsageRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsageRequestValidationError{}
@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/metering.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the UsageRecord message to JSON.
+func (x *UsageRecord) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EntityIds != nil || s.HasField("entity_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_ids")
+		x.EntityIds.MarshalProtoJSON(s.WithField("entity_ids"))
+	}
+	if x.Day != nil || s.HasField("day") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("day")
+		if x.Day == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.Day)
+		}
+	}
+	if x.Uplinks != 0 || s.HasField("uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplinks")
+		s.WriteUint64(x.Uplinks)
+	}
+	if x.Downlinks != 0 || s.HasField("downlinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlinks")
+		s.WriteUint64(x.Downlinks)
+	}
+	if x.Joins != 0 || s.HasField("joins") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("joins")
+		s.WriteUint64(x.Joins)
+	}
+	if x.Airtime != nil || s.HasField("airtime") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("airtime")
+		if x.Airtime == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Airtime)
+		}
+	}
+	if x.WebhookDeliveries != 0 || s.HasField("webhook_deliveries") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("webhook_deliveries")
+		s.WriteUint64(x.WebhookDeliveries)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UsageRecord to JSON.
+func (x *UsageRecord) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UsageRecord message from JSON.
+func (x *UsageRecord) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "entity_ids", "entityIds":
+			if s.ReadNil() {
+				x.EntityIds = nil
+				return
+			}
+			x.EntityIds = &EntityIdentifiers{}
+			x.EntityIds.UnmarshalProtoJSON(s.WithField("entity_ids", true))
+		case "day":
+			s.AddField("day")
+			if s.ReadNil() {
+				x.Day = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Day = v
+		case "uplinks":
+			s.AddField("uplinks")
+			x.Uplinks = s.ReadUint64()
+		case "downlinks":
+			s.AddField("downlinks")
+			x.Downlinks = s.ReadUint64()
+		case "joins":
+			s.AddField("joins")
+			x.Joins = s.ReadUint64()
+		case "airtime":
+			s.AddField("airtime")
+			if s.ReadNil() {
+				x.Airtime = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Airtime = v
+		case "webhook_deliveries", "webhookDeliveries":
+			s.AddField("webhook_deliveries")
+			x.WebhookDeliveries = s.ReadUint64()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UsageRecord from JSON.
+func (x *UsageRecord) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UsageRecords message to JSON.
+func (x *UsageRecords) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Records) > 0 || s.HasField("records") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("records")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Records {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("records"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UsageRecords to JSON.
+func (x *UsageRecords) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UsageRecords message from JSON.
+func (x *UsageRecords) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "records":
+			s.AddField("records")
+			if s.ReadNil() {
+				x.Records = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Records = append(x.Records, nil)
+					return
+				}
+				v := &UsageRecord{}
+				v.UnmarshalProtoJSON(s.WithField("records", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Records = append(x.Records, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UsageRecords from JSON.
+func (x *UsageRecords) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ListUsageRequest message to JSON.
+func (x *ListUsageRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EntityIds != nil || s.HasField("entity_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_ids")
+		x.EntityIds.MarshalProtoJSON(s.WithField("entity_ids"))
+	}
+	if x.From != nil || s.HasField("from") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("from")
+		if x.From == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.From)
+		}
+	}
+	if x.To != nil || s.HasField("to") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("to")
+		if x.To == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.To)
+		}
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ListUsageRequest to JSON.
+func (x *ListUsageRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ListUsageRequest message from JSON.
+func (x *ListUsageRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "entity_ids", "entityIds":
+			if s.ReadNil() {
+				x.EntityIds = nil
+				return
+			}
+			x.EntityIds = &EntityIdentifiers{}
+			x.EntityIds.UnmarshalProtoJSON(s.WithField("entity_ids", true))
+		case "from":
+			s.AddField("from")
+			if s.ReadNil() {
+				x.From = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.From = v
+		case "to":
+			s.AddField("to")
+			if s.ReadNil() {
+				x.To = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.To = v
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ListUsageRequest from JSON.
+func (x *ListUsageRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
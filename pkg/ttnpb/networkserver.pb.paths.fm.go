@@ -32,3 +32,29 @@ var GetDeviceAdressPrefixesResponseFieldPathsNested = []string{
 var GetDeviceAdressPrefixesResponseFieldPathsTopLevel = []string{
 	"dev_addr_prefixes",
 }
+var EnqueueMACCommandsRequestFieldPathsNested = []string{
+	"commands",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"replace",
+}
+
+var EnqueueMACCommandsRequestFieldPathsTopLevel = []string{
+	"commands",
+	"end_device_ids",
+	"replace",
+}
+var OperatorMACCommandsFieldPathsNested = []string{
+	"pending_requests",
+	"queued_commands",
+}
+
+var OperatorMACCommandsFieldPathsTopLevel = []string{
+	"pending_requests",
+	"queued_commands",
+}
@@ -93,3 +93,87 @@ func (dst *GetDeviceAdressPrefixesResponse) SetFields(src *GetDeviceAdressPrefix
 	}
 	return nil
 }
+
+func (dst *EnqueueMACCommandsRequest) SetFields(src *EnqueueMACCommandsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Commands = src.Commands
+			} else {
+				dst.Commands = nil
+			}
+		case "replace":
+			if len(subs) > 0 {
+				return fmt.Errorf("'replace' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Replace = src.Replace
+			} else {
+				var zero bool
+				dst.Replace = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *OperatorMACCommands) SetFields(src *OperatorMACCommands, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "queued_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queued_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueuedCommands = src.QueuedCommands
+			} else {
+				dst.QueuedCommands = nil
+			}
+		case "pending_requests":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending_requests' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PendingRequests = src.PendingRequests
+			} else {
+				dst.PendingRequests = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -413,3 +413,245 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetDeviceAdressPrefixesResponseValidationError{}
+
+// ValidateFields checks the field values on EnqueueMACCommandsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EnqueueMACCommandsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EnqueueMACCommandsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return EnqueueMACCommandsRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EnqueueMACCommandsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "commands":
+
+			if len(m.GetCommands()) > 16 {
+				return EnqueueMACCommandsRequestValidationError{
+					field:  "commands",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EnqueueMACCommandsRequestValidationError{
+							field:  fmt.Sprintf("commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "replace":
+			// no validation rules for Replace
+		default:
+			return EnqueueMACCommandsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EnqueueMACCommandsRequestValidationError is the validation error returned by
+// EnqueueMACCommandsRequest.ValidateFields if the designated constraints
+// aren't met.
+type EnqueueMACCommandsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnqueueMACCommandsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnqueueMACCommandsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnqueueMACCommandsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnqueueMACCommandsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnqueueMACCommandsRequestValidationError) ErrorName() string {
+	return "EnqueueMACCommandsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnqueueMACCommandsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnqueueMACCommandsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnqueueMACCommandsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnqueueMACCommandsRequestValidationError{}
+
+// ValidateFields checks the field values on OperatorMACCommands with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OperatorMACCommands) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OperatorMACCommandsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "queued_commands":
+
+			for idx, item := range m.GetQueuedCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return OperatorMACCommandsValidationError{
+							field:  fmt.Sprintf("queued_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "pending_requests":
+
+			for idx, item := range m.GetPendingRequests() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return OperatorMACCommandsValidationError{
+							field:  fmt.Sprintf("pending_requests[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return OperatorMACCommandsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OperatorMACCommandsValidationError is the validation error returned by
+// OperatorMACCommands.ValidateFields if the designated constraints aren't met.
+type OperatorMACCommandsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OperatorMACCommandsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OperatorMACCommandsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OperatorMACCommandsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OperatorMACCommandsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OperatorMACCommandsValidationError) ErrorName() string {
+	return "OperatorMACCommandsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OperatorMACCommandsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOperatorMACCommands.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OperatorMACCommandsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OperatorMACCommandsValidationError{}
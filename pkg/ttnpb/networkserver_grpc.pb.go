@@ -550,6 +550,7 @@ const (
 	NsEndDeviceRegistry_Set_FullMethodName                  = "/ttn.lorawan.v3.NsEndDeviceRegistry/Set"
 	NsEndDeviceRegistry_ResetFactoryDefaults_FullMethodName = "/ttn.lorawan.v3.NsEndDeviceRegistry/ResetFactoryDefaults"
 	NsEndDeviceRegistry_Delete_FullMethodName               = "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete"
+	NsEndDeviceRegistry_EnqueueMACCommands_FullMethodName   = "/ttn.lorawan.v3.NsEndDeviceRegistry/EnqueueMACCommands"
 )
 
 // NsEndDeviceRegistryClient is the client API for NsEndDeviceRegistry service.
@@ -566,6 +567,9 @@ type NsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// EnqueueMACCommands enqueues MAC commands, which are sent to the end device in the next downlink messages.
+	// The commands are validated against the band and LoRaWAN version of the end device.
+	EnqueueMACCommands(ctx context.Context, in *EnqueueMACCommandsRequest, opts ...grpc.CallOption) (*OperatorMACCommands, error)
 }
 
 type nsEndDeviceRegistryClient struct {
@@ -612,6 +616,15 @@ func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *nsEndDeviceRegistryClient) EnqueueMACCommands(ctx context.Context, in *EnqueueMACCommandsRequest, opts ...grpc.CallOption) (*OperatorMACCommands, error) {
+	out := new(OperatorMACCommands)
+	err := c.cc.Invoke(ctx, NsEndDeviceRegistry_EnqueueMACCommands_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
 // All implementations must embed UnimplementedNsEndDeviceRegistryServer
 // for forward compatibility
@@ -626,6 +639,9 @@ type NsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error)
+	// EnqueueMACCommands enqueues MAC commands, which are sent to the end device in the next downlink messages.
+	// The commands are validated against the band and LoRaWAN version of the end device.
+	EnqueueMACCommands(context.Context, *EnqueueMACCommandsRequest) (*OperatorMACCommands, error)
 	mustEmbedUnimplementedNsEndDeviceRegistryServer()
 }
 
@@ -645,6 +661,9 @@ func (UnimplementedNsEndDeviceRegistryServer) ResetFactoryDefaults(context.Conte
 func (UnimplementedNsEndDeviceRegistryServer) Delete(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedNsEndDeviceRegistryServer) EnqueueMACCommands(context.Context, *EnqueueMACCommandsRequest) (*OperatorMACCommands, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueueMACCommands not implemented")
+}
 func (UnimplementedNsEndDeviceRegistryServer) mustEmbedUnimplementedNsEndDeviceRegistryServer() {}
 
 // UnsafeNsEndDeviceRegistryServer may be embedded to opt out of forward compatibility for this service.
@@ -730,6 +749,24 @@ func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceRegistry_EnqueueMACCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueMACCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).EnqueueMACCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsEndDeviceRegistry_EnqueueMACCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).EnqueueMACCommands(ctx, req.(*EnqueueMACCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NsEndDeviceRegistry_ServiceDesc is the grpc.ServiceDesc for NsEndDeviceRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -753,6 +790,10 @@ var NsEndDeviceRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _NsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "EnqueueMACCommands",
+			Handler:    _NsEndDeviceRegistry_EnqueueMACCommands_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/networkserver.proto",
@@ -190,3 +190,178 @@ func (x *GetDeviceAdressPrefixesResponse) UnmarshalProtoJSON(s *jsonplugin.Unmar
 func (x *GetDeviceAdressPrefixesResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the EnqueueMACCommandsRequest message to JSON.
+func (x *EnqueueMACCommandsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.Commands) > 0 || s.HasField("commands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("commands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Commands {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("commands"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Replace || s.HasField("replace") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("replace")
+		s.WriteBool(x.Replace)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EnqueueMACCommandsRequest to JSON.
+func (x *EnqueueMACCommandsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EnqueueMACCommandsRequest message from JSON.
+func (x *EnqueueMACCommandsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "commands":
+			s.AddField("commands")
+			if s.ReadNil() {
+				x.Commands = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Commands = append(x.Commands, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("commands", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Commands = append(x.Commands, v)
+			})
+		case "replace":
+			s.AddField("replace")
+			x.Replace = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EnqueueMACCommandsRequest from JSON.
+func (x *EnqueueMACCommandsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the OperatorMACCommands message to JSON.
+func (x *OperatorMACCommands) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.QueuedCommands) > 0 || s.HasField("queued_commands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("queued_commands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.QueuedCommands {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("queued_commands"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.PendingRequests) > 0 || s.HasField("pending_requests") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_requests")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.PendingRequests {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("pending_requests"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the OperatorMACCommands to JSON.
+func (x *OperatorMACCommands) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the OperatorMACCommands message from JSON.
+func (x *OperatorMACCommands) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "queued_commands", "queuedCommands":
+			s.AddField("queued_commands")
+			if s.ReadNil() {
+				x.QueuedCommands = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.QueuedCommands = append(x.QueuedCommands, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("queued_commands", false))
+				if s.Err() != nil {
+					return
+				}
+				x.QueuedCommands = append(x.QueuedCommands, v)
+			})
+		case "pending_requests", "pendingRequests":
+			s.AddField("pending_requests")
+			if s.ReadNil() {
+				x.PendingRequests = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.PendingRequests = append(x.PendingRequests, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("pending_requests", false))
+				if s.Err() != nil {
+					return
+				}
+				x.PendingRequests = append(x.PendingRequests, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the OperatorMACCommands from JSON.
+func (x *OperatorMACCommands) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
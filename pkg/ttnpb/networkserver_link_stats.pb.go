@@ -0,0 +1,660 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: ttn/lorawan/v3/networkserver_link_stats.proto
+
+package ttnpb
+
+import (
+	_ "github.com/TheThingsIndustries/protoc-gen-go-json/annotations"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Time span of link statistics buckets.
+type LinkStatsGranularity int32
+
+const (
+	LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR LinkStatsGranularity = 0
+	LinkStatsGranularity_LINK_STATS_GRANULARITY_DAY  LinkStatsGranularity = 1
+)
+
+// Enum value maps for LinkStatsGranularity.
+var (
+	LinkStatsGranularity_name = map[int32]string{
+		0: "LINK_STATS_GRANULARITY_HOUR",
+		1: "LINK_STATS_GRANULARITY_DAY",
+	}
+	LinkStatsGranularity_value = map[string]int32{
+		"LINK_STATS_GRANULARITY_HOUR": 0,
+		"LINK_STATS_GRANULARITY_DAY":  1,
+	}
+)
+
+func (x LinkStatsGranularity) Enum() *LinkStatsGranularity {
+	p := new(LinkStatsGranularity)
+	*p = x
+	return p
+}
+
+func (x LinkStatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkStatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (LinkStatsGranularity) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_link_stats_proto_enumTypes[0]
+}
+
+func (x LinkStatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkStatsGranularity.Descriptor instead.
+func (LinkStatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescGZIP(), []int{0}
+}
+
+// Statistics of a link quality indicator.
+type SignalStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min  float32 `protobuf:"fixed32,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max  float32 `protobuf:"fixed32,2,opt,name=max,proto3" json:"max,omitempty"`
+	Mean float32 `protobuf:"fixed32,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Number of samples.
+	Count uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SignalStats) Reset() {
+	*x = SignalStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalStats) ProtoMessage() {}
+
+func (x *SignalStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalStats.ProtoReflect.Descriptor instead.
+func (*SignalStats) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SignalStats) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *SignalStats) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *SignalStats) GetMean() float32 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *SignalStats) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Aggregated link statistics of an end device over a time span.
+type EndDeviceLinkStatsBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start of the time span.
+	StartAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Number of received uplink messages.
+	UplinkCount uint32 `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of uplink messages that were not received, based on the gaps in the frame counter.
+	MissedUplinkCount uint32 `protobuf:"varint,3,opt,name=missed_uplink_count,json=missedUplinkCount,proto3" json:"missed_uplink_count,omitempty"`
+	// Packet error rate, which is the ratio of missed uplink messages to all transmitted uplink messages.
+	PacketErrorRate float32 `protobuf:"fixed32,4,opt,name=packet_error_rate,json=packetErrorRate,proto3" json:"packet_error_rate,omitempty"`
+	// Statistics of the best SNR per uplink message.
+	Snr *SignalStats `protobuf:"bytes,5,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Statistics of the best RSSI per uplink message.
+	Rssi *SignalStats `protobuf:"bytes,6,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// Statistics of the number of gateways that received an uplink message.
+	GatewayCount *SignalStats `protobuf:"bytes,7,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
+	// IDs of the gateways that received uplink messages.
+	GatewayIds []string `protobuf:"bytes,8,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Number of times the frame counter was reset, which typically indicates a device reset or rejoin.
+	FCntResetCount uint32 `protobuf:"varint,9,opt,name=f_cnt_reset_count,json=fCntResetCount,proto3" json:"f_cnt_reset_count,omitempty"`
+}
+
+func (x *EndDeviceLinkStatsBucket) Reset() {
+	*x = EndDeviceLinkStatsBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceLinkStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceLinkStatsBucket) ProtoMessage() {}
+
+func (x *EndDeviceLinkStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceLinkStatsBucket.ProtoReflect.Descriptor instead.
+func (*EndDeviceLinkStatsBucket) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EndDeviceLinkStatsBucket) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStatsBucket) GetUplinkCount() uint32 {
+	if x != nil {
+		return x.UplinkCount
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStatsBucket) GetMissedUplinkCount() uint32 {
+	if x != nil {
+		return x.MissedUplinkCount
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStatsBucket) GetPacketErrorRate() float32 {
+	if x != nil {
+		return x.PacketErrorRate
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStatsBucket) GetSnr() *SignalStats {
+	if x != nil {
+		return x.Snr
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStatsBucket) GetRssi() *SignalStats {
+	if x != nil {
+		return x.Rssi
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStatsBucket) GetGatewayCount() *SignalStats {
+	if x != nil {
+		return x.GatewayCount
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStatsBucket) GetGatewayIds() []string {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStatsBucket) GetFCntResetCount() uint32 {
+	if x != nil {
+		return x.FCntResetCount
+	}
+	return 0
+}
+
+type GetEndDeviceLinkStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	Granularity  LinkStatsGranularity  `protobuf:"varint,2,opt,name=granularity,proto3,enum=ttn.lorawan.v3.LinkStatsGranularity" json:"granularity,omitempty"`
+	// Only return buckets that start at or after this time.
+	Since *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *GetEndDeviceLinkStatsRequest) Reset() {
+	*x = GetEndDeviceLinkStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEndDeviceLinkStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndDeviceLinkStatsRequest) ProtoMessage() {}
+
+func (x *GetEndDeviceLinkStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndDeviceLinkStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEndDeviceLinkStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEndDeviceLinkStatsRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *GetEndDeviceLinkStatsRequest) GetGranularity() LinkStatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR
+}
+
+func (x *GetEndDeviceLinkStatsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type EndDeviceLinkStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	Granularity  LinkStatsGranularity  `protobuf:"varint,2,opt,name=granularity,proto3,enum=ttn.lorawan.v3.LinkStatsGranularity" json:"granularity,omitempty"`
+	// Buckets, ordered by start time.
+	Buckets []*EndDeviceLinkStatsBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// Aggregate of all buckets. The start time is the start time of the first bucket.
+	Total *EndDeviceLinkStatsBucket `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *EndDeviceLinkStats) Reset() {
+	*x = EndDeviceLinkStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceLinkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceLinkStats) ProtoMessage() {}
+
+func (x *EndDeviceLinkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceLinkStats.ProtoReflect.Descriptor instead.
+func (*EndDeviceLinkStats) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EndDeviceLinkStats) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetGranularity() LinkStatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return LinkStatsGranularity_LINK_STATS_GRANULARITY_HOUR
+}
+
+func (x *EndDeviceLinkStats) GetBuckets() []*EndDeviceLinkStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetTotal() *EndDeviceLinkStatsBucket {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+var File_ttn_lorawan_v3_networkserver_link_stats_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
+	0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b, 0x0a, 0x0b, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6d, 0x65,
+	0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd2, 0x03, 0x0a, 0x18, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x55, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x03, 0x73, 0x6e, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x04, 0x72, 0x73, 0x73, 0x69, 0x12, 0x40, 0x0a, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0c, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49,
+	0x64, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66,
+	0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf8, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54,
+	0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x4a, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x67,
+	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2a, 0x77, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52,
+	0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01,
+	0x1a, 0x1e, 0xea, 0xaa, 0x19, 0x1a, 0x18, 0x01, 0x2a, 0x16, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59,
+	0x32, 0x9b, 0x02, 0x0a, 0x14, 0x4e, 0x73, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0xd8, 0x01, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x76, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x70, 0x12, 0x6e, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x2d, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x1a, 0x28, 0x92, 0x41, 0x25, 0x12, 0x23, 0x47, 0x65, 0x74, 0x20, 0x6c,
+	0x69, 0x6e, 0x6b, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescData = file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_networkserver_link_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ttn_lorawan_v3_networkserver_link_stats_proto_goTypes = []interface{}{
+	(LinkStatsGranularity)(0),            // 0: ttn.lorawan.v3.LinkStatsGranularity
+	(*SignalStats)(nil),                  // 1: ttn.lorawan.v3.SignalStats
+	(*EndDeviceLinkStatsBucket)(nil),     // 2: ttn.lorawan.v3.EndDeviceLinkStatsBucket
+	(*GetEndDeviceLinkStatsRequest)(nil), // 3: ttn.lorawan.v3.GetEndDeviceLinkStatsRequest
+	(*EndDeviceLinkStats)(nil),           // 4: ttn.lorawan.v3.EndDeviceLinkStats
+	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+	(*EndDeviceIdentifiers)(nil),         // 6: ttn.lorawan.v3.EndDeviceIdentifiers
+}
+var file_ttn_lorawan_v3_networkserver_link_stats_proto_depIdxs = []int32{
+	5,  // 0: ttn.lorawan.v3.EndDeviceLinkStatsBucket.start_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: ttn.lorawan.v3.EndDeviceLinkStatsBucket.snr:type_name -> ttn.lorawan.v3.SignalStats
+	1,  // 2: ttn.lorawan.v3.EndDeviceLinkStatsBucket.rssi:type_name -> ttn.lorawan.v3.SignalStats
+	1,  // 3: ttn.lorawan.v3.EndDeviceLinkStatsBucket.gateway_count:type_name -> ttn.lorawan.v3.SignalStats
+	6,  // 4: ttn.lorawan.v3.GetEndDeviceLinkStatsRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	0,  // 5: ttn.lorawan.v3.GetEndDeviceLinkStatsRequest.granularity:type_name -> ttn.lorawan.v3.LinkStatsGranularity
+	5,  // 6: ttn.lorawan.v3.GetEndDeviceLinkStatsRequest.since:type_name -> google.protobuf.Timestamp
+	6,  // 7: ttn.lorawan.v3.EndDeviceLinkStats.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	0,  // 8: ttn.lorawan.v3.EndDeviceLinkStats.granularity:type_name -> ttn.lorawan.v3.LinkStatsGranularity
+	2,  // 9: ttn.lorawan.v3.EndDeviceLinkStats.buckets:type_name -> ttn.lorawan.v3.EndDeviceLinkStatsBucket
+	2,  // 10: ttn.lorawan.v3.EndDeviceLinkStats.total:type_name -> ttn.lorawan.v3.EndDeviceLinkStatsBucket
+	3,  // 11: ttn.lorawan.v3.NsEndDeviceLinkStats.GetLinkStats:input_type -> ttn.lorawan.v3.GetEndDeviceLinkStatsRequest
+	4,  // 12: ttn.lorawan.v3.NsEndDeviceLinkStats.GetLinkStats:output_type -> ttn.lorawan.v3.EndDeviceLinkStats
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_networkserver_link_stats_proto_init() }
+func file_ttn_lorawan_v3_networkserver_link_stats_proto_init() {
+	if File_ttn_lorawan_v3_networkserver_link_stats_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceLinkStatsBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEndDeviceLinkStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceLinkStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_networkserver_link_stats_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_networkserver_link_stats_proto_depIdxs,
+		EnumInfos:         file_ttn_lorawan_v3_networkserver_link_stats_proto_enumTypes,
+		MessageInfos:      file_ttn_lorawan_v3_networkserver_link_stats_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_networkserver_link_stats_proto = out.File
+	file_ttn_lorawan_v3_networkserver_link_stats_proto_rawDesc = nil
+	file_ttn_lorawan_v3_networkserver_link_stats_proto_goTypes = nil
+	file_ttn_lorawan_v3_networkserver_link_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var SignalStatsFieldPathsNested = []string{
+	"count",
+	"max",
+	"mean",
+	"min",
+}
+
+var SignalStatsFieldPathsTopLevel = []string{
+	"count",
+	"max",
+	"mean",
+	"min",
+}
+var EndDeviceLinkStatsBucketFieldPathsNested = []string{
+	"f_cnt_reset_count",
+	"gateway_count",
+	"gateway_count.count",
+	"gateway_count.max",
+	"gateway_count.mean",
+	"gateway_count.min",
+	"gateway_ids",
+	"missed_uplink_count",
+	"packet_error_rate",
+	"rssi",
+	"rssi.count",
+	"rssi.max",
+	"rssi.mean",
+	"rssi.min",
+	"snr",
+	"snr.count",
+	"snr.max",
+	"snr.mean",
+	"snr.min",
+	"start_at",
+	"uplink_count",
+}
+
+var EndDeviceLinkStatsBucketFieldPathsTopLevel = []string{
+	"f_cnt_reset_count",
+	"gateway_count",
+	"gateway_ids",
+	"missed_uplink_count",
+	"packet_error_rate",
+	"rssi",
+	"snr",
+	"start_at",
+	"uplink_count",
+}
+var GetEndDeviceLinkStatsRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"granularity",
+	"since",
+}
+
+var GetEndDeviceLinkStatsRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"granularity",
+	"since",
+}
+var EndDeviceLinkStatsFieldPathsNested = []string{
+	"buckets",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"granularity",
+	"total",
+	"total.f_cnt_reset_count",
+	"total.gateway_count",
+	"total.gateway_count.count",
+	"total.gateway_count.max",
+	"total.gateway_count.mean",
+	"total.gateway_count.min",
+	"total.gateway_ids",
+	"total.missed_uplink_count",
+	"total.packet_error_rate",
+	"total.rssi",
+	"total.rssi.count",
+	"total.rssi.max",
+	"total.rssi.mean",
+	"total.rssi.min",
+	"total.snr",
+	"total.snr.count",
+	"total.snr.max",
+	"total.snr.mean",
+	"total.snr.min",
+	"total.start_at",
+	"total.uplink_count",
+}
+
+var EndDeviceLinkStatsFieldPathsTopLevel = []string{
+	"buckets",
+	"end_device_ids",
+	"granularity",
+	"total",
+}
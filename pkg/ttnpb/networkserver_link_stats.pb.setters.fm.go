@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import fmt "fmt"
+
+func (dst *SignalStats) SetFields(src *SignalStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero float32
+				dst.Min = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero float32
+				dst.Max = zero
+			}
+		case "mean":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mean' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mean = src.Mean
+			} else {
+				var zero float32
+				dst.Mean = zero
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkStatsBucket) SetFields(src *EndDeviceLinkStatsBucket, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "start_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartAt = src.StartAt
+			} else {
+				dst.StartAt = nil
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+		case "missed_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'missed_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MissedUplinkCount = src.MissedUplinkCount
+			} else {
+				var zero uint32
+				dst.MissedUplinkCount = zero
+			}
+		case "packet_error_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'packet_error_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PacketErrorRate = src.PacketErrorRate
+			} else {
+				var zero float32
+				dst.PacketErrorRate = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				var newDst, newSrc *SignalStats
+				if (src == nil || src.Snr == nil) && dst.Snr == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Snr
+				}
+				if dst.Snr != nil {
+					newDst = dst.Snr
+				} else {
+					newDst = &SignalStats{}
+					dst.Snr = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Snr = src.Snr
+				} else {
+					dst.Snr = nil
+				}
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				var newDst, newSrc *SignalStats
+				if (src == nil || src.Rssi == nil) && dst.Rssi == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Rssi
+				}
+				if dst.Rssi != nil {
+					newDst = dst.Rssi
+				} else {
+					newDst = &SignalStats{}
+					dst.Rssi = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Rssi = src.Rssi
+				} else {
+					dst.Rssi = nil
+				}
+			}
+		case "gateway_count":
+			if len(subs) > 0 {
+				var newDst, newSrc *SignalStats
+				if (src == nil || src.GatewayCount == nil) && dst.GatewayCount == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayCount
+				}
+				if dst.GatewayCount != nil {
+					newDst = dst.GatewayCount
+				} else {
+					newDst = &SignalStats{}
+					dst.GatewayCount = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayCount = src.GatewayCount
+				} else {
+					dst.GatewayCount = nil
+				}
+			}
+		case "gateway_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayIds = src.GatewayIds
+			} else {
+				dst.GatewayIds = nil
+			}
+		case "f_cnt_reset_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt_reset_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCntResetCount = src.FCntResetCount
+			} else {
+				var zero uint32
+				dst.FCntResetCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetEndDeviceLinkStatsRequest) SetFields(src *GetEndDeviceLinkStatsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "granularity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'granularity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Granularity = src.Granularity
+			} else {
+				dst.Granularity = 0
+			}
+		case "since":
+			if len(subs) > 0 {
+				return fmt.Errorf("'since' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Since = src.Since
+			} else {
+				dst.Since = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkStats) SetFields(src *EndDeviceLinkStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "granularity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'granularity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Granularity = src.Granularity
+			} else {
+				dst.Granularity = 0
+			}
+		case "buckets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'buckets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Buckets = src.Buckets
+			} else {
+				dst.Buckets = nil
+			}
+		case "total":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkStatsBucket
+				if (src == nil || src.Total == nil) && dst.Total == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Total
+				}
+				if dst.Total != nil {
+					newDst = dst.Total
+				} else {
+					newDst = &EndDeviceLinkStatsBucket{}
+					dst.Total = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Total = src.Total
+				} else {
+					dst.Total = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
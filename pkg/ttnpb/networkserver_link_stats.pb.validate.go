@@ -0,0 +1,510 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on SignalStats with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *SignalStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SignalStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+			// no validation rules for Min
+		case "max":
+			// no validation rules for Max
+		case "mean":
+			// no validation rules for Mean
+		case "count":
+			// no validation rules for Count
+		default:
+			return SignalStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SignalStatsValidationError is the validation error returned by
+// SignalStats.ValidateFields if the designated constraints aren't met.
+type SignalStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignalStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignalStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignalStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignalStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignalStatsValidationError) ErrorName() string { return "SignalStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignalStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignalStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignalStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignalStatsValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkStatsBucket with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceLinkStatsBucket) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkStatsBucketFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start_at":
+
+			if m.GetStartAt() == nil {
+				return EndDeviceLinkStatsBucketValidationError{
+					field:  "start_at",
+					reason: "value is required",
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "missed_uplink_count":
+			// no validation rules for MissedUplinkCount
+		case "packet_error_rate":
+			// no validation rules for PacketErrorRate
+		case "snr":
+
+			if v, ok := interface{}(m.GetSnr()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsBucketValidationError{
+						field:  "snr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rssi":
+
+			if v, ok := interface{}(m.GetRssi()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsBucketValidationError{
+						field:  "rssi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateway_count":
+
+			if v, ok := interface{}(m.GetGatewayCount()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsBucketValidationError{
+						field:  "gateway_count",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateway_ids":
+
+			if len(m.GetGatewayIds()) > 100 {
+				return EndDeviceLinkStatsBucketValidationError{
+					field:  "gateway_ids",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+		case "f_cnt_reset_count":
+			// no validation rules for FCntResetCount
+		default:
+			return EndDeviceLinkStatsBucketValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkStatsBucketValidationError is the validation error returned by
+// EndDeviceLinkStatsBucket.ValidateFields if the designated constraints
+// aren't met.
+type EndDeviceLinkStatsBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkStatsBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkStatsBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkStatsBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkStatsBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkStatsBucketValidationError) ErrorName() string {
+	return "EndDeviceLinkStatsBucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkStatsBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkStatsBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkStatsBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkStatsBucketValidationError{}
+
+// ValidateFields checks the field values on GetEndDeviceLinkStatsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GetEndDeviceLinkStatsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetEndDeviceLinkStatsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return GetEndDeviceLinkStatsRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceLinkStatsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "granularity":
+
+			if _, ok := LinkStatsGranularity_name[int32(m.GetGranularity())]; !ok {
+				return GetEndDeviceLinkStatsRequestValidationError{
+					field:  "granularity",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "since":
+
+			if v, ok := interface{}(m.GetSince()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceLinkStatsRequestValidationError{
+						field:  "since",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetEndDeviceLinkStatsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetEndDeviceLinkStatsRequestValidationError is the validation error returned
+// by GetEndDeviceLinkStatsRequest.ValidateFields if the designated
+// constraints aren't met.
+type GetEndDeviceLinkStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEndDeviceLinkStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEndDeviceLinkStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEndDeviceLinkStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEndDeviceLinkStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEndDeviceLinkStatsRequestValidationError) ErrorName() string {
+	return "GetEndDeviceLinkStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEndDeviceLinkStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEndDeviceLinkStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEndDeviceLinkStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEndDeviceLinkStatsRequestValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceLinkStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "granularity":
+			// no validation rules for Granularity
+		case "buckets":
+
+			for idx, item := range m.GetBuckets() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceLinkStatsValidationError{
+							field:  fmt.Sprintf("buckets[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "total":
+
+			if v, ok := interface{}(m.GetTotal()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "total",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceLinkStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkStatsValidationError is the validation error returned by
+// EndDeviceLinkStats.ValidateFields if the designated constraints aren't met.
+type EndDeviceLinkStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkStatsValidationError) ErrorName() string {
+	return "EndDeviceLinkStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkStatsValidationError{}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/networkserver_link_stats.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the LinkStatsGranularity to JSON.
+func (x LinkStatsGranularity) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), LinkStatsGranularity_name)
+}
+
+// MarshalText marshals the LinkStatsGranularity to text.
+func (x LinkStatsGranularity) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), LinkStatsGranularity_name)), nil
+}
+
+// MarshalJSON marshals the LinkStatsGranularity to JSON.
+func (x LinkStatsGranularity) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// LinkStatsGranularity_customvalue contains custom string values that extend LinkStatsGranularity_value.
+var LinkStatsGranularity_customvalue = map[string]int32{
+	"HOUR": 0,
+	"DAY":  1,
+}
+
+// UnmarshalProtoJSON unmarshals the LinkStatsGranularity from JSON.
+func (x *LinkStatsGranularity) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(LinkStatsGranularity_value, LinkStatsGranularity_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read LinkStatsGranularity enum: %v", err)
+		return
+	}
+	*x = LinkStatsGranularity(v)
+}
+
+// UnmarshalText unmarshals the LinkStatsGranularity from text.
+func (x *LinkStatsGranularity) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), LinkStatsGranularity_customvalue, LinkStatsGranularity_value)
+	if err != nil {
+		return err
+	}
+	*x = LinkStatsGranularity(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the LinkStatsGranularity from JSON.
+func (x *LinkStatsGranularity) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetEndDeviceLinkStatsRequest message to JSON.
+func (x *GetEndDeviceLinkStatsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Granularity != 0 || s.HasField("granularity") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("granularity")
+		x.Granularity.MarshalProtoJSON(s)
+	}
+	if x.Since != nil || s.HasField("since") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("since")
+		if x.Since == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.Since)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetEndDeviceLinkStatsRequest to JSON.
+func (x *GetEndDeviceLinkStatsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetEndDeviceLinkStatsRequest message from JSON.
+func (x *GetEndDeviceLinkStatsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "granularity":
+			s.AddField("granularity")
+			x.Granularity.UnmarshalProtoJSON(s)
+		case "since":
+			s.AddField("since")
+			if s.ReadNil() {
+				x.Since = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Since = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetEndDeviceLinkStatsRequest from JSON.
+func (x *GetEndDeviceLinkStatsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceLinkStats message to JSON.
+func (x *EndDeviceLinkStats) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Granularity != 0 || s.HasField("granularity") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("granularity")
+		x.Granularity.MarshalProtoJSON(s)
+	}
+	if len(x.Buckets) > 0 || s.HasField("buckets") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("buckets")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Buckets {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: EndDeviceLinkStatsBucket does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Total != nil || s.HasField("total") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("total")
+		// NOTE: EndDeviceLinkStatsBucket does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Total)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceLinkStats to JSON.
+func (x *EndDeviceLinkStats) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceLinkStats message from JSON.
+func (x *EndDeviceLinkStats) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "granularity":
+			s.AddField("granularity")
+			x.Granularity.UnmarshalProtoJSON(s)
+		case "buckets":
+			s.AddField("buckets")
+			if s.ReadNil() {
+				x.Buckets = nil
+				return
+			}
+			s.ReadArray(func() {
+				// NOTE: EndDeviceLinkStatsBucket does not seem to implement UnmarshalProtoJSON.
+				var v EndDeviceLinkStatsBucket
+				golang.UnmarshalMessage(s, &v)
+				x.Buckets = append(x.Buckets, &v)
+			})
+		case "total":
+			s.AddField("total")
+			if s.ReadNil() {
+				x.Total = nil
+				return
+			}
+			// NOTE: EndDeviceLinkStatsBucket does not seem to implement UnmarshalProtoJSON.
+			var v EndDeviceLinkStatsBucket
+			golang.UnmarshalMessage(s, &v)
+			x.Total = &v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceLinkStats from JSON.
+func (x *EndDeviceLinkStats) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -0,0 +1,898 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var MulticastGroupFieldPathsNested = []string{
+	"created_at",
+	"gateways",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.dev_addr",
+	"ids.dev_eui",
+	"ids.device_id",
+	"ids.join_eui",
+	"member_device_ids",
+	"updated_at",
+}
+
+var MulticastGroupFieldPathsTopLevel = []string{
+	"created_at",
+	"gateways",
+	"ids",
+	"member_device_ids",
+	"updated_at",
+}
+var MulticastGroupsFieldPathsNested = []string{
+	"groups",
+}
+
+var MulticastGroupsFieldPathsTopLevel = []string{
+	"groups",
+}
+var CreateMulticastGroupRequestFieldPathsNested = []string{
+	"end_device",
+	"end_device.activated_at",
+	"end_device.application_server_address",
+	"end_device.application_server_id",
+	"end_device.application_server_kek_label",
+	"end_device.attributes",
+	"end_device.battery_percentage",
+	"end_device.claim_authentication_code",
+	"end_device.claim_authentication_code.valid_from",
+	"end_device.claim_authentication_code.valid_to",
+	"end_device.claim_authentication_code.value",
+	"end_device.created_at",
+	"end_device.description",
+	"end_device.downlink_margin",
+	"end_device.formatters",
+	"end_device.formatters.down_formatter",
+	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.up_formatter",
+	"end_device.formatters.up_formatter_parameter",
+	"end_device.frequency_plan_id",
+	"end_device.ids",
+	"end_device.ids.application_ids",
+	"end_device.ids.application_ids.application_id",
+	"end_device.ids.dev_addr",
+	"end_device.ids.dev_eui",
+	"end_device.ids.device_id",
+	"end_device.ids.join_eui",
+	"end_device.join_server_address",
+	"end_device.last_dev_nonce",
+	"end_device.last_dev_status_received_at",
+	"end_device.last_join_nonce",
+	"end_device.last_rj_count_0",
+	"end_device.last_rj_count_1",
+	"end_device.last_seen_at",
+	"end_device.locations",
+	"end_device.lora_alliance_profile_ids",
+	"end_device.lora_alliance_profile_ids.vendor_id",
+	"end_device.lora_alliance_profile_ids.vendor_profile_id",
+	"end_device.lorawan_phy_version",
+	"end_device.lorawan_version",
+	"end_device.mac_settings",
+	"end_device.mac_settings.adr",
+	"end_device.mac_settings.adr.mode",
+	"end_device.mac_settings.adr.mode.disabled",
+	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
+	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
+	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
+	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.lora_narrow",
+	"end_device.mac_settings.adr.mode.dynamic.margin",
+	"end_device.mac_settings.adr.mode.dynamic.max_data_rate_index",
+	"end_device.mac_settings.adr.mode.dynamic.max_data_rate_index.value",
+	"end_device.mac_settings.adr.mode.dynamic.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.max_tx_power_index",
+	"end_device.mac_settings.adr.mode.dynamic.min_data_rate_index",
+	"end_device.mac_settings.adr.mode.dynamic.min_data_rate_index.value",
+	"end_device.mac_settings.adr.mode.dynamic.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.min_tx_power_index",
+	"end_device.mac_settings.adr.mode.dynamic.overrides",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_0",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_0.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_0.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_1",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_1.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_1.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_10",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_10.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_10.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_11",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_11.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_11.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_12",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_12.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_12.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_13",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_13.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_13.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_14",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_14.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_14.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_15",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_15.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_15.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_2",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_2.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_2.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_3",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_3.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_3.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_4",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_4.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_4.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_5",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_5.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_5.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_6",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_6.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_6.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_7",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_7.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_7.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_8",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_8.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_8.min_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_9",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_9.max_nb_trans",
+	"end_device.mac_settings.adr.mode.dynamic.overrides.data_rate_9.min_nb_trans",
+	"end_device.mac_settings.adr.mode.static",
+	"end_device.mac_settings.adr.mode.static.data_rate_index",
+	"end_device.mac_settings.adr.mode.static.nb_trans",
+	"end_device.mac_settings.adr.mode.static.tx_power_index",
+	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.beacon_frequency",
+	"end_device.mac_settings.beacon_frequency.value",
+	"end_device.mac_settings.class_b_c_downlink_interval",
+	"end_device.mac_settings.class_b_timeout",
+	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.desired_adr_ack_delay_exponent",
+	"end_device.mac_settings.desired_adr_ack_delay_exponent.value",
+	"end_device.mac_settings.desired_adr_ack_limit_exponent",
+	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
+	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_beacon_frequency.value",
+	"end_device.mac_settings.desired_max_duty_cycle",
+	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_max_eirp",
+	"end_device.mac_settings.desired_max_eirp.value",
+	"end_device.mac_settings.desired_ping_slot_data_rate_index",
+	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
+	"end_device.mac_settings.desired_ping_slot_frequency",
+	"end_device.mac_settings.desired_ping_slot_frequency.value",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.mode",
+	"end_device.mac_settings.desired_relay.mode.served",
+	"end_device.mac_settings.desired_relay.mode.served.backoff",
+	"end_device.mac_settings.desired_relay.mode.served.mode",
+	"end_device.mac_settings.desired_relay.mode.served.mode.always",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.mode.serving",
+	"end_device.mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.mode.serving.limits",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.join_requests",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.join_requests.bucket_size",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.join_requests.reload_rate",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.notifications",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.notifications.bucket_size",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.notifications.reload_rate",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.overall",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.overall.bucket_size",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.overall.reload_rate",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.reset_behavior",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.uplink_messages",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.uplink_messages.bucket_size",
+	"end_device.mac_settings.desired_relay.mode.serving.limits.uplink_messages.reload_rate",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
+	"end_device.mac_settings.desired_rx1_data_rate_offset",
+	"end_device.mac_settings.desired_rx1_data_rate_offset.value",
+	"end_device.mac_settings.desired_rx1_delay",
+	"end_device.mac_settings.desired_rx1_delay.value",
+	"end_device.mac_settings.desired_rx2_data_rate_index",
+	"end_device.mac_settings.desired_rx2_data_rate_index.value",
+	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.desired_rx2_frequency.value",
+	"end_device.mac_settings.downlink_dwell_time",
+	"end_device.mac_settings.downlink_dwell_time.value",
+	"end_device.mac_settings.factory_preset_frequencies",
+	"end_device.mac_settings.max_duty_cycle",
+	"end_device.mac_settings.max_duty_cycle.value",
+	"end_device.mac_settings.ping_slot_data_rate_index",
+	"end_device.mac_settings.ping_slot_data_rate_index.value",
+	"end_device.mac_settings.ping_slot_frequency",
+	"end_device.mac_settings.ping_slot_frequency.value",
+	"end_device.mac_settings.ping_slot_periodicity",
+	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.relay",
+	"end_device.mac_settings.relay.mode",
+	"end_device.mac_settings.relay.mode.served",
+	"end_device.mac_settings.relay.mode.served.backoff",
+	"end_device.mac_settings.relay.mode.served.mode",
+	"end_device.mac_settings.relay.mode.served.mode.always",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.relay.mode.served.second_channel",
+	"end_device.mac_settings.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.served.serving_device_id",
+	"end_device.mac_settings.relay.mode.serving",
+	"end_device.mac_settings.relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.relay.mode.serving.limits",
+	"end_device.mac_settings.relay.mode.serving.limits.join_requests",
+	"end_device.mac_settings.relay.mode.serving.limits.join_requests.bucket_size",
+	"end_device.mac_settings.relay.mode.serving.limits.join_requests.reload_rate",
+	"end_device.mac_settings.relay.mode.serving.limits.notifications",
+	"end_device.mac_settings.relay.mode.serving.limits.notifications.bucket_size",
+	"end_device.mac_settings.relay.mode.serving.limits.notifications.reload_rate",
+	"end_device.mac_settings.relay.mode.serving.limits.overall",
+	"end_device.mac_settings.relay.mode.serving.limits.overall.bucket_size",
+	"end_device.mac_settings.relay.mode.serving.limits.overall.reload_rate",
+	"end_device.mac_settings.relay.mode.serving.limits.reset_behavior",
+	"end_device.mac_settings.relay.mode.serving.limits.uplink_messages",
+	"end_device.mac_settings.relay.mode.serving.limits.uplink_messages.bucket_size",
+	"end_device.mac_settings.relay.mode.serving.limits.uplink_messages.reload_rate",
+	"end_device.mac_settings.relay.mode.serving.second_channel",
+	"end_device.mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.serving.uplink_forwarding_rules",
+	"end_device.mac_settings.resets_f_cnt",
+	"end_device.mac_settings.resets_f_cnt.value",
+	"end_device.mac_settings.rx1_data_rate_offset",
+	"end_device.mac_settings.rx1_data_rate_offset.value",
+	"end_device.mac_settings.rx1_delay",
+	"end_device.mac_settings.rx1_delay.value",
+	"end_device.mac_settings.rx2_data_rate_index",
+	"end_device.mac_settings.rx2_data_rate_index.value",
+	"end_device.mac_settings.rx2_frequency",
+	"end_device.mac_settings.rx2_frequency.value",
+	"end_device.mac_settings.schedule_downlinks",
+	"end_device.mac_settings.schedule_downlinks.value",
+	"end_device.mac_settings.status_count_periodicity",
+	"end_device.mac_settings.status_time_periodicity",
+	"end_device.mac_settings.supports_32_bit_f_cnt",
+	"end_device.mac_settings.supports_32_bit_f_cnt.value",
+	"end_device.mac_settings.uplink_dwell_time",
+	"end_device.mac_settings.uplink_dwell_time.value",
+	"end_device.mac_settings.use_adr",
+	"end_device.mac_settings.use_adr.value",
+	"end_device.mac_state",
+	"end_device.mac_state.current_parameters",
+	"end_device.mac_state.current_parameters.adr_ack_delay",
+	"end_device.mac_state.current_parameters.adr_ack_delay_exponent",
+	"end_device.mac_state.current_parameters.adr_ack_delay_exponent.value",
+	"end_device.mac_state.current_parameters.adr_ack_limit",
+	"end_device.mac_state.current_parameters.adr_ack_limit_exponent",
+	"end_device.mac_state.current_parameters.adr_ack_limit_exponent.value",
+	"end_device.mac_state.current_parameters.adr_data_rate_index",
+	"end_device.mac_state.current_parameters.adr_nb_trans",
+	"end_device.mac_state.current_parameters.adr_tx_power_index",
+	"end_device.mac_state.current_parameters.beacon_frequency",
+	"end_device.mac_state.current_parameters.channels",
+	"end_device.mac_state.current_parameters.downlink_dwell_time",
+	"end_device.mac_state.current_parameters.downlink_dwell_time.value",
+	"end_device.mac_state.current_parameters.max_duty_cycle",
+	"end_device.mac_state.current_parameters.max_eirp",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index_value",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.mac_state.current_parameters.ping_slot_frequency",
+	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
+	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served",
+	"end_device.mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.mode.serving",
+	"end_device.mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.join_requests",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.join_requests.bucket_size",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.join_requests.reload_rate",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.notifications",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.notifications.bucket_size",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.notifications.reload_rate",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.overall",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.overall.bucket_size",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.overall.reload_rate",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.reset_behavior",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.uplink_messages",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.uplink_messages.bucket_size",
+	"end_device.mac_state.current_parameters.relay.mode.serving.limits.uplink_messages.reload_rate",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
+	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
+	"end_device.mac_state.current_parameters.rx1_delay",
+	"end_device.mac_state.current_parameters.rx2_data_rate_index",
+	"end_device.mac_state.current_parameters.rx2_frequency",
+	"end_device.mac_state.current_parameters.uplink_dwell_time",
+	"end_device.mac_state.current_parameters.uplink_dwell_time.value",
+	"end_device.mac_state.desired_parameters",
+	"end_device.mac_state.desired_parameters.adr_ack_delay",
+	"end_device.mac_state.desired_parameters.adr_ack_delay_exponent",
+	"end_device.mac_state.desired_parameters.adr_ack_delay_exponent.value",
+	"end_device.mac_state.desired_parameters.adr_ack_limit",
+	"end_device.mac_state.desired_parameters.adr_ack_limit_exponent",
+	"end_device.mac_state.desired_parameters.adr_ack_limit_exponent.value",
+	"end_device.mac_state.desired_parameters.adr_data_rate_index",
+	"end_device.mac_state.desired_parameters.adr_nb_trans",
+	"end_device.mac_state.desired_parameters.adr_tx_power_index",
+	"end_device.mac_state.desired_parameters.beacon_frequency",
+	"end_device.mac_state.desired_parameters.channels",
+	"end_device.mac_state.desired_parameters.downlink_dwell_time",
+	"end_device.mac_state.desired_parameters.downlink_dwell_time.value",
+	"end_device.mac_state.desired_parameters.max_duty_cycle",
+	"end_device.mac_state.desired_parameters.max_eirp",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index_value",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.mac_state.desired_parameters.ping_slot_frequency",
+	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
+	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served",
+	"end_device.mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.mode.serving",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.join_requests",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.join_requests.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.join_requests.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.notifications",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.notifications.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.notifications.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.overall",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.overall.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.overall.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.reset_behavior",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.uplink_messages",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.uplink_messages.bucket_size",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.limits.uplink_messages.reload_rate",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
+	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
+	"end_device.mac_state.desired_parameters.rx1_delay",
+	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
+	"end_device.mac_state.desired_parameters.rx2_frequency",
+	"end_device.mac_state.desired_parameters.uplink_dwell_time",
+	"end_device.mac_state.desired_parameters.uplink_dwell_time.value",
+	"end_device.mac_state.device_class",
+	"end_device.mac_state.last_adr_change_f_cnt_up",
+	"end_device.mac_state.last_confirmed_downlink_at",
+	"end_device.mac_state.last_dev_status_f_cnt_up",
+	"end_device.mac_state.last_downlink_at",
+	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.min_rj_count_0",
+	"end_device.mac_state.pending_application_downlink",
+	"end_device.mac_state.pending_application_downlink.class_b_c",
+	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
+	"end_device.mac_state.pending_application_downlink.class_b_c.gateways",
+	"end_device.mac_state.pending_application_downlink.confirmed",
+	"end_device.mac_state.pending_application_downlink.confirmed_retry",
+	"end_device.mac_state.pending_application_downlink.confirmed_retry.attempt",
+	"end_device.mac_state.pending_application_downlink.confirmed_retry.max_attempts",
+	"end_device.mac_state.pending_application_downlink.correlation_ids",
+	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.f_cnt",
+	"end_device.mac_state.pending_application_downlink.f_port",
+	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_join_request",
+	"end_device.mac_state.pending_join_request.cf_list",
+	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
+	"end_device.mac_state.pending_join_request.cf_list.freq",
+	"end_device.mac_state.pending_join_request.cf_list.type",
+	"end_device.mac_state.pending_join_request.downlink_settings",
+	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
+	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
+	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_operator_requests",
+	"end_device.mac_state.pending_relay_downlink",
+	"end_device.mac_state.pending_relay_downlink.raw_payload",
+	"end_device.mac_state.pending_requests",
+	"end_device.mac_state.ping_slot_periodicity",
+	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
+	"end_device.mac_state.queued_join_accept",
+	"end_device.mac_state.queued_join_accept.correlation_ids",
+	"end_device.mac_state.queued_join_accept.dev_addr",
+	"end_device.mac_state.queued_join_accept.keys",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
+	"end_device.mac_state.queued_join_accept.keys.session_key_id",
+	"end_device.mac_state.queued_join_accept.net_id",
+	"end_device.mac_state.queued_join_accept.payload",
+	"end_device.mac_state.queued_join_accept.request",
+	"end_device.mac_state.queued_join_accept.request.cf_list",
+	"end_device.mac_state.queued_join_accept.request.cf_list.ch_masks",
+	"end_device.mac_state.queued_join_accept.request.cf_list.freq",
+	"end_device.mac_state.queued_join_accept.request.cf_list.type",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_operator_commands",
+	"end_device.mac_state.queued_responses",
+	"end_device.mac_state.recent_downlinks",
+	"end_device.mac_state.recent_mac_command_identifiers",
+	"end_device.mac_state.recent_uplinks",
+	"end_device.mac_state.rejected_adr_data_rate_indexes",
+	"end_device.mac_state.rejected_adr_tx_power_indexes",
+	"end_device.mac_state.rejected_data_rate_ranges",
+	"end_device.mac_state.rejected_frequencies",
+	"end_device.mac_state.rx_windows_available",
+	"end_device.max_frequency",
+	"end_device.min_frequency",
+	"end_device.multicast",
+	"end_device.name",
+	"end_device.net_id",
+	"end_device.network_server_address",
+	"end_device.network_server_kek_label",
+	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.current_parameters",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay_exponent",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay_exponent.value",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit_exponent",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit_exponent.value",
+	"end_device.pending_mac_state.current_parameters.adr_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.adr_nb_trans",
+	"end_device.pending_mac_state.current_parameters.adr_tx_power_index",
+	"end_device.pending_mac_state.current_parameters.beacon_frequency",
+	"end_device.pending_mac_state.current_parameters.channels",
+	"end_device.pending_mac_state.current_parameters.downlink_dwell_time",
+	"end_device.pending_mac_state.current_parameters.downlink_dwell_time.value",
+	"end_device.pending_mac_state.current_parameters.max_duty_cycle",
+	"end_device.pending_mac_state.current_parameters.max_eirp",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index_value",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
+	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
+	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.join_requests",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.join_requests.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.join_requests.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.notifications",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.notifications.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.notifications.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.overall",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.overall.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.overall.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.reset_behavior",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.uplink_messages",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.uplink_messages.bucket_size",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.limits.uplink_messages.reload_rate",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
+	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
+	"end_device.pending_mac_state.current_parameters.rx1_delay",
+	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.rx2_frequency",
+	"end_device.pending_mac_state.current_parameters.uplink_dwell_time",
+	"end_device.pending_mac_state.current_parameters.uplink_dwell_time.value",
+	"end_device.pending_mac_state.desired_parameters",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay_exponent",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay_exponent.value",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit_exponent",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit_exponent.value",
+	"end_device.pending_mac_state.desired_parameters.adr_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.adr_nb_trans",
+	"end_device.pending_mac_state.desired_parameters.adr_tx_power_index",
+	"end_device.pending_mac_state.desired_parameters.beacon_frequency",
+	"end_device.pending_mac_state.desired_parameters.channels",
+	"end_device.pending_mac_state.desired_parameters.downlink_dwell_time",
+	"end_device.pending_mac_state.desired_parameters.downlink_dwell_time.value",
+	"end_device.pending_mac_state.desired_parameters.max_duty_cycle",
+	"end_device.pending_mac_state.desired_parameters.max_eirp",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index_value",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
+	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
+	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.join_requests",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.join_requests.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.join_requests.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.notifications",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.notifications.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.notifications.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.overall",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.overall.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.overall.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.reset_behavior",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.uplink_messages",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.uplink_messages.bucket_size",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.limits.uplink_messages.reload_rate",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
+	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
+	"end_device.pending_mac_state.desired_parameters.rx1_delay",
+	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.rx2_frequency",
+	"end_device.pending_mac_state.desired_parameters.uplink_dwell_time",
+	"end_device.pending_mac_state.desired_parameters.uplink_dwell_time.value",
+	"end_device.pending_mac_state.device_class",
+	"end_device.pending_mac_state.last_adr_change_f_cnt_up",
+	"end_device.pending_mac_state.last_confirmed_downlink_at",
+	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
+	"end_device.pending_mac_state.last_downlink_at",
+	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.min_rj_count_0",
+	"end_device.pending_mac_state.pending_application_downlink",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c.gateways",
+	"end_device.pending_mac_state.pending_application_downlink.confirmed",
+	"end_device.pending_mac_state.pending_application_downlink.confirmed_retry",
+	"end_device.pending_mac_state.pending_application_downlink.confirmed_retry.attempt",
+	"end_device.pending_mac_state.pending_application_downlink.confirmed_retry.max_attempts",
+	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
+	"end_device.pending_mac_state.pending_application_downlink.f_port",
+	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_join_request",
+	"end_device.pending_mac_state.pending_join_request.cf_list",
+	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
+	"end_device.pending_mac_state.pending_join_request.cf_list.freq",
+	"end_device.pending_mac_state.pending_join_request.cf_list.type",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_operator_requests",
+	"end_device.pending_mac_state.pending_relay_downlink",
+	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
+	"end_device.pending_mac_state.pending_requests",
+	"end_device.pending_mac_state.ping_slot_periodicity",
+	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
+	"end_device.pending_mac_state.queued_join_accept",
+	"end_device.pending_mac_state.queued_join_accept.correlation_ids",
+	"end_device.pending_mac_state.queued_join_accept.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.keys",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.session_key_id",
+	"end_device.pending_mac_state.queued_join_accept.net_id",
+	"end_device.pending_mac_state.queued_join_accept.payload",
+	"end_device.pending_mac_state.queued_join_accept.request",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.ch_masks",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.freq",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.type",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_operator_commands",
+	"end_device.pending_mac_state.queued_responses",
+	"end_device.pending_mac_state.recent_downlinks",
+	"end_device.pending_mac_state.recent_mac_command_identifiers",
+	"end_device.pending_mac_state.recent_uplinks",
+	"end_device.pending_mac_state.rejected_adr_data_rate_indexes",
+	"end_device.pending_mac_state.rejected_adr_tx_power_indexes",
+	"end_device.pending_mac_state.rejected_data_rate_ranges",
+	"end_device.pending_mac_state.rejected_frequencies",
+	"end_device.pending_mac_state.rx_windows_available",
+	"end_device.pending_session",
+	"end_device.pending_session.dev_addr",
+	"end_device.pending_session.keys",
+	"end_device.pending_session.keys.app_s_key",
+	"end_device.pending_session.keys.app_s_key.encrypted_key",
+	"end_device.pending_session.keys.app_s_key.kek_label",
+	"end_device.pending_session.keys.app_s_key.key",
+	"end_device.pending_session.keys.f_nwk_s_int_key",
+	"end_device.pending_session.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.pending_session.keys.f_nwk_s_int_key.kek_label",
+	"end_device.pending_session.keys.f_nwk_s_int_key.key",
+	"end_device.pending_session.keys.nwk_s_enc_key",
+	"end_device.pending_session.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.pending_session.keys.nwk_s_enc_key.kek_label",
+	"end_device.pending_session.keys.nwk_s_enc_key.key",
+	"end_device.pending_session.keys.s_nwk_s_int_key",
+	"end_device.pending_session.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.pending_session.keys.s_nwk_s_int_key.kek_label",
+	"end_device.pending_session.keys.s_nwk_s_int_key.key",
+	"end_device.pending_session.keys.session_key_id",
+	"end_device.pending_session.last_a_f_cnt_down",
+	"end_device.pending_session.last_conf_f_cnt_down",
+	"end_device.pending_session.last_f_cnt_up",
+	"end_device.pending_session.last_n_f_cnt_down",
+	"end_device.pending_session.queued_application_downlinks",
+	"end_device.pending_session.started_at",
+	"end_device.picture",
+	"end_device.picture.embedded",
+	"end_device.picture.embedded.data",
+	"end_device.picture.embedded.mime_type",
+	"end_device.picture.sizes",
+	"end_device.power_state",
+	"end_device.provisioner_id",
+	"end_device.provisioning_data",
+	"end_device.queued_application_downlinks",
+	"end_device.resets_join_nonces",
+	"end_device.root_keys",
+	"end_device.root_keys.app_key",
+	"end_device.root_keys.app_key.encrypted_key",
+	"end_device.root_keys.app_key.kek_label",
+	"end_device.root_keys.app_key.key",
+	"end_device.root_keys.nwk_key",
+	"end_device.root_keys.nwk_key.encrypted_key",
+	"end_device.root_keys.nwk_key.kek_label",
+	"end_device.root_keys.nwk_key.key",
+	"end_device.root_keys.root_key_id",
+	"end_device.serial_number",
+	"end_device.service_profile_id",
+	"end_device.session",
+	"end_device.session.dev_addr",
+	"end_device.session.keys",
+	"end_device.session.keys.app_s_key",
+	"end_device.session.keys.app_s_key.encrypted_key",
+	"end_device.session.keys.app_s_key.kek_label",
+	"end_device.session.keys.app_s_key.key",
+	"end_device.session.keys.f_nwk_s_int_key",
+	"end_device.session.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.session.keys.f_nwk_s_int_key.kek_label",
+	"end_device.session.keys.f_nwk_s_int_key.key",
+	"end_device.session.keys.nwk_s_enc_key",
+	"end_device.session.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.session.keys.nwk_s_enc_key.kek_label",
+	"end_device.session.keys.nwk_s_enc_key.key",
+	"end_device.session.keys.s_nwk_s_int_key",
+	"end_device.session.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.session.keys.s_nwk_s_int_key.kek_label",
+	"end_device.session.keys.s_nwk_s_int_key.key",
+	"end_device.session.keys.session_key_id",
+	"end_device.session.last_a_f_cnt_down",
+	"end_device.session.last_conf_f_cnt_down",
+	"end_device.session.last_f_cnt_up",
+	"end_device.session.last_n_f_cnt_down",
+	"end_device.session.queued_application_downlinks",
+	"end_device.session.started_at",
+	"end_device.skip_payload_crypto",
+	"end_device.skip_payload_crypto_override",
+	"end_device.supports_class_b",
+	"end_device.supports_class_c",
+	"end_device.supports_join",
+	"end_device.updated_at",
+	"end_device.used_dev_nonces",
+	"end_device.version_ids",
+	"end_device.version_ids.band_id",
+	"end_device.version_ids.brand_id",
+	"end_device.version_ids.firmware_version",
+	"end_device.version_ids.hardware_version",
+	"end_device.version_ids.model_id",
+	"group",
+	"group.created_at",
+	"group.gateways",
+	"group.ids",
+	"group.ids.application_ids",
+	"group.ids.application_ids.application_id",
+	"group.ids.dev_addr",
+	"group.ids.dev_eui",
+	"group.ids.device_id",
+	"group.ids.join_eui",
+	"group.member_device_ids",
+	"group.updated_at",
+}
+
+var CreateMulticastGroupRequestFieldPathsTopLevel = []string{
+	"end_device",
+	"group",
+}
+var GetMulticastGroupRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+}
+
+var GetMulticastGroupRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+}
+var ListMulticastGroupsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+}
+
+var ListMulticastGroupsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+}
+var UpdateMulticastGroupMembersRequestFieldPathsNested = []string{
+	"add_device_ids",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"remove_device_ids",
+}
+
+var UpdateMulticastGroupMembersRequestFieldPathsTopLevel = []string{
+	"add_device_ids",
+	"end_device_ids",
+	"remove_device_ids",
+}
+var SetMulticastGroupGatewaysRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"gateways",
+}
+
+var SetMulticastGroupGatewaysRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"gateways",
+}
+var ComputeMulticastGroupGatewaysRequestFieldPathsNested = []string{
+	"apply",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+}
+
+var ComputeMulticastGroupGatewaysRequestFieldPathsTopLevel = []string{
+	"apply",
+	"end_device_ids",
+}
+var ComputeMulticastGroupGatewaysResponseFieldPathsNested = []string{
+	"gateways",
+	"uncovered_device_ids",
+}
+
+var ComputeMulticastGroupGatewaysResponseFieldPathsTopLevel = []string{
+	"gateways",
+	"uncovered_device_ids",
+}
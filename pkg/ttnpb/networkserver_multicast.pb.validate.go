@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on MulticastGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MulticastGroup) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MulticastGroupFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if m.GetIds() == nil {
+				return MulticastGroupValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MulticastGroupValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MulticastGroupValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MulticastGroupValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "member_device_ids":
+
+			if len(m.GetMemberDeviceIds()) > 1000 {
+				return MulticastGroupValidationError{
+					field:  "member_device_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			_MulticastGroup_MemberDeviceIds_Unique := make(map[string]struct{}, len(m.GetMemberDeviceIds()))
+
+			for idx, item := range m.GetMemberDeviceIds() {
+				_, _ = idx, item
+
+				if _, exists := _MulticastGroup_MemberDeviceIds_Unique[item]; exists {
+					return MulticastGroupValidationError{
+						field:  fmt.Sprintf("member_device_ids[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_MulticastGroup_MemberDeviceIds_Unique[item] = struct{}{}
+				}
+
+				if utf8.RuneCountInString(item) > 36 {
+					return MulticastGroupValidationError{
+						field:  fmt.Sprintf("member_device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_MulticastGroup_MemberDeviceIds_Pattern.MatchString(item) {
+					return MulticastGroupValidationError{
+						field:  fmt.Sprintf("member_device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "gateways":
+
+			if len(m.GetGateways()) > 100 {
+				return MulticastGroupValidationError{
+					field:  "gateways",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetGateways() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MulticastGroupValidationError{
+							field:  fmt.Sprintf("gateways[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return MulticastGroupValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MulticastGroupValidationError is the validation error returned by
+// MulticastGroup.ValidateFields if the designated constraints aren't met.
+type MulticastGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MulticastGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MulticastGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MulticastGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MulticastGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MulticastGroupValidationError) ErrorName() string { return "MulticastGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MulticastGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMulticastGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MulticastGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MulticastGroupValidationError{}
+
+var _MulticastGroup_MemberDeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on MulticastGroups with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MulticastGroups) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MulticastGroupsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "groups":
+
+			for idx, item := range m.GetGroups() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MulticastGroupsValidationError{
+							field:  fmt.Sprintf("groups[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return MulticastGroupsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MulticastGroupsValidationError is the validation error returned by
+// MulticastGroups.ValidateFields if the designated constraints aren't met.
+type MulticastGroupsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MulticastGroupsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MulticastGroupsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MulticastGroupsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MulticastGroupsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MulticastGroupsValidationError) ErrorName() string { return "MulticastGroupsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MulticastGroupsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMulticastGroups.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MulticastGroupsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MulticastGroupsValidationError{}
+
+// ValidateFields checks the field values on CreateMulticastGroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateMulticastGroupRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateMulticastGroupRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "group":
+
+			if m.GetGroup() == nil {
+				return CreateMulticastGroupRequestValidationError{
+					field:  "group",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGroup()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateMulticastGroupRequestValidationError{
+						field:  "group",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device":
+
+			if v, ok := interface{}(m.GetEndDevice()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateMulticastGroupRequestValidationError{
+						field:  "end_device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return CreateMulticastGroupRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateMulticastGroupRequestValidationError is the validation error returned
+// by CreateMulticastGroupRequest.ValidateFields if the designated constraints
+// aren't met.
+type CreateMulticastGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMulticastGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMulticastGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMulticastGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMulticastGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMulticastGroupRequestValidationError) ErrorName() string {
+	return "CreateMulticastGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMulticastGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMulticastGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMulticastGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMulticastGroupRequestValidationError{}
+
+// ValidateFields checks the field values on GetMulticastGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMulticastGroupRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetMulticastGroupRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return GetMulticastGroupRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetMulticastGroupRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetMulticastGroupRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetMulticastGroupRequestValidationError is the validation error returned by
+// GetMulticastGroupRequest.ValidateFields if the designated constraints
+// aren't met.
+type GetMulticastGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMulticastGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMulticastGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMulticastGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMulticastGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMulticastGroupRequestValidationError) ErrorName() string {
+	return "GetMulticastGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMulticastGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMulticastGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMulticastGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMulticastGroupRequestValidationError{}
+
+// ValidateFields checks the field values on ListMulticastGroupsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListMulticastGroupsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListMulticastGroupsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ListMulticastGroupsRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListMulticastGroupsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListMulticastGroupsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListMulticastGroupsRequestValidationError is the validation error returned
+// by ListMulticastGroupsRequest.ValidateFields if the designated constraints
+// aren't met.
+type ListMulticastGroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMulticastGroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMulticastGroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMulticastGroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMulticastGroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMulticastGroupsRequestValidationError) ErrorName() string {
+	return "ListMulticastGroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMulticastGroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMulticastGroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMulticastGroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMulticastGroupsRequestValidationError{}
+
+// ValidateFields checks the field values on UpdateMulticastGroupMembersRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *UpdateMulticastGroupMembersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UpdateMulticastGroupMembersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return UpdateMulticastGroupMembersRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "add_device_ids":
+
+			if len(m.GetAddDeviceIds()) > 1000 {
+				return UpdateMulticastGroupMembersRequestValidationError{
+					field:  "add_device_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			_UpdateMulticastGroupMembersRequest_AddDeviceIds_Unique := make(map[string]struct{}, len(m.GetAddDeviceIds()))
+
+			for idx, item := range m.GetAddDeviceIds() {
+				_, _ = idx, item
+
+				if _, exists := _UpdateMulticastGroupMembersRequest_AddDeviceIds_Unique[item]; exists {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  fmt.Sprintf("add_device_ids[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_UpdateMulticastGroupMembersRequest_AddDeviceIds_Unique[item] = struct{}{}
+				}
+
+				if utf8.RuneCountInString(item) > 36 {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  fmt.Sprintf("add_device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_UpdateMulticastGroupMembersRequest_AddDeviceIds_Pattern.MatchString(item) {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  fmt.Sprintf("add_device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "remove_device_ids":
+
+			if len(m.GetRemoveDeviceIds()) > 1000 {
+				return UpdateMulticastGroupMembersRequestValidationError{
+					field:  "remove_device_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			_UpdateMulticastGroupMembersRequest_RemoveDeviceIds_Unique := make(map[string]struct{}, len(m.GetRemoveDeviceIds()))
+
+			for idx, item := range m.GetRemoveDeviceIds() {
+				_, _ = idx, item
+
+				if _, exists := _UpdateMulticastGroupMembersRequest_RemoveDeviceIds_Unique[item]; exists {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  fmt.Sprintf("remove_device_ids[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_UpdateMulticastGroupMembersRequest_RemoveDeviceIds_Unique[item] = struct{}{}
+				}
+
+				if utf8.RuneCountInString(item) > 36 {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  fmt.Sprintf("remove_device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_UpdateMulticastGroupMembersRequest_RemoveDeviceIds_Pattern.MatchString(item) {
+					return UpdateMulticastGroupMembersRequestValidationError{
+						field:  fmt.Sprintf("remove_device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		default:
+			return UpdateMulticastGroupMembersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateMulticastGroupMembersRequestValidationError is the validation error
+// returned by UpdateMulticastGroupMembersRequest.ValidateFields if the
+// designated constraints aren't met.
+type UpdateMulticastGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMulticastGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMulticastGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMulticastGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMulticastGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMulticastGroupMembersRequestValidationError) ErrorName() string {
+	return "UpdateMulticastGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMulticastGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMulticastGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMulticastGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMulticastGroupMembersRequestValidationError{}
+
+var _UpdateMulticastGroupMembersRequest_AddDeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _UpdateMulticastGroupMembersRequest_RemoveDeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on SetMulticastGroupGatewaysRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SetMulticastGroupGatewaysRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetMulticastGroupGatewaysRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return SetMulticastGroupGatewaysRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetMulticastGroupGatewaysRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateways":
+
+			if len(m.GetGateways()) > 100 {
+				return SetMulticastGroupGatewaysRequestValidationError{
+					field:  "gateways",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetGateways() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SetMulticastGroupGatewaysRequestValidationError{
+							field:  fmt.Sprintf("gateways[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return SetMulticastGroupGatewaysRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetMulticastGroupGatewaysRequestValidationError is the validation error
+// returned by SetMulticastGroupGatewaysRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetMulticastGroupGatewaysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMulticastGroupGatewaysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMulticastGroupGatewaysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMulticastGroupGatewaysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMulticastGroupGatewaysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMulticastGroupGatewaysRequestValidationError) ErrorName() string {
+	return "SetMulticastGroupGatewaysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMulticastGroupGatewaysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMulticastGroupGatewaysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMulticastGroupGatewaysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMulticastGroupGatewaysRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ComputeMulticastGroupGatewaysRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ComputeMulticastGroupGatewaysRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ComputeMulticastGroupGatewaysRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return ComputeMulticastGroupGatewaysRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ComputeMulticastGroupGatewaysRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "apply":
+			// no validation rules for Apply
+		default:
+			return ComputeMulticastGroupGatewaysRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ComputeMulticastGroupGatewaysRequestValidationError is the validation error
+// returned by ComputeMulticastGroupGatewaysRequest.ValidateFields if the
+// designated constraints aren't met.
+type ComputeMulticastGroupGatewaysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComputeMulticastGroupGatewaysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComputeMulticastGroupGatewaysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComputeMulticastGroupGatewaysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComputeMulticastGroupGatewaysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComputeMulticastGroupGatewaysRequestValidationError) ErrorName() string {
+	return "ComputeMulticastGroupGatewaysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComputeMulticastGroupGatewaysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComputeMulticastGroupGatewaysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComputeMulticastGroupGatewaysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComputeMulticastGroupGatewaysRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ComputeMulticastGroupGatewaysResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ComputeMulticastGroupGatewaysResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ComputeMulticastGroupGatewaysResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateways":
+
+			for idx, item := range m.GetGateways() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ComputeMulticastGroupGatewaysResponseValidationError{
+							field:  fmt.Sprintf("gateways[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "uncovered_device_ids":
+
+		default:
+			return ComputeMulticastGroupGatewaysResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ComputeMulticastGroupGatewaysResponseValidationError is the validation error
+// returned by ComputeMulticastGroupGatewaysResponse.ValidateFields if the
+// designated constraints aren't met.
+type ComputeMulticastGroupGatewaysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComputeMulticastGroupGatewaysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComputeMulticastGroupGatewaysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComputeMulticastGroupGatewaysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComputeMulticastGroupGatewaysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComputeMulticastGroupGatewaysResponseValidationError) ErrorName() string {
+	return "ComputeMulticastGroupGatewaysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComputeMulticastGroupGatewaysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComputeMulticastGroupGatewaysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComputeMulticastGroupGatewaysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComputeMulticastGroupGatewaysResponseValidationError{}
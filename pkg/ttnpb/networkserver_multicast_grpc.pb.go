@@ -0,0 +1,361 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: ttn/lorawan/v3/networkserver_multicast.proto
+
+package ttnpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NsMulticastGroupRegistry_CreateMulticastGroup_FullMethodName          = "/ttn.lorawan.v3.NsMulticastGroupRegistry/CreateMulticastGroup"
+	NsMulticastGroupRegistry_GetMulticastGroup_FullMethodName             = "/ttn.lorawan.v3.NsMulticastGroupRegistry/GetMulticastGroup"
+	NsMulticastGroupRegistry_ListMulticastGroups_FullMethodName           = "/ttn.lorawan.v3.NsMulticastGroupRegistry/ListMulticastGroups"
+	NsMulticastGroupRegistry_UpdateMulticastGroupMembers_FullMethodName   = "/ttn.lorawan.v3.NsMulticastGroupRegistry/UpdateMulticastGroupMembers"
+	NsMulticastGroupRegistry_SetMulticastGroupGateways_FullMethodName     = "/ttn.lorawan.v3.NsMulticastGroupRegistry/SetMulticastGroupGateways"
+	NsMulticastGroupRegistry_ComputeMulticastGroupGateways_FullMethodName = "/ttn.lorawan.v3.NsMulticastGroupRegistry/ComputeMulticastGroupGateways"
+	NsMulticastGroupRegistry_DeleteMulticastGroup_FullMethodName          = "/ttn.lorawan.v3.NsMulticastGroupRegistry/DeleteMulticastGroup"
+)
+
+// NsMulticastGroupRegistryClient is the client API for NsMulticastGroupRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NsMulticastGroupRegistryClient interface {
+	// Create a multicast group.
+	CreateMulticastGroup(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*MulticastGroup, error)
+	// Get a multicast group.
+	GetMulticastGroup(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*MulticastGroup, error)
+	// List the multicast groups of an application.
+	ListMulticastGroups(ctx context.Context, in *ListMulticastGroupsRequest, opts ...grpc.CallOption) (*MulticastGroups, error)
+	// Add and remove members of a multicast group.
+	UpdateMulticastGroupMembers(ctx context.Context, in *UpdateMulticastGroupMembersRequest, opts ...grpc.CallOption) (*MulticastGroup, error)
+	// Set the downlink gateways of a multicast group.
+	SetMulticastGroupGateways(ctx context.Context, in *SetMulticastGroupGatewaysRequest, opts ...grpc.CallOption) (*MulticastGroup, error)
+	// Compute a set of gateways that covers the members of a multicast group, based on their recent uplink messages.
+	ComputeMulticastGroupGateways(ctx context.Context, in *ComputeMulticastGroupGatewaysRequest, opts ...grpc.CallOption) (*ComputeMulticastGroupGatewaysResponse, error)
+	// Delete a multicast group. The multicast end device is not deleted.
+	DeleteMulticastGroup(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type nsMulticastGroupRegistryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNsMulticastGroupRegistryClient(cc grpc.ClientConnInterface) NsMulticastGroupRegistryClient {
+	return &nsMulticastGroupRegistryClient{cc}
+}
+
+func (c *nsMulticastGroupRegistryClient) CreateMulticastGroup(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*MulticastGroup, error) {
+	out := new(MulticastGroup)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_CreateMulticastGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsMulticastGroupRegistryClient) GetMulticastGroup(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*MulticastGroup, error) {
+	out := new(MulticastGroup)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_GetMulticastGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsMulticastGroupRegistryClient) ListMulticastGroups(ctx context.Context, in *ListMulticastGroupsRequest, opts ...grpc.CallOption) (*MulticastGroups, error) {
+	out := new(MulticastGroups)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_ListMulticastGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsMulticastGroupRegistryClient) UpdateMulticastGroupMembers(ctx context.Context, in *UpdateMulticastGroupMembersRequest, opts ...grpc.CallOption) (*MulticastGroup, error) {
+	out := new(MulticastGroup)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_UpdateMulticastGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsMulticastGroupRegistryClient) SetMulticastGroupGateways(ctx context.Context, in *SetMulticastGroupGatewaysRequest, opts ...grpc.CallOption) (*MulticastGroup, error) {
+	out := new(MulticastGroup)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_SetMulticastGroupGateways_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsMulticastGroupRegistryClient) ComputeMulticastGroupGateways(ctx context.Context, in *ComputeMulticastGroupGatewaysRequest, opts ...grpc.CallOption) (*ComputeMulticastGroupGatewaysResponse, error) {
+	out := new(ComputeMulticastGroupGatewaysResponse)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_ComputeMulticastGroupGateways_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsMulticastGroupRegistryClient) DeleteMulticastGroup(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NsMulticastGroupRegistry_DeleteMulticastGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsMulticastGroupRegistryServer is the server API for NsMulticastGroupRegistry service.
+// All implementations must embed UnimplementedNsMulticastGroupRegistryServer
+// for forward compatibility
+type NsMulticastGroupRegistryServer interface {
+	// Create a multicast group.
+	CreateMulticastGroup(context.Context, *CreateMulticastGroupRequest) (*MulticastGroup, error)
+	// Get a multicast group.
+	GetMulticastGroup(context.Context, *GetMulticastGroupRequest) (*MulticastGroup, error)
+	// List the multicast groups of an application.
+	ListMulticastGroups(context.Context, *ListMulticastGroupsRequest) (*MulticastGroups, error)
+	// Add and remove members of a multicast group.
+	UpdateMulticastGroupMembers(context.Context, *UpdateMulticastGroupMembersRequest) (*MulticastGroup, error)
+	// Set the downlink gateways of a multicast group.
+	SetMulticastGroupGateways(context.Context, *SetMulticastGroupGatewaysRequest) (*MulticastGroup, error)
+	// Compute a set of gateways that covers the members of a multicast group, based on their recent uplink messages.
+	ComputeMulticastGroupGateways(context.Context, *ComputeMulticastGroupGatewaysRequest) (*ComputeMulticastGroupGatewaysResponse, error)
+	// Delete a multicast group. The multicast end device is not deleted.
+	DeleteMulticastGroup(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error)
+	mustEmbedUnimplementedNsMulticastGroupRegistryServer()
+}
+
+// UnimplementedNsMulticastGroupRegistryServer must be embedded to have forward compatible implementations.
+type UnimplementedNsMulticastGroupRegistryServer struct {
+}
+
+func (UnimplementedNsMulticastGroupRegistryServer) CreateMulticastGroup(context.Context, *CreateMulticastGroupRequest) (*MulticastGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMulticastGroup not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) GetMulticastGroup(context.Context, *GetMulticastGroupRequest) (*MulticastGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMulticastGroup not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) ListMulticastGroups(context.Context, *ListMulticastGroupsRequest) (*MulticastGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMulticastGroups not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) UpdateMulticastGroupMembers(context.Context, *UpdateMulticastGroupMembersRequest) (*MulticastGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMulticastGroupMembers not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) SetMulticastGroupGateways(context.Context, *SetMulticastGroupGatewaysRequest) (*MulticastGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMulticastGroupGateways not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) ComputeMulticastGroupGateways(context.Context, *ComputeMulticastGroupGatewaysRequest) (*ComputeMulticastGroupGatewaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeMulticastGroupGateways not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) DeleteMulticastGroup(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMulticastGroup not implemented")
+}
+func (UnimplementedNsMulticastGroupRegistryServer) mustEmbedUnimplementedNsMulticastGroupRegistryServer() {
+}
+
+// UnsafeNsMulticastGroupRegistryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NsMulticastGroupRegistryServer will
+// result in compilation errors.
+type UnsafeNsMulticastGroupRegistryServer interface {
+	mustEmbedUnimplementedNsMulticastGroupRegistryServer()
+}
+
+func RegisterNsMulticastGroupRegistryServer(s grpc.ServiceRegistrar, srv NsMulticastGroupRegistryServer) {
+	s.RegisterService(&NsMulticastGroupRegistry_ServiceDesc, srv)
+}
+
+func _NsMulticastGroupRegistry_CreateMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).CreateMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_CreateMulticastGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).CreateMulticastGroup(ctx, req.(*CreateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsMulticastGroupRegistry_GetMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).GetMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_GetMulticastGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).GetMulticastGroup(ctx, req.(*GetMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsMulticastGroupRegistry_ListMulticastGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).ListMulticastGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_ListMulticastGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).ListMulticastGroups(ctx, req.(*ListMulticastGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsMulticastGroupRegistry_UpdateMulticastGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMulticastGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).UpdateMulticastGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_UpdateMulticastGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).UpdateMulticastGroupMembers(ctx, req.(*UpdateMulticastGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsMulticastGroupRegistry_SetMulticastGroupGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMulticastGroupGatewaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).SetMulticastGroupGateways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_SetMulticastGroupGateways_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).SetMulticastGroupGateways(ctx, req.(*SetMulticastGroupGatewaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsMulticastGroupRegistry_ComputeMulticastGroupGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeMulticastGroupGatewaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).ComputeMulticastGroupGateways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_ComputeMulticastGroupGateways_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).ComputeMulticastGroupGateways(ctx, req.(*ComputeMulticastGroupGatewaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsMulticastGroupRegistry_DeleteMulticastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsMulticastGroupRegistryServer).DeleteMulticastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsMulticastGroupRegistry_DeleteMulticastGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsMulticastGroupRegistryServer).DeleteMulticastGroup(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NsMulticastGroupRegistry_ServiceDesc is the grpc.ServiceDesc for NsMulticastGroupRegistry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NsMulticastGroupRegistry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsMulticastGroupRegistry",
+	HandlerType: (*NsMulticastGroupRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMulticastGroup",
+			Handler:    _NsMulticastGroupRegistry_CreateMulticastGroup_Handler,
+		},
+		{
+			MethodName: "GetMulticastGroup",
+			Handler:    _NsMulticastGroupRegistry_GetMulticastGroup_Handler,
+		},
+		{
+			MethodName: "ListMulticastGroups",
+			Handler:    _NsMulticastGroupRegistry_ListMulticastGroups_Handler,
+		},
+		{
+			MethodName: "UpdateMulticastGroupMembers",
+			Handler:    _NsMulticastGroupRegistry_UpdateMulticastGroupMembers_Handler,
+		},
+		{
+			MethodName: "SetMulticastGroupGateways",
+			Handler:    _NsMulticastGroupRegistry_SetMulticastGroupGateways_Handler,
+		},
+		{
+			MethodName: "ComputeMulticastGroupGateways",
+			Handler:    _NsMulticastGroupRegistry_ComputeMulticastGroupGateways_Handler,
+		},
+		{
+			MethodName: "DeleteMulticastGroup",
+			Handler:    _NsMulticastGroupRegistry_DeleteMulticastGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ttn/lorawan/v3/networkserver_multicast.proto",
+}
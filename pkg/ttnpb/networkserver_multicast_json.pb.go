@@ -0,0 +1,580 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/networkserver_multicast.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the MulticastGroup message to JSON.
+func (x *MulticastGroup) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Ids != nil || s.HasField("ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ids")
+		x.Ids.MarshalProtoJSON(s.WithField("ids"))
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.UpdatedAt != nil || s.HasField("updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		if x.UpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.UpdatedAt)
+		}
+	}
+	if len(x.MemberDeviceIds) > 0 || s.HasField("member_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("member_device_ids")
+		s.WriteStringArray(x.MemberDeviceIds)
+	}
+	if len(x.Gateways) > 0 || s.HasField("gateways") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateways")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Gateways {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateways"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MulticastGroup to JSON.
+func (x *MulticastGroup) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MulticastGroup message from JSON.
+func (x *MulticastGroup) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "ids":
+			if s.ReadNil() {
+				x.Ids = nil
+				return
+			}
+			x.Ids = &EndDeviceIdentifiers{}
+			x.Ids.UnmarshalProtoJSON(s.WithField("ids", true))
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			if s.ReadNil() {
+				x.UpdatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = v
+		case "member_device_ids", "memberDeviceIds":
+			s.AddField("member_device_ids")
+			if s.ReadNil() {
+				x.MemberDeviceIds = nil
+				return
+			}
+			x.MemberDeviceIds = s.ReadStringArray()
+		case "gateways":
+			s.AddField("gateways")
+			if s.ReadNil() {
+				x.Gateways = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Gateways = append(x.Gateways, nil)
+					return
+				}
+				v := &ClassBCGatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateways", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Gateways = append(x.Gateways, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MulticastGroup from JSON.
+func (x *MulticastGroup) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the MulticastGroups message to JSON.
+func (x *MulticastGroups) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Groups) > 0 || s.HasField("groups") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("groups")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Groups {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("groups"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MulticastGroups to JSON.
+func (x *MulticastGroups) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MulticastGroups message from JSON.
+func (x *MulticastGroups) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "groups":
+			s.AddField("groups")
+			if s.ReadNil() {
+				x.Groups = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Groups = append(x.Groups, nil)
+					return
+				}
+				v := &MulticastGroup{}
+				v.UnmarshalProtoJSON(s.WithField("groups", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Groups = append(x.Groups, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MulticastGroups from JSON.
+func (x *MulticastGroups) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the CreateMulticastGroupRequest message to JSON.
+func (x *CreateMulticastGroupRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Group != nil || s.HasField("group") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("group")
+		x.Group.MarshalProtoJSON(s.WithField("group"))
+	}
+	if x.EndDevice != nil || s.HasField("end_device") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device")
+		x.EndDevice.MarshalProtoJSON(s.WithField("end_device"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CreateMulticastGroupRequest to JSON.
+func (x *CreateMulticastGroupRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CreateMulticastGroupRequest message from JSON.
+func (x *CreateMulticastGroupRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "group":
+			if s.ReadNil() {
+				x.Group = nil
+				return
+			}
+			x.Group = &MulticastGroup{}
+			x.Group.UnmarshalProtoJSON(s.WithField("group", true))
+		case "end_device", "endDevice":
+			if s.ReadNil() {
+				x.EndDevice = nil
+				return
+			}
+			x.EndDevice = &EndDevice{}
+			x.EndDevice.UnmarshalProtoJSON(s.WithField("end_device", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CreateMulticastGroupRequest from JSON.
+func (x *CreateMulticastGroupRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetMulticastGroupRequest message to JSON.
+func (x *GetMulticastGroupRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetMulticastGroupRequest to JSON.
+func (x *GetMulticastGroupRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetMulticastGroupRequest message from JSON.
+func (x *GetMulticastGroupRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetMulticastGroupRequest from JSON.
+func (x *GetMulticastGroupRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UpdateMulticastGroupMembersRequest message to JSON.
+func (x *UpdateMulticastGroupMembersRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.AddDeviceIds) > 0 || s.HasField("add_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("add_device_ids")
+		s.WriteStringArray(x.AddDeviceIds)
+	}
+	if len(x.RemoveDeviceIds) > 0 || s.HasField("remove_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("remove_device_ids")
+		s.WriteStringArray(x.RemoveDeviceIds)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UpdateMulticastGroupMembersRequest to JSON.
+func (x *UpdateMulticastGroupMembersRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UpdateMulticastGroupMembersRequest message from JSON.
+func (x *UpdateMulticastGroupMembersRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "add_device_ids", "addDeviceIds":
+			s.AddField("add_device_ids")
+			if s.ReadNil() {
+				x.AddDeviceIds = nil
+				return
+			}
+			x.AddDeviceIds = s.ReadStringArray()
+		case "remove_device_ids", "removeDeviceIds":
+			s.AddField("remove_device_ids")
+			if s.ReadNil() {
+				x.RemoveDeviceIds = nil
+				return
+			}
+			x.RemoveDeviceIds = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UpdateMulticastGroupMembersRequest from JSON.
+func (x *UpdateMulticastGroupMembersRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SetMulticastGroupGatewaysRequest message to JSON.
+func (x *SetMulticastGroupGatewaysRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.Gateways) > 0 || s.HasField("gateways") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateways")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Gateways {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateways"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SetMulticastGroupGatewaysRequest to JSON.
+func (x *SetMulticastGroupGatewaysRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SetMulticastGroupGatewaysRequest message from JSON.
+func (x *SetMulticastGroupGatewaysRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "gateways":
+			s.AddField("gateways")
+			if s.ReadNil() {
+				x.Gateways = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Gateways = append(x.Gateways, nil)
+					return
+				}
+				v := &ClassBCGatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateways", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Gateways = append(x.Gateways, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SetMulticastGroupGatewaysRequest from JSON.
+func (x *SetMulticastGroupGatewaysRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ComputeMulticastGroupGatewaysRequest message to JSON.
+func (x *ComputeMulticastGroupGatewaysRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Apply || s.HasField("apply") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("apply")
+		s.WriteBool(x.Apply)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ComputeMulticastGroupGatewaysRequest to JSON.
+func (x *ComputeMulticastGroupGatewaysRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ComputeMulticastGroupGatewaysRequest message from JSON.
+func (x *ComputeMulticastGroupGatewaysRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "apply":
+			s.AddField("apply")
+			x.Apply = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ComputeMulticastGroupGatewaysRequest from JSON.
+func (x *ComputeMulticastGroupGatewaysRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ComputeMulticastGroupGatewaysResponse message to JSON.
+func (x *ComputeMulticastGroupGatewaysResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Gateways) > 0 || s.HasField("gateways") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateways")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Gateways {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateways"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.UncoveredDeviceIds) > 0 || s.HasField("uncovered_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uncovered_device_ids")
+		s.WriteStringArray(x.UncoveredDeviceIds)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ComputeMulticastGroupGatewaysResponse to JSON.
+func (x *ComputeMulticastGroupGatewaysResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ComputeMulticastGroupGatewaysResponse message from JSON.
+func (x *ComputeMulticastGroupGatewaysResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateways":
+			s.AddField("gateways")
+			if s.ReadNil() {
+				x.Gateways = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Gateways = append(x.Gateways, nil)
+					return
+				}
+				v := &ClassBCGatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateways", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Gateways = append(x.Gateways, v)
+			})
+		case "uncovered_device_ids", "uncoveredDeviceIds":
+			s.AddField("uncovered_device_ids")
+			if s.ReadNil() {
+				x.UncoveredDeviceIds = nil
+				return
+			}
+			x.UncoveredDeviceIds = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ComputeMulticastGroupGatewaysResponse from JSON.
+func (x *ComputeMulticastGroupGatewaysResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -0,0 +1,1231 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: ttn/lorawan/v3/networkserver_rekey.proto
+
+package ttnpb
+
+import (
+	_ "github.com/TheThingsIndustries/protoc-gen-go-json/annotations"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RekeyCampaignState int32
+
+const (
+	// The end devices of the campaign are being triggered to rejoin.
+	RekeyCampaignState_REKEY_CAMPAIGN_RUNNING RekeyCampaignState = 0
+	// All end devices of the campaign rejoined, timed out, failed or were skipped.
+	RekeyCampaignState_REKEY_CAMPAIGN_COMPLETED RekeyCampaignState = 1
+	// The campaign was cancelled. Pending end devices are not triggered anymore.
+	RekeyCampaignState_REKEY_CAMPAIGN_CANCELLED RekeyCampaignState = 2
+)
+
+// Enum value maps for RekeyCampaignState.
+var (
+	RekeyCampaignState_name = map[int32]string{
+		0: "REKEY_CAMPAIGN_RUNNING",
+		1: "REKEY_CAMPAIGN_COMPLETED",
+		2: "REKEY_CAMPAIGN_CANCELLED",
+	}
+	RekeyCampaignState_value = map[string]int32{
+		"REKEY_CAMPAIGN_RUNNING":   0,
+		"REKEY_CAMPAIGN_COMPLETED": 1,
+		"REKEY_CAMPAIGN_CANCELLED": 2,
+	}
+)
+
+func (x RekeyCampaignState) Enum() *RekeyCampaignState {
+	p := new(RekeyCampaignState)
+	*p = x
+	return p
+}
+
+func (x RekeyCampaignState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RekeyCampaignState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_enumTypes[0].Descriptor()
+}
+
+func (RekeyCampaignState) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_rekey_proto_enumTypes[0]
+}
+
+func (x RekeyCampaignState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RekeyCampaignState.Descriptor instead.
+func (RekeyCampaignState) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{0}
+}
+
+type RekeyCampaignDeviceState int32
+
+const (
+	// The end device is not triggered yet.
+	RekeyCampaignDeviceState_REKEY_DEVICE_PENDING RekeyCampaignDeviceState = 0
+	// The end device is triggered to rejoin.
+	RekeyCampaignDeviceState_REKEY_DEVICE_TRIGGERED RekeyCampaignDeviceState = 1
+	// The end device activated a new session after it was triggered.
+	RekeyCampaignDeviceState_REKEY_DEVICE_REJOINED RekeyCampaignDeviceState = 2
+	// The end device did not activate a new session within the rejoin timeout.
+	RekeyCampaignDeviceState_REKEY_DEVICE_TIMED_OUT RekeyCampaignDeviceState = 3
+	// The end device cannot be triggered to rejoin, for example because it is an ABP end device.
+	RekeyCampaignDeviceState_REKEY_DEVICE_SKIPPED RekeyCampaignDeviceState = 4
+	// Triggering the end device failed.
+	RekeyCampaignDeviceState_REKEY_DEVICE_FAILED RekeyCampaignDeviceState = 5
+)
+
+// Enum value maps for RekeyCampaignDeviceState.
+var (
+	RekeyCampaignDeviceState_name = map[int32]string{
+		0: "REKEY_DEVICE_PENDING",
+		1: "REKEY_DEVICE_TRIGGERED",
+		2: "REKEY_DEVICE_REJOINED",
+		3: "REKEY_DEVICE_TIMED_OUT",
+		4: "REKEY_DEVICE_SKIPPED",
+		5: "REKEY_DEVICE_FAILED",
+	}
+	RekeyCampaignDeviceState_value = map[string]int32{
+		"REKEY_DEVICE_PENDING":   0,
+		"REKEY_DEVICE_TRIGGERED": 1,
+		"REKEY_DEVICE_REJOINED":  2,
+		"REKEY_DEVICE_TIMED_OUT": 3,
+		"REKEY_DEVICE_SKIPPED":   4,
+		"REKEY_DEVICE_FAILED":    5,
+	}
+)
+
+func (x RekeyCampaignDeviceState) Enum() *RekeyCampaignDeviceState {
+	p := new(RekeyCampaignDeviceState)
+	*p = x
+	return p
+}
+
+func (x RekeyCampaignDeviceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RekeyCampaignDeviceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_enumTypes[1].Descriptor()
+}
+
+func (RekeyCampaignDeviceState) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_rekey_proto_enumTypes[1]
+}
+
+func (x RekeyCampaignDeviceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RekeyCampaignDeviceState.Descriptor instead.
+func (RekeyCampaignDeviceState) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{1}
+}
+
+// Status of an end device in a rekey campaign.
+type RekeyCampaignDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId string                   `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	State    RekeyCampaignDeviceState `protobuf:"varint,2,opt,name=state,proto3,enum=ttn.lorawan.v3.RekeyCampaignDeviceState" json:"state,omitempty"`
+	// LoRaWAN version of the end device when the campaign was created.
+	LorawanVersion MACVersion `protobuf:"varint,3,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
+	// Session key ID of the session of the end device when it was triggered.
+	SessionKeyId []byte                 `protobuf:"bytes,4,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+	TriggeredAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	RejoinedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=rejoined_at,json=rejoinedAt,proto3" json:"rejoined_at,omitempty"`
+	// The reason why the end device was skipped or why triggering it failed.
+	Error *ErrorDetails `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RekeyCampaignDevice) Reset() {
+	*x = RekeyCampaignDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RekeyCampaignDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RekeyCampaignDevice) ProtoMessage() {}
+
+func (x *RekeyCampaignDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RekeyCampaignDevice.ProtoReflect.Descriptor instead.
+func (*RekeyCampaignDevice) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RekeyCampaignDevice) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RekeyCampaignDevice) GetState() RekeyCampaignDeviceState {
+	if x != nil {
+		return x.State
+	}
+	return RekeyCampaignDeviceState_REKEY_DEVICE_PENDING
+}
+
+func (x *RekeyCampaignDevice) GetLorawanVersion() MACVersion {
+	if x != nil {
+		return x.LorawanVersion
+	}
+	return MACVersion_MAC_UNKNOWN
+}
+
+func (x *RekeyCampaignDevice) GetSessionKeyId() []byte {
+	if x != nil {
+		return x.SessionKeyId
+	}
+	return nil
+}
+
+func (x *RekeyCampaignDevice) GetTriggeredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return nil
+}
+
+func (x *RekeyCampaignDevice) GetRejoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RejoinedAt
+	}
+	return nil
+}
+
+func (x *RekeyCampaignDevice) GetError() *ErrorDetails {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Number of end devices in a rekey campaign per state.
+type RekeyCampaignSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending   uint32 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Triggered uint32 `protobuf:"varint,2,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	Rejoined  uint32 `protobuf:"varint,3,opt,name=rejoined,proto3" json:"rejoined,omitempty"`
+	TimedOut  uint32 `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Skipped   uint32 `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed    uint32 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *RekeyCampaignSummary) Reset() {
+	*x = RekeyCampaignSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RekeyCampaignSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RekeyCampaignSummary) ProtoMessage() {}
+
+func (x *RekeyCampaignSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RekeyCampaignSummary.ProtoReflect.Descriptor instead.
+func (*RekeyCampaignSummary) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RekeyCampaignSummary) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *RekeyCampaignSummary) GetTriggered() uint32 {
+	if x != nil {
+		return x.Triggered
+	}
+	return 0
+}
+
+func (x *RekeyCampaignSummary) GetRejoined() uint32 {
+	if x != nil {
+		return x.Rejoined
+	}
+	return 0
+}
+
+func (x *RekeyCampaignSummary) GetTimedOut() uint32 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+func (x *RekeyCampaignSummary) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *RekeyCampaignSummary) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// RekeyCampaign rotates the sessions of end devices of an application by triggering them to rejoin.
+// LoRaWAN 1.1 end devices are sent a ForceRejoinReq MAC command. LoRaWAN 1.0.x end devices are sent an application
+// downlink message, which the application on the end device handles by sending a join-request.
+// An end device is reported as rejoined once it activated a new session. Note that rejoin-requests are not handled
+// by the Network Server, so LoRaWAN 1.1 end devices need to activate the new session with a join-request.
+type RekeyCampaign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	CampaignId     string                  `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Selector       *RekeyCampaign_Selector `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
+	// Maximum number of end devices that are triggered per minute.
+	DevicesPerMinute uint32 `protobuf:"varint,6,opt,name=devices_per_minute,json=devicesPerMinute,proto3" json:"devices_per_minute,omitempty"`
+	// Time after which a triggered end device that did not activate a new session is considered timed out.
+	RejoinTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=rejoin_timeout,json=rejoinTimeout,proto3" json:"rejoin_timeout,omitempty"`
+	// ForceRejoinReq sent to LoRaWAN 1.1 end devices.
+	ForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,8,opt,name=force_rejoin_req,json=forceRejoinReq,proto3" json:"force_rejoin_req,omitempty"`
+	// Application downlink message sent to LoRaWAN 1.0.x end devices, which should trigger the end device to send
+	// a join-request. The message is encoded and encrypted by the Application Server.
+	// LoRaWAN 1.0.x end devices are skipped if this is not set.
+	AppCommand *ApplicationDownlink  `protobuf:"bytes,9,opt,name=app_command,json=appCommand,proto3" json:"app_command,omitempty"`
+	State      RekeyCampaignState    `protobuf:"varint,10,opt,name=state,proto3,enum=ttn.lorawan.v3.RekeyCampaignState" json:"state,omitempty"`
+	Summary    *RekeyCampaignSummary `protobuf:"bytes,11,opt,name=summary,proto3" json:"summary,omitempty"`
+	// The end devices of the campaign.
+	Devices []*RekeyCampaignDevice `protobuf:"bytes,12,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *RekeyCampaign) Reset() {
+	*x = RekeyCampaign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RekeyCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RekeyCampaign) ProtoMessage() {}
+
+func (x *RekeyCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RekeyCampaign.ProtoReflect.Descriptor instead.
+func (*RekeyCampaign) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RekeyCampaign) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RekeyCampaign) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetSelector() *RekeyCampaign_Selector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetDevicesPerMinute() uint32 {
+	if x != nil {
+		return x.DevicesPerMinute
+	}
+	return 0
+}
+
+func (x *RekeyCampaign) GetRejoinTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RejoinTimeout
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if x != nil {
+		return x.ForceRejoinReq
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetAppCommand() *ApplicationDownlink {
+	if x != nil {
+		return x.AppCommand
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetState() RekeyCampaignState {
+	if x != nil {
+		return x.State
+	}
+	return RekeyCampaignState_REKEY_CAMPAIGN_RUNNING
+}
+
+func (x *RekeyCampaign) GetSummary() *RekeyCampaignSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *RekeyCampaign) GetDevices() []*RekeyCampaignDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type RekeyCampaigns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Campaigns []*RekeyCampaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+}
+
+func (x *RekeyCampaigns) Reset() {
+	*x = RekeyCampaigns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RekeyCampaigns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RekeyCampaigns) ProtoMessage() {}
+
+func (x *RekeyCampaigns) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RekeyCampaigns.ProtoReflect.Descriptor instead.
+func (*RekeyCampaigns) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RekeyCampaigns) GetCampaigns() []*RekeyCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+type CreateRekeyCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The campaign to create. The end devices of the campaign are selected with the selector.
+	Campaign *RekeyCampaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+}
+
+func (x *CreateRekeyCampaignRequest) Reset() {
+	*x = CreateRekeyCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRekeyCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRekeyCampaignRequest) ProtoMessage() {}
+
+func (x *CreateRekeyCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRekeyCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateRekeyCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRekeyCampaignRequest) GetCampaign() *RekeyCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type GetRekeyCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	CampaignId     string                  `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (x *GetRekeyCampaignRequest) Reset() {
+	*x = GetRekeyCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRekeyCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRekeyCampaignRequest) ProtoMessage() {}
+
+func (x *GetRekeyCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRekeyCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetRekeyCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRekeyCampaignRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *GetRekeyCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type ListRekeyCampaignsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+}
+
+func (x *ListRekeyCampaignsRequest) Reset() {
+	*x = ListRekeyCampaignsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRekeyCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRekeyCampaignsRequest) ProtoMessage() {}
+
+func (x *ListRekeyCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRekeyCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListRekeyCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRekeyCampaignsRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+// Selection of the end devices of the campaign. All conditions must be met.
+type RekeyCampaign_Selector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Device IDs of the end devices. If empty, all end devices of the application are selected.
+	DeviceIds []string `protobuf:"bytes,1,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	// Attributes that the end devices must have in the Entity Registry.
+	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set, only the end devices of which the DevAddr of the current session matches one of the prefixes are selected.
+	DevAddrPrefixes []*DevAddrPrefix `protobuf:"bytes,3,rep,name=dev_addr_prefixes,json=devAddrPrefixes,proto3" json:"dev_addr_prefixes,omitempty"`
+}
+
+func (x *RekeyCampaign_Selector) Reset() {
+	*x = RekeyCampaign_Selector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RekeyCampaign_Selector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RekeyCampaign_Selector) ProtoMessage() {}
+
+func (x *RekeyCampaign_Selector) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RekeyCampaign_Selector.ProtoReflect.Descriptor instead.
+func (*RekeyCampaign_Selector) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *RekeyCampaign_Selector) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *RekeyCampaign_Selector) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *RekeyCampaign_Selector) GetDevAddrPrefixes() []*DevAddrPrefix {
+	if x != nil {
+		return x.DevAddrPrefixes
+	}
+	return nil
+}
+
+var File_ttn_lorawan_v3_networkserver_rekey_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_networkserver_rekey_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x74, 0x6e, 0x2f, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x03,
+	0x0a, 0x13, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xfa, 0x42, 0x24, 0x72, 0x22, 0x18,
+	0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b,
+	0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d,
+	0x24, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65,
+	0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x4d, 0x41, 0x43, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x7a, 0x03, 0x18, 0x80, 0x10, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x22, 0xee, 0x09, 0x0a, 0x0d, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x12, 0x59, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x48, 0x0a,
+	0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x27, 0xfa, 0x42, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4c, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x12, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x2a, 0x05, 0x18, 0xf0,
+	0x2e, 0x28, 0x01, 0x52, 0x10, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x0e, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06,
+	0x08, 0x01, 0x32, 0x02, 0x08, 0x3c, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x4d, 0x41, 0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x92,
+	0x01, 0x03, 0x10, 0x90, 0x4e, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x80,
+	0x03, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x31, 0xfa, 0x42, 0x2e, 0x92, 0x01, 0x2b, 0x10, 0x90, 0x4e, 0x18, 0x01, 0x22, 0x24, 0x72, 0x22,
+	0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a,
+	0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c,
+	0x7d, 0x24, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x8d, 0x01,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x35, 0xfa, 0x42, 0x32, 0x9a,
+	0x01, 0x2f, 0x10, 0x0a, 0x22, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x2a, 0x05, 0x72, 0x03, 0x18, 0xc8,
+	0x01, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a,
+	0x11, 0x64, 0x65, 0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64,
+	0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10,
+	0x10, 0x52, 0x0f, 0x64, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73,
+	0x22, 0x61, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x59, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x27, 0xfa, 0x42, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30,
+	0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6b, 0x65,
+	0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x59, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x2a, 0x84, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x4d,
+	0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x16, 0xea, 0xaa, 0x19,
+	0x12, 0x18, 0x01, 0x2a, 0x0e, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x41, 0x4d, 0x50, 0x41,
+	0x49, 0x47, 0x4e, 0x2a, 0xd0, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x4b, 0x45, 0x59, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f,
+	0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4b,
+	0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x4b, 0x45, 0x59,
+	0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x1a, 0x14, 0xea, 0xaa, 0x19, 0x10, 0x18, 0x01, 0x2a, 0x0c, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f,
+	0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x32, 0xd9, 0x07, 0x0a, 0x10, 0x4e, 0x73, 0x52, 0x65, 0x6b,
+	0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x12, 0x2a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6b, 0x65, 0x79,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x55,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4f, 0x3a, 0x01, 0x2a, 0x22, 0x4a, 0x2f, 0x6e, 0x73, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x2d, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6b,
+	0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x27, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x12, 0x4f, 0x2f, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x6b, 0x65, 0x79, 0x2d, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x2f, 0x7b, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xaa, 0x01, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x73, 0x12, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52,
+	0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x22, 0x49, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x2d, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0xc0, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x12, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6b, 0x65, 0x79,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x61, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5b,
+	0x3a, 0x01, 0x2a, 0x22, 0x56, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x2d, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0xaf, 0x01, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x12, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x2a, 0x4f, 0x2f, 0x6e,
+	0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x72, 0x65, 0x6b, 0x65, 0x79, 0x2d, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x2f,
+	0x7b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x33, 0x92,
+	0x41, 0x30, 0x12, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x20, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x20, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e,
+	0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescData = file_ttn_lorawan_v3_networkserver_rekey_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_networkserver_rekey_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_networkserver_rekey_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ttn_lorawan_v3_networkserver_rekey_proto_goTypes = []interface{}{
+	(RekeyCampaignState)(0),            // 0: ttn.lorawan.v3.RekeyCampaignState
+	(RekeyCampaignDeviceState)(0),      // 1: ttn.lorawan.v3.RekeyCampaignDeviceState
+	(*RekeyCampaignDevice)(nil),        // 2: ttn.lorawan.v3.RekeyCampaignDevice
+	(*RekeyCampaignSummary)(nil),       // 3: ttn.lorawan.v3.RekeyCampaignSummary
+	(*RekeyCampaign)(nil),              // 4: ttn.lorawan.v3.RekeyCampaign
+	(*RekeyCampaigns)(nil),             // 5: ttn.lorawan.v3.RekeyCampaigns
+	(*CreateRekeyCampaignRequest)(nil), // 6: ttn.lorawan.v3.CreateRekeyCampaignRequest
+	(*GetRekeyCampaignRequest)(nil),    // 7: ttn.lorawan.v3.GetRekeyCampaignRequest
+	(*ListRekeyCampaignsRequest)(nil),  // 8: ttn.lorawan.v3.ListRekeyCampaignsRequest
+	(*RekeyCampaign_Selector)(nil),     // 9: ttn.lorawan.v3.RekeyCampaign.Selector
+	nil,                                // 10: ttn.lorawan.v3.RekeyCampaign.Selector.AttributesEntry
+	(MACVersion)(0),                    // 11: ttn.lorawan.v3.MACVersion
+	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
+	(*ErrorDetails)(nil),               // 13: ttn.lorawan.v3.ErrorDetails
+	(*ApplicationIdentifiers)(nil),     // 14: ttn.lorawan.v3.ApplicationIdentifiers
+	(*durationpb.Duration)(nil),        // 15: google.protobuf.Duration
+	(*MACCommand_ForceRejoinReq)(nil),  // 16: ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	(*ApplicationDownlink)(nil),        // 17: ttn.lorawan.v3.ApplicationDownlink
+	(*DevAddrPrefix)(nil),              // 18: ttn.lorawan.v3.DevAddrPrefix
+	(*emptypb.Empty)(nil),              // 19: google.protobuf.Empty
+}
+var file_ttn_lorawan_v3_networkserver_rekey_proto_depIdxs = []int32{
+	1,  // 0: ttn.lorawan.v3.RekeyCampaignDevice.state:type_name -> ttn.lorawan.v3.RekeyCampaignDeviceState
+	11, // 1: ttn.lorawan.v3.RekeyCampaignDevice.lorawan_version:type_name -> ttn.lorawan.v3.MACVersion
+	12, // 2: ttn.lorawan.v3.RekeyCampaignDevice.triggered_at:type_name -> google.protobuf.Timestamp
+	12, // 3: ttn.lorawan.v3.RekeyCampaignDevice.rejoined_at:type_name -> google.protobuf.Timestamp
+	13, // 4: ttn.lorawan.v3.RekeyCampaignDevice.error:type_name -> ttn.lorawan.v3.ErrorDetails
+	14, // 5: ttn.lorawan.v3.RekeyCampaign.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	12, // 6: ttn.lorawan.v3.RekeyCampaign.created_at:type_name -> google.protobuf.Timestamp
+	12, // 7: ttn.lorawan.v3.RekeyCampaign.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 8: ttn.lorawan.v3.RekeyCampaign.selector:type_name -> ttn.lorawan.v3.RekeyCampaign.Selector
+	15, // 9: ttn.lorawan.v3.RekeyCampaign.rejoin_timeout:type_name -> google.protobuf.Duration
+	16, // 10: ttn.lorawan.v3.RekeyCampaign.force_rejoin_req:type_name -> ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	17, // 11: ttn.lorawan.v3.RekeyCampaign.app_command:type_name -> ttn.lorawan.v3.ApplicationDownlink
+	0,  // 12: ttn.lorawan.v3.RekeyCampaign.state:type_name -> ttn.lorawan.v3.RekeyCampaignState
+	3,  // 13: ttn.lorawan.v3.RekeyCampaign.summary:type_name -> ttn.lorawan.v3.RekeyCampaignSummary
+	2,  // 14: ttn.lorawan.v3.RekeyCampaign.devices:type_name -> ttn.lorawan.v3.RekeyCampaignDevice
+	4,  // 15: ttn.lorawan.v3.RekeyCampaigns.campaigns:type_name -> ttn.lorawan.v3.RekeyCampaign
+	4,  // 16: ttn.lorawan.v3.CreateRekeyCampaignRequest.campaign:type_name -> ttn.lorawan.v3.RekeyCampaign
+	14, // 17: ttn.lorawan.v3.GetRekeyCampaignRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	14, // 18: ttn.lorawan.v3.ListRekeyCampaignsRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	10, // 19: ttn.lorawan.v3.RekeyCampaign.Selector.attributes:type_name -> ttn.lorawan.v3.RekeyCampaign.Selector.AttributesEntry
+	18, // 20: ttn.lorawan.v3.RekeyCampaign.Selector.dev_addr_prefixes:type_name -> ttn.lorawan.v3.DevAddrPrefix
+	6,  // 21: ttn.lorawan.v3.NsRekeyCampaigns.CreateRekeyCampaign:input_type -> ttn.lorawan.v3.CreateRekeyCampaignRequest
+	7,  // 22: ttn.lorawan.v3.NsRekeyCampaigns.GetRekeyCampaign:input_type -> ttn.lorawan.v3.GetRekeyCampaignRequest
+	8,  // 23: ttn.lorawan.v3.NsRekeyCampaigns.ListRekeyCampaigns:input_type -> ttn.lorawan.v3.ListRekeyCampaignsRequest
+	7,  // 24: ttn.lorawan.v3.NsRekeyCampaigns.CancelRekeyCampaign:input_type -> ttn.lorawan.v3.GetRekeyCampaignRequest
+	7,  // 25: ttn.lorawan.v3.NsRekeyCampaigns.DeleteRekeyCampaign:input_type -> ttn.lorawan.v3.GetRekeyCampaignRequest
+	4,  // 26: ttn.lorawan.v3.NsRekeyCampaigns.CreateRekeyCampaign:output_type -> ttn.lorawan.v3.RekeyCampaign
+	4,  // 27: ttn.lorawan.v3.NsRekeyCampaigns.GetRekeyCampaign:output_type -> ttn.lorawan.v3.RekeyCampaign
+	5,  // 28: ttn.lorawan.v3.NsRekeyCampaigns.ListRekeyCampaigns:output_type -> ttn.lorawan.v3.RekeyCampaigns
+	4,  // 29: ttn.lorawan.v3.NsRekeyCampaigns.CancelRekeyCampaign:output_type -> ttn.lorawan.v3.RekeyCampaign
+	19, // 30: ttn.lorawan.v3.NsRekeyCampaigns.DeleteRekeyCampaign:output_type -> google.protobuf.Empty
+	26, // [26:31] is the sub-list for method output_type
+	21, // [21:26] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_networkserver_rekey_proto_init() }
+func file_ttn_lorawan_v3_networkserver_rekey_proto_init() {
+	if File_ttn_lorawan_v3_networkserver_rekey_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_end_device_proto_init()
+	file_ttn_lorawan_v3_error_proto_init()
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	file_ttn_lorawan_v3_lorawan_proto_init()
+	file_ttn_lorawan_v3_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RekeyCampaignDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RekeyCampaignSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RekeyCampaign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RekeyCampaigns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRekeyCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRekeyCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRekeyCampaignsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RekeyCampaign_Selector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_networkserver_rekey_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_networkserver_rekey_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_networkserver_rekey_proto_depIdxs,
+		EnumInfos:         file_ttn_lorawan_v3_networkserver_rekey_proto_enumTypes,
+		MessageInfos:      file_ttn_lorawan_v3_networkserver_rekey_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_networkserver_rekey_proto = out.File
+	file_ttn_lorawan_v3_networkserver_rekey_proto_rawDesc = nil
+	file_ttn_lorawan_v3_networkserver_rekey_proto_goTypes = nil
+	file_ttn_lorawan_v3_networkserver_rekey_proto_depIdxs = nil
+}
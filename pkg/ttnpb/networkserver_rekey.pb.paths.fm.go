@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var RekeyCampaignDeviceFieldPathsNested = []string{
+	"device_id",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"lorawan_version",
+	"rejoined_at",
+	"session_key_id",
+	"state",
+	"triggered_at",
+}
+
+var RekeyCampaignDeviceFieldPathsTopLevel = []string{
+	"device_id",
+	"error",
+	"lorawan_version",
+	"rejoined_at",
+	"session_key_id",
+	"state",
+	"triggered_at",
+}
+var RekeyCampaignSummaryFieldPathsNested = []string{
+	"failed",
+	"pending",
+	"rejoined",
+	"skipped",
+	"timed_out",
+	"triggered",
+}
+
+var RekeyCampaignSummaryFieldPathsTopLevel = []string{
+	"failed",
+	"pending",
+	"rejoined",
+	"skipped",
+	"timed_out",
+	"triggered",
+}
+var RekeyCampaignFieldPathsNested = []string{
+	"app_command",
+	"app_command.class_b_c",
+	"app_command.class_b_c.absolute_time",
+	"app_command.class_b_c.gateways",
+	"app_command.confirmed",
+	"app_command.confirmed_retry",
+	"app_command.confirmed_retry.attempt",
+	"app_command.confirmed_retry.max_attempts",
+	"app_command.correlation_ids",
+	"app_command.decoded_payload",
+	"app_command.decoded_payload_warnings",
+	"app_command.f_cnt",
+	"app_command.f_port",
+	"app_command.frm_payload",
+	"app_command.priority",
+	"app_command.session_key_id",
+	"application_ids",
+	"application_ids.application_id",
+	"campaign_id",
+	"created_at",
+	"devices",
+	"devices_per_minute",
+	"force_rejoin_req",
+	"force_rejoin_req.data_rate_index",
+	"force_rejoin_req.max_retries",
+	"force_rejoin_req.period_exponent",
+	"force_rejoin_req.rejoin_type",
+	"rejoin_timeout",
+	"selector",
+	"selector.attributes",
+	"selector.dev_addr_prefixes",
+	"selector.device_ids",
+	"state",
+	"summary",
+	"summary.failed",
+	"summary.pending",
+	"summary.rejoined",
+	"summary.skipped",
+	"summary.timed_out",
+	"summary.triggered",
+	"updated_at",
+}
+
+var RekeyCampaignFieldPathsTopLevel = []string{
+	"app_command",
+	"application_ids",
+	"campaign_id",
+	"created_at",
+	"devices",
+	"devices_per_minute",
+	"force_rejoin_req",
+	"rejoin_timeout",
+	"selector",
+	"state",
+	"summary",
+	"updated_at",
+}
+var RekeyCampaignsFieldPathsNested = []string{
+	"campaigns",
+}
+
+var RekeyCampaignsFieldPathsTopLevel = []string{
+	"campaigns",
+}
+var CreateRekeyCampaignRequestFieldPathsNested = []string{
+	"campaign",
+	"campaign.app_command",
+	"campaign.app_command.class_b_c",
+	"campaign.app_command.class_b_c.absolute_time",
+	"campaign.app_command.class_b_c.gateways",
+	"campaign.app_command.confirmed",
+	"campaign.app_command.confirmed_retry",
+	"campaign.app_command.confirmed_retry.attempt",
+	"campaign.app_command.confirmed_retry.max_attempts",
+	"campaign.app_command.correlation_ids",
+	"campaign.app_command.decoded_payload",
+	"campaign.app_command.decoded_payload_warnings",
+	"campaign.app_command.f_cnt",
+	"campaign.app_command.f_port",
+	"campaign.app_command.frm_payload",
+	"campaign.app_command.priority",
+	"campaign.app_command.session_key_id",
+	"campaign.application_ids",
+	"campaign.application_ids.application_id",
+	"campaign.campaign_id",
+	"campaign.created_at",
+	"campaign.devices",
+	"campaign.devices_per_minute",
+	"campaign.force_rejoin_req",
+	"campaign.force_rejoin_req.data_rate_index",
+	"campaign.force_rejoin_req.max_retries",
+	"campaign.force_rejoin_req.period_exponent",
+	"campaign.force_rejoin_req.rejoin_type",
+	"campaign.rejoin_timeout",
+	"campaign.selector",
+	"campaign.selector.attributes",
+	"campaign.selector.dev_addr_prefixes",
+	"campaign.selector.device_ids",
+	"campaign.state",
+	"campaign.summary",
+	"campaign.summary.failed",
+	"campaign.summary.pending",
+	"campaign.summary.rejoined",
+	"campaign.summary.skipped",
+	"campaign.summary.timed_out",
+	"campaign.summary.triggered",
+	"campaign.updated_at",
+}
+
+var CreateRekeyCampaignRequestFieldPathsTopLevel = []string{
+	"campaign",
+}
+var GetRekeyCampaignRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"campaign_id",
+}
+
+var GetRekeyCampaignRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"campaign_id",
+}
+var ListRekeyCampaignsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+}
+
+var ListRekeyCampaignsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+}
+var RekeyCampaign_SelectorFieldPathsNested = []string{
+	"attributes",
+	"dev_addr_prefixes",
+	"device_ids",
+}
+
+var RekeyCampaign_SelectorFieldPathsTopLevel = []string{
+	"attributes",
+	"dev_addr_prefixes",
+	"device_ids",
+}
@@ -0,0 +1,544 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import fmt "fmt"
+
+func (dst *RekeyCampaignDevice) SetFields(src *RekeyCampaignDevice, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				dst.State = 0
+			}
+		case "lorawan_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lorawan_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LorawanVersion = src.LorawanVersion
+			} else {
+				dst.LorawanVersion = 0
+			}
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyId = src.SessionKeyId
+			} else {
+				dst.SessionKeyId = nil
+			}
+		case "triggered_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'triggered_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TriggeredAt = src.TriggeredAt
+			} else {
+				dst.TriggeredAt = nil
+			}
+		case "rejoined_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rejoined_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RejoinedAt = src.RejoinedAt
+			} else {
+				dst.RejoinedAt = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RekeyCampaignSummary) SetFields(src *RekeyCampaignSummary, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "pending":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Pending = src.Pending
+			} else {
+				var zero uint32
+				dst.Pending = zero
+			}
+		case "triggered":
+			if len(subs) > 0 {
+				return fmt.Errorf("'triggered' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Triggered = src.Triggered
+			} else {
+				var zero uint32
+				dst.Triggered = zero
+			}
+		case "rejoined":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rejoined' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rejoined = src.Rejoined
+			} else {
+				var zero uint32
+				dst.Rejoined = zero
+			}
+		case "timed_out":
+			if len(subs) > 0 {
+				return fmt.Errorf("'timed_out' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TimedOut = src.TimedOut
+			} else {
+				var zero uint32
+				dst.TimedOut = zero
+			}
+		case "skipped":
+			if len(subs) > 0 {
+				return fmt.Errorf("'skipped' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Skipped = src.Skipped
+			} else {
+				var zero uint32
+				dst.Skipped = zero
+			}
+		case "failed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'failed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Failed = src.Failed
+			} else {
+				var zero uint32
+				dst.Failed = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RekeyCampaign) SetFields(src *RekeyCampaign, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "campaign_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'campaign_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CampaignId = src.CampaignId
+			} else {
+				var zero string
+				dst.CampaignId = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+		case "selector":
+			if len(subs) > 0 {
+				var newDst, newSrc *RekeyCampaign_Selector
+				if (src == nil || src.Selector == nil) && dst.Selector == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Selector
+				}
+				if dst.Selector != nil {
+					newDst = dst.Selector
+				} else {
+					newDst = &RekeyCampaign_Selector{}
+					dst.Selector = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Selector = src.Selector
+				} else {
+					dst.Selector = nil
+				}
+			}
+		case "devices_per_minute":
+			if len(subs) > 0 {
+				return fmt.Errorf("'devices_per_minute' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevicesPerMinute = src.DevicesPerMinute
+			} else {
+				var zero uint32
+				dst.DevicesPerMinute = zero
+			}
+		case "rejoin_timeout":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rejoin_timeout' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RejoinTimeout = src.RejoinTimeout
+			} else {
+				dst.RejoinTimeout = nil
+			}
+		case "force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.ForceRejoinReq == nil) && dst.ForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoinReq
+				}
+				if dst.ForceRejoinReq != nil {
+					newDst = dst.ForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.ForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoinReq = src.ForceRejoinReq
+				} else {
+					dst.ForceRejoinReq = nil
+				}
+			}
+		case "app_command":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationDownlink
+				if (src == nil || src.AppCommand == nil) && dst.AppCommand == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.AppCommand
+				}
+				if dst.AppCommand != nil {
+					newDst = dst.AppCommand
+				} else {
+					newDst = &ApplicationDownlink{}
+					dst.AppCommand = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.AppCommand = src.AppCommand
+				} else {
+					dst.AppCommand = nil
+				}
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				dst.State = 0
+			}
+		case "summary":
+			if len(subs) > 0 {
+				var newDst, newSrc *RekeyCampaignSummary
+				if (src == nil || src.Summary == nil) && dst.Summary == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Summary
+				}
+				if dst.Summary != nil {
+					newDst = dst.Summary
+				} else {
+					newDst = &RekeyCampaignSummary{}
+					dst.Summary = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Summary = src.Summary
+				} else {
+					dst.Summary = nil
+				}
+			}
+		case "devices":
+			if len(subs) > 0 {
+				return fmt.Errorf("'devices' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Devices = src.Devices
+			} else {
+				dst.Devices = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RekeyCampaigns) SetFields(src *RekeyCampaigns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "campaigns":
+			if len(subs) > 0 {
+				return fmt.Errorf("'campaigns' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Campaigns = src.Campaigns
+			} else {
+				dst.Campaigns = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateRekeyCampaignRequest) SetFields(src *CreateRekeyCampaignRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "campaign":
+			if len(subs) > 0 {
+				var newDst, newSrc *RekeyCampaign
+				if (src == nil || src.Campaign == nil) && dst.Campaign == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Campaign
+				}
+				if dst.Campaign != nil {
+					newDst = dst.Campaign
+				} else {
+					newDst = &RekeyCampaign{}
+					dst.Campaign = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Campaign = src.Campaign
+				} else {
+					dst.Campaign = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetRekeyCampaignRequest) SetFields(src *GetRekeyCampaignRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "campaign_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'campaign_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CampaignId = src.CampaignId
+			} else {
+				var zero string
+				dst.CampaignId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListRekeyCampaignsRequest) SetFields(src *ListRekeyCampaignsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RekeyCampaign_Selector) SetFields(src *RekeyCampaign_Selector, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIds = src.DeviceIds
+			} else {
+				dst.DeviceIds = nil
+			}
+		case "attributes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attributes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attributes = src.Attributes
+			} else {
+				dst.Attributes = nil
+			}
+		case "dev_addr_prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr_prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddrPrefixes = src.DevAddrPrefixes
+			} else {
+				dst.DevAddrPrefixes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1154 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on RekeyCampaignDevice with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RekeyCampaignDevice) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RekeyCampaignDeviceFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id":
+
+			if utf8.RuneCountInString(m.GetDeviceId()) > 36 {
+				return RekeyCampaignDeviceValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_RekeyCampaignDevice_DeviceId_Pattern.MatchString(m.GetDeviceId()) {
+				return RekeyCampaignDeviceValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "state":
+
+			if _, ok := RekeyCampaignDeviceState_name[int32(m.GetState())]; !ok {
+				return RekeyCampaignDeviceValidationError{
+					field:  "state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "lorawan_version":
+
+			if _, ok := MACVersion_name[int32(m.GetLorawanVersion())]; !ok {
+				return RekeyCampaignDeviceValidationError{
+					field:  "lorawan_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "session_key_id":
+
+			if len(m.GetSessionKeyId()) > 2048 {
+				return RekeyCampaignDeviceValidationError{
+					field:  "session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		case "triggered_at":
+
+			if v, ok := interface{}(m.GetTriggeredAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignDeviceValidationError{
+						field:  "triggered_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rejoined_at":
+
+			if v, ok := interface{}(m.GetRejoinedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignDeviceValidationError{
+						field:  "rejoined_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignDeviceValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return RekeyCampaignDeviceValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RekeyCampaignDeviceValidationError is the validation error returned by
+// RekeyCampaignDevice.ValidateFields if the designated constraints aren't met.
+type RekeyCampaignDeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RekeyCampaignDeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RekeyCampaignDeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RekeyCampaignDeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RekeyCampaignDeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RekeyCampaignDeviceValidationError) ErrorName() string {
+	return "RekeyCampaignDeviceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RekeyCampaignDeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRekeyCampaignDevice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RekeyCampaignDeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RekeyCampaignDeviceValidationError{}
+
+var _RekeyCampaignDevice_DeviceId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on RekeyCampaignSummary with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RekeyCampaignSummary) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RekeyCampaignSummaryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "pending":
+			// no validation rules for Pending
+		case "triggered":
+			// no validation rules for Triggered
+		case "rejoined":
+			// no validation rules for Rejoined
+		case "timed_out":
+			// no validation rules for TimedOut
+		case "skipped":
+			// no validation rules for Skipped
+		case "failed":
+			// no validation rules for Failed
+		default:
+			return RekeyCampaignSummaryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RekeyCampaignSummaryValidationError is the validation error returned by
+// RekeyCampaignSummary.ValidateFields if the designated constraints aren't met.
+type RekeyCampaignSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RekeyCampaignSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RekeyCampaignSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RekeyCampaignSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RekeyCampaignSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RekeyCampaignSummaryValidationError) ErrorName() string {
+	return "RekeyCampaignSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RekeyCampaignSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRekeyCampaignSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RekeyCampaignSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RekeyCampaignSummaryValidationError{}
+
+// ValidateFields checks the field values on RekeyCampaign with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RekeyCampaign) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RekeyCampaignFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return RekeyCampaignValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "campaign_id":
+
+			if utf8.RuneCountInString(m.GetCampaignId()) > 36 {
+				return RekeyCampaignValidationError{
+					field:  "campaign_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_RekeyCampaign_CampaignId_Pattern.MatchString(m.GetCampaignId()) {
+				return RekeyCampaignValidationError{
+					field:  "campaign_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "selector":
+
+			if m.GetSelector() == nil {
+				return RekeyCampaignValidationError{
+					field:  "selector",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetSelector()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "selector",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "devices_per_minute":
+
+			if val := m.GetDevicesPerMinute(); val < 1 || val > 6000 {
+				return RekeyCampaignValidationError{
+					field:  "devices_per_minute",
+					reason: "value must be inside range [1, 6000]",
+				}
+			}
+
+		case "rejoin_timeout":
+
+			if m.GetRejoinTimeout() == nil {
+				return RekeyCampaignValidationError{
+					field:  "rejoin_timeout",
+					reason: "value is required",
+				}
+			}
+
+			if d := m.GetRejoinTimeout(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return RekeyCampaignValidationError{
+						field:  "rejoin_timeout",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				gte := time.Duration(60*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					return RekeyCampaignValidationError{
+						field:  "rejoin_timeout",
+						reason: "value must be greater than or equal to 1m0s",
+					}
+				}
+
+			}
+
+		case "force_rejoin_req":
+
+			if v, ok := interface{}(m.GetForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "app_command":
+
+			if v, ok := interface{}(m.GetAppCommand()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "app_command",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "state":
+
+			if _, ok := RekeyCampaignState_name[int32(m.GetState())]; !ok {
+				return RekeyCampaignValidationError{
+					field:  "state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "summary":
+
+			if v, ok := interface{}(m.GetSummary()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RekeyCampaignValidationError{
+						field:  "summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "devices":
+
+			if len(m.GetDevices()) > 10000 {
+				return RekeyCampaignValidationError{
+					field:  "devices",
+					reason: "value must contain no more than 10000 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDevices() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RekeyCampaignValidationError{
+							field:  fmt.Sprintf("devices[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RekeyCampaignValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RekeyCampaignValidationError is the validation error returned by
+// RekeyCampaign.ValidateFields if the designated constraints aren't met.
+type RekeyCampaignValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RekeyCampaignValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RekeyCampaignValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RekeyCampaignValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RekeyCampaignValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RekeyCampaignValidationError) ErrorName() string { return "RekeyCampaignValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RekeyCampaignValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRekeyCampaign.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RekeyCampaignValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RekeyCampaignValidationError{}
+
+var _RekeyCampaign_CampaignId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on RekeyCampaigns with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RekeyCampaigns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RekeyCampaignsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "campaigns":
+
+			for idx, item := range m.GetCampaigns() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RekeyCampaignsValidationError{
+							field:  fmt.Sprintf("campaigns[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RekeyCampaignsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RekeyCampaignsValidationError is the validation error returned by
+// RekeyCampaigns.ValidateFields if the designated constraints aren't met.
+type RekeyCampaignsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RekeyCampaignsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RekeyCampaignsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RekeyCampaignsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RekeyCampaignsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RekeyCampaignsValidationError) ErrorName() string { return "RekeyCampaignsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RekeyCampaignsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRekeyCampaigns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RekeyCampaignsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RekeyCampaignsValidationError{}
+
+// ValidateFields checks the field values on CreateRekeyCampaignRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateRekeyCampaignRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateRekeyCampaignRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "campaign":
+
+			if m.GetCampaign() == nil {
+				return CreateRekeyCampaignRequestValidationError{
+					field:  "campaign",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetCampaign()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateRekeyCampaignRequestValidationError{
+						field:  "campaign",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return CreateRekeyCampaignRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateRekeyCampaignRequestValidationError is the validation error returned
+// by CreateRekeyCampaignRequest.ValidateFields if the designated constraints
+// aren't met.
+type CreateRekeyCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRekeyCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRekeyCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRekeyCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRekeyCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRekeyCampaignRequestValidationError) ErrorName() string {
+	return "CreateRekeyCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRekeyCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRekeyCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRekeyCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRekeyCampaignRequestValidationError{}
+
+// ValidateFields checks the field values on GetRekeyCampaignRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRekeyCampaignRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetRekeyCampaignRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return GetRekeyCampaignRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetRekeyCampaignRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "campaign_id":
+
+			if utf8.RuneCountInString(m.GetCampaignId()) > 36 {
+				return GetRekeyCampaignRequestValidationError{
+					field:  "campaign_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GetRekeyCampaignRequest_CampaignId_Pattern.MatchString(m.GetCampaignId()) {
+				return GetRekeyCampaignRequestValidationError{
+					field:  "campaign_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return GetRekeyCampaignRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetRekeyCampaignRequestValidationError is the validation error returned by
+// GetRekeyCampaignRequest.ValidateFields if the designated constraints aren't met.
+type GetRekeyCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRekeyCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRekeyCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRekeyCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRekeyCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRekeyCampaignRequestValidationError) ErrorName() string {
+	return "GetRekeyCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRekeyCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRekeyCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRekeyCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRekeyCampaignRequestValidationError{}
+
+var _GetRekeyCampaignRequest_CampaignId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ListRekeyCampaignsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRekeyCampaignsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListRekeyCampaignsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ListRekeyCampaignsRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListRekeyCampaignsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListRekeyCampaignsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListRekeyCampaignsRequestValidationError is the validation error returned by
+// ListRekeyCampaignsRequest.ValidateFields if the designated constraints
+// aren't met.
+type ListRekeyCampaignsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRekeyCampaignsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRekeyCampaignsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRekeyCampaignsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRekeyCampaignsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRekeyCampaignsRequestValidationError) ErrorName() string {
+	return "ListRekeyCampaignsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRekeyCampaignsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRekeyCampaignsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRekeyCampaignsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRekeyCampaignsRequestValidationError{}
+
+// ValidateFields checks the field values on RekeyCampaign_Selector with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RekeyCampaign_Selector) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RekeyCampaign_SelectorFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_ids":
+
+			if len(m.GetDeviceIds()) > 10000 {
+				return RekeyCampaign_SelectorValidationError{
+					field:  "device_ids",
+					reason: "value must contain no more than 10000 item(s)",
+				}
+			}
+
+			_RekeyCampaign_Selector_DeviceIds_Unique := make(map[string]struct{}, len(m.GetDeviceIds()))
+
+			for idx, item := range m.GetDeviceIds() {
+				_, _ = idx, item
+
+				if _, exists := _RekeyCampaign_Selector_DeviceIds_Unique[item]; exists {
+					return RekeyCampaign_SelectorValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_RekeyCampaign_Selector_DeviceIds_Unique[item] = struct{}{}
+				}
+
+				if utf8.RuneCountInString(item) > 36 {
+					return RekeyCampaign_SelectorValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_RekeyCampaign_Selector_DeviceIds_Pattern.MatchString(item) {
+					return RekeyCampaign_SelectorValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "attributes":
+
+			if len(m.GetAttributes()) > 10 {
+				return RekeyCampaign_SelectorValidationError{
+					field:  "attributes",
+					reason: "value must contain no more than 10 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetAttributes() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return RekeyCampaign_SelectorValidationError{
+						field:  fmt.Sprintf("attributes[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_RekeyCampaign_Selector_Attributes_Pattern.MatchString(key) {
+					return RekeyCampaign_SelectorValidationError{
+						field:  fmt.Sprintf("attributes[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 200 {
+					return RekeyCampaign_SelectorValidationError{
+						field:  fmt.Sprintf("attributes[%v]", key),
+						reason: "value length must be at most 200 runes",
+					}
+				}
+
+			}
+
+		case "dev_addr_prefixes":
+
+			if len(m.GetDevAddrPrefixes()) > 16 {
+				return RekeyCampaign_SelectorValidationError{
+					field:  "dev_addr_prefixes",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDevAddrPrefixes() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RekeyCampaign_SelectorValidationError{
+							field:  fmt.Sprintf("dev_addr_prefixes[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RekeyCampaign_SelectorValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RekeyCampaign_SelectorValidationError is the validation error returned by
+// RekeyCampaign_Selector.ValidateFields if the designated constraints aren't met.
+type RekeyCampaign_SelectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RekeyCampaign_SelectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RekeyCampaign_SelectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RekeyCampaign_SelectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RekeyCampaign_SelectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RekeyCampaign_SelectorValidationError) ErrorName() string {
+	return "RekeyCampaign_SelectorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RekeyCampaign_SelectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRekeyCampaign_Selector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RekeyCampaign_SelectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RekeyCampaign_SelectorValidationError{}
+
+var _RekeyCampaign_Selector_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _RekeyCampaign_Selector_Attributes_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
@@ -0,0 +1,282 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: ttn/lorawan/v3/networkserver_rekey.proto
+
+package ttnpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NsRekeyCampaigns_CreateRekeyCampaign_FullMethodName = "/ttn.lorawan.v3.NsRekeyCampaigns/CreateRekeyCampaign"
+	NsRekeyCampaigns_GetRekeyCampaign_FullMethodName    = "/ttn.lorawan.v3.NsRekeyCampaigns/GetRekeyCampaign"
+	NsRekeyCampaigns_ListRekeyCampaigns_FullMethodName  = "/ttn.lorawan.v3.NsRekeyCampaigns/ListRekeyCampaigns"
+	NsRekeyCampaigns_CancelRekeyCampaign_FullMethodName = "/ttn.lorawan.v3.NsRekeyCampaigns/CancelRekeyCampaign"
+	NsRekeyCampaigns_DeleteRekeyCampaign_FullMethodName = "/ttn.lorawan.v3.NsRekeyCampaigns/DeleteRekeyCampaign"
+)
+
+// NsRekeyCampaignsClient is the client API for NsRekeyCampaigns service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NsRekeyCampaignsClient interface {
+	// Create a rekey campaign. The campaign starts immediately.
+	CreateRekeyCampaign(ctx context.Context, in *CreateRekeyCampaignRequest, opts ...grpc.CallOption) (*RekeyCampaign, error)
+	// Get a rekey campaign, including the status of its end devices.
+	GetRekeyCampaign(ctx context.Context, in *GetRekeyCampaignRequest, opts ...grpc.CallOption) (*RekeyCampaign, error)
+	// List the rekey campaigns of an application. The status of the end devices is not included.
+	ListRekeyCampaigns(ctx context.Context, in *ListRekeyCampaignsRequest, opts ...grpc.CallOption) (*RekeyCampaigns, error)
+	// Cancel a rekey campaign. Pending end devices are not triggered anymore.
+	CancelRekeyCampaign(ctx context.Context, in *GetRekeyCampaignRequest, opts ...grpc.CallOption) (*RekeyCampaign, error)
+	// Delete a rekey campaign.
+	DeleteRekeyCampaign(ctx context.Context, in *GetRekeyCampaignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type nsRekeyCampaignsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNsRekeyCampaignsClient(cc grpc.ClientConnInterface) NsRekeyCampaignsClient {
+	return &nsRekeyCampaignsClient{cc}
+}
+
+func (c *nsRekeyCampaignsClient) CreateRekeyCampaign(ctx context.Context, in *CreateRekeyCampaignRequest, opts ...grpc.CallOption) (*RekeyCampaign, error) {
+	out := new(RekeyCampaign)
+	err := c.cc.Invoke(ctx, NsRekeyCampaigns_CreateRekeyCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsRekeyCampaignsClient) GetRekeyCampaign(ctx context.Context, in *GetRekeyCampaignRequest, opts ...grpc.CallOption) (*RekeyCampaign, error) {
+	out := new(RekeyCampaign)
+	err := c.cc.Invoke(ctx, NsRekeyCampaigns_GetRekeyCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsRekeyCampaignsClient) ListRekeyCampaigns(ctx context.Context, in *ListRekeyCampaignsRequest, opts ...grpc.CallOption) (*RekeyCampaigns, error) {
+	out := new(RekeyCampaigns)
+	err := c.cc.Invoke(ctx, NsRekeyCampaigns_ListRekeyCampaigns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsRekeyCampaignsClient) CancelRekeyCampaign(ctx context.Context, in *GetRekeyCampaignRequest, opts ...grpc.CallOption) (*RekeyCampaign, error) {
+	out := new(RekeyCampaign)
+	err := c.cc.Invoke(ctx, NsRekeyCampaigns_CancelRekeyCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsRekeyCampaignsClient) DeleteRekeyCampaign(ctx context.Context, in *GetRekeyCampaignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NsRekeyCampaigns_DeleteRekeyCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsRekeyCampaignsServer is the server API for NsRekeyCampaigns service.
+// All implementations must embed UnimplementedNsRekeyCampaignsServer
+// for forward compatibility
+type NsRekeyCampaignsServer interface {
+	// Create a rekey campaign. The campaign starts immediately.
+	CreateRekeyCampaign(context.Context, *CreateRekeyCampaignRequest) (*RekeyCampaign, error)
+	// Get a rekey campaign, including the status of its end devices.
+	GetRekeyCampaign(context.Context, *GetRekeyCampaignRequest) (*RekeyCampaign, error)
+	// List the rekey campaigns of an application. The status of the end devices is not included.
+	ListRekeyCampaigns(context.Context, *ListRekeyCampaignsRequest) (*RekeyCampaigns, error)
+	// Cancel a rekey campaign. Pending end devices are not triggered anymore.
+	CancelRekeyCampaign(context.Context, *GetRekeyCampaignRequest) (*RekeyCampaign, error)
+	// Delete a rekey campaign.
+	DeleteRekeyCampaign(context.Context, *GetRekeyCampaignRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedNsRekeyCampaignsServer()
+}
+
+// UnimplementedNsRekeyCampaignsServer must be embedded to have forward compatible implementations.
+type UnimplementedNsRekeyCampaignsServer struct {
+}
+
+func (UnimplementedNsRekeyCampaignsServer) CreateRekeyCampaign(context.Context, *CreateRekeyCampaignRequest) (*RekeyCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRekeyCampaign not implemented")
+}
+func (UnimplementedNsRekeyCampaignsServer) GetRekeyCampaign(context.Context, *GetRekeyCampaignRequest) (*RekeyCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRekeyCampaign not implemented")
+}
+func (UnimplementedNsRekeyCampaignsServer) ListRekeyCampaigns(context.Context, *ListRekeyCampaignsRequest) (*RekeyCampaigns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRekeyCampaigns not implemented")
+}
+func (UnimplementedNsRekeyCampaignsServer) CancelRekeyCampaign(context.Context, *GetRekeyCampaignRequest) (*RekeyCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRekeyCampaign not implemented")
+}
+func (UnimplementedNsRekeyCampaignsServer) DeleteRekeyCampaign(context.Context, *GetRekeyCampaignRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRekeyCampaign not implemented")
+}
+func (UnimplementedNsRekeyCampaignsServer) mustEmbedUnimplementedNsRekeyCampaignsServer() {}
+
+// UnsafeNsRekeyCampaignsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NsRekeyCampaignsServer will
+// result in compilation errors.
+type UnsafeNsRekeyCampaignsServer interface {
+	mustEmbedUnimplementedNsRekeyCampaignsServer()
+}
+
+func RegisterNsRekeyCampaignsServer(s grpc.ServiceRegistrar, srv NsRekeyCampaignsServer) {
+	s.RegisterService(&NsRekeyCampaigns_ServiceDesc, srv)
+}
+
+func _NsRekeyCampaigns_CreateRekeyCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRekeyCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsRekeyCampaignsServer).CreateRekeyCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsRekeyCampaigns_CreateRekeyCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsRekeyCampaignsServer).CreateRekeyCampaign(ctx, req.(*CreateRekeyCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsRekeyCampaigns_GetRekeyCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRekeyCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsRekeyCampaignsServer).GetRekeyCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsRekeyCampaigns_GetRekeyCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsRekeyCampaignsServer).GetRekeyCampaign(ctx, req.(*GetRekeyCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsRekeyCampaigns_ListRekeyCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRekeyCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsRekeyCampaignsServer).ListRekeyCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsRekeyCampaigns_ListRekeyCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsRekeyCampaignsServer).ListRekeyCampaigns(ctx, req.(*ListRekeyCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsRekeyCampaigns_CancelRekeyCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRekeyCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsRekeyCampaignsServer).CancelRekeyCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsRekeyCampaigns_CancelRekeyCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsRekeyCampaignsServer).CancelRekeyCampaign(ctx, req.(*GetRekeyCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsRekeyCampaigns_DeleteRekeyCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRekeyCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsRekeyCampaignsServer).DeleteRekeyCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsRekeyCampaigns_DeleteRekeyCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsRekeyCampaignsServer).DeleteRekeyCampaign(ctx, req.(*GetRekeyCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NsRekeyCampaigns_ServiceDesc is the grpc.ServiceDesc for NsRekeyCampaigns service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NsRekeyCampaigns_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsRekeyCampaigns",
+	HandlerType: (*NsRekeyCampaignsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRekeyCampaign",
+			Handler:    _NsRekeyCampaigns_CreateRekeyCampaign_Handler,
+		},
+		{
+			MethodName: "GetRekeyCampaign",
+			Handler:    _NsRekeyCampaigns_GetRekeyCampaign_Handler,
+		},
+		{
+			MethodName: "ListRekeyCampaigns",
+			Handler:    _NsRekeyCampaigns_ListRekeyCampaigns_Handler,
+		},
+		{
+			MethodName: "CancelRekeyCampaign",
+			Handler:    _NsRekeyCampaigns_CancelRekeyCampaign_Handler,
+		},
+		{
+			MethodName: "DeleteRekeyCampaign",
+			Handler:    _NsRekeyCampaigns_DeleteRekeyCampaign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ttn/lorawan/v3/networkserver_rekey.proto",
+}
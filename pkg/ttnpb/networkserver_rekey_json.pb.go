@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/networkserver_rekey.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the RekeyCampaignState to JSON.
+func (x RekeyCampaignState) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RekeyCampaignState_name)
+}
+
+// MarshalText marshals the RekeyCampaignState to text.
+func (x RekeyCampaignState) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), RekeyCampaignState_name)), nil
+}
+
+// MarshalJSON marshals the RekeyCampaignState to JSON.
+func (x RekeyCampaignState) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// RekeyCampaignState_customvalue contains custom string values that extend RekeyCampaignState_value.
+var RekeyCampaignState_customvalue = map[string]int32{
+	"RUNNING":   0,
+	"COMPLETED": 1,
+	"CANCELLED": 2,
+}
+
+// UnmarshalProtoJSON unmarshals the RekeyCampaignState from JSON.
+func (x *RekeyCampaignState) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RekeyCampaignState_value, RekeyCampaignState_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RekeyCampaignState enum: %v", err)
+		return
+	}
+	*x = RekeyCampaignState(v)
+}
+
+// UnmarshalText unmarshals the RekeyCampaignState from text.
+func (x *RekeyCampaignState) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), RekeyCampaignState_customvalue, RekeyCampaignState_value)
+	if err != nil {
+		return err
+	}
+	*x = RekeyCampaignState(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the RekeyCampaignState from JSON.
+func (x *RekeyCampaignState) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RekeyCampaignDeviceState to JSON.
+func (x RekeyCampaignDeviceState) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RekeyCampaignDeviceState_name)
+}
+
+// MarshalText marshals the RekeyCampaignDeviceState to text.
+func (x RekeyCampaignDeviceState) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), RekeyCampaignDeviceState_name)), nil
+}
+
+// MarshalJSON marshals the RekeyCampaignDeviceState to JSON.
+func (x RekeyCampaignDeviceState) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// RekeyCampaignDeviceState_customvalue contains custom string values that extend RekeyCampaignDeviceState_value.
+var RekeyCampaignDeviceState_customvalue = map[string]int32{
+	"PENDING":   0,
+	"TRIGGERED": 1,
+	"REJOINED":  2,
+	"TIMED_OUT": 3,
+	"SKIPPED":   4,
+	"FAILED":    5,
+}
+
+// UnmarshalProtoJSON unmarshals the RekeyCampaignDeviceState from JSON.
+func (x *RekeyCampaignDeviceState) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RekeyCampaignDeviceState_value, RekeyCampaignDeviceState_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RekeyCampaignDeviceState enum: %v", err)
+		return
+	}
+	*x = RekeyCampaignDeviceState(v)
+}
+
+// UnmarshalText unmarshals the RekeyCampaignDeviceState from text.
+func (x *RekeyCampaignDeviceState) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), RekeyCampaignDeviceState_customvalue, RekeyCampaignDeviceState_value)
+	if err != nil {
+		return err
+	}
+	*x = RekeyCampaignDeviceState(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the RekeyCampaignDeviceState from JSON.
+func (x *RekeyCampaignDeviceState) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RekeyCampaignDevice message to JSON.
+func (x *RekeyCampaignDevice) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.DeviceId != "" || s.HasField("device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_id")
+		s.WriteString(x.DeviceId)
+	}
+	if x.State != 0 || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		x.State.MarshalProtoJSON(s)
+	}
+	if x.LorawanVersion != 0 || s.HasField("lorawan_version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("lorawan_version")
+		x.LorawanVersion.MarshalProtoJSON(s)
+	}
+	if len(x.SessionKeyId) > 0 || s.HasField("session_key_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("session_key_id")
+		s.WriteBytes(x.SessionKeyId)
+	}
+	if x.TriggeredAt != nil || s.HasField("triggered_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("triggered_at")
+		if x.TriggeredAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.TriggeredAt)
+		}
+	}
+	if x.RejoinedAt != nil || s.HasField("rejoined_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rejoined_at")
+		if x.RejoinedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.RejoinedAt)
+		}
+	}
+	if x.Error != nil || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		// NOTE: ErrorDetails does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Error)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RekeyCampaignDevice to JSON.
+func (x *RekeyCampaignDevice) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RekeyCampaignDevice message from JSON.
+func (x *RekeyCampaignDevice) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "device_id", "deviceId":
+			s.AddField("device_id")
+			x.DeviceId = s.ReadString()
+		case "state":
+			s.AddField("state")
+			x.State.UnmarshalProtoJSON(s)
+		case "lorawan_version", "lorawanVersion":
+			s.AddField("lorawan_version")
+			x.LorawanVersion.UnmarshalProtoJSON(s)
+		case "session_key_id", "sessionKeyId":
+			s.AddField("session_key_id")
+			x.SessionKeyId = s.ReadBytes()
+		case "triggered_at", "triggeredAt":
+			s.AddField("triggered_at")
+			if s.ReadNil() {
+				x.TriggeredAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.TriggeredAt = v
+		case "rejoined_at", "rejoinedAt":
+			s.AddField("rejoined_at")
+			if s.ReadNil() {
+				x.RejoinedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.RejoinedAt = v
+		case "error":
+			s.AddField("error")
+			if s.ReadNil() {
+				x.Error = nil
+				return
+			}
+			// NOTE: ErrorDetails does not seem to implement UnmarshalProtoJSON.
+			var v ErrorDetails
+			golang.UnmarshalMessage(s, &v)
+			x.Error = &v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RekeyCampaignDevice from JSON.
+func (x *RekeyCampaignDevice) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RekeyCampaign_Selector message to JSON.
+func (x *RekeyCampaign_Selector) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.DeviceIds) > 0 || s.HasField("device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_ids")
+		s.WriteStringArray(x.DeviceIds)
+	}
+	if x.Attributes != nil || s.HasField("attributes") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("attributes")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Attributes {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if len(x.DevAddrPrefixes) > 0 || s.HasField("dev_addr_prefixes") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr_prefixes")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.DevAddrPrefixes {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("dev_addr_prefixes"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RekeyCampaign_Selector to JSON.
+func (x *RekeyCampaign_Selector) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RekeyCampaign_Selector message from JSON.
+func (x *RekeyCampaign_Selector) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "device_ids", "deviceIds":
+			s.AddField("device_ids")
+			if s.ReadNil() {
+				x.DeviceIds = nil
+				return
+			}
+			x.DeviceIds = s.ReadStringArray()
+		case "attributes":
+			s.AddField("attributes")
+			if s.ReadNil() {
+				x.Attributes = nil
+				return
+			}
+			x.Attributes = make(map[string]string)
+			s.ReadStringMap(func(key string) {
+				x.Attributes[key] = s.ReadString()
+			})
+		case "dev_addr_prefixes", "devAddrPrefixes":
+			s.AddField("dev_addr_prefixes")
+			if s.ReadNil() {
+				x.DevAddrPrefixes = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.DevAddrPrefixes = append(x.DevAddrPrefixes, nil)
+					return
+				}
+				v := &DevAddrPrefix{}
+				v.UnmarshalProtoJSON(s.WithField("dev_addr_prefixes", false))
+				if s.Err() != nil {
+					return
+				}
+				x.DevAddrPrefixes = append(x.DevAddrPrefixes, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RekeyCampaign_Selector from JSON.
+func (x *RekeyCampaign_Selector) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RekeyCampaign message to JSON.
+func (x *RekeyCampaign) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if x.CampaignId != "" || s.HasField("campaign_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("campaign_id")
+		s.WriteString(x.CampaignId)
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.UpdatedAt != nil || s.HasField("updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		if x.UpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.UpdatedAt)
+		}
+	}
+	if x.Selector != nil || s.HasField("selector") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("selector")
+		x.Selector.MarshalProtoJSON(s.WithField("selector"))
+	}
+	if x.DevicesPerMinute != 0 || s.HasField("devices_per_minute") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("devices_per_minute")
+		s.WriteUint32(x.DevicesPerMinute)
+	}
+	if x.RejoinTimeout != nil || s.HasField("rejoin_timeout") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rejoin_timeout")
+		if x.RejoinTimeout == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.RejoinTimeout)
+		}
+	}
+	if x.ForceRejoinReq != nil || s.HasField("force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin_req")
+		x.ForceRejoinReq.MarshalProtoJSON(s.WithField("force_rejoin_req"))
+	}
+	if x.AppCommand != nil || s.HasField("app_command") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("app_command")
+		x.AppCommand.MarshalProtoJSON(s.WithField("app_command"))
+	}
+	if x.State != 0 || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		x.State.MarshalProtoJSON(s)
+	}
+	if x.Summary != nil || s.HasField("summary") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("summary")
+		// NOTE: RekeyCampaignSummary does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Summary)
+	}
+	if len(x.Devices) > 0 || s.HasField("devices") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("devices")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Devices {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("devices"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RekeyCampaign to JSON.
+func (x *RekeyCampaign) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RekeyCampaign message from JSON.
+func (x *RekeyCampaign) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "campaign_id", "campaignId":
+			s.AddField("campaign_id")
+			x.CampaignId = s.ReadString()
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			if s.ReadNil() {
+				x.UpdatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = v
+		case "selector":
+			if s.ReadNil() {
+				x.Selector = nil
+				return
+			}
+			x.Selector = &RekeyCampaign_Selector{}
+			x.Selector.UnmarshalProtoJSON(s.WithField("selector", true))
+		case "devices_per_minute", "devicesPerMinute":
+			s.AddField("devices_per_minute")
+			x.DevicesPerMinute = s.ReadUint32()
+		case "rejoin_timeout", "rejoinTimeout":
+			s.AddField("rejoin_timeout")
+			if s.ReadNil() {
+				x.RejoinTimeout = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.RejoinTimeout = v
+		case "force_rejoin_req", "forceRejoinReq":
+			if s.ReadNil() {
+				x.ForceRejoinReq = nil
+				return
+			}
+			x.ForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.ForceRejoinReq.UnmarshalProtoJSON(s.WithField("force_rejoin_req", true))
+		case "app_command", "appCommand":
+			if s.ReadNil() {
+				x.AppCommand = nil
+				return
+			}
+			x.AppCommand = &ApplicationDownlink{}
+			x.AppCommand.UnmarshalProtoJSON(s.WithField("app_command", true))
+		case "state":
+			s.AddField("state")
+			x.State.UnmarshalProtoJSON(s)
+		case "summary":
+			s.AddField("summary")
+			if s.ReadNil() {
+				x.Summary = nil
+				return
+			}
+			// NOTE: RekeyCampaignSummary does not seem to implement UnmarshalProtoJSON.
+			var v RekeyCampaignSummary
+			golang.UnmarshalMessage(s, &v)
+			x.Summary = &v
+		case "devices":
+			s.AddField("devices")
+			if s.ReadNil() {
+				x.Devices = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Devices = append(x.Devices, nil)
+					return
+				}
+				v := &RekeyCampaignDevice{}
+				v.UnmarshalProtoJSON(s.WithField("devices", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Devices = append(x.Devices, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RekeyCampaign from JSON.
+func (x *RekeyCampaign) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RekeyCampaigns message to JSON.
+func (x *RekeyCampaigns) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Campaigns) > 0 || s.HasField("campaigns") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("campaigns")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Campaigns {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("campaigns"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RekeyCampaigns to JSON.
+func (x *RekeyCampaigns) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RekeyCampaigns message from JSON.
+func (x *RekeyCampaigns) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "campaigns":
+			s.AddField("campaigns")
+			if s.ReadNil() {
+				x.Campaigns = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Campaigns = append(x.Campaigns, nil)
+					return
+				}
+				v := &RekeyCampaign{}
+				v.UnmarshalProtoJSON(s.WithField("campaigns", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Campaigns = append(x.Campaigns, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RekeyCampaigns from JSON.
+func (x *RekeyCampaigns) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the CreateRekeyCampaignRequest message to JSON.
+func (x *CreateRekeyCampaignRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Campaign != nil || s.HasField("campaign") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("campaign")
+		x.Campaign.MarshalProtoJSON(s.WithField("campaign"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CreateRekeyCampaignRequest to JSON.
+func (x *CreateRekeyCampaignRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CreateRekeyCampaignRequest message from JSON.
+func (x *CreateRekeyCampaignRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "campaign":
+			if s.ReadNil() {
+				x.Campaign = nil
+				return
+			}
+			x.Campaign = &RekeyCampaign{}
+			x.Campaign.UnmarshalProtoJSON(s.WithField("campaign", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CreateRekeyCampaignRequest from JSON.
+func (x *CreateRekeyCampaignRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -0,0 +1,748 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: ttn/lorawan/v3/networkserver_security.proto
+
+package ttnpb
+
+import (
+	_ "github.com/TheThingsIndustries/protoc-gen-go-json/annotations"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SecurityAnomalyType int32
+
+const (
+	SecurityAnomalyType_SECURITY_ANOMALY_UNKNOWN SecurityAnomalyType = 0
+	// The Join Server rejected join-requests of the end device because the DevNonce was reused, and none of the gateways that received them received the last accepted join-request.
+	SecurityAnomalyType_SECURITY_ANOMALY_DEV_NONCE_REPLAY SecurityAnomalyType = 1
+	// The end device sent more join-requests than expected.
+	SecurityAnomalyType_SECURITY_ANOMALY_JOIN_FLOOD SecurityAnomalyType = 2
+	// Data uplink messages with the DevAddr of the end device failed the MIC check.
+	SecurityAnomalyType_SECURITY_ANOMALY_MIC_FAILURES SecurityAnomalyType = 3
+	// The gateways that received consecutive uplink messages of the end device are too far apart.
+	SecurityAnomalyType_SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL SecurityAnomalyType = 4
+	// The frame counter of the end device was reset.
+	SecurityAnomalyType_SECURITY_ANOMALY_F_CNT_RESET SecurityAnomalyType = 5
+)
+
+// Enum value maps for SecurityAnomalyType.
+var (
+	SecurityAnomalyType_name = map[int32]string{
+		0: "SECURITY_ANOMALY_UNKNOWN",
+		1: "SECURITY_ANOMALY_DEV_NONCE_REPLAY",
+		2: "SECURITY_ANOMALY_JOIN_FLOOD",
+		3: "SECURITY_ANOMALY_MIC_FAILURES",
+		4: "SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL",
+		5: "SECURITY_ANOMALY_F_CNT_RESET",
+	}
+	SecurityAnomalyType_value = map[string]int32{
+		"SECURITY_ANOMALY_UNKNOWN":           0,
+		"SECURITY_ANOMALY_DEV_NONCE_REPLAY":  1,
+		"SECURITY_ANOMALY_JOIN_FLOOD":        2,
+		"SECURITY_ANOMALY_MIC_FAILURES":      3,
+		"SECURITY_ANOMALY_IMPOSSIBLE_TRAVEL": 4,
+		"SECURITY_ANOMALY_F_CNT_RESET":       5,
+	}
+)
+
+func (x SecurityAnomalyType) Enum() *SecurityAnomalyType {
+	p := new(SecurityAnomalyType)
+	*p = x
+	return p
+}
+
+func (x SecurityAnomalyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityAnomalyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_security_proto_enumTypes[0].Descriptor()
+}
+
+func (SecurityAnomalyType) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_security_proto_enumTypes[0]
+}
+
+func (x SecurityAnomalyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecurityAnomalyType.Descriptor instead.
+func (SecurityAnomalyType) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_security_proto_rawDescGZIP(), []int{0}
+}
+
+// A security anomaly detected by the Network Server.
+type SecurityAnomaly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         SecurityAnomalyType   `protobuf:"varint,1,opt,name=type,proto3,enum=ttn.lorawan.v3.SecurityAnomalyType" json:"type,omitempty"`
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,2,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// DevAddr of the uplink messages.
+	DevAddr []byte `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// Number of occurrences within the window.
+	Count uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// The window in which the occurrences were counted.
+	Window *durationpb.Duration `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
+	// IDs of the gateways that received the uplink messages.
+	GatewayIds []*GatewayIdentifiers `protobuf:"bytes,6,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Locations of the gateways that received the previous and the current uplink message, for impossible travel.
+	Locations []*Location `protobuf:"bytes,7,rep,name=locations,proto3" json:"locations,omitempty"`
+	// Apparent travel speed in km/h, for impossible travel.
+	Speed float32 `protobuf:"fixed32,8,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Frame counter of the uplink message, for frame counter resets.
+	FCnt uint32 `protobuf:"varint,9,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Last frame counter before the reset, for frame counter resets.
+	LastFCnt   uint32                 `protobuf:"varint,10,opt,name=last_f_cnt,json=lastFCnt,proto3" json:"last_f_cnt,omitempty"`
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+}
+
+func (x *SecurityAnomaly) Reset() {
+	*x = SecurityAnomaly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityAnomaly) ProtoMessage() {}
+
+func (x *SecurityAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityAnomaly.ProtoReflect.Descriptor instead.
+func (*SecurityAnomaly) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_security_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecurityAnomaly) GetType() SecurityAnomalyType {
+	if x != nil {
+		return x.Type
+	}
+	return SecurityAnomalyType_SECURITY_ANOMALY_UNKNOWN
+}
+
+func (x *SecurityAnomaly) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *SecurityAnomaly) GetDevAddr() []byte {
+	if x != nil {
+		return x.DevAddr
+	}
+	return nil
+}
+
+func (x *SecurityAnomaly) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SecurityAnomaly) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *SecurityAnomaly) GetGatewayIds() []*GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *SecurityAnomaly) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *SecurityAnomaly) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *SecurityAnomaly) GetFCnt() uint32 {
+	if x != nil {
+		return x.FCnt
+	}
+	return 0
+}
+
+func (x *SecurityAnomaly) GetLastFCnt() uint32 {
+	if x != nil {
+		return x.LastFCnt
+	}
+	return 0
+}
+
+func (x *SecurityAnomaly) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+// Security monitor settings of an application.
+// Zero thresholds and windows use the defaults of the Network Server.
+type SecurityMonitorSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	CreatedAt      *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Number of DevNonce replays of an end device within the window that is reported.
+	DevNonceReplayThreshold uint32               `protobuf:"varint,4,opt,name=dev_nonce_replay_threshold,json=devNonceReplayThreshold,proto3" json:"dev_nonce_replay_threshold,omitempty"`
+	DevNonceReplayWindow    *durationpb.Duration `protobuf:"bytes,5,opt,name=dev_nonce_replay_window,json=devNonceReplayWindow,proto3" json:"dev_nonce_replay_window,omitempty"`
+	// Number of join-requests of an end device within the window that is reported.
+	JoinFloodThreshold uint32               `protobuf:"varint,6,opt,name=join_flood_threshold,json=joinFloodThreshold,proto3" json:"join_flood_threshold,omitempty"`
+	JoinFloodWindow    *durationpb.Duration `protobuf:"bytes,7,opt,name=join_flood_window,json=joinFloodWindow,proto3" json:"join_flood_window,omitempty"`
+	// Number of MIC failures of a DevAddr within the window that is reported.
+	MicFailureThreshold uint32               `protobuf:"varint,8,opt,name=mic_failure_threshold,json=micFailureThreshold,proto3" json:"mic_failure_threshold,omitempty"`
+	MicFailureWindow    *durationpb.Duration `protobuf:"bytes,9,opt,name=mic_failure_window,json=micFailureWindow,proto3" json:"mic_failure_window,omitempty"`
+	// Maximum travel speed of an end device in km/h.
+	MaxTravelSpeed float32 `protobuf:"fixed32,10,opt,name=max_travel_speed,json=maxTravelSpeed,proto3" json:"max_travel_speed,omitempty"`
+	// Anomaly types that are not reported.
+	DisabledTypes []SecurityAnomalyType `protobuf:"varint,11,rep,packed,name=disabled_types,json=disabledTypes,proto3,enum=ttn.lorawan.v3.SecurityAnomalyType" json:"disabled_types,omitempty"`
+	// Whether the collaborators and technical contact of the application are notified of anomalies.
+	Notify bool `protobuf:"varint,12,opt,name=notify,proto3" json:"notify,omitempty"`
+}
+
+func (x *SecurityMonitorSettings) Reset() {
+	*x = SecurityMonitorSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityMonitorSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityMonitorSettings) ProtoMessage() {}
+
+func (x *SecurityMonitorSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityMonitorSettings.ProtoReflect.Descriptor instead.
+func (*SecurityMonitorSettings) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_security_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SecurityMonitorSettings) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetDevNonceReplayThreshold() uint32 {
+	if x != nil {
+		return x.DevNonceReplayThreshold
+	}
+	return 0
+}
+
+func (x *SecurityMonitorSettings) GetDevNonceReplayWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DevNonceReplayWindow
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetJoinFloodThreshold() uint32 {
+	if x != nil {
+		return x.JoinFloodThreshold
+	}
+	return 0
+}
+
+func (x *SecurityMonitorSettings) GetJoinFloodWindow() *durationpb.Duration {
+	if x != nil {
+		return x.JoinFloodWindow
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetMicFailureThreshold() uint32 {
+	if x != nil {
+		return x.MicFailureThreshold
+	}
+	return 0
+}
+
+func (x *SecurityMonitorSettings) GetMicFailureWindow() *durationpb.Duration {
+	if x != nil {
+		return x.MicFailureWindow
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetMaxTravelSpeed() float32 {
+	if x != nil {
+		return x.MaxTravelSpeed
+	}
+	return 0
+}
+
+func (x *SecurityMonitorSettings) GetDisabledTypes() []SecurityAnomalyType {
+	if x != nil {
+		return x.DisabledTypes
+	}
+	return nil
+}
+
+func (x *SecurityMonitorSettings) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type SetSecurityMonitorSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *SecurityMonitorSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// The names of the settings fields that should be updated.
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+}
+
+func (x *SetSecurityMonitorSettingsRequest) Reset() {
+	*x = SetSecurityMonitorSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSecurityMonitorSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecurityMonitorSettingsRequest) ProtoMessage() {}
+
+func (x *SetSecurityMonitorSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecurityMonitorSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetSecurityMonitorSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_security_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetSecurityMonitorSettingsRequest) GetSettings() *SecurityMonitorSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *SetSecurityMonitorSettingsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+var File_ttn_lorawan_v3_networkserver_security_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_networkserver_security_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x68, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74,
+	0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x04, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4a,
+	0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x64, 0x65,
+	0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42,
+	0x06, 0x7a, 0x04, 0x68, 0x04, 0x70, 0x01, 0x52, 0x07, 0x64, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x0a, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x02, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x13, 0x0a, 0x05, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x66, 0x43, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x5f,
+	0x63, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x46,
+	0x43, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x9a, 0x06, 0x0a, 0x17, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x59, 0x0a, 0x0f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a,
+	0x1a, 0x64, 0x65, 0x76, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x17, 0x64, 0x65, 0x76, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x50, 0x0a, 0x17, 0x64, 0x65,
+	0x76, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x64, 0x65, 0x76, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x0a, 0x14,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6a, 0x6f, 0x69, 0x6e,
+	0x46, 0x6c, 0x6f, 0x6f, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x45,
+	0x0a, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x6f, 0x6f, 0x64, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x69, 0x63, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x6d, 0x69, 0x63,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x6d, 0x69, 0x63, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x34, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x5b, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x92, 0x01, 0x09, 0x18, 0x01, 0x22,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0xad, 0x01,
+	0x0a, 0x21, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x2a, 0x82, 0x02,
+	0x0a, 0x13, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x56, 0x5f, 0x4e, 0x4f, 0x4e, 0x43,
+	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x45,
+	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x4a,
+	0x4f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x4f, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f,
+	0x4d, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x53, 0x10, 0x03, 0x12, 0x26,
+	0x0a, 0x22, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41,
+	0x4c, 0x59, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x53, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x56, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x46, 0x5f, 0x43, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x05, 0x1a, 0x18, 0xea, 0xaa, 0x19, 0x14, 0x18, 0x01,
+	0x2a, 0x10, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41,
+	0x4c, 0x59, 0x32, 0xe8, 0x04, 0x0a, 0x11, 0x4e, 0x73, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0xa9, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a,
+	0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34,
+	0x12, 0x32, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2d, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x12, 0xd0, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x31, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x50, 0x3a, 0x01, 0x2a, 0x1a, 0x4b, 0x2f, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2d,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x9b, 0x01, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x34, 0x2a, 0x32, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2d, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x1a, 0x36, 0x92, 0x41, 0x33, 0x12, 0x31, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x20, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x20, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x42, 0x31, 0x5a,
+	0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_networkserver_security_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_networkserver_security_proto_rawDescData = file_ttn_lorawan_v3_networkserver_security_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_networkserver_security_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_networkserver_security_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_networkserver_security_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_networkserver_security_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_networkserver_security_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_networkserver_security_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ttn_lorawan_v3_networkserver_security_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ttn_lorawan_v3_networkserver_security_proto_goTypes = []interface{}{
+	(SecurityAnomalyType)(0),                  // 0: ttn.lorawan.v3.SecurityAnomalyType
+	(*SecurityAnomaly)(nil),                   // 1: ttn.lorawan.v3.SecurityAnomaly
+	(*SecurityMonitorSettings)(nil),           // 2: ttn.lorawan.v3.SecurityMonitorSettings
+	(*SetSecurityMonitorSettingsRequest)(nil), // 3: ttn.lorawan.v3.SetSecurityMonitorSettingsRequest
+	(*EndDeviceIdentifiers)(nil),              // 4: ttn.lorawan.v3.EndDeviceIdentifiers
+	(*durationpb.Duration)(nil),               // 5: google.protobuf.Duration
+	(*GatewayIdentifiers)(nil),                // 6: ttn.lorawan.v3.GatewayIdentifiers
+	(*Location)(nil),                          // 7: ttn.lorawan.v3.Location
+	(*timestamppb.Timestamp)(nil),             // 8: google.protobuf.Timestamp
+	(*ApplicationIdentifiers)(nil),            // 9: ttn.lorawan.v3.ApplicationIdentifiers
+	(*fieldmaskpb.FieldMask)(nil),             // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                     // 11: google.protobuf.Empty
+}
+var file_ttn_lorawan_v3_networkserver_security_proto_depIdxs = []int32{
+	0,  // 0: ttn.lorawan.v3.SecurityAnomaly.type:type_name -> ttn.lorawan.v3.SecurityAnomalyType
+	4,  // 1: ttn.lorawan.v3.SecurityAnomaly.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	5,  // 2: ttn.lorawan.v3.SecurityAnomaly.window:type_name -> google.protobuf.Duration
+	6,  // 3: ttn.lorawan.v3.SecurityAnomaly.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	7,  // 4: ttn.lorawan.v3.SecurityAnomaly.locations:type_name -> ttn.lorawan.v3.Location
+	8,  // 5: ttn.lorawan.v3.SecurityAnomaly.detected_at:type_name -> google.protobuf.Timestamp
+	9,  // 6: ttn.lorawan.v3.SecurityMonitorSettings.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	8,  // 7: ttn.lorawan.v3.SecurityMonitorSettings.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: ttn.lorawan.v3.SecurityMonitorSettings.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 9: ttn.lorawan.v3.SecurityMonitorSettings.dev_nonce_replay_window:type_name -> google.protobuf.Duration
+	5,  // 10: ttn.lorawan.v3.SecurityMonitorSettings.join_flood_window:type_name -> google.protobuf.Duration
+	5,  // 11: ttn.lorawan.v3.SecurityMonitorSettings.mic_failure_window:type_name -> google.protobuf.Duration
+	0,  // 12: ttn.lorawan.v3.SecurityMonitorSettings.disabled_types:type_name -> ttn.lorawan.v3.SecurityAnomalyType
+	2,  // 13: ttn.lorawan.v3.SetSecurityMonitorSettingsRequest.settings:type_name -> ttn.lorawan.v3.SecurityMonitorSettings
+	10, // 14: ttn.lorawan.v3.SetSecurityMonitorSettingsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	9,  // 15: ttn.lorawan.v3.NsSecurityMonitor.GetSecurityMonitorSettings:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	3,  // 16: ttn.lorawan.v3.NsSecurityMonitor.SetSecurityMonitorSettings:input_type -> ttn.lorawan.v3.SetSecurityMonitorSettingsRequest
+	9,  // 17: ttn.lorawan.v3.NsSecurityMonitor.DeleteSecurityMonitorSettings:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	2,  // 18: ttn.lorawan.v3.NsSecurityMonitor.GetSecurityMonitorSettings:output_type -> ttn.lorawan.v3.SecurityMonitorSettings
+	2,  // 19: ttn.lorawan.v3.NsSecurityMonitor.SetSecurityMonitorSettings:output_type -> ttn.lorawan.v3.SecurityMonitorSettings
+	11, // 20: ttn.lorawan.v3.NsSecurityMonitor.DeleteSecurityMonitorSettings:output_type -> google.protobuf.Empty
+	18, // [18:21] is the sub-list for method output_type
+	15, // [15:18] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_networkserver_security_proto_init() }
+func file_ttn_lorawan_v3_networkserver_security_proto_init() {
+	if File_ttn_lorawan_v3_networkserver_security_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	file_ttn_lorawan_v3_metadata_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityAnomaly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityMonitorSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_security_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSecurityMonitorSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_networkserver_security_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_networkserver_security_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_networkserver_security_proto_depIdxs,
+		EnumInfos:         file_ttn_lorawan_v3_networkserver_security_proto_enumTypes,
+		MessageInfos:      file_ttn_lorawan_v3_networkserver_security_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_networkserver_security_proto = out.File
+	file_ttn_lorawan_v3_networkserver_security_proto_rawDesc = nil
+	file_ttn_lorawan_v3_networkserver_security_proto_goTypes = nil
+	file_ttn_lorawan_v3_networkserver_security_proto_depIdxs = nil
+}
@@ -0,0 +1,89 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var SecurityAnomalyFieldPathsNested = []string{
+	"count",
+	"detected_at",
+	"dev_addr",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_cnt",
+	"gateway_ids",
+	"last_f_cnt",
+	"locations",
+	"speed",
+	"type",
+	"window",
+}
+
+var SecurityAnomalyFieldPathsTopLevel = []string{
+	"count",
+	"detected_at",
+	"dev_addr",
+	"end_device_ids",
+	"f_cnt",
+	"gateway_ids",
+	"last_f_cnt",
+	"locations",
+	"speed",
+	"type",
+	"window",
+}
+var SecurityMonitorSettingsFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"created_at",
+	"dev_nonce_replay_threshold",
+	"dev_nonce_replay_window",
+	"disabled_types",
+	"join_flood_threshold",
+	"join_flood_window",
+	"max_travel_speed",
+	"mic_failure_threshold",
+	"mic_failure_window",
+	"notify",
+	"updated_at",
+}
+
+var SecurityMonitorSettingsFieldPathsTopLevel = []string{
+	"application_ids",
+	"created_at",
+	"dev_nonce_replay_threshold",
+	"dev_nonce_replay_window",
+	"disabled_types",
+	"join_flood_threshold",
+	"join_flood_window",
+	"max_travel_speed",
+	"mic_failure_threshold",
+	"mic_failure_window",
+	"notify",
+	"updated_at",
+}
+var SetSecurityMonitorSettingsRequestFieldPathsNested = []string{
+	"field_mask",
+	"settings",
+	"settings.application_ids",
+	"settings.application_ids.application_id",
+	"settings.created_at",
+	"settings.dev_nonce_replay_threshold",
+	"settings.dev_nonce_replay_window",
+	"settings.disabled_types",
+	"settings.join_flood_threshold",
+	"settings.join_flood_window",
+	"settings.max_travel_speed",
+	"settings.mic_failure_threshold",
+	"settings.mic_failure_window",
+	"settings.notify",
+	"settings.updated_at",
+}
+
+var SetSecurityMonitorSettingsRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"settings",
+}
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import fmt "fmt"
+
+func (dst *SecurityAnomaly) SetFields(src *SecurityAnomaly, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				dst.Type = 0
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				dst.DevAddr = nil
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+		case "window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Window = src.Window
+			} else {
+				dst.Window = nil
+			}
+		case "gateway_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayIds = src.GatewayIds
+			} else {
+				dst.GatewayIds = nil
+			}
+		case "locations":
+			if len(subs) > 0 {
+				return fmt.Errorf("'locations' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Locations = src.Locations
+			} else {
+				dst.Locations = nil
+			}
+		case "speed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'speed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Speed = src.Speed
+			} else {
+				var zero float32
+				dst.Speed = zero
+			}
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "last_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFCnt = src.LastFCnt
+			} else {
+				var zero uint32
+				dst.LastFCnt = zero
+			}
+		case "detected_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'detected_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DetectedAt = src.DetectedAt
+			} else {
+				dst.DetectedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SecurityMonitorSettings) SetFields(src *SecurityMonitorSettings, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+		case "dev_nonce_replay_threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_nonce_replay_threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevNonceReplayThreshold = src.DevNonceReplayThreshold
+			} else {
+				var zero uint32
+				dst.DevNonceReplayThreshold = zero
+			}
+		case "dev_nonce_replay_window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_nonce_replay_window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevNonceReplayWindow = src.DevNonceReplayWindow
+			} else {
+				dst.DevNonceReplayWindow = nil
+			}
+		case "join_flood_threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_flood_threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinFloodThreshold = src.JoinFloodThreshold
+			} else {
+				var zero uint32
+				dst.JoinFloodThreshold = zero
+			}
+		case "join_flood_window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_flood_window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinFloodWindow = src.JoinFloodWindow
+			} else {
+				dst.JoinFloodWindow = nil
+			}
+		case "mic_failure_threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mic_failure_threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MicFailureThreshold = src.MicFailureThreshold
+			} else {
+				var zero uint32
+				dst.MicFailureThreshold = zero
+			}
+		case "mic_failure_window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mic_failure_window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MicFailureWindow = src.MicFailureWindow
+			} else {
+				dst.MicFailureWindow = nil
+			}
+		case "max_travel_speed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_travel_speed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxTravelSpeed = src.MaxTravelSpeed
+			} else {
+				var zero float32
+				dst.MaxTravelSpeed = zero
+			}
+		case "disabled_types":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disabled_types' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DisabledTypes = src.DisabledTypes
+			} else {
+				dst.DisabledTypes = nil
+			}
+		case "notify":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notify' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Notify = src.Notify
+			} else {
+				var zero bool
+				dst.Notify = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetSecurityMonitorSettingsRequest) SetFields(src *SetSecurityMonitorSettingsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *SecurityMonitorSettings
+				if (src == nil || src.Settings == nil) && dst.Settings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Settings
+				}
+				if dst.Settings != nil {
+					newDst = dst.Settings
+				} else {
+					newDst = &SecurityMonitorSettings{}
+					dst.Settings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Settings = src.Settings
+				} else {
+					dst.Settings = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on SecurityAnomaly with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SecurityAnomaly) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SecurityAnomalyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "type":
+
+			if _, ok := SecurityAnomalyType_name[int32(m.GetType())]; !ok {
+				return SecurityAnomalyValidationError{
+					field:  "type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityAnomalyValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "dev_addr":
+
+			if len(m.GetDevAddr()) > 0 {
+
+				if len(m.GetDevAddr()) != 4 {
+					return SecurityAnomalyValidationError{
+						field:  "dev_addr",
+						reason: "value length must be 4 bytes",
+					}
+				}
+
+			}
+
+		case "count":
+			// no validation rules for Count
+		case "window":
+
+			if v, ok := interface{}(m.GetWindow()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityAnomalyValidationError{
+						field:  "window",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateway_ids":
+
+			if len(m.GetGatewayIds()) > 100 {
+				return SecurityAnomalyValidationError{
+					field:  "gateway_ids",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetGatewayIds() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SecurityAnomalyValidationError{
+							field:  fmt.Sprintf("gateway_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "locations":
+
+			if len(m.GetLocations()) > 2 {
+				return SecurityAnomalyValidationError{
+					field:  "locations",
+					reason: "value must contain no more than 2 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetLocations() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SecurityAnomalyValidationError{
+							field:  fmt.Sprintf("locations[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "speed":
+			// no validation rules for Speed
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "last_f_cnt":
+			// no validation rules for LastFCnt
+		case "detected_at":
+
+			if v, ok := interface{}(m.GetDetectedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityAnomalyValidationError{
+						field:  "detected_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SecurityAnomalyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SecurityAnomalyValidationError is the validation error returned by
+// SecurityAnomaly.ValidateFields if the designated constraints aren't met.
+type SecurityAnomalyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityAnomalyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityAnomalyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityAnomalyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityAnomalyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityAnomalyValidationError) ErrorName() string { return "SecurityAnomalyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecurityAnomalyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityAnomaly.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityAnomalyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityAnomalyValidationError{}
+
+// ValidateFields checks the field values on SecurityMonitorSettings with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SecurityMonitorSettings) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SecurityMonitorSettingsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return SecurityMonitorSettingsValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityMonitorSettingsValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityMonitorSettingsValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityMonitorSettingsValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "dev_nonce_replay_threshold":
+			// no validation rules for DevNonceReplayThreshold
+		case "dev_nonce_replay_window":
+
+			if v, ok := interface{}(m.GetDevNonceReplayWindow()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityMonitorSettingsValidationError{
+						field:  "dev_nonce_replay_window",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "join_flood_threshold":
+			// no validation rules for JoinFloodThreshold
+		case "join_flood_window":
+
+			if v, ok := interface{}(m.GetJoinFloodWindow()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityMonitorSettingsValidationError{
+						field:  "join_flood_window",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "mic_failure_threshold":
+			// no validation rules for MicFailureThreshold
+		case "mic_failure_window":
+
+			if v, ok := interface{}(m.GetMicFailureWindow()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SecurityMonitorSettingsValidationError{
+						field:  "mic_failure_window",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max_travel_speed":
+
+			if m.GetMaxTravelSpeed() < 0 {
+				return SecurityMonitorSettingsValidationError{
+					field:  "max_travel_speed",
+					reason: "value must be greater than or equal to 0",
+				}
+			}
+
+		case "disabled_types":
+
+			_SecurityMonitorSettings_DisabledTypes_Unique := make(map[SecurityAnomalyType]struct{}, len(m.GetDisabledTypes()))
+
+			for idx, item := range m.GetDisabledTypes() {
+				_, _ = idx, item
+
+				if _, exists := _SecurityMonitorSettings_DisabledTypes_Unique[item]; exists {
+					return SecurityMonitorSettingsValidationError{
+						field:  fmt.Sprintf("disabled_types[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_SecurityMonitorSettings_DisabledTypes_Unique[item] = struct{}{}
+				}
+
+				if _, ok := SecurityAnomalyType_name[int32(item)]; !ok {
+					return SecurityMonitorSettingsValidationError{
+						field:  fmt.Sprintf("disabled_types[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "notify":
+			// no validation rules for Notify
+		default:
+			return SecurityMonitorSettingsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SecurityMonitorSettingsValidationError is the validation error returned by
+// SecurityMonitorSettings.ValidateFields if the designated constraints aren't met.
+type SecurityMonitorSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityMonitorSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityMonitorSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityMonitorSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityMonitorSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityMonitorSettingsValidationError) ErrorName() string {
+	return "SecurityMonitorSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityMonitorSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityMonitorSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityMonitorSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityMonitorSettingsValidationError{}
+
+// ValidateFields checks the field values on SetSecurityMonitorSettingsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SetSecurityMonitorSettingsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetSecurityMonitorSettingsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "settings":
+
+			if m.GetSettings() == nil {
+				return SetSecurityMonitorSettingsRequestValidationError{
+					field:  "settings",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetSecurityMonitorSettingsRequestValidationError{
+						field:  "settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetSecurityMonitorSettingsRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetSecurityMonitorSettingsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetSecurityMonitorSettingsRequestValidationError is the validation error
+// returned by SetSecurityMonitorSettingsRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetSecurityMonitorSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSecurityMonitorSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSecurityMonitorSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSecurityMonitorSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSecurityMonitorSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSecurityMonitorSettingsRequestValidationError) ErrorName() string {
+	return "SetSecurityMonitorSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSecurityMonitorSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSecurityMonitorSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSecurityMonitorSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSecurityMonitorSettingsRequestValidationError{}
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-json v1.6.0
+// - protoc             v4.23.4
+// source: ttn/lorawan/v3/networkserver_security.proto
+
+package ttnpb
+
+import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
+	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+)
+
+// MarshalProtoJSON marshals the SecurityAnomalyType to JSON.
+func (x SecurityAnomalyType) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), SecurityAnomalyType_name)
+}
+
+// MarshalText marshals the SecurityAnomalyType to text.
+func (x SecurityAnomalyType) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), SecurityAnomalyType_name)), nil
+}
+
+// MarshalJSON marshals the SecurityAnomalyType to JSON.
+func (x SecurityAnomalyType) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// SecurityAnomalyType_customvalue contains custom string values that extend SecurityAnomalyType_value.
+var SecurityAnomalyType_customvalue = map[string]int32{
+	"UNKNOWN":           0,
+	"DEV_NONCE_REPLAY":  1,
+	"JOIN_FLOOD":        2,
+	"MIC_FAILURES":      3,
+	"IMPOSSIBLE_TRAVEL": 4,
+	"F_CNT_RESET":       5,
+}
+
+// UnmarshalProtoJSON unmarshals the SecurityAnomalyType from JSON.
+func (x *SecurityAnomalyType) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(SecurityAnomalyType_value, SecurityAnomalyType_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read SecurityAnomalyType enum: %v", err)
+		return
+	}
+	*x = SecurityAnomalyType(v)
+}
+
+// UnmarshalText unmarshals the SecurityAnomalyType from text.
+func (x *SecurityAnomalyType) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), SecurityAnomalyType_customvalue, SecurityAnomalyType_value)
+	if err != nil {
+		return err
+	}
+	*x = SecurityAnomalyType(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the SecurityAnomalyType from JSON.
+func (x *SecurityAnomalyType) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SecurityAnomaly message to JSON.
+func (x *SecurityAnomaly) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Type != 0 || s.HasField("type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("type")
+		x.Type.MarshalProtoJSON(s)
+	}
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.DevAddr) > 0 || s.HasField("dev_addr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr")
+		s.WriteBytes(x.DevAddr)
+	}
+	if x.Count != 0 || s.HasField("count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("count")
+		s.WriteUint32(x.Count)
+	}
+	if x.Window != nil || s.HasField("window") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("window")
+		if x.Window == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Window)
+		}
+	}
+	if len(x.GatewayIds) > 0 || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.GatewayIds {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateway_ids"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Locations) > 0 || s.HasField("locations") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("locations")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Locations {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("locations"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Speed != 0 || s.HasField("speed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("speed")
+		s.WriteFloat32(x.Speed)
+	}
+	if x.FCnt != 0 || s.HasField("f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_cnt")
+		s.WriteUint32(x.FCnt)
+	}
+	if x.LastFCnt != 0 || s.HasField("last_f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_f_cnt")
+		s.WriteUint32(x.LastFCnt)
+	}
+	if x.DetectedAt != nil || s.HasField("detected_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("detected_at")
+		if x.DetectedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.DetectedAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SecurityAnomaly to JSON.
+func (x *SecurityAnomaly) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SecurityAnomaly message from JSON.
+func (x *SecurityAnomaly) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "type":
+			s.AddField("type")
+			x.Type.UnmarshalProtoJSON(s)
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "dev_addr", "devAddr":
+			s.AddField("dev_addr")
+			x.DevAddr = s.ReadBytes()
+		case "count":
+			s.AddField("count")
+			x.Count = s.ReadUint32()
+		case "window":
+			s.AddField("window")
+			if s.ReadNil() {
+				x.Window = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Window = v
+		case "gateway_ids", "gatewayIds":
+			s.AddField("gateway_ids")
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.GatewayIds = append(x.GatewayIds, nil)
+					return
+				}
+				v := &GatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateway_ids", false))
+				if s.Err() != nil {
+					return
+				}
+				x.GatewayIds = append(x.GatewayIds, v)
+			})
+		case "locations":
+			s.AddField("locations")
+			if s.ReadNil() {
+				x.Locations = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Locations = append(x.Locations, nil)
+					return
+				}
+				v := &Location{}
+				v.UnmarshalProtoJSON(s.WithField("locations", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Locations = append(x.Locations, v)
+			})
+		case "speed":
+			s.AddField("speed")
+			x.Speed = s.ReadFloat32()
+		case "f_cnt", "fCnt":
+			s.AddField("f_cnt")
+			x.FCnt = s.ReadUint32()
+		case "last_f_cnt", "lastFCnt":
+			s.AddField("last_f_cnt")
+			x.LastFCnt = s.ReadUint32()
+		case "detected_at", "detectedAt":
+			s.AddField("detected_at")
+			if s.ReadNil() {
+				x.DetectedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.DetectedAt = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SecurityAnomaly from JSON.
+func (x *SecurityAnomaly) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SecurityMonitorSettings message to JSON.
+func (x *SecurityMonitorSettings) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.UpdatedAt != nil || s.HasField("updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		if x.UpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.UpdatedAt)
+		}
+	}
+	if x.DevNonceReplayThreshold != 0 || s.HasField("dev_nonce_replay_threshold") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_nonce_replay_threshold")
+		s.WriteUint32(x.DevNonceReplayThreshold)
+	}
+	if x.DevNonceReplayWindow != nil || s.HasField("dev_nonce_replay_window") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_nonce_replay_window")
+		if x.DevNonceReplayWindow == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.DevNonceReplayWindow)
+		}
+	}
+	if x.JoinFloodThreshold != 0 || s.HasField("join_flood_threshold") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_flood_threshold")
+		s.WriteUint32(x.JoinFloodThreshold)
+	}
+	if x.JoinFloodWindow != nil || s.HasField("join_flood_window") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_flood_window")
+		if x.JoinFloodWindow == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.JoinFloodWindow)
+		}
+	}
+	if x.MicFailureThreshold != 0 || s.HasField("mic_failure_threshold") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mic_failure_threshold")
+		s.WriteUint32(x.MicFailureThreshold)
+	}
+	if x.MicFailureWindow != nil || s.HasField("mic_failure_window") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mic_failure_window")
+		if x.MicFailureWindow == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.MicFailureWindow)
+		}
+	}
+	if x.MaxTravelSpeed != 0 || s.HasField("max_travel_speed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("max_travel_speed")
+		s.WriteFloat32(x.MaxTravelSpeed)
+	}
+	if len(x.DisabledTypes) > 0 || s.HasField("disabled_types") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("disabled_types")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.DisabledTypes {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Notify || s.HasField("notify") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("notify")
+		s.WriteBool(x.Notify)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SecurityMonitorSettings to JSON.
+func (x *SecurityMonitorSettings) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SecurityMonitorSettings message from JSON.
+func (x *SecurityMonitorSettings) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			if s.ReadNil() {
+				x.UpdatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = v
+		case "dev_nonce_replay_threshold", "devNonceReplayThreshold":
+			s.AddField("dev_nonce_replay_threshold")
+			x.DevNonceReplayThreshold = s.ReadUint32()
+		case "dev_nonce_replay_window", "devNonceReplayWindow":
+			s.AddField("dev_nonce_replay_window")
+			if s.ReadNil() {
+				x.DevNonceReplayWindow = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.DevNonceReplayWindow = v
+		case "join_flood_threshold", "joinFloodThreshold":
+			s.AddField("join_flood_threshold")
+			x.JoinFloodThreshold = s.ReadUint32()
+		case "join_flood_window", "joinFloodWindow":
+			s.AddField("join_flood_window")
+			if s.ReadNil() {
+				x.JoinFloodWindow = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.JoinFloodWindow = v
+		case "mic_failure_threshold", "micFailureThreshold":
+			s.AddField("mic_failure_threshold")
+			x.MicFailureThreshold = s.ReadUint32()
+		case "mic_failure_window", "micFailureWindow":
+			s.AddField("mic_failure_window")
+			if s.ReadNil() {
+				x.MicFailureWindow = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.MicFailureWindow = v
+		case "max_travel_speed", "maxTravelSpeed":
+			s.AddField("max_travel_speed")
+			x.MaxTravelSpeed = s.ReadFloat32()
+		case "disabled_types", "disabledTypes":
+			s.AddField("disabled_types")
+			if s.ReadNil() {
+				x.DisabledTypes = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v SecurityAnomalyType
+				v.UnmarshalProtoJSON(s)
+				x.DisabledTypes = append(x.DisabledTypes, v)
+			})
+		case "notify":
+			s.AddField("notify")
+			x.Notify = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SecurityMonitorSettings from JSON.
+func (x *SecurityMonitorSettings) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SetSecurityMonitorSettingsRequest message to JSON.
+func (x *SetSecurityMonitorSettingsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Settings != nil || s.HasField("settings") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("settings")
+		x.Settings.MarshalProtoJSON(s.WithField("settings"))
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SetSecurityMonitorSettingsRequest to JSON.
+func (x *SetSecurityMonitorSettingsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SetSecurityMonitorSettingsRequest message from JSON.
+func (x *SetSecurityMonitorSettingsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "settings":
+			if s.ReadNil() {
+				x.Settings = nil
+				return
+			}
+			x.Settings = &SecurityMonitorSettings{}
+			x.Settings.UnmarshalProtoJSON(s.WithField("settings", true))
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SetSecurityMonitorSettingsRequest from JSON.
+func (x *SetSecurityMonitorSettingsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -130,6 +130,150 @@ var UpdateNotificationStatusRequestFieldPathsTopLevel = []string{
 	"receiver_ids",
 	"status",
 }
+var NotificationChannelFieldPathsNested = []string{
+	"channel",
+	"channel.matrix",
+	"channel.matrix.access_token",
+	"channel.matrix.access_token.key_id",
+	"channel.matrix.access_token.value",
+	"channel.matrix.homeserver_url",
+	"channel.matrix.room_id",
+	"channel.slack",
+	"channel.slack.url",
+	"channel.webhook",
+	"channel.webhook.headers",
+	"channel.webhook.secret",
+	"channel.webhook.secret.key_id",
+	"channel.webhook.secret.value",
+	"channel.webhook.url",
+	"channel_id",
+	"created_at",
+	"disabled",
+	"notification_types",
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+	"updated_at",
+}
+
+var NotificationChannelFieldPathsTopLevel = []string{
+	"channel",
+	"channel_id",
+	"created_at",
+	"disabled",
+	"notification_types",
+	"owner_ids",
+	"updated_at",
+}
+var NotificationChannelsFieldPathsNested = []string{
+	"channels",
+}
+
+var NotificationChannelsFieldPathsTopLevel = []string{
+	"channels",
+}
+var NotificationChannelIdentifiersFieldPathsNested = []string{
+	"channel_id",
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+}
+
+var NotificationChannelIdentifiersFieldPathsTopLevel = []string{
+	"channel_id",
+	"owner_ids",
+}
+var CreateNotificationChannelRequestFieldPathsNested = []string{
+	"channel",
+	"channel.channel",
+	"channel.channel.matrix",
+	"channel.channel.matrix.access_token",
+	"channel.channel.matrix.access_token.key_id",
+	"channel.channel.matrix.access_token.value",
+	"channel.channel.matrix.homeserver_url",
+	"channel.channel.matrix.room_id",
+	"channel.channel.slack",
+	"channel.channel.slack.url",
+	"channel.channel.webhook",
+	"channel.channel.webhook.headers",
+	"channel.channel.webhook.secret",
+	"channel.channel.webhook.secret.key_id",
+	"channel.channel.webhook.secret.value",
+	"channel.channel.webhook.url",
+	"channel.channel_id",
+	"channel.created_at",
+	"channel.disabled",
+	"channel.notification_types",
+	"channel.owner_ids",
+	"channel.owner_ids.ids",
+	"channel.owner_ids.ids.organization_ids",
+	"channel.owner_ids.ids.organization_ids.organization_id",
+	"channel.owner_ids.ids.user_ids",
+	"channel.owner_ids.ids.user_ids.email",
+	"channel.owner_ids.ids.user_ids.user_id",
+	"channel.updated_at",
+}
+
+var CreateNotificationChannelRequestFieldPathsTopLevel = []string{
+	"channel",
+}
+var UpdateNotificationChannelRequestFieldPathsNested = []string{
+	"channel",
+	"channel.channel",
+	"channel.channel.matrix",
+	"channel.channel.matrix.access_token",
+	"channel.channel.matrix.access_token.key_id",
+	"channel.channel.matrix.access_token.value",
+	"channel.channel.matrix.homeserver_url",
+	"channel.channel.matrix.room_id",
+	"channel.channel.slack",
+	"channel.channel.slack.url",
+	"channel.channel.webhook",
+	"channel.channel.webhook.headers",
+	"channel.channel.webhook.secret",
+	"channel.channel.webhook.secret.key_id",
+	"channel.channel.webhook.secret.value",
+	"channel.channel.webhook.url",
+	"channel.channel_id",
+	"channel.created_at",
+	"channel.disabled",
+	"channel.notification_types",
+	"channel.owner_ids",
+	"channel.owner_ids.ids",
+	"channel.owner_ids.ids.organization_ids",
+	"channel.owner_ids.ids.organization_ids.organization_id",
+	"channel.owner_ids.ids.user_ids",
+	"channel.owner_ids.ids.user_ids.email",
+	"channel.owner_ids.ids.user_ids.user_id",
+	"channel.updated_at",
+	"field_mask",
+}
+
+var UpdateNotificationChannelRequestFieldPathsTopLevel = []string{
+	"channel",
+	"field_mask",
+}
+var ListNotificationChannelsRequestFieldPathsNested = []string{
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+}
+
+var ListNotificationChannelsRequestFieldPathsTopLevel = []string{
+	"owner_ids",
+}
 var EntityStateChangedNotificationFieldPathsNested = []string{
 	"state",
 	"state_description",
@@ -139,3 +283,36 @@ var EntityStateChangedNotificationFieldPathsTopLevel = []string{
 	"state",
 	"state_description",
 }
+var NotificationChannel_WebhookFieldPathsNested = []string{
+	"headers",
+	"secret",
+	"secret.key_id",
+	"secret.value",
+	"url",
+}
+
+var NotificationChannel_WebhookFieldPathsTopLevel = []string{
+	"headers",
+	"secret",
+	"url",
+}
+var NotificationChannel_SlackFieldPathsNested = []string{
+	"url",
+}
+
+var NotificationChannel_SlackFieldPathsTopLevel = []string{
+	"url",
+}
+var NotificationChannel_MatrixFieldPathsNested = []string{
+	"access_token",
+	"access_token.key_id",
+	"access_token.value",
+	"homeserver_url",
+	"room_id",
+}
+
+var NotificationChannel_MatrixFieldPathsTopLevel = []string{
+	"access_token",
+	"homeserver_url",
+	"room_id",
+}
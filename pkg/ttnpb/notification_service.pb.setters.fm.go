@@ -399,6 +399,401 @@ func (dst *UpdateNotificationStatusRequest) SetFields(src *UpdateNotificationSta
 	return nil
 }
 
+func (dst *NotificationChannel) SetFields(src *NotificationChannel, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+		case "channel_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelId = src.ChannelId
+			} else {
+				var zero string
+				dst.ChannelId = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+		case "notification_types":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notification_types' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotificationTypes = src.NotificationTypes
+			} else {
+				dst.NotificationTypes = nil
+			}
+		case "disabled":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disabled' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Disabled = src.Disabled
+			} else {
+				var zero bool
+				dst.Disabled = zero
+			}
+
+		case "channel":
+			if len(subs) == 0 && src == nil {
+				dst.Channel = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Channel = src.Channel
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "webhook":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Channel.(*NotificationChannel_Webhook_)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Channel == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'webhook', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Channel.(*NotificationChannel_Webhook_)
+					if dstValid := dstTypeOk || dst.Channel == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'webhook', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *NotificationChannel_Webhook
+						if srcTypeOk {
+							newSrc = src.Channel.(*NotificationChannel_Webhook_).Webhook
+						}
+						if dstTypeOk {
+							newDst = dst.Channel.(*NotificationChannel_Webhook_).Webhook
+						} else if srcTypeOk {
+							newDst = &NotificationChannel_Webhook{}
+							dst.Channel = &NotificationChannel_Webhook_{Webhook: newDst}
+						} else {
+							dst.Channel = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Channel = src.Channel
+						} else {
+							dst.Channel = nil
+						}
+					}
+				case "slack":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Channel.(*NotificationChannel_Slack_)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Channel == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'slack', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Channel.(*NotificationChannel_Slack_)
+					if dstValid := dstTypeOk || dst.Channel == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'slack', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *NotificationChannel_Slack
+						if srcTypeOk {
+							newSrc = src.Channel.(*NotificationChannel_Slack_).Slack
+						}
+						if dstTypeOk {
+							newDst = dst.Channel.(*NotificationChannel_Slack_).Slack
+						} else if srcTypeOk {
+							newDst = &NotificationChannel_Slack{}
+							dst.Channel = &NotificationChannel_Slack_{Slack: newDst}
+						} else {
+							dst.Channel = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Channel = src.Channel
+						} else {
+							dst.Channel = nil
+						}
+					}
+				case "matrix":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Channel.(*NotificationChannel_Matrix_)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Channel == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'matrix', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Channel.(*NotificationChannel_Matrix_)
+					if dstValid := dstTypeOk || dst.Channel == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'matrix', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *NotificationChannel_Matrix
+						if srcTypeOk {
+							newSrc = src.Channel.(*NotificationChannel_Matrix_).Matrix
+						}
+						if dstTypeOk {
+							newDst = dst.Channel.(*NotificationChannel_Matrix_).Matrix
+						} else if srcTypeOk {
+							newDst = &NotificationChannel_Matrix{}
+							dst.Channel = &NotificationChannel_Matrix_{Matrix: newDst}
+						} else {
+							dst.Channel = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Channel = src.Channel
+						} else {
+							dst.Channel = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationChannels) SetFields(src *NotificationChannels, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Channels = src.Channels
+			} else {
+				dst.Channels = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationChannelIdentifiers) SetFields(src *NotificationChannelIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+		case "channel_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelId = src.ChannelId
+			} else {
+				var zero string
+				dst.ChannelId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateNotificationChannelRequest) SetFields(src *CreateNotificationChannelRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *NotificationChannel
+				if (src == nil || src.Channel == nil) && dst.Channel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Channel
+				}
+				if dst.Channel != nil {
+					newDst = dst.Channel
+				} else {
+					newDst = &NotificationChannel{}
+					dst.Channel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Channel = src.Channel
+				} else {
+					dst.Channel = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UpdateNotificationChannelRequest) SetFields(src *UpdateNotificationChannelRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *NotificationChannel
+				if (src == nil || src.Channel == nil) && dst.Channel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Channel
+				}
+				if dst.Channel != nil {
+					newDst = dst.Channel
+				} else {
+					newDst = &NotificationChannel{}
+					dst.Channel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Channel = src.Channel
+				} else {
+					dst.Channel = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListNotificationChannelsRequest) SetFields(src *ListNotificationChannelsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EntityStateChangedNotification) SetFields(src *EntityStateChangedNotification, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -428,3 +823,135 @@ func (dst *EntityStateChangedNotification) SetFields(src *EntityStateChangedNoti
 	}
 	return nil
 }
+
+func (dst *NotificationChannel_Webhook) SetFields(src *NotificationChannel_Webhook, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Url = src.Url
+			} else {
+				var zero string
+				dst.Url = zero
+			}
+		case "secret":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.Secret == nil) && dst.Secret == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Secret
+				}
+				if dst.Secret != nil {
+					newDst = dst.Secret
+				} else {
+					newDst = &Secret{}
+					dst.Secret = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Secret = src.Secret
+				} else {
+					dst.Secret = nil
+				}
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationChannel_Slack) SetFields(src *NotificationChannel_Slack, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Url = src.Url
+			} else {
+				var zero string
+				dst.Url = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationChannel_Matrix) SetFields(src *NotificationChannel_Matrix, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "homeserver_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'homeserver_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.HomeserverUrl = src.HomeserverUrl
+			} else {
+				var zero string
+				dst.HomeserverUrl = zero
+			}
+		case "room_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'room_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RoomId = src.RoomId
+			} else {
+				var zero string
+				dst.RoomId = zero
+			}
+		case "access_token":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.AccessToken == nil) && dst.AccessToken == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.AccessToken
+				}
+				if dst.AccessToken != nil {
+					newDst = dst.AccessToken
+				} else {
+					newDst = &Secret{}
+					dst.AccessToken = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.AccessToken = src.AccessToken
+				} else {
+					dst.AccessToken = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -812,6 +812,779 @@ var _ interface {
 	ErrorName() string
 } = UpdateNotificationStatusRequestValidationError{}
 
+// ValidateFields checks the field values on NotificationChannel with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationChannel) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationChannelFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return NotificationChannelValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationChannelValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "channel_id":
+
+			if utf8.RuneCountInString(m.GetChannelId()) > 36 {
+				return NotificationChannelValidationError{
+					field:  "channel_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_NotificationChannel_ChannelId_Pattern.MatchString(m.GetChannelId()) {
+				return NotificationChannelValidationError{
+					field:  "channel_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationChannelValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationChannelValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "notification_types":
+
+			if len(m.GetNotificationTypes()) > 100 {
+				return NotificationChannelValidationError{
+					field:  "notification_types",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			_NotificationChannel_NotificationTypes_Unique := make(map[string]struct{}, len(m.GetNotificationTypes()))
+
+			for idx, item := range m.GetNotificationTypes() {
+				_, _ = idx, item
+
+				if _, exists := _NotificationChannel_NotificationTypes_Unique[item]; exists {
+					return NotificationChannelValidationError{
+						field:  fmt.Sprintf("notification_types[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_NotificationChannel_NotificationTypes_Unique[item] = struct{}{}
+				}
+
+				if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+					return NotificationChannelValidationError{
+						field:  fmt.Sprintf("notification_types[%v]", idx),
+						reason: "value length must be between 1 and 100 runes, inclusive",
+					}
+				}
+
+			}
+
+		case "disabled":
+			// no validation rules for Disabled
+		case "channel":
+			if m.Channel == nil {
+				return NotificationChannelValidationError{
+					field:  "channel",
+					reason: "value is required",
+				}
+			}
+			if len(subs) == 0 {
+				subs = []string{
+					"webhook", "slack", "matrix",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "webhook":
+					w, ok := m.Channel.(*NotificationChannel_Webhook_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetWebhook()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return NotificationChannelValidationError{
+								field:  "webhook",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "slack":
+					w, ok := m.Channel.(*NotificationChannel_Slack_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetSlack()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return NotificationChannelValidationError{
+								field:  "slack",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "matrix":
+					w, ok := m.Channel.(*NotificationChannel_Matrix_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetMatrix()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return NotificationChannelValidationError{
+								field:  "matrix",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return NotificationChannelValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationChannelValidationError is the validation error returned by
+// NotificationChannel.ValidateFields if the designated constraints aren't met.
+type NotificationChannelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannelValidationError) ErrorName() string {
+	return "NotificationChannelValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannelValidationError{}
+
+var _NotificationChannel_ChannelId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on NotificationChannels with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationChannels) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationChannelsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channels":
+
+			for idx, item := range m.GetChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return NotificationChannelsValidationError{
+							field:  fmt.Sprintf("channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return NotificationChannelsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationChannelsValidationError is the validation error returned by
+// NotificationChannels.ValidateFields if the designated constraints aren't met.
+type NotificationChannelsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannelsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannelsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannelsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannelsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannelsValidationError) ErrorName() string {
+	return "NotificationChannelsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannelsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannels.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannelsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannelsValidationError{}
+
+// ValidateFields checks the field values on NotificationChannelIdentifiers
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *NotificationChannelIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationChannelIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return NotificationChannelIdentifiersValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationChannelIdentifiersValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "channel_id":
+
+			if utf8.RuneCountInString(m.GetChannelId()) > 36 {
+				return NotificationChannelIdentifiersValidationError{
+					field:  "channel_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_NotificationChannelIdentifiers_ChannelId_Pattern.MatchString(m.GetChannelId()) {
+				return NotificationChannelIdentifiersValidationError{
+					field:  "channel_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return NotificationChannelIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationChannelIdentifiersValidationError is the validation error
+// returned by NotificationChannelIdentifiers.ValidateFields if the designated
+// constraints aren't met.
+type NotificationChannelIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannelIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannelIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannelIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannelIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannelIdentifiersValidationError) ErrorName() string {
+	return "NotificationChannelIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannelIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannelIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannelIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannelIdentifiersValidationError{}
+
+var _NotificationChannelIdentifiers_ChannelId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on CreateNotificationChannelRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *CreateNotificationChannelRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateNotificationChannelRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel":
+
+			if m.GetChannel() == nil {
+				return CreateNotificationChannelRequestValidationError{
+					field:  "channel",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateNotificationChannelRequestValidationError{
+						field:  "channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return CreateNotificationChannelRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateNotificationChannelRequestValidationError is the validation error
+// returned by CreateNotificationChannelRequest.ValidateFields if the
+// designated constraints aren't met.
+type CreateNotificationChannelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNotificationChannelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNotificationChannelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNotificationChannelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNotificationChannelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNotificationChannelRequestValidationError) ErrorName() string {
+	return "CreateNotificationChannelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNotificationChannelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNotificationChannelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNotificationChannelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNotificationChannelRequestValidationError{}
+
+// ValidateFields checks the field values on UpdateNotificationChannelRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *UpdateNotificationChannelRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UpdateNotificationChannelRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel":
+
+			if m.GetChannel() == nil {
+				return UpdateNotificationChannelRequestValidationError{
+					field:  "channel",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateNotificationChannelRequestValidationError{
+						field:  "channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateNotificationChannelRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return UpdateNotificationChannelRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateNotificationChannelRequestValidationError is the validation error
+// returned by UpdateNotificationChannelRequest.ValidateFields if the
+// designated constraints aren't met.
+type UpdateNotificationChannelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationChannelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationChannelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationChannelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationChannelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationChannelRequestValidationError) ErrorName() string {
+	return "UpdateNotificationChannelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationChannelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationChannelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationChannelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationChannelRequestValidationError{}
+
+// ValidateFields checks the field values on ListNotificationChannelsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ListNotificationChannelsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListNotificationChannelsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return ListNotificationChannelsRequestValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNotificationChannelsRequestValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListNotificationChannelsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListNotificationChannelsRequestValidationError is the validation error
+// returned by ListNotificationChannelsRequest.ValidateFields if the
+// designated constraints aren't met.
+type ListNotificationChannelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationChannelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationChannelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationChannelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationChannelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationChannelsRequestValidationError) ErrorName() string {
+	return "ListNotificationChannelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationChannelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationChannelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationChannelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationChannelsRequestValidationError{}
+
 // ValidateFields checks the field values on EntityStateChangedNotification
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -911,3 +1684,362 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = EntityStateChangedNotificationValidationError{}
+
+// ValidateFields checks the field values on NotificationChannel_Webhook with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *NotificationChannel_Webhook) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationChannel_WebhookFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "url":
+
+			if uri, err := url.Parse(m.GetUrl()); err != nil {
+				return NotificationChannel_WebhookValidationError{
+					field:  "url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return NotificationChannel_WebhookValidationError{
+					field:  "url",
+					reason: "value must be absolute",
+				}
+			}
+
+		case "secret":
+
+			if v, ok := interface{}(m.GetSecret()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationChannel_WebhookValidationError{
+						field:  "secret",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "headers":
+
+			if len(m.GetHeaders()) > 50 {
+				return NotificationChannel_WebhookValidationError{
+					field:  "headers",
+					reason: "value must contain no more than 50 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetHeaders() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 64 {
+					return NotificationChannel_WebhookValidationError{
+						field:  fmt.Sprintf("headers[%v]", key),
+						reason: "value length must be at most 64 runes",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 4096 {
+					return NotificationChannel_WebhookValidationError{
+						field:  fmt.Sprintf("headers[%v]", key),
+						reason: "value length must be at most 4096 runes",
+					}
+				}
+
+			}
+
+		default:
+			return NotificationChannel_WebhookValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationChannel_WebhookValidationError is the validation error returned
+// by NotificationChannel_Webhook.ValidateFields if the designated constraints
+// aren't met.
+type NotificationChannel_WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannel_WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannel_WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannel_WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannel_WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannel_WebhookValidationError) ErrorName() string {
+	return "NotificationChannel_WebhookValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannel_WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannel_Webhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannel_WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannel_WebhookValidationError{}
+
+// ValidateFields checks the field values on NotificationChannel_Slack with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationChannel_Slack) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationChannel_SlackFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "url":
+
+			if uri, err := url.Parse(m.GetUrl()); err != nil {
+				return NotificationChannel_SlackValidationError{
+					field:  "url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return NotificationChannel_SlackValidationError{
+					field:  "url",
+					reason: "value must be absolute",
+				}
+			}
+
+		default:
+			return NotificationChannel_SlackValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationChannel_SlackValidationError is the validation error returned by
+// NotificationChannel_Slack.ValidateFields if the designated constraints
+// aren't met.
+type NotificationChannel_SlackValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannel_SlackValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannel_SlackValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannel_SlackValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannel_SlackValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannel_SlackValidationError) ErrorName() string {
+	return "NotificationChannel_SlackValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannel_SlackValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannel_Slack.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannel_SlackValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannel_SlackValidationError{}
+
+// ValidateFields checks the field values on NotificationChannel_Matrix with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *NotificationChannel_Matrix) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationChannel_MatrixFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "homeserver_url":
+
+			if uri, err := url.Parse(m.GetHomeserverUrl()); err != nil {
+				return NotificationChannel_MatrixValidationError{
+					field:  "homeserver_url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return NotificationChannel_MatrixValidationError{
+					field:  "homeserver_url",
+					reason: "value must be absolute",
+				}
+			}
+
+		case "room_id":
+
+			if l := utf8.RuneCountInString(m.GetRoomId()); l < 1 || l > 255 {
+				return NotificationChannel_MatrixValidationError{
+					field:  "room_id",
+					reason: "value length must be between 1 and 255 runes, inclusive",
+				}
+			}
+
+		case "access_token":
+
+			if m.GetAccessToken() == nil {
+				return NotificationChannel_MatrixValidationError{
+					field:  "access_token",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetAccessToken()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationChannel_MatrixValidationError{
+						field:  "access_token",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return NotificationChannel_MatrixValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationChannel_MatrixValidationError is the validation error returned
+// by NotificationChannel_Matrix.ValidateFields if the designated constraints
+// aren't met.
+type NotificationChannel_MatrixValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannel_MatrixValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannel_MatrixValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannel_MatrixValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannel_MatrixValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannel_MatrixValidationError) ErrorName() string {
+	return "NotificationChannel_MatrixValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannel_MatrixValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannel_Matrix.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannel_MatrixValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannel_MatrixValidationError{}
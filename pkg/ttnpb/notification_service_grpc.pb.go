@@ -34,9 +34,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	NotificationService_Create_FullMethodName       = "/ttn.lorawan.v3.NotificationService/Create"
-	NotificationService_List_FullMethodName         = "/ttn.lorawan.v3.NotificationService/List"
-	NotificationService_UpdateStatus_FullMethodName = "/ttn.lorawan.v3.NotificationService/UpdateStatus"
+	NotificationService_Create_FullMethodName        = "/ttn.lorawan.v3.NotificationService/Create"
+	NotificationService_List_FullMethodName          = "/ttn.lorawan.v3.NotificationService/List"
+	NotificationService_UpdateStatus_FullMethodName  = "/ttn.lorawan.v3.NotificationService/UpdateStatus"
+	NotificationService_CreateChannel_FullMethodName = "/ttn.lorawan.v3.NotificationService/CreateChannel"
+	NotificationService_GetChannel_FullMethodName    = "/ttn.lorawan.v3.NotificationService/GetChannel"
+	NotificationService_ListChannels_FullMethodName  = "/ttn.lorawan.v3.NotificationService/ListChannels"
+	NotificationService_UpdateChannel_FullMethodName = "/ttn.lorawan.v3.NotificationService/UpdateChannel"
+	NotificationService_DeleteChannel_FullMethodName = "/ttn.lorawan.v3.NotificationService/DeleteChannel"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -51,6 +56,16 @@ type NotificationServiceClient interface {
 	List(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
 	// Batch-update multiple notifications to the same status.
 	UpdateStatus(ctx context.Context, in *UpdateNotificationStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create a notification channel for a user or an organization.
+	CreateChannel(ctx context.Context, in *CreateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error)
+	// Get a notification channel.
+	GetChannel(ctx context.Context, in *NotificationChannelIdentifiers, opts ...grpc.CallOption) (*NotificationChannel, error)
+	// List the notification channels of a user or an organization.
+	ListChannels(ctx context.Context, in *ListNotificationChannelsRequest, opts ...grpc.CallOption) (*NotificationChannels, error)
+	// Update a notification channel.
+	UpdateChannel(ctx context.Context, in *UpdateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error)
+	// Delete a notification channel.
+	DeleteChannel(ctx context.Context, in *NotificationChannelIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type notificationServiceClient struct {
@@ -88,6 +103,51 @@ func (c *notificationServiceClient) UpdateStatus(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *notificationServiceClient) CreateChannel(ctx context.Context, in *CreateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, NotificationService_CreateChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetChannel(ctx context.Context, in *NotificationChannelIdentifiers, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, NotificationService_GetChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListChannels(ctx context.Context, in *ListNotificationChannelsRequest, opts ...grpc.CallOption) (*NotificationChannels, error) {
+	out := new(NotificationChannels)
+	err := c.cc.Invoke(ctx, NotificationService_ListChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateChannel(ctx context.Context, in *UpdateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteChannel(ctx context.Context, in *NotificationChannelIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility
@@ -100,6 +160,16 @@ type NotificationServiceServer interface {
 	List(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
 	// Batch-update multiple notifications to the same status.
 	UpdateStatus(context.Context, *UpdateNotificationStatusRequest) (*emptypb.Empty, error)
+	// Create a notification channel for a user or an organization.
+	CreateChannel(context.Context, *CreateNotificationChannelRequest) (*NotificationChannel, error)
+	// Get a notification channel.
+	GetChannel(context.Context, *NotificationChannelIdentifiers) (*NotificationChannel, error)
+	// List the notification channels of a user or an organization.
+	ListChannels(context.Context, *ListNotificationChannelsRequest) (*NotificationChannels, error)
+	// Update a notification channel.
+	UpdateChannel(context.Context, *UpdateNotificationChannelRequest) (*NotificationChannel, error)
+	// Delete a notification channel.
+	DeleteChannel(context.Context, *NotificationChannelIdentifiers) (*emptypb.Empty, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -116,6 +186,21 @@ func (UnimplementedNotificationServiceServer) List(context.Context, *ListNotific
 func (UnimplementedNotificationServiceServer) UpdateStatus(context.Context, *UpdateNotificationStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
 }
+func (UnimplementedNotificationServiceServer) CreateChannel(context.Context, *CreateNotificationChannelRequest) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetChannel(context.Context, *NotificationChannelIdentifiers) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListChannels(context.Context, *ListNotificationChannelsRequest) (*NotificationChannels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateChannel(context.Context, *UpdateNotificationChannelRequest) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteChannel(context.Context, *NotificationChannelIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +268,96 @@ func _NotificationService_UpdateStatus_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateChannel(ctx, req.(*CreateNotificationChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationChannelIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetChannel(ctx, req.(*NotificationChannelIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListChannels(ctx, req.(*ListNotificationChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateChannel(ctx, req.(*UpdateNotificationChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationChannelIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteChannel(ctx, req.(*NotificationChannelIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +377,26 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStatus",
 			Handler:    _NotificationService_UpdateStatus_Handler,
 		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _NotificationService_CreateChannel_Handler,
+		},
+		{
+			MethodName: "GetChannel",
+			Handler:    _NotificationService_GetChannel_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _NotificationService_ListChannels_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _NotificationService_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _NotificationService_DeleteChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/notification_service.proto",
@@ -623,6 +623,76 @@ func (x *UpdateNotificationStatusRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the UpdateNotificationChannelRequest message to JSON.
+func (x *UpdateNotificationChannelRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Channel != nil || s.HasField("channel") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("channel")
+		// NOTE: NotificationChannel does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Channel)
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UpdateNotificationChannelRequest to JSON.
+func (x *UpdateNotificationChannelRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UpdateNotificationChannelRequest message from JSON.
+func (x *UpdateNotificationChannelRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "channel":
+			s.AddField("channel")
+			if s.ReadNil() {
+				x.Channel = nil
+				return
+			}
+			// NOTE: NotificationChannel does not seem to implement UnmarshalProtoJSON.
+			var v NotificationChannel
+			golang.UnmarshalMessage(s, &v)
+			x.Channel = &v
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UpdateNotificationChannelRequest from JSON.
+func (x *UpdateNotificationChannelRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the EntityStateChangedNotification message to JSON.
 func (x *EntityStateChangedNotification) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
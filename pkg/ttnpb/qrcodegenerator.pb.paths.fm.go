@@ -406,6 +406,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_downlink_at",
 	"end_device.mac_state.last_network_initiated_downlink_at",
 	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.min_rj_count_0",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -432,11 +433,17 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_operator_requests",
 	"end_device.mac_state.pending_relay_downlink",
 	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.correlation_ids",
 	"end_device.mac_state.queued_join_accept.dev_addr",
@@ -470,6 +477,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.recent_downlinks",
 	"end_device.mac_state.recent_mac_command_identifiers",
@@ -622,6 +630,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_downlink_at",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
 	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.min_rj_count_0",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -648,11 +657,17 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_operator_requests",
 	"end_device.pending_mac_state.pending_relay_downlink",
 	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.correlation_ids",
 	"end_device.pending_mac_state.queued_join_accept.dev_addr",
@@ -686,6 +701,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.recent_downlinks",
 	"end_device.pending_mac_state.recent_mac_command_identifiers",
@@ -1194,6 +1210,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_state.last_downlink_at",
 	"end_device_template.end_device.mac_state.last_network_initiated_downlink_at",
 	"end_device_template.end_device.mac_state.lorawan_version",
+	"end_device_template.end_device.mac_state.min_rj_count_0",
 	"end_device_template.end_device.mac_state.pending_application_downlink",
 	"end_device_template.end_device.mac_state.pending_application_downlink.class_b_c",
 	"end_device_template.end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -1220,11 +1237,17 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device_template.end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device_template.end_device.mac_state.pending_join_request.rx_delay",
+	"end_device_template.end_device.mac_state.pending_operator_requests",
 	"end_device_template.end_device.mac_state.pending_relay_downlink",
 	"end_device_template.end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device_template.end_device.mac_state.pending_requests",
 	"end_device_template.end_device.mac_state.ping_slot_periodicity",
 	"end_device_template.end_device.mac_state.ping_slot_periodicity.value",
+	"end_device_template.end_device.mac_state.queued_force_rejoin_req",
+	"end_device_template.end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device_template.end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device_template.end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device_template.end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device_template.end_device.mac_state.queued_join_accept",
 	"end_device_template.end_device.mac_state.queued_join_accept.correlation_ids",
 	"end_device_template.end_device.mac_state.queued_join_accept.dev_addr",
@@ -1258,6 +1281,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device_template.end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device_template.end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device_template.end_device.mac_state.queued_operator_commands",
 	"end_device_template.end_device.mac_state.queued_responses",
 	"end_device_template.end_device.mac_state.recent_downlinks",
 	"end_device_template.end_device.mac_state.recent_mac_command_identifiers",
@@ -1410,6 +1434,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.pending_mac_state.last_downlink_at",
 	"end_device_template.end_device.pending_mac_state.last_network_initiated_downlink_at",
 	"end_device_template.end_device.pending_mac_state.lorawan_version",
+	"end_device_template.end_device.pending_mac_state.min_rj_count_0",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.class_b_c",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
@@ -1436,11 +1461,17 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device_template.end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device_template.end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device_template.end_device.pending_mac_state.pending_operator_requests",
 	"end_device_template.end_device.pending_mac_state.pending_relay_downlink",
 	"end_device_template.end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device_template.end_device.pending_mac_state.pending_requests",
 	"end_device_template.end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device_template.end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device_template.end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device_template.end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device_template.end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device_template.end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device_template.end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device_template.end_device.pending_mac_state.queued_join_accept",
 	"end_device_template.end_device.pending_mac_state.queued_join_accept.correlation_ids",
 	"end_device_template.end_device.pending_mac_state.queued_join_accept.dev_addr",
@@ -1474,6 +1505,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device_template.end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
 	"end_device_template.end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device_template.end_device.pending_mac_state.queued_operator_commands",
 	"end_device_template.end_device.pending_mac_state.queued_responses",
 	"end_device_template.end_device.pending_mac_state.recent_downlinks",
 	"end_device_template.end_device.pending_mac_state.recent_mac_command_identifiers",
@@ -0,0 +1,113 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcap
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+const (
+	loRaTapVersion      = 1
+	loRaTapHeaderLength = 35
+
+	// LoRaTapSyncWordPublic is the sync word of public LoRaWAN networks.
+	LoRaTapSyncWordPublic = 0x34
+	// LoRaTapSyncWordPrivate is the sync word of private LoRa networks.
+	LoRaTapSyncWordPrivate = 0x12
+
+	// loRaTapRSSIOffset is the offset of the RSSI values in dBm.
+	loRaTapRSSIOffset = 139
+)
+
+// LoRaTapFlags are the flags of a LoRaTap frame.
+type LoRaTapFlags uint8
+
+// LoRaTap flags.
+const (
+	LoRaTapFlagFSK LoRaTapFlags = 1 << iota
+	LoRaTapFlagIQInverted
+	LoRaTapFlagImplicitHeader
+	LoRaTapFlagCRCOK
+	LoRaTapFlagCRCBad
+	LoRaTapFlagNoCRC
+)
+
+// LoRaTapHeader is the LoRaTap version 1 header of a frame.
+type LoRaTapHeader struct {
+	// Frequency is the frequency in Hz.
+	Frequency uint32
+	// Bandwidth is the LoRa bandwidth in Hz.
+	Bandwidth uint32
+	// SpreadingFactor is the LoRa spreading factor.
+	SpreadingFactor uint8
+	// RSSI is the packet RSSI in dBm.
+	RSSI float32
+	// ChannelRSSI is the channel RSSI in dBm.
+	ChannelRSSI float32
+	// SNR is the signal-to-noise ratio in dB.
+	SNR float32
+	// SyncWord is the sync word.
+	SyncWord uint8
+	// SourceGateway is the EUI of the gateway.
+	SourceGateway uint64
+	// Timestamp is the concentrator timestamp in microseconds.
+	Timestamp uint32
+	// Flags are the flags of the frame.
+	Flags LoRaTapFlags
+	// CodingRate is the denominator of the LoRa coding rate, i.e. 5 for 4/5.
+	CodingRate uint8
+	// DataRate is the FSK bit rate.
+	DataRate uint16
+	// IFChannel is the IF channel of the concentrator.
+	IFChannel uint8
+	// RFChain is the RF chain of the concentrator.
+	RFChain uint8
+	// Tag is an application specific tag.
+	Tag uint16
+}
+
+func loRaTapRSSI(rssi float32) uint8 {
+	return uint8(math.Max(0, math.Min(math.MaxUint8, math.Round(float64(rssi)+loRaTapRSSIOffset))))
+}
+
+func loRaTapSNR(snr float32) uint8 {
+	return uint8(int8(math.Max(math.MinInt8, math.Min(math.MaxInt8, math.Round(float64(snr)*4)))))
+}
+
+// AppendLoRaTap appends the LoRaTap frame with the given header and payload to dst.
+func AppendLoRaTap(dst []byte, hdr LoRaTapHeader, payload []byte) []byte {
+	var b [loRaTapHeaderLength]byte
+	b[0] = loRaTapVersion
+	binary.BigEndian.PutUint16(b[2:], loRaTapHeaderLength)
+	binary.BigEndian.PutUint32(b[4:], hdr.Frequency)
+	b[8] = uint8(hdr.Bandwidth / 125000)
+	b[9] = hdr.SpreadingFactor
+	b[10] = loRaTapRSSI(hdr.RSSI)
+	b[11] = loRaTapRSSI(hdr.RSSI)
+	b[12] = loRaTapRSSI(hdr.ChannelRSSI)
+	b[13] = loRaTapSNR(hdr.SNR)
+	b[14] = hdr.SyncWord
+	binary.BigEndian.PutUint64(b[15:], hdr.SourceGateway)
+	binary.BigEndian.PutUint32(b[23:], hdr.Timestamp)
+	b[27] = uint8(hdr.Flags)
+	b[28] = hdr.CodingRate
+	binary.BigEndian.PutUint16(b[29:], hdr.DataRate)
+	b[31] = hdr.IFChannel
+	b[32] = hdr.RFChain
+	binary.BigEndian.PutUint16(b[33:], hdr.Tag)
+	dst = append(dst, b[:]...)
+	return append(dst, payload...)
+}
@@ -0,0 +1,76 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pcap writes packet captures in the PCAP file format with LoRaTap encapsulated LoRa frames.
+package pcap
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// LinkType is the link-layer header type of the packets in a capture.
+type LinkType uint32
+
+// LinkTypeLoRaTap is the link-layer header type of LoRaTap encapsulated frames.
+const LinkTypeLoRaTap LinkType = 270
+
+const (
+	// magicNanoseconds is the magic number of PCAP files with nanosecond resolution timestamps.
+	magicNanoseconds = 0xa1b23c4d
+	versionMajor     = 2
+	versionMinor     = 4
+	// SnapLength is the maximum length of captured packets.
+	SnapLength = 65535
+)
+
+// Writer writes packets to a PCAP file.
+type Writer struct {
+	w   io.Writer
+	buf [16]byte
+}
+
+// NewWriter writes the PCAP file header to w and returns a Writer that writes packets of the given link type.
+func NewWriter(w io.Writer, linkType LinkType) (*Writer, error) {
+	var hdr [24]byte
+	binary.LittleEndian.PutUint32(hdr[0:], magicNanoseconds)
+	binary.LittleEndian.PutUint16(hdr[4:], versionMajor)
+	binary.LittleEndian.PutUint16(hdr[6:], versionMinor)
+	// Bytes 8 to 16 are the time zone offset and timestamp accuracy, which are always zero.
+	binary.LittleEndian.PutUint32(hdr[16:], SnapLength)
+	binary.LittleEndian.PutUint32(hdr[20:], uint32(linkType))
+	if _, err := w.Write(hdr[:]); err != nil {
+		return nil, err
+	}
+	return &Writer{w: w}, nil
+}
+
+// WritePacket writes the packet captured at the given time.
+// Packets longer than SnapLength are truncated.
+func (w *Writer) WritePacket(t time.Time, data []byte) error {
+	origLen := len(data)
+	if len(data) > SnapLength {
+		data = data[:SnapLength]
+	}
+	binary.LittleEndian.PutUint32(w.buf[0:], uint32(t.Unix()))
+	binary.LittleEndian.PutUint32(w.buf[4:], uint32(t.Nanosecond()))
+	binary.LittleEndian.PutUint32(w.buf[8:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(w.buf[12:], uint32(origLen))
+	if _, err := w.w.Write(w.buf[:]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(data)
+	return err
+}
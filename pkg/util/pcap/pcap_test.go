@@ -0,0 +1,91 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcap_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/pcap"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	var buf bytes.Buffer
+	w, err := pcap.NewWriter(&buf, pcap.LinkTypeLoRaTap)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(buf.Bytes(), should.Resemble, []byte{
+		0x4d, 0x3c, 0xb2, 0xa1, // Magic.
+		0x02, 0x00, 0x04, 0x00, // Version.
+		0x00, 0x00, 0x00, 0x00, // Time zone.
+		0x00, 0x00, 0x00, 0x00, // Accuracy.
+		0xff, 0xff, 0x00, 0x00, // Snap length.
+		0x0e, 0x01, 0x00, 0x00, // Link type.
+	})
+
+	buf.Reset()
+	err = w.WritePacket(time.Unix(0x01020304, 0x05060708), []byte{0xaa, 0xbb})
+	a.So(err, should.BeNil)
+	a.So(buf.Bytes(), should.Resemble, []byte{
+		0x04, 0x03, 0x02, 0x01, // Seconds.
+		0x08, 0x07, 0x06, 0x05, // Nanoseconds.
+		0x02, 0x00, 0x00, 0x00, // Captured length.
+		0x02, 0x00, 0x00, 0x00, // Original length.
+		0xaa, 0xbb,
+	})
+}
+
+func TestAppendLoRaTap(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	b := pcap.AppendLoRaTap(nil, pcap.LoRaTapHeader{
+		Frequency:       868100000,
+		Bandwidth:       125000,
+		SpreadingFactor: 7,
+		RSSI:            -42,
+		ChannelRSSI:     -40,
+		SNR:             -7.25,
+		SyncWord:        pcap.LoRaTapSyncWordPublic,
+		SourceGateway:   0x0102030405060708,
+		Timestamp:       0x11223344,
+		Flags:           pcap.LoRaTapFlagCRCOK,
+		CodingRate:      5,
+		IFChannel:       3,
+		RFChain:         1,
+		Tag:             1,
+	}, []byte{0x40, 0x01})
+	a.So(b, should.Resemble, []byte{
+		0x01, 0x00, 0x00, 0x23, // Version, padding and header length.
+		0x33, 0xbe, 0x27, 0xa0, // Frequency.
+		0x01, 0x07, // Bandwidth and spreading factor.
+		0x61, 0x61, 0x63, 0xe3, // Packet RSSI, max RSSI, current RSSI and SNR.
+		0x34,                                           // Sync word.
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, // Source gateway.
+		0x11, 0x22, 0x33, 0x44, // Timestamp.
+		0x08, 0x05, // Flags and coding rate.
+		0x00, 0x00, // Data rate.
+		0x03, 0x01, // IF channel and RF chain.
+		0x00, 0x01, // Tag.
+		0x40, 0x01,
+	})
+}